@@ -779,7 +779,7 @@ const metadataApiRoutes = `
       "body": "{\"Code\": \"Success\",\"LastUpdated\": \"2016-03-17T12:27:32Z\",\"InstanceProfileArn\": \"arn:aws:iam::123456789013:instance-profile/my-instance-profile\",\"InstanceProfileId\": \"AIPAABCDEFGHIJKLMN123\"}"
     },
     {
-      "uri": "/latest/meta-data/iam/security-credentials",
+      "uri": "/latest/meta-data/iam/security-credentials/",
       "body": "test_role"
     },
     {
@@ -47,6 +47,7 @@ func expandDistributionConfig(d *schema.ResourceData) *cloudfront.DistributionCo
 		DefaultCacheBehavior: expandDefaultCacheBehavior(d.Get("default_cache_behavior").(*schema.Set).List()[0].(map[string]interface{})),
 		Enabled:              aws.Bool(d.Get("enabled").(bool)),
 		HttpVersion:          aws.String(d.Get("http_version").(string)),
+		IsIPV6Enabled:        aws.Bool(d.Get("is_ipv6_enabled").(bool)),
 		Origins:              expandOrigins(d.Get("origin").(*schema.Set)),
 		PriceClass:           aws.String(d.Get("price_class").(string)),
 	}
@@ -127,6 +128,9 @@ func flattenDistributionConfig(d *schema.ResourceData, distributionConfig *cloud
 	if distributionConfig.HttpVersion != nil {
 		d.Set("http_version", distributionConfig.HttpVersion)
 	}
+	if distributionConfig.IsIPV6Enabled != nil {
+		d.Set("is_ipv6_enabled", distributionConfig.IsIPV6Enabled)
+	}
 	if distributionConfig.WebACLId != nil {
 		d.Set("web_acl_id", distributionConfig.WebACLId)
 	}
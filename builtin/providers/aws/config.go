@@ -14,17 +14,29 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/athena"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/budgets"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/codebuild"
 	"github.com/aws/aws-sdk-go/service/codecommit"
 	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/aws/aws-sdk-go/service/directconnect"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -39,23 +51,30 @@ import (
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/emr"
 	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/gamelift"
 	"github.com/aws/aws-sdk-go/service/glacier"
+	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/kinesis"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lightsail"
 	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
 	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/aws-sdk-go/service/simpledb"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/go-cleanhttp"
@@ -75,17 +94,34 @@ type Config struct {
 	AssumeRoleARN         string
 	AssumeRoleExternalID  string
 	AssumeRoleSessionName string
+	AssumeRolePolicy      string
 
 	AllowedAccountIds   []interface{}
 	ForbiddenAccountIds []interface{}
 
-	DynamoDBEndpoint string
-	KinesisEndpoint  string
-	Ec2Endpoint      string
-	IamEndpoint      string
-	ElbEndpoint      string
-	S3Endpoint       string
-	Insecure         bool
+	RequiredTagKeys []string
+
+	IamPropagationDelay   time.Duration
+	IamPropagationTimeout time.Duration
+	Route53SyncTimeout    time.Duration
+
+	DynamoDBEndpoint         string
+	KinesisEndpoint          string
+	Ec2Endpoint              string
+	IamEndpoint              string
+	ElbEndpoint              string
+	S3Endpoint               string
+	CloudFormationEndpoint   string
+	CloudWatchEndpoint       string
+	CloudWatchEventsEndpoint string
+	CloudWatchLogsEndpoint   string
+	KmsEndpoint              string
+	RdsEndpoint              string
+	SnsEndpoint              string
+	SqsEndpoint              string
+	StsEndpoint              string
+
+	Insecure bool
 
 	SkipCredsValidation     bool
 	SkipRequestingAccountId bool
@@ -94,12 +130,16 @@ type Config struct {
 }
 
 type AWSClient struct {
+	acmconn               *acm.ACM
 	cfconn                *cloudformation.CloudFormation
 	cloudfrontconn        *cloudfront.CloudFront
 	cloudtrailconn        *cloudtrail.CloudTrail
 	cloudwatchconn        *cloudwatch.CloudWatch
 	cloudwatchlogsconn    *cloudwatchlogs.CloudWatchLogs
 	cloudwatcheventsconn  *cloudwatchevents.CloudWatchEvents
+	dmsconn               *databasemigrationservice.DatabaseMigrationService
+	daxconn               *dax.DAX
+	dxconn                *directconnect.DirectConnect
 	dsconn                *directoryservice.DirectoryService
 	dynamodbconn          *dynamodb.DynamoDB
 	ec2conn               *ec2.EC2
@@ -114,6 +154,7 @@ type AWSClient struct {
 	appautoscalingconn    *applicationautoscaling.ApplicationAutoScaling
 	autoscalingconn       *autoscaling.AutoScaling
 	s3conn                *s3.S3
+	sdconn                *servicediscovery.ServiceDiscovery
 	sesConn               *ses.SES
 	simpledbconn          *simpledb.SimpleDB
 	sqsconn               *sqs.SQS
@@ -124,21 +165,41 @@ type AWSClient struct {
 	partition             string
 	accountid             string
 	region                string
+	requiredTagKeys       []string
+	inlineManagement      *inlineManagement
+	describeCache         *describeCache
+	iamPropagation        *propagationWaiter
+	route53Propagation    *propagationWaiter
+	readOnlyClient        *AWSClient
 	rdsconn               *rds.RDS
 	iamconn               *iam.IAM
 	kinesisconn           *kinesis.Kinesis
 	kmsconn               *kms.KMS
 	firehoseconn          *firehose.Firehose
+	gameliftconn          *gamelift.GameLift
 	elasticacheconn       *elasticache.ElastiCache
 	elasticbeanstalkconn  *elasticbeanstalk.ElasticBeanstalk
 	elastictranscoderconn *elastictranscoder.ElasticTranscoder
 	lambdaconn            *lambda.Lambda
+	lightsailconn         *lightsail.Lightsail
 	opsworksconn          *opsworks.OpsWorks
+	organizationsconn     *organizations.Organizations
 	glacierconn           *glacier.Glacier
+	guarddutyconn         *guardduty.GuardDuty
 	codedeployconn        *codedeploy.CodeDeploy
+	codepipelineconn      *codepipeline.CodePipeline
+	codebuildconn         *codebuild.CodeBuild
 	codecommitconn        *codecommit.CodeCommit
 	ssmconn               *ssm.SSM
 	wafconn               *waf.WAF
+	wafregionalconn       *wafregional.WAFRegional
+	athenaconn            *athena.Athena
+	batchconn             *batch.Batch
+	budgetconn            *budgets.Budgets
+	cognitoconn           *cognitoidentity.CognitoIdentity
+	cognitoidpconn        *cognitoidentityprovider.CognitoIdentityProvider
+	configconn            *configservice.ConfigService
+	sfnconn               *sfn.SFN
 }
 
 // Client configures and returns a fully initialized AWSClient
@@ -155,6 +216,20 @@ func (c *Config) Client() (interface{}, error) {
 	// store AWS region in client struct, for region specific operations such as
 	// bucket storage in S3
 	client.region = c.Region
+	client.requiredTagKeys = c.RequiredTagKeys
+	client.inlineManagement = newInlineManagement()
+	client.describeCache = newDescribeCache()
+	client.iamPropagation = &propagationWaiter{
+		Delay:   c.IamPropagationDelay,
+		Timeout: c.IamPropagationTimeout,
+	}
+	if client.iamPropagation.Timeout == 0 {
+		client.iamPropagation.Timeout = defaultIamPropagationTimeout
+	}
+	client.route53Propagation = &propagationWaiter{Timeout: c.Route53SyncTimeout}
+	if client.route53Propagation.Timeout == 0 {
+		client.route53Propagation.Timeout = defaultRoute53SyncTimeout
+	}
 
 	log.Println("[INFO] Building AWS auth structure")
 	creds, err := GetCredentials(c)
@@ -176,6 +251,10 @@ func (c *Config) Client() (interface{}, error) {
 
 	log.Printf("[INFO] AWS Auth provider used: %q", cp.ProviderName)
 
+	// All clients share one throttle state, so that API rate limiting seen
+	// by any of them slows down requests made by the others.
+	throttle := newThrottleState()
+
 	awsConfig := &aws.Config{
 		Credentials:      creds,
 		Region:           aws.String(c.Region),
@@ -183,6 +262,7 @@ func (c *Config) Client() (interface{}, error) {
 		HTTPClient:       cleanhttp.DefaultClient(),
 		S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle),
 	}
+	request.WithRetryer(awsConfig, newAwsRetryer(c.MaxRetries, throttle))
 
 	if logging.IsDebugOrHigher() {
 		awsConfig.LogLevel = aws.LogLevel(aws.LogDebugWithHTTPBody)
@@ -202,6 +282,9 @@ func (c *Config) Client() (interface{}, error) {
 		return nil, errwrap.Wrapf("Error creating AWS session: {{err}}", err)
 	}
 	sess.Handlers.Build.PushFrontNamed(addTerraformVersionToUserAgent)
+	addThrottleHandlers(&sess.Handlers, throttle)
+	addDescribeCacheHandlers(&sess.Handlers, client.describeCache)
+	addMetricsHandlers(&sess.Handlers)
 
 	if extraDebug := os.Getenv("TERRAFORM_AWS_AUTHFAILURE_DEBUG"); extraDebug != "" {
 		sess.Handlers.UnmarshalError.PushFrontNamed(debugAuthFailure)
@@ -221,10 +304,19 @@ func (c *Config) Client() (interface{}, error) {
 	awsS3Sess := sess.Copy(&aws.Config{Endpoint: aws.String(c.S3Endpoint)})
 	dynamoSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.DynamoDBEndpoint)})
 	kinesisSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.KinesisEndpoint)})
+	awsCfSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudFormationEndpoint)})
+	awsCwSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchEndpoint)})
+	awsCweSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchEventsEndpoint)})
+	awsCwlSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchLogsEndpoint)})
+	awsKmsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.KmsEndpoint)})
+	awsRdsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.RdsEndpoint)})
+	awsSnsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.SnsEndpoint)})
+	awsSqsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.SqsEndpoint)})
+	awsStsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.StsEndpoint)})
 
 	// These two services need to be set up early so we can check on AccountID
 	client.iamconn = iam.New(awsIamSess)
-	client.stsconn = sts.New(sess)
+	client.stsconn = sts.New(awsStsSess)
 
 	if !c.SkipCredsValidation {
 		err = c.ValidateCredentials(client.stsconn)
@@ -246,17 +338,30 @@ func (c *Config) Client() (interface{}, error) {
 		return nil, authErr
 	}
 
+	client.acmconn = acm.New(sess)
 	client.apigateway = apigateway.New(sess)
 	client.appautoscalingconn = applicationautoscaling.New(sess)
+	client.athenaconn = athena.New(sess)
+	client.batchconn = batch.New(sess)
+	client.budgetconn = budgets.New(sess)
+	client.sfnconn = sfn.New(sess)
 	client.autoscalingconn = autoscaling.New(sess)
-	client.cfconn = cloudformation.New(sess)
+	client.cfconn = cloudformation.New(awsCfSess)
 	client.cloudfrontconn = cloudfront.New(sess)
 	client.cloudtrailconn = cloudtrail.New(sess)
-	client.cloudwatchconn = cloudwatch.New(sess)
-	client.cloudwatcheventsconn = cloudwatchevents.New(sess)
-	client.cloudwatchlogsconn = cloudwatchlogs.New(sess)
+	client.cloudwatchconn = cloudwatch.New(awsCwSess)
+	client.cloudwatcheventsconn = cloudwatchevents.New(awsCweSess)
+	client.cloudwatchlogsconn = cloudwatchlogs.New(awsCwlSess)
+	client.codebuildconn = codebuild.New(sess)
 	client.codecommitconn = codecommit.New(usEast1Sess)
 	client.codedeployconn = codedeploy.New(sess)
+	client.codepipelineconn = codepipeline.New(sess)
+	client.cognitoconn = cognitoidentity.New(sess)
+	client.cognitoidpconn = cognitoidentityprovider.New(sess)
+	client.configconn = configservice.New(sess)
+	client.dmsconn = databasemigrationservice.New(sess)
+	client.daxconn = dax.New(sess)
+	client.dxconn = directconnect.New(sess)
 	client.dsconn = directoryservice.New(sess)
 	client.dynamodbconn = dynamodb.New(dynamoSess)
 	client.ec2conn = ec2.New(awsEc2Sess)
@@ -271,21 +376,27 @@ func (c *Config) Client() (interface{}, error) {
 	client.emrconn = emr.New(sess)
 	client.esconn = elasticsearch.New(sess)
 	client.firehoseconn = firehose.New(sess)
+	client.gameliftconn = gamelift.New(sess)
 	client.glacierconn = glacier.New(sess)
+	client.guarddutyconn = guardduty.New(sess)
 	client.kinesisconn = kinesis.New(kinesisSess)
-	client.kmsconn = kms.New(sess)
+	client.kmsconn = kms.New(awsKmsSess)
 	client.lambdaconn = lambda.New(sess)
+	client.lightsailconn = lightsail.New(sess)
 	client.opsworksconn = opsworks.New(usEast1Sess)
+	client.organizationsconn = organizations.New(sess)
 	client.r53conn = route53.New(usEast1Sess)
-	client.rdsconn = rds.New(sess)
+	client.rdsconn = rds.New(awsRdsSess)
 	client.redshiftconn = redshift.New(sess)
 	client.simpledbconn = simpledb.New(sess)
 	client.s3conn = s3.New(awsS3Sess)
+	client.sdconn = servicediscovery.New(sess)
 	client.sesConn = ses.New(sess)
-	client.snsconn = sns.New(sess)
-	client.sqsconn = sqs.New(sess)
+	client.snsconn = sns.New(awsSnsSess)
+	client.sqsconn = sqs.New(awsSqsSess)
 	client.ssmconn = ssm.New(sess)
 	client.wafconn = waf.New(sess)
+	client.wafregionalconn = wafregional.New(sess)
 
 	return &client, nil
 }
@@ -0,0 +1,74 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsAcmCertificate() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsAcmCertificateRead,
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"statuses": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsAcmCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmconn
+
+	params := &acm.ListCertificatesInput{}
+	if v, ok := d.GetOk("statuses"); ok && len(v.([]interface{})) > 0 {
+		params.CertificateStatuses = expandStringList(v.([]interface{}))
+	} else {
+		params.CertificateStatuses = []*string{aws.String(acm.CertificateStatusIssued)}
+	}
+
+	domain := d.Get("domain").(string)
+
+	var arns []string
+	log.Printf("[DEBUG] Reading ACM certificates: %s", params)
+	err := conn.ListCertificatesPages(params, func(page *acm.ListCertificatesOutput, lastPage bool) bool {
+		for _, cert := range page.CertificateSummaryList {
+			if aws.StringValue(cert.DomainName) == domain {
+				arns = append(arns, aws.StringValue(cert.CertificateArn))
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("Error describing certificates: %s", err)
+	}
+
+	if len(arns) == 0 {
+		return fmt.Errorf("No certificate for domain %q found in this region.", domain)
+	}
+	if len(arns) > 1 {
+		return fmt.Errorf("Multiple certificates for domain %q found in this region.", domain)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("arn", arns[0])
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAwsAcmCertificateDataSource_noMatchReturnsError(t *testing.T) {
+	domain := "hashicorp.com"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccCheckAwsAcmCertificateDataSourceConfig(domain),
+				ExpectError: regexp.MustCompile(`No certificate for domain`),
+			},
+			resource.TestStep{
+				Config:      testAccCheckAwsAcmCertificateDataSourceConfigWithStatus(domain),
+				ExpectError: regexp.MustCompile(`No certificate for domain`),
+			},
+		},
+	})
+}
+
+func TestAccAwsAcmCertificateDataSource_issued(t *testing.T) {
+	domain := os.Getenv("ACM_CERTIFICATE_DOMAIN")
+	if domain == "" {
+		t.Skip("Environment variable ACM_CERTIFICATE_DOMAIN is not set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckAwsAcmCertificateDataSourceConfig(domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.aws_acm_certificate.test", "arn",
+						regexp.MustCompile(`^arn:aws:acm:[^:]+:[^:]+:certificate/.+$`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsAcmCertificateDataSourceConfig(domain string) string {
+	return fmt.Sprintf(`
+data "aws_acm_certificate" "test" {
+  domain = "%s"
+}
+`, domain)
+}
+
+func testAccCheckAwsAcmCertificateDataSourceConfigWithStatus(domain string) string {
+	return fmt.Sprintf(`
+data "aws_acm_certificate" "test" {
+  domain   = "%s"
+  statuses = ["ISSUED"]
+}
+`, domain)
+}
@@ -45,9 +45,10 @@ func dataSourceAwsAmi() *schema.Resource {
 				},
 			},
 			"name_regex": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegexp,
 			},
 			"most_recent": &schema.Schema{
 				Type:     schema.TypeBool,
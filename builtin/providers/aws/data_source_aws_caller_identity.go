@@ -5,6 +5,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -17,24 +18,35 @@ func dataSourceAwsCallerIdentity() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
 func dataSourceAwsCallerIdentityRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient)
+	client := meta.(*AWSClient).stsconn
 
 	log.Printf("[DEBUG] Reading Caller Identity.")
-	d.SetId(time.Now().UTC().String())
-
-	if client.accountid == "" {
-		log.Println("[DEBUG] No Account ID available, failing")
-		return fmt.Errorf("No AWS Account ID is available to the provider. Please ensure that\n" +
-			"skip_requesting_account_id is not set on the AWS provider.")
+	res, err := client.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		return fmt.Errorf("Error getting Caller Identity: %s", err)
 	}
 
-	log.Printf("[DEBUG] Setting AWS Account ID to %s.", client.accountid)
-	d.Set("account_id", meta.(*AWSClient).accountid)
+	log.Printf("[DEBUG] Received Caller Identity: %s", res)
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("account_id", res.Account)
+	d.Set("arn", res.Arn)
+	d.Set("user_id", res.UserId)
 
 	return nil
 }
@@ -36,7 +36,15 @@ func testAccCheckAwsCallerIdentityAccountId(n string) resource.TestCheckFunc {
 
 		expected := testAccProvider.Meta().(*AWSClient).accountid
 		if rs.Primary.Attributes["account_id"] != expected {
-			return fmt.Errorf("Incorrect Account ID: expected %q, got %q", expected, rs.Primary.ID)
+			return fmt.Errorf("Incorrect Account ID: expected %q, got %q", expected, rs.Primary.Attributes["account_id"])
+		}
+
+		if rs.Primary.Attributes["arn"] == "" {
+			return fmt.Errorf("Caller Identity ARN not set.")
+		}
+
+		if rs.Primary.Attributes["user_id"] == "" {
+			return fmt.Errorf("Caller Identity User ID not set.")
 		}
 
 		return nil
@@ -0,0 +1,89 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEbsSnapshotDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckAwsEbsSnapshotDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEbsSnapshotDataSourceID("data.aws_ebs_snapshot.by_id"),
+					testAccCheckAwsEbsSnapshotDataSourceID("data.aws_ebs_snapshot.most_recent"),
+					resource.TestCheckResourceAttr("data.aws_ebs_snapshot.most_recent", "volume_size", "1"),
+					resource.TestCheckResourceAttr("data.aws_ebs_snapshot.most_recent", "state", "completed"),
+				),
+			},
+		},
+	})
+}
+
+func TestMostRecentSnapshot(t *testing.T) {
+	now := time.Now()
+	snapshots := []*ec2.Snapshot{
+		&ec2.Snapshot{SnapshotId: aws.String("snap-1"), StartTime: aws.Time(now.Add(-time.Hour))},
+		&ec2.Snapshot{SnapshotId: aws.String("snap-2"), StartTime: aws.Time(now)},
+		&ec2.Snapshot{SnapshotId: aws.String("snap-3"), StartTime: aws.Time(now.Add(-2 * time.Hour))},
+	}
+
+	if id := *mostRecentSnapshot(snapshots).SnapshotId; id != "snap-2" {
+		t.Fatalf("bad: %s", id)
+	}
+}
+
+func testAccCheckAwsEbsSnapshotDataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find snapshot data source: %s", n)
+		}
+
+		snapshotRs, ok := s.RootModule().Resources["aws_ebs_snapshot.snapshot"]
+		if !ok {
+			return fmt.Errorf("Can't find aws_ebs_snapshot.snapshot in state")
+		}
+
+		if rs.Primary.ID != snapshotRs.Primary.ID {
+			return fmt.Errorf("Snapshot data source ID is %s, want %s",
+				rs.Primary.ID, snapshotRs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+const testAccCheckAwsEbsSnapshotDataSourceConfig = `
+resource "aws_ebs_volume" "example" {
+	availability_zone = "us-west-2a"
+	type = "gp2"
+	size = 1
+}
+
+resource "aws_ebs_snapshot" "snapshot" {
+	volume_id = "${aws_ebs_volume.example.id}"
+}
+
+data "aws_ebs_snapshot" "by_id" {
+	snapshot_ids = ["${aws_ebs_snapshot.snapshot.id}"]
+}
+
+data "aws_ebs_snapshot" "most_recent" {
+	most_recent = true
+	owners = ["self"]
+
+	filter {
+		name = "volume-id"
+		values = ["${aws_ebs_snapshot.snapshot.volume_id}"]
+	}
+}
+`
@@ -0,0 +1,66 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsEcrRepository() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsEcrRepositoryRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"registry_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"repository_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsEcrRepositoryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrconn
+
+	name := d.Get("name").(string)
+	params := &ecr.DescribeRepositoriesInput{
+		RepositoryNames: []*string{aws.String(name)},
+	}
+
+	log.Printf("[DEBUG] Reading ECR repository: %s", params)
+	out, err := conn.DescribeRepositories(params)
+	if err != nil {
+		if isAWSErr(err, ecr.ErrCodeRepositoryNotFoundException, "") {
+			return fmt.Errorf("ECR repository %q not found", name)
+		}
+		return fmt.Errorf("Error reading ECR repository %q: %s", name, err)
+	}
+	if len(out.Repositories) != 1 {
+		return fmt.Errorf("Expected exactly one ECR repository named %q, got %d", name, len(out.Repositories))
+	}
+
+	repository := out.Repositories[0]
+
+	d.SetId(*repository.RepositoryName)
+	d.Set("arn", repository.RepositoryArn)
+	d.Set("registry_id", repository.RegistryId)
+	d.Set("name", repository.RepositoryName)
+	d.Set("repository_url", buildRepositoryUrl(repository, meta.(*AWSClient).region))
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSEcrRepository_dataSource_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-ecr-ds-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckAwsEcrRepositoryDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_ecr_repository.test", "name", rName),
+					resource.TestMatchResourceAttr("data.aws_ecr_repository.test", "arn",
+						regexp.MustCompile("^arn:aws:ecr:[a-z0-9-]+:[0-9]{12}:repository/"+rName+"$")),
+					resource.TestMatchResourceAttr("data.aws_ecr_repository.test", "repository_url",
+						regexp.MustCompile("^https://[0-9]{12}\\.dkr\\.ecr\\.[a-z0-9-]+\\.amazonaws\\.com/"+rName+"$")),
+					resource.TestCheckResourceAttrSet("data.aws_ecr_repository.test", "registry_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsEcrRepositoryDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "test" {
+  name = "%s"
+}
+
+data "aws_ecr_repository" "test" {
+  name = "${aws_ecr_repository.test.name}"
+}
+`, name)
+}
@@ -0,0 +1,139 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsEip() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsEipRead,
+
+		Schema: map[string]*schema.Schema{
+			"association_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"filter": ec2CustomFiltersSchema(),
+
+			"id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"network_interface_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"private_dns": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"private_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"public_dns": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"public_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"public_ipv4_pool": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsEipRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AWSClient)
+	conn := client.ec2conn
+
+	req := &ec2.DescribeAddressesInput{}
+
+	if id, ok := d.GetOk("id"); ok {
+		req.AllocationIds = []*string{aws.String(id.(string))}
+	}
+
+	if publicIp, ok := d.GetOk("public_ip"); ok {
+		req.PublicIps = []*string{aws.String(publicIp.(string))}
+	}
+
+	req.Filters = buildEC2TagFilterList(
+		tagsFromMap(d.Get("tags").(map[string]interface{})),
+	)
+	req.Filters = append(req.Filters, buildEC2CustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+	if len(req.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		req.Filters = nil
+	}
+
+	log.Printf("[DEBUG] DescribeAddresses %s\n", req)
+	resp, err := conn.DescribeAddresses(req)
+	if err != nil {
+		return err
+	}
+	if resp == nil || len(resp.Addresses) == 0 {
+		return fmt.Errorf("no matching Elastic IP found")
+	}
+	if len(resp.Addresses) > 1 {
+		return fmt.Errorf("multiple Elastic IPs matched; use additional constraints to reduce matches to a single Elastic IP")
+	}
+
+	eip := resp.Addresses[0]
+
+	// EC2-Classic addresses have no allocation ID and are identified by
+	// their public IP instead.
+	if eip.AllocationId != nil {
+		d.SetId(*eip.AllocationId)
+	} else {
+		d.SetId(*eip.PublicIp)
+	}
+	d.Set("id", d.Id())
+	d.Set("association_id", eip.AssociationId)
+	d.Set("domain", eip.Domain)
+	d.Set("instance_id", eip.InstanceId)
+	d.Set("network_interface_id", eip.NetworkInterfaceId)
+	d.Set("private_ip", eip.PrivateIpAddress)
+	if eip.PrivateIpAddress != nil {
+		d.Set("private_dns", ec2PrivateDnsName(*eip.PrivateIpAddress, client.region))
+	}
+	d.Set("public_ip", eip.PublicIp)
+	if eip.PublicIp != nil {
+		d.Set("public_dns", ec2PublicDnsName(*eip.PublicIp, client.region, client.partition))
+	}
+	d.Set("public_ipv4_pool", eip.PublicIpv4Pool)
+	d.Set("tags", tagsToMap(eip.Tags))
+
+	return nil
+}
@@ -2,11 +2,13 @@ package aws
 
 import (
 	"encoding/json"
+	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
-	"strconv"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 var dataSourceAwsIamPolicyDocumentVarReplacer = strings.NewReplacer("&{", "${")
@@ -41,6 +43,10 @@ func dataSourceAwsIamPolicyDocument() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 							Default:  "Allow",
+							ValidateFunc: validation.StringInSlice([]string{
+								"Allow",
+								"Deny",
+							}, false),
 						},
 						"actions":        setOfString,
 						"not_actions":    setOfString,
@@ -96,6 +102,10 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 	doc.Statements = stmts
 	for i, stmtI := range cfgStmts {
 		cfgStmt := stmtI.(map[string]interface{})
+		if err := dataSourceAwsIamPolicyDocumentValidateStatement(cfgStmt); err != nil {
+			return fmt.Errorf("statement %d: %s", i, err)
+		}
+
 		stmt := &IAMPolicyStatement{
 			Effect: cfgStmt["effect"].(string),
 		}
@@ -150,6 +160,55 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
+// dataSourceAwsIamPolicyDocumentValidateStatement catches statements that
+// IAM would reject, so that they fail when the document is read during plan
+// rather than when the policy is eventually applied.
+func dataSourceAwsIamPolicyDocumentValidateStatement(cfgStmt map[string]interface{}) error {
+	actions := cfgStmt["actions"].(*schema.Set)
+	notActions := cfgStmt["not_actions"].(*schema.Set)
+	if actions.Len() == 0 && notActions.Len() == 0 {
+		return fmt.Errorf("one of actions or not_actions must be set")
+	}
+
+	conflicts := [][2]string{
+		{"actions", "not_actions"},
+		{"resources", "not_resources"},
+		{"principals", "not_principals"},
+	}
+	for _, c := range conflicts {
+		if cfgStmt[c[0]].(*schema.Set).Len() > 0 && cfgStmt[c[1]].(*schema.Set).Len() > 0 {
+			return fmt.Errorf("only one of %s or %s can be set", c[0], c[1])
+		}
+	}
+
+	for _, action := range append(actions.List(), notActions.List()...) {
+		if err := validateIamPolicyAction(action.(string)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateIamPolicyAction checks that an action is either "*" or of the
+// form "service:Action", where the action may contain wildcards.
+func validateIamPolicyAction(action string) error {
+	if action == "*" {
+		return nil
+	}
+
+	parts := strings.Split(action, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf(
+			"action %q must be \"*\" or of the form \"service:Action\"", action)
+	}
+	if strings.ContainsAny(parts[0], "*?") {
+		return fmt.Errorf("action %q: service prefix cannot contain wildcards", action)
+	}
+
+	return nil
+}
+
 func dataSourceAwsIamPolicyDocumentReplaceVarsInList(in interface{}) interface{} {
 	switch v := in.(type) {
 	case string:
@@ -207,6 +266,13 @@ func dataSourceAwsIamPolicyPrincipalSchema() *schema.Schema {
 				"type": &schema.Schema{
 					Type:     schema.TypeString,
 					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"*",
+						"AWS",
+						"Service",
+						"Federated",
+						"CanonicalUser",
+					}, false),
 				},
 				"identifiers": &schema.Schema{
 					Type:     schema.TypeSet,
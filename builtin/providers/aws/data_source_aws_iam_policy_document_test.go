@@ -1,10 +1,13 @@
 package aws
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
-	"fmt"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -50,6 +53,132 @@ func testAccCheckStateValue(id, name, value string) resource.TestCheckFunc {
 	}
 }
 
+func TestDataSourceAwsIamPolicyDocumentRead_invalidStatements(t *testing.T) {
+	cases := []struct {
+		Statement map[string]interface{}
+		Err       string
+	}{
+		{
+			map[string]interface{}{
+				"actions":   []interface{}{"s3:GetObject"},
+				"resources": []interface{}{"*"},
+			},
+			"",
+		},
+		{
+			map[string]interface{}{
+				"resources": []interface{}{"*"},
+			},
+			"one of actions or not_actions must be set",
+		},
+		{
+			map[string]interface{}{
+				"actions":     []interface{}{"s3:GetObject"},
+				"not_actions": []interface{}{"s3:PutObject"},
+			},
+			"only one of actions or not_actions",
+		},
+		{
+			map[string]interface{}{
+				"actions":       []interface{}{"s3:*"},
+				"resources":     []interface{}{"arn:aws:s3:::foo"},
+				"not_resources": []interface{}{"arn:aws:s3:::bar"},
+			},
+			"only one of resources or not_resources",
+		},
+		{
+			map[string]interface{}{
+				"actions": []interface{}{"sts:AssumeRole"},
+				"principals": []interface{}{
+					map[string]interface{}{
+						"type":        "AWS",
+						"identifiers": []interface{}{"*"},
+					},
+				},
+				"not_principals": []interface{}{
+					map[string]interface{}{
+						"type":        "Service",
+						"identifiers": []interface{}{"ec2.amazonaws.com"},
+					},
+				},
+			},
+			"only one of principals or not_principals",
+		},
+		{
+			map[string]interface{}{
+				"actions": []interface{}{"GetObject"},
+			},
+			`action "GetObject" must be`,
+		},
+	}
+
+	r := dataSourceAwsIamPolicyDocument()
+	for i, tc := range cases {
+		raw, err := config.NewRawConfig(map[string]interface{}{
+			"statement": []interface{}{tc.Statement},
+		})
+		if err != nil {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+
+		diff, err := r.Diff(nil, terraform.NewResourceConfig(raw))
+		if err != nil {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+
+		_, err = r.ReadDataApply(diff, nil)
+		if (err != nil) != (tc.Err != "") {
+			t.Fatalf("%d: unexpected err: %v", i, err)
+		}
+		if err != nil && !strings.Contains(err.Error(), "statement 0: "+tc.Err) {
+			t.Fatalf("%d: expected %q in: %s", i, tc.Err, err)
+		}
+	}
+}
+
+func TestValidateIamPolicyAction(t *testing.T) {
+	valid := []string{
+		"*",
+		"s3:GetObject",
+		"s3:*",
+		"ec2:Describe*",
+		"iam:Get?ser",
+	}
+	for _, v := range valid {
+		if err := validateIamPolicyAction(v); err != nil {
+			t.Fatalf("%q should be valid: %s", v, err)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"GetObject",
+		"s3:",
+		":GetObject",
+		"s3:Get:Object",
+		"s*:GetObject",
+	}
+	for _, v := range invalid {
+		if err := validateIamPolicyAction(v); err == nil {
+			t.Fatalf("%q should be invalid", v)
+		}
+	}
+}
+
+func TestDataSourceAwsIamPolicyDocument_validateEffect(t *testing.T) {
+	effect := dataSourceAwsIamPolicyDocument().Schema["statement"].Elem.(*schema.Resource).Schema["effect"]
+	for _, v := range []string{"Allow", "Deny"} {
+		if _, errs := effect.ValidateFunc(v, "effect"); len(errs) > 0 {
+			t.Fatalf("%q should be valid: %v", v, errs)
+		}
+	}
+	for _, v := range []string{"allow", "Permit", ""} {
+		if _, errs := effect.ValidateFunc(v, "effect"); len(errs) == 0 {
+			t.Fatalf("%q should be invalid", v)
+		}
+	}
+}
+
 var testAccAWSIAMPolicyDocumentConfig = `
 data "aws_iam_policy_document" "test" {
     policy_id = "policy_id"
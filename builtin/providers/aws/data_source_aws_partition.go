@@ -0,0 +1,49 @@
+package aws
+
+import (
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsPartition() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsPartitionRead,
+
+		Schema: map[string]*schema.Schema{
+			"partition": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsPartitionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AWSClient)
+
+	partition := client.partition
+	if partition == "" {
+		// The partition is only known from the account when the provider
+		// requests the account ID, but it can always be derived from the
+		// region.
+		partition = partitionForRegion(client.region)
+	}
+
+	log.Printf("[DEBUG] Setting AWS partition to %s.", partition)
+	d.SetId(partition)
+	d.Set("partition", partition)
+
+	return nil
+}
+
+// partitionForRegion returns the partition a region belongs to, e.g.
+// "aws-cn" for cn-north-1. Unknown regions are assumed to be in the
+// standard "aws" partition.
+func partitionForRegion(region string) string {
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
+		return p.ID()
+	}
+	return endpoints.AwsPartitionID
+}
@@ -0,0 +1,60 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSPartition_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAwsPartitionConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsPartition("data.aws_partition.current"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsPartition(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find partition resource: %s", n)
+		}
+
+		expected := partitionForRegion(testAccProvider.Meta().(*AWSClient).region)
+		if rs.Primary.Attributes["partition"] != expected {
+			return fmt.Errorf("Incorrect partition: expected %q, got %q",
+				expected, rs.Primary.Attributes["partition"])
+		}
+
+		return nil
+	}
+}
+
+func TestPartitionForRegion(t *testing.T) {
+	cases := map[string]string{
+		"us-west-2":     "aws",
+		"eu-central-1":  "aws",
+		"cn-north-1":    "aws-cn",
+		"us-gov-west-1": "aws-us-gov",
+		"xx-nowhere-1":  "aws",
+	}
+	for region, expected := range cases {
+		if actual := partitionForRegion(region); actual != expected {
+			t.Errorf("%s: expected %q, got %q", region, expected, actual)
+		}
+	}
+}
+
+const testAccCheckAwsPartitionConfig_basic = `
+data "aws_partition" "current" { }
+`
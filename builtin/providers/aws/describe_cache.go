@@ -0,0 +1,128 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// describeCache is a read-through cache of Describe* results, shared by
+// every resource of a single provider configuration. Many resources read the
+// same parent object during a refresh, for example every aws_route of a
+// route table reads the whole table, so without the cache a configuration
+// with hundreds of routes makes hundreds of identical API calls.
+//
+// Only reads that can tolerate a result from earlier in the same run should
+// go through the cache: waiters polling for a state change must call the API
+// directly. Any write to a service drops the cached results of that service.
+type describeCache struct {
+	mu      sync.Mutex
+	entries map[string]interface{}
+
+	// generations counts the invalidations of each service, so that a read
+	// that raced with a write isn't cached.
+	generations map[string]uint64
+}
+
+func newDescribeCache() *describeCache {
+	return &describeCache{
+		entries:     make(map[string]interface{}),
+		generations: make(map[string]uint64),
+	}
+}
+
+// Read returns the cached result of the given operation with the given
+// input, calling read and caching its result if there is none. Errors aren't
+// cached. A nil cache always calls read.
+//
+// The result is a copy, so callers are free to modify it.
+func (c *describeCache) Read(service, operation string, input fmt.Stringer, read func() (interface{}, error)) (interface{}, error) {
+	if c == nil {
+		return read()
+	}
+
+	key := describeCacheKey(service, operation, input)
+
+	c.mu.Lock()
+	v, ok := c.entries[key]
+	gen := c.generations[service]
+	c.mu.Unlock()
+	if ok {
+		log.Printf("[DEBUG] Using cached result of %s/%s", service, operation)
+		return awsutil.CopyOf(v), nil
+	}
+
+	v, err := read()
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	if c.generations[service] == gen {
+		c.entries[key] = awsutil.CopyOf(v)
+	}
+	c.mu.Unlock()
+
+	return v, nil
+}
+
+// Invalidate drops every cached result of the given service.
+func (c *describeCache) Invalidate(service string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generations[service]++
+	prefix := service + "/"
+	for k := range c.entries {
+		if strings.HasPrefix(k, prefix) {
+			delete(c.entries, k)
+		}
+	}
+}
+
+func describeCacheKey(service, operation string, input fmt.Stringer) string {
+	return service + "/" + operation + "/" + input.String()
+}
+
+// isReadOperation reports whether an API operation only reads.
+func isReadOperation(operation string) bool {
+	for _, prefix := range []string{"Describe", "Get", "List"} {
+		if strings.HasPrefix(operation, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// addDescribeCacheHandlers drops the cached results of a service whenever
+// a request that writes to it completes. Failed writes may still have
+// changed something, so they drop the results too.
+func addDescribeCacheHandlers(h *request.Handlers, cache *describeCache) {
+	h.Complete.PushBackNamed(request.NamedHandler{
+		Name: "terraform.DescribeCacheInvalidateHandler",
+		Fn: func(r *request.Request) {
+			if r.Operation == nil || isReadOperation(r.Operation.Name) {
+				return
+			}
+			cache.Invalidate(r.ClientInfo.ServiceName)
+		},
+	})
+}
+
+// describeCacheFromMeta returns the describeCache of the configured
+// provider, or nil if the provider isn't configured.
+func describeCacheFromMeta(meta interface{}) *describeCache {
+	client, ok := meta.(*AWSClient)
+	if !ok || client == nil {
+		return nil
+	}
+	return client.describeCache
+}
@@ -0,0 +1,114 @@
+package aws
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestDescribeCache(t *testing.T) {
+	cache := newDescribeCache()
+	calls := 0
+	describe := func(id string) (*ec2.DescribeRouteTablesOutput, error) {
+		input := &ec2.DescribeRouteTablesInput{
+			RouteTableIds: []*string{aws.String(id)},
+		}
+		out, err := cache.Read("ec2", "DescribeRouteTables", input, func() (interface{}, error) {
+			calls++
+			return &ec2.DescribeRouteTablesOutput{
+				RouteTables: []*ec2.RouteTable{{RouteTableId: aws.String(id)}},
+			}, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return out.(*ec2.DescribeRouteTablesOutput), nil
+	}
+
+	out, _ := describe("rtb-1")
+	out.RouteTables[0].RouteTableId = aws.String("modified")
+	out, _ = describe("rtb-1")
+	if calls != 1 {
+		t.Fatalf("expected 1 call, got %d", calls)
+	}
+	if id := *out.RouteTables[0].RouteTableId; id != "rtb-1" {
+		t.Fatalf("cached result was modified: %s", id)
+	}
+
+	// Other input
+	describe("rtb-2")
+	if calls != 2 {
+		t.Fatalf("expected 2 calls, got %d", calls)
+	}
+
+	// Writes to another service keep the results
+	cache.Invalidate("iam")
+	describe("rtb-1")
+	if calls != 2 {
+		t.Fatalf("expected 2 calls, got %d", calls)
+	}
+
+	cache.Invalidate("ec2")
+	describe("rtb-1")
+	if calls != 3 {
+		t.Fatalf("expected 3 calls, got %d", calls)
+	}
+}
+
+func TestDescribeCache_raceWithWrite(t *testing.T) {
+	cache := newDescribeCache()
+	input := &ec2.DescribeSecurityGroupsInput{}
+	calls := 0
+
+	cache.Read("ec2", "DescribeSecurityGroups", input, func() (interface{}, error) {
+		calls++
+		cache.Invalidate("ec2")
+		return &ec2.DescribeSecurityGroupsOutput{}, nil
+	})
+	cache.Read("ec2", "DescribeSecurityGroups", input, func() (interface{}, error) {
+		calls++
+		return &ec2.DescribeSecurityGroupsOutput{}, nil
+	})
+	if calls != 2 {
+		t.Fatalf("expected 2 calls, got %d", calls)
+	}
+}
+
+func TestDescribeCache_errors(t *testing.T) {
+	var cache *describeCache
+	input := &ec2.DescribeSecurityGroupsInput{}
+	read := func() (interface{}, error) {
+		return nil, errors.New("boom")
+	}
+
+	// A nil cache just reads
+	if _, err := cache.Read("ec2", "DescribeSecurityGroups", input, read); err == nil {
+		t.Fatal("expected error")
+	}
+
+	cache = newDescribeCache()
+	if _, err := cache.Read("ec2", "DescribeSecurityGroups", input, read); err == nil {
+		t.Fatal("expected error")
+	}
+	if len(cache.entries) != 0 {
+		t.Fatalf("errors must not be cached: %#v", cache.entries)
+	}
+}
+
+func TestIsReadOperation(t *testing.T) {
+	cases := map[string]bool{
+		"DescribeRouteTables": true,
+		"GetRole":             true,
+		"ListTagsForResource": true,
+		"CreateRoute":         false,
+		"ModifyVpcAttribute":  false,
+		"PutRolePolicy":       false,
+	}
+	for op, expected := range cases {
+		if actual := isReadOperation(op); actual != expected {
+			t.Errorf("%s: expected %t, got %t", op, expected, actual)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package aws
 
 import (
+	"encoding/json"
+	"reflect"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/jen20/awspolicyequivalence"
 )
@@ -13,3 +16,30 @@ func suppressEquivalentAwsPolicyDiffs(k, old, new string, d *schema.ResourceData
 
 	return equivalent
 }
+
+func suppressEquivalentBatchContainerPropertiesDiffs(k, old, new string, d *schema.ResourceData) bool {
+	equivalent, err := batchContainerPropertiesAreEquivalent(old, new)
+	if err != nil {
+		return false
+	}
+
+	return equivalent
+}
+
+func suppressEquivalentJsonDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return jsonBytesEqual([]byte(old), []byte(new))
+}
+
+func jsonBytesEqual(b1, b2 []byte) bool {
+	var o1 interface{}
+	if err := json.Unmarshal(b1, &o1); err != nil {
+		return false
+	}
+
+	var o2 interface{}
+	if err := json.Unmarshal(b2, &o2); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(o1, o2)
+}
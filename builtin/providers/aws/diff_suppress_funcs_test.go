@@ -0,0 +1,45 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestSuppressEquivalentJsonDiffs(t *testing.T) {
+	cases := []struct {
+		Old, New   string
+		Equivalent bool
+	}{
+		{
+			Old:        `{"a": "b", "c": [1, 2]}`,
+			New:        "{\n  \"c\": [1, 2],\n  \"a\": \"b\"\n}",
+			Equivalent: true,
+		},
+		{
+			Old:        `{"a": "b"}`,
+			New:        `{"a": "c"}`,
+			Equivalent: false,
+		},
+		{
+			Old:        `{"c": [1, 2]}`,
+			New:        `{"c": [2, 1]}`,
+			Equivalent: false,
+		},
+		{
+			Old:        ``,
+			New:        `{}`,
+			Equivalent: false,
+		},
+		{
+			Old:        `{"a": `,
+			New:        `{"a": `,
+			Equivalent: false,
+		},
+	}
+
+	for i, tc := range cases {
+		equivalent := suppressEquivalentJsonDiffs("", tc.Old, tc.New, nil)
+		if equivalent != tc.Equivalent {
+			t.Fatalf("%d: expected %t for %q and %q", i, tc.Equivalent, tc.Old, tc.New)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package aws
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// inlineKind describes a parent resource whose children can be managed
+// either inline or with standalone resources, such as the routes of a
+// route table.
+type inlineKind struct {
+	Parent     string // Human name of the parent, e.g. "route table"
+	Children   string // Human name of the children, e.g. "routes"
+	Standalone string // Type of the standalone resources, e.g. "aws_route"
+	Exclusive  string // Argument of the parent that forbids standalone resources
+}
+
+var (
+	inlineKindRouteTable = &inlineKind{
+		Parent:     "route table",
+		Children:   "routes",
+		Standalone: "aws_route",
+		Exclusive:  "exclusive_routes",
+	}
+
+	inlineKindSecurityGroup = &inlineKind{
+		Parent:     "security group",
+		Children:   "rules",
+		Standalone: "aws_security_group_rule",
+		Exclusive:  "exclusive_rules",
+	}
+)
+
+// inlineManagement records, while a configured provider diffs resources,
+// which parents manage their children inline and which children are
+// managed by standalone resources. Managing the children of one parent both
+// ways makes the two undo each other's changes on every apply, so the
+// resource that is diffed last warns about it, or fails the plan if the
+// parent is declared to manage its children exclusively.
+//
+// Only resources diffed by the same provider instance are seen, so
+// standalone resources in another module aren't detected.
+type inlineManagement struct {
+	sync.Mutex
+	parents map[string]*inlineParent
+}
+
+type inlineParent struct {
+	inline     bool
+	exclusive  bool
+	standalone map[string]struct{}
+}
+
+func newInlineManagement() *inlineManagement {
+	return &inlineManagement{parents: make(map[string]*inlineParent)}
+}
+
+// Inline records that the parent with the given ID declares its children
+// inline.
+func (m *inlineManagement) Inline(kind *inlineKind, id string, exclusive bool) ([]string, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	p := m.parent(kind, id)
+	p.inline = true
+	p.exclusive = p.exclusive || exclusive
+	return p.conflict(kind, id)
+}
+
+// Standalone records that a standalone resource manages the child of the
+// parent with the given ID that is described by child.
+func (m *inlineManagement) Standalone(kind *inlineKind, id, child string) ([]string, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	p := m.parent(kind, id)
+	p.standalone[child] = struct{}{}
+	return p.conflict(kind, id)
+}
+
+func (m *inlineManagement) parent(kind *inlineKind, id string) *inlineParent {
+	key := kind.Parent + ":" + id
+	p, ok := m.parents[key]
+	if !ok {
+		p = &inlineParent{standalone: make(map[string]struct{})}
+		m.parents[key] = p
+	}
+	return p
+}
+
+func (p *inlineParent) conflict(kind *inlineKind, id string) ([]string, error) {
+	if !p.inline || len(p.standalone) == 0 {
+		return nil, nil
+	}
+
+	children := make([]string, 0, len(p.standalone))
+	for c := range p.standalone {
+		children = append(children, c)
+	}
+	sort.Strings(children)
+
+	if p.exclusive {
+		return nil, fmt.Errorf(
+			"%s %s sets %s, but %s resources also manage its %s: %s",
+			kind.Parent, id, kind.Exclusive, kind.Standalone, kind.Children,
+			strings.Join(children, ", "))
+	}
+
+	return []string{fmt.Sprintf(
+		"%s %s declares its %s inline, but %s resources also manage its %s: %s. "+
+			"Declare them either inline or with %s, not both, or each apply "+
+			"will undo the changes of the other.",
+		kind.Parent, id, kind.Children, kind.Standalone, kind.Children,
+		strings.Join(children, ", "), kind.Standalone)}, nil
+}
+
+// inlineManagementFromMeta returns the inlineManagement of the configured
+// provider, or nil if the provider isn't configured.
+func inlineManagementFromMeta(meta interface{}) *inlineManagement {
+	client, ok := meta.(*AWSClient)
+	if !ok || client == nil {
+		return nil
+	}
+	return client.inlineManagement
+}
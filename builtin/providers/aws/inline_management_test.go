@@ -0,0 +1,55 @@
+package aws
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestInlineManagement(t *testing.T) {
+	m := newInlineManagement()
+
+	// Standalone routes of a table that isn't known to be managed inline
+	ws, err := m.Standalone(inlineKindRouteTable, "rtb-1", "10.1.0.0/16")
+	if err != nil || len(ws) != 0 {
+		t.Fatalf("bad: %#v, %s", ws, err)
+	}
+	ws, err = m.Standalone(inlineKindRouteTable, "rtb-2", "10.1.0.0/16")
+	if err != nil || len(ws) != 0 {
+		t.Fatalf("bad: %#v, %s", ws, err)
+	}
+
+	// The table is diffed after its standalone routes
+	ws, err = m.Inline(inlineKindRouteTable, "rtb-1", false)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(ws) != 1 || !strings.Contains(ws[0], "route table rtb-1 declares its routes inline") ||
+		!strings.Contains(ws[0], "10.1.0.0/16") {
+		t.Fatalf("bad: %#v", ws)
+	}
+
+	// Another standalone route of the same table
+	ws, err = m.Standalone(inlineKindRouteTable, "rtb-1", "10.0.0.0/16")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(ws) != 1 || !strings.Contains(ws[0], "10.0.0.0/16, 10.1.0.0/16") {
+		t.Fatalf("bad: %#v", ws)
+	}
+
+	// A security group with the same ID is a different parent
+	ws, err = m.Inline(inlineKindSecurityGroup, "rtb-2", false)
+	if err != nil || len(ws) != 0 {
+		t.Fatalf("bad: %#v, %s", ws, err)
+	}
+
+	// An exclusively managed parent fails
+	_, err = m.Inline(inlineKindSecurityGroup, "sg-1", true)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	_, err = m.Standalone(inlineKindSecurityGroup, "sg-1", "ingress tcp 80-80")
+	if err == nil || !strings.Contains(err.Error(), "sets exclusive_rules") {
+		t.Fatalf("bad: %s", err)
+	}
+}
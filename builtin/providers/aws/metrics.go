@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/hashicorp/terraform/helper/metrics"
+)
+
+// addMetricsHandlers counts API calls, retries and throttling for the run
+// metrics file. Nothing is recorded unless Terraform asked for metrics.
+func addMetricsHandlers(h *request.Handlers) {
+	if !metrics.Enabled() {
+		return
+	}
+
+	// Throttling is counted before the default handler clears the error of
+	// attempts that will be retried.
+	h.AfterRetry.PushFrontNamed(request.NamedHandler{
+		Name: "terraform.MetricsThrottleHandler",
+		Fn: func(r *request.Request) {
+			if r.IsErrorThrottle() {
+				metrics.RecordAPICall("aws", metricsOperationName(r), metrics.Counts{Throttles: 1})
+			}
+		},
+	})
+	h.Complete.PushBackNamed(request.NamedHandler{
+		Name: "terraform.MetricsCompleteHandler",
+		Fn: func(r *request.Request) {
+			c := metrics.Counts{
+				Calls:   1,
+				Retries: r.RetryCount,
+			}
+			if r.Error != nil {
+				c.Errors = 1
+			}
+			metrics.RecordAPICall("aws", metricsOperationName(r), c)
+		},
+	})
+}
+
+// metricsOperationName names the API operation of a request, such as
+// "ec2.DescribeInstances".
+func metricsOperationName(r *request.Request) string {
+	name := r.ClientInfo.ServiceName
+	if r.Operation != nil {
+		name += "." + r.Operation.Name
+	}
+	return name
+}
@@ -0,0 +1,76 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	defaultIamPropagationDelay   time.Duration = 0
+	defaultIamPropagationTimeout               = 2 * time.Minute
+	defaultRoute53SyncTimeout                  = 30 * time.Minute
+)
+
+// propagationWaiter waits for a write to an eventually consistent service
+// to become usable by other services. Resources that write to such a service
+// wait before they return, so that resources depending on them don't fail
+// with NotFound or invalid principal errors.
+type propagationWaiter struct {
+	// Delay is the minimum time between the write and the return of the
+	// resource. Some services, like IAM, give no way to tell when a write
+	// has reached every other service.
+	Delay time.Duration
+
+	// Timeout is the maximum time to wait for the write to become visible.
+	Timeout time.Duration
+}
+
+// Wait waits until visible reports that what was written at start can be
+// read back, and then until at least Delay has passed since start. visible
+// may be nil if there's nothing to read back. desc describes what was written
+// for logs and errors.
+func (w *propagationWaiter) Wait(desc string, start time.Time, visible func() (bool, error)) error {
+	if w == nil {
+		return nil
+	}
+
+	if visible != nil {
+		err := resource.Retry(w.Timeout, func() *resource.RetryError {
+			ok, err := visible()
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if !ok {
+				return resource.RetryableError(fmt.Errorf("%s isn't visible yet", desc))
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error waiting for %s to propagate: %s", desc, err)
+		}
+	}
+
+	if remaining := w.Delay - time.Since(start); remaining > 0 {
+		log.Printf("[DEBUG] Waiting %s for %s to propagate", remaining, desc)
+		time.Sleep(remaining)
+	}
+	return nil
+}
+
+// iamPropagationWaiter returns the waiter for IAM writes of the configured
+// provider.
+func iamPropagationWaiter(meta interface{}) *propagationWaiter {
+	return meta.(*AWSClient).iamPropagation
+}
+
+// route53SyncTimeout returns how long to wait for Route 53 changes to be
+// in sync.
+func route53SyncTimeout(meta interface{}) time.Duration {
+	if w := meta.(*AWSClient).route53Propagation; w != nil {
+		return w.Timeout
+	}
+	return defaultRoute53SyncTimeout
+}
@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPropagationWaiter(t *testing.T) {
+	w := &propagationWaiter{
+		Delay:   50 * time.Millisecond,
+		Timeout: time.Minute,
+	}
+
+	// Visible right away, so only the delay is waited for
+	start := time.Now()
+	if err := w.Wait("test", start, func() (bool, error) { return true, nil }); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if d := time.Since(start); d < w.Delay {
+		t.Fatalf("returned after %s, before the delay", d)
+	}
+
+	// The delay counts from the write, not from the wait
+	start = time.Now().Add(-time.Hour)
+	before := time.Now()
+	if err := w.Wait("test", start, nil); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if d := time.Since(before); d >= w.Delay {
+		t.Fatalf("waited %s after the delay passed", d)
+	}
+
+	// Errors from the visibility check fail the wait
+	err := w.Wait("IAM Role foo", time.Now(), func() (bool, error) {
+		return false, fmt.Errorf("AccessDenied")
+	})
+	if err == nil || !strings.Contains(err.Error(), "IAM Role foo") {
+		t.Fatalf("bad: %s", err)
+	}
+
+	// A nil waiter doesn't wait
+	var nilWaiter *propagationWaiter
+	if err := nilWaiter.Wait("test", time.Now(), nil); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/mutexkv"
@@ -42,6 +43,8 @@ func Provider() terraform.ResourceProvider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"read_only": readOnlySchema(),
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -95,6 +98,7 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				Default:     "",
 				Description: descriptions["dynamodb_endpoint"],
+				Deprecated:  "Use `dynamodb` inside `endpoints` block instead",
 			},
 
 			"kinesis_endpoint": {
@@ -102,6 +106,7 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				Default:     "",
 				Description: descriptions["kinesis_endpoint"],
+				Deprecated:  "Use `kinesis` inside `endpoints` block instead",
 			},
 
 			"endpoints": endpointsSchema(),
@@ -140,27 +145,39 @@ func Provider() terraform.ResourceProvider {
 				Default:     false,
 				Description: descriptions["s3_force_path_style"],
 			},
+
+			"tag_policy": tagPolicySchema(),
+
+			"propagation": propagationSchema(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
+			"aws_acm_certificate":          dataSourceAwsAcmCertificate(),
 			"aws_ami":                      dataSourceAwsAmi(),
 			"aws_availability_zone":        dataSourceAwsAvailabilityZone(),
 			"aws_availability_zones":       dataSourceAwsAvailabilityZones(),
 			"aws_billing_service_account":  dataSourceAwsBillingServiceAccount(),
 			"aws_caller_identity":          dataSourceAwsCallerIdentity(),
 			"aws_cloudformation_stack":     dataSourceAwsCloudFormationStack(),
+			"aws_ebs_snapshot":             dataSourceAwsEbsSnapshot(),
+			"aws_ecr_repository":           dataSourceAwsEcrRepository(),
 			"aws_ecs_container_definition": dataSourceAwsEcsContainerDefinition(),
+			"aws_eip":                      dataSourceAwsEip(),
 			"aws_elb_service_account":      dataSourceAwsElbServiceAccount(),
 			"aws_iam_policy_document":      dataSourceAwsIamPolicyDocument(),
 			"aws_ip_ranges":                dataSourceAwsIPRanges(),
+			"aws_partition":                dataSourceAwsPartition(),
 			"aws_redshift_service_account": dataSourceAwsRedshiftServiceAccount(),
 			"aws_region":                   dataSourceAwsRegion(),
+			"aws_route_table":              dataSourceAwsRouteTable(),
 			"aws_s3_bucket_object":         dataSourceAwsS3BucketObject(),
 			"aws_subnet":                   dataSourceAwsSubnet(),
 			"aws_vpc":                      dataSourceAwsVpc(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
+			"aws_acm_certificate":                          resourceAwsAcmCertificate(),
+			"aws_acm_certificate_validation":               resourceAwsAcmCertificateValidation(),
 			"aws_alb":                                      resourceAwsAlb(),
 			"aws_alb_listener":                             resourceAwsAlbListener(),
 			"aws_alb_listener_rule":                        resourceAwsAlbListenerRule(),
@@ -187,10 +204,16 @@ func Provider() terraform.ResourceProvider {
 			"aws_app_cookie_stickiness_policy":             resourceAwsAppCookieStickinessPolicy(),
 			"aws_appautoscaling_target":                    resourceAwsAppautoscalingTarget(),
 			"aws_appautoscaling_policy":                    resourceAwsAppautoscalingPolicy(),
+			"aws_athena_database":                          resourceAwsAthenaDatabase(),
+			"aws_athena_named_query":                       resourceAwsAthenaNamedQuery(),
 			"aws_autoscaling_group":                        resourceAwsAutoscalingGroup(),
 			"aws_autoscaling_notification":                 resourceAwsAutoscalingNotification(),
 			"aws_autoscaling_policy":                       resourceAwsAutoscalingPolicy(),
 			"aws_autoscaling_schedule":                     resourceAwsAutoscalingSchedule(),
+			"aws_batch_compute_environment":                resourceAwsBatchComputeEnvironment(),
+			"aws_batch_job_definition":                     resourceAwsBatchJobDefinition(),
+			"aws_batch_job_queue":                          resourceAwsBatchJobQueue(),
+			"aws_budgets_budget":                           resourceAwsBudgetsBudget(),
 			"aws_cloudformation_stack":                     resourceAwsCloudFormationStack(),
 			"aws_cloudfront_distribution":                  resourceAwsCloudFrontDistribution(),
 			"aws_cloudfront_origin_access_identity":        resourceAwsCloudFrontOriginAccessIdentity(),
@@ -205,18 +228,42 @@ func Provider() terraform.ResourceProvider {
 			"aws_cloudwatch_metric_alarm":                  resourceAwsCloudWatchMetricAlarm(),
 			"aws_codedeploy_app":                           resourceAwsCodeDeployApp(),
 			"aws_codedeploy_deployment_group":              resourceAwsCodeDeployDeploymentGroup(),
+			"aws_codebuild_project":                        resourceAwsCodeBuildProject(),
 			"aws_codecommit_repository":                    resourceAwsCodeCommitRepository(),
 			"aws_codecommit_trigger":                       resourceAwsCodeCommitTrigger(),
+			"aws_codepipeline":                             resourceAwsCodePipeline(),
+			"aws_cognito_identity_pool":                    resourceAwsCognitoIdentityPool(),
+			"aws_cognito_identity_pool_roles_attachment":   resourceAwsCognitoIdentityPoolRolesAttachment(),
+			"aws_cognito_user_pool":                        resourceAwsCognitoUserPool(),
+			"aws_cognito_user_pool_client":                 resourceAwsCognitoUserPoolClient(),
+			"aws_config_config_rule":                       resourceAwsConfigConfigRule(),
+			"aws_config_configuration_recorder":            resourceAwsConfigConfigurationRecorder(),
+			"aws_config_configuration_recorder_status":     resourceAwsConfigConfigurationRecorderStatus(),
+			"aws_config_delivery_channel":                  resourceAwsConfigDeliveryChannel(),
 			"aws_customer_gateway":                         resourceAwsCustomerGateway(),
+			"aws_dax_cluster":                              resourceAwsDaxCluster(),
+			"aws_dax_parameter_group":                      resourceAwsDaxParameterGroup(),
+			"aws_dax_subnet_group":                         resourceAwsDaxSubnetGroup(),
 			"aws_db_event_subscription":                    resourceAwsDbEventSubscription(),
 			"aws_db_instance":                              resourceAwsDbInstance(),
 			"aws_db_option_group":                          resourceAwsDbOptionGroup(),
 			"aws_db_parameter_group":                       resourceAwsDbParameterGroup(),
 			"aws_db_security_group":                        resourceAwsDbSecurityGroup(),
 			"aws_db_subnet_group":                          resourceAwsDbSubnetGroup(),
+			"aws_directory_service_conditional_forwarder":  resourceAwsDirectoryServiceConditionalForwarder(),
 			"aws_directory_service_directory":              resourceAwsDirectoryServiceDirectory(),
+			"aws_directory_service_trust":                  resourceAwsDirectoryServiceTrust(),
+			"aws_dms_endpoint":                             resourceAwsDmsEndpoint(),
+			"aws_dms_replication_instance":                 resourceAwsDmsReplicationInstance(),
+			"aws_dms_replication_subnet_group":             resourceAwsDmsReplicationSubnetGroup(),
+			"aws_dms_replication_task":                     resourceAwsDmsReplicationTask(),
+			"aws_dx_connection":                            resourceAwsDxConnection(),
+			"aws_dx_gateway":                               resourceAwsDxGateway(),
+			"aws_dx_private_virtual_interface":             resourceAwsDxPrivateVirtualInterface(),
 			"aws_dynamodb_table":                           resourceAwsDynamoDbTable(),
+			"aws_ebs_snapshot":                             resourceAwsEbsSnapshot(),
 			"aws_ebs_volume":                               resourceAwsEbsVolume(),
+			"aws_ecr_lifecycle_policy":                     resourceAwsEcrLifecyclePolicy(),
 			"aws_ecr_repository":                           resourceAwsEcrRepository(),
 			"aws_ecr_repository_policy":                    resourceAwsEcrRepositoryPolicy(),
 			"aws_ecs_cluster":                              resourceAwsEcsCluster(),
@@ -242,14 +289,21 @@ func Provider() terraform.ResourceProvider {
 			"aws_emr_cluster":                              resourceAwsEMRCluster(),
 			"aws_emr_instance_group":                       resourceAwsEMRInstanceGroup(),
 			"aws_flow_log":                                 resourceAwsFlowLog(),
+			"aws_gamelift_build":                           resourceAwsGameliftBuild(),
+			"aws_gamelift_fleet":                           resourceAwsGameliftFleet(),
 			"aws_glacier_vault":                            resourceAwsGlacierVault(),
+			"aws_guardduty_detector":                       resourceAwsGuardDutyDetector(),
+			"aws_guardduty_invite_accepter":                resourceAwsGuardDutyInviteAccepter(),
+			"aws_guardduty_member":                         resourceAwsGuardDutyMember(),
 			"aws_iam_access_key":                           resourceAwsIamAccessKey(),
+			"aws_iam_account_alias":                        resourceAwsIamAccountAlias(),
 			"aws_iam_account_password_policy":              resourceAwsIamAccountPasswordPolicy(),
 			"aws_iam_group_policy":                         resourceAwsIamGroupPolicy(),
 			"aws_iam_group":                                resourceAwsIamGroup(),
 			"aws_iam_group_membership":                     resourceAwsIamGroupMembership(),
 			"aws_iam_group_policy_attachment":              resourceAwsIamGroupPolicyAttachment(),
 			"aws_iam_instance_profile":                     resourceAwsIamInstanceProfile(),
+			"aws_iam_openid_connect_provider":              resourceAwsIamOpenIDConnectProvider(),
 			"aws_iam_policy":                               resourceAwsIamPolicy(),
 			"aws_iam_policy_attachment":                    resourceAwsIamPolicyAttachment(),
 			"aws_iam_role_policy_attachment":               resourceAwsIamRolePolicyAttachment(),
@@ -274,6 +328,11 @@ func Provider() terraform.ResourceProvider {
 			"aws_lambda_permission":                        resourceAwsLambdaPermission(),
 			"aws_launch_configuration":                     resourceAwsLaunchConfiguration(),
 			"aws_lb_cookie_stickiness_policy":              resourceAwsLBCookieStickinessPolicy(),
+			"aws_lightsail_domain":                         resourceAwsLightsailDomain(),
+			"aws_lightsail_instance":                       resourceAwsLightsailInstance(),
+			"aws_lightsail_key_pair":                       resourceAwsLightsailKeyPair(),
+			"aws_lightsail_static_ip":                      resourceAwsLightsailStaticIp(),
+			"aws_lightsail_static_ip_attachment":           resourceAwsLightsailStaticIpAttachment(),
 			"aws_load_balancer_policy":                     resourceAwsLoadBalancerPolicy(),
 			"aws_load_balancer_backend_server_policy":      resourceAwsLoadBalancerBackendServerPolicies(),
 			"aws_load_balancer_listener_policy":            resourceAwsLoadBalancerListenerPolicies(),
@@ -300,6 +359,10 @@ func Provider() terraform.ResourceProvider {
 			"aws_opsworks_instance":                        resourceAwsOpsworksInstance(),
 			"aws_opsworks_user_profile":                    resourceAwsOpsworksUserProfile(),
 			"aws_opsworks_permission":                      resourceAwsOpsworksPermission(),
+			"aws_organizations_account":                    resourceAwsOrganizationsAccount(),
+			"aws_organizations_organizational_unit":        resourceAwsOrganizationsOrganizationalUnit(),
+			"aws_organizations_policy":                     resourceAwsOrganizationsPolicy(),
+			"aws_organizations_policy_attachment":          resourceAwsOrganizationsPolicyAttachment(),
 			"aws_placement_group":                          resourceAwsPlacementGroup(),
 			"aws_proxy_protocol_policy":                    resourceAwsProxyProtocolPolicy(),
 			"aws_rds_cluster":                              resourceAwsRDSCluster(),
@@ -328,6 +391,11 @@ func Provider() terraform.ResourceProvider {
 			"aws_default_security_group":                   resourceAwsDefaultSecurityGroup(),
 			"aws_security_group":                           resourceAwsSecurityGroup(),
 			"aws_security_group_rule":                      resourceAwsSecurityGroupRule(),
+			"aws_service_discovery_instance":               resourceAwsServiceDiscoveryInstance(),
+			"aws_service_discovery_private_dns_namespace":  resourceAwsServiceDiscoveryPrivateDnsNamespace(),
+			"aws_service_discovery_service":                resourceAwsServiceDiscoveryService(),
+			"aws_sfn_activity":                             resourceAwsSfnActivity(),
+			"aws_sfn_state_machine":                        resourceAwsSfnStateMachine(),
 			"aws_simpledb_domain":                          resourceAwsSimpleDBDomain(),
 			"aws_ssm_association":                          resourceAwsSsmAssociation(),
 			"aws_ssm_document":                             resourceAwsSsmDocument(),
@@ -344,14 +412,22 @@ func Provider() terraform.ResourceProvider {
 			"aws_vpc_dhcp_options_association":             resourceAwsVpcDhcpOptionsAssociation(),
 			"aws_vpc_dhcp_options":                         resourceAwsVpcDhcpOptions(),
 			"aws_vpc_peering_connection":                   resourceAwsVpcPeeringConnection(),
+			"aws_vpc_peering_connection_accepter":          resourceAwsVpcPeeringConnectionAccepter(),
 			"aws_vpc":                                      resourceAwsVpc(),
 			"aws_vpc_endpoint":                             resourceAwsVpcEndpoint(),
 			"aws_vpn_connection":                           resourceAwsVpnConnection(),
 			"aws_vpn_connection_route":                     resourceAwsVpnConnectionRoute(),
 			"aws_vpn_gateway":                              resourceAwsVpnGateway(),
 			"aws_vpn_gateway_attachment":                   resourceAwsVpnGatewayAttachment(),
+			"aws_vpn_gateway_route_propagation":            resourceAwsVpnGatewayRoutePropagation(),
+			"aws_wafregional_ipset":                        resourceAwsWafRegionalIPSet(),
+			"aws_wafregional_rule":                         resourceAwsWafRegionalRule(),
+			"aws_wafregional_web_acl":                      resourceAwsWafRegionalWebAcl(),
+			"aws_wafregional_web_acl_association":          resourceAwsWafRegionalWebAclAssociation(),
 		},
-		ConfigureFunc: providerConfigure,
+		ConfigureFunc:    providerConfigure,
+		DiffValidateFunc: validateRequiredTags,
+		ReadMetaFunc:     providerReadMeta,
 	}
 }
 
@@ -395,6 +471,24 @@ func init() {
 
 		"s3_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
 
+		"cloudformation_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+
+		"cloudwatch_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+
+		"cloudwatchevents_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+
+		"cloudwatchlogs_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+
+		"kms_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+
+		"rds_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+
+		"sns_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+
+		"sqs_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+
+		"sts_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted," +
 			"default value is `false`",
 
@@ -412,6 +506,16 @@ func init() {
 			"use virtual hosted bucket addressing when possible\n" +
 			"(http://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
 
+		"tag_policy_required_keys": "Tag keys that every taggable resource must set in its\n" +
+			"`tags`. Plans for resources missing any of them fail.",
+
+		"propagation_iam_delay": "How long IAM resources wait after they're written\n" +
+			"before they return, so that other services can use them.",
+
+		"propagation_iam_timeout": "How long IAM resources wait for a write to be visible.",
+
+		"propagation_route53_timeout": "How long Route 53 resources wait for a change to be in sync.",
+
 		"assume_role_role_arn": "The ARN of an IAM role to assume prior to making API calls.",
 
 		"assume_role_session_name": "The session name to use when assuming the role. If omitted," +
@@ -419,6 +523,20 @@ func init() {
 
 		"assume_role_external_id": "The external ID to use when assuming the role. If omitted," +
 			" no external ID is passed to the AssumeRole call.",
+
+		"assume_role_policy": "The permissions applied when assuming a role. You cannot use" +
+			" this policy to grant further permissions that are in excess to those of the" +
+			" role that is being assumed.",
+
+		"read_only_profile": "The profile used for refresh and plan instead of the\n" +
+			"credentials used to apply changes.",
+
+		"read_only_role_arn": "The ARN of a role to assume for refresh and plan instead\n" +
+			"of the role used to apply changes.",
+
+		"read_only_session_name": "The session name to use when assuming the read-only role.",
+
+		"read_only_external_id": "The external ID to use when assuming the read-only role.",
 	}
 }
 
@@ -446,8 +564,9 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		config.AssumeRoleARN = assumeRole["role_arn"].(string)
 		config.AssumeRoleSessionName = assumeRole["session_name"].(string)
 		config.AssumeRoleExternalID = assumeRole["external_id"].(string)
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)",
-			config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID)
+		config.AssumeRolePolicy = assumeRole["policy"].(string)
+		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, Policy: %q)",
+			config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID, config.AssumeRolePolicy)
 	} else {
 		log.Printf("[INFO] No assume_role block read from configuration")
 	}
@@ -460,6 +579,24 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		config.Ec2Endpoint = endpoints["ec2"].(string)
 		config.ElbEndpoint = endpoints["elb"].(string)
 		config.S3Endpoint = endpoints["s3"].(string)
+		config.CloudFormationEndpoint = endpoints["cloudformation"].(string)
+		config.CloudWatchEndpoint = endpoints["cloudwatch"].(string)
+		config.CloudWatchEventsEndpoint = endpoints["cloudwatchevents"].(string)
+		config.CloudWatchLogsEndpoint = endpoints["cloudwatchlogs"].(string)
+		config.KmsEndpoint = endpoints["kms"].(string)
+		config.RdsEndpoint = endpoints["rds"].(string)
+		config.SnsEndpoint = endpoints["sns"].(string)
+		config.SqsEndpoint = endpoints["sqs"].(string)
+		config.StsEndpoint = endpoints["sts"].(string)
+
+		// The deprecated top-level dynamodb_endpoint and kinesis_endpoint
+		// arguments are only overridden when set in the endpoints block.
+		if v := endpoints["dynamodb"].(string); v != "" {
+			config.DynamoDBEndpoint = v
+		}
+		if v := endpoints["kinesis"].(string); v != "" {
+			config.KinesisEndpoint = v
+		}
 	}
 
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
@@ -470,7 +607,73 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		config.ForbiddenAccountIds = v.(*schema.Set).List()
 	}
 
-	return config.Client()
+	if v, ok := d.GetOk("tag_policy"); ok {
+		tagPolicy := v.([]interface{})[0].(map[string]interface{})
+		for _, k := range tagPolicy["required_keys"].([]interface{}) {
+			config.RequiredTagKeys = append(config.RequiredTagKeys, k.(string))
+		}
+	}
+
+	if v, ok := d.GetOk("propagation"); ok {
+		propagation := v.([]interface{})[0].(map[string]interface{})
+		// The durations were validated already
+		config.IamPropagationDelay, _ = time.ParseDuration(propagation["iam_delay"].(string))
+		config.IamPropagationTimeout, _ = time.ParseDuration(propagation["iam_timeout"].(string))
+		config.Route53SyncTimeout, _ = time.ParseDuration(propagation["route53_timeout"].(string))
+	}
+
+	client, err := config.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	if v, ok := d.GetOk("read_only"); ok {
+		readOnly := v.([]interface{})[0].(map[string]interface{})
+		readOnlyConfig := config
+
+		profile := readOnly["profile"].(string)
+		roleArn := readOnly["role_arn"].(string)
+		if profile == "" && roleArn == "" {
+			return nil, fmt.Errorf("read_only: one of profile or role_arn must be set")
+		}
+
+		if profile != "" {
+			// Static credentials would otherwise take precedence over the profile
+			readOnlyConfig.AccessKey = ""
+			readOnlyConfig.SecretKey = ""
+			readOnlyConfig.Token = ""
+			readOnlyConfig.Profile = profile
+		}
+
+		if roleArn != "" {
+			readOnlyConfig.AssumeRoleARN = roleArn
+			readOnlyConfig.AssumeRoleSessionName = readOnly["session_name"].(string)
+			readOnlyConfig.AssumeRoleExternalID = readOnly["external_id"].(string)
+			readOnlyConfig.AssumeRolePolicy = ""
+		}
+
+		log.Printf("[INFO] read_only configuration set: (Profile: %q, ARN: %q, SessionID: %q, ExternalID: %q)",
+			readOnlyConfig.Profile, readOnlyConfig.AssumeRoleARN,
+			readOnlyConfig.AssumeRoleSessionName, readOnlyConfig.AssumeRoleExternalID)
+
+		readOnlyClient, err := readOnlyConfig.Client()
+		if err != nil {
+			return nil, fmt.Errorf("Error configuring read_only credentials: %s", err)
+		}
+		client.(*AWSClient).readOnlyClient = readOnlyClient.(*AWSClient)
+	}
+
+	return client, nil
+}
+
+// providerReadMeta returns the client used for refresh and data source
+// reads. It is the read_only client when one is configured.
+func providerReadMeta(meta interface{}) interface{} {
+	if client, ok := meta.(*AWSClient); ok && client.readOnlyClient != nil {
+		return client.readOnlyClient
+	}
+
+	return meta
 }
 
 // This is a global MutexKV for use within this plugin.
@@ -500,6 +703,12 @@ func assumeRoleSchema() *schema.Schema {
 					Optional:    true,
 					Description: descriptions["assume_role_external_id"],
 				},
+
+				"policy": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: descriptions["assume_role_policy"],
+				},
 			},
 		},
 		Set: assumeRoleToHash,
@@ -512,9 +721,98 @@ func assumeRoleToHash(v interface{}) int {
 	buf.WriteString(fmt.Sprintf("%s-", m["role_arn"].(string)))
 	buf.WriteString(fmt.Sprintf("%s-", m["session_name"].(string)))
 	buf.WriteString(fmt.Sprintf("%s-", m["external_id"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["policy"].(string)))
 	return hashcode.String(buf.String())
 }
 
+func tagPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"required_keys": {
+					Type:        schema.TypeList,
+					Required:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: descriptions["tag_policy_required_keys"],
+				},
+			},
+		},
+	}
+}
+
+func propagationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"iam_delay": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      defaultIamPropagationDelay.String(),
+					ValidateFunc: validateDuration,
+					Description:  descriptions["propagation_iam_delay"],
+				},
+
+				"iam_timeout": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      defaultIamPropagationTimeout.String(),
+					ValidateFunc: validateDuration,
+					Description:  descriptions["propagation_iam_timeout"],
+				},
+
+				"route53_timeout": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      defaultRoute53SyncTimeout.String(),
+					ValidateFunc: validateDuration,
+					Description:  descriptions["propagation_route53_timeout"],
+				},
+			},
+		},
+	}
+}
+
+func readOnlySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"profile": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: descriptions["read_only_profile"],
+				},
+
+				"role_arn": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: descriptions["read_only_role_arn"],
+				},
+
+				"session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: descriptions["read_only_session_name"],
+				},
+
+				"external_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: descriptions["read_only_external_id"],
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeSet,
@@ -541,12 +839,90 @@ func endpointsSchema() *schema.Schema {
 					Default:     "",
 					Description: descriptions["elb_endpoint"],
 				},
+
 				"s3": {
 					Type:        schema.TypeString,
 					Optional:    true,
 					Default:     "",
 					Description: descriptions["s3_endpoint"],
 				},
+
+				"cloudformation": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["cloudformation_endpoint"],
+				},
+
+				"cloudwatch": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["cloudwatch_endpoint"],
+				},
+
+				"cloudwatchevents": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["cloudwatchevents_endpoint"],
+				},
+
+				"cloudwatchlogs": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["cloudwatchlogs_endpoint"],
+				},
+
+				"dynamodb": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["dynamodb_endpoint"],
+				},
+
+				"kinesis": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["kinesis_endpoint"],
+				},
+
+				"kms": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["kms_endpoint"],
+				},
+
+				"rds": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["rds_endpoint"],
+				},
+
+				"sns": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["sns_endpoint"],
+				},
+
+				"sqs": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["sqs_endpoint"],
+				},
+
+				"sts": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["sts_endpoint"],
+				},
 			},
 		},
 		Set: endpointsToHash,
@@ -560,6 +936,17 @@ func endpointsToHash(v interface{}) int {
 	buf.WriteString(fmt.Sprintf("%s-", m["ec2"].(string)))
 	buf.WriteString(fmt.Sprintf("%s-", m["elb"].(string)))
 	buf.WriteString(fmt.Sprintf("%s-", m["s3"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["cloudformation"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatch"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatchevents"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatchlogs"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["dynamodb"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["kinesis"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["kms"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["rds"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["sns"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["sqs"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["sts"].(string)))
 
 	return hashcode.String(buf.String())
 }
@@ -0,0 +1,249 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsAcmCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAcmCertificateCreate,
+		Read:   resourceAwsAcmCertificateRead,
+		Update: resourceAwsAcmCertificateUpdate,
+		Delete: resourceAwsAcmCertificateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"subject_alternative_names": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"validation_method": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAcmValidationMethod,
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"domain_validation_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_record_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_record_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_record_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"validation_emails": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsAcmCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmconn
+
+	input := &acm.RequestCertificateInput{
+		DomainName:       aws.String(d.Get("domain_name").(string)),
+		ValidationMethod: aws.String(d.Get("validation_method").(string)),
+	}
+
+	if v, ok := d.GetOk("subject_alternative_names"); ok && len(v.([]interface{})) > 0 {
+		input.SubjectAlternativeNames = expandStringList(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Requesting ACM certificate: %s", input)
+	out, err := conn.RequestCertificate(input)
+	if err != nil {
+		return fmt.Errorf("Error requesting ACM certificate: %s", err)
+	}
+
+	d.SetId(*out.CertificateArn)
+
+	if err := setTagsACM(conn, d); err != nil {
+		return err
+	}
+
+	return resourceAwsAcmCertificateRead(d, meta)
+}
+
+func resourceAwsAcmCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmconn
+
+	// The DNS validation records are filled in asynchronously after the
+	// certificate is requested, so wait a little for them to show up.
+	return resource.Retry(time.Minute, func() *resource.RetryError {
+		out, err := conn.DescribeCertificate(&acm.DescribeCertificateInput{
+			CertificateArn: aws.String(d.Id()),
+		})
+		if err != nil {
+			if isAWSErr(err, acm.ErrCodeResourceNotFoundException, "") {
+				log.Printf("[WARN] ACM certificate %s not found, removing from state", d.Id())
+				d.SetId("")
+				return nil
+			}
+			return resource.NonRetryableError(
+				fmt.Errorf("Error describing ACM certificate %s: %s", d.Id(), err))
+		}
+
+		cert := out.Certificate
+		options, emails, err := flattenAcmDomainValidationOptions(cert.DomainValidationOptions)
+		if err != nil {
+			return resource.RetryableError(err)
+		}
+
+		d.Set("arn", cert.CertificateArn)
+		d.Set("domain_name", cert.DomainName)
+		if method := acmCertificateValidationMethod(cert); method != "" {
+			d.Set("validation_method", method)
+		}
+
+		// The domain name is always reported as the first SAN, but is not
+		// part of the configured subject_alternative_names.
+		var sans []*string
+		for _, san := range cert.SubjectAlternativeNames {
+			if aws.StringValue(san) != aws.StringValue(cert.DomainName) {
+				sans = append(sans, san)
+			}
+		}
+		d.Set("subject_alternative_names", flattenStringList(sans))
+
+		if err := d.Set("domain_validation_options", options); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		d.Set("validation_emails", emails)
+
+		tags, err := conn.ListTagsForCertificate(&acm.ListTagsForCertificateInput{
+			CertificateArn: aws.String(d.Id()),
+		})
+		if err != nil {
+			return resource.NonRetryableError(
+				fmt.Errorf("Error listing tags for ACM certificate %s: %s", d.Id(), err))
+		}
+		d.Set("tags", tagsToMapACM(tags.Tags))
+
+		return nil
+	})
+}
+
+func resourceAwsAcmCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmconn
+
+	if err := setTagsACM(conn, d); err != nil {
+		return err
+	}
+	d.SetPartial("tags")
+
+	return resourceAwsAcmCertificateRead(d, meta)
+}
+
+func resourceAwsAcmCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmconn
+
+	// A certificate can't be deleted while it is still associated with a
+	// load balancer or CloudFront distribution, which may only just be
+	// being torn down.
+	err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteCertificate(&acm.DeleteCertificateInput{
+			CertificateArn: aws.String(d.Id()),
+		})
+		if err != nil {
+			if isAWSErr(err, acm.ErrCodeResourceInUseException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil && !isAWSErr(err, acm.ErrCodeResourceNotFoundException, "") {
+		return fmt.Errorf("Error deleting ACM certificate %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// acmCertificateValidationMethod returns the validation method of the
+// certificate, or an empty string for certificates imported into ACM.
+func acmCertificateValidationMethod(cert *acm.CertificateDetail) string {
+	for _, o := range cert.DomainValidationOptions {
+		if o.ValidationMethod != nil {
+			return *o.ValidationMethod
+		}
+	}
+	return ""
+}
+
+// flattenAcmDomainValidationOptions returns the DNS validation records and
+// the validation emails of a certificate. It returns an error if a domain is
+// validated through DNS but its record isn't available yet.
+func flattenAcmDomainValidationOptions(opts []*acm.DomainValidation) ([]map[string]interface{}, []string, error) {
+	var options []map[string]interface{}
+	var emails []string
+
+	for _, o := range opts {
+		switch aws.StringValue(o.ValidationMethod) {
+		case acm.ValidationMethodDns:
+			if o.ResourceRecord == nil {
+				return nil, nil, fmt.Errorf(
+					"DNS validation record for %s is not available yet", aws.StringValue(o.DomainName))
+			}
+			options = append(options, map[string]interface{}{
+				"domain_name":           aws.StringValue(o.DomainName),
+				"resource_record_name":  aws.StringValue(o.ResourceRecord.Name),
+				"resource_record_type":  aws.StringValue(o.ResourceRecord.Type),
+				"resource_record_value": aws.StringValue(o.ResourceRecord.Value),
+			})
+		case acm.ValidationMethodEmail:
+			for _, e := range o.ValidationEmails {
+				emails = append(emails, aws.StringValue(e))
+			}
+		}
+	}
+
+	return options, emails, nil
+}
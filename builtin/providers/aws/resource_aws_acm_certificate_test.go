@@ -0,0 +1,176 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccAwsAcmCertificateRootDomain(t *testing.T) string {
+	rootDomain := os.Getenv("ACM_CERTIFICATE_ROOT_DOMAIN")
+	if rootDomain == "" {
+		t.Skip(
+			"Environment variable ACM_CERTIFICATE_ROOT_DOMAIN is not set. " +
+				"This environment variable must be set to a domain with a public " +
+				"Route 53 zone in the test account to enable this test.")
+	}
+	return rootDomain
+}
+
+func TestAccAWSAcmCertificate_dns(t *testing.T) {
+	rootDomain := testAccAwsAcmCertificateRootDomain(t)
+	domain := fmt.Sprintf("tf-acc-%s.%s", acctest.RandString(8), rootDomain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAcmCertificateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAcmCertificateConfig(domain, "DNS"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAcmCertificateExists("aws_acm_certificate.cert"),
+					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_name", domain),
+					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "validation_method", "DNS"),
+					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "subject_alternative_names.#", "0"),
+					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.#", "1"),
+					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.0.domain_name", domain),
+					resource.TestCheckResourceAttrSet("aws_acm_certificate.cert", "domain_validation_options.0.resource_record_name"),
+					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.0.resource_record_type", "CNAME"),
+					resource.TestCheckResourceAttrSet("aws_acm_certificate.cert", "domain_validation_options.0.resource_record_value"),
+					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "tags.%", "1"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "aws_acm_certificate.cert",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAcmCertificate_email(t *testing.T) {
+	rootDomain := testAccAwsAcmCertificateRootDomain(t)
+	domain := fmt.Sprintf("tf-acc-%s.%s", acctest.RandString(8), rootDomain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAcmCertificateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAcmCertificateConfig(domain, "EMAIL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAcmCertificateExists("aws_acm_certificate.cert"),
+					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "validation_method", "EMAIL"),
+					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.#", "0"),
+					resource.TestMatchResourceAttr("aws_acm_certificate.cert", "validation_emails.#", regexp.MustCompile("^[1-9][0-9]*$")),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAcmCertificateExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ACM certificate ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).acmconn
+		_, err := conn.DescribeCertificate(&acm.DescribeCertificateInput{
+			CertificateArn: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
+func testAccCheckAcmCertificateDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).acmconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_acm_certificate" {
+			continue
+		}
+
+		_, err := conn.DescribeCertificate(&acm.DescribeCertificateInput{
+			CertificateArn: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("ACM certificate %s still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, acm.ErrCodeResourceNotFoundException, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func TestFlattenAcmDomainValidationOptions(t *testing.T) {
+	options, emails, err := flattenAcmDomainValidationOptions([]*acm.DomainValidation{
+		&acm.DomainValidation{
+			DomainName:       aws.String("example.com"),
+			ValidationMethod: aws.String("DNS"),
+			ResourceRecord: &acm.ResourceRecord{
+				Name:  aws.String("_abc.example.com."),
+				Type:  aws.String("CNAME"),
+				Value: aws.String("_def.acm-validations.aws."),
+			},
+		},
+		&acm.DomainValidation{
+			DomainName:       aws.String("www.example.com"),
+			ValidationMethod: aws.String("EMAIL"),
+			ValidationEmails: []*string{aws.String("admin@example.com")},
+		},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if len(options) != 1 {
+		t.Fatalf("bad: %#v", options)
+	}
+	if options[0]["resource_record_name"] != "_abc.example.com." {
+		t.Fatalf("bad: %#v", options[0])
+	}
+	if len(emails) != 1 || emails[0] != "admin@example.com" {
+		t.Fatalf("bad: %#v", emails)
+	}
+
+	_, _, err = flattenAcmDomainValidationOptions([]*acm.DomainValidation{
+		&acm.DomainValidation{
+			DomainName:       aws.String("example.com"),
+			ValidationMethod: aws.String("DNS"),
+		},
+	})
+	if err == nil {
+		t.Fatal("expected an error for a missing DNS record")
+	}
+}
+
+func testAccAcmCertificateConfig(domain, method string) string {
+	return fmt.Sprintf(`
+resource "aws_acm_certificate" "cert" {
+  domain_name       = "%s"
+  validation_method = "%s"
+
+  tags {
+    Name = "terraform-testacc-acm-certificate"
+  }
+}
+`, domain, method)
+}
@@ -0,0 +1,151 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsAcmCertificateValidation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAcmCertificateValidationCreate,
+		Read:   resourceAwsAcmCertificateValidationRead,
+		Delete: resourceAwsAcmCertificateValidationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"certificate_arn": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"validation_record_fqdns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceAwsAcmCertificateValidationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmconn
+	arn := d.Get("certificate_arn").(string)
+
+	out, err := conn.DescribeCertificate(&acm.DescribeCertificateInput{
+		CertificateArn: aws.String(arn),
+	})
+	if err != nil {
+		return fmt.Errorf("Error describing ACM certificate %s: %s", arn, err)
+	}
+
+	if v, ok := d.GetOk("validation_record_fqdns"); ok {
+		if err := acmCheckValidationRecords(v.(*schema.Set).List(), out.Certificate); err != nil {
+			return err
+		}
+	} else if acmCertificateValidationMethod(out.Certificate) == acm.ValidationMethodDns {
+		log.Printf("[INFO] No validation_record_fqdns set, skipping check of the DNS validation records of %s", arn)
+	}
+
+	log.Printf("[DEBUG] Waiting for ACM certificate %s to be issued", arn)
+	err = resource.Retry(45*time.Minute, func() *resource.RetryError {
+		out, err = conn.DescribeCertificate(&acm.DescribeCertificateInput{
+			CertificateArn: aws.String(arn),
+		})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		status := aws.StringValue(out.Certificate.Status)
+		switch status {
+		case acm.CertificateStatusIssued:
+			return nil
+		case acm.CertificateStatusPendingValidation:
+			return resource.RetryableError(
+				fmt.Errorf("Certificate %s is still pending validation", arn))
+		default:
+			return resource.NonRetryableError(
+				fmt.Errorf("Certificate %s can't be issued, its status is %s", arn, status))
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("Error waiting for ACM certificate %s to be issued: %s", arn, err)
+	}
+
+	d.SetId(aws.TimeValue(out.Certificate.IssuedAt).UTC().String())
+
+	return resourceAwsAcmCertificateValidationRead(d, meta)
+}
+
+func resourceAwsAcmCertificateValidationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmconn
+	arn := d.Get("certificate_arn").(string)
+
+	out, err := conn.DescribeCertificate(&acm.DescribeCertificateInput{
+		CertificateArn: aws.String(arn),
+	})
+	if err != nil {
+		if isAWSErr(err, acm.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] ACM certificate %s not found, removing validation from state", arn)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error describing ACM certificate %s: %s", arn, err)
+	}
+
+	if status := aws.StringValue(out.Certificate.Status); status != acm.CertificateStatusIssued {
+		log.Printf("[WARN] ACM certificate %s is %s, removing validation from state", arn, status)
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceAwsAcmCertificateValidationDelete(d *schema.ResourceData, meta interface{}) error {
+	// Validation can't be undone, removing it from the state is enough.
+	d.SetId("")
+	return nil
+}
+
+// acmCheckValidationRecords verifies that the given FQDNs cover every DNS
+// validation record of the certificate, so that a typo in the configuration
+// fails fast instead of waiting for the validation to time out.
+func acmCheckValidationRecords(fqdns []interface{}, cert *acm.CertificateDetail) error {
+	if acmCertificateValidationMethod(cert) != acm.ValidationMethodDns {
+		return fmt.Errorf(
+			"validation_record_fqdns is only valid for DNS validation of certificate %s",
+			aws.StringValue(cert.CertificateArn))
+	}
+
+	given := make(map[string]bool)
+	for _, v := range fqdns {
+		given[strings.TrimSuffix(v.(string), ".")] = true
+	}
+
+	var missing []string
+	for _, o := range cert.DomainValidationOptions {
+		if o.ResourceRecord == nil {
+			continue
+		}
+		name := strings.TrimSuffix(aws.StringValue(o.ResourceRecord.Name), ".")
+		if !given[name] {
+			missing = append(missing, name)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf(
+			"Missing validation records for certificate %s: %s",
+			aws.StringValue(cert.CertificateArn), strings.Join(missing, ", "))
+	}
+
+	return nil
+}
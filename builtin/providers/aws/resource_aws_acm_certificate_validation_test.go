@@ -0,0 +1,96 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSAcmCertificateValidation_basic(t *testing.T) {
+	rootDomain := testAccAwsAcmCertificateRootDomain(t)
+	domain := fmt.Sprintf("tf-acc-%s.%s", acctest.RandString(8), rootDomain)
+
+	zoneId := os.Getenv("ACM_CERTIFICATE_ROOT_DOMAIN_ZONE_ID")
+	if zoneId == "" {
+		t.Skip(
+			"Environment variable ACM_CERTIFICATE_ROOT_DOMAIN_ZONE_ID is not set. " +
+				"This environment variable must be set to the ID of the public " +
+				"Route 53 zone of ACM_CERTIFICATE_ROOT_DOMAIN to enable this test.")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAcmCertificateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAcmCertificateValidationConfig(zoneId, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAcmCertificateExists("aws_acm_certificate.cert"),
+					resource.TestCheckResourceAttrSet("aws_acm_certificate_validation.cert", "certificate_arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcmCheckValidationRecords(t *testing.T) {
+	cert := &acm.CertificateDetail{
+		CertificateArn: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/abc"),
+		DomainValidationOptions: []*acm.DomainValidation{
+			&acm.DomainValidation{
+				DomainName:       aws.String("example.com"),
+				ValidationMethod: aws.String("DNS"),
+				ResourceRecord: &acm.ResourceRecord{
+					Name:  aws.String("_abc.example.com."),
+					Type:  aws.String("CNAME"),
+					Value: aws.String("_def.acm-validations.aws."),
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		FQDNs []interface{}
+		Err   bool
+	}{
+		{[]interface{}{"_abc.example.com"}, false},
+		{[]interface{}{"_abc.example.com."}, false},
+		{[]interface{}{"_xyz.example.com"}, true},
+		{[]interface{}{}, true},
+	}
+
+	for i, tc := range cases {
+		err := acmCheckValidationRecords(tc.FQDNs, cert)
+		if (err != nil) != tc.Err {
+			t.Fatalf("%d: err: %v", i, err)
+		}
+	}
+}
+
+func testAccAcmCertificateValidationConfig(zoneId, domain string) string {
+	return fmt.Sprintf(`
+resource "aws_acm_certificate" "cert" {
+  domain_name       = "%s"
+  validation_method = "DNS"
+}
+
+resource "aws_route53_record" "cert_validation" {
+  name    = "${aws_acm_certificate.cert.domain_validation_options.0.resource_record_name}"
+  type    = "${aws_acm_certificate.cert.domain_validation_options.0.resource_record_type}"
+  zone_id = "%s"
+  records = ["${aws_acm_certificate.cert.domain_validation_options.0.resource_record_value}"]
+  ttl     = 60
+}
+
+resource "aws_acm_certificate_validation" "cert" {
+  certificate_arn         = "${aws_acm_certificate.cert.arn}"
+  validation_record_fqdns = ["${aws_route53_record.cert_validation.fqdn}"]
+}
+`, domain, zoneId)
+}
@@ -66,6 +66,7 @@ func resourceAwsAlbListenerRule() *schema.Resource {
 						},
 						"values": {
 							Type:     schema.TypeList,
+							MaxItems: 1,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 							Optional: true,
 						},
@@ -240,8 +241,13 @@ func validateAwsAlbListenerRulePriority(v interface{}, k string) (ws []string, e
 
 func validateAwsListenerRuleField(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
-	if len(value) > 64 {
-		errors = append(errors, fmt.Errorf("%q must be a maximum of 64 characters", k))
+	validFields := map[string]bool{
+		"host-header":  true,
+		"path-pattern": true,
+	}
+	if !validFields[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of host-header or path-pattern: %q", k, value))
 	}
 	return
 }
@@ -43,6 +43,46 @@ func TestAccAWSALBListenerRule_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSALBListenerRule_hostHeader(t *testing.T) {
+	var conf elbv2.Rule
+	albName := fmt.Sprintf("testrule-host-%s", acctest.RandStringFromCharSet(14, acctest.CharSetAlphaNum))
+	targetGroupName := fmt.Sprintf("testtargetgroup-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_alb_listener_rule.static",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckAWSALBListenerRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSALBListenerRuleConfig_condition(albName, targetGroupName, "host-header", "example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSALBListenerRuleExists("aws_alb_listener_rule.static", &conf),
+					resource.TestCheckResourceAttr("aws_alb_listener_rule.static", "condition.#", "1"),
+					resource.TestCheckResourceAttr("aws_alb_listener_rule.static", "condition.0.field", "host-header"),
+					resource.TestCheckResourceAttr("aws_alb_listener_rule.static", "condition.0.values.0", "example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateAwsListenerRuleField(t *testing.T) {
+	for _, v := range []string{"host-header", "path-pattern"} {
+		_, errors := validateAwsListenerRuleField(v, "field")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid listener rule field: %q", v, errors)
+		}
+	}
+
+	for _, v := range []string{"", "path", "Host-Header", "source-ip"} {
+		_, errors := validateAwsListenerRuleField(v, "field")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid listener rule field", v)
+		}
+	}
+}
+
 func testAccCheckAWSALBListenerRuleExists(n string, res *elbv2.Rule) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -105,6 +145,10 @@ func testAccCheckAWSALBListenerRuleDestroy(s *terraform.State) error {
 }
 
 func testAccAWSALBListenerRuleConfig_basic(albName, targetGroupName string) string {
+	return testAccAWSALBListenerRuleConfig_condition(albName, targetGroupName, "path-pattern", "/static/*")
+}
+
+func testAccAWSALBListenerRuleConfig_condition(albName, targetGroupName, field, value string) string {
 	return fmt.Sprintf(`resource "aws_alb_listener_rule" "static" {
   listener_arn = "${aws_alb_listener.front_end.arn}"
   priority = 100
@@ -115,8 +159,8 @@ func testAccAWSALBListenerRuleConfig_basic(albName, targetGroupName string) stri
   }
 
   condition {
-    field = "path-pattern"
-    values = ["/static/*"]
+    field = "%s"
+    values = ["%s"]
   }
 }
 
@@ -212,5 +256,5 @@ resource "aws_security_group" "alb_test" {
   tags {
     TestName = "TestAccAWSALB_basic"
   }
-}`, albName, targetGroupName)
+}`, field, value, albName, targetGroupName)
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -252,7 +253,18 @@ func resourceAwsAmiDelete(d *schema.ResourceData, meta interface{}) error {
 			snapshotId := ebsBlockDev["snapshot_id"].(string)
 			if snapshotId != "" {
 				req.SnapshotId = aws.String(snapshotId)
-				_, err := client.DeleteSnapshot(req)
+				// The snapshot stays in use for a short while after the
+				// AMI is deregistered.
+				err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+					_, err := client.DeleteSnapshot(req)
+					if err != nil {
+						if isAWSErr(err, "InvalidSnapshot.InUse", "") {
+							return resource.RetryableError(err)
+						}
+						return resource.NonRetryableError(err)
+					}
+					return nil
+				})
 				if err != nil {
 					errs[snapshotId] = err
 				}
@@ -274,14 +286,24 @@ func resourceAwsAmiDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// amiAvailableTimeout is how long to wait for a new AMI to become available.
+// Copying a large AMI to another region, especially when it's re-encrypted,
+// can take a long time.
+const amiAvailableTimeout = 40 * time.Minute
+
 func resourceAwsAmiWaitForAvailable(id string, client *ec2.EC2) (*ec2.Image, error) {
 	log.Printf("Waiting for AMI %s to become available...", id)
 
 	req := &ec2.DescribeImagesInput{
 		ImageIds: []*string{aws.String(id)},
 	}
+	deadline := time.Now().Add(amiAvailableTimeout)
 	pollsWhereNotFound := 0
 	for {
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timeout after %s waiting for AMI %s to become available", amiAvailableTimeout, id)
+		}
+
 		res, err := client.DescribeImages(req)
 		if err != nil {
 			// When using RegisterImage (for aws_ami) the AMI sometimes isn't available at all
@@ -18,27 +18,41 @@ func resourceAwsApiGatewayDomainName() *schema.Resource {
 		Read:   resourceAwsApiGatewayDomainNameRead,
 		Update: resourceAwsApiGatewayDomainNameUpdate,
 		Delete: resourceAwsApiGatewayDomainNameDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 
+			//According to AWS Documentation, ACM will be the only way to add certificates
+			//to ApiGateway DomainNames. When this happens, we will be deprecating all certificate methods
+			//except certificate_arn. We are not quite sure when this will happen.
 			"certificate_body": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				ForceNew:      true,
+				Optional:      true,
+				ConflictsWith: []string{"certificate_arn", "regional_certificate_arn"},
 			},
 
 			"certificate_chain": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				ForceNew:      true,
+				Optional:      true,
+				ConflictsWith: []string{"certificate_arn", "regional_certificate_arn"},
 			},
 
 			"certificate_name": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"certificate_arn", "regional_certificate_arn", "regional_certificate_name"},
 			},
 
 			"certificate_private_key": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				ForceNew:      true,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"certificate_arn", "regional_certificate_arn"},
 			},
 
 			"domain_name": &schema.Schema{
@@ -47,15 +61,71 @@ func resourceAwsApiGatewayDomainName() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"certificate_arn": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"certificate_body", "certificate_chain", "certificate_name", "certificate_private_key", "regional_certificate_arn", "regional_certificate_name"},
+			},
+
+			"endpoint_configuration": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"types": &schema.Schema{
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							// BadRequestException: Cannot create an api with multiple Endpoint Types
+							MaxItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateApiGatewayEndpointType,
+							},
+						},
+					},
+				},
+			},
+
+			"regional_certificate_arn": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"certificate_arn", "certificate_body", "certificate_chain", "certificate_name", "certificate_private_key"},
+			},
+
+			"regional_certificate_name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"certificate_arn", "certificate_name", "certificate_body", "certificate_chain", "certificate_private_key"},
+			},
+
 			"cloudfront_domain_name": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
+			"certificate_upload_date": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"cloudfront_zone_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"regional_domain_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"regional_zone_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -64,20 +134,48 @@ func resourceAwsApiGatewayDomainNameCreate(d *schema.ResourceData, meta interfac
 	conn := meta.(*AWSClient).apigateway
 	log.Printf("[DEBUG] Creating API Gateway Domain Name")
 
-	domainName, err := conn.CreateDomainName(&apigateway.CreateDomainNameInput{
-		CertificateBody:       aws.String(d.Get("certificate_body").(string)),
-		CertificateChain:      aws.String(d.Get("certificate_chain").(string)),
-		CertificateName:       aws.String(d.Get("certificate_name").(string)),
-		CertificatePrivateKey: aws.String(d.Get("certificate_private_key").(string)),
-		DomainName:            aws.String(d.Get("domain_name").(string)),
-	})
+	params := &apigateway.CreateDomainNameInput{
+		DomainName: aws.String(d.Get("domain_name").(string)),
+	}
+
+	if v, ok := d.GetOk("certificate_arn"); ok {
+		params.CertificateArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("certificate_name"); ok {
+		params.CertificateName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("certificate_body"); ok {
+		params.CertificateBody = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("certificate_chain"); ok {
+		params.CertificateChain = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("certificate_private_key"); ok {
+		params.CertificatePrivateKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("endpoint_configuration"); ok {
+		params.EndpointConfiguration = expandApiGatewayEndpointConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("regional_certificate_arn"); ok {
+		params.RegionalCertificateArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("regional_certificate_name"); ok {
+		params.RegionalCertificateName = aws.String(v.(string))
+	}
+
+	domainName, err := conn.CreateDomainName(params)
 	if err != nil {
 		return fmt.Errorf("Error creating API Gateway Domain Name: %s", err)
 	}
 
 	d.SetId(*domainName.DomainName)
-	d.Set("cloudfront_domain_name", domainName.DistributionDomainName)
-	d.Set("cloudfront_zone_id", cloudFrontRoute53ZoneID)
 
 	return resourceAwsApiGatewayDomainNameRead(d, meta)
 }
@@ -99,49 +197,76 @@ func resourceAwsApiGatewayDomainNameRead(d *schema.ResourceData, meta interface{
 		return err
 	}
 
+	d.Set("certificate_arn", domainName.CertificateArn)
 	d.Set("certificate_name", domainName.CertificateName)
-	d.Set("certificate_upload_date", domainName.CertificateUploadDate)
+	if domainName.CertificateUploadDate != nil {
+		d.Set("certificate_upload_date", domainName.CertificateUploadDate.Format(time.RFC3339))
+	}
 	d.Set("cloudfront_domain_name", domainName.DistributionDomainName)
+	d.Set("cloudfront_zone_id", cloudFrontRoute53ZoneID)
 	d.Set("domain_name", domainName.DomainName)
 
+	if err := d.Set("endpoint_configuration", flattenApiGatewayEndpointConfiguration(domainName.EndpointConfiguration)); err != nil {
+		return fmt.Errorf("error setting endpoint_configuration: %s", err)
+	}
+
+	d.Set("regional_certificate_arn", domainName.RegionalCertificateArn)
+	d.Set("regional_certificate_name", domainName.RegionalCertificateName)
+	d.Set("regional_domain_name", domainName.RegionalDomainName)
+	d.Set("regional_zone_id", domainName.RegionalHostedZoneId)
+
 	return nil
 }
 
 func resourceAwsApiGatewayDomainNameUpdateOperations(d *schema.ResourceData) []*apigateway.PatchOperation {
 	operations := make([]*apigateway.PatchOperation, 0)
 
-	if d.HasChange("certificate_body") {
+	if d.HasChange("certificate_name") {
 		operations = append(operations, &apigateway.PatchOperation{
 			Op:    aws.String("replace"),
-			Path:  aws.String("/certificate_body"),
-			Value: aws.String(d.Get("certificate_body").(string)),
+			Path:  aws.String("/certificateName"),
+			Value: aws.String(d.Get("certificate_name").(string)),
 		})
 	}
 
-	if d.HasChange("certificate_chain") {
+	if d.HasChange("certificate_arn") {
 		operations = append(operations, &apigateway.PatchOperation{
 			Op:    aws.String("replace"),
-			Path:  aws.String("/certificate_chain"),
-			Value: aws.String(d.Get("certificate_chain").(string)),
+			Path:  aws.String("/certificateArn"),
+			Value: aws.String(d.Get("certificate_arn").(string)),
 		})
 	}
 
-	if d.HasChange("certificate_name") {
+	if d.HasChange("regional_certificate_name") {
 		operations = append(operations, &apigateway.PatchOperation{
 			Op:    aws.String("replace"),
-			Path:  aws.String("/certificate_name"),
-			Value: aws.String(d.Get("certificate_name").(string)),
+			Path:  aws.String("/regionalCertificateName"),
+			Value: aws.String(d.Get("regional_certificate_name").(string)),
 		})
 	}
 
-	if d.HasChange("certificate_private_key") {
+	if d.HasChange("regional_certificate_arn") {
 		operations = append(operations, &apigateway.PatchOperation{
 			Op:    aws.String("replace"),
-			Path:  aws.String("/certificate_private_key"),
-			Value: aws.String(d.Get("certificate_private_key").(string)),
+			Path:  aws.String("/regionalCertificateArn"),
+			Value: aws.String(d.Get("regional_certificate_arn").(string)),
 		})
 	}
 
+	if d.HasChange("endpoint_configuration.0.types") {
+		// The domain name must have an endpoint type.
+		// If attempting to remove the configuration, do nothing.
+		if v, ok := d.GetOk("endpoint_configuration"); ok && len(v.([]interface{})) > 0 {
+			m := v.([]interface{})[0].(map[string]interface{})
+
+			operations = append(operations, &apigateway.PatchOperation{
+				Op:    aws.String("replace"),
+				Path:  aws.String("/endpointConfiguration/types/0"),
+				Value: aws.String(m["types"].([]interface{})[0].(string)),
+			})
+		}
+	}
+
 	return operations
 }
 
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -47,6 +48,96 @@ func TestAccAWSAPIGatewayDomainName_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSAPIGatewayDomainName_CertificateArn(t *testing.T) {
+	certificateArn := os.Getenv("AWS_API_GATEWAY_DOMAIN_NAME_CERTIFICATE_ARN")
+	if certificateArn == "" {
+		t.Skip(
+			"Environment variable AWS_API_GATEWAY_DOMAIN_NAME_CERTIFICATE_ARN is not set. " +
+				"This environment variable must be set to the ARN of " +
+				"an ISSUED ACM certificate in us-east-1 to enable this test.")
+	}
+
+	// This test must always run in us-east-1
+	// BadRequestException: Invalid certificate ARN: arn:aws:acm:us-west-2:123456789012:certificate/xxxxx. Certificate must be in 'us-east-1'.
+	oldvar := os.Getenv("AWS_DEFAULT_REGION")
+	os.Setenv("AWS_DEFAULT_REGION", "us-east-1")
+	defer os.Setenv("AWS_DEFAULT_REGION", oldvar)
+
+	domainName := os.Getenv("AWS_API_GATEWAY_DOMAIN_NAME_DOMAIN_NAME")
+	if domainName == "" {
+		t.Skip(
+			"Environment variable AWS_API_GATEWAY_DOMAIN_NAME_DOMAIN_NAME is not set. " +
+				"This environment variable must be set to a domain name covered by " +
+				"the certificate to enable this test.")
+	}
+
+	var conf apigateway.DomainName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayDomainNameDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSAPIGatewayDomainNameConfig_CertificateArn(domainName, certificateArn),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayDomainNameExists("aws_api_gateway_domain_name.test", &conf),
+					resource.TestCheckResourceAttr("aws_api_gateway_domain_name.test", "certificate_arn", certificateArn),
+					resource.TestCheckResourceAttrSet("aws_api_gateway_domain_name.test", "cloudfront_domain_name"),
+					resource.TestCheckResourceAttr("aws_api_gateway_domain_name.test", "cloudfront_zone_id", "Z2FDTNDATAQYW2"),
+					resource.TestCheckResourceAttr("aws_api_gateway_domain_name.test", "domain_name", domainName),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "aws_api_gateway_domain_name.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAPIGatewayDomainName_RegionalCertificateArn(t *testing.T) {
+	certificateArn := os.Getenv("AWS_API_GATEWAY_DOMAIN_NAME_REGIONAL_CERTIFICATE_ARN")
+	if certificateArn == "" {
+		t.Skip(
+			"Environment variable AWS_API_GATEWAY_DOMAIN_NAME_REGIONAL_CERTIFICATE_ARN is not set. " +
+				"This environment variable must be set to the ARN of " +
+				"an ISSUED ACM certificate in the region of the test to enable this test.")
+	}
+
+	domainName := os.Getenv("AWS_API_GATEWAY_DOMAIN_NAME_DOMAIN_NAME")
+	if domainName == "" {
+		t.Skip(
+			"Environment variable AWS_API_GATEWAY_DOMAIN_NAME_DOMAIN_NAME is not set. " +
+				"This environment variable must be set to a domain name covered by " +
+				"the certificate to enable this test.")
+	}
+
+	var conf apigateway.DomainName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayDomainNameDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSAPIGatewayDomainNameConfig_RegionalCertificateArn(domainName, certificateArn),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayDomainNameExists("aws_api_gateway_domain_name.test", &conf),
+					resource.TestCheckResourceAttr("aws_api_gateway_domain_name.test", "domain_name", domainName),
+					resource.TestCheckResourceAttr("aws_api_gateway_domain_name.test", "endpoint_configuration.#", "1"),
+					resource.TestCheckResourceAttr("aws_api_gateway_domain_name.test", "endpoint_configuration.0.types.#", "1"),
+					resource.TestCheckResourceAttr("aws_api_gateway_domain_name.test", "endpoint_configuration.0.types.0", "REGIONAL"),
+					resource.TestCheckResourceAttr("aws_api_gateway_domain_name.test", "regional_certificate_arn", certificateArn),
+					resource.TestCheckResourceAttrSet("aws_api_gateway_domain_name.test", "regional_domain_name"),
+					resource.TestCheckResourceAttrSet("aws_api_gateway_domain_name.test", "regional_zone_id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSAPIGatewayDomainNameExists(n string, res *apigateway.DomainName) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -209,3 +300,25 @@ resource "aws_api_gateway_domain_name" "test" {
 }
 `, name, testAccAWSAPIGatewayCertBody, testAccAWSAPIGatewayCertChain, testAccAWSAPIGatewayCertPrivateKey)
 }
+
+func testAccAWSAPIGatewayDomainNameConfig_CertificateArn(domainName, certificateArn string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_domain_name" "test" {
+  domain_name     = "%s"
+  certificate_arn = "%s"
+}
+`, domainName, certificateArn)
+}
+
+func testAccAWSAPIGatewayDomainNameConfig_RegionalCertificateArn(domainName, certificateArn string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_domain_name" "test" {
+  domain_name              = "%s"
+  regional_certificate_arn = "%s"
+
+  endpoint_configuration {
+    types = ["REGIONAL"]
+  }
+}
+`, domainName, certificateArn)
+}
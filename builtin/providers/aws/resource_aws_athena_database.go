@@ -0,0 +1,178 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsAthenaDatabase() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAthenaDatabaseCreate,
+		Read:   resourceAwsAthenaDatabaseRead,
+		Update: resourceAwsAthenaDatabaseUpdate,
+		Delete: resourceAwsAthenaDatabaseDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAthenaDatabaseName,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAwsAthenaDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).athenaconn
+
+	query := fmt.Sprintf("CREATE DATABASE `%s`;", d.Get("name").(string))
+	qeid, err := athenaStartQuery(conn, query, d.Get("bucket").(string))
+	if err != nil {
+		return fmt.Errorf("Error creating Athena database: %s", err)
+	}
+
+	if err := athenaWaitForQuery(conn, qeid); err != nil {
+		return fmt.Errorf("Error creating Athena database: %s", err)
+	}
+
+	d.SetId(d.Get("name").(string))
+	return resourceAwsAthenaDatabaseRead(d, meta)
+}
+
+func resourceAwsAthenaDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).athenaconn
+
+	qeid, err := athenaStartQuery(conn, "SHOW DATABASES;", d.Get("bucket").(string))
+	if err != nil {
+		return fmt.Errorf("Error reading Athena database %s: %s", d.Id(), err)
+	}
+
+	if err := athenaWaitForQuery(conn, qeid); err != nil {
+		return fmt.Errorf("Error reading Athena database %s: %s", d.Id(), err)
+	}
+
+	found := false
+	err = conn.GetQueryResultsPages(&athena.GetQueryResultsInput{
+		QueryExecutionId: aws.String(qeid),
+	}, func(page *athena.GetQueryResultsOutput, lastPage bool) bool {
+		if page.ResultSet == nil {
+			return !lastPage
+		}
+		for _, row := range page.ResultSet.Rows {
+			if len(row.Data) > 0 && aws.StringValue(row.Data[0].VarCharValue) == d.Id() {
+				found = true
+				return false
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading Athena database %s: %s", d.Id(), err)
+	}
+
+	if !found {
+		log.Printf("[WARN] Athena database %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", d.Id())
+	return nil
+}
+
+func resourceAwsAthenaDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
+	// force_destroy is only consulted on delete, so there is nothing to
+	// send to Athena here.
+	return resourceAwsAthenaDatabaseRead(d, meta)
+}
+
+func resourceAwsAthenaDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).athenaconn
+
+	query := fmt.Sprintf("DROP DATABASE `%s`", d.Id())
+	if d.Get("force_destroy").(bool) {
+		query += " CASCADE"
+	}
+	query += ";"
+
+	qeid, err := athenaStartQuery(conn, query, d.Get("bucket").(string))
+	if err != nil {
+		return fmt.Errorf("Error deleting Athena database %s: %s", d.Id(), err)
+	}
+
+	if err := athenaWaitForQuery(conn, qeid); err != nil {
+		return fmt.Errorf("Error deleting Athena database %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// athenaStartQuery starts the given query, writing its results to the
+// named S3 bucket, and returns the query execution ID.
+func athenaStartQuery(conn *athena.Athena, query, bucket string) (string, error) {
+	input := &athena.StartQueryExecutionInput{
+		QueryString: aws.String(query),
+		ResultConfiguration: &athena.ResultConfiguration{
+			OutputLocation: aws.String("s3://" + bucket),
+		},
+	}
+
+	log.Printf("[DEBUG] Starting Athena query: %s", input)
+	out, err := conn.StartQueryExecution(input)
+	if err != nil {
+		return "", err
+	}
+	return aws.StringValue(out.QueryExecutionId), nil
+}
+
+func athenaWaitForQuery(conn *athena.Athena, qeid string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{athena.QueryExecutionStateQueued, athena.QueryExecutionStateRunning},
+		Target:     []string{athena.QueryExecutionStateSucceeded},
+		Refresh:    athenaQueryStateRefreshFunc(conn, qeid),
+		Timeout:    10 * time.Minute,
+		Delay:      2 * time.Second,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func athenaQueryStateRefreshFunc(conn *athena.Athena, qeid string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := conn.GetQueryExecution(&athena.GetQueryExecutionInput{
+			QueryExecutionId: aws.String(qeid),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := out.QueryExecution.Status
+		state := aws.StringValue(status.State)
+		switch state {
+		case athena.QueryExecutionStateFailed, athena.QueryExecutionStateCancelled:
+			return out, state, fmt.Errorf("query %s %s: %s",
+				qeid, state, aws.StringValue(status.StateChangeReason))
+		}
+		return out, state, nil
+	}
+}
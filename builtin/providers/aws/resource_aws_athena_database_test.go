@@ -0,0 +1,106 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAthenaDatabase_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	dbName := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAthenaDatabaseDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAthenaDatabaseConfig(rInt, dbName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaDatabaseExists("aws_athena_database.foo"),
+					resource.TestCheckResourceAttr("aws_athena_database.foo", "name", dbName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAthenaDatabaseDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_athena_database" {
+			continue
+		}
+
+		found, err := testAccAthenaDatabaseFound(rs)
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("Athena database %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckAWSAthenaDatabaseExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		found, err := testAccAthenaDatabaseFound(rs)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("Athena database %s not found", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccAthenaDatabaseFound(rs *terraform.ResourceState) (bool, error) {
+	conn := testAccProvider.Meta().(*AWSClient).athenaconn
+
+	qeid, err := athenaStartQuery(conn, "SHOW DATABASES;", rs.Primary.Attributes["bucket"])
+	if err != nil {
+		return false, err
+	}
+	if err := athenaWaitForQuery(conn, qeid); err != nil {
+		return false, err
+	}
+
+	out, err := conn.GetQueryResults(&athena.GetQueryResultsInput{
+		QueryExecutionId: aws.String(qeid),
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, row := range out.ResultSet.Rows {
+		if len(row.Data) > 0 && aws.StringValue(row.Data[0].VarCharValue) == rs.Primary.ID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func testAccAthenaDatabaseConfig(rInt int, dbName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "results" {
+  bucket        = "tf-athena-db-%d"
+  force_destroy = true
+}
+
+resource "aws_athena_database" "foo" {
+  name          = "%s"
+  bucket        = "${aws_s3_bucket.results.bucket}"
+  force_destroy = true
+}
+`, rInt, dbName)
+}
@@ -0,0 +1,103 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsAthenaNamedQuery() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAthenaNamedQueryCreate,
+		Read:   resourceAwsAthenaNamedQueryRead,
+		Delete: resourceAwsAthenaNamedQueryDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"query": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"database": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsAthenaNamedQueryCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).athenaconn
+
+	input := &athena.CreateNamedQueryInput{
+		Database:    aws.String(d.Get("database").(string)),
+		Name:        aws.String(d.Get("name").(string)),
+		QueryString: aws.String(d.Get("query").(string)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Athena named query: %s", input)
+	out, err := conn.CreateNamedQuery(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Athena named query: %s", err)
+	}
+
+	d.SetId(aws.StringValue(out.NamedQueryId))
+	return resourceAwsAthenaNamedQueryRead(d, meta)
+}
+
+func resourceAwsAthenaNamedQueryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).athenaconn
+
+	out, err := conn.GetNamedQuery(&athena.GetNamedQueryInput{
+		NamedQueryId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, athena.ErrCodeInvalidRequestException, "") {
+			log.Printf("[WARN] Athena named query %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Athena named query %s: %s", d.Id(), err)
+	}
+
+	d.Set("name", out.NamedQuery.Name)
+	d.Set("query", out.NamedQuery.QueryString)
+	d.Set("database", out.NamedQuery.Database)
+	d.Set("description", out.NamedQuery.Description)
+	return nil
+}
+
+func resourceAwsAthenaNamedQueryDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).athenaconn
+
+	_, err := conn.DeleteNamedQuery(&athena.DeleteNamedQueryInput{
+		NamedQueryId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting Athena named query %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,109 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAthenaNamedQuery_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	dbName := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAthenaNamedQueryDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAthenaNamedQueryConfig(rInt, dbName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaNamedQueryExists("aws_athena_named_query.foo"),
+					resource.TestCheckResourceAttr("aws_athena_named_query.foo", "database", dbName),
+					resource.TestCheckResourceAttr("aws_athena_named_query.foo", "description", "tf test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAthenaNamedQuery_import(t *testing.T) {
+	rInt := acctest.RandInt()
+	dbName := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAthenaNamedQueryDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAthenaNamedQueryConfig(rInt, dbName),
+			},
+			resource.TestStep{
+				ResourceName:      "aws_athena_named_query.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAthenaNamedQueryDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).athenaconn
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_athena_named_query" {
+			continue
+		}
+
+		_, err := conn.GetNamedQuery(&athena.GetNamedQueryInput{
+			NamedQueryId: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("Athena named query %s still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, athena.ErrCodeInvalidRequestException, "") {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccCheckAWSAthenaNamedQueryExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).athenaconn
+		_, err := conn.GetNamedQuery(&athena.GetNamedQueryInput{
+			NamedQueryId: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
+func testAccAthenaNamedQueryConfig(rInt int, dbName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "results" {
+  bucket        = "tf-athena-query-%d"
+  force_destroy = true
+}
+
+resource "aws_athena_database" "test" {
+  name          = "%s"
+  bucket        = "${aws_s3_bucket.results.bucket}"
+  force_destroy = true
+}
+
+resource "aws_athena_named_query" "foo" {
+  name        = "tf-athena-query-%d"
+  database    = "${aws_athena_database.test.name}"
+  query       = "SELECT * FROM ${aws_athena_database.test.name} limit 10;"
+  description = "tf test"
+}
+`, rInt, dbName, rInt)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsAutoscalingLifecycleHook() *schema.Resource {
@@ -19,6 +20,9 @@ func resourceAwsAutoscalingLifecycleHook() *schema.Resource {
 		Read:   resourceAwsAutoscalingLifecycleHookRead,
 		Update: resourceAwsAutoscalingLifecycleHookPut,
 		Delete: resourceAwsAutoscalingLifecycleHookDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAutoscalingLifecycleHookImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -34,14 +38,23 @@ func resourceAwsAutoscalingLifecycleHook() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"CONTINUE",
+					"ABANDON",
+				}, false),
 			},
 			"heartbeat_timeout": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(30, 7200),
 			},
 			"lifecycle_transition": {
 				Type:     schema.TypeString,
 				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"autoscaling:EC2_INSTANCE_LAUNCHING",
+					"autoscaling:EC2_INSTANCE_TERMINATING",
+				}, false),
 			},
 			"notification_metadata": {
 				Type:     schema.TypeString,
@@ -134,6 +147,19 @@ func resourceAwsAutoscalingLifecycleHookDelete(d *schema.ResourceData, meta inte
 	return nil
 }
 
+func resourceAwsAutoscalingLifecycleHookImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Wrong format of resource: %s. Please follow 'autoscaling-group-name/lifecycle-hook-name'", d.Id())
+	}
+
+	d.Set("autoscaling_group_name", parts[0])
+	d.Set("name", parts[1])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func getAwsAutoscalingPutLifecycleHookInput(d *schema.ResourceData) autoscaling.PutLifecycleHookInput {
 	var params = autoscaling.PutLifecycleHookInput{
 		AutoScalingGroupName: aws.String(d.Get("autoscaling_group_name").(string)),
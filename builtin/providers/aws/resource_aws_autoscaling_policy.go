@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsAutoscalingPolicy() *schema.Resource {
@@ -31,7 +32,7 @@ func resourceAwsAutoscalingPolicy() *schema.Resource {
 			},
 			"adjustment_type": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 			"autoscaling_group_name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -42,6 +43,11 @@ func resourceAwsAutoscalingPolicy() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 				Default:  "SimpleScaling", // preserve AWS's default to make validation easier.
+				ValidateFunc: validation.StringInSlice([]string{
+					"SimpleScaling",
+					"StepScaling",
+					"TargetTrackingScaling",
+				}, false),
 			},
 			"cooldown": &schema.Schema{
 				Type:     schema.TypeInt,
@@ -92,6 +98,97 @@ func resourceAwsAutoscalingPolicy() *schema.Resource {
 				},
 				Set: resourceAwsAutoscalingScalingAdjustmentHash,
 			},
+			"target_tracking_configuration": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"predefined_metric_specification": &schema.Schema{
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"target_tracking_configuration.0.customized_metric_specification"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"predefined_metric_type": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"ASGAverageCPUUtilization",
+											"ASGAverageNetworkIn",
+											"ASGAverageNetworkOut",
+											"ALBRequestCountPerTarget",
+										}, false),
+									},
+									"resource_label": &schema.Schema{
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"customized_metric_specification": &schema.Schema{
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"target_tracking_configuration.0.predefined_metric_specification"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric_dimension": &schema.Schema{
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": &schema.Schema{
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"value": &schema.Schema{
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"metric_name": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"namespace": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"statistic": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"Average",
+											"Minimum",
+											"Maximum",
+											"SampleCount",
+											"Sum",
+										}, false),
+									},
+									"unit": &schema.Schema{
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"target_value": &schema.Schema{
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+						"disable_scale_in": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -145,6 +242,9 @@ func resourceAwsAutoscalingPolicyRead(d *schema.ResourceData, meta interface{})
 	d.Set("name", p.PolicyName)
 	d.Set("scaling_adjustment", p.ScalingAdjustment)
 	d.Set("step_adjustment", flattenStepAdjustments(p.StepAdjustments))
+	if err := d.Set("target_tracking_configuration", flattenTargetTrackingConfiguration(p.TargetTrackingConfiguration)); err != nil {
+		return fmt.Errorf("Error setting target_tracking_configuration: %s", err)
+	}
 
 	return nil
 }
@@ -230,6 +330,10 @@ func getAwsAutoscalingPutScalingPolicyInput(d *schema.ResourceData) (autoscaling
 		params.StepAdjustments = steps
 	}
 
+	if v, ok := d.GetOk("target_tracking_configuration"); ok {
+		params.TargetTrackingConfiguration = expandTargetTrackingConfiguration(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("min_adjustment_magnitude"); ok {
 		// params.MinAdjustmentMagnitude = aws.Int64(int64(d.Get("min_adjustment_magnitude").(int)))
 		params.MinAdjustmentMagnitude = aws.Int64(int64(v.(int)))
@@ -239,7 +343,15 @@ func getAwsAutoscalingPutScalingPolicyInput(d *schema.ResourceData) (autoscaling
 	}
 
 	// Validate our final input to confirm it won't error when sent to AWS.
-	// First, SimpleScaling policy types...
+	// First, settings shared by SimpleScaling and StepScaling policy types...
+	if *params.PolicyType != "TargetTrackingScaling" && params.AdjustmentType == nil {
+		return params, fmt.Errorf("%s policy types must use adjustment_type!", *params.PolicyType)
+	}
+	if *params.PolicyType != "TargetTrackingScaling" && params.TargetTrackingConfiguration != nil {
+		return params, fmt.Errorf("%s policy types cannot use target_tracking_configuration!", *params.PolicyType)
+	}
+
+	// Second, SimpleScaling policy types...
 	if *params.PolicyType == "SimpleScaling" && params.StepAdjustments != nil {
 		return params, fmt.Errorf("SimpleScaling policy types cannot use step_adjustments!")
 	}
@@ -250,7 +362,7 @@ func getAwsAutoscalingPutScalingPolicyInput(d *schema.ResourceData) (autoscaling
 		return params, fmt.Errorf("SimpleScaling policy types cannot use estimated_instance_warmup!")
 	}
 
-	// Second, StepScaling policy types...
+	// Third, StepScaling policy types...
 	if *params.PolicyType == "StepScaling" && params.ScalingAdjustment != nil {
 		return params, fmt.Errorf("StepScaling policy types cannot use scaling_adjustment!")
 	}
@@ -258,6 +370,32 @@ func getAwsAutoscalingPutScalingPolicyInput(d *schema.ResourceData) (autoscaling
 		return params, fmt.Errorf("StepScaling policy types cannot use cooldown!")
 	}
 
+	// Last, TargetTrackingScaling policy types, which only take a target
+	// tracking configuration and estimated_instance_warmup.
+	if *params.PolicyType == "TargetTrackingScaling" {
+		if params.TargetTrackingConfiguration == nil {
+			return params, fmt.Errorf("TargetTrackingScaling policy types must use target_tracking_configuration!")
+		}
+		if params.AdjustmentType != nil {
+			return params, fmt.Errorf("TargetTrackingScaling policy types cannot use adjustment_type!")
+		}
+		if params.ScalingAdjustment != nil {
+			return params, fmt.Errorf("TargetTrackingScaling policy types cannot use scaling_adjustment!")
+		}
+		if params.StepAdjustments != nil {
+			return params, fmt.Errorf("TargetTrackingScaling policy types cannot use step_adjustments!")
+		}
+		if params.Cooldown != nil {
+			return params, fmt.Errorf("TargetTrackingScaling policy types cannot use cooldown!")
+		}
+		if params.MetricAggregationType != nil {
+			return params, fmt.Errorf("TargetTrackingScaling policy types cannot use metric_aggregation_type!")
+		}
+		if params.MinAdjustmentMagnitude != nil || params.MinAdjustmentStep != nil {
+			return params, fmt.Errorf("TargetTrackingScaling policy types cannot use min_adjustment_magnitude!")
+		}
+	}
+
 	return params, nil
 }
 
@@ -140,6 +140,34 @@ func TestAccAWSAutoscalingPolicy_upgrade(t *testing.T) {
 	})
 }
 
+func TestAccAWSAutoscalingPolicy_TargetTrack(t *testing.T) {
+	var policy autoscaling.ScalingPolicy
+
+	name := fmt.Sprintf("terraform-test-foobar-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoscalingPolicyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAwsAutoscalingPolicyConfig_TargetTracking(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalingPolicyExists("aws_autoscaling_policy.predefined", &policy),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.predefined", "policy_type", "TargetTrackingScaling"),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.predefined", "target_tracking_configuration.0.predefined_metric_specification.0.predefined_metric_type", "ASGAverageCPUUtilization"),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.predefined", "target_tracking_configuration.0.target_value", "40"),
+					testAccCheckScalingPolicyExists("aws_autoscaling_policy.custom", &policy),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.custom", "policy_type", "TargetTrackingScaling"),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.custom", "target_tracking_configuration.0.customized_metric_specification.0.metric_dimension.0.name", "fuga"),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.custom", "target_tracking_configuration.0.customized_metric_specification.0.statistic", "Average"),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.custom", "target_tracking_configuration.0.disable_scale_in", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckScalingPolicyExists(n string, policy *autoscaling.ScalingPolicy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -316,3 +344,53 @@ resource "aws_autoscaling_policy" "foobar_simple" {
 }
 `, name, name, name)
 }
+
+func testAccAwsAutoscalingPolicyConfig_TargetTracking(name string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_configuration" "foobar" {
+	name = "%s"
+	image_id = "ami-21f78e11"
+	instance_type = "t1.micro"
+}
+
+resource "aws_autoscaling_group" "foobar" {
+	availability_zones = ["us-west-2a"]
+	name = "%s"
+	max_size = 5
+	min_size = 1
+	force_delete = true
+	launch_configuration = "${aws_launch_configuration.foobar.name}"
+}
+
+resource "aws_autoscaling_policy" "predefined" {
+	name = "%s-predefined"
+	policy_type = "TargetTrackingScaling"
+	autoscaling_group_name = "${aws_autoscaling_group.foobar.name}"
+	target_tracking_configuration {
+		predefined_metric_specification {
+			predefined_metric_type = "ASGAverageCPUUtilization"
+		}
+		target_value = 40.0
+	}
+}
+
+resource "aws_autoscaling_policy" "custom" {
+	name = "%s-custom"
+	policy_type = "TargetTrackingScaling"
+	autoscaling_group_name = "${aws_autoscaling_group.foobar.name}"
+	target_tracking_configuration {
+		customized_metric_specification {
+			metric_dimension {
+				name = "fuga"
+				value = "fuga"
+			}
+			metric_name = "hoge"
+			namespace = "hoge"
+			statistic = "Average"
+		}
+		target_value = 40.0
+		disable_scale_in = true
+	}
+}
+`, name, name, name, name)
+}
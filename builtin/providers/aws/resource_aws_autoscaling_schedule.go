@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,6 +19,9 @@ func resourceAwsAutoscalingSchedule() *schema.Resource {
 		Read:   resourceAwsAutoscalingScheduleRead,
 		Update: resourceAwsAutoscalingScheduleCreate,
 		Delete: resourceAwsAutoscalingScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAutoscalingScheduleImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"arn": &schema.Schema{
@@ -117,7 +121,13 @@ func resourceAwsAutoscalingScheduleRead(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		return err
 	}
+	if sa == nil {
+		log.Printf("[WARN] Autoscaling Scheduled Action (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 
+	d.Set("scheduled_action_name", sa.ScheduledActionName)
 	d.Set("autoscaling_group_name", sa.AutoScalingGroupName)
 	d.Set("arn", sa.ScheduledActionARN)
 	d.Set("desired_capacity", sa.DesiredCapacity)
@@ -153,6 +163,20 @@ func resourceAwsAutoscalingScheduleDelete(d *schema.ResourceData, meta interface
 	return nil
 }
 
+func resourceAwsAutoscalingScheduleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Wrong format of resource: %s. Please follow 'autoscaling-group-name/scheduled-action-name'", d.Id())
+	}
+
+	d.Set("autoscaling_group_name", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceAwsASGScheduledActionRetrieve returns the scheduled action, or
+// nil if it doesn't exist.
 func resourceAwsASGScheduledActionRetrieve(d *schema.ResourceData, meta interface{}) (*autoscaling.ScheduledUpdateGroupAction, error) {
 	autoscalingconn := meta.(*AWSClient).autoscalingconn
 
@@ -167,10 +191,11 @@ func resourceAwsASGScheduledActionRetrieve(d *schema.ResourceData, meta interfac
 		return nil, fmt.Errorf("Error retrieving Autoscaling Scheduled Actions: %s", err)
 	}
 
-	if len(actions.ScheduledUpdateGroupActions) != 1 ||
-		*actions.ScheduledUpdateGroupActions[0].ScheduledActionName != d.Id() {
-		return nil, fmt.Errorf("Unable to find Autoscaling Scheduled Action: %#v", actions.ScheduledUpdateGroupActions)
+	for _, sa := range actions.ScheduledUpdateGroupActions {
+		if aws.StringValue(sa.ScheduledActionName) == d.Id() {
+			return sa, nil
+		}
 	}
 
-	return actions.ScheduledUpdateGroupActions[0], nil
+	return nil, nil
 }
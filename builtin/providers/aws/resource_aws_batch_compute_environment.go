@@ -0,0 +1,407 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsBatchComputeEnvironment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsBatchComputeEnvironmentCreate,
+		Read:   resourceAwsBatchComputeEnvironmentRead,
+		Update: resourceAwsBatchComputeEnvironmentUpdate,
+		Delete: resourceAwsBatchComputeEnvironmentDelete,
+
+		Schema: map[string]*schema.Schema{
+			"compute_environment_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateBatchName,
+			},
+			"compute_resources": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bid_percentage": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"desired_vcpus": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"ec2_key_pair": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"image_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"instance_role": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"instance_type": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"max_vcpus": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"min_vcpus": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"spot_iam_fleet_role": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"subnets": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateBatchComputeResourceType,
+						},
+					},
+				},
+			},
+			"service_role": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      batch.CEStateEnabled,
+				ValidateFunc: validateBatchState,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateBatchComputeEnvironmentType,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ecs_cluster_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsBatchComputeEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).batchconn
+
+	name := d.Get("compute_environment_name").(string)
+	ceType := d.Get("type").(string)
+
+	input := &batch.CreateComputeEnvironmentInput{
+		ComputeEnvironmentName: aws.String(name),
+		ServiceRole:            aws.String(d.Get("service_role").(string)),
+		State:                  aws.String(d.Get("state").(string)),
+		Type:                   aws.String(ceType),
+	}
+
+	if ceType == batch.CETypeManaged {
+		l := d.Get("compute_resources").([]interface{})
+		if len(l) == 0 {
+			return fmt.Errorf("One compute_resources block is required when type is %q", batch.CETypeManaged)
+		}
+		input.ComputeResources = expandBatchComputeResource(l[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Batch compute environment: %s", input)
+	if _, err := conn.CreateComputeEnvironment(input); err != nil {
+		return fmt.Errorf("Error creating Batch compute environment %s: %s", name, err)
+	}
+
+	d.SetId(name)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{batch.CEStatusCreating},
+		Target:     []string{batch.CEStatusValid},
+		Refresh:    batchComputeEnvironmentStatusRefreshFunc(conn, name),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Batch compute environment %s to become valid: %s", name, err)
+	}
+
+	return resourceAwsBatchComputeEnvironmentRead(d, meta)
+}
+
+func resourceAwsBatchComputeEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).batchconn
+
+	ce, err := describeBatchComputeEnvironment(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading Batch compute environment %s: %s", d.Id(), err)
+	}
+	if ce == nil || aws.StringValue(ce.Status) == batch.CEStatusDeleted {
+		log.Printf("[WARN] Batch compute environment %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("compute_environment_name", ce.ComputeEnvironmentName)
+	d.Set("service_role", ce.ServiceRole)
+	d.Set("state", ce.State)
+	d.Set("type", ce.Type)
+	d.Set("arn", ce.ComputeEnvironmentArn)
+	d.Set("ecs_cluster_arn", ce.EcsClusterArn)
+	d.Set("status", ce.Status)
+	d.Set("status_reason", ce.StatusReason)
+
+	if ce.ComputeResources != nil {
+		if err := d.Set("compute_resources", flattenBatchComputeResource(ce.ComputeResources)); err != nil {
+			return fmt.Errorf("Error setting compute_resources: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsBatchComputeEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).batchconn
+
+	input := &batch.UpdateComputeEnvironmentInput{
+		ComputeEnvironment: aws.String(d.Id()),
+	}
+	update := false
+
+	if d.HasChange("service_role") {
+		input.ServiceRole = aws.String(d.Get("service_role").(string))
+		update = true
+	}
+
+	if d.HasChange("state") {
+		input.State = aws.String(d.Get("state").(string))
+		update = true
+	}
+
+	if d.HasChange("compute_resources.0.desired_vcpus") ||
+		d.HasChange("compute_resources.0.max_vcpus") ||
+		d.HasChange("compute_resources.0.min_vcpus") {
+		input.ComputeResources = &batch.ComputeResourceUpdate{
+			MaxvCpus: aws.Int64(int64(d.Get("compute_resources.0.max_vcpus").(int))),
+			MinvCpus: aws.Int64(int64(d.Get("compute_resources.0.min_vcpus").(int))),
+		}
+		if v, ok := d.GetOk("compute_resources.0.desired_vcpus"); ok {
+			input.ComputeResources.DesiredvCpus = aws.Int64(int64(v.(int)))
+		}
+		update = true
+	}
+
+	if update {
+		log.Printf("[DEBUG] Updating Batch compute environment: %s", input)
+		if _, err := conn.UpdateComputeEnvironment(input); err != nil {
+			return fmt.Errorf("Error updating Batch compute environment %s: %s", d.Id(), err)
+		}
+
+		if err := waitForBatchComputeEnvironmentUpdate(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsBatchComputeEnvironmentRead(d, meta)
+}
+
+func resourceAwsBatchComputeEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).batchconn
+
+	// A compute environment must be disabled before it can be deleted.
+	if d.Get("state").(string) != batch.CEStateDisabled {
+		log.Printf("[DEBUG] Disabling Batch compute environment %s", d.Id())
+		_, err := conn.UpdateComputeEnvironment(&batch.UpdateComputeEnvironmentInput{
+			ComputeEnvironment: aws.String(d.Id()),
+			State:              aws.String(batch.CEStateDisabled),
+		})
+		if err != nil {
+			return fmt.Errorf("Error disabling Batch compute environment %s: %s", d.Id(), err)
+		}
+
+		if err := waitForBatchComputeEnvironmentUpdate(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Batch compute environment %s", d.Id())
+	_, err := conn.DeleteComputeEnvironment(&batch.DeleteComputeEnvironmentInput{
+		ComputeEnvironment: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting Batch compute environment %s: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{batch.CEStatusDeleting},
+		Target:     []string{batch.CEStatusDeleted},
+		Refresh:    batchComputeEnvironmentStatusRefreshFunc(conn, d.Id()),
+		Timeout:    20 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Batch compute environment %s to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForBatchComputeEnvironmentUpdate(conn *batch.Batch, name string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{batch.CEStatusUpdating},
+		Target:     []string{batch.CEStatusValid},
+		Refresh:    batchComputeEnvironmentStatusRefreshFunc(conn, name),
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Batch compute environment %s to finish updating: %s", name, err)
+	}
+	return nil
+}
+
+func describeBatchComputeEnvironment(conn *batch.Batch, name string) (*batch.ComputeEnvironmentDetail, error) {
+	out, err := conn.DescribeComputeEnvironments(&batch.DescribeComputeEnvironmentsInput{
+		ComputeEnvironments: []*string{aws.String(name)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ce := range out.ComputeEnvironments {
+		if aws.StringValue(ce.ComputeEnvironmentName) == name {
+			return ce, nil
+		}
+	}
+	return nil, nil
+}
+
+func batchComputeEnvironmentStatusRefreshFunc(conn *batch.Batch, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		ce, err := describeBatchComputeEnvironment(conn, name)
+		if err != nil {
+			return nil, "", err
+		}
+
+		// Deleted compute environments stop showing up after a while, so
+		// treat a missing one the same as a deleted one.
+		if ce == nil {
+			return &batch.ComputeEnvironmentDetail{}, batch.CEStatusDeleted, nil
+		}
+
+		status := aws.StringValue(ce.Status)
+		if status == batch.CEStatusInvalid {
+			return ce, status, fmt.Errorf("compute environment is invalid: %s", aws.StringValue(ce.StatusReason))
+		}
+		return ce, status, nil
+	}
+}
+
+func expandBatchComputeResource(m map[string]interface{}) *batch.ComputeResource {
+	cr := &batch.ComputeResource{
+		InstanceRole:     aws.String(m["instance_role"].(string)),
+		InstanceTypes:    expandStringList(m["instance_type"].(*schema.Set).List()),
+		MaxvCpus:         aws.Int64(int64(m["max_vcpus"].(int))),
+		MinvCpus:         aws.Int64(int64(m["min_vcpus"].(int))),
+		SecurityGroupIds: expandStringList(m["security_group_ids"].(*schema.Set).List()),
+		Subnets:          expandStringList(m["subnets"].(*schema.Set).List()),
+		Type:             aws.String(m["type"].(string)),
+	}
+
+	if v, ok := m["bid_percentage"].(int); ok && v > 0 {
+		cr.BidPercentage = aws.Int64(int64(v))
+	}
+	if v, ok := m["desired_vcpus"].(int); ok && v > 0 {
+		cr.DesiredvCpus = aws.Int64(int64(v))
+	}
+	if v, ok := m["ec2_key_pair"].(string); ok && v != "" {
+		cr.Ec2KeyPair = aws.String(v)
+	}
+	if v, ok := m["image_id"].(string); ok && v != "" {
+		cr.ImageId = aws.String(v)
+	}
+	if v, ok := m["spot_iam_fleet_role"].(string); ok && v != "" {
+		cr.SpotIamFleetRole = aws.String(v)
+	}
+	if v, ok := m["tags"].(map[string]interface{}); ok && len(v) > 0 {
+		cr.Tags = stringMapToPointers(v)
+	}
+
+	return cr
+}
+
+func flattenBatchComputeResource(cr *batch.ComputeResource) []interface{} {
+	m := map[string]interface{}{
+		"bid_percentage":      int(aws.Int64Value(cr.BidPercentage)),
+		"desired_vcpus":       int(aws.Int64Value(cr.DesiredvCpus)),
+		"ec2_key_pair":        aws.StringValue(cr.Ec2KeyPair),
+		"image_id":            aws.StringValue(cr.ImageId),
+		"instance_role":       aws.StringValue(cr.InstanceRole),
+		"instance_type":       schema.NewSet(schema.HashString, flattenStringList(cr.InstanceTypes)),
+		"max_vcpus":           int(aws.Int64Value(cr.MaxvCpus)),
+		"min_vcpus":           int(aws.Int64Value(cr.MinvCpus)),
+		"security_group_ids":  schema.NewSet(schema.HashString, flattenStringList(cr.SecurityGroupIds)),
+		"spot_iam_fleet_role": aws.StringValue(cr.SpotIamFleetRole),
+		"subnets":             schema.NewSet(schema.HashString, flattenStringList(cr.Subnets)),
+		"tags":                pointersMapToStringList(cr.Tags),
+		"type":                aws.StringValue(cr.Type),
+	}
+	return []interface{}{m}
+}
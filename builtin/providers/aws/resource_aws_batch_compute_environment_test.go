@@ -0,0 +1,208 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSBatchComputeEnvironment_managed(t *testing.T) {
+	var ce batch.ComputeEnvironmentDetail
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBatchComputeEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBatchComputeEnvironmentConfig(rInt, 0, 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBatchComputeEnvironmentExists("aws_batch_compute_environment.foo", &ce),
+					resource.TestCheckResourceAttr("aws_batch_compute_environment.foo", "type", "MANAGED"),
+					resource.TestCheckResourceAttr("aws_batch_compute_environment.foo", "state", "ENABLED"),
+					resource.TestCheckResourceAttr("aws_batch_compute_environment.foo", "status", "VALID"),
+					resource.TestCheckResourceAttr("aws_batch_compute_environment.foo", "compute_resources.0.max_vcpus", "4"),
+					resource.TestCheckResourceAttrSet("aws_batch_compute_environment.foo", "ecs_cluster_arn"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccBatchComputeEnvironmentConfig(rInt, 0, 8),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBatchComputeEnvironmentExists("aws_batch_compute_environment.foo", &ce),
+					resource.TestCheckResourceAttr("aws_batch_compute_environment.foo", "compute_resources.0.max_vcpus", "8"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSBatchComputeEnvironment_unmanaged(t *testing.T) {
+	var ce batch.ComputeEnvironmentDetail
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBatchComputeEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBatchComputeEnvironmentConfigUnmanaged(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBatchComputeEnvironmentExists("aws_batch_compute_environment.foo", &ce),
+					resource.TestCheckResourceAttr("aws_batch_compute_environment.foo", "type", "UNMANAGED"),
+					resource.TestCheckResourceAttr("aws_batch_compute_environment.foo", "compute_resources.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBatchComputeEnvironmentExists(n string, ce *batch.ComputeEnvironmentDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).batchconn
+		out, err := describeBatchComputeEnvironment(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if out == nil {
+			return fmt.Errorf("Batch compute environment %s not found", rs.Primary.ID)
+		}
+
+		*ce = *out
+		return nil
+	}
+}
+
+func testAccCheckBatchComputeEnvironmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).batchconn
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_batch_compute_environment" {
+			continue
+		}
+
+		out, err := describeBatchComputeEnvironment(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if out != nil && aws.StringValue(out.Status) != batch.CEStatusDeleted {
+			return fmt.Errorf("Batch compute environment %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+// The service role's policy attachment has to outlive the compute
+// environment, otherwise Batch can't clean up the ECS cluster and the
+// environment gets stuck in INVALID; hence the explicit depends_on.
+func testAccBatchComputeEnvironmentConfigBase(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "ecs_instance_role" {
+  name = "tf_acc_batch_ecs_instance_%d"
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "ec2.amazonaws.com"
+      }
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy_attachment" "ecs_instance_role" {
+  role       = "${aws_iam_role.ecs_instance_role.name}"
+  policy_arn = "arn:aws:iam::aws:policy/service-role/AmazonEC2ContainerServiceforEC2Role"
+}
+
+resource "aws_iam_instance_profile" "ecs_instance_role" {
+  name  = "tf_acc_batch_ecs_instance_%d"
+  roles = ["${aws_iam_role.ecs_instance_role.name}"]
+}
+
+resource "aws_iam_role" "batch_service" {
+  name = "tf_acc_batch_service_%d"
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "batch.amazonaws.com"
+      }
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy_attachment" "batch_service" {
+  role       = "${aws_iam_role.batch_service.name}"
+  policy_arn = "arn:aws:iam::aws:policy/service-role/AWSBatchServiceRole"
+}
+
+resource "aws_vpc" "foo" {
+  cidr_block = "10.1.0.0/16"
+}
+
+resource "aws_subnet" "foo" {
+  vpc_id     = "${aws_vpc.foo.id}"
+  cidr_block = "10.1.1.0/24"
+}
+
+resource "aws_security_group" "foo" {
+  name   = "tf_acc_batch_%d"
+  vpc_id = "${aws_vpc.foo.id}"
+}
+`, rInt, rInt, rInt, rInt)
+}
+
+func testAccBatchComputeEnvironmentConfig(rInt, minVcpus, maxVcpus int) string {
+	return testAccBatchComputeEnvironmentConfigBase(rInt) + fmt.Sprintf(`
+resource "aws_batch_compute_environment" "foo" {
+  compute_environment_name = "tf_acc_batch_%d"
+
+  compute_resources {
+    instance_role      = "${aws_iam_instance_profile.ecs_instance_role.arn}"
+    instance_type      = ["c4.large"]
+    min_vcpus          = %d
+    max_vcpus          = %d
+    security_group_ids = ["${aws_security_group.foo.id}"]
+    subnets            = ["${aws_subnet.foo.id}"]
+    type               = "EC2"
+  }
+
+  service_role = "${aws_iam_role.batch_service.arn}"
+  type         = "MANAGED"
+  depends_on   = ["aws_iam_role_policy_attachment.batch_service"]
+}
+`, rInt, minVcpus, maxVcpus)
+}
+
+func testAccBatchComputeEnvironmentConfigUnmanaged(rInt int) string {
+	return testAccBatchComputeEnvironmentConfigBase(rInt) + fmt.Sprintf(`
+resource "aws_batch_compute_environment" "foo" {
+  compute_environment_name = "tf_acc_batch_%d"
+  service_role             = "${aws_iam_role.batch_service.arn}"
+  type                     = "UNMANAGED"
+  depends_on               = ["aws_iam_role_policy_attachment.batch_service"]
+}
+`, rInt)
+}
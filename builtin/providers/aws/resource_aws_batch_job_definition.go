@@ -0,0 +1,189 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsBatchJobDefinition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsBatchJobDefinitionCreate,
+		Read:   resourceAwsBatchJobDefinitionRead,
+		Delete: resourceAwsBatchJobDefinitionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateBatchName,
+			},
+			"container_properties": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateBatchContainerProperties,
+				DiffSuppressFunc: suppressEquivalentBatchContainerPropertiesDiffs,
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+			"retry_strategy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateIntegerInRange(1, 10),
+						},
+					},
+				},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateBatchJobDefinitionType,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"revision": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsBatchJobDefinitionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).batchconn
+
+	name := d.Get("name").(string)
+	input := &batch.RegisterJobDefinitionInput{
+		JobDefinitionName: aws.String(name),
+		Type:              aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("container_properties"); ok {
+		props, err := expandBatchContainerProperties(v.(string))
+		if err != nil {
+			return err
+		}
+		input.ContainerProperties = props
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		input.Parameters = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("retry_strategy"); ok {
+		input.RetryStrategy = expandBatchRetryStrategy(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Registering Batch job definition: %s", input)
+	out, err := conn.RegisterJobDefinition(input)
+	if err != nil {
+		return fmt.Errorf("Error registering Batch job definition %s: %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(out.JobDefinitionArn))
+	return resourceAwsBatchJobDefinitionRead(d, meta)
+}
+
+func resourceAwsBatchJobDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).batchconn
+
+	out, err := conn.DescribeJobDefinitions(&batch.DescribeJobDefinitionsInput{
+		JobDefinitions: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading Batch job definition %s: %s", d.Id(), err)
+	}
+
+	// Deregistered revisions are still returned, but marked INACTIVE.
+	var jd *batch.JobDefinition
+	for _, v := range out.JobDefinitions {
+		if aws.StringValue(v.JobDefinitionArn) == d.Id() && aws.StringValue(v.Status) != "INACTIVE" {
+			jd = v
+			break
+		}
+	}
+	if jd == nil {
+		log.Printf("[WARN] Batch job definition %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", jd.JobDefinitionArn)
+	d.Set("name", jd.JobDefinitionName)
+	d.Set("revision", jd.Revision)
+	d.Set("type", jd.Type)
+	d.Set("parameters", pointersMapToStringList(jd.Parameters))
+
+	if jd.ContainerProperties != nil {
+		props, err := flattenBatchContainerProperties(jd.ContainerProperties)
+		if err != nil {
+			return err
+		}
+		d.Set("container_properties", props)
+	}
+
+	if err := d.Set("retry_strategy", flattenBatchRetryStrategy(jd.RetryStrategy)); err != nil {
+		return fmt.Errorf("Error setting retry_strategy: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsBatchJobDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).batchconn
+
+	log.Printf("[DEBUG] Deregistering Batch job definition %s", d.Id())
+	_, err := conn.DeregisterJobDefinition(&batch.DeregisterJobDefinitionInput{
+		JobDefinition: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error deregistering Batch job definition %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandBatchRetryStrategy(l []interface{}) *batch.RetryStrategy {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	rs := &batch.RetryStrategy{}
+	if v, ok := m["attempts"].(int); ok && v > 0 {
+		rs.Attempts = aws.Int64(int64(v))
+	}
+	return rs
+}
+
+func flattenBatchRetryStrategy(rs *batch.RetryStrategy) []interface{} {
+	if rs == nil || rs.Attempts == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"attempts": int(aws.Int64Value(rs.Attempts)),
+		},
+	}
+}
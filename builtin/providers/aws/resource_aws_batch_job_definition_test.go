@@ -0,0 +1,123 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSBatchJobDefinition_basic(t *testing.T) {
+	var jd batch.JobDefinition
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBatchJobDefinitionDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBatchJobDefinitionConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBatchJobDefinitionExists("aws_batch_job_definition.foo", &jd),
+					testAccCheckBatchJobDefinitionImage(&jd, "busybox"),
+					resource.TestCheckResourceAttr("aws_batch_job_definition.foo", "type", "container"),
+					resource.TestCheckResourceAttr("aws_batch_job_definition.foo", "revision", "1"),
+					resource.TestCheckResourceAttr("aws_batch_job_definition.foo", "retry_strategy.0.attempts", "1"),
+					resource.TestCheckResourceAttr("aws_batch_job_definition.foo", "parameters.param1", "val1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBatchJobDefinitionExists(n string, jd *batch.JobDefinition) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).batchconn
+		out, err := conn.DescribeJobDefinitions(&batch.DescribeJobDefinitionsInput{
+			JobDefinitions: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+		if len(out.JobDefinitions) == 0 {
+			return fmt.Errorf("Batch job definition %s not found", rs.Primary.ID)
+		}
+
+		*jd = *out.JobDefinitions[0]
+		return nil
+	}
+}
+
+func testAccCheckBatchJobDefinitionImage(jd *batch.JobDefinition, image string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if jd.ContainerProperties == nil {
+			return fmt.Errorf("Batch job definition has no container properties")
+		}
+		if v := aws.StringValue(jd.ContainerProperties.Image); v != image {
+			return fmt.Errorf("Expected image %q, got %q", image, v)
+		}
+		return nil
+	}
+}
+
+func testAccCheckBatchJobDefinitionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).batchconn
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_batch_job_definition" {
+			continue
+		}
+
+		out, err := conn.DescribeJobDefinitions(&batch.DescribeJobDefinitionsInput{
+			JobDefinitions: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+		for _, jd := range out.JobDefinitions {
+			if aws.StringValue(jd.Status) != "INACTIVE" {
+				return fmt.Errorf("Batch job definition %s still active", rs.Primary.ID)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccBatchJobDefinitionConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_batch_job_definition" "foo" {
+  name = "tf_acc_batch_%d"
+  type = "container"
+
+  parameters = {
+    param1 = "val1"
+  }
+
+  retry_strategy {
+    attempts = 1
+  }
+
+  container_properties = <<CONTAINER_PROPERTIES
+{
+  "command": ["ls", "-la"],
+  "image": "busybox",
+  "memory": 128,
+  "vcpus": 1,
+  "environment": [
+    {"name": "VARNAME", "value": "VARVAL"}
+  ],
+  "ulimits": []
+}
+CONTAINER_PROPERTIES
+}
+`, rInt)
+}
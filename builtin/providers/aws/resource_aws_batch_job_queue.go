@@ -0,0 +1,257 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsBatchJobQueue() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsBatchJobQueueCreate,
+		Read:   resourceAwsBatchJobQueueRead,
+		Update: resourceAwsBatchJobQueueUpdate,
+		Delete: resourceAwsBatchJobQueueDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateBatchName,
+			},
+			"compute_environments": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"priority": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateBatchState,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsBatchJobQueueCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).batchconn
+
+	name := d.Get("name").(string)
+	input := &batch.CreateJobQueueInput{
+		ComputeEnvironmentOrder: expandBatchComputeEnvironmentOrder(d.Get("compute_environments").([]interface{})),
+		JobQueueName:            aws.String(name),
+		Priority:                aws.Int64(int64(d.Get("priority").(int))),
+		State:                   aws.String(d.Get("state").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Batch job queue: %s", input)
+	out, err := conn.CreateJobQueue(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Batch job queue %s: %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(out.JobQueueArn))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{batch.JQStatusCreating, batch.JQStatusUpdating},
+		Target:     []string{batch.JQStatusValid},
+		Refresh:    batchJobQueueStatusRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Batch job queue %s to become valid: %s", name, err)
+	}
+
+	return resourceAwsBatchJobQueueRead(d, meta)
+}
+
+func resourceAwsBatchJobQueueRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).batchconn
+
+	jq, err := describeBatchJobQueue(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading Batch job queue %s: %s", d.Id(), err)
+	}
+	if jq == nil || aws.StringValue(jq.Status) == batch.JQStatusDeleted {
+		log.Printf("[WARN] Batch job queue %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("compute_environments", flattenBatchComputeEnvironmentOrder(jq.ComputeEnvironmentOrder)); err != nil {
+		return fmt.Errorf("Error setting compute_environments: %s", err)
+	}
+	d.Set("arn", jq.JobQueueArn)
+	d.Set("name", jq.JobQueueName)
+	d.Set("priority", jq.Priority)
+	d.Set("state", jq.State)
+
+	return nil
+}
+
+func resourceAwsBatchJobQueueUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).batchconn
+
+	input := &batch.UpdateJobQueueInput{
+		JobQueue: aws.String(d.Id()),
+		Priority: aws.Int64(int64(d.Get("priority").(int))),
+		State:    aws.String(d.Get("state").(string)),
+	}
+	if d.HasChange("compute_environments") {
+		input.ComputeEnvironmentOrder = expandBatchComputeEnvironmentOrder(d.Get("compute_environments").([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating Batch job queue: %s", input)
+	if _, err := conn.UpdateJobQueue(input); err != nil {
+		return fmt.Errorf("Error updating Batch job queue %s: %s", d.Id(), err)
+	}
+
+	if err := waitForBatchJobQueueUpdate(conn, d.Id()); err != nil {
+		return err
+	}
+
+	return resourceAwsBatchJobQueueRead(d, meta)
+}
+
+func resourceAwsBatchJobQueueDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).batchconn
+
+	// A job queue must be disabled before it can be deleted.
+	if d.Get("state").(string) != batch.JQStateDisabled {
+		log.Printf("[DEBUG] Disabling Batch job queue %s", d.Id())
+		_, err := conn.UpdateJobQueue(&batch.UpdateJobQueueInput{
+			JobQueue: aws.String(d.Id()),
+			State:    aws.String(batch.JQStateDisabled),
+		})
+		if err != nil {
+			return fmt.Errorf("Error disabling Batch job queue %s: %s", d.Id(), err)
+		}
+
+		if err := waitForBatchJobQueueUpdate(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Batch job queue %s", d.Id())
+	_, err := conn.DeleteJobQueue(&batch.DeleteJobQueueInput{
+		JobQueue: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting Batch job queue %s: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{batch.JQStatusDeleting},
+		Target:     []string{batch.JQStatusDeleted},
+		Refresh:    batchJobQueueStatusRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Batch job queue %s to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForBatchJobQueueUpdate(conn *batch.Batch, arn string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{batch.JQStatusUpdating},
+		Target:     []string{batch.JQStatusValid},
+		Refresh:    batchJobQueueStatusRefreshFunc(conn, arn),
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Batch job queue %s to finish updating: %s", arn, err)
+	}
+	return nil
+}
+
+func describeBatchJobQueue(conn *batch.Batch, arn string) (*batch.JobQueueDetail, error) {
+	out, err := conn.DescribeJobQueues(&batch.DescribeJobQueuesInput{
+		JobQueues: []*string{aws.String(arn)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, jq := range out.JobQueues {
+		if aws.StringValue(jq.JobQueueArn) == arn {
+			return jq, nil
+		}
+	}
+	return nil, nil
+}
+
+func batchJobQueueStatusRefreshFunc(conn *batch.Batch, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		jq, err := describeBatchJobQueue(conn, arn)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if jq == nil {
+			return &batch.JobQueueDetail{}, batch.JQStatusDeleted, nil
+		}
+
+		status := aws.StringValue(jq.Status)
+		if status == batch.JQStatusInvalid {
+			return jq, status, fmt.Errorf("job queue is invalid: %s", aws.StringValue(jq.StatusReason))
+		}
+		return jq, status, nil
+	}
+}
+
+// The order of compute_environments is the order in which the scheduler
+// tries to place jobs, so each environment's position becomes its order.
+func expandBatchComputeEnvironmentOrder(l []interface{}) []*batch.ComputeEnvironmentOrder {
+	ceos := make([]*batch.ComputeEnvironmentOrder, 0, len(l))
+	for i, v := range l {
+		ceos = append(ceos, &batch.ComputeEnvironmentOrder{
+			ComputeEnvironment: aws.String(v.(string)),
+			Order:              aws.Int64(int64(i)),
+		})
+	}
+	return ceos
+}
+
+func flattenBatchComputeEnvironmentOrder(ceos []*batch.ComputeEnvironmentOrder) []interface{} {
+	sorted := make([]*batch.ComputeEnvironmentOrder, len(ceos))
+	copy(sorted, ceos)
+	sort.Sort(batchComputeEnvironmentOrders(sorted))
+
+	l := make([]interface{}, 0, len(sorted))
+	for _, ceo := range sorted {
+		l = append(l, aws.StringValue(ceo.ComputeEnvironment))
+	}
+	return l
+}
+
+type batchComputeEnvironmentOrders []*batch.ComputeEnvironmentOrder
+
+func (s batchComputeEnvironmentOrders) Len() int      { return len(s) }
+func (s batchComputeEnvironmentOrders) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s batchComputeEnvironmentOrders) Less(i, j int) bool {
+	return aws.Int64Value(s[i].Order) < aws.Int64Value(s[j].Order)
+}
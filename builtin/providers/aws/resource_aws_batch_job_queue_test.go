@@ -0,0 +1,112 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestFlattenBatchComputeEnvironmentOrder(t *testing.T) {
+	ceos := []*batch.ComputeEnvironmentOrder{
+		{ComputeEnvironment: aws.String("arn:c"), Order: aws.Int64(5)},
+		{ComputeEnvironment: aws.String("arn:a"), Order: aws.Int64(0)},
+		{ComputeEnvironment: aws.String("arn:b"), Order: aws.Int64(2)},
+	}
+
+	expected := []interface{}{"arn:a", "arn:b", "arn:c"}
+	actual := flattenBatchComputeEnvironmentOrder(ceos)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Got %#v, expected %#v", actual, expected)
+	}
+
+	// The input must be left untouched.
+	if aws.StringValue(ceos[0].ComputeEnvironment) != "arn:c" {
+		t.Fatalf("flattenBatchComputeEnvironmentOrder modified its input")
+	}
+}
+
+func TestAccAWSBatchJobQueue_basic(t *testing.T) {
+	var jq batch.JobQueueDetail
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBatchJobQueueDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBatchJobQueueConfig(rInt, 1, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBatchJobQueueExists("aws_batch_job_queue.foo", &jq),
+					resource.TestCheckResourceAttr("aws_batch_job_queue.foo", "priority", "1"),
+					resource.TestCheckResourceAttr("aws_batch_job_queue.foo", "state", "ENABLED"),
+					resource.TestCheckResourceAttr("aws_batch_job_queue.foo", "compute_environments.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccBatchJobQueueConfig(rInt, 2, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBatchJobQueueExists("aws_batch_job_queue.foo", &jq),
+					resource.TestCheckResourceAttr("aws_batch_job_queue.foo", "priority", "2"),
+					resource.TestCheckResourceAttr("aws_batch_job_queue.foo", "state", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBatchJobQueueExists(n string, jq *batch.JobQueueDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).batchconn
+		out, err := describeBatchJobQueue(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if out == nil {
+			return fmt.Errorf("Batch job queue %s not found", rs.Primary.ID)
+		}
+
+		*jq = *out
+		return nil
+	}
+}
+
+func testAccCheckBatchJobQueueDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).batchconn
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_batch_job_queue" {
+			continue
+		}
+
+		out, err := describeBatchJobQueue(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if out != nil && aws.StringValue(out.Status) != batch.JQStatusDeleted {
+			return fmt.Errorf("Batch job queue %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccBatchJobQueueConfig(rInt, priority int, state string) string {
+	return testAccBatchComputeEnvironmentConfig(rInt, 0, 4) + fmt.Sprintf(`
+resource "aws_batch_job_queue" "foo" {
+  name                 = "tf_acc_batch_%d"
+  compute_environments = ["${aws_batch_compute_environment.foo.arn}"]
+  priority             = %d
+  state                = "%s"
+}
+`, rInt, priority, state)
+}
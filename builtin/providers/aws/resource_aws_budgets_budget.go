@@ -0,0 +1,476 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const awsBudgetsBudgetTimePeriodLayout = "2006-01-02_15:04"
+
+func resourceAwsBudgetsBudget() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsBudgetsBudgetCreate,
+		Read:   resourceAwsBudgetsBudgetRead,
+		Update: resourceAwsBudgetsBudgetUpdate,
+		Delete: resourceAwsBudgetsBudgetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"budget_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateBudgetsBudgetType,
+			},
+			"limit_amount": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"limit_unit": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"time_unit": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateBudgetsTimeUnit,
+			},
+			"time_period_start": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateBudgetsTimePeriod,
+			},
+			"time_period_end": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateBudgetsTimePeriod,
+			},
+			"cost_filters": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"notification": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"comparison_operator": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateBudgetsComparisonOperator,
+						},
+						"threshold": &schema.Schema{
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+						"threshold_type": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      budgets.ThresholdTypePercentage,
+							ValidateFunc: validateBudgetsThresholdType,
+						},
+						"notification_type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateBudgetsNotificationType,
+						},
+						"subscriber_email_addresses": &schema.Schema{
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"subscriber_sns_topic_arns": &schema.Schema{
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsBudgetsBudgetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+
+	accountId := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("account_id"); ok {
+		accountId = v.(string)
+	}
+	if accountId == "" {
+		return fmt.Errorf("account_id must be set when skip_requesting_account_id is enabled")
+	}
+
+	budget, err := expandBudgetsBudget(d)
+	if err != nil {
+		return err
+	}
+
+	notifications, err := expandBudgetsNotificationsWithSubscribers(d.Get("notification").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+
+	input := &budgets.CreateBudgetInput{
+		AccountId:                    aws.String(accountId),
+		Budget:                       budget,
+		NotificationsWithSubscribers: notifications,
+	}
+
+	log.Printf("[DEBUG] Creating Budget: %s", input)
+	if _, err := conn.CreateBudget(input); err != nil {
+		return fmt.Errorf("Error creating Budget: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", accountId, aws.StringValue(budget.BudgetName)))
+
+	return resourceAwsBudgetsBudgetRead(d, meta)
+}
+
+func resourceAwsBudgetsBudgetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+
+	accountId, budgetName, err := parseBudgetsBudgetId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DescribeBudget(&budgets.DescribeBudgetInput{
+		AccountId:  aws.String(accountId),
+		BudgetName: aws.String(budgetName),
+	})
+	if err != nil {
+		if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Budget %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Budget %s: %s", d.Id(), err)
+	}
+
+	budget := resp.Budget
+	d.Set("account_id", accountId)
+	d.Set("name", budget.BudgetName)
+	d.Set("budget_type", budget.BudgetType)
+	d.Set("time_unit", budget.TimeUnit)
+
+	if budget.BudgetLimit != nil {
+		d.Set("limit_amount", budget.BudgetLimit.Amount)
+		d.Set("limit_unit", budget.BudgetLimit.Unit)
+	}
+
+	if budget.TimePeriod != nil {
+		if budget.TimePeriod.Start != nil {
+			d.Set("time_period_start", budget.TimePeriod.Start.UTC().Format(awsBudgetsBudgetTimePeriodLayout))
+		}
+		if budget.TimePeriod.End != nil {
+			d.Set("time_period_end", budget.TimePeriod.End.UTC().Format(awsBudgetsBudgetTimePeriodLayout))
+		}
+	}
+
+	if err := d.Set("cost_filters", flattenBudgetsCostFilters(budget.CostFilters)); err != nil {
+		return fmt.Errorf("Error setting cost_filters: %s", err)
+	}
+
+	notifications, err := readBudgetsNotificationsWithSubscribers(conn, accountId, budgetName)
+	if err != nil {
+		return fmt.Errorf("Error reading Budget %s notifications: %s", d.Id(), err)
+	}
+	if err := d.Set("notification", notifications); err != nil {
+		return fmt.Errorf("Error setting notification: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsBudgetsBudgetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+
+	accountId, _, err := parseBudgetsBudgetId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Partial(true)
+
+	budget, err := expandBudgetsBudget(d)
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("budget_type") || d.HasChange("limit_amount") || d.HasChange("limit_unit") ||
+		d.HasChange("time_unit") || d.HasChange("time_period_start") || d.HasChange("time_period_end") ||
+		d.HasChange("cost_filters") {
+		input := &budgets.UpdateBudgetInput{
+			AccountId: aws.String(accountId),
+			NewBudget: budget,
+		}
+
+		log.Printf("[DEBUG] Updating Budget: %s", input)
+		if _, err := conn.UpdateBudget(input); err != nil {
+			return fmt.Errorf("Error updating Budget %s: %s", d.Id(), err)
+		}
+
+		d.SetPartial("budget_type")
+		d.SetPartial("limit_amount")
+		d.SetPartial("limit_unit")
+		d.SetPartial("time_unit")
+		d.SetPartial("time_period_start")
+		d.SetPartial("time_period_end")
+		d.SetPartial("cost_filters")
+	}
+
+	if d.HasChange("notification") {
+		o, n := d.GetChange("notification")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		remove, err := expandBudgetsNotificationsWithSubscribers(os.Difference(ns).List())
+		if err != nil {
+			return err
+		}
+		for _, notification := range remove {
+			log.Printf("[DEBUG] Deleting Budget %s notification: %s", d.Id(), notification.Notification)
+			_, err := conn.DeleteNotification(&budgets.DeleteNotificationInput{
+				AccountId:    aws.String(accountId),
+				BudgetName:   budget.BudgetName,
+				Notification: notification.Notification,
+			})
+			if err != nil && !isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+				return fmt.Errorf("Error deleting Budget %s notification: %s", d.Id(), err)
+			}
+		}
+
+		add, err := expandBudgetsNotificationsWithSubscribers(ns.Difference(os).List())
+		if err != nil {
+			return err
+		}
+		for _, notification := range add {
+			log.Printf("[DEBUG] Creating Budget %s notification: %s", d.Id(), notification.Notification)
+			_, err := conn.CreateNotification(&budgets.CreateNotificationInput{
+				AccountId:    aws.String(accountId),
+				BudgetName:   budget.BudgetName,
+				Notification: notification.Notification,
+				Subscribers:  notification.Subscribers,
+			})
+			if err != nil {
+				return fmt.Errorf("Error creating Budget %s notification: %s", d.Id(), err)
+			}
+		}
+
+		d.SetPartial("notification")
+	}
+
+	d.Partial(false)
+
+	return resourceAwsBudgetsBudgetRead(d, meta)
+}
+
+func resourceAwsBudgetsBudgetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+
+	accountId, budgetName, err := parseBudgetsBudgetId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Budget: %s", d.Id())
+	_, err = conn.DeleteBudget(&budgets.DeleteBudgetInput{
+		AccountId:  aws.String(accountId),
+		BudgetName: aws.String(budgetName),
+	})
+	if err != nil {
+		if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Budget %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func parseBudgetsBudgetId(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected ACCOUNT_ID:BUDGET_NAME", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func expandBudgetsBudget(d *schema.ResourceData) (*budgets.Budget, error) {
+	budget := &budgets.Budget{
+		BudgetName: aws.String(d.Get("name").(string)),
+		BudgetType: aws.String(d.Get("budget_type").(string)),
+		TimeUnit:   aws.String(d.Get("time_unit").(string)),
+		BudgetLimit: &budgets.Spend{
+			Amount: aws.String(d.Get("limit_amount").(string)),
+			Unit:   aws.String(d.Get("limit_unit").(string)),
+		},
+	}
+
+	timePeriod := &budgets.TimePeriod{}
+	if v, ok := d.GetOk("time_period_start"); ok {
+		start, err := time.Parse(awsBudgetsBudgetTimePeriodLayout, v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing time_period_start: %s", err)
+		}
+		timePeriod.Start = aws.Time(start)
+	}
+	if v, ok := d.GetOk("time_period_end"); ok {
+		end, err := time.Parse(awsBudgetsBudgetTimePeriodLayout, v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing time_period_end: %s", err)
+		}
+		timePeriod.End = aws.Time(end)
+	}
+	if timePeriod.Start != nil || timePeriod.End != nil {
+		budget.TimePeriod = timePeriod
+	}
+
+	if v, ok := d.GetOk("cost_filters"); ok {
+		filters := make(map[string][]*string)
+		for k, vs := range v.(map[string]interface{}) {
+			filters[k] = []*string{aws.String(vs.(string))}
+		}
+		budget.CostFilters = filters
+	}
+
+	return budget, nil
+}
+
+func flattenBudgetsCostFilters(filters map[string][]*string) map[string]interface{} {
+	result := make(map[string]interface{})
+	for k, vs := range filters {
+		values := make([]string, 0, len(vs))
+		for _, v := range vs {
+			values = append(values, aws.StringValue(v))
+		}
+		result[k] = strings.Join(values, ",")
+	}
+	return result
+}
+
+func expandBudgetsNotificationsWithSubscribers(configured []interface{}) ([]*budgets.NotificationWithSubscribers, error) {
+	notifications := make([]*budgets.NotificationWithSubscribers, 0, len(configured))
+	for _, raw := range configured {
+		m := raw.(map[string]interface{})
+
+		var subscribers []*budgets.Subscriber
+		for _, address := range m["subscriber_email_addresses"].(*schema.Set).List() {
+			subscribers = append(subscribers, &budgets.Subscriber{
+				Address:          aws.String(address.(string)),
+				SubscriptionType: aws.String(budgets.SubscriptionTypeEmail),
+			})
+		}
+		for _, arn := range m["subscriber_sns_topic_arns"].(*schema.Set).List() {
+			subscribers = append(subscribers, &budgets.Subscriber{
+				Address:          aws.String(arn.(string)),
+				SubscriptionType: aws.String(budgets.SubscriptionTypeSns),
+			})
+		}
+		if len(subscribers) == 0 {
+			return nil, fmt.Errorf("Budget notification must have at least one subscriber_email_addresses or subscriber_sns_topic_arns")
+		}
+
+		notifications = append(notifications, &budgets.NotificationWithSubscribers{
+			Notification: &budgets.Notification{
+				ComparisonOperator: aws.String(m["comparison_operator"].(string)),
+				Threshold:          aws.Float64(m["threshold"].(float64)),
+				ThresholdType:      aws.String(m["threshold_type"].(string)),
+				NotificationType:   aws.String(m["notification_type"].(string)),
+			},
+			Subscribers: subscribers,
+		})
+	}
+	return notifications, nil
+}
+
+func readBudgetsNotificationsWithSubscribers(conn *budgets.Budgets, accountId, budgetName string) ([]map[string]interface{}, error) {
+	var notifications []*budgets.Notification
+	input := &budgets.DescribeNotificationsForBudgetInput{
+		AccountId:  aws.String(accountId),
+		BudgetName: aws.String(budgetName),
+	}
+	for {
+		resp, err := conn.DescribeNotificationsForBudget(input)
+		if err != nil {
+			if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+				return nil, nil
+			}
+			return nil, err
+		}
+		notifications = append(notifications, resp.Notifications...)
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+
+	result := make([]map[string]interface{}, 0, len(notifications))
+	for _, notification := range notifications {
+		var emails, topics []interface{}
+		subscribersInput := &budgets.DescribeSubscribersForNotificationInput{
+			AccountId:    aws.String(accountId),
+			BudgetName:   aws.String(budgetName),
+			Notification: notification,
+		}
+		for {
+			resp, err := conn.DescribeSubscribersForNotification(subscribersInput)
+			if err != nil {
+				return nil, err
+			}
+			for _, subscriber := range resp.Subscribers {
+				switch aws.StringValue(subscriber.SubscriptionType) {
+				case budgets.SubscriptionTypeEmail:
+					emails = append(emails, aws.StringValue(subscriber.Address))
+				case budgets.SubscriptionTypeSns:
+					topics = append(topics, aws.StringValue(subscriber.Address))
+				}
+			}
+			if resp.NextToken == nil {
+				break
+			}
+			subscribersInput.NextToken = resp.NextToken
+		}
+
+		thresholdType := aws.StringValue(notification.ThresholdType)
+		if thresholdType == "" {
+			thresholdType = budgets.ThresholdTypePercentage
+		}
+
+		result = append(result, map[string]interface{}{
+			"comparison_operator":        aws.StringValue(notification.ComparisonOperator),
+			"threshold":                  aws.Float64Value(notification.Threshold),
+			"threshold_type":             thresholdType,
+			"notification_type":          aws.StringValue(notification.NotificationType),
+			"subscriber_email_addresses": schema.NewSet(schema.HashString, emails),
+			"subscriber_sns_topic_arns":  schema.NewSet(schema.HashString, topics),
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,146 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestParseBudgetsBudgetId(t *testing.T) {
+	accountId, name, err := parseBudgetsBudgetId("123456789012:my:budget")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if accountId != "123456789012" || name != "my:budget" {
+		t.Fatalf("bad: %s, %s", accountId, name)
+	}
+
+	for _, id := range []string{"", "123456789012", "123456789012:", ":budget"} {
+		if _, _, err := parseBudgetsBudgetId(id); err == nil {
+			t.Fatalf("expected error for %q", id)
+		}
+	}
+}
+
+func TestAccAWSBudgetsBudget_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-budget-%s", acctest.RandString(5))
+	resourceName := "aws_budgets_budget.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSBudgetsBudgetDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSBudgetsBudgetConfig(rName, "100.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSBudgetsBudgetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "budget_type", "COST"),
+					resource.TestCheckResourceAttr(resourceName, "limit_amount", "100.0"),
+					resource.TestCheckResourceAttr(resourceName, "limit_unit", "USD"),
+					resource.TestCheckResourceAttr(resourceName, "time_unit", "MONTHLY"),
+					resource.TestCheckResourceAttr(resourceName, "time_period_start", "2017-01-01_00:00"),
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "account_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSBudgetsBudgetConfig(rName, "200.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSBudgetsBudgetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "limit_amount", "200.0"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSBudgetsBudgetExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		accountId, budgetName, err := parseBudgetsBudgetId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).budgetconn
+		_, err = conn.DescribeBudget(&budgets.DescribeBudgetInput{
+			AccountId:  aws.String(accountId),
+			BudgetName: aws.String(budgetName),
+		})
+		return err
+	}
+}
+
+func testAccCheckAWSBudgetsBudgetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).budgetconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_budgets_budget" {
+			continue
+		}
+
+		accountId, budgetName, err := parseBudgetsBudgetId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DescribeBudget(&budgets.DescribeBudgetInput{
+			AccountId:  aws.String(accountId),
+			BudgetName: aws.String(budgetName),
+		})
+		if err == nil {
+			return fmt.Errorf("Budget %s still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSBudgetsBudgetConfig(name, limit string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = "%s"
+}
+
+resource "aws_budgets_budget" "test" {
+  name              = "%s"
+  budget_type       = "COST"
+  limit_amount      = "%s"
+  limit_unit        = "USD"
+  time_unit         = "MONTHLY"
+  time_period_start = "2017-01-01_00:00"
+
+  cost_filters {
+    Service = "Amazon Elastic Compute Cloud - Compute"
+  }
+
+  notification {
+    comparison_operator        = "GREATER_THAN"
+    threshold                  = 80
+    threshold_type             = "PERCENTAGE"
+    notification_type          = "FORECASTED"
+    subscriber_email_addresses = ["test@example.com"]
+    subscriber_sns_topic_arns  = ["${aws_sns_topic.test.arn}"]
+  }
+}
+`, name, name, limit)
+}
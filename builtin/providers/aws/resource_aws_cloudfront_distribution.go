@@ -273,6 +273,11 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 				Default:      "http2",
 				ValidateFunc: validateHTTP,
 			},
+			"is_ipv6_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"logging_config": {
 				Type:     schema.TypeSet,
 				Optional: true,
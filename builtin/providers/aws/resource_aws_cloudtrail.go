@@ -3,10 +3,13 @@ package aws
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsCloudTrail() *schema.Resource {
@@ -69,6 +72,50 @@ func resourceAwsCloudTrail() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"event_selector": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"read_write_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  cloudtrail.ReadWriteTypeAll,
+							ValidateFunc: validation.StringInSlice([]string{
+								cloudtrail.ReadWriteTypeAll,
+								cloudtrail.ReadWriteTypeReadOnly,
+								cloudtrail.ReadWriteTypeWriteOnly,
+							}, false),
+						},
+						"include_management_events": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"data_resource": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"AWS::S3::Object",
+										}, false),
+									},
+									"values": &schema.Schema{
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"home_region": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -115,7 +162,19 @@ func resourceAwsCloudTrailCreate(d *schema.ResourceData, meta interface{}) error
 		input.SnsTopicName = aws.String(v.(string))
 	}
 
-	t, err := conn.CreateTrail(&input)
+	var t *cloudtrail.CreateTrailOutput
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		var err error
+		t, err = conn.CreateTrail(&input)
+		if cloudTrailIsPropagationErr(err) {
+			log.Printf("[DEBUG] Retrying CloudTrail creation: %s", err)
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
 	if err != nil {
 		return err
 	}
@@ -204,6 +263,16 @@ func resourceAwsCloudTrailRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	selectors, err := conn.GetEventSelectors(&cloudtrail.GetEventSelectorsInput{
+		TrailName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return err
+	}
+	if err := d.Set("event_selector", flattenCloudTrailEventSelectors(selectors.EventSelectors)); err != nil {
+		return err
+	}
+
 	logstatus, err := cloudTrailGetLoggingStatus(conn, trail.Name)
 	if err != nil {
 		return err
@@ -249,11 +318,29 @@ func resourceAwsCloudTrailUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] Updating CloudTrail: %s", input)
-	t, err := conn.UpdateTrail(&input)
+	var t *cloudtrail.UpdateTrailOutput
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		var err error
+		t, err = conn.UpdateTrail(&input)
+		if cloudTrailIsPropagationErr(err) {
+			log.Printf("[DEBUG] Retrying CloudTrail update: %s", err)
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
 	if err != nil {
 		return err
 	}
 
+	if d.HasChange("event_selector") {
+		if err := cloudTrailSetEventSelectors(conn, d.Id(), d.Get("event_selector").([]interface{})); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("tags") {
 		err := setTagsCloudtrail(conn, d)
 		if err != nil {
@@ -326,3 +413,101 @@ func cloudTrailSetLogging(conn *cloudtrail.CloudTrail, enabled bool, id string)
 
 	return nil
 }
+
+// cloudTrailIsPropagationErr returns true if err is caused by a role,
+// bucket policy or key policy that CloudTrail can't see yet because it
+// was only just created or changed.
+func cloudTrailIsPropagationErr(err error) bool {
+	for _, code := range []string{
+		cloudtrail.ErrCodeInsufficientEncryptionPolicyException,
+		cloudtrail.ErrCodeInsufficientS3BucketPolicyException,
+		cloudtrail.ErrCodeInsufficientSnsTopicPolicyException,
+		cloudtrail.ErrCodeInvalidCloudWatchLogsLogGroupArnException,
+		cloudtrail.ErrCodeInvalidCloudWatchLogsRoleArnException,
+	} {
+		if isAWSErr(err, code, "") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func cloudTrailSetEventSelectors(conn *cloudtrail.CloudTrail, name string, configured []interface{}) error {
+	// A trail without event selectors logs all management events, so
+	// removing the selectors restores that default.
+	selectors := expandCloudTrailEventSelectors(configured)
+	if len(selectors) == 0 {
+		selectors = []*cloudtrail.EventSelector{
+			&cloudtrail.EventSelector{
+				IncludeManagementEvents: aws.Bool(true),
+				ReadWriteType:           aws.String(cloudtrail.ReadWriteTypeAll),
+			},
+		}
+	}
+
+	input := &cloudtrail.PutEventSelectorsInput{
+		TrailName:      aws.String(name),
+		EventSelectors: selectors,
+	}
+
+	log.Printf("[DEBUG] Setting event selectors on CloudTrail: %s", input)
+	if _, err := conn.PutEventSelectors(input); err != nil {
+		return fmt.Errorf("Error setting event selectors on CloudTrail (%s): %s", name, err)
+	}
+
+	return nil
+}
+
+func expandCloudTrailEventSelectors(configured []interface{}) []*cloudtrail.EventSelector {
+	selectors := make([]*cloudtrail.EventSelector, 0, len(configured))
+	for _, raw := range configured {
+		m := raw.(map[string]interface{})
+		selector := &cloudtrail.EventSelector{
+			IncludeManagementEvents: aws.Bool(m["include_management_events"].(bool)),
+			ReadWriteType:           aws.String(m["read_write_type"].(string)),
+			DataResources:           []*cloudtrail.DataResource{},
+		}
+
+		for _, rawResource := range m["data_resource"].([]interface{}) {
+			r := rawResource.(map[string]interface{})
+			selector.DataResources = append(selector.DataResources, &cloudtrail.DataResource{
+				Type:   aws.String(r["type"].(string)),
+				Values: expandStringList(r["values"].([]interface{})),
+			})
+		}
+
+		selectors = append(selectors, selector)
+	}
+
+	return selectors
+}
+
+func flattenCloudTrailEventSelectors(selectors []*cloudtrail.EventSelector) []map[string]interface{} {
+	// The default selector that every trail has is the same as not
+	// configuring any, so it isn't reported to avoid a perpetual diff.
+	if len(selectors) == 1 && len(selectors[0].DataResources) == 0 &&
+		aws.BoolValue(selectors[0].IncludeManagementEvents) &&
+		aws.StringValue(selectors[0].ReadWriteType) == cloudtrail.ReadWriteTypeAll {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, 0, len(selectors))
+	for _, selector := range selectors {
+		resources := make([]map[string]interface{}, 0, len(selector.DataResources))
+		for _, r := range selector.DataResources {
+			resources = append(resources, map[string]interface{}{
+				"type":   aws.StringValue(r.Type),
+				"values": flattenStringList(r.Values),
+			})
+		}
+
+		result = append(result, map[string]interface{}{
+			"read_write_type":           aws.StringValue(selector.ReadWriteType),
+			"include_management_events": aws.BoolValue(selector.IncludeManagementEvents),
+			"data_resource":             resources,
+		})
+	}
+
+	return result
+}
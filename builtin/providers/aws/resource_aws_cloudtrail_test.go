@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -161,6 +162,79 @@ func TestAccAWSCloudTrail_logValidation(t *testing.T) {
 	})
 }
 
+func TestAccAWSCloudTrail_eventSelector(t *testing.T) {
+	var trail cloudtrail.Trail
+	cloudTrailRandInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudTrailDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSCloudTrailConfig_eventSelector(cloudTrailRandInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudTrailExists("aws_cloudtrail.foobar", &trail),
+					resource.TestCheckResourceAttr("aws_cloudtrail.foobar", "event_selector.#", "1"),
+					resource.TestCheckResourceAttr("aws_cloudtrail.foobar", "event_selector.0.read_write_type", "WriteOnly"),
+					resource.TestCheckResourceAttr("aws_cloudtrail.foobar", "event_selector.0.include_management_events", "false"),
+					resource.TestCheckResourceAttr("aws_cloudtrail.foobar", "event_selector.0.data_resource.#", "1"),
+					resource.TestCheckResourceAttr("aws_cloudtrail.foobar", "event_selector.0.data_resource.0.type", "AWS::S3::Object"),
+					resource.TestCheckResourceAttr("aws_cloudtrail.foobar", "event_selector.0.data_resource.0.values.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSCloudTrailConfig(cloudTrailRandInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudTrailExists("aws_cloudtrail.foobar", &trail),
+					resource.TestCheckResourceAttr("aws_cloudtrail.foobar", "event_selector.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenCloudTrailEventSelectors(t *testing.T) {
+	// The default selector is the same as having none
+	result := flattenCloudTrailEventSelectors([]*cloudtrail.EventSelector{
+		&cloudtrail.EventSelector{
+			IncludeManagementEvents: aws.Bool(true),
+			ReadWriteType:           aws.String("All"),
+		},
+	})
+	if len(result) != 0 {
+		t.Fatalf("expected no selectors, got: %#v", result)
+	}
+
+	result = flattenCloudTrailEventSelectors([]*cloudtrail.EventSelector{
+		&cloudtrail.EventSelector{
+			IncludeManagementEvents: aws.Bool(true),
+			ReadWriteType:           aws.String("ReadOnly"),
+			DataResources: []*cloudtrail.DataResource{
+				&cloudtrail.DataResource{
+					Type:   aws.String("AWS::S3::Object"),
+					Values: []*string{aws.String("arn:aws:s3:::foo/")},
+				},
+			},
+		},
+	})
+	expected := []map[string]interface{}{
+		map[string]interface{}{
+			"read_write_type":           "ReadOnly",
+			"include_management_events": true,
+			"data_resource": []map[string]interface{}{
+				map[string]interface{}{
+					"type":   "AWS::S3::Object",
+					"values": []interface{}{"arn:aws:s3:::foo/"},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("expected:\n%#v\n\ngot:\n%#v", expected, result)
+	}
+}
+
 func TestAccAWSCloudTrail_tags(t *testing.T) {
 	var trail cloudtrail.Trail
 	var trailTags []*cloudtrail.Tag
@@ -647,3 +721,58 @@ func testAccAWSCloudTrailConfig_tagsModifiedAgain(cloudTrailRandInt int) string
 	return fmt.Sprintf(testAccAWSCloudTrailConfig_tags_tpl,
 		cloudTrailRandInt, "", cloudTrailRandInt, cloudTrailRandInt, cloudTrailRandInt)
 }
+
+func testAccAWSCloudTrailConfig_eventSelector(cloudTrailRandInt int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudtrail" "foobar" {
+    name = "tf-trail-foobar-%d"
+    s3_bucket_name = "${aws_s3_bucket.foo.id}"
+
+    event_selector {
+        read_write_type = "WriteOnly"
+        include_management_events = false
+
+        data_resource {
+            type = "AWS::S3::Object"
+            values = ["${aws_s3_bucket.data.arn}/"]
+        }
+    }
+}
+
+resource "aws_s3_bucket" "data" {
+	bucket = "tf-test-trail-data-%d"
+	force_destroy = true
+}
+
+resource "aws_s3_bucket" "foo" {
+	bucket = "tf-test-trail-%d"
+	force_destroy = true
+	policy = <<POLICY
+{
+	"Version": "2012-10-17",
+	"Statement": [
+		{
+			"Sid": "AWSCloudTrailAclCheck",
+			"Effect": "Allow",
+			"Principal": "*",
+			"Action": "s3:GetBucketAcl",
+			"Resource": "arn:aws:s3:::tf-test-trail-%d"
+		},
+		{
+			"Sid": "AWSCloudTrailWrite",
+			"Effect": "Allow",
+			"Principal": "*",
+			"Action": "s3:PutObject",
+			"Resource": "arn:aws:s3:::tf-test-trail-%d/*",
+			"Condition": {
+				"StringEquals": {
+					"s3:x-amz-acl": "bucket-owner-full-control"
+				}
+			}
+		}
+	]
+}
+POLICY
+}
+`, cloudTrailRandInt, cloudTrailRandInt, cloudTrailRandInt, cloudTrailRandInt, cloudTrailRandInt)
+}
@@ -54,6 +54,31 @@ func resourceAwsCloudWatchEventTarget() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"input"},
 			},
+
+			"role_arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ecs_target": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"task_count": &schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validateCloudWatchEventTargetEcsTaskCount,
+						},
+						"task_definition_arn": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -128,6 +153,13 @@ func resourceAwsCloudWatchEventTargetRead(d *schema.ResourceData, meta interface
 	d.Set("target_id", t.Id)
 	d.Set("input", t.Input)
 	d.Set("input_path", t.InputPath)
+	d.Set("role_arn", t.RoleArn)
+
+	if t.EcsParameters != nil {
+		if err := d.Set("ecs_target", flattenAwsCloudWatchEventTargetEcsParameters(t.EcsParameters)); err != nil {
+			return fmt.Errorf("Error setting ecs_target error: %#v", err)
+		}
+	}
 
 	return nil
 }
@@ -203,6 +235,14 @@ func buildPutTargetInputStruct(d *schema.ResourceData) *events.PutTargetsInput {
 		e.InputPath = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("role_arn"); ok {
+		e.RoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ecs_target"); ok {
+		e.EcsParameters = expandAwsCloudWatchEventTargetEcsParameters(v.([]interface{}))
+	}
+
 	input := events.PutTargetsInput{
 		Rule:    aws.String(d.Get("rule").(string)),
 		Targets: []*events.Target{e},
@@ -210,3 +250,22 @@ func buildPutTargetInputStruct(d *schema.ResourceData) *events.PutTargetsInput {
 
 	return &input
 }
+
+func expandAwsCloudWatchEventTargetEcsParameters(config []interface{}) *events.EcsParameters {
+	ecsParameters := &events.EcsParameters{}
+	for _, c := range config {
+		param := c.(map[string]interface{})
+		ecsParameters.TaskCount = aws.Int64(int64(param["task_count"].(int)))
+		ecsParameters.TaskDefinitionArn = aws.String(param["task_definition_arn"].(string))
+	}
+
+	return ecsParameters
+}
+
+func flattenAwsCloudWatchEventTargetEcsParameters(ecsParameters *events.EcsParameters) []map[string]interface{} {
+	config := make(map[string]interface{})
+	config["task_count"] = aws.Int64Value(ecsParameters.TaskCount)
+	config["task_definition_arn"] = aws.StringValue(ecsParameters.TaskDefinitionArn)
+	result := []map[string]interface{}{config}
+	return result
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -87,6 +88,29 @@ func TestAccAWSCloudWatchEventTarget_full(t *testing.T) {
 	})
 }
 
+func TestAccAWSCloudWatchEventTarget_ecs(t *testing.T) {
+	var target events.Target
+	rName := fmt.Sprintf("tf-acc-cw-target-ecs-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventTargetDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSCloudWatchEventTargetConfigEcs(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventTargetExists("aws_cloudwatch_event_target.test", &target),
+					resource.TestCheckResourceAttrSet("aws_cloudwatch_event_target.test", "role_arn"),
+					resource.TestCheckResourceAttr("aws_cloudwatch_event_target.test", "ecs_target.#", "1"),
+					resource.TestCheckResourceAttr("aws_cloudwatch_event_target.test", "ecs_target.0.task_count", "1"),
+					resource.TestCheckResourceAttrSet("aws_cloudwatch_event_target.test", "ecs_target.0.task_definition_arn"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCloudWatchEventTargetExists(n string, rule *events.Target) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -249,3 +273,81 @@ resource "aws_kinesis_stream" "test_stream" {
     shard_count = 1
 }
 `
+
+func testAccAWSCloudWatchEventTargetConfigEcs(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_rule" "test" {
+  name                = "%[1]s"
+  description         = "schedule_ecs_test"
+  schedule_expression = "rate(5 minutes)"
+}
+
+resource "aws_iam_role" "test" {
+  name = "%[1]s"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "events.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = "%[1]s"
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "ecs:RunTask",
+      "Resource": "*"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_ecs_cluster" "test" {
+  name = "%[1]s"
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family = "%[1]s"
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "name": "first",
+    "image": "service-first",
+    "cpu": 10,
+    "memory": 512,
+    "essential": true
+  }
+]
+DEFINITION
+}
+
+resource "aws_cloudwatch_event_target" "test" {
+  rule     = "${aws_cloudwatch_event_rule.test.name}"
+  arn      = "${aws_ecs_cluster.test.id}"
+  role_arn = "${aws_iam_role.test.arn}"
+
+  ecs_target {
+    task_count          = 1
+    task_definition_arn = "${aws_ecs_task_definition.test.arn}"
+  }
+}
+`, rName)
+}
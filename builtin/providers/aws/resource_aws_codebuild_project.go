@@ -0,0 +1,588 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsCodeBuildProject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCodeBuildProjectCreate,
+		Read:   resourceAwsCodeBuildProjectRead,
+		Update: resourceAwsCodeBuildProjectUpdate,
+		Delete: resourceAwsCodeBuildProjectDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsCodeBuildProjectName,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAwsCodeBuildProjectDescription,
+			},
+
+			"service_role": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"encryption_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"build_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validateAwsCodeBuildTimeout,
+			},
+
+			"badge_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"badge_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"artifacts": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAwsCodeBuildArtifactsType,
+						},
+						"location": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"namespace_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateAwsCodeBuildArtifactsNamespaceType,
+						},
+						"packaging": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateAwsCodeBuildArtifactsPackaging,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"environment": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compute_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAwsCodeBuildComputeType,
+						},
+						"image": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAwsCodeBuildEnvironmentType,
+						},
+						"privileged_mode": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"environment_variable": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      codebuild.EnvironmentVariableTypePlaintext,
+										ValidateFunc: validateAwsCodeBuildEnvironmentVariableType,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAwsCodeBuildSourceType,
+						},
+						"location": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"buildspec": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"git_clone_depth": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"insecure_ssl": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"auth": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateAwsCodeBuildSourceAuthType,
+									},
+									"resource": {
+										Type:      schema.TypeString,
+										Optional:  true,
+										Sensitive: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"vpc_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"subnets": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsCodeBuildProjectCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codebuildconn
+
+	input := &codebuild.CreateProjectInput{
+		Name:             aws.String(d.Get("name").(string)),
+		ServiceRole:      aws.String(d.Get("service_role").(string)),
+		TimeoutInMinutes: aws.Int64(int64(d.Get("build_timeout").(int))),
+		BadgeEnabled:     aws.Bool(d.Get("badge_enabled").(bool)),
+		Artifacts:        expandCodeBuildArtifacts(d.Get("artifacts").([]interface{})),
+		Environment:      expandCodeBuildEnvironment(d.Get("environment").([]interface{})),
+		Source:           expandCodeBuildSource(d.Get("source").([]interface{})),
+		VpcConfig:        expandCodeBuildVpcConfig(d.Get("vpc_config").([]interface{})),
+		Tags:             tagsFromMapCodeBuild(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("encryption_key"); ok {
+		input.EncryptionKey = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating CodeBuild project: %s", input)
+
+	// Retry for IAM eventual consistency: a freshly created service role
+	// may not be assumable by CodeBuild yet.
+	var out *codebuild.CreateProjectOutput
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		var err error
+		out, err = conn.CreateProject(input)
+		if err != nil {
+			if isAWSErr(err, codebuild.ErrCodeInvalidInputException, "Not authorized to perform sts:AssumeRole") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating CodeBuild project: %s", err)
+	}
+
+	d.SetId(aws.StringValue(out.Project.Name))
+	return resourceAwsCodeBuildProjectRead(d, meta)
+}
+
+func resourceAwsCodeBuildProjectRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codebuildconn
+
+	out, err := conn.BatchGetProjects(&codebuild.BatchGetProjectsInput{
+		Names: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading CodeBuild project %s: %s", d.Id(), err)
+	}
+
+	if len(out.Projects) == 0 {
+		log.Printf("[WARN] CodeBuild project %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	project := out.Projects[0]
+
+	d.Set("name", project.Name)
+	d.Set("arn", project.Arn)
+	d.Set("description", project.Description)
+	d.Set("service_role", project.ServiceRole)
+	d.Set("encryption_key", project.EncryptionKey)
+	d.Set("build_timeout", project.TimeoutInMinutes)
+
+	if project.Badge != nil {
+		d.Set("badge_enabled", project.Badge.BadgeEnabled)
+		d.Set("badge_url", project.Badge.BadgeRequestUrl)
+	} else {
+		d.Set("badge_enabled", false)
+		d.Set("badge_url", "")
+	}
+
+	if err := d.Set("artifacts", flattenCodeBuildArtifacts(project.Artifacts)); err != nil {
+		return fmt.Errorf("Error setting artifacts: %s", err)
+	}
+	if err := d.Set("environment", flattenCodeBuildEnvironment(project.Environment)); err != nil {
+		return fmt.Errorf("Error setting environment: %s", err)
+	}
+	if err := d.Set("source", flattenCodeBuildSource(project.Source)); err != nil {
+		return fmt.Errorf("Error setting source: %s", err)
+	}
+	if err := d.Set("vpc_config", flattenCodeBuildVpcConfig(project.VpcConfig)); err != nil {
+		return fmt.Errorf("Error setting vpc_config: %s", err)
+	}
+
+	d.Set("tags", tagsToMapCodeBuild(project.Tags))
+
+	return nil
+}
+
+func resourceAwsCodeBuildProjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codebuildconn
+
+	input := &codebuild.UpdateProjectInput{
+		Name: aws.String(d.Id()),
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+	if d.HasChange("service_role") {
+		input.ServiceRole = aws.String(d.Get("service_role").(string))
+	}
+	if d.HasChange("encryption_key") {
+		input.EncryptionKey = aws.String(d.Get("encryption_key").(string))
+	}
+	if d.HasChange("build_timeout") {
+		input.TimeoutInMinutes = aws.Int64(int64(d.Get("build_timeout").(int)))
+	}
+	if d.HasChange("badge_enabled") {
+		input.BadgeEnabled = aws.Bool(d.Get("badge_enabled").(bool))
+	}
+	if d.HasChange("artifacts") {
+		input.Artifacts = expandCodeBuildArtifacts(d.Get("artifacts").([]interface{}))
+	}
+	if d.HasChange("environment") {
+		input.Environment = expandCodeBuildEnvironment(d.Get("environment").([]interface{}))
+	}
+	if d.HasChange("source") {
+		input.Source = expandCodeBuildSource(d.Get("source").([]interface{}))
+	}
+	if d.HasChange("vpc_config") {
+		input.VpcConfig = expandCodeBuildVpcConfig(d.Get("vpc_config").([]interface{}))
+		if input.VpcConfig == nil {
+			// An empty configuration removes the project from the VPC.
+			input.VpcConfig = &codebuild.VpcConfig{}
+		}
+	}
+
+	// The API replaces the full set of tags on every update.
+	if d.HasChange("tags") {
+		input.Tags = tagsFromMapCodeBuild(d.Get("tags").(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating CodeBuild project: %s", input)
+	_, err := conn.UpdateProject(input)
+	if err != nil {
+		return fmt.Errorf("Error updating CodeBuild project %s: %s", d.Id(), err)
+	}
+
+	return resourceAwsCodeBuildProjectRead(d, meta)
+}
+
+func resourceAwsCodeBuildProjectDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codebuildconn
+
+	log.Printf("[DEBUG] Deleting CodeBuild project %s", d.Id())
+	_, err := conn.DeleteProject(&codebuild.DeleteProjectInput{
+		Name: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting CodeBuild project %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandCodeBuildArtifacts(l []interface{}) *codebuild.ProjectArtifacts {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	m := l[0].(map[string]interface{})
+
+	artifacts := &codebuild.ProjectArtifacts{
+		Type: aws.String(m["type"].(string)),
+	}
+	if v, ok := m["location"].(string); ok && v != "" {
+		artifacts.Location = aws.String(v)
+	}
+	if v, ok := m["name"].(string); ok && v != "" {
+		artifacts.Name = aws.String(v)
+	}
+	if v, ok := m["namespace_type"].(string); ok && v != "" {
+		artifacts.NamespaceType = aws.String(v)
+	}
+	if v, ok := m["packaging"].(string); ok && v != "" {
+		artifacts.Packaging = aws.String(v)
+	}
+	if v, ok := m["path"].(string); ok && v != "" {
+		artifacts.Path = aws.String(v)
+	}
+
+	return artifacts
+}
+
+func flattenCodeBuildArtifacts(artifacts *codebuild.ProjectArtifacts) []interface{} {
+	if artifacts == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"type":           aws.StringValue(artifacts.Type),
+		"location":       aws.StringValue(artifacts.Location),
+		"name":           aws.StringValue(artifacts.Name),
+		"namespace_type": aws.StringValue(artifacts.NamespaceType),
+		"packaging":      aws.StringValue(artifacts.Packaging),
+		"path":           aws.StringValue(artifacts.Path),
+	}
+
+	return []interface{}{m}
+}
+
+func expandCodeBuildEnvironment(l []interface{}) *codebuild.ProjectEnvironment {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	m := l[0].(map[string]interface{})
+
+	environment := &codebuild.ProjectEnvironment{
+		ComputeType:    aws.String(m["compute_type"].(string)),
+		Image:          aws.String(m["image"].(string)),
+		Type:           aws.String(m["type"].(string)),
+		PrivilegedMode: aws.Bool(m["privileged_mode"].(bool)),
+	}
+
+	variables := make([]*codebuild.EnvironmentVariable, 0)
+	for _, raw := range m["environment_variable"].([]interface{}) {
+		v := raw.(map[string]interface{})
+		variables = append(variables, &codebuild.EnvironmentVariable{
+			Name:  aws.String(v["name"].(string)),
+			Value: aws.String(v["value"].(string)),
+			Type:  aws.String(v["type"].(string)),
+		})
+	}
+	environment.EnvironmentVariables = variables
+
+	return environment
+}
+
+func flattenCodeBuildEnvironment(environment *codebuild.ProjectEnvironment) []interface{} {
+	if environment == nil {
+		return []interface{}{}
+	}
+
+	variables := make([]interface{}, 0, len(environment.EnvironmentVariables))
+	for _, v := range environment.EnvironmentVariables {
+		variables = append(variables, map[string]interface{}{
+			"name":  aws.StringValue(v.Name),
+			"value": aws.StringValue(v.Value),
+			"type":  aws.StringValue(v.Type),
+		})
+	}
+
+	m := map[string]interface{}{
+		"compute_type":         aws.StringValue(environment.ComputeType),
+		"image":                aws.StringValue(environment.Image),
+		"type":                 aws.StringValue(environment.Type),
+		"privileged_mode":      aws.BoolValue(environment.PrivilegedMode),
+		"environment_variable": variables,
+	}
+
+	return []interface{}{m}
+}
+
+func expandCodeBuildSource(l []interface{}) *codebuild.ProjectSource {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	m := l[0].(map[string]interface{})
+
+	source := &codebuild.ProjectSource{
+		Type: aws.String(m["type"].(string)),
+	}
+	if v, ok := m["location"].(string); ok && v != "" {
+		source.Location = aws.String(v)
+	}
+	if v, ok := m["buildspec"].(string); ok && v != "" {
+		source.Buildspec = aws.String(v)
+	}
+	if v, ok := m["git_clone_depth"].(int); ok && v > 0 {
+		source.GitCloneDepth = aws.Int64(int64(v))
+	}
+	if v, ok := m["insecure_ssl"].(bool); ok && v {
+		source.InsecureSsl = aws.Bool(v)
+	}
+	if v, ok := m["auth"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		auth := v[0].(map[string]interface{})
+		source.Auth = &codebuild.SourceAuth{
+			Type: aws.String(auth["type"].(string)),
+		}
+		if r, ok := auth["resource"].(string); ok && r != "" {
+			source.Auth.Resource = aws.String(r)
+		}
+	}
+
+	return source
+}
+
+func flattenCodeBuildSource(source *codebuild.ProjectSource) []interface{} {
+	if source == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"type":            aws.StringValue(source.Type),
+		"location":        aws.StringValue(source.Location),
+		"buildspec":       aws.StringValue(source.Buildspec),
+		"git_clone_depth": int(aws.Int64Value(source.GitCloneDepth)),
+		"insecure_ssl":    aws.BoolValue(source.InsecureSsl),
+	}
+
+	if source.Auth != nil {
+		m["auth"] = []interface{}{
+			map[string]interface{}{
+				"type":     aws.StringValue(source.Auth.Type),
+				"resource": aws.StringValue(source.Auth.Resource),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
+func expandCodeBuildVpcConfig(l []interface{}) *codebuild.VpcConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	m := l[0].(map[string]interface{})
+
+	return &codebuild.VpcConfig{
+		VpcId:            aws.String(m["vpc_id"].(string)),
+		Subnets:          expandStringList(m["subnets"].(*schema.Set).List()),
+		SecurityGroupIds: expandStringList(m["security_group_ids"].(*schema.Set).List()),
+	}
+}
+
+func flattenCodeBuildVpcConfig(vpcConfig *codebuild.VpcConfig) []interface{} {
+	if vpcConfig == nil || aws.StringValue(vpcConfig.VpcId) == "" {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"vpc_id":             aws.StringValue(vpcConfig.VpcId),
+		"subnets":            schema.NewSet(schema.HashString, flattenStringList(vpcConfig.Subnets)),
+		"security_group_ids": schema.NewSet(schema.HashString, flattenStringList(vpcConfig.SecurityGroupIds)),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,310 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCodeBuildProject_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-test-codebuild-%s", acctest.RandString(8))
+	resourceName := "aws_codebuild_project.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeBuildProjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSCodeBuildProjectConfig_basic(name, "test description", 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCodeBuildProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "build_timeout", "5"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.type", "GITHUB"),
+					resource.TestCheckResourceAttr(resourceName, "environment.0.environment_variable.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Environment", "Test"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSCodeBuildProjectConfig_basic(name, "updated description", 50),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCodeBuildProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated description"),
+					resource.TestCheckResourceAttr(resourceName, "build_timeout", "50"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCodeBuildProject_badge(t *testing.T) {
+	name := fmt.Sprintf("tf-test-codebuild-%s", acctest.RandString(8))
+	resourceName := "aws_codebuild_project.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeBuildProjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSCodeBuildProjectConfig_badge(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCodeBuildProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "badge_enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "badge_url"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCodeBuildProject_vpcConfig(t *testing.T) {
+	name := fmt.Sprintf("tf-test-codebuild-%s", acctest.RandString(8))
+	resourceName := "aws_codebuild_project.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeBuildProjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSCodeBuildProjectConfig_vpcConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCodeBuildProjectExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "vpc_config.0.vpc_id"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.subnets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.security_group_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCodeBuildProjectExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CodeBuild project ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).codebuildconn
+		out, err := conn.BatchGetProjects(&codebuild.BatchGetProjectsInput{
+			Names: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(out.Projects) == 0 {
+			return fmt.Errorf("CodeBuild project %q not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCodeBuildProjectDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).codebuildconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_codebuild_project" {
+			continue
+		}
+
+		out, err := conn.BatchGetProjects(&codebuild.BatchGetProjectsInput{
+			Names: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(out.Projects) != 0 {
+			return fmt.Errorf("CodeBuild project %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSCodeBuildProjectConfig_role(name string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "codebuild_role" {
+  name = "%s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "codebuild.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "codebuild_policy" {
+  name = "%s"
+  role = "${aws_iam_role.codebuild_role.id}"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Resource": ["*"],
+      "Action": [
+        "logs:CreateLogGroup",
+        "logs:CreateLogStream",
+        "logs:PutLogEvents",
+        "ec2:CreateNetworkInterface",
+        "ec2:DescribeDhcpOptions",
+        "ec2:DescribeNetworkInterfaces",
+        "ec2:DeleteNetworkInterface",
+        "ec2:DescribeSubnets",
+        "ec2:DescribeSecurityGroups",
+        "ec2:DescribeVpcs",
+        "ec2:CreateNetworkInterfacePermission"
+      ]
+    }
+  ]
+}
+POLICY
+}
+`, name, name)
+}
+
+func testAccAWSCodeBuildProjectConfig_basic(name, description string, timeout int) string {
+	return testAccAWSCodeBuildProjectConfig_role(name) + fmt.Sprintf(`
+resource "aws_codebuild_project" "foo" {
+  name          = "%s"
+  description   = "%s"
+  build_timeout = %d
+  service_role  = "${aws_iam_role.codebuild_role.arn}"
+
+  artifacts {
+    type = "NO_ARTIFACTS"
+  }
+
+  environment {
+    compute_type = "BUILD_GENERAL1_SMALL"
+    image        = "aws/codebuild/nodejs:6.3.1"
+    type         = "LINUX_CONTAINER"
+
+    environment_variable {
+      name  = "SOME_KEY"
+      value = "SOME_VALUE"
+    }
+
+    environment_variable {
+      name  = "SOME_PARAMETER"
+      value = "/some/parameter"
+      type  = "PARAMETER_STORE"
+    }
+  }
+
+  source {
+    type     = "GITHUB"
+    location = "https://github.com/hashicorp/packer.git"
+  }
+
+  tags {
+    Environment = "Test"
+  }
+}
+`, name, description, timeout)
+}
+
+func testAccAWSCodeBuildProjectConfig_badge(name string) string {
+	return testAccAWSCodeBuildProjectConfig_role(name) + fmt.Sprintf(`
+resource "aws_codebuild_project" "foo" {
+  name          = "%s"
+  service_role  = "${aws_iam_role.codebuild_role.arn}"
+  badge_enabled = true
+
+  artifacts {
+    type = "NO_ARTIFACTS"
+  }
+
+  environment {
+    compute_type = "BUILD_GENERAL1_SMALL"
+    image        = "aws/codebuild/nodejs:6.3.1"
+    type         = "LINUX_CONTAINER"
+  }
+
+  source {
+    type     = "GITHUB"
+    location = "https://github.com/hashicorp/packer.git"
+  }
+}
+`, name)
+}
+
+func testAccAWSCodeBuildProjectConfig_vpcConfig(name string) string {
+	return testAccAWSCodeBuildProjectConfig_role(name) + fmt.Sprintf(`
+resource "aws_vpc" "codebuild_vpc" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-codebuild-project-vpc"
+  }
+}
+
+resource "aws_subnet" "codebuild_subnet" {
+  vpc_id     = "${aws_vpc.codebuild_vpc.id}"
+  cidr_block = "10.0.0.0/24"
+}
+
+resource "aws_security_group" "codebuild_security_group" {
+  vpc_id = "${aws_vpc.codebuild_vpc.id}"
+}
+
+resource "aws_codebuild_project" "foo" {
+  name         = "%s"
+  service_role = "${aws_iam_role.codebuild_role.arn}"
+
+  artifacts {
+    type = "NO_ARTIFACTS"
+  }
+
+  environment {
+    compute_type = "BUILD_GENERAL1_SMALL"
+    image        = "aws/codebuild/nodejs:6.3.1"
+    type         = "LINUX_CONTAINER"
+  }
+
+  source {
+    type     = "GITHUB"
+    location = "https://github.com/hashicorp/packer.git"
+  }
+
+  vpc_config {
+    vpc_id             = "${aws_vpc.codebuild_vpc.id}"
+    subnets            = ["${aws_subnet.codebuild_subnet.id}"]
+    security_group_ids = ["${aws_security_group.codebuild_security_group.id}"]
+  }
+}
+`, name)
+}
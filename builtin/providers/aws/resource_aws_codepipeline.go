@@ -0,0 +1,443 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsCodePipeline() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCodePipelineCreate,
+		Read:   resourceAwsCodePipelineRead,
+		Update: resourceAwsCodePipelineUpdate,
+		Delete: resourceAwsCodePipelineDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"role_arn": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"artifact_store": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAwsCodePipelineArtifactStoreType,
+						},
+
+						"encryption_key": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateAwsCodePipelineEncryptionKeyType,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"stage": {
+				Type:     schema.TypeList,
+				MinItems: 2,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"action": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"configuration": {
+										Type:     schema.TypeMap,
+										Optional: true,
+									},
+
+									"category": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateAwsCodePipelineActionCategory,
+									},
+
+									"owner": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateAwsCodePipelineActionOwner,
+									},
+
+									"provider": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"version": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"input_artifacts": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"output_artifacts": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"role_arn": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"run_order": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsCodePipelineCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codepipelineconn
+
+	input := &codepipeline.CreatePipelineInput{
+		Pipeline: expandAwsCodePipeline(d),
+	}
+
+	log.Printf("[DEBUG] Creating CodePipeline: %s", input)
+
+	// Retry for IAM eventual consistency: a freshly created role may not
+	// be assumable by CodePipeline yet.
+	var out *codepipeline.CreatePipelineOutput
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		var err error
+		out, err = conn.CreatePipeline(input)
+		if err != nil {
+			if isAWSErr(err, codepipeline.ErrCodeInvalidStructureException, "not authorized") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating CodePipeline: %s", err)
+	}
+
+	d.SetId(aws.StringValue(out.Pipeline.Name))
+	return resourceAwsCodePipelineRead(d, meta)
+}
+
+func resourceAwsCodePipelineRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codepipelineconn
+
+	out, err := conn.GetPipeline(&codepipeline.GetPipelineInput{
+		Name: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, codepipeline.ErrCodePipelineNotFoundException, "") {
+			log.Printf("[WARN] CodePipeline %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading CodePipeline %s: %s", d.Id(), err)
+	}
+	pipeline := out.Pipeline
+
+	if out.Metadata != nil {
+		d.Set("arn", out.Metadata.PipelineArn)
+	}
+	d.Set("name", pipeline.Name)
+	d.Set("role_arn", pipeline.RoleArn)
+
+	if err := d.Set("artifact_store", flattenAwsCodePipelineArtifactStore(pipeline.ArtifactStore)); err != nil {
+		return fmt.Errorf("Error setting artifact_store: %s", err)
+	}
+	if err := d.Set("stage", flattenAwsCodePipelineStages(pipeline.Stages, d)); err != nil {
+		return fmt.Errorf("Error setting stage: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCodePipelineUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codepipelineconn
+
+	input := &codepipeline.UpdatePipelineInput{
+		Pipeline: expandAwsCodePipeline(d),
+	}
+
+	log.Printf("[DEBUG] Updating CodePipeline: %s", input)
+	_, err := conn.UpdatePipeline(input)
+	if err != nil {
+		return fmt.Errorf("Error updating CodePipeline %s: %s", d.Id(), err)
+	}
+
+	return resourceAwsCodePipelineRead(d, meta)
+}
+
+func resourceAwsCodePipelineDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codepipelineconn
+
+	log.Printf("[DEBUG] Deleting CodePipeline %s", d.Id())
+	_, err := conn.DeletePipeline(&codepipeline.DeletePipelineInput{
+		Name: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, codepipeline.ErrCodePipelineNotFoundException, "") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error deleting CodePipeline %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandAwsCodePipeline(d *schema.ResourceData) *codepipeline.PipelineDeclaration {
+	return &codepipeline.PipelineDeclaration{
+		Name:          aws.String(d.Get("name").(string)),
+		RoleArn:       aws.String(d.Get("role_arn").(string)),
+		ArtifactStore: expandAwsCodePipelineArtifactStore(d.Get("artifact_store").([]interface{})),
+		Stages:        expandAwsCodePipelineStages(d.Get("stage").([]interface{})),
+	}
+}
+
+func expandAwsCodePipelineArtifactStore(l []interface{}) *codepipeline.ArtifactStore {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	m := l[0].(map[string]interface{})
+
+	store := &codepipeline.ArtifactStore{
+		Location: aws.String(m["location"].(string)),
+		Type:     aws.String(m["type"].(string)),
+	}
+
+	if v, ok := m["encryption_key"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		key := v[0].(map[string]interface{})
+		store.EncryptionKey = &codepipeline.EncryptionKey{
+			Id:   aws.String(key["id"].(string)),
+			Type: aws.String(key["type"].(string)),
+		}
+	}
+
+	return store
+}
+
+func flattenAwsCodePipelineArtifactStore(store *codepipeline.ArtifactStore) []interface{} {
+	if store == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"location": aws.StringValue(store.Location),
+		"type":     aws.StringValue(store.Type),
+	}
+
+	if store.EncryptionKey != nil {
+		m["encryption_key"] = []interface{}{
+			map[string]interface{}{
+				"id":   aws.StringValue(store.EncryptionKey.Id),
+				"type": aws.StringValue(store.EncryptionKey.Type),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
+func expandAwsCodePipelineStages(l []interface{}) []*codepipeline.StageDeclaration {
+	stages := make([]*codepipeline.StageDeclaration, 0, len(l))
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+		stages = append(stages, &codepipeline.StageDeclaration{
+			Name:    aws.String(m["name"].(string)),
+			Actions: expandAwsCodePipelineActions(m["action"].([]interface{})),
+		})
+	}
+	return stages
+}
+
+func expandAwsCodePipelineActions(l []interface{}) []*codepipeline.ActionDeclaration {
+	actions := make([]*codepipeline.ActionDeclaration, 0, len(l))
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+
+		action := &codepipeline.ActionDeclaration{
+			Name: aws.String(m["name"].(string)),
+			ActionTypeId: &codepipeline.ActionTypeId{
+				Category: aws.String(m["category"].(string)),
+				Owner:    aws.String(m["owner"].(string)),
+				Provider: aws.String(m["provider"].(string)),
+				Version:  aws.String(m["version"].(string)),
+			},
+		}
+
+		if v, ok := m["configuration"].(map[string]interface{}); ok && len(v) > 0 {
+			action.Configuration = stringMapToPointers(v)
+		}
+
+		// GitHub source actions fall back to the token in the environment,
+		// so that it does not need to be written into the configuration.
+		if aws.StringValue(action.ActionTypeId.Provider) == "GitHub" {
+			if _, ok := action.Configuration["OAuthToken"]; !ok {
+				if token := os.Getenv("GITHUB_TOKEN"); token != "" {
+					if action.Configuration == nil {
+						action.Configuration = make(map[string]*string)
+					}
+					action.Configuration["OAuthToken"] = aws.String(token)
+				}
+			}
+		}
+
+		if v, ok := m["input_artifacts"].([]interface{}); ok && len(v) > 0 {
+			for _, name := range v {
+				action.InputArtifacts = append(action.InputArtifacts, &codepipeline.InputArtifact{
+					Name: aws.String(name.(string)),
+				})
+			}
+		}
+
+		if v, ok := m["output_artifacts"].([]interface{}); ok && len(v) > 0 {
+			for _, name := range v {
+				action.OutputArtifacts = append(action.OutputArtifacts, &codepipeline.OutputArtifact{
+					Name: aws.String(name.(string)),
+				})
+			}
+		}
+
+		if v, ok := m["role_arn"].(string); ok && v != "" {
+			action.RoleArn = aws.String(v)
+		}
+
+		if v, ok := m["run_order"].(int); ok && v > 0 {
+			action.RunOrder = aws.Int64(int64(v))
+		}
+
+		actions = append(actions, action)
+	}
+	return actions
+}
+
+func flattenAwsCodePipelineStages(stages []*codepipeline.StageDeclaration, d *schema.ResourceData) []interface{} {
+	result := make([]interface{}, 0, len(stages))
+	for i, stage := range stages {
+		result = append(result, map[string]interface{}{
+			"name":   aws.StringValue(stage.Name),
+			"action": flattenAwsCodePipelineActions(stage.Actions, d, i),
+		})
+	}
+	return result
+}
+
+func flattenAwsCodePipelineActions(actions []*codepipeline.ActionDeclaration, d *schema.ResourceData, stageIndex int) []interface{} {
+	result := make([]interface{}, 0, len(actions))
+	for i, action := range actions {
+		m := map[string]interface{}{
+			"name":      aws.StringValue(action.Name),
+			"category":  aws.StringValue(action.ActionTypeId.Category),
+			"owner":     aws.StringValue(action.ActionTypeId.Owner),
+			"provider":  aws.StringValue(action.ActionTypeId.Provider),
+			"version":   aws.StringValue(action.ActionTypeId.Version),
+			"role_arn":  aws.StringValue(action.RoleArn),
+			"run_order": int(aws.Int64Value(action.RunOrder)),
+		}
+
+		if action.Configuration != nil {
+			config := pointersMapToStringList(action.Configuration)
+
+			// The API masks secrets such as the GitHub OAuthToken, so keep
+			// the configured value (if any) to avoid a perpetual diff.
+			if v, ok := config["OAuthToken"]; ok && v == "****" {
+				key := fmt.Sprintf("stage.%d.action.%d.configuration.OAuthToken", stageIndex, i)
+				if token, ok := d.GetOk(key); ok {
+					config["OAuthToken"] = token.(string)
+				} else {
+					delete(config, "OAuthToken")
+				}
+			}
+
+			m["configuration"] = config
+		}
+
+		inputArtifacts := make([]interface{}, 0, len(action.InputArtifacts))
+		for _, artifact := range action.InputArtifacts {
+			inputArtifacts = append(inputArtifacts, aws.StringValue(artifact.Name))
+		}
+		m["input_artifacts"] = inputArtifacts
+
+		outputArtifacts := make([]interface{}, 0, len(action.OutputArtifacts))
+		for _, artifact := range action.OutputArtifacts {
+			outputArtifacts = append(outputArtifacts, aws.StringValue(artifact.Name))
+		}
+		m["output_artifacts"] = outputArtifacts
+
+		result = append(result, m)
+	}
+	return result
+}
@@ -0,0 +1,111 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsCognitoIdentityPoolRolesAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoIdentityPoolRolesAttachmentCreate,
+		Read:   resourceAwsCognitoIdentityPoolRolesAttachmentRead,
+		Update: resourceAwsCognitoIdentityPoolRolesAttachmentUpdate,
+		Delete: resourceAwsCognitoIdentityPoolRolesAttachmentDelete,
+
+		Schema: map[string]*schema.Schema{
+			"identity_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"roles": {
+				Type:         schema.TypeMap,
+				Required:     true,
+				ValidateFunc: validateCognitoIdentityPoolRoles,
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoIdentityPoolRolesAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoconn
+
+	params := &cognitoidentity.SetIdentityPoolRolesInput{
+		IdentityPoolId: aws.String(d.Get("identity_pool_id").(string)),
+		Roles:          stringMapToPointers(d.Get("roles").(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] Setting Cognito identity pool roles: %s", params)
+	if _, err := conn.SetIdentityPoolRoles(params); err != nil {
+		return fmt.Errorf("Error setting Cognito identity pool roles: %s", err)
+	}
+
+	d.SetId(d.Get("identity_pool_id").(string))
+
+	return resourceAwsCognitoIdentityPoolRolesAttachmentRead(d, meta)
+}
+
+func resourceAwsCognitoIdentityPoolRolesAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoconn
+
+	ip, err := conn.GetIdentityPoolRoles(&cognitoidentity.GetIdentityPoolRolesInput{
+		IdentityPoolId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, cognitoidentity.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Cognito identity pool %s not found, removing roles attachment from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Cognito identity pool roles %s: %s", d.Id(), err)
+	}
+
+	d.Set("identity_pool_id", ip.IdentityPoolId)
+
+	if err := d.Set("roles", pointersMapToStringList(ip.Roles)); err != nil {
+		return fmt.Errorf("Error setting roles: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoIdentityPoolRolesAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoconn
+
+	params := &cognitoidentity.SetIdentityPoolRolesInput{
+		IdentityPoolId: aws.String(d.Id()),
+		Roles:          stringMapToPointers(d.Get("roles").(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] Updating Cognito identity pool roles: %s", params)
+	if _, err := conn.SetIdentityPoolRoles(params); err != nil {
+		return fmt.Errorf("Error updating Cognito identity pool roles %s: %s", d.Id(), err)
+	}
+
+	return resourceAwsCognitoIdentityPoolRolesAttachmentRead(d, meta)
+}
+
+func resourceAwsCognitoIdentityPoolRolesAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoconn
+
+	log.Printf("[DEBUG] Removing Cognito identity pool roles from %s", d.Id())
+	_, err := conn.SetIdentityPoolRoles(&cognitoidentity.SetIdentityPoolRolesInput{
+		IdentityPoolId: aws.String(d.Id()),
+		Roles:          make(map[string]*string),
+	})
+	if err != nil {
+		if isAWSErr(err, cognitoidentity.ErrCodeResourceNotFoundException, "") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error removing Cognito identity pool roles %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,459 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsCognitoUserPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoUserPoolCreate,
+		Read:   resourceAwsCognitoUserPoolRead,
+		Update: resourceAwsCognitoUserPoolUpdate,
+		Delete: resourceAwsCognitoUserPoolDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCognitoUserPoolName,
+			},
+
+			"alias_attributes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"auto_verified_attributes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"email_verification_subject": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"email_verification_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"sms_authentication_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"sms_verification_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"mfa_configuration": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      cognitoidentityprovider.UserPoolMfaTypeOff,
+				ValidateFunc: validateCognitoUserPoolMfaConfiguration,
+			},
+
+			"lambda_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"create_auth_challenge": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
+						"custom_message": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
+						"define_auth_challenge": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
+						"post_authentication": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
+						"post_confirmation": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
+						"pre_authentication": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
+						"pre_sign_up": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
+						"verify_auth_challenge_response": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+
+			"password_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"minimum_length": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      8,
+							ValidateFunc: validateIntegerInRange(6, 99),
+						},
+						"require_lowercase": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"require_numbers": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"require_symbols": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"require_uppercase": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+
+			// The API returns all of the standard attributes along with the
+			// configured ones, so the schema is only sent on creation and
+			// isn't read back.
+			"schema": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute_data_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateCognitoUserPoolAttributeDataType,
+						},
+						"developer_only_attribute": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"mutable": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"number_attribute_constraints": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"min_value": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"max_value": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"required": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"string_attribute_constraints": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"min_length": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"max_length": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	params := &cognitoidentityprovider.CreateUserPoolInput{
+		PoolName:         aws.String(d.Get("name").(string)),
+		MfaConfiguration: aws.String(d.Get("mfa_configuration").(string)),
+	}
+
+	if v, ok := d.GetOk("alias_attributes"); ok {
+		params.AliasAttributes = expandStringList(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("auto_verified_attributes"); ok {
+		params.AutoVerifiedAttributes = expandStringList(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("email_verification_subject"); ok {
+		params.EmailVerificationSubject = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("email_verification_message"); ok {
+		params.EmailVerificationMessage = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sms_authentication_message"); ok {
+		params.SmsAuthenticationMessage = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sms_verification_message"); ok {
+		params.SmsVerificationMessage = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("lambda_config"); ok {
+		configs := v.([]interface{})
+		if config, ok := configs[0].(map[string]interface{}); ok && config != nil {
+			params.LambdaConfig = expandCognitoUserPoolLambdaConfig(config)
+		}
+	}
+
+	if v, ok := d.GetOk("password_policy"); ok {
+		configs := v.([]interface{})
+		if config, ok := configs[0].(map[string]interface{}); ok && config != nil {
+			params.Policies = &cognitoidentityprovider.UserPoolPolicyType{
+				PasswordPolicy: expandCognitoUserPoolPasswordPolicy(config),
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("schema"); ok {
+		params.Schema = expandCognitoUserPoolSchema(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		params.UserPoolTags = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Cognito user pool: %s", params)
+
+	var resp *cognitoidentityprovider.CreateUserPoolOutput
+	// Lambda triggers and IAM roles may not be usable by Cognito straight
+	// after they've been created.
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		var err error
+		resp, err = conn.CreateUserPool(params)
+		if err != nil {
+			if isAWSErr(err, cognitoidentityprovider.ErrCodeInvalidSmsRoleTrustRelationshipException, "") ||
+				isAWSErr(err, cognitoidentityprovider.ErrCodeInvalidLambdaResponseException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating Cognito user pool: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.UserPool.Id))
+
+	return resourceAwsCognitoUserPoolRead(d, meta)
+}
+
+func resourceAwsCognitoUserPoolRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	resp, err := conn.DescribeUserPool(&cognitoidentityprovider.DescribeUserPoolInput{
+		UserPoolId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Cognito user pool %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Cognito user pool %s: %s", d.Id(), err)
+	}
+
+	pool := resp.UserPool
+
+	d.Set("name", pool.Name)
+	d.Set("arn", pool.Arn)
+	d.Set("alias_attributes", flattenStringList(pool.AliasAttributes))
+	d.Set("auto_verified_attributes", flattenStringList(pool.AutoVerifiedAttributes))
+	d.Set("email_verification_subject", pool.EmailVerificationSubject)
+	d.Set("email_verification_message", pool.EmailVerificationMessage)
+	d.Set("sms_authentication_message", pool.SmsAuthenticationMessage)
+	d.Set("sms_verification_message", pool.SmsVerificationMessage)
+	d.Set("mfa_configuration", pool.MfaConfiguration)
+	d.Set("tags", pointersMapToStringList(pool.UserPoolTags))
+
+	if pool.CreationDate != nil {
+		d.Set("creation_date", pool.CreationDate.Format(time.RFC3339))
+	}
+	if pool.LastModifiedDate != nil {
+		d.Set("last_modified_date", pool.LastModifiedDate.Format(time.RFC3339))
+	}
+
+	if pool.LambdaConfig != nil {
+		if err := d.Set("lambda_config", flattenCognitoUserPoolLambdaConfig(pool.LambdaConfig)); err != nil {
+			return fmt.Errorf("Error setting lambda_config: %s", err)
+		}
+	}
+
+	if pool.Policies != nil && pool.Policies.PasswordPolicy != nil {
+		if err := d.Set("password_policy", flattenCognitoUserPoolPasswordPolicy(pool.Policies.PasswordPolicy)); err != nil {
+			return fmt.Errorf("Error setting password_policy: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	// Any setting left out of an update is reset to its default, so
+	// everything is sent every time.
+	params := &cognitoidentityprovider.UpdateUserPoolInput{
+		UserPoolId:       aws.String(d.Id()),
+		MfaConfiguration: aws.String(d.Get("mfa_configuration").(string)),
+		LambdaConfig:     &cognitoidentityprovider.LambdaConfigType{},
+	}
+
+	if v, ok := d.GetOk("auto_verified_attributes"); ok {
+		params.AutoVerifiedAttributes = expandStringList(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("email_verification_subject"); ok {
+		params.EmailVerificationSubject = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("email_verification_message"); ok {
+		params.EmailVerificationMessage = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sms_authentication_message"); ok {
+		params.SmsAuthenticationMessage = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sms_verification_message"); ok {
+		params.SmsVerificationMessage = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("lambda_config"); ok {
+		configs := v.([]interface{})
+		if config, ok := configs[0].(map[string]interface{}); ok && config != nil {
+			params.LambdaConfig = expandCognitoUserPoolLambdaConfig(config)
+		}
+	}
+
+	if v, ok := d.GetOk("password_policy"); ok {
+		configs := v.([]interface{})
+		if config, ok := configs[0].(map[string]interface{}); ok && config != nil {
+			params.Policies = &cognitoidentityprovider.UserPoolPolicyType{
+				PasswordPolicy: expandCognitoUserPoolPasswordPolicy(config),
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		params.UserPoolTags = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating Cognito user pool: %s", params)
+	if _, err := conn.UpdateUserPool(params); err != nil {
+		return fmt.Errorf("Error updating Cognito user pool %s: %s", d.Id(), err)
+	}
+
+	return resourceAwsCognitoUserPoolRead(d, meta)
+}
+
+func resourceAwsCognitoUserPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	log.Printf("[DEBUG] Deleting Cognito user pool %s", d.Id())
+	_, err := conn.DeleteUserPool(&cognitoidentityprovider.DeleteUserPoolInput{
+		UserPoolId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting Cognito user pool %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,197 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsCognitoUserPoolClient() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoUserPoolClientCreate,
+		Read:   resourceAwsCognitoUserPoolClientRead,
+		Update: resourceAwsCognitoUserPoolClientUpdate,
+		Delete: resourceAwsCognitoUserPoolClientDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCognitoUserPoolClientImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"user_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"generate_secret": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"client_secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"explicit_auth_flows": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateCognitoUserPoolClientAuthFlow,
+				},
+				Set: schema.HashString,
+			},
+
+			"read_attributes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"write_attributes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"refresh_token_validity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validateIntegerInRange(0, 3650),
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoUserPoolClientCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	params := &cognitoidentityprovider.CreateUserPoolClientInput{
+		ClientName:           aws.String(d.Get("name").(string)),
+		UserPoolId:           aws.String(d.Get("user_pool_id").(string)),
+		GenerateSecret:       aws.Bool(d.Get("generate_secret").(bool)),
+		RefreshTokenValidity: aws.Int64(int64(d.Get("refresh_token_validity").(int))),
+	}
+
+	if v, ok := d.GetOk("explicit_auth_flows"); ok {
+		params.ExplicitAuthFlows = expandStringList(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("read_attributes"); ok {
+		params.ReadAttributes = expandStringList(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("write_attributes"); ok {
+		params.WriteAttributes = expandStringList(v.(*schema.Set).List())
+	}
+
+	log.Printf("[DEBUG] Creating Cognito user pool client: %s", params)
+	resp, err := conn.CreateUserPoolClient(params)
+	if err != nil {
+		return fmt.Errorf("Error creating Cognito user pool client: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.UserPoolClient.ClientId))
+
+	return resourceAwsCognitoUserPoolClientRead(d, meta)
+}
+
+func resourceAwsCognitoUserPoolClientRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	resp, err := conn.DescribeUserPoolClient(&cognitoidentityprovider.DescribeUserPoolClientInput{
+		ClientId:   aws.String(d.Id()),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	})
+	if err != nil {
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Cognito user pool client %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Cognito user pool client %s: %s", d.Id(), err)
+	}
+
+	client := resp.UserPoolClient
+
+	d.Set("name", client.ClientName)
+	d.Set("user_pool_id", client.UserPoolId)
+	d.Set("client_secret", client.ClientSecret)
+	d.Set("generate_secret", client.ClientSecret != nil)
+	d.Set("explicit_auth_flows", flattenStringList(client.ExplicitAuthFlows))
+	d.Set("read_attributes", flattenStringList(client.ReadAttributes))
+	d.Set("write_attributes", flattenStringList(client.WriteAttributes))
+	d.Set("refresh_token_validity", client.RefreshTokenValidity)
+
+	return nil
+}
+
+func resourceAwsCognitoUserPoolClientUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	// Any setting left out of an update is reset to its default, so
+	// everything is sent every time.
+	params := &cognitoidentityprovider.UpdateUserPoolClientInput{
+		ClientId:             aws.String(d.Id()),
+		ClientName:           aws.String(d.Get("name").(string)),
+		UserPoolId:           aws.String(d.Get("user_pool_id").(string)),
+		RefreshTokenValidity: aws.Int64(int64(d.Get("refresh_token_validity").(int))),
+		ExplicitAuthFlows:    expandStringList(d.Get("explicit_auth_flows").(*schema.Set).List()),
+		ReadAttributes:       expandStringList(d.Get("read_attributes").(*schema.Set).List()),
+		WriteAttributes:      expandStringList(d.Get("write_attributes").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] Updating Cognito user pool client: %s", params)
+	if _, err := conn.UpdateUserPoolClient(params); err != nil {
+		return fmt.Errorf("Error updating Cognito user pool client %s: %s", d.Id(), err)
+	}
+
+	return resourceAwsCognitoUserPoolClientRead(d, meta)
+}
+
+func resourceAwsCognitoUserPoolClientDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	log.Printf("[DEBUG] Deleting Cognito user pool client %s", d.Id())
+	_, err := conn.DeleteUserPoolClient(&cognitoidentityprovider.DeleteUserPoolClientInput{
+		ClientId:   aws.String(d.Id()),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting Cognito user pool client %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Clients can only be looked up within their user pool, so they are
+// imported using "USER_POOL_ID/CLIENT_ID".
+func resourceAwsCognitoUserPoolClientImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Wrong format of resource: %s. Please follow 'user-pool-id/client-id'", d.Id())
+	}
+
+	d.Set("user_pool_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,103 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCognitoUserPoolClient_basic(t *testing.T) {
+	name := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolClientDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSCognitoUserPoolClientConfig_basic(name, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolClientExists("aws_cognito_user_pool_client.client"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool_client.client", "name", "client-"+name),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool_client.client", "explicit_auth_flows.#", "1"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool_client.client", "refresh_token_validity", "30"),
+					resource.TestCheckResourceAttrSet("aws_cognito_user_pool_client.client", "client_secret"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSCognitoUserPoolClientConfig_basic(name, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolClientExists("aws_cognito_user_pool_client.client"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool_client.client", "refresh_token_validity", "60"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCognitoUserPoolClientExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cognito user pool client ID set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+		_, err := conn.DescribeUserPoolClient(&cognitoidentityprovider.DescribeUserPoolClientInput{
+			ClientId:   aws.String(rs.Primary.ID),
+			UserPoolId: aws.String(rs.Primary.Attributes["user_pool_id"]),
+		})
+		return err
+	}
+}
+
+func testAccCheckAWSCognitoUserPoolClientDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cognito_user_pool_client" {
+			continue
+		}
+
+		_, err := conn.DescribeUserPoolClient(&cognitoidentityprovider.DescribeUserPoolClientInput{
+			ClientId:   aws.String(rs.Primary.ID),
+			UserPoolId: aws.String(rs.Primary.Attributes["user_pool_id"]),
+		})
+		if err == nil {
+			return fmt.Errorf("Cognito user pool client %s still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSCognitoUserPoolClientConfig_basic(name string, refreshTokenValidity int) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "pool" {
+  name = "pool-%s"
+}
+
+resource "aws_cognito_user_pool_client" "client" {
+  name         = "client-%s"
+  user_pool_id = "${aws_cognito_user_pool.pool.id}"
+
+  generate_secret        = true
+  explicit_auth_flows    = ["ADMIN_NO_SRP_AUTH"]
+  read_attributes        = ["email"]
+  write_attributes       = ["email"]
+  refresh_token_validity = %d
+}
+`, name, name, refreshTokenValidity)
+}
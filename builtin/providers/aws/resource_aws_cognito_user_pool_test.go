@@ -0,0 +1,273 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCognitoUserPool_basic(t *testing.T) {
+	name := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSCognitoUserPoolConfig_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolExists("aws_cognito_user_pool.pool"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "name", "terraform-test-pool-"+name),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "mfa_configuration", "OFF"),
+					resource.TestCheckResourceAttrSet("aws_cognito_user_pool.pool", "arn"),
+					resource.TestCheckResourceAttrSet("aws_cognito_user_pool.pool", "creation_date"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUserPool_importBasic(t *testing.T) {
+	resourceName := "aws_cognito_user_pool.pool"
+	name := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSCognitoUserPoolConfig_basic(name),
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUserPool_passwordPolicy(t *testing.T) {
+	name := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSCognitoUserPoolConfig_passwordPolicy(name, 7, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolExists("aws_cognito_user_pool.pool"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "password_policy.#", "1"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "password_policy.0.minimum_length", "7"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "password_policy.0.require_lowercase", "true"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "password_policy.0.require_symbols", "true"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSCognitoUserPoolConfig_passwordPolicy(name, 9, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolExists("aws_cognito_user_pool.pool"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "password_policy.0.minimum_length", "9"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "password_policy.0.require_symbols", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUserPool_withLambdaConfig(t *testing.T) {
+	name := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSCognitoUserPoolConfig_withLambdaConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolExists("aws_cognito_user_pool.pool"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "lambda_config.#", "1"),
+					resource.TestCheckResourceAttrSet("aws_cognito_user_pool.pool", "lambda_config.0.pre_sign_up"),
+					resource.TestCheckResourceAttrSet("aws_cognito_user_pool.pool", "lambda_config.0.post_confirmation"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUserPool_withSchemaAttributes(t *testing.T) {
+	name := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSCognitoUserPoolConfig_withSchemaAttributes(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolExists("aws_cognito_user_pool.pool"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "schema.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCognitoUserPoolExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cognito user pool ID set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+		_, err := conn.DescribeUserPool(&cognitoidentityprovider.DescribeUserPoolInput{
+			UserPoolId: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
+func testAccCheckAWSCognitoUserPoolDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cognito_user_pool" {
+			continue
+		}
+
+		_, err := conn.DescribeUserPool(&cognitoidentityprovider.DescribeUserPoolInput{
+			UserPoolId: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("Cognito user pool %s still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSCognitoUserPoolConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "pool" {
+  name = "terraform-test-pool-%s"
+}
+`, name)
+}
+
+func testAccAWSCognitoUserPoolConfig_passwordPolicy(name string, minLength int, requireSymbols bool) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "pool" {
+  name = "terraform-test-pool-%s"
+
+  password_policy {
+    minimum_length    = %d
+    require_lowercase = true
+    require_numbers   = false
+    require_symbols   = %t
+    require_uppercase = false
+  }
+}
+`, name, minLength, requireSymbols)
+}
+
+func testAccAWSCognitoUserPoolConfig_withLambdaConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "main" {
+  name = "terraform-test-pool-%s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_lambda_function" "main" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = "terraform-test-pool-%s"
+  role          = "${aws_iam_role.main.arn}"
+  handler       = "exports.example"
+  runtime       = "nodejs4.3"
+}
+
+resource "aws_lambda_permission" "main" {
+  action        = "lambda:InvokeFunction"
+  function_name = "${aws_lambda_function.main.function_name}"
+  principal     = "cognito-idp.amazonaws.com"
+}
+
+resource "aws_cognito_user_pool" "pool" {
+  name = "terraform-test-pool-%s"
+
+  lambda_config {
+    pre_sign_up       = "${aws_lambda_function.main.arn}"
+    post_confirmation = "${aws_lambda_function.main.arn}"
+  }
+
+  depends_on = ["aws_lambda_permission.main"]
+}
+`, name, name, name)
+}
+
+func testAccAWSCognitoUserPoolConfig_withSchemaAttributes(name string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "pool" {
+  name = "terraform-test-pool-%s"
+
+  schema {
+    attribute_data_type      = "String"
+    developer_only_attribute = false
+    mutable                  = false
+    name                     = "email"
+    required                 = true
+
+    string_attribute_constraints {
+      min_length = 7
+      max_length = 15
+    }
+  }
+
+  schema {
+    attribute_data_type      = "Number"
+    developer_only_attribute = true
+    mutable                  = true
+    name                     = "mynumber"
+    required                 = false
+
+    number_attribute_constraints {
+      min_value = 2
+      max_value = 6
+    }
+  }
+}
+`, name)
+}
@@ -0,0 +1,338 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsConfigConfigRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsConfigConfigRulePut,
+		Read:   resourceAwsConfigConfigRuleRead,
+		Update: resourceAwsConfigConfigRulePut,
+		Delete: resourceAwsConfigConfigRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateMaxLength(64),
+			},
+			"rule_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMaxLength(256),
+			},
+			"input_parameters": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+			"maximum_execution_frequency": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(configMaximumExecutionFrequencies, false),
+			},
+			"scope": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compliance_resource_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"compliance_resource_types": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 100,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"tag_key": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"tag_value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"owner": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								configservice.OwnerAws,
+								configservice.OwnerCustomLambda,
+							}, false),
+						},
+						"source_identifier": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"source_detail": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 25,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"event_source": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  configservice.EventSourceAwsConfig,
+									},
+									"maximum_execution_frequency": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(configMaximumExecutionFrequencies, false),
+									},
+									"message_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											configservice.MessageTypeConfigurationItemChangeNotification,
+											configservice.MessageTypeConfigurationSnapshotDeliveryCompleted,
+											configservice.MessageTypeScheduledNotification,
+											configservice.MessageTypeOversizedConfigurationItemChangeNotification,
+										}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsConfigConfigRulePut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	name := d.Get("name").(string)
+	rule := &configservice.ConfigRule{
+		ConfigRuleName: aws.String(name),
+		Source:         expandConfigRuleSource(d.Get("source").([]interface{})),
+		Scope:          expandConfigRuleScope(d.Get("scope").([]interface{})),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		rule.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("input_parameters"); ok {
+		rule.InputParameters = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("maximum_execution_frequency"); ok {
+		rule.MaximumExecutionFrequency = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Putting AWS Config Rule: %s", rule)
+
+	// The permission that allows Config to invoke the Lambda function of
+	// a custom rule may not have propagated yet.
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.PutConfigRule(&configservice.PutConfigRuleInput{
+			ConfigRule: rule,
+		})
+		if isAWSErr(err, configservice.ErrCodeInsufficientPermissionsException, "") {
+			log.Printf("[DEBUG] Retrying AWS Config Rule put: %s", err)
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error putting AWS Config Rule %q: %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsConfigConfigRuleRead(d, meta)
+}
+
+func resourceAwsConfigConfigRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	out, err := conn.DescribeConfigRules(&configservice.DescribeConfigRulesInput{
+		ConfigRuleNames: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		if isAWSErr(err, configservice.ErrCodeNoSuchConfigRuleException, "") {
+			log.Printf("[WARN] AWS Config Rule (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading AWS Config Rule %q: %s", d.Id(), err)
+	}
+
+	if len(out.ConfigRules) != 1 ||
+		aws.StringValue(out.ConfigRules[0].ConfigRuleState) == configservice.ConfigRuleStateDeleting {
+		log.Printf("[WARN] AWS Config Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	rule := out.ConfigRules[0]
+	d.Set("name", rule.ConfigRuleName)
+	d.Set("rule_id", rule.ConfigRuleId)
+	d.Set("arn", rule.ConfigRuleArn)
+	d.Set("description", rule.Description)
+	d.Set("input_parameters", rule.InputParameters)
+	d.Set("maximum_execution_frequency", rule.MaximumExecutionFrequency)
+
+	if err := d.Set("scope", flattenConfigRuleScope(rule.Scope)); err != nil {
+		return fmt.Errorf("Error setting scope: %s", err)
+	}
+	if err := d.Set("source", flattenConfigRuleSource(rule.Source)); err != nil {
+		return fmt.Errorf("Error setting source: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsConfigConfigRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	// A rule can't be deleted while it is being evaluated.
+	log.Printf("[DEBUG] Deleting AWS Config Rule: %s", d.Id())
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteConfigRule(&configservice.DeleteConfigRuleInput{
+			ConfigRuleName: aws.String(d.Id()),
+		})
+		if isAWSErr(err, configservice.ErrCodeResourceInUseException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil && !isAWSErr(err, configservice.ErrCodeNoSuchConfigRuleException, "") {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting AWS Config Rule %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandConfigRuleScope(configured []interface{}) *configservice.Scope {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	m := configured[0].(map[string]interface{})
+	scope := &configservice.Scope{}
+	if v, ok := m["compliance_resource_id"].(string); ok && v != "" {
+		scope.ComplianceResourceId = aws.String(v)
+	}
+	if v, ok := m["compliance_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		scope.ComplianceResourceTypes = expandStringList(v.List())
+	}
+	if v, ok := m["tag_key"].(string); ok && v != "" {
+		scope.TagKey = aws.String(v)
+	}
+	if v, ok := m["tag_value"].(string); ok && v != "" {
+		scope.TagValue = aws.String(v)
+	}
+
+	return scope
+}
+
+func flattenConfigRuleScope(scope *configservice.Scope) []interface{} {
+	if scope == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"compliance_resource_id": aws.StringValue(scope.ComplianceResourceId),
+		"tag_key":                aws.StringValue(scope.TagKey),
+		"tag_value":              aws.StringValue(scope.TagValue),
+	}
+	if len(scope.ComplianceResourceTypes) > 0 {
+		m["compliance_resource_types"] = schema.NewSet(
+			schema.HashString, flattenStringList(scope.ComplianceResourceTypes))
+	}
+
+	return []interface{}{m}
+}
+
+func expandConfigRuleSource(configured []interface{}) *configservice.Source {
+	m := configured[0].(map[string]interface{})
+	source := &configservice.Source{
+		Owner:            aws.String(m["owner"].(string)),
+		SourceIdentifier: aws.String(m["source_identifier"].(string)),
+	}
+
+	if v, ok := m["source_detail"].(*schema.Set); ok && v.Len() > 0 {
+		for _, raw := range v.List() {
+			dm := raw.(map[string]interface{})
+			detail := &configservice.SourceDetail{}
+			if s, ok := dm["event_source"].(string); ok && s != "" {
+				detail.EventSource = aws.String(s)
+			}
+			if s, ok := dm["maximum_execution_frequency"].(string); ok && s != "" {
+				detail.MaximumExecutionFrequency = aws.String(s)
+			}
+			if s, ok := dm["message_type"].(string); ok && s != "" {
+				detail.MessageType = aws.String(s)
+			}
+			source.SourceDetails = append(source.SourceDetails, detail)
+		}
+	}
+
+	return source
+}
+
+func flattenConfigRuleSource(source *configservice.Source) []interface{} {
+	if source == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"owner":             aws.StringValue(source.Owner),
+		"source_identifier": aws.StringValue(source.SourceIdentifier),
+	}
+
+	if len(source.SourceDetails) > 0 {
+		details := make([]interface{}, 0, len(source.SourceDetails))
+		for _, detail := range source.SourceDetails {
+			details = append(details, map[string]interface{}{
+				"event_source":                aws.StringValue(detail.EventSource),
+				"maximum_execution_frequency": aws.StringValue(detail.MaximumExecutionFrequency),
+				"message_type":                aws.StringValue(detail.MessageType),
+			})
+		}
+		m["source_detail"] = details
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,151 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSConfigConfigRule_managed(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_config_config_rule.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigConfigRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfigRuleConfig_managed(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigConfigRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("tf-acc-test-%d", rInt)),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "rule_id"),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.owner", "AWS"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.source_identifier", "REQUIRED_TAGS"),
+					resource.TestCheckResourceAttr(resourceName, "scope.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scope.0.compliance_resource_types.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestConfigRuleSource_roundTrip(t *testing.T) {
+	source := &configservice.Source{
+		Owner:            aws.String(configservice.OwnerCustomLambda),
+		SourceIdentifier: aws.String("arn:aws:lambda:us-east-1:123456789012:function:check"),
+		SourceDetails: []*configservice.SourceDetail{
+			{
+				EventSource: aws.String(configservice.EventSourceAwsConfig),
+				MessageType: aws.String(configservice.MessageTypeConfigurationItemChangeNotification),
+			},
+		},
+	}
+
+	flattened := flattenConfigRuleSource(source)
+	m := flattened[0].(map[string]interface{})
+	if m["owner"] != "CUSTOM_LAMBDA" {
+		t.Fatalf("bad: %#v", m)
+	}
+
+	// The schema holds source_detail as a set.
+	details := resourceAwsConfigConfigRule().Schema["source"].Elem.(*schema.Resource).Schema["source_detail"]
+	m["source_detail"] = schema.NewSet(
+		schema.HashResource(details.Elem.(*schema.Resource)),
+		m["source_detail"].([]interface{}))
+
+	expanded := expandConfigRuleSource(flattened)
+	if !reflect.DeepEqual(expanded, source) {
+		t.Fatalf("bad:\n\nexpected: %s\n\ngot: %s", source, expanded)
+	}
+}
+
+func testAccCheckConfigConfigRuleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).configconn
+		out, err := conn.DescribeConfigRules(&configservice.DescribeConfigRulesInput{
+			ConfigRuleNames: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+		if len(out.ConfigRules) != 1 {
+			return fmt.Errorf("Config Rule %q not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConfigConfigRuleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).configconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_config_config_rule" {
+			continue
+		}
+
+		out, err := conn.DescribeConfigRules(&configservice.DescribeConfigRulesInput{
+			ConfigRuleNames: []*string{aws.String(rs.Primary.ID)},
+		})
+		if isAWSErr(err, configservice.ErrCodeNoSuchConfigRuleException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		for _, rule := range out.ConfigRules {
+			if aws.StringValue(rule.ConfigRuleState) != configservice.ConfigRuleStateDeleting {
+				return fmt.Errorf("Config Rule %q still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccConfigConfigRuleConfig_managed(rInt int) string {
+	return testAccConfigConfigurationRecorderConfig(rInt) + fmt.Sprintf(`
+resource "aws_config_config_rule" "foo" {
+  name        = "tf-acc-test-%d"
+  description = "Instances must have an owner"
+
+  source {
+    owner             = "AWS"
+    source_identifier = "REQUIRED_TAGS"
+  }
+
+  scope {
+    compliance_resource_types = ["AWS::EC2::Instance"]
+  }
+
+  input_parameters = <<PARAMS
+{
+  "tag1Key": "Owner"
+}
+PARAMS
+
+  depends_on = ["aws_config_configuration_recorder.foo"]
+}
+`, rInt)
+}
@@ -0,0 +1,165 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsConfigConfigurationRecorder() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsConfigConfigurationRecorderPut,
+		Read:   resourceAwsConfigConfigurationRecorderRead,
+		Update: resourceAwsConfigConfigurationRecorderPut,
+		Delete: resourceAwsConfigConfigurationRecorderDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "default",
+				ForceNew:     true,
+				ValidateFunc: validateMaxLength(256),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"recording_group": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"all_supported": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"include_global_resource_types": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"resource_types": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsConfigConfigurationRecorderPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	name := d.Get("name").(string)
+	recorder := &configservice.ConfigurationRecorder{
+		Name:    aws.String(name),
+		RoleARN: aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("recording_group"); ok {
+		recorder.RecordingGroup = expandConfigRecordingGroup(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Putting AWS Config Configuration Recorder: %s", recorder)
+	_, err := conn.PutConfigurationRecorder(&configservice.PutConfigurationRecorderInput{
+		ConfigurationRecorder: recorder,
+	})
+	if err != nil {
+		return fmt.Errorf("Error putting AWS Config Configuration Recorder %q: %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsConfigConfigurationRecorderRead(d, meta)
+}
+
+func resourceAwsConfigConfigurationRecorderRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	out, err := conn.DescribeConfigurationRecorders(&configservice.DescribeConfigurationRecordersInput{
+		ConfigurationRecorderNames: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		if isAWSErr(err, configservice.ErrCodeNoSuchConfigurationRecorderException, "") {
+			log.Printf("[WARN] AWS Config Configuration Recorder (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading AWS Config Configuration Recorder %q: %s", d.Id(), err)
+	}
+
+	if len(out.ConfigurationRecorders) != 1 {
+		log.Printf("[WARN] AWS Config Configuration Recorder (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	recorder := out.ConfigurationRecorders[0]
+	d.Set("name", recorder.Name)
+	d.Set("role_arn", recorder.RoleARN)
+
+	if recorder.RecordingGroup != nil {
+		if err := d.Set("recording_group", flattenConfigRecordingGroup(recorder.RecordingGroup)); err != nil {
+			return fmt.Errorf("Error setting recording_group: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsConfigConfigurationRecorderDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	log.Printf("[DEBUG] Deleting AWS Config Configuration Recorder: %s", d.Id())
+	_, err := conn.DeleteConfigurationRecorder(&configservice.DeleteConfigurationRecorderInput{
+		ConfigurationRecorderName: aws.String(d.Id()),
+	})
+	if err != nil && !isAWSErr(err, configservice.ErrCodeNoSuchConfigurationRecorderException, "") {
+		return fmt.Errorf("Error deleting AWS Config Configuration Recorder %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandConfigRecordingGroup(configured []interface{}) *configservice.RecordingGroup {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	group := configured[0].(map[string]interface{})
+	result := &configservice.RecordingGroup{
+		AllSupported:               aws.Bool(group["all_supported"].(bool)),
+		IncludeGlobalResourceTypes: aws.Bool(group["include_global_resource_types"].(bool)),
+	}
+	if v, ok := group["resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		result.ResourceTypes = expandStringList(v.List())
+	}
+
+	return result
+}
+
+func flattenConfigRecordingGroup(group *configservice.RecordingGroup) []interface{} {
+	m := map[string]interface{}{
+		"all_supported":                 aws.BoolValue(group.AllSupported),
+		"include_global_resource_types": aws.BoolValue(group.IncludeGlobalResourceTypes),
+	}
+	if len(group.ResourceTypes) > 0 {
+		m["resource_types"] = schema.NewSet(schema.HashString, flattenStringList(group.ResourceTypes))
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,105 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsConfigConfigurationRecorderStatus() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsConfigConfigurationRecorderStatusPut,
+		Read:   resourceAwsConfigConfigurationRecorderStatusRead,
+		Update: resourceAwsConfigConfigurationRecorderStatusPut,
+		Delete: resourceAwsConfigConfigurationRecorderStatusDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"is_enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAwsConfigConfigurationRecorderStatusPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	name := d.Get("name").(string)
+	d.SetId(name)
+
+	if d.HasChange("is_enabled") {
+		if d.Get("is_enabled").(bool) {
+			log.Printf("[DEBUG] Starting AWS Config Configuration Recorder %q", name)
+			_, err := conn.StartConfigurationRecorder(&configservice.StartConfigurationRecorderInput{
+				ConfigurationRecorderName: aws.String(name),
+			})
+			if err != nil {
+				return fmt.Errorf("Error starting AWS Config Configuration Recorder %q: %s", name, err)
+			}
+		} else {
+			log.Printf("[DEBUG] Stopping AWS Config Configuration Recorder %q", name)
+			_, err := conn.StopConfigurationRecorder(&configservice.StopConfigurationRecorderInput{
+				ConfigurationRecorderName: aws.String(name),
+			})
+			if err != nil {
+				return fmt.Errorf("Error stopping AWS Config Configuration Recorder %q: %s", name, err)
+			}
+		}
+	}
+
+	return resourceAwsConfigConfigurationRecorderStatusRead(d, meta)
+}
+
+func resourceAwsConfigConfigurationRecorderStatusRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	out, err := conn.DescribeConfigurationRecorderStatus(&configservice.DescribeConfigurationRecorderStatusInput{
+		ConfigurationRecorderNames: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		if isAWSErr(err, configservice.ErrCodeNoSuchConfigurationRecorderException, "") {
+			log.Printf("[WARN] AWS Config Configuration Recorder (%s) not found, removing status from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading AWS Config Configuration Recorder Status %q: %s", d.Id(), err)
+	}
+
+	if len(out.ConfigurationRecordersStatus) != 1 {
+		log.Printf("[WARN] AWS Config Configuration Recorder (%s) not found, removing status from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	status := out.ConfigurationRecordersStatus[0]
+	d.Set("name", status.Name)
+	d.Set("is_enabled", status.Recording)
+
+	return nil
+}
+
+func resourceAwsConfigConfigurationRecorderStatusDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	log.Printf("[DEBUG] Stopping AWS Config Configuration Recorder %q", d.Id())
+	_, err := conn.StopConfigurationRecorder(&configservice.StopConfigurationRecorderInput{
+		ConfigurationRecorderName: aws.String(d.Id()),
+	})
+	if err != nil && !isAWSErr(err, configservice.ErrCodeNoSuchConfigurationRecorderException, "") {
+		return fmt.Errorf("Error stopping AWS Config Configuration Recorder %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSConfigConfigurationRecorderStatus_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_config_configuration_recorder_status.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigConfigurationRecorderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfigurationRecorderStatusConfig(rInt, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigConfigurationRecorderRecording(resourceName, true),
+					resource.TestCheckResourceAttr(resourceName, "is_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccConfigConfigurationRecorderStatusConfig(rInt, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigConfigurationRecorderRecording(resourceName, false),
+					resource.TestCheckResourceAttr(resourceName, "is_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConfigConfigurationRecorderRecording(n string, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).configconn
+		out, err := conn.DescribeConfigurationRecorderStatus(&configservice.DescribeConfigurationRecorderStatusInput{
+			ConfigurationRecorderNames: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+		if len(out.ConfigurationRecordersStatus) != 1 {
+			return fmt.Errorf("Configuration Recorder %q not found", rs.Primary.ID)
+		}
+
+		if actual := aws.BoolValue(out.ConfigurationRecordersStatus[0].Recording); actual != expected {
+			return fmt.Errorf("expected recording to be %t, got %t", expected, actual)
+		}
+
+		return nil
+	}
+}
+
+func testAccConfigConfigurationRecorderStatusConfig(rInt int, enabled bool) string {
+	return testAccConfigDeliveryChannelConfig(rInt, "") + fmt.Sprintf(`
+resource "aws_config_configuration_recorder_status" "foo" {
+  name       = "${aws_config_configuration_recorder.foo.name}"
+  is_enabled = %t
+  depends_on = ["aws_config_delivery_channel.foo"]
+}
+`, enabled)
+}
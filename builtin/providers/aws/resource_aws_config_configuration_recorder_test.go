@@ -0,0 +1,176 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSConfigConfigurationRecorder_basic(t *testing.T) {
+	var cr configservice.ConfigurationRecorder
+	rInt := acctest.RandInt()
+	resourceName := "aws_config_configuration_recorder.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigConfigurationRecorderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfigurationRecorderConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigConfigurationRecorderExists(resourceName, &cr),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("tf-acc-test-%d", rInt)),
+					resource.TestCheckResourceAttrSet(resourceName, "role_arn"),
+					resource.TestCheckResourceAttr(resourceName, "recording_group.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recording_group.0.all_supported", "true"),
+				),
+			},
+			{
+				Config: testAccConfigConfigurationRecorderConfig_resourceTypes(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigConfigurationRecorderExists(resourceName, &cr),
+					resource.TestCheckResourceAttr(resourceName, "recording_group.0.all_supported", "false"),
+					resource.TestCheckResourceAttr(resourceName, "recording_group.0.resource_types.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConfigConfigurationRecorderExists(n string, cr *configservice.ConfigurationRecorder) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).configconn
+		out, err := conn.DescribeConfigurationRecorders(&configservice.DescribeConfigurationRecordersInput{
+			ConfigurationRecorderNames: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+		if len(out.ConfigurationRecorders) != 1 {
+			return fmt.Errorf("Configuration Recorder %q not found", rs.Primary.ID)
+		}
+
+		*cr = *out.ConfigurationRecorders[0]
+		return nil
+	}
+}
+
+func testAccCheckConfigConfigurationRecorderDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).configconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_config_configuration_recorder" {
+			continue
+		}
+
+		out, err := conn.DescribeConfigurationRecorders(&configservice.DescribeConfigurationRecordersInput{
+			ConfigurationRecorderNames: []*string{aws.String(rs.Primary.ID)},
+		})
+		if isAWSErr(err, configservice.ErrCodeNoSuchConfigurationRecorderException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if len(out.ConfigurationRecorders) > 0 {
+			return fmt.Errorf("Configuration Recorder %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+// testAccConfigRoleConfig is the IAM role that AWS Config uses to record
+// resources and deliver to the test bucket.
+func testAccConfigRoleConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "r" {
+  name = "tf-acc-test-awsconfig-%d"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "config.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy_attachment" "a" {
+  role       = "${aws_iam_role.r.name}"
+  policy_arn = "arn:aws:iam::aws:policy/service-role/AWSConfigRole"
+}
+
+resource "aws_iam_role_policy" "p" {
+  name = "tf-acc-test-awsconfig-%d"
+  role = "${aws_iam_role.r.id}"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "s3:*",
+      "Effect": "Allow",
+      "Resource": [
+        "${aws_s3_bucket.b.arn}",
+        "${aws_s3_bucket.b.arn}/*"
+      ]
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_s3_bucket" "b" {
+  bucket        = "tf-acc-test-awsconfig-%d"
+  force_destroy = true
+}
+`, rInt, rInt, rInt)
+}
+
+func testAccConfigConfigurationRecorderConfig(rInt int) string {
+	return testAccConfigRoleConfig(rInt) + fmt.Sprintf(`
+resource "aws_config_configuration_recorder" "foo" {
+  name     = "tf-acc-test-%d"
+  role_arn = "${aws_iam_role.r.arn}"
+}
+`, rInt)
+}
+
+func testAccConfigConfigurationRecorderConfig_resourceTypes(rInt int) string {
+	return testAccConfigRoleConfig(rInt) + fmt.Sprintf(`
+resource "aws_config_configuration_recorder" "foo" {
+  name     = "tf-acc-test-%d"
+  role_arn = "${aws_iam_role.r.arn}"
+
+  recording_group {
+    all_supported  = false
+    resource_types = ["AWS::EC2::Instance", "AWS::S3::Bucket"]
+  }
+}
+`, rInt)
+}
@@ -0,0 +1,186 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsConfigDeliveryChannel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsConfigDeliveryChannelPut,
+		Read:   resourceAwsConfigDeliveryChannelRead,
+		Update: resourceAwsConfigDeliveryChannelPut,
+		Delete: resourceAwsConfigDeliveryChannelDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "default",
+				ForceNew:     true,
+				ValidateFunc: validateMaxLength(256),
+			},
+			"s3_bucket_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"s3_key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sns_topic_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"snapshot_delivery_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delivery_frequency": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(configMaximumExecutionFrequencies, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// configMaximumExecutionFrequencies are the valid values for the execution
+// frequency of config rules and the delivery frequency of snapshots.
+var configMaximumExecutionFrequencies = []string{
+	configservice.MaximumExecutionFrequencyOneHour,
+	configservice.MaximumExecutionFrequencyThreeHours,
+	configservice.MaximumExecutionFrequencySixHours,
+	configservice.MaximumExecutionFrequencyTwelveHours,
+	configservice.MaximumExecutionFrequencyTwentyFourHours,
+}
+
+func resourceAwsConfigDeliveryChannelPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	name := d.Get("name").(string)
+	channel := &configservice.DeliveryChannel{
+		Name:         aws.String(name),
+		S3BucketName: aws.String(d.Get("s3_bucket_name").(string)),
+	}
+	if v, ok := d.GetOk("s3_key_prefix"); ok {
+		channel.S3KeyPrefix = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("sns_topic_arn"); ok {
+		channel.SnsTopicARN = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("snapshot_delivery_properties"); ok {
+		l := v.([]interface{})
+		if len(l) > 0 && l[0] != nil {
+			p := l[0].(map[string]interface{})
+			channel.ConfigSnapshotDeliveryProperties = &configservice.ConfigSnapshotDeliveryProperties{}
+			if f, ok := p["delivery_frequency"].(string); ok && f != "" {
+				channel.ConfigSnapshotDeliveryProperties.DeliveryFrequency = aws.String(f)
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Putting AWS Config Delivery Channel: %s", channel)
+
+	// The bucket and topic policies that allow Config to deliver to them
+	// may not have propagated yet if they were just created.
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.PutDeliveryChannel(&configservice.PutDeliveryChannelInput{
+			DeliveryChannel: channel,
+		})
+		if isAWSErr(err, configservice.ErrCodeInsufficientDeliveryPolicyException, "") {
+			log.Printf("[DEBUG] Retrying AWS Config Delivery Channel put: %s", err)
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error putting AWS Config Delivery Channel %q: %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsConfigDeliveryChannelRead(d, meta)
+}
+
+func resourceAwsConfigDeliveryChannelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	out, err := conn.DescribeDeliveryChannels(&configservice.DescribeDeliveryChannelsInput{
+		DeliveryChannelNames: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		if isAWSErr(err, configservice.ErrCodeNoSuchDeliveryChannelException, "") {
+			log.Printf("[WARN] AWS Config Delivery Channel (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading AWS Config Delivery Channel %q: %s", d.Id(), err)
+	}
+
+	if len(out.DeliveryChannels) != 1 {
+		log.Printf("[WARN] AWS Config Delivery Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	channel := out.DeliveryChannels[0]
+	d.Set("name", channel.Name)
+	d.Set("s3_bucket_name", channel.S3BucketName)
+	d.Set("s3_key_prefix", channel.S3KeyPrefix)
+	d.Set("sns_topic_arn", channel.SnsTopicARN)
+
+	if p := channel.ConfigSnapshotDeliveryProperties; p != nil && p.DeliveryFrequency != nil {
+		d.Set("snapshot_delivery_properties", []interface{}{
+			map[string]interface{}{
+				"delivery_frequency": aws.StringValue(p.DeliveryFrequency),
+			},
+		})
+	}
+
+	return nil
+}
+
+func resourceAwsConfigDeliveryChannelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	// The last delivery channel can't be deleted while the recorder is
+	// running, and stopping the recorder takes a moment to be noticed.
+	log.Printf("[DEBUG] Deleting AWS Config Delivery Channel: %s", d.Id())
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteDeliveryChannel(&configservice.DeleteDeliveryChannelInput{
+			DeliveryChannelName: aws.String(d.Id()),
+		})
+		if isAWSErr(err, configservice.ErrCodeLastDeliveryChannelDeleteFailedException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil && !isAWSErr(err, configservice.ErrCodeNoSuchDeliveryChannelException, "") {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting AWS Config Delivery Channel %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
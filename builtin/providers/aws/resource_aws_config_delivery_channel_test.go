@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSConfigDeliveryChannel_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_config_delivery_channel.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigDeliveryChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigDeliveryChannelConfig(rInt, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigDeliveryChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("tf-acc-test-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "s3_bucket_name", fmt.Sprintf("tf-acc-test-awsconfig-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_delivery_properties.#", "0"),
+				),
+			},
+			{
+				Config: testAccConfigDeliveryChannelConfig(rInt, "Six_Hours"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigDeliveryChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "s3_key_prefix", "config"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_delivery_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_delivery_properties.0.delivery_frequency", "Six_Hours"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConfigDeliveryChannelExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).configconn
+		out, err := conn.DescribeDeliveryChannels(&configservice.DescribeDeliveryChannelsInput{
+			DeliveryChannelNames: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+		if len(out.DeliveryChannels) != 1 {
+			return fmt.Errorf("Delivery Channel %q not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConfigDeliveryChannelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).configconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_config_delivery_channel" {
+			continue
+		}
+
+		out, err := conn.DescribeDeliveryChannels(&configservice.DescribeDeliveryChannelsInput{
+			DeliveryChannelNames: []*string{aws.String(rs.Primary.ID)},
+		})
+		if isAWSErr(err, configservice.ErrCodeNoSuchDeliveryChannelException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if len(out.DeliveryChannels) > 0 {
+			return fmt.Errorf("Delivery Channel %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccConfigDeliveryChannelConfig(rInt int, frequency string) string {
+	channel := `
+resource "aws_config_delivery_channel" "foo" {
+  name           = "tf-acc-test-%d"
+  s3_bucket_name = "${aws_s3_bucket.b.bucket}"
+  depends_on     = ["aws_config_configuration_recorder.foo"]
+}
+`
+	if frequency != "" {
+		channel = `
+resource "aws_config_delivery_channel" "foo" {
+  name           = "tf-acc-test-%d"
+  s3_bucket_name = "${aws_s3_bucket.b.bucket}"
+  s3_key_prefix  = "config"
+  depends_on     = ["aws_config_configuration_recorder.foo"]
+
+  snapshot_delivery_properties {
+    delivery_frequency = "` + frequency + `"
+  }
+}
+`
+	}
+
+	return testAccConfigConfigurationRecorderConfig(rInt) + fmt.Sprintf(channel, rInt)
+}
@@ -0,0 +1,516 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDaxCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDaxClusterCreate,
+		Read:   resourceAwsDaxClusterRead,
+		Update: resourceAwsDaxClusterUpdate,
+		Delete: resourceAwsDaxClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(val interface{}) string {
+					return strings.ToLower(val.(string))
+				},
+				ValidateFunc: validateDaxClusterName,
+			},
+
+			"iam_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"node_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"replication_factor": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"availability_zones": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"notification_topic_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"parameter_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"maintenance_window": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				StateFunc: func(val interface{}) string {
+					// DAX always changes the maintenance to lowercase
+					return strings.ToLower(val.(string))
+				},
+			},
+
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"subnet_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"server_side_encryption": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"cluster_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"configuration_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"nodes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsDaxClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).daxconn
+
+	clusterName := d.Get("cluster_name").(string)
+	req := &dax.CreateClusterInput{
+		ClusterName:       aws.String(clusterName),
+		IamRoleArn:        aws.String(d.Get("iam_role_arn").(string)),
+		NodeType:          aws.String(d.Get("node_type").(string)),
+		ReplicationFactor: aws.Int64(int64(d.Get("replication_factor").(int))),
+		Tags:              tagsFromMapDAX(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("availability_zones"); ok {
+		req.AvailabilityZones = expandStringList(v.(*schema.Set).List())
+	}
+	if v, ok := d.GetOk("description"); ok {
+		req.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("notification_topic_arn"); ok {
+		req.NotificationTopicArn = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("parameter_group_name"); ok {
+		req.ParameterGroupName = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("maintenance_window"); ok {
+		req.PreferredMaintenanceWindow = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("security_group_ids"); ok {
+		req.SecurityGroupIds = expandStringList(v.(*schema.Set).List())
+	}
+	if v, ok := d.GetOk("subnet_group_name"); ok {
+		req.SubnetGroupName = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("server_side_encryption"); ok && len(v.([]interface{})) > 0 {
+		if m, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			req.SSESpecification = &dax.SSESpecification{
+				Enabled: aws.Bool(m["enabled"].(bool)),
+			}
+		}
+	}
+
+	// IAM roles take some time to propagate, so retry while DAX
+	// reports that it cannot assume the given role.
+	log.Printf("[DEBUG] Creating DAX cluster: %s", req)
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.CreateCluster(req)
+		if err != nil {
+			if isAWSErr(err, dax.ErrCodeInvalidParameterValueException, "No permission to assume role") {
+				log.Printf("[DEBUG] Retrying creation of DAX cluster %s: %s", clusterName, err)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating DAX cluster: %s", err)
+	}
+
+	// Assign the cluster name as the resource ID. DAX lowercases the
+	// name, so do the same to keep the ID and the API in agreement.
+	d.SetId(strings.ToLower(clusterName))
+
+	if err := waitForDaxClusterAvailable(conn, d.Id(), 40*time.Minute); err != nil {
+		return fmt.Errorf("Error waiting for DAX cluster (%s) to be created: %s", d.Id(), err)
+	}
+
+	return resourceAwsDaxClusterRead(d, meta)
+}
+
+func resourceAwsDaxClusterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).daxconn
+
+	c, err := describeDaxCluster(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading DAX cluster (%s): %s", d.Id(), err)
+	}
+	if c == nil {
+		log.Printf("[WARN] DAX cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", c.ClusterArn)
+	d.Set("cluster_name", c.ClusterName)
+	d.Set("description", c.Description)
+	d.Set("iam_role_arn", c.IamRoleArn)
+	d.Set("node_type", c.NodeType)
+	d.Set("replication_factor", c.TotalNodes)
+	d.Set("maintenance_window", c.PreferredMaintenanceWindow)
+	d.Set("subnet_group_name", c.SubnetGroup)
+
+	if c.ClusterDiscoveryEndpoint != nil {
+		d.Set("port", c.ClusterDiscoveryEndpoint.Port)
+		d.Set("configuration_endpoint", fmt.Sprintf("%s:%d",
+			aws.StringValue(c.ClusterDiscoveryEndpoint.Address), aws.Int64Value(c.ClusterDiscoveryEndpoint.Port)))
+		d.Set("cluster_address", c.ClusterDiscoveryEndpoint.Address)
+	}
+
+	if c.NotificationConfiguration != nil {
+		d.Set("notification_topic_arn", c.NotificationConfiguration.TopicArn)
+	}
+
+	if c.ParameterGroup != nil {
+		d.Set("parameter_group_name", c.ParameterGroup.ParameterGroupName)
+	}
+
+	sgIds := make([]string, 0, len(c.SecurityGroups))
+	for _, sg := range c.SecurityGroups {
+		sgIds = append(sgIds, aws.StringValue(sg.SecurityGroupIdentifier))
+	}
+	if err := d.Set("security_group_ids", sgIds); err != nil {
+		return fmt.Errorf("Error setting security_group_ids for DAX cluster (%s): %s", d.Id(), err)
+	}
+
+	sse := []map[string]interface{}{}
+	if c.SSEDescription != nil {
+		sse = append(sse, map[string]interface{}{
+			"enabled": aws.StringValue(c.SSEDescription.Status) == dax.SSEStatusEnabled,
+		})
+	}
+	if err := d.Set("server_side_encryption", sse); err != nil {
+		return fmt.Errorf("Error setting server_side_encryption for DAX cluster (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("nodes", flattenDaxNodes(c.Nodes)); err != nil {
+		return fmt.Errorf("Error setting nodes for DAX cluster (%s): %s", d.Id(), err)
+	}
+
+	resp, err := conn.ListTags(&dax.ListTagsInput{
+		ResourceName: c.ClusterArn,
+	})
+	if err != nil {
+		log.Printf("[DEBUG] Error retrieving tags for ARN: %s", aws.StringValue(c.ClusterArn))
+	} else {
+		d.Set("tags", tagsToMapDAX(resp.Tags))
+	}
+
+	return nil
+}
+
+func resourceAwsDaxClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).daxconn
+
+	if err := setTagsDAX(conn, d, d.Get("arn").(string)); err != nil {
+		return err
+	}
+
+	req := &dax.UpdateClusterInput{
+		ClusterName: aws.String(d.Id()),
+	}
+	requestUpdate := false
+
+	if d.HasChange("description") {
+		req.Description = aws.String(d.Get("description").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("security_group_ids") {
+		if v, ok := d.GetOk("security_group_ids"); ok {
+			req.SecurityGroupIds = expandStringList(v.(*schema.Set).List())
+			requestUpdate = true
+		}
+	}
+
+	if d.HasChange("parameter_group_name") {
+		req.ParameterGroupName = aws.String(d.Get("parameter_group_name").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("maintenance_window") {
+		req.PreferredMaintenanceWindow = aws.String(d.Get("maintenance_window").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("notification_topic_arn") {
+		v := d.Get("notification_topic_arn").(string)
+		req.NotificationTopicArn = aws.String(v)
+		if v == "" {
+			req.NotificationTopicStatus = aws.String("inactive")
+		} else {
+			req.NotificationTopicStatus = aws.String("active")
+		}
+		requestUpdate = true
+	}
+
+	if requestUpdate {
+		log.Printf("[DEBUG] Modifying DAX cluster (%s): %s", d.Id(), req)
+		if _, err := conn.UpdateCluster(req); err != nil {
+			return fmt.Errorf("Error updating DAX cluster (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForDaxClusterAvailable(conn, d.Id(), 80*time.Minute); err != nil {
+			return fmt.Errorf("Error waiting for DAX cluster (%s) to be updated: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("replication_factor") {
+		o, n := d.GetChange("replication_factor")
+		if n.(int) > o.(int) {
+			log.Printf("[INFO] Increasing DAX cluster (%s) replication factor to %d", d.Id(), n.(int))
+			_, err := conn.IncreaseReplicationFactor(&dax.IncreaseReplicationFactorInput{
+				ClusterName:          aws.String(d.Id()),
+				NewReplicationFactor: aws.Int64(int64(n.(int))),
+			})
+			if err != nil {
+				return fmt.Errorf("Error increasing DAX cluster (%s) replication factor: %s", d.Id(), err)
+			}
+		} else {
+			log.Printf("[INFO] Decreasing DAX cluster (%s) replication factor to %d", d.Id(), n.(int))
+			_, err := conn.DecreaseReplicationFactor(&dax.DecreaseReplicationFactorInput{
+				ClusterName:          aws.String(d.Id()),
+				NewReplicationFactor: aws.Int64(int64(n.(int))),
+			})
+			if err != nil {
+				return fmt.Errorf("Error decreasing DAX cluster (%s) replication factor: %s", d.Id(), err)
+			}
+		}
+
+		if err := waitForDaxClusterAvailable(conn, d.Id(), 80*time.Minute); err != nil {
+			return fmt.Errorf("Error waiting for DAX cluster (%s) replication factor change: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsDaxClusterRead(d, meta)
+}
+
+func resourceAwsDaxClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).daxconn
+
+	log.Printf("[DEBUG] Deleting DAX cluster: %s", d.Id())
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteCluster(&dax.DeleteClusterInput{
+			ClusterName: aws.String(d.Id()),
+		})
+		if err != nil {
+			// The cluster may still be in a transitional state
+			if isAWSErr(err, dax.ErrCodeInvalidClusterStateFault, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		if isAWSErr(err, dax.ErrCodeClusterNotFoundFault, "") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error deleting DAX cluster (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating", "available", "deleting", "incompatible-parameters", "incompatible-network"},
+		Target:     []string{},
+		Refresh:    daxClusterStateRefreshFunc(conn, d.Id()),
+		Timeout:    40 * time.Minute,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for DAX cluster (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func describeDaxCluster(conn *dax.DAX, clusterName string) (*dax.Cluster, error) {
+	resp, err := conn.DescribeClusters(&dax.DescribeClustersInput{
+		ClusterNames: []*string{aws.String(clusterName)},
+	})
+	if err != nil {
+		if isAWSErr(err, dax.ErrCodeClusterNotFoundFault, "") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	for _, c := range resp.Clusters {
+		if aws.StringValue(c.ClusterName) == clusterName {
+			return c, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func waitForDaxClusterAvailable(conn *dax.DAX, clusterName string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating", "modifying"},
+		Target:     []string{"available"},
+		Refresh:    daxClusterStateRefreshFunc(conn, clusterName),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func daxClusterStateRefreshFunc(conn *dax.DAX, clusterName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		c, err := describeDaxCluster(conn, clusterName)
+		if err != nil {
+			return nil, "", err
+		}
+		if c == nil {
+			return nil, "", nil
+		}
+
+		status := aws.StringValue(c.Status)
+		log.Printf("[DEBUG] DAX cluster (%s) status: %s", clusterName, status)
+
+		// While nodes are being added or removed the cluster itself can
+		// report as available, so wait for all of its nodes as well.
+		if status == "available" {
+			for _, n := range c.Nodes {
+				if aws.StringValue(n.NodeStatus) != "available" {
+					return c, "modifying", nil
+				}
+			}
+		}
+
+		return c, status, nil
+	}
+}
+
+func flattenDaxNodes(nodes []*dax.Node) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(nodes))
+	for _, n := range nodes {
+		m := map[string]interface{}{
+			"id":                aws.StringValue(n.NodeId),
+			"availability_zone": aws.StringValue(n.AvailabilityZone),
+		}
+		if n.Endpoint != nil {
+			m["address"] = aws.StringValue(n.Endpoint.Address)
+			m["port"] = int(aws.Int64Value(n.Endpoint.Port))
+		}
+		result = append(result, m)
+	}
+
+	return result
+}
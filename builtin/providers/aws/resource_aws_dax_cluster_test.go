@@ -0,0 +1,156 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDAXCluster_basic(t *testing.T) {
+	var dc dax.Cluster
+	rString := acctest.RandString(10)
+	resourceName := "aws_dax_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDAXClusterDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSDAXClusterConfig(rString, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDAXClusterExists(resourceName, &dc),
+					resource.TestMatchResourceAttr(resourceName, "arn",
+						regexp.MustCompile("^arn:aws:dax:[\\w-]+:\\d+:cache/tf-[\\w-]+$")),
+					resource.TestCheckResourceAttr(resourceName, "cluster_name", fmt.Sprintf("tf-%s", rString)),
+					resource.TestCheckResourceAttr(resourceName, "node_type", "dax.r3.large"),
+					resource.TestCheckResourceAttr(resourceName, "replication_factor", "1"),
+					resource.TestCheckResourceAttr(resourceName, "nodes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+					resource.TestCheckResourceAttrSet(resourceName, "configuration_endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "cluster_address"),
+					resource.TestMatchResourceAttr(resourceName, "port", regexp.MustCompile("^8111$")),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSDAXClusterConfig(rString, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDAXClusterExists(resourceName, &dc),
+					resource.TestCheckResourceAttr(resourceName, "replication_factor", "2"),
+					resource.TestCheckResourceAttr(resourceName, "nodes.#", "2"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDAXClusterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).daxconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dax_cluster" {
+			continue
+		}
+
+		c, err := describeDaxCluster(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if c != nil {
+			return fmt.Errorf("DAX cluster %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSDAXClusterExists(n string, v *dax.Cluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DAX cluster ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).daxconn
+		c, err := describeDaxCluster(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if c == nil {
+			return fmt.Errorf("DAX cluster %s not found", rs.Primary.ID)
+		}
+
+		*v = *c
+		return nil
+	}
+}
+
+func testAccAWSDAXClusterConfig(rString string, replicationFactor int) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = "tf-dax-%s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "dax.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = "tf-dax-%s"
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "dynamodb:*",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_dax_cluster" "test" {
+  cluster_name       = "tf-%s"
+  iam_role_arn       = "${aws_iam_role.test.arn}"
+  node_type          = "dax.r3.large"
+  replication_factor = %d
+  description        = "test cluster"
+
+  tags {
+    foo = "bar"
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rString, rString, rString, replicationFactor)
+}
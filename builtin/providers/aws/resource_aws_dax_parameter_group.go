@@ -0,0 +1,183 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDaxParameterGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDaxParameterGroupCreate,
+		Read:   resourceAwsDaxParameterGroupRead,
+		Update: resourceAwsDaxParameterGroupUpdate,
+		Delete: resourceAwsDaxParameterGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"parameters": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsDaxParameterGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).daxconn
+
+	input := &dax.CreateParameterGroupInput{
+		ParameterGroupName: aws.String(d.Get("name").(string)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating DAX parameter group: %s", input)
+	if _, err := conn.CreateParameterGroup(input); err != nil {
+		return fmt.Errorf("Error creating DAX parameter group: %s", err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	if len(d.Get("parameters").(*schema.Set).List()) > 0 {
+		return resourceAwsDaxParameterGroupUpdate(d, meta)
+	}
+	return resourceAwsDaxParameterGroupRead(d, meta)
+}
+
+func resourceAwsDaxParameterGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).daxconn
+
+	resp, err := conn.DescribeParameterGroups(&dax.DescribeParameterGroupsInput{
+		ParameterGroupNames: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		if isAWSErr(err, dax.ErrCodeParameterGroupNotFoundFault, "") {
+			log.Printf("[WARN] DAX parameter group (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading DAX parameter group (%s): %s", d.Id(), err)
+	}
+
+	if len(resp.ParameterGroups) == 0 {
+		log.Printf("[WARN] DAX parameter group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	pg := resp.ParameterGroups[0]
+
+	paramresp, err := conn.DescribeParameters(&dax.DescribeParametersInput{
+		ParameterGroupName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading DAX parameter group (%s) parameters: %s", d.Id(), err)
+	}
+
+	d.Set("name", pg.ParameterGroupName)
+	d.Set("description", pg.Description)
+	d.Set("parameters", flattenDaxParameterGroupParameters(paramresp.Parameters))
+
+	return nil
+}
+
+func resourceAwsDaxParameterGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).daxconn
+
+	if d.HasChange("parameters") {
+		o, n := d.GetChange("parameters")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		params := expandDaxParameterGroupParameterNameValue(ns.Difference(os).List())
+		if len(params) > 0 {
+			input := &dax.UpdateParameterGroupInput{
+				ParameterGroupName:  aws.String(d.Id()),
+				ParameterNameValues: params,
+			}
+
+			log.Printf("[DEBUG] Updating DAX parameter group: %s", input)
+			if _, err := conn.UpdateParameterGroup(input); err != nil {
+				return fmt.Errorf("Error updating DAX parameter group (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceAwsDaxParameterGroupRead(d, meta)
+}
+
+func resourceAwsDaxParameterGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).daxconn
+
+	log.Printf("[DEBUG] Deleting DAX parameter group: %s", d.Id())
+	_, err := conn.DeleteParameterGroup(&dax.DeleteParameterGroupInput{
+		ParameterGroupName: aws.String(d.Id()),
+	})
+	if err != nil && !isAWSErr(err, dax.ErrCodeParameterGroupNotFoundFault, "") {
+		return fmt.Errorf("Error deleting DAX parameter group (%s): %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandDaxParameterGroupParameterNameValue(config []interface{}) []*dax.ParameterNameValue {
+	if len(config) == 0 {
+		return nil
+	}
+	initial := make([]*dax.ParameterNameValue, 0, len(config))
+	for _, raw := range config {
+		data := raw.(map[string]interface{})
+		initial = append(initial, &dax.ParameterNameValue{
+			ParameterName:  aws.String(data["name"].(string)),
+			ParameterValue: aws.String(data["value"].(string)),
+		})
+	}
+	return initial
+}
+
+func flattenDaxParameterGroupParameters(params []*dax.Parameter) []map[string]interface{} {
+	if len(params) == 0 {
+		return nil
+	}
+	result := make([]map[string]interface{}, 0, len(params))
+	for _, p := range params {
+		result = append(result, map[string]interface{}{
+			"name":  aws.StringValue(p.ParameterName),
+			"value": aws.StringValue(p.ParameterValue),
+		})
+	}
+	return result
+}
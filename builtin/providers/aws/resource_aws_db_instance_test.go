@@ -209,6 +209,37 @@ func TestAccAWS_separate_DBInstance_iops_update(t *testing.T) {
 	})
 }
 
+func TestAccAWSDBInstance_iamAuth(t *testing.T) {
+	var v rds.DBInstance
+
+	rName := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSnapshotInstanceConfig_iamAuth(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBInstanceExists("aws_db_instance.bar", &v),
+					resource.TestCheckResourceAttr(
+						"aws_db_instance.bar", "iam_database_authentication_enabled", "false"),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccSnapshotInstanceConfig_iamAuth(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBInstanceExists("aws_db_instance.bar", &v),
+					resource.TestCheckResourceAttr(
+						"aws_db_instance.bar", "iam_database_authentication_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSDBInstance_portUpdate(t *testing.T) {
 	var v rds.DBInstance
 
@@ -730,6 +761,24 @@ resource "aws_db_instance" "bar" {
 }`, rName, iops)
 }
 
+func testAccSnapshotInstanceConfig_iamAuth(rName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_db_instance" "bar" {
+  identifier           = "mydb-rds-%s"
+  engine               = "mysql"
+  engine_version       = "5.6.34"
+  instance_class       = "db.t2.micro"
+  name                 = "mydb"
+  username             = "foo"
+  password             = "barbarbar"
+  parameter_group_name = "default.mysql5.6"
+  allocated_storage = 10
+
+  iam_database_authentication_enabled = %t
+  apply_immediately = true
+}`, rName, enabled)
+}
+
 func testAccSnapshotInstanceConfig_mysqlPort(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_db_instance" "bar" {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -48,8 +49,9 @@ func resourceAwsDbOptionGroup() *schema.Resource {
 			},
 			"option_group_description": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
+				Default:  "Managed by Terraform",
 			},
 
 			"option": &schema.Schema{
@@ -81,6 +83,10 @@ func resourceAwsDbOptionGroup() *schema.Resource {
 							Type:     schema.TypeInt,
 							Optional: true,
 						},
+						"version": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
 						"db_security_group_memberships": &schema.Schema{
 							Type:     schema.TypeSet,
 							Optional: true,
@@ -162,8 +168,9 @@ func resourceAwsDbOptionGroupRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("major_engine_version", option.MajorEngineVersion)
 	d.Set("engine_name", option.EngineName)
 	d.Set("option_group_description", option.OptionGroupDescription)
-	if len(option.Options) != 0 {
-		d.Set("option", flattenOptions(option.Options))
+	configuredOptions := d.Get("option").(*schema.Set).List()
+	if err := d.Set("option", matchConfiguredDbOptions(configuredOptions, flattenOptions(option.Options))); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Options to state for DB Option Group (%s): %s", d.Id(), err)
 	}
 
 	optionGroup := options.OptionGroupsList[0]
@@ -194,6 +201,49 @@ func resourceAwsDbOptionGroupRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+// matchConfiguredDbOptions reconciles the options returned by the API with
+// the configured ones. RDS returns option names in its own case and every
+// setting of an option, including defaults that weren't configured, which
+// would otherwise show up as a perpetual diff.
+func matchConfiguredDbOptions(configured []interface{}, options []map[string]interface{}) []map[string]interface{} {
+	byName := make(map[string]map[string]interface{})
+	for _, raw := range configured {
+		o := raw.(map[string]interface{})
+		byName[strings.ToLower(o["option_name"].(string))] = o
+	}
+
+	for _, o := range options {
+		c, ok := byName[strings.ToLower(o["option_name"].(string))]
+		if !ok {
+			continue
+		}
+
+		o["option_name"] = c["option_name"]
+
+		if c["version"].(string) == "" {
+			delete(o, "version")
+		}
+
+		settings, ok := o["option_settings"].([]map[string]interface{})
+		if !ok {
+			continue
+		}
+		configuredSettings := make(map[string]bool)
+		for _, raw := range c["option_settings"].(*schema.Set).List() {
+			configuredSettings[raw.(map[string]interface{})["name"].(string)] = true
+		}
+		filtered := make([]map[string]interface{}, 0, len(settings))
+		for _, setting := range settings {
+			if configuredSettings[setting["name"].(string)] {
+				filtered = append(filtered, setting)
+			}
+		}
+		o["option_settings"] = filtered
+	}
+
+	return options
+}
+
 func optionInList(optionName string, list []*string) bool {
 	for _, opt := range list {
 		if *opt == optionName {
@@ -317,6 +367,10 @@ func resourceAwsDbOptionHash(v interface{}) int {
 		buf.WriteString(fmt.Sprintf("%d-", m["port"].(int)))
 	}
 
+	if v, ok := m["version"]; ok && v.(string) != "" {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+
 	for _, oRaw := range m["option_settings"].(*schema.Set).List() {
 		o := oRaw.(map[string]interface{})
 		buf.WriteString(fmt.Sprintf("%s-", o["name"].(string)))
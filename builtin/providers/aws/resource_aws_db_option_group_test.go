@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -196,6 +198,52 @@ func TestResourceAWSDBOptionGroupName_validation(t *testing.T) {
 	}
 }
 
+func TestMatchConfiguredDbOptions(t *testing.T) {
+	optionElem := resourceAwsDbOptionGroup().Schema["option"].Elem.(*schema.Resource)
+	settingsElem := optionElem.Schema["option_settings"].Elem.(*schema.Resource)
+
+	configured := []interface{}{
+		map[string]interface{}{
+			"option_name": "Timezone",
+			"version":     "",
+			"option_settings": schema.NewSet(schema.HashResource(settingsElem), []interface{}{
+				map[string]interface{}{"name": "TIME_ZONE", "value": "UTC"},
+			}),
+		},
+	}
+
+	options := []map[string]interface{}{
+		map[string]interface{}{
+			"option_name": "timezone",
+			"version":     "1.0",
+			"option_settings": []map[string]interface{}{
+				map[string]interface{}{"name": "TIME_ZONE", "value": "UTC"},
+				map[string]interface{}{"name": "DEFAULT_SETTING", "value": "1"},
+			},
+		},
+		map[string]interface{}{
+			"option_name": "xmldb",
+		},
+	}
+
+	expected := []map[string]interface{}{
+		map[string]interface{}{
+			"option_name": "Timezone",
+			"option_settings": []map[string]interface{}{
+				map[string]interface{}{"name": "TIME_ZONE", "value": "UTC"},
+			},
+		},
+		map[string]interface{}{
+			"option_name": "xmldb",
+		},
+	}
+
+	actual := matchConfiguredDbOptions(configured, options)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad:\nexpected: %#v\n  actual: %#v", expected, actual)
+	}
+}
+
 func testAccCheckAWSDBOptionGroupExists(n string, v *rds.OptionGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
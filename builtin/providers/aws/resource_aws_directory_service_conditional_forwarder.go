@@ -0,0 +1,156 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDirectoryServiceConditionalForwarder() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDirectoryServiceConditionalForwarderCreate,
+		Read:   resourceAwsDirectoryServiceConditionalForwarderRead,
+		Update: resourceAwsDirectoryServiceConditionalForwarderUpdate,
+		Delete: resourceAwsDirectoryServiceConditionalForwarderDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"dns_ips": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"remote_domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDirectoryServiceConditionalForwarderCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dsconn
+
+	directoryId := d.Get("directory_id").(string)
+	domainName := d.Get("remote_domain_name").(string)
+
+	input := &directoryservice.CreateConditionalForwarderInput{
+		DirectoryId:      aws.String(directoryId),
+		DnsIpAddrs:       expandStringList(d.Get("dns_ips").([]interface{})),
+		RemoteDomainName: aws.String(domainName),
+	}
+
+	log.Printf("[DEBUG] Creating Directory Service conditional forwarder: %s", input)
+	_, err := conn.CreateConditionalForwarder(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Directory Service conditional forwarder: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", directoryId, domainName))
+	return resourceAwsDirectoryServiceConditionalForwarderRead(d, meta)
+}
+
+func resourceAwsDirectoryServiceConditionalForwarderRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dsconn
+
+	directoryId, domainName, err := parseDSConditionalForwarderId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	out, err := conn.DescribeConditionalForwarders(&directoryservice.DescribeConditionalForwardersInput{
+		DirectoryId:       aws.String(directoryId),
+		RemoteDomainNames: []*string{aws.String(domainName)},
+	})
+	if err != nil {
+		if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+			log.Printf("[WARN] Directory Service conditional forwarder %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Directory Service conditional forwarder %s: %s", d.Id(), err)
+	}
+
+	if len(out.ConditionalForwarders) == 0 {
+		log.Printf("[WARN] Directory Service conditional forwarder %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	cfd := out.ConditionalForwarders[0]
+
+	d.Set("directory_id", directoryId)
+	d.Set("remote_domain_name", cfd.RemoteDomainName)
+	d.Set("dns_ips", flattenStringList(cfd.DnsIpAddrs))
+
+	return nil
+}
+
+func resourceAwsDirectoryServiceConditionalForwarderUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dsconn
+
+	directoryId, domainName, err := parseDSConditionalForwarderId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &directoryservice.UpdateConditionalForwarderInput{
+		DirectoryId:      aws.String(directoryId),
+		DnsIpAddrs:       expandStringList(d.Get("dns_ips").([]interface{})),
+		RemoteDomainName: aws.String(domainName),
+	}
+
+	log.Printf("[DEBUG] Updating Directory Service conditional forwarder: %s", input)
+	_, err = conn.UpdateConditionalForwarder(input)
+	if err != nil {
+		return fmt.Errorf("Error updating Directory Service conditional forwarder %s: %s", d.Id(), err)
+	}
+
+	return resourceAwsDirectoryServiceConditionalForwarderRead(d, meta)
+}
+
+func resourceAwsDirectoryServiceConditionalForwarderDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dsconn
+
+	directoryId, domainName, err := parseDSConditionalForwarderId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Directory Service conditional forwarder %s", d.Id())
+	_, err = conn.DeleteConditionalForwarder(&directoryservice.DeleteConditionalForwarderInput{
+		DirectoryId:      aws.String(directoryId),
+		RemoteDomainName: aws.String(domainName),
+	})
+	if err != nil && !isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+		return fmt.Errorf("Error deleting Directory Service conditional forwarder %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// parseDSConditionalForwarderId splits an ID of the form
+// DIRECTORY_ID:REMOTE_DOMAIN_NAME into its parts.
+func parseDSConditionalForwarderId(id string) (directoryId, domainName string, err error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected DIRECTORY_ID:REMOTE_DOMAIN_NAME", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,170 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDirectoryServiceConditionalForwarder_basic(t *testing.T) {
+	resourceName := "aws_directory_service_conditional_forwarder.fwd"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDirectoryServiceConditionalForwarderDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDirectoryServiceConditionalForwarderConfig("8.8.8.8", "1.1.1.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDirectoryServiceConditionalForwarderExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "dns_ips.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "dns_ips.0", "8.8.8.8"),
+					resource.TestCheckResourceAttr(resourceName, "dns_ips.1", "1.1.1.1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDirectoryServiceConditionalForwarderConfig("8.8.8.8", "8.8.4.4"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDirectoryServiceConditionalForwarderExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "dns_ips.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "dns_ips.1", "8.8.4.4"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestParseDSConditionalForwarderId(t *testing.T) {
+	directoryId, domainName, err := parseDSConditionalForwarderId("d-1234567890:test.example.com")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if directoryId != "d-1234567890" {
+		t.Fatalf("bad directory ID: %q", directoryId)
+	}
+	if domainName != "test.example.com" {
+		t.Fatalf("bad remote domain name: %q", domainName)
+	}
+
+	for _, id := range []string{"", "d-1234567890", "d-1234567890:", ":test.example.com"} {
+		if _, _, err := parseDSConditionalForwarderId(id); err == nil {
+			t.Fatalf("expected error parsing %q", id)
+		}
+	}
+}
+
+func testAccCheckAwsDirectoryServiceConditionalForwarderDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_directory_service_conditional_forwarder" {
+			continue
+		}
+
+		directoryId, domainName, err := parseDSConditionalForwarderId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		res, err := conn.DescribeConditionalForwarders(&directoryservice.DescribeConditionalForwardersInput{
+			DirectoryId:       aws.String(directoryId),
+			RemoteDomainNames: []*string{aws.String(domainName)},
+		})
+		if err != nil {
+			if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+				continue
+			}
+			return err
+		}
+
+		if len(res.ConditionalForwarders) > 0 {
+			return fmt.Errorf("Expected AWS Directory Service Conditional Forwarder to be gone, but was still found")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsDirectoryServiceConditionalForwarderExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		directoryId, domainName, err := parseDSConditionalForwarderId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).dsconn
+		res, err := conn.DescribeConditionalForwarders(&directoryservice.DescribeConditionalForwardersInput{
+			DirectoryId:       aws.String(directoryId),
+			RemoteDomainNames: []*string{aws.String(domainName)},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(res.ConditionalForwarders) == 0 {
+			return fmt.Errorf("No Conditional Forwarder found")
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectoryServiceConditionalForwarderConfig(ip1, ip2 string) string {
+	return fmt.Sprintf(`
+resource "aws_directory_service_directory" "bar" {
+  name = "corp.notexample.com"
+  password = "SuperSecretPassw0rd"
+  type = "MicrosoftAD"
+
+  vpc_settings {
+    vpc_id = "${aws_vpc.main.id}"
+    subnet_ids = ["${aws_subnet.foo.id}", "${aws_subnet.bar.id}"]
+  }
+}
+
+resource "aws_vpc" "main" {
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_subnet" "foo" {
+  vpc_id = "${aws_vpc.main.id}"
+  availability_zone = "us-west-2a"
+  cidr_block = "10.0.1.0/24"
+}
+resource "aws_subnet" "bar" {
+  vpc_id = "${aws_vpc.main.id}"
+  availability_zone = "us-west-2b"
+  cidr_block = "10.0.2.0/24"
+}
+
+resource "aws_directory_service_conditional_forwarder" "fwd" {
+  directory_id = "${aws_directory_service_directory.bar.id}"
+
+  remote_domain_name = "test.example.com"
+
+  dns_ips = [
+    "%s",
+    "%s",
+  ]
+}
+`, ip1, ip2)
+}
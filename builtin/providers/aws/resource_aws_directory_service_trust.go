@@ -0,0 +1,244 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDirectoryServiceTrust() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDirectoryServiceTrustCreate,
+		Read:   resourceAwsDirectoryServiceTrustRead,
+		Update: resourceAwsDirectoryServiceTrustUpdate,
+		Delete: resourceAwsDirectoryServiceTrustDelete,
+
+		Schema: map[string]*schema.Schema{
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"remote_domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"trust_direction": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDirectoryServiceTrustDirection,
+			},
+
+			"trust_password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+
+			"trust_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      directoryservice.TrustTypeForest,
+				ValidateFunc: validateDirectoryServiceTrustType,
+			},
+
+			"selective_auth": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateDirectoryServiceSelectiveAuth,
+			},
+
+			"conditional_forwarder_ip_addrs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"delete_associated_conditional_forwarder": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"trust_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDirectoryServiceTrustCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dsconn
+
+	input := &directoryservice.CreateTrustInput{
+		DirectoryId:      aws.String(d.Get("directory_id").(string)),
+		RemoteDomainName: aws.String(d.Get("remote_domain_name").(string)),
+		TrustDirection:   aws.String(d.Get("trust_direction").(string)),
+		TrustPassword:    aws.String(d.Get("trust_password").(string)),
+		TrustType:        aws.String(d.Get("trust_type").(string)),
+	}
+
+	if v, ok := d.GetOk("selective_auth"); ok {
+		input.SelectiveAuth = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("conditional_forwarder_ip_addrs"); ok {
+		input.ConditionalForwarderIpAddrs = expandStringList(v.(*schema.Set).List())
+	}
+
+	log.Printf("[DEBUG] Creating Directory Service trust with %s", d.Get("remote_domain_name").(string))
+	out, err := conn.CreateTrust(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Directory Service trust: %s", err)
+	}
+
+	d.SetId(aws.StringValue(out.TrustId))
+
+	// A trust is verified against the remote domain after creation. A failed
+	// verification is reported through trust_state rather than as an error,
+	// since it usually means the remote side has not been configured yet.
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			directoryservice.TrustStateCreating,
+			directoryservice.TrustStateVerifying,
+		},
+		Target: []string{
+			directoryservice.TrustStateCreated,
+			directoryservice.TrustStateVerified,
+			directoryservice.TrustStateVerifyFailed,
+		},
+		Refresh:    resourceAwsDirectoryServiceTrustStateRefreshFunc(conn, d.Get("directory_id").(string), d.Id()),
+		Timeout:    10 * time.Minute,
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Directory Service trust %s to be created: %s", d.Id(), err)
+	}
+
+	return resourceAwsDirectoryServiceTrustRead(d, meta)
+}
+
+func resourceAwsDirectoryServiceTrustRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dsconn
+
+	trust, err := resourceAwsDirectoryServiceDescribeTrust(conn, d.Get("directory_id").(string), d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading Directory Service trust %s: %s", d.Id(), err)
+	}
+	if trust == nil || aws.StringValue(trust.TrustState) == directoryservice.TrustStateDeleted {
+		log.Printf("[WARN] Directory Service trust %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("directory_id", trust.DirectoryId)
+	d.Set("remote_domain_name", trust.RemoteDomainName)
+	d.Set("trust_direction", trust.TrustDirection)
+	d.Set("trust_type", trust.TrustType)
+	d.Set("selective_auth", trust.SelectiveAuth)
+	d.Set("trust_state", trust.TrustState)
+
+	return nil
+}
+
+func resourceAwsDirectoryServiceTrustUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dsconn
+
+	if d.HasChange("selective_auth") {
+		input := &directoryservice.UpdateTrustInput{
+			TrustId:       aws.String(d.Id()),
+			SelectiveAuth: aws.String(d.Get("selective_auth").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Directory Service trust: %s", input)
+		if _, err := conn.UpdateTrust(input); err != nil {
+			return fmt.Errorf("Error updating Directory Service trust %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsDirectoryServiceTrustRead(d, meta)
+}
+
+func resourceAwsDirectoryServiceTrustDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dsconn
+
+	log.Printf("[DEBUG] Deleting Directory Service trust %s", d.Id())
+	_, err := conn.DeleteTrust(&directoryservice.DeleteTrustInput{
+		TrustId:                              aws.String(d.Id()),
+		DeleteAssociatedConditionalForwarder: aws.Bool(d.Get("delete_associated_conditional_forwarder").(bool)),
+	})
+	if err != nil {
+		if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error deleting Directory Service trust %s: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{directoryservice.TrustStateDeleting},
+		Target:     []string{directoryservice.TrustStateDeleted},
+		Refresh:    resourceAwsDirectoryServiceTrustStateRefreshFunc(conn, d.Get("directory_id").(string), d.Id()),
+		Timeout:    10 * time.Minute,
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Directory Service trust %s to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceAwsDirectoryServiceDescribeTrust(conn *directoryservice.DirectoryService, directoryId, trustId string) (*directoryservice.Trust, error) {
+	out, err := conn.DescribeTrusts(&directoryservice.DescribeTrustsInput{
+		DirectoryId: aws.String(directoryId),
+		TrustIds:    []*string{aws.String(trustId)},
+	})
+	if err != nil {
+		if isAWSErr(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if len(out.Trusts) == 0 {
+		return nil, nil
+	}
+	return out.Trusts[0], nil
+}
+
+func resourceAwsDirectoryServiceTrustStateRefreshFunc(conn *directoryservice.DirectoryService, directoryId, trustId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		trust, err := resourceAwsDirectoryServiceDescribeTrust(conn, directoryId, trustId)
+		if err != nil {
+			return nil, "", err
+		}
+		if trust == nil {
+			return 42, directoryservice.TrustStateDeleted, nil
+		}
+
+		state := aws.StringValue(trust.TrustState)
+		if state == directoryservice.TrustStateFailed {
+			return trust, state, fmt.Errorf("Directory Service trust %s failed: %s",
+				trustId, aws.StringValue(trust.TrustStateReason))
+		}
+		return trust, state, nil
+	}
+}
@@ -0,0 +1,129 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDirectoryServiceTrust_basic(t *testing.T) {
+	resourceName := "aws_directory_service_trust.trust"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDirectoryServiceTrustDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDirectoryServiceTrustConfig("Disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDirectoryServiceTrustExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "trust_direction", "One-Way: Outgoing"),
+					resource.TestCheckResourceAttr(resourceName, "trust_type", "Forest"),
+					resource.TestCheckResourceAttr(resourceName, "selective_auth", "Disabled"),
+					resource.TestCheckResourceAttrSet(resourceName, "trust_state"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDirectoryServiceTrustConfig("Enabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDirectoryServiceTrustExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "selective_auth", "Enabled"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDirectoryServiceTrustDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_directory_service_trust" {
+			continue
+		}
+
+		trust, err := resourceAwsDirectoryServiceDescribeTrust(conn, rs.Primary.Attributes["directory_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if trust != nil && *trust.TrustState != directoryservice.TrustStateDeleted {
+			return fmt.Errorf("Directory Service trust %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsDirectoryServiceTrustExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).dsconn
+		trust, err := resourceAwsDirectoryServiceDescribeTrust(conn, rs.Primary.Attributes["directory_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if trust == nil {
+			return fmt.Errorf("Directory Service trust %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// The remote domain does not exist, so the trust ends up in the
+// VerifyFailed state, which is enough to exercise create, update and delete.
+func testAccDirectoryServiceTrustConfig(selectiveAuth string) string {
+	return fmt.Sprintf(`
+resource "aws_directory_service_directory" "bar" {
+  name = "corp.notexample.com"
+  password = "SuperSecretPassw0rd"
+  type = "MicrosoftAD"
+
+  vpc_settings {
+    vpc_id = "${aws_vpc.main.id}"
+    subnet_ids = ["${aws_subnet.foo.id}", "${aws_subnet.bar.id}"]
+  }
+}
+
+resource "aws_vpc" "main" {
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_subnet" "foo" {
+  vpc_id = "${aws_vpc.main.id}"
+  availability_zone = "us-west-2a"
+  cidr_block = "10.0.1.0/24"
+}
+resource "aws_subnet" "bar" {
+  vpc_id = "${aws_vpc.main.id}"
+  availability_zone = "us-west-2b"
+  cidr_block = "10.0.2.0/24"
+}
+
+resource "aws_directory_service_trust" "trust" {
+  directory_id       = "${aws_directory_service_directory.bar.id}"
+  remote_domain_name = "remote.notexample.com"
+  trust_direction    = "One-Way: Outgoing"
+  trust_password     = "SuperSecretPassw0rd"
+  selective_auth     = "%s"
+
+  conditional_forwarder_ip_addrs = ["10.0.1.10", "10.0.2.10"]
+
+  delete_associated_conditional_forwarder = true
+}
+`, selectiveAuth)
+}
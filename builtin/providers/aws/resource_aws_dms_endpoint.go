@@ -0,0 +1,438 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDmsEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDmsEndpointCreate,
+		Read:   resourceAwsDmsEndpointRead,
+		Update: resourceAwsDmsEndpointUpdate,
+		Delete: resourceAwsDmsEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"endpoint_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDmsEndpointId,
+			},
+
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateDmsEndpointType,
+			},
+
+			"engine_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateDmsEndpointEngineName,
+			},
+
+			"server_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"database_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"username": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"ssl_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateDmsEndpointSslMode,
+			},
+
+			"certificate_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"extra_connection_attributes": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"service_access_role": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"mongodb_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auth_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "password",
+						},
+						"auth_mechanism": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "default",
+						},
+						"auth_source": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "admin",
+						},
+						"nesting_level": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "none",
+						},
+						"extract_doc_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "false",
+						},
+						"docs_to_investigate": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "1000",
+						},
+					},
+				},
+			},
+
+			"s3_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_access_role_arn": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"external_table_definition": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"csv_row_delimiter": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "\\n",
+						},
+						"csv_delimiter": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  ",",
+						},
+						"bucket_folder": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"bucket_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"compression_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "NONE",
+						},
+					},
+				},
+			},
+
+			"endpoint_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsDmsEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dmsconn
+
+	input := &dms.CreateEndpointInput{
+		EndpointIdentifier: aws.String(d.Get("endpoint_id").(string)),
+		EndpointType:       aws.String(d.Get("endpoint_type").(string)),
+		EngineName:         aws.String(d.Get("engine_name").(string)),
+		Tags:               tagsFromMapDMS(d.Get("tags").(map[string]interface{})),
+	}
+
+	// Each engine family takes its connection details in a different
+	// place: S3 and DynamoDB only need an IAM role, MongoDB has its own
+	// settings block and everything else uses the top-level fields.
+	switch d.Get("engine_name").(string) {
+	case "dynamodb":
+		input.DynamoDbSettings = &dms.DynamoDbSettings{
+			ServiceAccessRoleArn: aws.String(d.Get("service_access_role").(string)),
+		}
+	case "s3":
+		input.S3Settings = expandDmsS3Settings(d.Get("s3_settings").([]interface{}))
+	case "mongodb":
+		settings := expandDmsMongoDbSettings(d.Get("mongodb_settings").([]interface{}))
+		if settings == nil {
+			settings = &dms.MongoDbSettings{}
+		}
+		settings.ServerName = aws.String(d.Get("server_name").(string))
+		settings.Port = aws.Int64(int64(d.Get("port").(int)))
+		settings.DatabaseName = aws.String(d.Get("database_name").(string))
+		if v, ok := d.GetOk("username"); ok {
+			settings.Username = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("password"); ok {
+			settings.Password = aws.String(v.(string))
+		}
+		input.MongoDbSettings = settings
+
+		// The top-level fields are still required for validation.
+		input.ServerName = settings.ServerName
+		input.Port = settings.Port
+		input.DatabaseName = settings.DatabaseName
+	default:
+		input.ServerName = aws.String(d.Get("server_name").(string))
+		input.Port = aws.Int64(int64(d.Get("port").(int)))
+		input.Username = aws.String(d.Get("username").(string))
+		input.Password = aws.String(d.Get("password").(string))
+		if v, ok := d.GetOk("database_name"); ok {
+			input.DatabaseName = aws.String(v.(string))
+		}
+	}
+
+	if v, ok := d.GetOk("ssl_mode"); ok {
+		input.SslMode = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("certificate_arn"); ok {
+		input.CertificateArn = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("extra_connection_attributes"); ok {
+		input.ExtraConnectionAttributes = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	// Don't log the input, it contains the password
+	log.Printf("[DEBUG] Creating DMS endpoint %s", d.Get("endpoint_id").(string))
+	_, err := conn.CreateEndpoint(input)
+	if err != nil {
+		return fmt.Errorf("Error creating DMS endpoint: %s", err)
+	}
+
+	d.SetId(d.Get("endpoint_id").(string))
+	return resourceAwsDmsEndpointRead(d, meta)
+}
+
+func resourceAwsDmsEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dmsconn
+
+	out, err := conn.DescribeEndpoints(&dms.DescribeEndpointsInput{
+		Filters: []*dms.Filter{
+			{
+				Name:   aws.String("endpoint-id"),
+				Values: []*string{aws.String(d.Id())},
+			},
+		},
+	})
+	if err != nil {
+		if isAWSErr(err, dms.ErrCodeResourceNotFoundFault, "") {
+			log.Printf("[WARN] DMS endpoint %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading DMS endpoint %s: %s", d.Id(), err)
+	}
+
+	if len(out.Endpoints) == 0 {
+		log.Printf("[WARN] DMS endpoint %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	endpoint := out.Endpoints[0]
+
+	d.Set("endpoint_id", endpoint.EndpointIdentifier)
+	d.Set("endpoint_arn", endpoint.EndpointArn)
+	d.Set("endpoint_type", endpoint.EndpointType)
+	d.Set("engine_name", endpoint.EngineName)
+	d.Set("ssl_mode", endpoint.SslMode)
+	d.Set("certificate_arn", endpoint.CertificateArn)
+	d.Set("extra_connection_attributes", endpoint.ExtraConnectionAttributes)
+	d.Set("kms_key_arn", endpoint.KmsKeyId)
+
+	switch aws.StringValue(endpoint.EngineName) {
+	case "dynamodb":
+		if endpoint.DynamoDbSettings != nil {
+			d.Set("service_access_role", endpoint.DynamoDbSettings.ServiceAccessRoleArn)
+		}
+	case "s3":
+		if err := d.Set("s3_settings", flattenDmsS3Settings(endpoint.S3Settings)); err != nil {
+			return fmt.Errorf("Error setting s3_settings for DMS endpoint %s: %s", d.Id(), err)
+		}
+	case "mongodb":
+		if err := d.Set("mongodb_settings", flattenDmsMongoDbSettings(endpoint.MongoDbSettings)); err != nil {
+			return fmt.Errorf("Error setting mongodb_settings for DMS endpoint %s: %s", d.Id(), err)
+		}
+		d.Set("server_name", endpoint.ServerName)
+		d.Set("port", endpoint.Port)
+		d.Set("database_name", endpoint.DatabaseName)
+		d.Set("username", endpoint.Username)
+	default:
+		d.Set("server_name", endpoint.ServerName)
+		d.Set("port", endpoint.Port)
+		d.Set("database_name", endpoint.DatabaseName)
+		d.Set("username", endpoint.Username)
+	}
+
+	tags, err := conn.ListTagsForResource(&dms.ListTagsForResourceInput{
+		ResourceArn: endpoint.EndpointArn,
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing tags for DMS endpoint %s: %s", d.Id(), err)
+	}
+	d.Set("tags", tagsToMapDMS(tags.TagList))
+
+	return nil
+}
+
+func resourceAwsDmsEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dmsconn
+	arn := d.Get("endpoint_arn").(string)
+
+	d.Partial(true)
+
+	input := &dms.ModifyEndpointInput{
+		EndpointArn: aws.String(arn),
+	}
+	hasChanges := false
+
+	if d.HasChange("endpoint_type") {
+		input.EndpointType = aws.String(d.Get("endpoint_type").(string))
+		hasChanges = true
+	}
+	if d.HasChange("engine_name") {
+		input.EngineName = aws.String(d.Get("engine_name").(string))
+		hasChanges = true
+	}
+	if d.HasChange("server_name") {
+		input.ServerName = aws.String(d.Get("server_name").(string))
+		hasChanges = true
+	}
+	if d.HasChange("port") {
+		input.Port = aws.Int64(int64(d.Get("port").(int)))
+		hasChanges = true
+	}
+	if d.HasChange("database_name") {
+		input.DatabaseName = aws.String(d.Get("database_name").(string))
+		hasChanges = true
+	}
+	if d.HasChange("username") {
+		input.Username = aws.String(d.Get("username").(string))
+		hasChanges = true
+	}
+	if d.HasChange("password") {
+		input.Password = aws.String(d.Get("password").(string))
+		hasChanges = true
+	}
+	if d.HasChange("ssl_mode") {
+		input.SslMode = aws.String(d.Get("ssl_mode").(string))
+		hasChanges = true
+	}
+	if d.HasChange("certificate_arn") {
+		input.CertificateArn = aws.String(d.Get("certificate_arn").(string))
+		hasChanges = true
+	}
+	if d.HasChange("extra_connection_attributes") {
+		input.ExtraConnectionAttributes = aws.String(d.Get("extra_connection_attributes").(string))
+		hasChanges = true
+	}
+	if d.HasChange("service_access_role") {
+		input.DynamoDbSettings = &dms.DynamoDbSettings{
+			ServiceAccessRoleArn: aws.String(d.Get("service_access_role").(string)),
+		}
+		hasChanges = true
+	}
+	if d.HasChange("s3_settings") {
+		input.S3Settings = expandDmsS3Settings(d.Get("s3_settings").([]interface{}))
+		hasChanges = true
+	}
+	if d.HasChange("mongodb_settings") {
+		input.MongoDbSettings = expandDmsMongoDbSettings(d.Get("mongodb_settings").([]interface{}))
+		hasChanges = true
+	}
+
+	if hasChanges {
+		log.Printf("[DEBUG] Updating DMS endpoint %s", d.Id())
+		_, err := conn.ModifyEndpoint(input)
+		if err != nil {
+			return fmt.Errorf("Error updating DMS endpoint %s: %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsDMS(conn, d, arn); err != nil {
+		return err
+	}
+	d.SetPartial("tags")
+
+	d.Partial(false)
+	return resourceAwsDmsEndpointRead(d, meta)
+}
+
+func resourceAwsDmsEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dmsconn
+
+	log.Printf("[DEBUG] Deleting DMS endpoint %s", d.Id())
+	_, err := conn.DeleteEndpoint(&dms.DeleteEndpointInput{
+		EndpointArn: aws.String(d.Get("endpoint_arn").(string)),
+	})
+	if err != nil {
+		if isAWSErr(err, dms.ErrCodeResourceNotFoundFault, "") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error deleting DMS endpoint %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,214 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDmsEndpoint_basic(t *testing.T) {
+	resourceName := "aws_dms_endpoint.test"
+	randId := acctest.RandString(8)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDmsEndpointDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSDmsEndpointConfig(randId, "tf-test-dms-db", 3306),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDmsEndpointExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint_arn"),
+					resource.TestCheckResourceAttr(resourceName, "database_name", "tf-test-dms-db"),
+					resource.TestCheckResourceAttr(resourceName, "port", "3306"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "tf-test-dms-endpoint-"+randId),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSDmsEndpointConfig(randId, "tf-test-dms-db-updated", 3303),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDmsEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "database_name", "tf-test-dms-db-updated"),
+					resource.TestCheckResourceAttr(resourceName, "port", "3303"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func TestAccAWSDmsEndpoint_s3(t *testing.T) {
+	resourceName := "aws_dms_endpoint.test"
+	randId := acctest.RandString(8)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDmsEndpointDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSDmsEndpointConfig_s3(randId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDmsEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "s3_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_settings.0.bucket_folder", "folder"),
+					resource.TestCheckResourceAttr(resourceName, "s3_settings.0.compression_type", "GZIP"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDmsEndpointExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DMS endpoint ID set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).dmsconn
+		out, err := conn.DescribeEndpoints(&dms.DescribeEndpointsInput{
+			Filters: []*dms.Filter{
+				{
+					Name:   aws.String("endpoint-id"),
+					Values: []*string{aws.String(rs.Primary.ID)},
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if len(out.Endpoints) == 0 {
+			return fmt.Errorf("DMS endpoint %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSDmsEndpointDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dmsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dms_endpoint" {
+			continue
+		}
+
+		out, err := conn.DescribeEndpoints(&dms.DescribeEndpointsInput{
+			Filters: []*dms.Filter{
+				{
+					Name:   aws.String("endpoint-id"),
+					Values: []*string{aws.String(rs.Primary.ID)},
+				},
+			},
+		})
+		if err != nil {
+			if isAWSErr(err, dms.ErrCodeResourceNotFoundFault, "") {
+				continue
+			}
+			return err
+		}
+		if len(out.Endpoints) != 0 {
+			return fmt.Errorf("DMS endpoint %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSDmsEndpointConfig(randId, database string, port int) string {
+	return fmt.Sprintf(`
+resource "aws_dms_endpoint" "test" {
+  endpoint_id                 = "tf-test-dms-endpoint-%[1]s"
+  endpoint_type               = "source"
+  engine_name                 = "aurora"
+  server_name                 = "tftest"
+  port                        = %[3]d
+  database_name               = "%[2]s"
+  username                    = "tftest"
+  password                    = "tftest"
+  ssl_mode                    = "none"
+  extra_connection_attributes = ""
+
+  tags {
+    Name = "tf-test-dms-endpoint-%[1]s"
+  }
+}
+`, randId, database, port)
+}
+
+func testAccAWSDmsEndpointConfig_s3(randId string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "dms" {
+  bucket        = "tf-test-dms-bucket-%[1]s"
+  force_destroy = true
+}
+
+resource "aws_iam_role" "dms" {
+  name = "tf-test-dms-s3-role-%[1]s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "dms.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "dms" {
+  name = "tf-test-dms-s3-policy-%[1]s"
+  role = "${aws_iam_role.dms.name}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["s3:*"],
+      "Resource": ["${aws_s3_bucket.dms.arn}", "${aws_s3_bucket.dms.arn}/*"]
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_dms_endpoint" "test" {
+  endpoint_id   = "tf-test-dms-endpoint-%[1]s"
+  endpoint_type = "target"
+  engine_name   = "s3"
+
+  s3_settings {
+    service_access_role_arn = "${aws_iam_role.dms.arn}"
+    bucket_name             = "${aws_s3_bucket.dms.id}"
+    bucket_folder           = "folder"
+    compression_type        = "GZIP"
+  }
+
+  depends_on = ["aws_iam_role_policy.dms"]
+}
+`, randId)
+}
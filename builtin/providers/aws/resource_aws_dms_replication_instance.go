@@ -0,0 +1,380 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDmsReplicationInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDmsReplicationInstanceCreate,
+		Read:   resourceAwsDmsReplicationInstanceRead,
+		Update: resourceAwsDmsReplicationInstanceUpdate,
+		Delete: resourceAwsDmsReplicationInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"replication_instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDmsReplicationInstanceId,
+			},
+
+			"replication_instance_class": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"allocated_storage": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntegerInRange(5, 6144),
+			},
+
+			"apply_immediately": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"auto_minor_version_upgrade": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"multi_az": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"preferred_maintenance_window": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"publicly_accessible": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"replication_subnet_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"vpc_security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"replication_instance_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"replication_instance_private_ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"replication_instance_public_ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsDmsReplicationInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dmsconn
+
+	input := &dms.CreateReplicationInstanceInput{
+		ReplicationInstanceIdentifier: aws.String(d.Get("replication_instance_id").(string)),
+		ReplicationInstanceClass:      aws.String(d.Get("replication_instance_class").(string)),
+		Tags:                          tagsFromMapDMS(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("allocated_storage"); ok {
+		input.AllocatedStorage = aws.Int64(int64(v.(int)))
+	}
+	if v, ok := d.GetOk("auto_minor_version_upgrade"); ok {
+		input.AutoMinorVersionUpgrade = aws.Bool(v.(bool))
+	}
+	if v, ok := d.GetOk("availability_zone"); ok {
+		input.AvailabilityZone = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("engine_version"); ok {
+		input.EngineVersion = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("multi_az"); ok {
+		input.MultiAZ = aws.Bool(v.(bool))
+	}
+	if v, ok := d.GetOk("preferred_maintenance_window"); ok {
+		input.PreferredMaintenanceWindow = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("publicly_accessible"); ok {
+		input.PubliclyAccessible = aws.Bool(v.(bool))
+	}
+	if v, ok := d.GetOk("replication_subnet_group_id"); ok {
+		input.ReplicationSubnetGroupIdentifier = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("vpc_security_group_ids"); ok {
+		input.VpcSecurityGroupIds = expandStringList(v.(*schema.Set).List())
+	}
+
+	log.Printf("[DEBUG] Creating DMS replication instance: %s", input)
+	_, err := conn.CreateReplicationInstance(input)
+	if err != nil {
+		return fmt.Errorf("Error creating DMS replication instance: %s", err)
+	}
+
+	d.SetId(d.Get("replication_instance_id").(string))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{"available"},
+		Refresh:    resourceAwsDmsReplicationInstanceStateRefreshFunc(conn, d.Id()),
+		Timeout:    30 * time.Minute,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for DMS replication instance %s to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsDmsReplicationInstanceRead(d, meta)
+}
+
+func resourceAwsDmsReplicationInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dmsconn
+
+	instance, err := resourceAwsDmsDescribeReplicationInstance(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading DMS replication instance %s: %s", d.Id(), err)
+	}
+	if instance == nil {
+		log.Printf("[WARN] DMS replication instance %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("replication_instance_id", instance.ReplicationInstanceIdentifier)
+	d.Set("replication_instance_class", instance.ReplicationInstanceClass)
+	d.Set("replication_instance_arn", instance.ReplicationInstanceArn)
+	d.Set("allocated_storage", instance.AllocatedStorage)
+	d.Set("auto_minor_version_upgrade", instance.AutoMinorVersionUpgrade)
+	d.Set("availability_zone", instance.AvailabilityZone)
+	d.Set("engine_version", instance.EngineVersion)
+	d.Set("kms_key_arn", instance.KmsKeyId)
+	d.Set("multi_az", instance.MultiAZ)
+	d.Set("preferred_maintenance_window", instance.PreferredMaintenanceWindow)
+	d.Set("publicly_accessible", instance.PubliclyAccessible)
+	d.Set("replication_instance_private_ips", flattenStringList(instance.ReplicationInstancePrivateIpAddresses))
+	d.Set("replication_instance_public_ips", flattenStringList(instance.ReplicationInstancePublicIpAddresses))
+	if instance.ReplicationSubnetGroup != nil {
+		d.Set("replication_subnet_group_id", instance.ReplicationSubnetGroup.ReplicationSubnetGroupIdentifier)
+	}
+
+	sgIds := make([]string, 0, len(instance.VpcSecurityGroups))
+	for _, sg := range instance.VpcSecurityGroups {
+		sgIds = append(sgIds, aws.StringValue(sg.VpcSecurityGroupId))
+	}
+	d.Set("vpc_security_group_ids", sgIds)
+
+	tags, err := conn.ListTagsForResource(&dms.ListTagsForResourceInput{
+		ResourceArn: instance.ReplicationInstanceArn,
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing tags for DMS replication instance %s: %s", d.Id(), err)
+	}
+	d.Set("tags", tagsToMapDMS(tags.TagList))
+
+	return nil
+}
+
+func resourceAwsDmsReplicationInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dmsconn
+	arn := d.Get("replication_instance_arn").(string)
+
+	d.Partial(true)
+
+	input := &dms.ModifyReplicationInstanceInput{
+		ReplicationInstanceArn: aws.String(arn),
+		ApplyImmediately:       aws.Bool(d.Get("apply_immediately").(bool)),
+	}
+	hasChanges := false
+
+	if d.HasChange("replication_instance_class") {
+		input.ReplicationInstanceClass = aws.String(d.Get("replication_instance_class").(string))
+		hasChanges = true
+	}
+	if d.HasChange("allocated_storage") {
+		input.AllocatedStorage = aws.Int64(int64(d.Get("allocated_storage").(int)))
+		hasChanges = true
+	}
+	if d.HasChange("auto_minor_version_upgrade") {
+		input.AutoMinorVersionUpgrade = aws.Bool(d.Get("auto_minor_version_upgrade").(bool))
+		hasChanges = true
+	}
+	if d.HasChange("engine_version") {
+		input.EngineVersion = aws.String(d.Get("engine_version").(string))
+		hasChanges = true
+	}
+	if d.HasChange("multi_az") {
+		input.MultiAZ = aws.Bool(d.Get("multi_az").(bool))
+		hasChanges = true
+	}
+	if d.HasChange("preferred_maintenance_window") {
+		input.PreferredMaintenanceWindow = aws.String(d.Get("preferred_maintenance_window").(string))
+		hasChanges = true
+	}
+	if d.HasChange("vpc_security_group_ids") {
+		input.VpcSecurityGroupIds = expandStringList(d.Get("vpc_security_group_ids").(*schema.Set).List())
+		hasChanges = true
+	}
+
+	if hasChanges {
+		log.Printf("[DEBUG] Updating DMS replication instance: %s", input)
+		_, err := conn.ModifyReplicationInstance(input)
+		if err != nil {
+			return fmt.Errorf("Error updating DMS replication instance %s: %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"modifying", "upgrading"},
+			Target:     []string{"available"},
+			Refresh:    resourceAwsDmsReplicationInstanceStateRefreshFunc(conn, d.Id()),
+			Timeout:    30 * time.Minute,
+			MinTimeout: 10 * time.Second,
+			Delay:      30 * time.Second,
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("Error waiting for DMS replication instance %s to be modified: %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsDMS(conn, d, arn); err != nil {
+		return err
+	}
+	d.SetPartial("tags")
+
+	d.Partial(false)
+	return resourceAwsDmsReplicationInstanceRead(d, meta)
+}
+
+func resourceAwsDmsReplicationInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dmsconn
+
+	log.Printf("[DEBUG] Deleting DMS replication instance %s", d.Id())
+	_, err := conn.DeleteReplicationInstance(&dms.DeleteReplicationInstanceInput{
+		ReplicationInstanceArn: aws.String(d.Get("replication_instance_arn").(string)),
+	})
+	if err != nil {
+		if isAWSErr(err, dms.ErrCodeResourceNotFoundFault, "") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error deleting DMS replication instance %s: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"deleting"},
+		Target:     []string{"deleted"},
+		Refresh:    resourceAwsDmsReplicationInstanceStateRefreshFunc(conn, d.Id()),
+		Timeout:    30 * time.Minute,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for DMS replication instance %s to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceAwsDmsDescribeReplicationInstance returns the replication instance
+// with the given identifier, or nil if it does not exist.
+func resourceAwsDmsDescribeReplicationInstance(conn *dms.DatabaseMigrationService, id string) (*dms.ReplicationInstance, error) {
+	out, err := conn.DescribeReplicationInstances(&dms.DescribeReplicationInstancesInput{
+		Filters: []*dms.Filter{
+			{
+				Name:   aws.String("replication-instance-id"),
+				Values: []*string{aws.String(id)},
+			},
+		},
+	})
+	if err != nil {
+		if isAWSErr(err, dms.ErrCodeResourceNotFoundFault, "") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if len(out.ReplicationInstances) == 0 {
+		return nil, nil
+	}
+	return out.ReplicationInstances[0], nil
+}
+
+func resourceAwsDmsReplicationInstanceStateRefreshFunc(conn *dms.DatabaseMigrationService, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := resourceAwsDmsDescribeReplicationInstance(conn, id)
+		if err != nil {
+			return nil, "", err
+		}
+		if instance == nil {
+			return id, "deleted", nil
+		}
+
+		return instance, aws.StringValue(instance.ReplicationInstanceStatus), nil
+	}
+}
@@ -0,0 +1,136 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDmsReplicationInstance_basic(t *testing.T) {
+	resourceName := "aws_dms_replication_instance.test"
+	randId := acctest.RandString(8)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDmsReplicationInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSDmsReplicationInstanceConfig(randId, "dms.t2.micro", "Test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDmsReplicationInstanceExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "replication_instance_arn"),
+					resource.TestCheckResourceAttr(resourceName, "replication_instance_class", "dms.t2.micro"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "Test"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSDmsReplicationInstanceConfig(randId, "dms.t2.small", "Updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDmsReplicationInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replication_instance_class", "dms.t2.small"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "Updated"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately"},
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDmsReplicationInstanceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DMS replication instance ID set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).dmsconn
+		instance, err := resourceAwsDmsDescribeReplicationInstance(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if instance == nil {
+			return fmt.Errorf("DMS replication instance %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSDmsReplicationInstanceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dmsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dms_replication_instance" {
+			continue
+		}
+
+		instance, err := resourceAwsDmsDescribeReplicationInstance(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if instance != nil {
+			return fmt.Errorf("DMS replication instance %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSDmsReplicationInstanceConfig(randId, class, tag string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_vpc" "dms_vpc" {
+  cidr_block = "10.1.0.0/16"
+
+  tags {
+    Name = "tf-test-dms-vpc-%[1]s"
+  }
+}
+
+resource "aws_subnet" "dms_subnet_1" {
+  cidr_block        = "10.1.1.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  vpc_id            = "${aws_vpc.dms_vpc.id}"
+}
+
+resource "aws_subnet" "dms_subnet_2" {
+  cidr_block        = "10.1.2.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[1]}"
+  vpc_id            = "${aws_vpc.dms_vpc.id}"
+}
+
+resource "aws_dms_replication_subnet_group" "dms_replication_subnet_group" {
+  replication_subnet_group_id          = "tf-test-dms-replication-subnet-group-%[1]s"
+  replication_subnet_group_description = "terraform test for replication subnet group"
+  subnet_ids                           = ["${aws_subnet.dms_subnet_1.id}", "${aws_subnet.dms_subnet_2.id}"]
+}
+
+resource "aws_dms_replication_instance" "test" {
+  replication_instance_id     = "tf-test-dms-replication-instance-%[1]s"
+  replication_instance_class  = "%[2]s"
+  allocated_storage           = 5
+  apply_immediately           = true
+  publicly_accessible         = false
+  replication_subnet_group_id = "${aws_dms_replication_subnet_group.dms_replication_subnet_group.id}"
+
+  tags {
+    Name = "%[3]s"
+  }
+}
+`, randId, class, tag)
+}
@@ -0,0 +1,147 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDmsReplicationSubnetGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDmsReplicationSubnetGroupCreate,
+		Read:   resourceAwsDmsReplicationSubnetGroupRead,
+		Update: resourceAwsDmsReplicationSubnetGroupUpdate,
+		Delete: resourceAwsDmsReplicationSubnetGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"replication_subnet_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDmsReplicationSubnetGroupId,
+			},
+
+			"replication_subnet_group_description": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDmsReplicationSubnetGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dmsconn
+
+	input := &dms.CreateReplicationSubnetGroupInput{
+		ReplicationSubnetGroupIdentifier:  aws.String(d.Get("replication_subnet_group_id").(string)),
+		ReplicationSubnetGroupDescription: aws.String(d.Get("replication_subnet_group_description").(string)),
+		SubnetIds:                         expandStringList(d.Get("subnet_ids").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] Creating DMS replication subnet group: %s", input)
+	_, err := conn.CreateReplicationSubnetGroup(input)
+	if err != nil {
+		return fmt.Errorf("Error creating DMS replication subnet group: %s", err)
+	}
+
+	d.SetId(d.Get("replication_subnet_group_id").(string))
+	return resourceAwsDmsReplicationSubnetGroupRead(d, meta)
+}
+
+func resourceAwsDmsReplicationSubnetGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dmsconn
+
+	out, err := conn.DescribeReplicationSubnetGroups(&dms.DescribeReplicationSubnetGroupsInput{
+		Filters: []*dms.Filter{
+			{
+				Name:   aws.String("replication-subnet-group-id"),
+				Values: []*string{aws.String(d.Id())},
+			},
+		},
+	})
+	if err != nil {
+		if isAWSErr(err, dms.ErrCodeResourceNotFoundFault, "") {
+			log.Printf("[WARN] DMS replication subnet group %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading DMS replication subnet group %s: %s", d.Id(), err)
+	}
+
+	if len(out.ReplicationSubnetGroups) == 0 {
+		log.Printf("[WARN] DMS replication subnet group %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	group := out.ReplicationSubnetGroups[0]
+
+	subnetIds := make([]string, 0, len(group.Subnets))
+	for _, subnet := range group.Subnets {
+		subnetIds = append(subnetIds, aws.StringValue(subnet.SubnetIdentifier))
+	}
+
+	d.Set("replication_subnet_group_id", group.ReplicationSubnetGroupIdentifier)
+	d.Set("replication_subnet_group_description", group.ReplicationSubnetGroupDescription)
+	d.Set("subnet_ids", subnetIds)
+	d.Set("vpc_id", group.VpcId)
+
+	return nil
+}
+
+func resourceAwsDmsReplicationSubnetGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dmsconn
+
+	// The API requires the subnet ids on every modification, even if only
+	// the description has changed.
+	input := &dms.ModifyReplicationSubnetGroupInput{
+		ReplicationSubnetGroupIdentifier:  aws.String(d.Id()),
+		ReplicationSubnetGroupDescription: aws.String(d.Get("replication_subnet_group_description").(string)),
+		SubnetIds:                         expandStringList(d.Get("subnet_ids").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] Updating DMS replication subnet group: %s", input)
+	_, err := conn.ModifyReplicationSubnetGroup(input)
+	if err != nil {
+		return fmt.Errorf("Error updating DMS replication subnet group %s: %s", d.Id(), err)
+	}
+
+	return resourceAwsDmsReplicationSubnetGroupRead(d, meta)
+}
+
+func resourceAwsDmsReplicationSubnetGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dmsconn
+
+	log.Printf("[DEBUG] Deleting DMS replication subnet group %s", d.Id())
+	_, err := conn.DeleteReplicationSubnetGroup(&dms.DeleteReplicationSubnetGroupInput{
+		ReplicationSubnetGroupIdentifier: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, dms.ErrCodeResourceNotFoundFault, "") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error deleting DMS replication subnet group %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
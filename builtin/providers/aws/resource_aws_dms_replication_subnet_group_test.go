@@ -0,0 +1,139 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDmsReplicationSubnetGroup_basic(t *testing.T) {
+	resourceName := "aws_dms_replication_subnet_group.test"
+	randId := acctest.RandString(8)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDmsReplicationSubnetGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSDmsReplicationSubnetGroupConfig(randId, "terraform test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDmsReplicationSubnetGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "replication_subnet_group_description", "terraform test"),
+					resource.TestCheckResourceAttrSet(resourceName, "vpc_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSDmsReplicationSubnetGroupConfig(randId, "terraform test updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDmsReplicationSubnetGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replication_subnet_group_description", "terraform test updated"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDmsReplicationSubnetGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DMS replication subnet group ID set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).dmsconn
+		out, err := conn.DescribeReplicationSubnetGroups(&dms.DescribeReplicationSubnetGroupsInput{
+			Filters: []*dms.Filter{
+				{
+					Name:   aws.String("replication-subnet-group-id"),
+					Values: []*string{aws.String(rs.Primary.ID)},
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if len(out.ReplicationSubnetGroups) == 0 {
+			return fmt.Errorf("DMS replication subnet group %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSDmsReplicationSubnetGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dmsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dms_replication_subnet_group" {
+			continue
+		}
+
+		out, err := conn.DescribeReplicationSubnetGroups(&dms.DescribeReplicationSubnetGroupsInput{
+			Filters: []*dms.Filter{
+				{
+					Name:   aws.String("replication-subnet-group-id"),
+					Values: []*string{aws.String(rs.Primary.ID)},
+				},
+			},
+		})
+		if err != nil {
+			if isAWSErr(err, dms.ErrCodeResourceNotFoundFault, "") {
+				continue
+			}
+			return err
+		}
+		if len(out.ReplicationSubnetGroups) != 0 {
+			return fmt.Errorf("DMS replication subnet group %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSDmsReplicationSubnetGroupConfig(randId, description string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_vpc" "dms_vpc" {
+  cidr_block = "10.1.0.0/16"
+
+  tags {
+    Name = "tf-test-dms-vpc-%[1]s"
+  }
+}
+
+resource "aws_subnet" "dms_subnet_1" {
+  cidr_block        = "10.1.1.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  vpc_id            = "${aws_vpc.dms_vpc.id}"
+}
+
+resource "aws_subnet" "dms_subnet_2" {
+  cidr_block        = "10.1.2.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[1]}"
+  vpc_id            = "${aws_vpc.dms_vpc.id}"
+}
+
+resource "aws_dms_replication_subnet_group" "test" {
+  replication_subnet_group_id          = "tf-test-dms-replication-subnet-group-%[1]s"
+  replication_subnet_group_description = "%[2]s"
+  subnet_ids                           = ["${aws_subnet.dms_subnet_1.id}", "${aws_subnet.dms_subnet_2.id}"]
+}
+`, randId, description)
+}
@@ -0,0 +1,310 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDmsReplicationTask() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDmsReplicationTaskCreate,
+		Read:   resourceAwsDmsReplicationTaskRead,
+		Update: resourceAwsDmsReplicationTaskUpdate,
+		Delete: resourceAwsDmsReplicationTaskDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"replication_task_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDmsReplicationTaskId,
+			},
+
+			"migration_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateDmsReplicationTaskMigrationType,
+			},
+
+			"replication_instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"source_endpoint_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"target_endpoint_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"table_mappings": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+
+			"replication_task_settings": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+
+			// The API takes a timestamp but only ever returns the start
+			// position, so this is kept as a Unix timestamp and not read back.
+			"cdc_start_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"replication_task_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsDmsReplicationTaskCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dmsconn
+
+	input := &dms.CreateReplicationTaskInput{
+		ReplicationTaskIdentifier: aws.String(d.Get("replication_task_id").(string)),
+		MigrationType:             aws.String(d.Get("migration_type").(string)),
+		ReplicationInstanceArn:    aws.String(d.Get("replication_instance_arn").(string)),
+		SourceEndpointArn:         aws.String(d.Get("source_endpoint_arn").(string)),
+		TargetEndpointArn:         aws.String(d.Get("target_endpoint_arn").(string)),
+		TableMappings:             aws.String(d.Get("table_mappings").(string)),
+		Tags:                      tagsFromMapDMS(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("replication_task_settings"); ok {
+		input.ReplicationTaskSettings = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("cdc_start_time"); ok {
+		t, err := resourceAwsDmsParseCdcStartTime(v.(string))
+		if err != nil {
+			return err
+		}
+		input.CdcStartTime = t
+	}
+
+	log.Printf("[DEBUG] Creating DMS replication task: %s", input)
+	_, err := conn.CreateReplicationTask(input)
+	if err != nil {
+		return fmt.Errorf("Error creating DMS replication task: %s", err)
+	}
+
+	d.SetId(d.Get("replication_task_id").(string))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{"ready"},
+		Refresh:    resourceAwsDmsReplicationTaskStateRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for DMS replication task %s to become ready: %s", d.Id(), err)
+	}
+
+	return resourceAwsDmsReplicationTaskRead(d, meta)
+}
+
+func resourceAwsDmsReplicationTaskRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dmsconn
+
+	task, err := resourceAwsDmsDescribeReplicationTask(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading DMS replication task %s: %s", d.Id(), err)
+	}
+	if task == nil {
+		log.Printf("[WARN] DMS replication task %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("replication_task_id", task.ReplicationTaskIdentifier)
+	d.Set("replication_task_arn", task.ReplicationTaskArn)
+	d.Set("migration_type", task.MigrationType)
+	d.Set("replication_instance_arn", task.ReplicationInstanceArn)
+	d.Set("source_endpoint_arn", task.SourceEndpointArn)
+	d.Set("target_endpoint_arn", task.TargetEndpointArn)
+	d.Set("table_mappings", task.TableMappings)
+	d.Set("replication_task_settings", task.ReplicationTaskSettings)
+
+	tags, err := conn.ListTagsForResource(&dms.ListTagsForResourceInput{
+		ResourceArn: task.ReplicationTaskArn,
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing tags for DMS replication task %s: %s", d.Id(), err)
+	}
+	d.Set("tags", tagsToMapDMS(tags.TagList))
+
+	return nil
+}
+
+func resourceAwsDmsReplicationTaskUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dmsconn
+	arn := d.Get("replication_task_arn").(string)
+
+	d.Partial(true)
+
+	input := &dms.ModifyReplicationTaskInput{
+		ReplicationTaskArn: aws.String(arn),
+	}
+	hasChanges := false
+
+	if d.HasChange("migration_type") {
+		input.MigrationType = aws.String(d.Get("migration_type").(string))
+		hasChanges = true
+	}
+	if d.HasChange("table_mappings") {
+		input.TableMappings = aws.String(d.Get("table_mappings").(string))
+		hasChanges = true
+	}
+	if d.HasChange("replication_task_settings") {
+		input.ReplicationTaskSettings = aws.String(d.Get("replication_task_settings").(string))
+		hasChanges = true
+	}
+	if d.HasChange("cdc_start_time") {
+		t, err := resourceAwsDmsParseCdcStartTime(d.Get("cdc_start_time").(string))
+		if err != nil {
+			return err
+		}
+		input.CdcStartTime = t
+		hasChanges = true
+	}
+
+	if hasChanges {
+		log.Printf("[DEBUG] Updating DMS replication task: %s", input)
+		_, err := conn.ModifyReplicationTask(input)
+		if err != nil {
+			return fmt.Errorf("Error updating DMS replication task %s: %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"modifying"},
+			Target:     []string{"ready", "stopped", "failed"},
+			Refresh:    resourceAwsDmsReplicationTaskStateRefreshFunc(conn, d.Id()),
+			Timeout:    10 * time.Minute,
+			MinTimeout: 10 * time.Second,
+			Delay:      30 * time.Second,
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("Error waiting for DMS replication task %s to be modified: %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsDMS(conn, d, arn); err != nil {
+		return err
+	}
+	d.SetPartial("tags")
+
+	d.Partial(false)
+	return resourceAwsDmsReplicationTaskRead(d, meta)
+}
+
+func resourceAwsDmsReplicationTaskDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dmsconn
+
+	log.Printf("[DEBUG] Deleting DMS replication task %s", d.Id())
+	_, err := conn.DeleteReplicationTask(&dms.DeleteReplicationTaskInput{
+		ReplicationTaskArn: aws.String(d.Get("replication_task_arn").(string)),
+	})
+	if err != nil {
+		if isAWSErr(err, dms.ErrCodeResourceNotFoundFault, "") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error deleting DMS replication task %s: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"deleting"},
+		Target:     []string{"deleted"},
+		Refresh:    resourceAwsDmsReplicationTaskStateRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for DMS replication task %s to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceAwsDmsParseCdcStartTime(v string) (*time.Time, error) {
+	seconds, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("cdc_start_time must be a Unix timestamp: %s", err)
+	}
+	t := time.Unix(seconds, 0)
+	return &t, nil
+}
+
+// resourceAwsDmsDescribeReplicationTask returns the replication task with
+// the given identifier, or nil if it does not exist.
+func resourceAwsDmsDescribeReplicationTask(conn *dms.DatabaseMigrationService, id string) (*dms.ReplicationTask, error) {
+	out, err := conn.DescribeReplicationTasks(&dms.DescribeReplicationTasksInput{
+		Filters: []*dms.Filter{
+			{
+				Name:   aws.String("replication-task-id"),
+				Values: []*string{aws.String(id)},
+			},
+		},
+	})
+	if err != nil {
+		if isAWSErr(err, dms.ErrCodeResourceNotFoundFault, "") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if len(out.ReplicationTasks) == 0 {
+		return nil, nil
+	}
+	return out.ReplicationTasks[0], nil
+}
+
+func resourceAwsDmsReplicationTaskStateRefreshFunc(conn *dms.DatabaseMigrationService, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		task, err := resourceAwsDmsDescribeReplicationTask(conn, id)
+		if err != nil {
+			return nil, "", err
+		}
+		if task == nil {
+			return id, "deleted", nil
+		}
+
+		return task, aws.StringValue(task.Status), nil
+	}
+}
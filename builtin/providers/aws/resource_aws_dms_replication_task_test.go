@@ -0,0 +1,171 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDmsReplicationTask_basic(t *testing.T) {
+	resourceName := "aws_dms_replication_task.test"
+	randId := acctest.RandString(8)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDmsReplicationTaskDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSDmsReplicationTaskConfig(randId, "%"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDmsReplicationTaskExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "replication_task_arn"),
+					resource.TestCheckResourceAttr(resourceName, "migration_type", "full-load"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSDmsReplicationTaskConfig(randId, "public"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDmsReplicationTaskExists(resourceName),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDmsReplicationTaskExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DMS replication task ID set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).dmsconn
+		task, err := resourceAwsDmsDescribeReplicationTask(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if task == nil {
+			return fmt.Errorf("DMS replication task %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSDmsReplicationTaskDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dmsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dms_replication_task" {
+			continue
+		}
+
+		task, err := resourceAwsDmsDescribeReplicationTask(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if task != nil {
+			return fmt.Errorf("DMS replication task %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSDmsReplicationTaskConfig(randId, schemaName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_vpc" "dms_vpc" {
+  cidr_block = "10.1.0.0/16"
+
+  tags {
+    Name = "tf-test-dms-vpc-%[1]s"
+  }
+}
+
+resource "aws_subnet" "dms_subnet_1" {
+  cidr_block        = "10.1.1.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  vpc_id            = "${aws_vpc.dms_vpc.id}"
+}
+
+resource "aws_subnet" "dms_subnet_2" {
+  cidr_block        = "10.1.2.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[1]}"
+  vpc_id            = "${aws_vpc.dms_vpc.id}"
+}
+
+resource "aws_dms_endpoint" "dms_endpoint_source" {
+  endpoint_id   = "tf-test-dms-endpoint-source-%[1]s"
+  endpoint_type = "source"
+  engine_name   = "aurora"
+  server_name   = "tftest"
+  port          = 3306
+  username      = "tftest"
+  password      = "tftest"
+}
+
+resource "aws_dms_endpoint" "dms_endpoint_target" {
+  endpoint_id   = "tf-test-dms-endpoint-target-%[1]s"
+  endpoint_type = "target"
+  engine_name   = "aurora"
+  server_name   = "tftest"
+  port          = 3306
+  username      = "tftest"
+  password      = "tftest"
+}
+
+resource "aws_dms_replication_subnet_group" "dms_replication_subnet_group" {
+  replication_subnet_group_id          = "tf-test-dms-replication-subnet-group-%[1]s"
+  replication_subnet_group_description = "terraform test for replication subnet group"
+  subnet_ids                           = ["${aws_subnet.dms_subnet_1.id}", "${aws_subnet.dms_subnet_2.id}"]
+}
+
+resource "aws_dms_replication_instance" "dms_replication_instance" {
+  replication_instance_id     = "tf-test-dms-replication-instance-%[1]s"
+  replication_instance_class  = "dms.t2.micro"
+  allocated_storage           = 5
+  publicly_accessible         = false
+  replication_subnet_group_id = "${aws_dms_replication_subnet_group.dms_replication_subnet_group.id}"
+}
+
+resource "aws_dms_replication_task" "test" {
+  replication_task_id      = "tf-test-dms-replication-task-%[1]s"
+  migration_type           = "full-load"
+  replication_instance_arn = "${aws_dms_replication_instance.dms_replication_instance.replication_instance_arn}"
+  source_endpoint_arn      = "${aws_dms_endpoint.dms_endpoint_source.endpoint_arn}"
+  target_endpoint_arn      = "${aws_dms_endpoint.dms_endpoint_target.endpoint_arn}"
+
+  table_mappings = <<EOF
+{
+  "rules": [
+    {
+      "rule-type": "selection",
+      "rule-id": "1",
+      "rule-name": "1",
+      "object-locator": {
+        "schema-name": "%[2]s",
+        "table-name": "%%"
+      },
+      "rule-action": "include"
+    }
+  ]
+}
+EOF
+}
+`, randId, schemaName)
+}
@@ -0,0 +1,171 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsDxConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDxConnectionCreate,
+		Read:   resourceAwsDxConnectionRead,
+		Update: resourceAwsDxConnectionUpdate,
+		Delete: resourceAwsDxConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bandwidth": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"1Gbps", "10Gbps"}, false),
+			},
+			"location": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsDxConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	input := &directconnect.CreateConnectionInput{
+		Bandwidth:      aws.String(d.Get("bandwidth").(string)),
+		ConnectionName: aws.String(d.Get("name").(string)),
+		Location:       aws.String(d.Get("location").(string)),
+	}
+	log.Printf("[DEBUG] Creating Direct Connect connection: %s", input)
+	resp, err := conn.CreateConnection(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Direct Connect connection: %s", err)
+	}
+
+	// The connection stays requested until the cross connect to the
+	// Direct Connect location is set up, so there's nothing to wait for.
+	d.SetId(*resp.ConnectionId)
+	return resourceAwsDxConnectionUpdate(d, meta)
+}
+
+func resourceAwsDxConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	connRaw, state, err := dxConnectionRefreshFunc(conn, d.Id())()
+	if err != nil {
+		return fmt.Errorf("Error reading Direct Connect connection %s: %s", d.Id(), err)
+	}
+	if state == directconnect.ConnectionStateDeleted ||
+		state == directconnect.ConnectionStateRejected {
+		log.Printf("[WARN] Direct Connect connection %s is %s, removing from state", d.Id(), state)
+		d.SetId("")
+		return nil
+	}
+	connection := connRaw.(*directconnect.Connection)
+
+	arn, err := buildDXARN(fmt.Sprintf("dxcon/%s", d.Id()), meta)
+	if err != nil {
+		return err
+	}
+	d.Set("arn", arn)
+	d.Set("name", connection.ConnectionName)
+	d.Set("bandwidth", connection.Bandwidth)
+	d.Set("location", connection.Location)
+
+	return getTagsDX(conn, d, arn)
+}
+
+func resourceAwsDxConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	arn, err := buildDXARN(fmt.Sprintf("dxcon/%s", d.Id()), meta)
+	if err != nil {
+		return err
+	}
+	if err := setTagsDX(conn, d, arn); err != nil {
+		return err
+	}
+
+	return resourceAwsDxConnectionRead(d, meta)
+}
+
+func resourceAwsDxConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	log.Printf("[DEBUG] Deleting Direct Connect connection: %s", d.Id())
+	_, err := conn.DeleteConnection(&directconnect.DeleteConnectionInput{
+		ConnectionId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isNoSuchDxConnectionErr(err) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Direct Connect connection %s: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			directconnect.ConnectionStatePending,
+			directconnect.ConnectionStateOrdering,
+			directconnect.ConnectionStateAvailable,
+			directconnect.ConnectionStateRequested,
+			directconnect.ConnectionStateDeleting,
+		},
+		Target:     []string{directconnect.ConnectionStateDeleted},
+		Refresh:    dxConnectionRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Direct Connect connection %s to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// dxConnectionRefreshFunc returns the state of a connection. Connections
+// that no longer exist are reported as deleted.
+func dxConnectionRefreshFunc(conn *directconnect.DirectConnect, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeConnections(&directconnect.DescribeConnectionsInput{
+			ConnectionId: aws.String(id),
+		})
+		if err != nil {
+			if isNoSuchDxConnectionErr(err) {
+				return "", directconnect.ConnectionStateDeleted, nil
+			}
+			return nil, "", err
+		}
+		if len(resp.Connections) < 1 {
+			return "", directconnect.ConnectionStateDeleted, nil
+		}
+
+		connection := resp.Connections[0]
+		return connection, aws.StringValue(connection.ConnectionState), nil
+	}
+}
+
+func isNoSuchDxConnectionErr(err error) bool {
+	return isAWSErr(err, directconnect.ErrCodeClientException, "Could not find Connection")
+}
@@ -0,0 +1,104 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDxConnection_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-testacc-dxcon-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDxConnectionDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDxConnectionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxConnectionExists("aws_dx_connection.test"),
+					resource.TestCheckResourceAttr("aws_dx_connection.test", "name", rName),
+					resource.TestCheckResourceAttr("aws_dx_connection.test", "bandwidth", "1Gbps"),
+					resource.TestCheckResourceAttr("aws_dx_connection.test", "location", "EqSe2"),
+					resource.TestCheckResourceAttr("aws_dx_connection.test", "tags.%", "1"),
+					resource.TestCheckResourceAttr("aws_dx_connection.test", "tags.Usage", "original"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDxConnectionConfig_tagsChanged(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxConnectionExists("aws_dx_connection.test"),
+					resource.TestCheckResourceAttr("aws_dx_connection.test", "tags.%", "1"),
+					resource.TestCheckResourceAttr("aws_dx_connection.test", "tags.Usage", "changed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDxConnectionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dxconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dx_connection" {
+			continue
+		}
+
+		_, state, err := dxConnectionRefreshFunc(conn, rs.Primary.ID)()
+		if err != nil {
+			return err
+		}
+		if state != directconnect.ConnectionStateDeleted {
+			return fmt.Errorf("Direct Connect connection %s still exists: %s", rs.Primary.ID, state)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsDxConnectionExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccDxConnectionConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dx_connection" "test" {
+	name = "%s"
+	bandwidth = "1Gbps"
+	location = "EqSe2"
+
+	tags {
+		Usage = "original"
+	}
+}
+`, rName)
+}
+
+func testAccDxConnectionConfig_tagsChanged(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dx_connection" "test" {
+	name = "%s"
+	bandwidth = "1Gbps"
+	location = "EqSe2"
+
+	tags {
+		Usage = "changed"
+	}
+}
+`, rName)
+}
@@ -0,0 +1,150 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDxGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDxGatewayCreate,
+		Read:   resourceAwsDxGatewayRead,
+		Delete: resourceAwsDxGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// The ASN is a string as it doesn't fit in an int on 32-bit
+			// platforms.
+			"amazon_side_asn": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAmazonSideAsn,
+			},
+		},
+	}
+}
+
+func resourceAwsDxGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	asn, err := strconv.ParseInt(d.Get("amazon_side_asn").(string), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	input := &directconnect.CreateDirectConnectGatewayInput{
+		DirectConnectGatewayName: aws.String(d.Get("name").(string)),
+		AmazonSideAsn:            aws.Int64(asn),
+	}
+	log.Printf("[DEBUG] Creating Direct Connect gateway: %s", input)
+	resp, err := conn.CreateDirectConnectGateway(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Direct Connect gateway: %s", err)
+	}
+
+	d.SetId(*resp.DirectConnectGateway.DirectConnectGatewayId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{directconnect.GatewayStatePending},
+		Target:     []string{directconnect.GatewayStateAvailable},
+		Refresh:    dxGatewayRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Direct Connect gateway %s to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsDxGatewayRead(d, meta)
+}
+
+func resourceAwsDxGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	gwRaw, state, err := dxGatewayRefreshFunc(conn, d.Id())()
+	if err != nil {
+		return fmt.Errorf("Error reading Direct Connect gateway %s: %s", d.Id(), err)
+	}
+	if state == directconnect.GatewayStateDeleted {
+		log.Printf("[WARN] Direct Connect gateway %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	gateway := gwRaw.(*directconnect.Gateway)
+
+	d.Set("name", gateway.DirectConnectGatewayName)
+	d.Set("amazon_side_asn", strconv.FormatInt(aws.Int64Value(gateway.AmazonSideAsn), 10))
+
+	return nil
+}
+
+func resourceAwsDxGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	log.Printf("[DEBUG] Deleting Direct Connect gateway: %s", d.Id())
+	_, err := conn.DeleteDirectConnectGateway(&directconnect.DeleteDirectConnectGatewayInput{
+		DirectConnectGatewayId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, directconnect.ErrCodeClientException, "does not exist") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Direct Connect gateway %s: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			directconnect.GatewayStatePending,
+			directconnect.GatewayStateAvailable,
+			directconnect.GatewayStateDeleting,
+		},
+		Target:     []string{directconnect.GatewayStateDeleted},
+		Refresh:    dxGatewayRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Direct Connect gateway %s to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// dxGatewayRefreshFunc returns the state of a Direct Connect gateway.
+// Gateways that no longer exist are reported as deleted.
+func dxGatewayRefreshFunc(conn *directconnect.DirectConnect, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeDirectConnectGateways(&directconnect.DescribeDirectConnectGatewaysInput{
+			DirectConnectGatewayId: aws.String(id),
+		})
+		if err != nil {
+			if isAWSErr(err, directconnect.ErrCodeClientException, "does not exist") {
+				return "", directconnect.GatewayStateDeleted, nil
+			}
+			return nil, "", err
+		}
+		if len(resp.DirectConnectGateways) < 1 {
+			return "", directconnect.GatewayStateDeleted, nil
+		}
+
+		gateway := resp.DirectConnectGateways[0]
+		return gateway, aws.StringValue(gateway.DirectConnectGatewayState), nil
+	}
+}
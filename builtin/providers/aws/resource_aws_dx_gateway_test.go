@@ -0,0 +1,74 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDxGateway_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-testacc-dxgw-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDxGatewayDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDxGatewayConfig(rName, 64512),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxGatewayExists("aws_dx_gateway.test"),
+					resource.TestCheckResourceAttr("aws_dx_gateway.test", "name", rName),
+					resource.TestCheckResourceAttr("aws_dx_gateway.test", "amazon_side_asn", "64512"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDxGatewayDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dxconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dx_gateway" {
+			continue
+		}
+
+		_, state, err := dxGatewayRefreshFunc(conn, rs.Primary.ID)()
+		if err != nil {
+			return err
+		}
+		if state != directconnect.GatewayStateDeleted {
+			return fmt.Errorf("Direct Connect gateway %s still exists: %s", rs.Primary.ID, state)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsDxGatewayExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccDxGatewayConfig(rName string, asn int) string {
+	return fmt.Sprintf(`
+resource "aws_dx_gateway" "test" {
+	name = "%s"
+	amazon_side_asn = "%d"
+}
+`, rName, asn)
+}
@@ -0,0 +1,304 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsDxPrivateVirtualInterface() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDxPrivateVirtualInterfaceCreate,
+		Read:   resourceAwsDxPrivateVirtualInterfaceRead,
+		Update: resourceAwsDxPrivateVirtualInterfaceUpdate,
+		Delete: resourceAwsDxPrivateVirtualInterfaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"connection_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vlan": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 4094),
+			},
+			"bgp_asn": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"bgp_auth_key": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Computed:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"address_family": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					directconnect.AddressFamilyIpv4,
+					directconnect.AddressFamilyIpv6,
+				}, false),
+			},
+			"customer_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"amazon_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"vpn_gateway_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"dx_gateway_id"},
+			},
+			"dx_gateway_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"vpn_gateway_id"},
+			},
+			"mtu": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1500,
+				ValidateFunc: validation.IntInSlice([]int{1500, 9001}),
+			},
+			"arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsDxPrivateVirtualInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	vgwIdRaw, vgwOk := d.GetOk("vpn_gateway_id")
+	dxgwIdRaw, dxgwOk := d.GetOk("dx_gateway_id")
+	if !vgwOk && !dxgwOk {
+		return fmt.Errorf("One of vpn_gateway_id or dx_gateway_id must be set")
+	}
+
+	vif := &directconnect.NewPrivateVirtualInterface{
+		VirtualInterfaceName: aws.String(d.Get("name").(string)),
+		Vlan:                 aws.Int64(int64(d.Get("vlan").(int))),
+		Asn:                  aws.Int64(int64(d.Get("bgp_asn").(int))),
+		AddressFamily:        aws.String(d.Get("address_family").(string)),
+		Mtu:                  aws.Int64(int64(d.Get("mtu").(int))),
+	}
+	if vgwOk {
+		vif.VirtualGatewayId = aws.String(vgwIdRaw.(string))
+	}
+	if dxgwOk {
+		vif.DirectConnectGatewayId = aws.String(dxgwIdRaw.(string))
+	}
+	if v, ok := d.GetOk("bgp_auth_key"); ok {
+		vif.AuthKey = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("customer_address"); ok {
+		vif.CustomerAddress = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("amazon_address"); ok {
+		vif.AmazonAddress = aws.String(v.(string))
+	}
+
+	input := &directconnect.CreatePrivateVirtualInterfaceInput{
+		ConnectionId:               aws.String(d.Get("connection_id").(string)),
+		NewPrivateVirtualInterface: vif,
+	}
+	log.Printf("[DEBUG] Creating Direct Connect private virtual interface: %s", input)
+	resp, err := conn.CreatePrivateVirtualInterface(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Direct Connect private virtual interface: %s", err)
+	}
+
+	d.SetId(*resp.VirtualInterfaceId)
+
+	if err := dxPrivateVirtualInterfaceWaitUntilAvailable(conn, d.Id()); err != nil {
+		return err
+	}
+
+	return resourceAwsDxPrivateVirtualInterfaceUpdate(d, meta)
+}
+
+func resourceAwsDxPrivateVirtualInterfaceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	vifRaw, state, err := dxVirtualInterfaceRefreshFunc(conn, d.Id())()
+	if err != nil {
+		return fmt.Errorf("Error reading Direct Connect virtual interface %s: %s", d.Id(), err)
+	}
+	if state == directconnect.VirtualInterfaceStateDeleted ||
+		state == directconnect.VirtualInterfaceStateRejected {
+		log.Printf("[WARN] Direct Connect virtual interface %s is %s, removing from state", d.Id(), state)
+		d.SetId("")
+		return nil
+	}
+	vif := vifRaw.(*directconnect.VirtualInterface)
+
+	arn, err := buildDXARN(fmt.Sprintf("dxvif/%s", d.Id()), meta)
+	if err != nil {
+		return err
+	}
+	d.Set("arn", arn)
+	d.Set("connection_id", vif.ConnectionId)
+	d.Set("name", vif.VirtualInterfaceName)
+	d.Set("vlan", vif.Vlan)
+	d.Set("bgp_asn", vif.Asn)
+	d.Set("bgp_auth_key", vif.AuthKey)
+	d.Set("address_family", vif.AddressFamily)
+	d.Set("customer_address", vif.CustomerAddress)
+	d.Set("amazon_address", vif.AmazonAddress)
+	d.Set("vpn_gateway_id", vif.VirtualGatewayId)
+	d.Set("dx_gateway_id", vif.DirectConnectGatewayId)
+	d.Set("mtu", vif.Mtu)
+
+	return getTagsDX(conn, d, arn)
+}
+
+func resourceAwsDxPrivateVirtualInterfaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	// The MTU is given on creation, so it's only updated on changes
+	// after that.
+	if d.HasChange("mtu") && !d.IsNewResource() {
+		input := &directconnect.UpdateVirtualInterfaceAttributesInput{
+			VirtualInterfaceId: aws.String(d.Id()),
+			Mtu:                aws.Int64(int64(d.Get("mtu").(int))),
+		}
+		log.Printf("[DEBUG] Modifying Direct Connect virtual interface attributes: %s", input)
+		if _, err := conn.UpdateVirtualInterfaceAttributes(input); err != nil {
+			return fmt.Errorf("Error modifying Direct Connect virtual interface %s: %s", d.Id(), err)
+		}
+
+		if err := dxPrivateVirtualInterfaceWaitUntilAvailable(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	arn, err := buildDXARN(fmt.Sprintf("dxvif/%s", d.Id()), meta)
+	if err != nil {
+		return err
+	}
+	if err := setTagsDX(conn, d, arn); err != nil {
+		return err
+	}
+
+	return resourceAwsDxPrivateVirtualInterfaceRead(d, meta)
+}
+
+func resourceAwsDxPrivateVirtualInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	log.Printf("[DEBUG] Deleting Direct Connect virtual interface: %s", d.Id())
+	_, err := conn.DeleteVirtualInterface(&directconnect.DeleteVirtualInterfaceInput{
+		VirtualInterfaceId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isNoSuchDxVirtualInterfaceErr(err) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Direct Connect virtual interface %s: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			directconnect.VirtualInterfaceStateAvailable,
+			directconnect.VirtualInterfaceStateConfirming,
+			directconnect.VirtualInterfaceStateDeleting,
+			directconnect.VirtualInterfaceStateDown,
+			directconnect.VirtualInterfaceStatePending,
+			directconnect.VirtualInterfaceStateRejected,
+			directconnect.VirtualInterfaceStateVerifying,
+		},
+		Target:     []string{directconnect.VirtualInterfaceStateDeleted},
+		Refresh:    dxVirtualInterfaceRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Direct Connect virtual interface %s to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// dxPrivateVirtualInterfaceWaitUntilAvailable waits for a private virtual
+// interface to be usable. Interfaces are down until the BGP session with
+// the customer router is established, which Terraform can't wait for.
+func dxPrivateVirtualInterfaceWaitUntilAvailable(conn *directconnect.DirectConnect, id string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			directconnect.VirtualInterfaceStatePending,
+		},
+		Target: []string{
+			directconnect.VirtualInterfaceStateAvailable,
+			directconnect.VirtualInterfaceStateDown,
+		},
+		Refresh:    dxVirtualInterfaceRefreshFunc(conn, id),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Direct Connect virtual interface %s to become available: %s", id, err)
+	}
+
+	return nil
+}
+
+// dxVirtualInterfaceRefreshFunc returns the state of a virtual interface.
+// Interfaces that no longer exist are reported as deleted.
+func dxVirtualInterfaceRefreshFunc(conn *directconnect.DirectConnect, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeVirtualInterfaces(&directconnect.DescribeVirtualInterfacesInput{
+			VirtualInterfaceId: aws.String(id),
+		})
+		if err != nil {
+			if isNoSuchDxVirtualInterfaceErr(err) {
+				return "", directconnect.VirtualInterfaceStateDeleted, nil
+			}
+			return nil, "", err
+		}
+		if len(resp.VirtualInterfaces) < 1 {
+			return "", directconnect.VirtualInterfaceStateDeleted, nil
+		}
+
+		vif := resp.VirtualInterfaces[0]
+		return vif, aws.StringValue(vif.VirtualInterfaceState), nil
+	}
+}
+
+func isNoSuchDxVirtualInterfaceErr(err error) bool {
+	return isAWSErr(err, directconnect.ErrCodeClientException, "does not exist")
+}
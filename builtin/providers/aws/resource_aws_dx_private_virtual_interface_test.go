@@ -0,0 +1,158 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Virtual interfaces can only be created on a connection that is
+// available, which takes a physical cross connect to set up.
+func testAccDxPrivateVirtualInterfaceConnectionId(t *testing.T) string {
+	connectionId := os.Getenv("DX_CONNECTION_ID")
+	if connectionId == "" {
+		t.Skip(
+			"Environment variable DX_CONNECTION_ID is not set. " +
+				"This environment variable must be set to the ID of an " +
+				"available Direct Connect connection to enable this test.")
+	}
+	return connectionId
+}
+
+func TestAccAWSDxPrivateVirtualInterface_basic(t *testing.T) {
+	connectionId := testAccDxPrivateVirtualInterfaceConnectionId(t)
+	vifName := fmt.Sprintf("tf-dx-vif-%s", acctest.RandString(5))
+	bgpAsn := 64512 + acctest.RandInt()%1023
+	vlan := 2049 + acctest.RandInt()%2046
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDxPrivateVirtualInterfaceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDxPrivateVirtualInterfaceConfig_vgw(connectionId, vifName, bgpAsn, vlan, 1500),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxPrivateVirtualInterfaceExists("aws_dx_private_virtual_interface.foo"),
+					resource.TestCheckResourceAttr("aws_dx_private_virtual_interface.foo", "name", vifName),
+					resource.TestCheckResourceAttr("aws_dx_private_virtual_interface.foo", "mtu", "1500"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDxPrivateVirtualInterfaceConfig_vgw(connectionId, vifName, bgpAsn, vlan, 9001),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxPrivateVirtualInterfaceExists("aws_dx_private_virtual_interface.foo"),
+					resource.TestCheckResourceAttr("aws_dx_private_virtual_interface.foo", "mtu", "9001"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "aws_dx_private_virtual_interface.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDxPrivateVirtualInterface_dxGateway(t *testing.T) {
+	connectionId := testAccDxPrivateVirtualInterfaceConnectionId(t)
+	vifName := fmt.Sprintf("tf-dx-vif-%s", acctest.RandString(5))
+	amzAsn := 64512 + acctest.RandInt()%1023
+	bgpAsn := 64512 + acctest.RandInt()%1023
+	vlan := 2049 + acctest.RandInt()%2046
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDxPrivateVirtualInterfaceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDxPrivateVirtualInterfaceConfig_dxGateway(connectionId, vifName, amzAsn, bgpAsn, vlan),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxPrivateVirtualInterfaceExists("aws_dx_private_virtual_interface.foo"),
+					resource.TestCheckResourceAttrSet(
+						"aws_dx_private_virtual_interface.foo", "dx_gateway_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDxPrivateVirtualInterfaceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dxconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dx_private_virtual_interface" {
+			continue
+		}
+
+		_, state, err := dxVirtualInterfaceRefreshFunc(conn, rs.Primary.ID)()
+		if err != nil {
+			return err
+		}
+		if state != directconnect.VirtualInterfaceStateDeleted {
+			return fmt.Errorf("Direct Connect virtual interface %s still exists: %s", rs.Primary.ID, state)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsDxPrivateVirtualInterfaceExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccDxPrivateVirtualInterfaceConfig_vgw(cid, n string, bgpAsn, vlan, mtu int) string {
+	return fmt.Sprintf(`
+resource "aws_vpn_gateway" "foo" {
+	tags {
+		Name = "%s"
+	}
+}
+
+resource "aws_dx_private_virtual_interface" "foo" {
+	connection_id = "%s"
+
+	vpn_gateway_id = "${aws_vpn_gateway.foo.id}"
+	name = "%s"
+	vlan = %d
+	address_family = "ipv4"
+	bgp_asn = %d
+	mtu = %d
+}
+`, n, cid, n, vlan, bgpAsn, mtu)
+}
+
+func testAccDxPrivateVirtualInterfaceConfig_dxGateway(cid, n string, amzAsn, bgpAsn, vlan int) string {
+	return fmt.Sprintf(`
+resource "aws_dx_gateway" "foo" {
+	name = "%s"
+	amazon_side_asn = "%d"
+}
+
+resource "aws_dx_private_virtual_interface" "foo" {
+	connection_id = "%s"
+
+	dx_gateway_id = "${aws_dx_gateway.foo.id}"
+	name = "%s"
+	vlan = %d
+	address_family = "ipv4"
+	bgp_asn = %d
+}
+`, n, amzAsn, cid, n, vlan, bgpAsn)
+}
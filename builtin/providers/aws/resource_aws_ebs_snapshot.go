@@ -0,0 +1,202 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsEbsSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEbsSnapshotCreate,
+		Read:   resourceAwsEbsSnapshotRead,
+		Update: resourceAwsEbsSnapshotUpdate,
+		Delete: resourceAwsEbsSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"volume_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"owner_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner_alias": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encrypted": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"volume_size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"kms_key_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_encryption_key_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsEbsSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	request := &ec2.CreateSnapshotInput{
+		VolumeId: aws.String(d.Get("volume_id").(string)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		request.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] EBS Snapshot create opts: %s", request)
+	res, err := conn.CreateSnapshot(request)
+	if err != nil {
+		return fmt.Errorf("Error creating EBS Snapshot of volume %s: %s",
+			d.Get("volume_id").(string), err)
+	}
+
+	d.SetId(*res.SnapshotId)
+
+	if _, ok := d.GetOk("tags"); ok {
+		if err := setTags(conn, d); err != nil {
+			return errwrap.Wrapf("Error setting tags for EBS Snapshot: {{err}}", err)
+		}
+	}
+
+	log.Printf("[DEBUG] Waiting for EBS Snapshot (%s) to complete", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending"},
+		Target:     []string{"completed"},
+		Refresh:    ebsSnapshotStateRefreshFunc(conn, d.Id()),
+		Timeout:    60 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for EBS Snapshot (%s) to complete: %s", d.Id(), err)
+	}
+
+	return resourceAwsEbsSnapshotRead(d, meta)
+}
+
+func resourceAwsEbsSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	res, err := conn.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
+		SnapshotIds: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidSnapshot.NotFound", "") {
+			log.Printf("[WARN] EBS Snapshot (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading EBS Snapshot %s: %s", d.Id(), err)
+	}
+	if len(res.Snapshots) == 0 {
+		log.Printf("[WARN] EBS Snapshot (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return readEbsSnapshot(d, res.Snapshots[0])
+}
+
+func resourceAwsEbsSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	if err := setTags(conn, d); err != nil {
+		return errwrap.Wrapf("Error updating tags for EBS Snapshot: {{err}}", err)
+	}
+	return resourceAwsEbsSnapshotRead(d, meta)
+}
+
+func resourceAwsEbsSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	// A snapshot can't be deleted while an AMI that is being registered
+	// from it still uses it.
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteSnapshot(&ec2.DeleteSnapshotInput{
+			SnapshotId: aws.String(d.Id()),
+		})
+		if err != nil {
+			if isAWSErr(err, "InvalidSnapshot.NotFound", "") {
+				return nil
+			}
+			if isAWSErr(err, "InvalidSnapshot.InUse", "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting EBS Snapshot %s: %s", d.Id(), err)
+	}
+	return nil
+}
+
+// ebsSnapshotStateRefreshFunc returns a resource.StateRefreshFunc that is
+// used to watch the state of an EBS Snapshot until it's completed.
+func ebsSnapshotStateRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := conn.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
+			SnapshotIds: []*string{aws.String(id)},
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		if len(res.Snapshots) == 0 {
+			return nil, "", nil
+		}
+
+		s := res.Snapshots[0]
+		if *s.State == ec2.SnapshotStateError {
+			msg := "unknown error"
+			if s.StateMessage != nil {
+				msg = *s.StateMessage
+			}
+			return s, *s.State, fmt.Errorf("EBS Snapshot %s failed: %s", id, msg)
+		}
+		return s, *s.State, nil
+	}
+}
+
+func readEbsSnapshot(d *schema.ResourceData, snapshot *ec2.Snapshot) error {
+	d.Set("volume_id", snapshot.VolumeId)
+	d.Set("description", snapshot.Description)
+	d.Set("owner_id", snapshot.OwnerId)
+	d.Set("owner_alias", snapshot.OwnerAlias)
+	d.Set("encrypted", snapshot.Encrypted)
+	d.Set("volume_size", snapshot.VolumeSize)
+	d.Set("kms_key_id", snapshot.KmsKeyId)
+	d.Set("data_encryption_key_id", snapshot.DataEncryptionKeyId)
+	d.Set("tags", tagsToMap(snapshot.Tags))
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEBSSnapshot_basic(t *testing.T) {
+	var v ec2.Snapshot
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_ebs_snapshot.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckAWSEBSSnapshotDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAwsEbsSnapshotConfig("first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotExists("aws_ebs_snapshot.test", &v),
+					resource.TestCheckResourceAttr("aws_ebs_snapshot.test", "description", "terraform test"),
+					resource.TestCheckResourceAttr("aws_ebs_snapshot.test", "volume_size", "1"),
+					resource.TestCheckResourceAttr("aws_ebs_snapshot.test", "tags.Name", "first"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAwsEbsSnapshotConfig("second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotExists("aws_ebs_snapshot.test", &v),
+					resource.TestCheckResourceAttr("aws_ebs_snapshot.test", "tags.Name", "second"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSnapshotExists(n string, v *ec2.Snapshot) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		response, err := conn.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
+			SnapshotIds: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+		if len(response.Snapshots) == 0 {
+			return fmt.Errorf("Snapshot %s not found", rs.Primary.ID)
+		}
+
+		*v = *response.Snapshots[0]
+		return nil
+	}
+}
+
+func testAccCheckAWSEBSSnapshotDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ebs_snapshot" {
+			continue
+		}
+
+		response, err := conn.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
+			SnapshotIds: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			if isAWSErr(err, "InvalidSnapshot.NotFound", "") {
+				continue
+			}
+			return err
+		}
+		if len(response.Snapshots) > 0 {
+			return fmt.Errorf("Snapshot %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsEbsSnapshotConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_ebs_volume" "test" {
+	availability_zone = "us-west-2a"
+	size = 1
+}
+
+resource "aws_ebs_snapshot" "test" {
+	volume_id = "${aws_ebs_volume.test.id}"
+	description = "terraform test"
+
+	tags {
+		Name = "%s"
+	}
+}
+`, name)
+}
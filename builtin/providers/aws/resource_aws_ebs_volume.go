@@ -207,7 +207,18 @@ func resourceAwsEbsVolumeDelete(d *schema.ResourceData, meta interface{}) error
 		VolumeId: aws.String(d.Id()),
 	}
 
-	_, err := conn.DeleteVolume(request)
+	// The volume may still be attached to an instance that is being
+	// terminated, or whose attachment was removed with skip_destroy.
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteVolume(request)
+		if err != nil {
+			if isAWSErr(err, "VolumeInUse", "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
 	if err != nil {
 		return fmt.Errorf("Error deleting EC2 volume %s: %s", d.Id(), err)
 	}
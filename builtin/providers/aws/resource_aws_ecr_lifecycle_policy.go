@@ -0,0 +1,102 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsEcrLifecyclePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEcrLifecyclePolicyPut,
+		Read:   resourceAwsEcrLifecyclePolicyRead,
+		Update: resourceAwsEcrLifecyclePolicyPut,
+		Delete: resourceAwsEcrLifecyclePolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+			"registry_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsEcrLifecyclePolicyPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrconn
+
+	input := &ecr.PutLifecyclePolicyInput{
+		RepositoryName:      aws.String(d.Get("repository").(string)),
+		LifecyclePolicyText: aws.String(d.Get("policy").(string)),
+	}
+
+	log.Printf("[DEBUG] Putting ECR lifecycle policy: %s", input)
+	out, err := conn.PutLifecyclePolicy(input)
+	if err != nil {
+		return fmt.Errorf("Error putting ECR lifecycle policy: %s", err)
+	}
+
+	d.SetId(*out.RepositoryName)
+	d.Set("registry_id", out.RegistryId)
+
+	return resourceAwsEcrLifecyclePolicyRead(d, meta)
+}
+
+func resourceAwsEcrLifecyclePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrconn
+
+	log.Printf("[DEBUG] Reading ECR lifecycle policy %s", d.Id())
+	out, err := conn.GetLifecyclePolicy(&ecr.GetLifecyclePolicyInput{
+		RepositoryName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, ecr.ErrCodeRepositoryNotFoundException, "") ||
+			isAWSErr(err, ecr.ErrCodeLifecyclePolicyNotFoundException, "") {
+			log.Printf("[WARN] ECR lifecycle policy %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("repository", out.RepositoryName)
+	d.Set("registry_id", out.RegistryId)
+	d.Set("policy", out.LifecyclePolicyText)
+
+	return nil
+}
+
+func resourceAwsEcrLifecyclePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrconn
+
+	log.Printf("[DEBUG] Deleting ECR lifecycle policy %s", d.Id())
+	_, err := conn.DeleteLifecyclePolicy(&ecr.DeleteLifecyclePolicyInput{
+		RepositoryName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, ecr.ErrCodeRepositoryNotFoundException, "") ||
+			isAWSErr(err, ecr.ErrCodeLifecyclePolicyNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting ECR lifecycle policy %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
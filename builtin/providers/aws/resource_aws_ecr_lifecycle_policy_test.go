@@ -0,0 +1,114 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEcrLifecyclePolicy_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-ecr-lifecycle-%s", acctest.RandString(5))
+	resourceName := "aws_ecr_lifecycle_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcrLifecyclePolicyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEcrLifecyclePolicyConfig(rName, 14),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrLifecyclePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "repository", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "registry_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccEcrLifecyclePolicyConfig(rName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrLifecyclePolicyExists(resourceName),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEcrLifecyclePolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ecrconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ecr_lifecycle_policy" {
+			continue
+		}
+
+		_, err := conn.GetLifecyclePolicy(&ecr.GetLifecyclePolicyInput{
+			RepositoryName: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("ECR lifecycle policy %s still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, ecr.ErrCodeRepositoryNotFoundException, "") &&
+			!isAWSErr(err, ecr.ErrCodeLifecyclePolicyNotFoundException, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSEcrLifecyclePolicyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ecrconn
+		_, err := conn.GetLifecyclePolicy(&ecr.GetLifecyclePolicyInput{
+			RepositoryName: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
+func testAccEcrLifecyclePolicyConfig(name string, days int) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "test" {
+  name = "%s"
+}
+
+resource "aws_ecr_lifecycle_policy" "test" {
+  repository = "${aws_ecr_repository.test.name}"
+
+  policy = <<POLICY
+{
+  "rules": [
+    {
+      "rulePriority": 1,
+      "description": "Expire images older than %d days",
+      "selection": {
+        "tagStatus": "untagged",
+        "countType": "sinceImagePushed",
+        "countUnit": "days",
+        "countNumber": %d
+      },
+      "action": {
+        "type": "expire"
+      }
+    }
+  ]
+}
+POLICY
+}
+`, name, days, days)
+}
@@ -101,6 +101,81 @@ func resourceAwsEcsService() *schema.Resource {
 				},
 				Set: resourceAwsEcsLoadBalancerHash,
 			},
+
+			"network_configuration": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnets": &schema.Schema{
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+
+						"security_groups": &schema.Schema{
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+
+						"assign_public_ip": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"placement_strategy": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateEcsPlacementStrategyType,
+						},
+
+						"field": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"placement_constraints": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateEcsPlacementConstraintType,
+						},
+
+						"expression": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -132,6 +207,16 @@ func resourceAwsEcsServiceCreate(d *schema.ResourceData, meta interface{}) error
 		input.Role = aws.String(v.(string))
 	}
 
+	input.NetworkConfiguration = expandEcsNetworkConfiguration(d.Get("network_configuration").([]interface{}))
+
+	if v, ok := d.GetOk("placement_strategy"); ok {
+		input.PlacementStrategy = expandEcsPlacementStrategy(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("placement_constraints"); ok {
+		input.PlacementConstraints = expandEcsPlacementConstraints(v.(*schema.Set).List())
+	}
+
 	log.Printf("[DEBUG] Creating ECS service: %s", input)
 
 	// Retry due to AWS IAM policy eventual consistency
@@ -240,6 +325,18 @@ func resourceAwsEcsServiceRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("load_balancers", flattenEcsLoadBalancers(service.LoadBalancers))
 	}
 
+	if err := d.Set("network_configuration", flattenEcsNetworkConfiguration(service.NetworkConfiguration)); err != nil {
+		return fmt.Errorf("[ERR] Error setting network_configuration for (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("placement_strategy", flattenEcsPlacementStrategy(service.PlacementStrategy)); err != nil {
+		return fmt.Errorf("[ERR] Error setting placement_strategy for (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("placement_constraints", flattenEcsPlacementConstraints(service.PlacementConstraints)); err != nil {
+		return fmt.Errorf("[ERR] Error setting placement_constraints for (%s): %s", d.Id(), err)
+	}
+
 	return nil
 }
 
@@ -268,6 +365,10 @@ func resourceAwsEcsServiceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if d.HasChange("network_configuration") {
+		input.NetworkConfiguration = expandEcsNetworkConfiguration(d.Get("network_configuration").([]interface{}))
+	}
+
 	out, err := conn.UpdateService(&input)
 	if err != nil {
 		return err
@@ -385,6 +486,100 @@ func resourceAwsEcsLoadBalancerHash(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
+func expandEcsNetworkConfiguration(configured []interface{}) *ecs.NetworkConfiguration {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	raw := configured[0].(map[string]interface{})
+	awsVpcConfig := &ecs.AwsVpcConfiguration{
+		Subnets: expandStringSet(raw["subnets"].(*schema.Set)),
+	}
+	if v, ok := raw["security_groups"]; ok && v.(*schema.Set).Len() > 0 {
+		awsVpcConfig.SecurityGroups = expandStringSet(v.(*schema.Set))
+	}
+
+	assignPublicIp := ecs.AssignPublicIpDisabled
+	if raw["assign_public_ip"].(bool) {
+		assignPublicIp = ecs.AssignPublicIpEnabled
+	}
+	awsVpcConfig.AssignPublicIp = aws.String(assignPublicIp)
+
+	return &ecs.NetworkConfiguration{AwsvpcConfiguration: awsVpcConfig}
+}
+
+func flattenEcsNetworkConfiguration(nc *ecs.NetworkConfiguration) []interface{} {
+	if nc == nil || nc.AwsvpcConfiguration == nil {
+		return nil
+	}
+
+	result := map[string]interface{}{
+		"subnets":          schema.NewSet(schema.HashString, flattenStringList(nc.AwsvpcConfiguration.Subnets)),
+		"security_groups":  schema.NewSet(schema.HashString, flattenStringList(nc.AwsvpcConfiguration.SecurityGroups)),
+		"assign_public_ip": aws.StringValue(nc.AwsvpcConfiguration.AssignPublicIp) == ecs.AssignPublicIpEnabled,
+	}
+
+	return []interface{}{result}
+}
+
+func expandEcsPlacementStrategy(configured []interface{}) []*ecs.PlacementStrategy {
+	strategies := make([]*ecs.PlacementStrategy, 0, len(configured))
+	for _, raw := range configured {
+		p := raw.(map[string]interface{})
+		ps := &ecs.PlacementStrategy{
+			Type: aws.String(p["type"].(string)),
+		}
+		if v, ok := p["field"]; ok && v.(string) != "" {
+			ps.Field = aws.String(v.(string))
+		}
+		strategies = append(strategies, ps)
+	}
+	return strategies
+}
+
+func flattenEcsPlacementStrategy(list []*ecs.PlacementStrategy) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(list))
+	for _, ps := range list {
+		p := map[string]interface{}{
+			"type": aws.StringValue(ps.Type),
+		}
+		if ps.Field != nil {
+			p["field"] = aws.StringValue(ps.Field)
+		}
+		result = append(result, p)
+	}
+	return result
+}
+
+func expandEcsPlacementConstraints(configured []interface{}) []*ecs.PlacementConstraint {
+	constraints := make([]*ecs.PlacementConstraint, 0, len(configured))
+	for _, raw := range configured {
+		p := raw.(map[string]interface{})
+		pc := &ecs.PlacementConstraint{
+			Type: aws.String(p["type"].(string)),
+		}
+		if v, ok := p["expression"]; ok && v.(string) != "" {
+			pc.Expression = aws.String(v.(string))
+		}
+		constraints = append(constraints, pc)
+	}
+	return constraints
+}
+
+func flattenEcsPlacementConstraints(list []*ecs.PlacementConstraint) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(list))
+	for _, pc := range list {
+		c := map[string]interface{}{
+			"type": aws.StringValue(pc.Type),
+		}
+		if pc.Expression != nil {
+			c["expression"] = aws.StringValue(pc.Expression)
+		}
+		result = append(result, c)
+	}
+	return result
+}
+
 func buildFamilyAndRevisionFromARN(arn string) string {
 	return strings.Split(arn, "/")[1]
 }
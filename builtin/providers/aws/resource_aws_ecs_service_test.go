@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -199,6 +200,59 @@ func TestAccAWSEcsService_withDeploymentValues(t *testing.T) {
 }
 
 // Regression for https://github.com/hashicorp/terraform/issues/3444
+func TestAccAWSEcsService_withPlacementStrategy(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-ecs-placement-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcsServiceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSEcsServiceWithPlacement(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsServiceExists("aws_ecs_service.test"),
+					resource.TestCheckResourceAttr("aws_ecs_service.test", "placement_strategy.#", "2"),
+					resource.TestCheckResourceAttr("aws_ecs_service.test", "placement_strategy.0.type", "spread"),
+					resource.TestCheckResourceAttr("aws_ecs_service.test", "placement_strategy.0.field", "attribute:ecs.availability-zone"),
+					resource.TestCheckResourceAttr("aws_ecs_service.test", "placement_strategy.1.type", "binpack"),
+					resource.TestCheckResourceAttr("aws_ecs_service.test", "placement_strategy.1.field", "memory"),
+					resource.TestCheckResourceAttr("aws_ecs_service.test", "placement_constraints.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEcsService_withNetworkConfiguration(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-ecs-awsvpc-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcsServiceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSEcsServiceWithNetworkConfiguration(rName, "aws_security_group.allow_all_a.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsServiceExists("aws_ecs_service.test"),
+					resource.TestCheckResourceAttr("aws_ecs_service.test", "network_configuration.#", "1"),
+					resource.TestCheckResourceAttr("aws_ecs_service.test", "network_configuration.0.subnets.#", "2"),
+					resource.TestCheckResourceAttr("aws_ecs_service.test", "network_configuration.0.security_groups.#", "1"),
+					resource.TestCheckResourceAttr("aws_ecs_service.test", "network_configuration.0.assign_public_ip", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSEcsServiceWithNetworkConfiguration(rName, "aws_security_group.allow_all_b.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsServiceExists("aws_ecs_service.test"),
+					resource.TestCheckResourceAttr("aws_ecs_service.test", "network_configuration.0.security_groups.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSEcsService_withLbChanges(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -843,3 +897,128 @@ resource "aws_ecs_service" "with_alb" {
   ]
 }
 `
+
+func testAccAWSEcsServiceWithPlacement(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "default" {
+  name = "%[1]s"
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family = "%[1]s"
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 128,
+    "essential": true,
+    "image": "mongo:latest",
+    "memory": 128,
+    "name": "mongodb"
+  }
+]
+DEFINITION
+}
+
+resource "aws_ecs_service" "test" {
+  name            = "%[1]s"
+  cluster         = "${aws_ecs_cluster.default.id}"
+  task_definition = "${aws_ecs_task_definition.test.arn}"
+  desired_count   = 1
+
+  placement_strategy {
+    type  = "spread"
+    field = "attribute:ecs.availability-zone"
+  }
+
+  placement_strategy {
+    type  = "binpack"
+    field = "memory"
+  }
+
+  placement_constraints {
+    type = "distinctInstance"
+  }
+}
+`, rName)
+}
+
+func testAccAWSEcsServiceWithNetworkConfiguration(rName, securityGroup string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_vpc" "main" {
+  cidr_block = "10.10.0.0/16"
+
+  tags {
+    Name = "%[1]s"
+  }
+}
+
+resource "aws_subnet" "main" {
+  count             = 2
+  cidr_block        = "${cidrsubnet(aws_vpc.main.cidr_block, 8, count.index)}"
+  availability_zone = "${data.aws_availability_zones.available.names[count.index]}"
+  vpc_id            = "${aws_vpc.main.id}"
+}
+
+resource "aws_security_group" "allow_all_a" {
+  name        = "%[1]s-a"
+  description = "Allow all inbound traffic"
+  vpc_id      = "${aws_vpc.main.id}"
+
+  ingress {
+    protocol    = "6"
+    from_port   = 80
+    to_port     = 8000
+    cidr_blocks = ["${aws_vpc.main.cidr_block}"]
+  }
+}
+
+resource "aws_security_group" "allow_all_b" {
+  name        = "%[1]s-b"
+  description = "Allow all inbound traffic"
+  vpc_id      = "${aws_vpc.main.id}"
+
+  ingress {
+    protocol    = "6"
+    from_port   = 80
+    to_port     = 8000
+    cidr_blocks = ["${aws_vpc.main.cidr_block}"]
+  }
+}
+
+resource "aws_ecs_cluster" "default" {
+  name = "%[1]s"
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family       = "%[1]s"
+  network_mode = "awsvpc"
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 128,
+    "essential": true,
+    "image": "mongo:latest",
+    "memory": 128,
+    "name": "mongodb"
+  }
+]
+DEFINITION
+}
+
+resource "aws_ecs_service" "test" {
+  name            = "%[1]s"
+  cluster         = "${aws_ecs_cluster.default.id}"
+  task_definition = "${aws_ecs_task_definition.test.arn}"
+  desired_count   = 1
+
+  network_configuration {
+    security_groups = ["${%[2]s}"]
+    subnets         = ["${aws_subnet.main.*.id}"]
+  }
+}
+`, rName, securityGroup)
+}
@@ -87,13 +87,14 @@ func resourceAwsEcsTaskDefinition() *schema.Resource {
 func validateAwsEcsTaskDefinitionNetworkMode(v interface{}, k string) (ws []string, errors []error) {
 	value := strings.ToLower(v.(string))
 	validTypes := map[string]struct{}{
+		"awsvpc": struct{}{},
 		"bridge": struct{}{},
 		"host":   struct{}{},
 		"none":   struct{}{},
 	}
 
 	if _, ok := validTypes[value]; !ok {
-		errors = append(errors, fmt.Errorf("ECS Task Definition network_mode %q is invalid, must be `bridge`, `host` or `none`", value))
+		errors = append(errors, fmt.Errorf("ECS Task Definition network_mode %q is invalid, must be `awsvpc`, `bridge`, `host` or `none`", value))
 	}
 	return
 }
@@ -110,6 +110,7 @@ func TestAccAWSEcsTaskDefinition_withNetworkMode(t *testing.T) {
 
 func TestValidateAwsEcsTaskDefinitionNetworkMode(t *testing.T) {
 	validNames := []string{
+		"awsvpc",
 		"bridge",
 		"host",
 		"none",
@@ -69,6 +69,23 @@ func resourceAwsEip() *schema.Resource {
 				Computed: true,
 			},
 
+			"public_dns": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"private_dns": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"public_ipv4_pool": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
 			"associate_with_private_ip": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -90,6 +107,10 @@ func resourceAwsEipCreate(d *schema.ResourceData, meta interface{}) error {
 		Domain: aws.String(domainOpt),
 	}
 
+	if v, ok := d.GetOk("public_ipv4_pool"); ok {
+		allocOpts.PublicIpv4Pool = aws.String(v.(string))
+	}
+
 	log.Printf("[DEBUG] EIP create configuration: %#v", allocOpts)
 	allocResp, err := ec2conn.AllocateAddress(allocOpts)
 	if err != nil {
@@ -165,7 +186,17 @@ func resourceAwsEipRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("network_interface", "")
 	}
 	d.Set("private_ip", address.PrivateIpAddress)
+	if address.PrivateIpAddress != nil {
+		d.Set("private_dns", ec2PrivateDnsName(*address.PrivateIpAddress, meta.(*AWSClient).region))
+	} else {
+		d.Set("private_dns", "")
+	}
 	d.Set("public_ip", address.PublicIp)
+	if address.PublicIp != nil {
+		client := meta.(*AWSClient)
+		d.Set("public_dns", ec2PublicDnsName(*address.PublicIp, client.region, client.partition))
+	}
+	d.Set("public_ipv4_pool", address.PublicIpv4Pool)
 
 	// On import (domain never set, which it must've been if we created),
 	// set the 'vpc' attribute depending on if we're in a VPC.
@@ -324,3 +355,31 @@ func resourceAwsEipDomain(d *schema.ResourceData) string {
 
 	return "standard"
 }
+
+// ec2PublicDnsName returns the public DNS hostname EC2 assigns to a public
+// IPv4 address, e.g. ec2-203-0-113-25.us-west-2.compute.amazonaws.com
+func ec2PublicDnsName(ip, region, partition string) string {
+	domain := "amazonaws.com"
+	if partition == "aws-cn" {
+		domain = "amazonaws.com.cn"
+	}
+
+	// us-east-1 predates the regional naming scheme
+	if region == "us-east-1" {
+		return fmt.Sprintf("ec2-%s.compute-1.%s", ec2DashedIp(ip), domain)
+	}
+	return fmt.Sprintf("ec2-%s.%s.compute.%s", ec2DashedIp(ip), region, domain)
+}
+
+// ec2PrivateDnsName returns the internal DNS hostname EC2 assigns to a
+// private IPv4 address, e.g. ip-10-0-0-12.us-west-2.compute.internal
+func ec2PrivateDnsName(ip, region string) string {
+	if region == "us-east-1" {
+		return fmt.Sprintf("ip-%s.ec2.internal", ec2DashedIp(ip))
+	}
+	return fmt.Sprintf("ip-%s.%s.compute.internal", ec2DashedIp(ip), region)
+}
+
+func ec2DashedIp(ip string) string {
+	return strings.Replace(ip, ".", "-", -1)
+}
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -71,12 +72,81 @@ func TestAccAWSEIP_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSEIPExists("aws_eip.bar", &conf),
 					testAccCheckAWSEIPAttributes(&conf),
+					resource.TestMatchResourceAttr(
+						"aws_eip.bar", "public_dns", regexp.MustCompile(`^ec2-[0-9-]+\..*amazonaws\.com`)),
 				),
 			},
 		},
 	})
 }
 
+func TestAccAWSEIP_publicIpv4Pool(t *testing.T) {
+	var conf ec2.Address
+
+	// Allocating from a pool requires a BYOIP address range provisioned
+	// in the account, so the pool ID has to be supplied externally.
+	pool := os.Getenv("AWS_EC2_EIP_PUBLIC_IPV4_POOL")
+	if pool == "" {
+		t.Skip("Environment variable AWS_EC2_EIP_PUBLIC_IPV4_POOL is not set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEIPDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSEIPConfig_publicIpv4Pool(pool),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEIPExists("aws_eip.bar", &conf),
+					resource.TestCheckResourceAttr("aws_eip.bar", "public_ipv4_pool", pool),
+				),
+			},
+		},
+	})
+}
+
+func TestEc2DnsNames(t *testing.T) {
+	cases := []struct {
+		IP        string
+		Region    string
+		Partition string
+		Public    string
+		Private   string
+	}{
+		{
+			IP:        "203.0.113.25",
+			Region:    "us-west-2",
+			Partition: "aws",
+			Public:    "ec2-203-0-113-25.us-west-2.compute.amazonaws.com",
+			Private:   "ip-203-0-113-25.us-west-2.compute.internal",
+		},
+		{
+			IP:        "10.0.0.12",
+			Region:    "us-east-1",
+			Partition: "aws",
+			Public:    "ec2-10-0-0-12.compute-1.amazonaws.com",
+			Private:   "ip-10-0-0-12.ec2.internal",
+		},
+		{
+			IP:        "192.0.2.1",
+			Region:    "cn-north-1",
+			Partition: "aws-cn",
+			Public:    "ec2-192-0-2-1.cn-north-1.compute.amazonaws.com.cn",
+			Private:   "ip-192-0-2-1.cn-north-1.compute.internal",
+		},
+	}
+
+	for _, tc := range cases {
+		if v := ec2PublicDnsName(tc.IP, tc.Region, tc.Partition); v != tc.Public {
+			t.Errorf("expected public DNS %q, got %q", tc.Public, v)
+		}
+		if v := ec2PrivateDnsName(tc.IP, tc.Region); v != tc.Private {
+			t.Errorf("expected private DNS %q, got %q", tc.Private, v)
+		}
+	}
+}
+
 func TestAccAWSEIP_instance(t *testing.T) {
 	var conf ec2.Address
 
@@ -301,6 +371,15 @@ resource "aws_eip" "bar" {
 }
 `
 
+func testAccAWSEIPConfig_publicIpv4Pool(pool string) string {
+	return fmt.Sprintf(`
+resource "aws_eip" "bar" {
+  vpc              = true
+  public_ipv4_pool = "%s"
+}
+`, pool)
+}
+
 const testAccAWSEIPInstanceEc2Classic = `
 provider "aws" {
 	region = "us-east-1"
@@ -38,15 +38,42 @@ func resourceAwsElasticacheReplicationGroup() *schema.Resource {
 
 	resourceSchema["number_cache_clusters"] = &schema.Schema{
 		Type:     schema.TypeInt,
-		Required: true,
+		Optional: true,
+		Computed: true,
 		ForceNew: true,
 	}
 
+	resourceSchema["cluster_mode"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"num_node_groups": &schema.Schema{
+					Type:     schema.TypeInt,
+					Required: true,
+					ForceNew: true,
+				},
+				"replicas_per_node_group": &schema.Schema{
+					Type:     schema.TypeInt,
+					Required: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+
 	resourceSchema["primary_endpoint_address"] = &schema.Schema{
 		Type:     schema.TypeString,
 		Computed: true,
 	}
 
+	resourceSchema["configuration_endpoint_address"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
 	resourceSchema["engine"].Required = false
 	resourceSchema["engine"].Optional = true
 	resourceSchema["engine"].Default = "redis"
@@ -76,10 +103,33 @@ func resourceAwsElasticacheReplicationGroupCreate(d *schema.ResourceData, meta i
 		CacheNodeType:               aws.String(d.Get("node_type").(string)),
 		Engine:                      aws.String(d.Get("engine").(string)),
 		Port:                        aws.Int64(int64(d.Get("port").(int))),
-		NumCacheClusters:            aws.Int64(int64(d.Get("number_cache_clusters").(int))),
 		Tags:                        tags,
 	}
 
+	if clusterMode, ok := d.GetOk("cluster_mode"); ok {
+		m := clusterMode.([]interface{})[0].(map[string]interface{})
+		params.NumNodeGroups = aws.Int64(int64(m["num_node_groups"].(int)))
+		params.ReplicasPerNodeGroup = aws.Int64(int64(m["replicas_per_node_group"].(int)))
+
+		if !d.Get("automatic_failover_enabled").(bool) {
+			return fmt.Errorf("automatic_failover_enabled must be true when cluster_mode is set")
+		}
+
+		// number_cache_clusters is computed for cluster mode groups, so
+		// it can't be checked with ConflictsWith.
+		if _, ok := d.GetOk("number_cache_clusters"); ok {
+			return fmt.Errorf("number_cache_clusters can't be set when cluster_mode is set")
+		}
+	} else if v, ok := d.GetOk("number_cache_clusters"); ok {
+		params.NumCacheClusters = aws.Int64(int64(v.(int)))
+
+		if d.Get("automatic_failover_enabled").(bool) && v.(int) < 2 {
+			return fmt.Errorf("number_cache_clusters must be at least 2 when automatic_failover_enabled is true")
+		}
+	} else {
+		return fmt.Errorf("One of number_cache_clusters or cluster_mode must be set")
+	}
+
 	if v, ok := d.GetOk("engine_version"); ok {
 		params.EngineVersion = aws.String(v.(string))
 	}
@@ -210,7 +260,13 @@ func resourceAwsElasticacheReplicationGroupRead(d *schema.ResourceData, meta int
 	d.Set("number_cache_clusters", len(rgp.MemberClusters))
 	d.Set("replication_group_id", rgp.ReplicationGroupId)
 
-	if rgp.NodeGroups != nil {
+	if rgp.ClusterEnabled != nil && *rgp.ClusterEnabled {
+		if err := d.Set("cluster_mode", flattenElasticacheClusterMode(rgp.NodeGroups)); err != nil {
+			return fmt.Errorf("[DEBUG] Error setting cluster_mode for Elasticache Replication Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	if rgp.NodeGroups != nil && len(rgp.NodeGroups[0].NodeGroupMembers) > 0 {
 		cacheCluster := *rgp.NodeGroups[0].NodeGroupMembers[0]
 
 		res, err := conn.DescribeCacheClusters(&elasticache.DescribeCacheClustersInput{
@@ -238,8 +294,16 @@ func resourceAwsElasticacheReplicationGroupRead(d *schema.ResourceData, meta int
 		d.Set("maintenance_window", c.PreferredMaintenanceWindow)
 		d.Set("snapshot_window", c.SnapshotWindow)
 		d.Set("snapshot_retention_limit", c.SnapshotRetentionLimit)
-		d.Set("port", rgp.NodeGroups[0].PrimaryEndpoint.Port)
-		d.Set("primary_endpoint_address", rgp.NodeGroups[0].PrimaryEndpoint.Address)
+
+		// Cluster mode groups only expose a configuration endpoint, the
+		// individual node groups have no primary endpoint.
+		if rgp.ConfigurationEndpoint != nil {
+			d.Set("port", rgp.ConfigurationEndpoint.Port)
+			d.Set("configuration_endpoint_address", rgp.ConfigurationEndpoint.Address)
+		} else if rgp.NodeGroups[0].PrimaryEndpoint != nil {
+			d.Set("port", rgp.NodeGroups[0].PrimaryEndpoint.Port)
+			d.Set("primary_endpoint_address", rgp.NodeGroups[0].PrimaryEndpoint.Address)
+		}
 	}
 
 	return nil
@@ -278,8 +342,8 @@ func resourceAwsElasticacheReplicationGroupUpdate(d *schema.ResourceData, meta i
 		}
 	}
 
-	if d.HasChange("preferred_maintenance_window") {
-		params.PreferredMaintenanceWindow = aws.String(d.Get("preferred_maintenance_window").(string))
+	if d.HasChange("maintenance_window") {
+		params.PreferredMaintenanceWindow = aws.String(d.Get("maintenance_window").(string))
 		requestUpdate = true
 	}
 
@@ -420,6 +484,26 @@ func cacheReplicationGroupStateRefreshFunc(conn *elasticache.ElastiCache, replic
 	}
 }
 
+func flattenElasticacheClusterMode(nodeGroups []*elasticache.NodeGroup) []map[string]interface{} {
+	if len(nodeGroups) == 0 {
+		return nil
+	}
+
+	// Every node group has the same number of replicas, the primary
+	// being the only member which isn't one.
+	replicas := len(nodeGroups[0].NodeGroupMembers) - 1
+	if replicas < 0 {
+		replicas = 0
+	}
+
+	return []map[string]interface{}{
+		map[string]interface{}{
+			"num_node_groups":         len(nodeGroups),
+			"replicas_per_node_group": replicas,
+		},
+	}
+}
+
 func validateAwsElastiCacheReplicationGroupEngine(v interface{}, k string) (ws []string, errors []error) {
 	if strings.ToLower(v.(string)) != "redis" {
 		errors = append(errors, fmt.Errorf("The only acceptable Engine type when using Replication Groups is Redis"))
@@ -168,6 +168,67 @@ func TestAccAWSElasticacheReplicationGroup_multiAzInVpc(t *testing.T) {
 	})
 }
 
+func TestAccAWSElasticacheReplicationGroup_clusterMode(t *testing.T) {
+	var rg elasticache.ReplicationGroup
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSElasticacheReplicationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSElasticacheReplicationGroupClusterModeConfig(acctest.RandString(10)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSElasticacheReplicationGroupExists("aws_elasticache_replication_group.bar", &rg),
+					resource.TestCheckResourceAttr(
+						"aws_elasticache_replication_group.bar", "cluster_mode.#", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_elasticache_replication_group.bar", "cluster_mode.0.num_node_groups", "2"),
+					resource.TestCheckResourceAttr(
+						"aws_elasticache_replication_group.bar", "cluster_mode.0.replicas_per_node_group", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_elasticache_replication_group.bar", "number_cache_clusters", "4"),
+					resource.TestCheckResourceAttrSet(
+						"aws_elasticache_replication_group.bar", "configuration_endpoint_address"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenElasticacheClusterMode(t *testing.T) {
+	nodeGroups := []*elasticache.NodeGroup{
+		&elasticache.NodeGroup{
+			NodeGroupId: aws.String("0001"),
+			NodeGroupMembers: []*elasticache.NodeGroupMember{
+				&elasticache.NodeGroupMember{CacheClusterId: aws.String("tf-0001-001")},
+				&elasticache.NodeGroupMember{CacheClusterId: aws.String("tf-0001-002")},
+			},
+		},
+		&elasticache.NodeGroup{
+			NodeGroupId: aws.String("0002"),
+			NodeGroupMembers: []*elasticache.NodeGroupMember{
+				&elasticache.NodeGroupMember{CacheClusterId: aws.String("tf-0002-001")},
+				&elasticache.NodeGroupMember{CacheClusterId: aws.String("tf-0002-002")},
+			},
+		},
+	}
+
+	result := flattenElasticacheClusterMode(nodeGroups)
+	if len(result) != 1 {
+		t.Fatalf("expected 1 cluster_mode, got %d", len(result))
+	}
+	if v := result[0]["num_node_groups"]; v != 2 {
+		t.Fatalf("expected 2 node groups, got %v", v)
+	}
+	if v := result[0]["replicas_per_node_group"]; v != 1 {
+		t.Fatalf("expected 1 replica per node group, got %v", v)
+	}
+
+	if result := flattenElasticacheClusterMode(nil); result != nil {
+		t.Fatalf("expected nil, got %#v", result)
+	}
+}
+
 func TestResourceAWSElastiCacheReplicationGroupIdValidation(t *testing.T) {
 	cases := []struct {
 		Value    string
@@ -544,3 +605,24 @@ resource "aws_elasticache_replication_group" "bar" {
     automatic_failover_enabled = true
 }
 `, acctest.RandInt(), acctest.RandInt(), acctest.RandInt(), acctest.RandInt(), acctest.RandString(10))
+
+func testAccAWSElasticacheReplicationGroupClusterModeConfig(rName string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-west-2"
+}
+
+resource "aws_elasticache_replication_group" "bar" {
+  replication_group_id          = "tf-%s"
+  replication_group_description = "test description"
+  node_type                     = "cache.t2.medium"
+  port                          = 6379
+  parameter_group_name          = "default.redis3.2.cluster.on"
+  automatic_failover_enabled    = true
+
+  cluster_mode {
+    replicas_per_node_group = 1
+    num_node_groups         = 2
+  }
+}`, rName)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -20,6 +21,9 @@ func resourceAwsElasticSearchDomain() *schema.Resource {
 		Read:   resourceAwsElasticSearchDomainRead,
 		Update: resourceAwsElasticSearchDomainUpdate,
 		Delete: resourceAwsElasticSearchDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsElasticSearchDomainImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"access_policies": &schema.Schema{
@@ -62,6 +66,7 @@ func resourceAwsElasticSearchDomain() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				Computed: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"ebs_enabled": &schema.Schema{
@@ -87,6 +92,7 @@ func resourceAwsElasticSearchDomain() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				Computed: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"dedicated_master_count": &schema.Schema{
@@ -122,11 +128,13 @@ func resourceAwsElasticSearchDomain() *schema.Resource {
 			"snapshot_options": &schema.Schema{
 				Type:     schema.TypeList,
 				Optional: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"automated_snapshot_start_hour": &schema.Schema{
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateIntegerInRange(0, 23),
 						},
 					},
 				},
@@ -297,11 +305,14 @@ func resourceAwsElasticSearchDomainRead(d *schema.ResourceData, meta interface{}
 		return err
 	}
 	if ds.SnapshotOptions != nil {
-		d.Set("snapshot_options", map[string]interface{}{
-			"automated_snapshot_start_hour": *ds.SnapshotOptions.AutomatedSnapshotStartHour,
+		d.Set("snapshot_options", []map[string]interface{}{
+			{
+				"automated_snapshot_start_hour": *ds.SnapshotOptions.AutomatedSnapshotStartHour,
+			},
 		})
 	}
 
+	d.SetId(*ds.ARN)
 	d.Set("arn", ds.ARN)
 
 	listOut, err := conn.ListTags(&elasticsearch.ListTagsInput{
@@ -332,78 +343,82 @@ func resourceAwsElasticSearchDomainUpdate(d *schema.ResourceData, meta interface
 		d.SetPartial("tags")
 	}
 
-	input := elasticsearch.UpdateElasticsearchDomainConfigInput{
-		DomainName: aws.String(d.Get("domain_name").(string)),
-	}
+	if d.HasChange("access_policies") || d.HasChange("advanced_options") ||
+		d.HasChange("ebs_options") || d.HasChange("cluster_config") ||
+		d.HasChange("snapshot_options") {
+		input := elasticsearch.UpdateElasticsearchDomainConfigInput{
+			DomainName: aws.String(d.Get("domain_name").(string)),
+		}
 
-	if d.HasChange("access_policies") {
-		input.AccessPolicies = aws.String(d.Get("access_policies").(string))
-	}
+		if d.HasChange("access_policies") {
+			input.AccessPolicies = aws.String(d.Get("access_policies").(string))
+		}
 
-	if d.HasChange("advanced_options") {
-		input.AdvancedOptions = stringMapToPointers(d.Get("advanced_options").(map[string]interface{}))
-	}
+		if d.HasChange("advanced_options") {
+			input.AdvancedOptions = stringMapToPointers(d.Get("advanced_options").(map[string]interface{}))
+		}
 
-	if d.HasChange("ebs_options") {
-		options := d.Get("ebs_options").([]interface{})
+		if d.HasChange("ebs_options") {
+			options := d.Get("ebs_options").([]interface{})
 
-		if len(options) > 1 {
-			return fmt.Errorf("Only a single ebs_options block is expected")
-		} else if len(options) == 1 {
-			s := options[0].(map[string]interface{})
-			input.EBSOptions = expandESEBSOptions(s)
+			if len(options) > 1 {
+				return fmt.Errorf("Only a single ebs_options block is expected")
+			} else if len(options) == 1 {
+				s := options[0].(map[string]interface{})
+				input.EBSOptions = expandESEBSOptions(s)
+			}
 		}
-	}
 
-	if d.HasChange("cluster_config") {
-		config := d.Get("cluster_config").([]interface{})
+		if d.HasChange("cluster_config") {
+			config := d.Get("cluster_config").([]interface{})
 
-		if len(config) > 1 {
-			return fmt.Errorf("Only a single cluster_config block is expected")
-		} else if len(config) == 1 {
-			m := config[0].(map[string]interface{})
-			input.ElasticsearchClusterConfig = expandESClusterConfig(m)
+			if len(config) > 1 {
+				return fmt.Errorf("Only a single cluster_config block is expected")
+			} else if len(config) == 1 {
+				m := config[0].(map[string]interface{})
+				input.ElasticsearchClusterConfig = expandESClusterConfig(m)
+			}
 		}
-	}
 
-	if d.HasChange("snapshot_options") {
-		options := d.Get("snapshot_options").([]interface{})
+		if d.HasChange("snapshot_options") {
+			options := d.Get("snapshot_options").([]interface{})
 
-		if len(options) > 1 {
-			return fmt.Errorf("Only a single snapshot_options block is expected")
-		} else if len(options) == 1 {
-			o := options[0].(map[string]interface{})
+			if len(options) > 1 {
+				return fmt.Errorf("Only a single snapshot_options block is expected")
+			} else if len(options) == 1 {
+				o := options[0].(map[string]interface{})
 
-			snapshotOptions := elasticsearch.SnapshotOptions{
-				AutomatedSnapshotStartHour: aws.Int64(int64(o["automated_snapshot_start_hour"].(int))),
+				snapshotOptions := elasticsearch.SnapshotOptions{
+					AutomatedSnapshotStartHour: aws.Int64(int64(o["automated_snapshot_start_hour"].(int))),
+				}
+
+				input.SnapshotOptions = &snapshotOptions
 			}
+		}
 
-			input.SnapshotOptions = &snapshotOptions
+		_, err := conn.UpdateElasticsearchDomainConfig(&input)
+		if err != nil {
+			return err
 		}
-	}
 
-	_, err := conn.UpdateElasticsearchDomainConfig(&input)
-	if err != nil {
-		return err
-	}
+		err = resource.Retry(60*time.Minute, func() *resource.RetryError {
+			out, err := conn.DescribeElasticsearchDomain(&elasticsearch.DescribeElasticsearchDomainInput{
+				DomainName: aws.String(d.Get("domain_name").(string)),
+			})
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
 
-	err = resource.Retry(60*time.Minute, func() *resource.RetryError {
-		out, err := conn.DescribeElasticsearchDomain(&elasticsearch.DescribeElasticsearchDomainInput{
-			DomainName: aws.String(d.Get("domain_name").(string)),
+			if *out.DomainStatus.Processing == false {
+				return nil
+			}
+
+			return resource.RetryableError(
+				fmt.Errorf("%q: Timeout while waiting for changes to be processed", d.Id()))
 		})
 		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		if *out.DomainStatus.Processing == false {
-			return nil
+			return err
 		}
-
-		return resource.RetryableError(
-			fmt.Errorf("%q: Timeout while waiting for changes to be processed", d.Id()))
-	})
-	if err != nil {
-		return err
 	}
 
 	d.Partial(false)
@@ -411,6 +426,23 @@ func resourceAwsElasticSearchDomainUpdate(d *schema.ResourceData, meta interface
 	return resourceAwsElasticSearchDomainRead(d, meta)
 }
 
+func resourceAwsElasticSearchDomainImport(
+	d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Domains can be imported by name or by the ARN used as the resource ID,
+	// e.g. arn:aws:es:us-west-2:123456789012:domain/example
+	name := d.Id()
+	if strings.HasPrefix(name, "arn:") {
+		parts := strings.SplitN(name, ":domain/", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			return nil, fmt.Errorf("Unexpected format of ElasticSearch domain ARN (%s)", name)
+		}
+		name = parts[1]
+	}
+
+	d.Set("domain_name", name)
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsElasticSearchDomainDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).esconn
 
@@ -73,6 +73,39 @@ func TestAccAWSElasticSearchDomain_complex(t *testing.T) {
 	})
 }
 
+func TestAccAWSElasticSearchDomain_clusterConfigUpdate(t *testing.T) {
+	var domain elasticsearch.ElasticsearchDomainStatus
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckESDomainDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccESDomainConfig_clusterConfig(ri, 1, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckESDomainExists("aws_elasticsearch_domain.example", &domain),
+					resource.TestCheckResourceAttr(
+						"aws_elasticsearch_domain.example", "cluster_config.0.instance_count", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_elasticsearch_domain.example", "snapshot_options.0.automated_snapshot_start_hour", "0"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccESDomainConfig_clusterConfig(ri, 2, 23),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckESDomainExists("aws_elasticsearch_domain.example", &domain),
+					resource.TestCheckResourceAttr(
+						"aws_elasticsearch_domain.example", "cluster_config.0.instance_count", "2"),
+					resource.TestCheckResourceAttr(
+						"aws_elasticsearch_domain.example", "snapshot_options.0.automated_snapshot_start_hour", "23"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSElasticSearch_tags(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
 	var td elasticsearch.ListTagsOutput
@@ -221,6 +254,28 @@ resource "aws_elasticsearch_domain" "example" {
 `, randInt)
 }
 
+func testAccESDomainConfig_clusterConfig(randInt, instanceCount, snapshotHour int) string {
+	return fmt.Sprintf(`
+resource "aws_elasticsearch_domain" "example" {
+  domain_name = "tf-test-%d"
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+
+  cluster_config {
+    instance_count = %d
+    instance_type  = "t2.micro.elasticsearch"
+  }
+
+  snapshot_options {
+    automated_snapshot_start_hour = %d
+  }
+}
+`, randInt, instanceCount, snapshotHour)
+}
+
 func testAccESDomainConfigV23(randInt int) string {
 	return fmt.Sprintf(`
 resource "aws_elasticsearch_domain" "example" {
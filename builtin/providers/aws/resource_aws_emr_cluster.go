@@ -148,6 +148,11 @@ func resourceAwsEMRCluster() *schema.Resource {
 				ForceNew: true,
 				Default:  true,
 			},
+			"autoscaling_role": &schema.Schema{
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -237,6 +242,10 @@ func resourceAwsEMRClusterCreate(d *schema.ResourceData, meta interface{}) error
 		params.JobFlowRole = aws.String(instanceProfile)
 	}
 
+	if v, ok := d.GetOk("autoscaling_role"); ok {
+		params.AutoScalingRole = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("bootstrap_action"); ok {
 		bootstrapActions := v.(*schema.Set).List()
 		params.BootstrapActions = expandBootstrapActions(bootstrapActions)
@@ -330,6 +339,7 @@ func resourceAwsEMRClusterRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("log_uri", cluster.LogUri)
 	d.Set("master_public_dns", cluster.MasterPublicDnsName)
 	d.Set("visible_to_all_users", cluster.VisibleToAllUsers)
+	d.Set("autoscaling_role", cluster.AutoScalingRole)
 	d.Set("tags", tagsToMapEMR(cluster.Tags))
 
 	if err := d.Set("applications", flattenApplications(cluster.Applications)); err != nil {
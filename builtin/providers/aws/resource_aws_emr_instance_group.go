@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"encoding/json"
 	"errors"
 	"log"
 	"time"
@@ -50,6 +51,12 @@ func resourceAwsEMRInstanceGroup() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"autoscaling_policy": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
 		},
 	}
 }
@@ -62,16 +69,24 @@ func resourceAwsEMRInstanceGroupCreate(d *schema.ResourceData, meta interface{})
 	instanceCount := d.Get("instance_count").(int)
 	groupName := d.Get("name").(string)
 
+	groupConfig := &emr.InstanceGroupConfig{
+		InstanceRole:  aws.String("TASK"),
+		InstanceCount: aws.Int64(int64(instanceCount)),
+		InstanceType:  aws.String(instanceType),
+		Name:          aws.String(groupName),
+	}
+
+	if v, ok := d.GetOk("autoscaling_policy"); ok {
+		policy, err := expandEMRAutoScalingPolicy(v.(string))
+		if err != nil {
+			return err
+		}
+		groupConfig.AutoScalingPolicy = policy
+	}
+
 	params := &emr.AddInstanceGroupsInput{
-		InstanceGroups: []*emr.InstanceGroupConfig{
-			{
-				InstanceRole:  aws.String("TASK"),
-				InstanceCount: aws.Int64(int64(instanceCount)),
-				InstanceType:  aws.String(instanceType),
-				Name:          aws.String(groupName),
-			},
-		},
-		JobFlowId: aws.String(clusterId),
+		InstanceGroups: []*emr.InstanceGroupConfig{groupConfig},
+		JobFlowId:      aws.String(clusterId),
 	}
 
 	log.Printf("[DEBUG] Creating EMR task group params: %s", params)
@@ -118,6 +133,12 @@ func resourceAwsEMRInstanceGroupRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("status", group.Status.State)
 	}
 
+	// The policy description returned by the API carries status and
+	// defaulted fields, so only detect removal rather than drift.
+	if group.AutoScalingPolicy == nil {
+		d.Set("autoscaling_policy", "")
+	}
+
 	return nil
 }
 
@@ -180,6 +201,39 @@ func fetchEMRInstanceGroup(meta interface{}, clusterId, groupId string) (*emr.In
 func resourceAwsEMRInstanceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).emrconn
 
+	if d.HasChange("autoscaling_policy") {
+		clusterId := d.Get("cluster_id").(string)
+		if v, ok := d.GetOk("autoscaling_policy"); ok {
+			policy, err := expandEMRAutoScalingPolicy(v.(string))
+			if err != nil {
+				return err
+			}
+
+			log.Printf("[DEBUG] Putting EMR task group auto scaling policy: %s", policy)
+			_, err = conn.PutAutoScalingPolicy(&emr.PutAutoScalingPolicyInput{
+				ClusterId:         aws.String(clusterId),
+				InstanceGroupId:   aws.String(d.Id()),
+				AutoScalingPolicy: policy,
+			})
+			if err != nil {
+				return fmt.Errorf("Error putting EMR instance group (%s) auto scaling policy: %s", d.Id(), err)
+			}
+		} else {
+			log.Printf("[DEBUG] Removing EMR task group auto scaling policy")
+			_, err := conn.RemoveAutoScalingPolicy(&emr.RemoveAutoScalingPolicyInput{
+				ClusterId:       aws.String(clusterId),
+				InstanceGroupId: aws.String(d.Id()),
+			})
+			if err != nil {
+				return fmt.Errorf("Error removing EMR instance group (%s) auto scaling policy: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if !d.HasChange("instance_count") {
+		return resourceAwsEMRInstanceGroupRead(d, meta)
+	}
+
 	log.Printf("[DEBUG] Modify EMR task group")
 	instanceCount := d.Get("instance_count").(int)
 
@@ -200,7 +254,7 @@ func resourceAwsEMRInstanceGroupUpdate(d *schema.ResourceData, meta interface{})
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"PROVISIONING", "BOOTSTRAPPING", "RESIZING"},
 		Target:     []string{"RUNNING"},
-		Refresh:    instanceGroupStateRefresh(conn, d.Get("cluster_id").(string), d.Id()),
+		Refresh:    instanceGroupStateRefresh(meta, d.Get("cluster_id").(string), d.Id()),
 		Timeout:    10 * time.Minute,
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
@@ -249,3 +303,13 @@ func resourceAwsEMRInstanceGroupDelete(d *schema.ResourceData, meta interface{})
 	}
 	return nil
 }
+
+func expandEMRAutoScalingPolicy(rawDefinitions string) (*emr.AutoScalingPolicy, error) {
+	var policy *emr.AutoScalingPolicy
+
+	if err := json.Unmarshal([]byte(rawDefinitions), &policy); err != nil {
+		return nil, fmt.Errorf("Error decoding EMR auto scaling policy JSON: %s", err)
+	}
+
+	return policy, nil
+}
@@ -29,6 +29,45 @@ func TestAccAWSEMRInstanceGroup_basic(t *testing.T) {
 	})
 }
 
+func TestExpandEMRAutoScalingPolicy(t *testing.T) {
+	raw := `{
+  "Constraints": {"MinCapacity": 1, "MaxCapacity": 4},
+  "Rules": [{
+    "Name": "ScaleOutMemoryPercentage",
+    "Action": {
+      "SimpleScalingPolicyConfiguration": {"ScalingAdjustment": 1, "CoolDown": 300}
+    },
+    "Trigger": {
+      "CloudWatchAlarmDefinition": {
+        "ComparisonOperator": "LESS_THAN",
+        "MetricName": "YARNMemoryAvailablePercentage",
+        "Period": 300,
+        "Threshold": 15.0
+      }
+    }
+  }]
+}`
+
+	policy, err := expandEMRAutoScalingPolicy(raw)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if *policy.Constraints.MinCapacity != 1 || *policy.Constraints.MaxCapacity != 4 {
+		t.Fatalf("bad constraints: %s", policy.Constraints)
+	}
+	if len(policy.Rules) != 1 {
+		t.Fatalf("expected 1 rule, got %d", len(policy.Rules))
+	}
+	alarm := policy.Rules[0].Trigger.CloudWatchAlarmDefinition
+	if *alarm.MetricName != "YARNMemoryAvailablePercentage" || *alarm.Threshold != 15.0 {
+		t.Fatalf("bad alarm definition: %s", alarm)
+	}
+
+	if _, err := expandEMRAutoScalingPolicy("{"); err == nil {
+		t.Fatal("expected error decoding invalid JSON")
+	}
+}
+
 func testAccCheckAWSEmrInstanceGroupDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).emrconn
 
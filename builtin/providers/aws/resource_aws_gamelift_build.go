@@ -0,0 +1,186 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsGameliftBuild() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGameliftBuildCreate,
+		Read:   resourceAwsGameliftBuildRead,
+		Update: resourceAwsGameliftBuildUpdate,
+		Delete: resourceAwsGameliftBuildDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"operating_system": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateGameliftOperatingSystem,
+			},
+
+			"storage_location": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAwsGameliftBuildCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).gameliftconn
+
+	sl := d.Get("storage_location").([]interface{})[0].(map[string]interface{})
+	input := &gamelift.CreateBuildInput{
+		Name:            aws.String(d.Get("name").(string)),
+		OperatingSystem: aws.String(d.Get("operating_system").(string)),
+		StorageLocation: &gamelift.S3Location{
+			Bucket:  aws.String(sl["bucket"].(string)),
+			Key:     aws.String(sl["key"].(string)),
+			RoleArn: aws.String(sl["role_arn"].(string)),
+		},
+	}
+	if v, ok := d.GetOk("version"); ok {
+		input.Version = aws.String(v.(string))
+	}
+
+	// GameLift validates access to the build files with the given role,
+	// which can fail for a little while after the role is created.
+	log.Printf("[INFO] Creating Gamelift Build: %s", input)
+	var out *gamelift.CreateBuildOutput
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		var err error
+		out, err = conn.CreateBuild(input)
+		if err != nil {
+			if isAWSErr(err, gamelift.ErrCodeInvalidRequestException, "Provided build is not accessible.") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating Gamelift build: %s", err)
+	}
+
+	d.SetId(aws.StringValue(out.Build.BuildId))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{gamelift.BuildStatusInitialized},
+		Target:  []string{gamelift.BuildStatusReady},
+		Refresh: func() (interface{}, string, error) {
+			out, err := conn.DescribeBuild(&gamelift.DescribeBuildInput{
+				BuildId: aws.String(d.Id()),
+			})
+			if err != nil {
+				return 42, "", err
+			}
+
+			return out, aws.StringValue(out.Build.Status), nil
+		},
+		Timeout: 1 * time.Minute,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Gamelift build (%s) to become ready: %s", d.Id(), err)
+	}
+
+	return resourceAwsGameliftBuildRead(d, meta)
+}
+
+func resourceAwsGameliftBuildRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).gameliftconn
+
+	log.Printf("[INFO] Reading Gamelift Build: %s", d.Id())
+	out, err := conn.DescribeBuild(&gamelift.DescribeBuildInput{
+		BuildId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, gamelift.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Gamelift Build (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	b := out.Build
+
+	d.Set("name", b.Name)
+	d.Set("operating_system", b.OperatingSystem)
+	d.Set("version", b.Version)
+
+	return nil
+}
+
+func resourceAwsGameliftBuildUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).gameliftconn
+
+	log.Printf("[INFO] Updating Gamelift Build: %s", d.Id())
+	input := &gamelift.UpdateBuildInput{
+		BuildId: aws.String(d.Id()),
+		Name:    aws.String(d.Get("name").(string)),
+	}
+	if v, ok := d.GetOk("version"); ok {
+		input.Version = aws.String(v.(string))
+	}
+
+	if _, err := conn.UpdateBuild(input); err != nil {
+		return fmt.Errorf("Error updating Gamelift build (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsGameliftBuildRead(d, meta)
+}
+
+func resourceAwsGameliftBuildDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).gameliftconn
+
+	log.Printf("[INFO] Deleting Gamelift Build: %s", d.Id())
+	_, err := conn.DeleteBuild(&gamelift.DeleteBuildInput{
+		BuildId: aws.String(d.Id()),
+	})
+	if err != nil && !isAWSErr(err, gamelift.ErrCodeNotFoundException, "") {
+		return fmt.Errorf("Error deleting Gamelift build (%s): %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,167 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// testAccAWSGameliftBuildPreCheck skips the test unless the location of a
+// zipped game server build has been provided.
+func testAccAWSGameliftBuildPreCheck(t *testing.T) (string, string) {
+	bucket := os.Getenv("GAMELIFT_BUILD_BUCKET")
+	key := os.Getenv("GAMELIFT_BUILD_KEY")
+	if bucket == "" || key == "" {
+		t.Skip("Environment variables GAMELIFT_BUILD_BUCKET and GAMELIFT_BUILD_KEY must be set")
+	}
+	return bucket, key
+}
+
+func TestAccAWSGameliftBuild_basic(t *testing.T) {
+	bucket, key := testAccAWSGameliftBuildPreCheck(t)
+
+	var conf gamelift.Build
+	rString := acctest.RandString(8)
+	resourceName := "aws_gamelift_build.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGameliftBuildDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSGameliftBuildConfig(rString, "tf-acc-build", "1.0", bucket, key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGameliftBuildExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-acc-build"),
+					resource.TestCheckResourceAttr(resourceName, "operating_system", "WINDOWS_2012"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1.0"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSGameliftBuildConfig(rString, "tf-acc-build-updated", "1.1", bucket, key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGameliftBuildExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-acc-build-updated"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1.1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGameliftBuildExists(n string, res *gamelift.Build) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Gamelift Build ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+		out, err := conn.DescribeBuild(&gamelift.DescribeBuildInput{
+			BuildId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*res = *out.Build
+		return nil
+	}
+}
+
+func testAccCheckAWSGameliftBuildDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_gamelift_build" {
+			continue
+		}
+
+		_, err := conn.DescribeBuild(&gamelift.DescribeBuildInput{
+			BuildId: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("Gamelift Build still exists")
+		}
+
+		if !isAWSErr(err, gamelift.ErrCodeNotFoundException, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSGameliftBuildConfig_iam(rString, bucket string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = "tf-acc-gamelift-%s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "gamelift.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = "tf-acc-gamelift-%s"
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:GetObject",
+        "s3:GetObjectVersion",
+        "s3:GetObjectMetadata"
+      ],
+      "Resource": "arn:aws:s3:::%s/*"
+    }
+  ]
+}
+EOF
+}
+`, rString, rString, bucket)
+}
+
+func testAccAWSGameliftBuildConfig(rString, name, version, bucket, key string) string {
+	return testAccAWSGameliftBuildConfig_iam(rString, bucket) + fmt.Sprintf(`
+resource "aws_gamelift_build" "test" {
+  name             = "%s"
+  operating_system = "WINDOWS_2012"
+  version          = "%s"
+
+  storage_location {
+    bucket   = "%s"
+    key      = "%s"
+    role_arn = "${aws_iam_role.test.arn}"
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, name, version, bucket, key)
+}
@@ -0,0 +1,598 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsGameliftFleet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGameliftFleetCreate,
+		Read:   resourceAwsGameliftFleetRead,
+		Update: resourceAwsGameliftFleetUpdate,
+		Delete: resourceAwsGameliftFleetDelete,
+
+		Schema: map[string]*schema.Schema{
+			"build_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"ec2_instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ec2_inbound_permission": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from_port": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"ip_range": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateCIDRNetworkAddress,
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateGameliftIpProtocol,
+						},
+						"to_port": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"log_paths": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"metric_groups": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"new_game_session_protection_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      gamelift.ProtectionPolicyNoProtection,
+				ValidateFunc: validateGameliftProtectionPolicy,
+			},
+
+			"resource_creation_limit_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"new_game_sessions_per_creator": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"policy_period_in_minutes": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"runtime_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"game_session_activation_timeout_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"max_concurrent_game_session_activations": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"server_process": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 50,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"concurrent_executions": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+									"launch_path": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"parameters": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"operating_system": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsGameliftFleetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).gameliftconn
+
+	input := &gamelift.CreateFleetInput{
+		BuildId:                        aws.String(d.Get("build_id").(string)),
+		EC2InstanceType:                aws.String(d.Get("ec2_instance_type").(string)),
+		Name:                           aws.String(d.Get("name").(string)),
+		NewGameSessionProtectionPolicy: aws.String(d.Get("new_game_session_protection_policy").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("ec2_inbound_permission"); ok {
+		input.EC2InboundPermissions = expandGameliftIpPermissions(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("metric_groups"); ok {
+		input.MetricGroups = expandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("resource_creation_limit_policy"); ok {
+		input.ResourceCreationLimitPolicy = expandGameliftResourceCreationLimitPolicy(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("runtime_configuration"); ok {
+		input.RuntimeConfiguration = expandGameliftRuntimeConfiguration(v.([]interface{}))
+	}
+
+	log.Printf("[INFO] Creating Gamelift Fleet: %s", input)
+	out, err := conn.CreateFleet(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Gamelift fleet: %s", err)
+	}
+
+	d.SetId(aws.StringValue(out.FleetAttributes.FleetId))
+
+	// Fleets take a long time to activate, as GameLift installs the build
+	// on a new instance and starts the configured server processes.
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			gamelift.FleetStatusActivating,
+			gamelift.FleetStatusBuilding,
+			gamelift.FleetStatusDownloading,
+			gamelift.FleetStatusNew,
+			gamelift.FleetStatusValidating,
+		},
+		Target:     []string{gamelift.FleetStatusActive},
+		Refresh:    gameliftFleetStateRefreshFunc(conn, d.Id()),
+		Timeout:    70 * time.Minute,
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		events, evErr := getGameliftFleetFailures(conn, d.Id())
+		if evErr != nil {
+			log.Printf("[ERROR] Failed to poll Gamelift fleet events: %s", evErr)
+		}
+		if len(events) > 0 {
+			return fmt.Errorf("Error waiting for Gamelift fleet (%s) to become active: %s\nRecent failures:\n%s",
+				d.Id(), err, strings.Join(events, "\n"))
+		}
+		return fmt.Errorf("Error waiting for Gamelift fleet (%s) to become active: %s", d.Id(), err)
+	}
+
+	return resourceAwsGameliftFleetRead(d, meta)
+}
+
+func resourceAwsGameliftFleetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).gameliftconn
+
+	log.Printf("[INFO] Reading Gamelift Fleet: %s", d.Id())
+	fleet, err := describeGameliftFleet(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading Gamelift fleet (%s): %s", d.Id(), err)
+	}
+	if fleet == nil || aws.StringValue(fleet.Status) == gamelift.FleetStatusTerminated {
+		log.Printf("[WARN] Gamelift Fleet (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", fleet.FleetArn)
+	d.Set("build_id", fleet.BuildId)
+	d.Set("description", fleet.Description)
+	d.Set("ec2_instance_type", fleet.InstanceType)
+	d.Set("log_paths", flattenStringList(fleet.LogPaths))
+	d.Set("metric_groups", flattenStringList(fleet.MetricGroups))
+	d.Set("name", fleet.Name)
+	d.Set("new_game_session_protection_policy", fleet.NewGameSessionProtectionPolicy)
+	d.Set("operating_system", fleet.OperatingSystem)
+
+	if err := d.Set("resource_creation_limit_policy", flattenGameliftResourceCreationLimitPolicy(fleet.ResourceCreationLimitPolicy)); err != nil {
+		return fmt.Errorf("Error setting resource_creation_limit_policy for Gamelift fleet (%s): %s", d.Id(), err)
+	}
+
+	portOut, err := conn.DescribeFleetPortSettings(&gamelift.DescribeFleetPortSettingsInput{
+		FleetId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading Gamelift fleet (%s) port settings: %s", d.Id(), err)
+	}
+	if err := d.Set("ec2_inbound_permission", flattenGameliftIpPermissions(portOut.InboundPermissions)); err != nil {
+		return fmt.Errorf("Error setting ec2_inbound_permission for Gamelift fleet (%s): %s", d.Id(), err)
+	}
+
+	rcOut, err := conn.DescribeRuntimeConfiguration(&gamelift.DescribeRuntimeConfigurationInput{
+		FleetId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading Gamelift fleet (%s) runtime configuration: %s", d.Id(), err)
+	}
+	if err := d.Set("runtime_configuration", flattenGameliftRuntimeConfiguration(rcOut.RuntimeConfiguration)); err != nil {
+		return fmt.Errorf("Error setting runtime_configuration for Gamelift fleet (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsGameliftFleetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).gameliftconn
+
+	log.Printf("[INFO] Updating Gamelift Fleet: %s", d.Id())
+
+	if d.HasChange("description") || d.HasChange("metric_groups") || d.HasChange("name") ||
+		d.HasChange("new_game_session_protection_policy") || d.HasChange("resource_creation_limit_policy") {
+		input := &gamelift.UpdateFleetAttributesInput{
+			FleetId:                        aws.String(d.Id()),
+			Name:                           aws.String(d.Get("name").(string)),
+			NewGameSessionProtectionPolicy: aws.String(d.Get("new_game_session_protection_policy").(string)),
+			ResourceCreationLimitPolicy:    expandGameliftResourceCreationLimitPolicy(d.Get("resource_creation_limit_policy").([]interface{})),
+		}
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("metric_groups"); ok {
+			input.MetricGroups = expandStringList(v.([]interface{}))
+		}
+
+		if _, err := conn.UpdateFleetAttributes(input); err != nil {
+			return fmt.Errorf("Error updating Gamelift fleet (%s) attributes: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("ec2_inbound_permission") {
+		o, n := d.GetChange("ec2_inbound_permission")
+		authorizations, revocations := diffGameliftPortSettings(o.([]interface{}), n.([]interface{}))
+
+		if len(authorizations) > 0 || len(revocations) > 0 {
+			_, err := conn.UpdateFleetPortSettings(&gamelift.UpdateFleetPortSettingsInput{
+				FleetId:                         aws.String(d.Id()),
+				InboundPermissionAuthorizations: authorizations,
+				InboundPermissionRevocations:    revocations,
+			})
+			if err != nil {
+				return fmt.Errorf("Error updating Gamelift fleet (%s) port settings: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("runtime_configuration") {
+		_, err := conn.UpdateRuntimeConfiguration(&gamelift.UpdateRuntimeConfigurationInput{
+			FleetId:              aws.String(d.Id()),
+			RuntimeConfiguration: expandGameliftRuntimeConfiguration(d.Get("runtime_configuration").([]interface{})),
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating Gamelift fleet (%s) runtime configuration: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsGameliftFleetRead(d, meta)
+}
+
+func resourceAwsGameliftFleetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).gameliftconn
+
+	log.Printf("[INFO] Deleting Gamelift Fleet: %s", d.Id())
+	// The fleet can't be deleted until it has finished activating
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteFleet(&gamelift.DeleteFleetInput{
+			FleetId: aws.String(d.Id()),
+		})
+		if err != nil {
+			if isAWSErr(err, gamelift.ErrCodeInvalidFleetStatusException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		if isAWSErr(err, gamelift.ErrCodeNotFoundException, "") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error deleting Gamelift fleet (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			gamelift.FleetStatusActive,
+			gamelift.FleetStatusDeleting,
+			gamelift.FleetStatusError,
+		},
+		Target:     []string{gamelift.FleetStatusTerminated},
+		Refresh:    gameliftFleetStateRefreshFunc(conn, d.Id()),
+		Timeout:    20 * time.Minute,
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Gamelift fleet (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func describeGameliftFleet(conn *gamelift.GameLift, id string) (*gamelift.FleetAttributes, error) {
+	out, err := conn.DescribeFleetAttributes(&gamelift.DescribeFleetAttributesInput{
+		FleetIds: []*string{aws.String(id)},
+	})
+	if err != nil {
+		if isAWSErr(err, gamelift.ErrCodeNotFoundException, "") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	for _, f := range out.FleetAttributes {
+		if aws.StringValue(f.FleetId) == id {
+			return f, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func gameliftFleetStateRefreshFunc(conn *gamelift.GameLift, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		fleet, err := describeGameliftFleet(conn, id)
+		if err != nil {
+			return nil, "", err
+		}
+		if fleet == nil {
+			return 42, gamelift.FleetStatusTerminated, nil
+		}
+
+		return fleet, aws.StringValue(fleet.Status), nil
+	}
+}
+
+// getGameliftFleetFailures returns the messages of the fleet's recent
+// events, which is where GameLift reports why a fleet failed to activate.
+func getGameliftFleetFailures(conn *gamelift.GameLift, id string) ([]string, error) {
+	out, err := conn.DescribeFleetEvents(&gamelift.DescribeFleetEventsInput{
+		FleetId: aws.String(id),
+		Limit:   aws.Int64(10),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var events []string
+	for _, e := range out.Events {
+		if isGameliftEventFailure(aws.StringValue(e.EventCode)) {
+			events = append(events, fmt.Sprintf("(%s) %s",
+				aws.StringValue(e.EventCode), aws.StringValue(e.Message)))
+		}
+	}
+
+	return events, nil
+}
+
+func isGameliftEventFailure(code string) bool {
+	return strings.Contains(code, "ERROR") || strings.Contains(code, "FAILED") ||
+		strings.Contains(code, "TIMED_OUT")
+}
+
+func expandGameliftIpPermissions(cfgs []interface{}) []*gamelift.IpPermission {
+	if len(cfgs) < 1 {
+		return []*gamelift.IpPermission{}
+	}
+
+	perms := make([]*gamelift.IpPermission, 0, len(cfgs))
+	for _, rawCfg := range cfgs {
+		cfg := rawCfg.(map[string]interface{})
+		perms = append(perms, expandGameliftIpPermission(cfg))
+	}
+	return perms
+}
+
+func expandGameliftIpPermission(cfg map[string]interface{}) *gamelift.IpPermission {
+	return &gamelift.IpPermission{
+		FromPort: aws.Int64(int64(cfg["from_port"].(int))),
+		IpRange:  aws.String(cfg["ip_range"].(string)),
+		Protocol: aws.String(cfg["protocol"].(string)),
+		ToPort:   aws.Int64(int64(cfg["to_port"].(int))),
+	}
+}
+
+func flattenGameliftIpPermissions(ipps []*gamelift.IpPermission) []interface{} {
+	perms := make([]interface{}, 0, len(ipps))
+	for _, ipp := range ipps {
+		perms = append(perms, map[string]interface{}{
+			"from_port": int(aws.Int64Value(ipp.FromPort)),
+			"ip_range":  aws.StringValue(ipp.IpRange),
+			"protocol":  aws.StringValue(ipp.Protocol),
+			"to_port":   int(aws.Int64Value(ipp.ToPort)),
+		})
+	}
+	return perms
+}
+
+// diffGameliftPortSettings returns the permissions that need to be added
+// and removed to get from the old to the new list of inbound permissions.
+func diffGameliftPortSettings(oldPerms, newPerms []interface{}) (a []*gamelift.IpPermission, r []*gamelift.IpPermission) {
+	newPerms = append([]interface{}{}, newPerms...)
+
+OUTER:
+	for i, op := range oldPerms {
+		oldPerm := op.(map[string]interface{})
+		for j, np := range newPerms {
+			newPerm := np.(map[string]interface{})
+
+			// Permission already exists
+			if oldPerm["from_port"].(int) == newPerm["from_port"].(int) &&
+				oldPerm["to_port"].(int) == newPerm["to_port"].(int) &&
+				oldPerm["ip_range"].(string) == newPerm["ip_range"].(string) &&
+				oldPerm["protocol"].(string) == newPerm["protocol"].(string) {
+				newPerms = append(newPerms[:j], newPerms[j+1:]...)
+				continue OUTER
+			}
+		}
+
+		r = append(r, expandGameliftIpPermission(oldPerms[i].(map[string]interface{})))
+	}
+
+	for _, np := range newPerms {
+		a = append(a, expandGameliftIpPermission(np.(map[string]interface{})))
+	}
+
+	return
+}
+
+func expandGameliftResourceCreationLimitPolicy(cfg []interface{}) *gamelift.ResourceCreationLimitPolicy {
+	if len(cfg) < 1 || cfg[0] == nil {
+		return nil
+	}
+	out := gamelift.ResourceCreationLimitPolicy{}
+	m := cfg[0].(map[string]interface{})
+
+	if v, ok := m["new_game_sessions_per_creator"]; ok {
+		out.NewGameSessionsPerCreator = aws.Int64(int64(v.(int)))
+	}
+	if v, ok := m["policy_period_in_minutes"]; ok {
+		out.PolicyPeriodInMinutes = aws.Int64(int64(v.(int)))
+	}
+
+	return &out
+}
+
+func flattenGameliftResourceCreationLimitPolicy(policy *gamelift.ResourceCreationLimitPolicy) []interface{} {
+	// GameLift reports an empty policy for fleets created without one
+	if policy == nil || (aws.Int64Value(policy.NewGameSessionsPerCreator) == 0 &&
+		aws.Int64Value(policy.PolicyPeriodInMinutes) == 0) {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"new_game_sessions_per_creator": int(aws.Int64Value(policy.NewGameSessionsPerCreator)),
+			"policy_period_in_minutes":      int(aws.Int64Value(policy.PolicyPeriodInMinutes)),
+		},
+	}
+}
+
+func expandGameliftRuntimeConfiguration(cfg []interface{}) *gamelift.RuntimeConfiguration {
+	if len(cfg) < 1 || cfg[0] == nil {
+		return nil
+	}
+	out := gamelift.RuntimeConfiguration{}
+	m := cfg[0].(map[string]interface{})
+
+	if v, ok := m["game_session_activation_timeout_seconds"].(int); ok && v > 0 {
+		out.GameSessionActivationTimeoutSeconds = aws.Int64(int64(v))
+	}
+	if v, ok := m["max_concurrent_game_session_activations"].(int); ok && v > 0 {
+		out.MaxConcurrentGameSessionActivations = aws.Int64(int64(v))
+	}
+	if v, ok := m["server_process"]; ok {
+		out.ServerProcesses = expandGameliftServerProcesses(v.([]interface{}))
+	}
+
+	return &out
+}
+
+func expandGameliftServerProcesses(cfgs []interface{}) []*gamelift.ServerProcess {
+	if len(cfgs) < 1 {
+		return []*gamelift.ServerProcess{}
+	}
+
+	processes := make([]*gamelift.ServerProcess, 0, len(cfgs))
+	for _, rawCfg := range cfgs {
+		cfg := rawCfg.(map[string]interface{})
+		process := &gamelift.ServerProcess{
+			ConcurrentExecutions: aws.Int64(int64(cfg["concurrent_executions"].(int))),
+			LaunchPath:           aws.String(cfg["launch_path"].(string)),
+		}
+		if v, ok := cfg["parameters"].(string); ok && v != "" {
+			process.Parameters = aws.String(v)
+		}
+		processes = append(processes, process)
+	}
+	return processes
+}
+
+func flattenGameliftRuntimeConfiguration(rc *gamelift.RuntimeConfiguration) []interface{} {
+	if rc == nil {
+		return []interface{}{}
+	}
+
+	processes := make([]interface{}, 0, len(rc.ServerProcesses))
+	for _, p := range rc.ServerProcesses {
+		processes = append(processes, map[string]interface{}{
+			"concurrent_executions": int(aws.Int64Value(p.ConcurrentExecutions)),
+			"launch_path":           aws.StringValue(p.LaunchPath),
+			"parameters":            aws.StringValue(p.Parameters),
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"game_session_activation_timeout_seconds": int(aws.Int64Value(rc.GameSessionActivationTimeoutSeconds)),
+			"max_concurrent_game_session_activations": int(aws.Int64Value(rc.MaxConcurrentGameSessionActivations)),
+			"server_process": processes,
+		},
+	}
+}
@@ -0,0 +1,192 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDiffGameliftPortSettings(t *testing.T) {
+	perm := func(from, to int, ipRange, protocol string) map[string]interface{} {
+		return map[string]interface{}{
+			"from_port": from,
+			"to_port":   to,
+			"ip_range":  ipRange,
+			"protocol":  protocol,
+		}
+	}
+
+	cases := []struct {
+		Old, New                    []interface{}
+		Authorizations, Revocations []*gamelift.IpPermission
+	}{
+		{
+			Old: []interface{}{},
+			New: []interface{}{perm(8000, 8080, "0.0.0.0/0", "TCP")},
+			Authorizations: []*gamelift.IpPermission{
+				{
+					FromPort: aws.Int64(8000),
+					ToPort:   aws.Int64(8080),
+					IpRange:  aws.String("0.0.0.0/0"),
+					Protocol: aws.String("TCP"),
+				},
+			},
+		},
+		{
+			Old: []interface{}{
+				perm(8000, 8080, "0.0.0.0/0", "TCP"),
+				perm(9000, 9000, "10.0.0.0/8", "UDP"),
+			},
+			New: []interface{}{
+				perm(9000, 9000, "10.0.0.0/8", "UDP"),
+				perm(8000, 8080, "0.0.0.0/0", "UDP"),
+			},
+			Authorizations: []*gamelift.IpPermission{
+				{
+					FromPort: aws.Int64(8000),
+					ToPort:   aws.Int64(8080),
+					IpRange:  aws.String("0.0.0.0/0"),
+					Protocol: aws.String("UDP"),
+				},
+			},
+			Revocations: []*gamelift.IpPermission{
+				{
+					FromPort: aws.Int64(8000),
+					ToPort:   aws.Int64(8080),
+					IpRange:  aws.String("0.0.0.0/0"),
+					Protocol: aws.String("TCP"),
+				},
+			},
+		},
+		{
+			Old: []interface{}{perm(8000, 8080, "0.0.0.0/0", "TCP")},
+			New: []interface{}{perm(8000, 8080, "0.0.0.0/0", "TCP")},
+		},
+	}
+
+	for i, tc := range cases {
+		a, r := diffGameliftPortSettings(tc.Old, tc.New)
+		if !reflect.DeepEqual(a, tc.Authorizations) {
+			t.Fatalf("%d: bad authorizations: %s", i, a)
+		}
+		if !reflect.DeepEqual(r, tc.Revocations) {
+			t.Fatalf("%d: bad revocations: %s", i, r)
+		}
+	}
+}
+
+func TestAccAWSGameliftFleet_basic(t *testing.T) {
+	bucket, key := testAccAWSGameliftBuildPreCheck(t)
+	launchPath := os.Getenv("GAMELIFT_BUILD_LAUNCH_PATH")
+	if launchPath == "" {
+		t.Skip("Environment variable GAMELIFT_BUILD_LAUNCH_PATH is not set")
+	}
+
+	rString := acctest.RandString(8)
+	resourceName := "aws_gamelift_fleet.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGameliftFleetDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSGameliftFleetConfig(rString, "tf-acc-fleet", "8000", bucket, key, launchPath),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGameliftFleetExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-acc-fleet"),
+					resource.TestCheckResourceAttr(resourceName, "ec2_instance_type", "c4.large"),
+					resource.TestCheckResourceAttr(resourceName, "ec2_inbound_permission.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ec2_inbound_permission.0.from_port", "8000"),
+					resource.TestCheckResourceAttr(resourceName, "runtime_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "runtime_configuration.0.server_process.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSGameliftFleetConfig(rString, "tf-acc-fleet-updated", "8001", bucket, key, launchPath),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGameliftFleetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-acc-fleet-updated"),
+					resource.TestCheckResourceAttr(resourceName, "ec2_inbound_permission.0.from_port", "8001"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGameliftFleetExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Gamelift Fleet ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+		fleet, err := describeGameliftFleet(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if fleet == nil {
+			return fmt.Errorf("Gamelift Fleet %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSGameliftFleetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_gamelift_fleet" {
+			continue
+		}
+
+		fleet, err := describeGameliftFleet(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if fleet != nil && aws.StringValue(fleet.Status) != gamelift.FleetStatusTerminated {
+			return fmt.Errorf("Gamelift Fleet still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSGameliftFleetConfig(rString, name, port, bucket, key, launchPath string) string {
+	return testAccAWSGameliftBuildConfig(rString, "tf-acc-fleet-build", "1.0", bucket, key) + fmt.Sprintf(`
+resource "aws_gamelift_fleet" "test" {
+  build_id          = "${aws_gamelift_build.test.id}"
+  ec2_instance_type = "c4.large"
+  name              = "%s"
+  description       = "Terraform acceptance test fleet"
+
+  ec2_inbound_permission {
+    from_port = %s
+    to_port   = %s
+    ip_range  = "0.0.0.0/0"
+    protocol  = "TCP"
+  }
+
+  runtime_configuration {
+    server_process {
+      concurrent_executions = 1
+      launch_path           = "%s"
+    }
+  }
+}
+`, name, port, port, launchPath)
+}
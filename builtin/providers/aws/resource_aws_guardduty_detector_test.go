@@ -0,0 +1,91 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSGuardDutyDetector_basic(t *testing.T) {
+	resourceName := "aws_guardduty_detector.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsGuardDutyDetectorDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccGuardDutyDetectorConfig(true, "SIX_HOURS"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsGuardDutyDetectorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "finding_publishing_frequency", "SIX_HOURS"),
+					resource.TestCheckResourceAttrSet(resourceName, "account_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccGuardDutyDetectorConfig(false, "FIFTEEN_MINUTES"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsGuardDutyDetectorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "finding_publishing_frequency", "FIFTEEN_MINUTES"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsGuardDutyDetectorDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_guardduty_detector" {
+			continue
+		}
+
+		_, err := conn.GetDetector(&guardduty.GetDetectorInput{
+			DetectorId: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("GuardDuty Detector %s still exists", rs.Primary.ID)
+		}
+		if !isGuardDutyDetectorNotFoundErr(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsGuardDutyDetectorExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+		_, err := conn.GetDetector(&guardduty.GetDetectorInput{
+			DetectorId: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
+func testAccGuardDutyDetectorConfig(enable bool, frequency string) string {
+	return fmt.Sprintf(`
+resource "aws_guardduty_detector" "test" {
+  enable                       = %t
+  finding_publishing_frequency = "%s"
+}
+`, enable, frequency)
+}
@@ -0,0 +1,126 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsGuardDutyInviteAccepter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGuardDutyInviteAccepterCreate,
+		Read:   resourceAwsGuardDutyInviteAccepterRead,
+		Delete: resourceAwsGuardDutyInviteAccepterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"detector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"master_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+		},
+	}
+}
+
+func resourceAwsGuardDutyInviteAccepterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorId := d.Get("detector_id").(string)
+	masterAccountId := d.Get("master_account_id").(string)
+
+	// The invitation is sent from the master account, usually through a
+	// provider alias in the same configuration, so it may take a moment to
+	// become visible to the member account.
+	var invitationId string
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		err := conn.ListInvitationsPages(&guardduty.ListInvitationsInput{}, func(page *guardduty.ListInvitationsOutput, lastPage bool) bool {
+			for _, invitation := range page.Invitations {
+				if aws.StringValue(invitation.AccountId) == masterAccountId {
+					invitationId = aws.StringValue(invitation.InvitationId)
+					return false
+				}
+			}
+			return !lastPage
+		})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if invitationId == "" {
+			return resource.RetryableError(fmt.Errorf("No GuardDuty invitation found from master account %s", masterAccountId))
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing GuardDuty invitations: %s", err)
+	}
+
+	input := &guardduty.AcceptInvitationInput{
+		DetectorId:   aws.String(detectorId),
+		InvitationId: aws.String(invitationId),
+		MasterId:     aws.String(masterAccountId),
+	}
+
+	log.Printf("[DEBUG] Accepting GuardDuty invitation: %s", input)
+	if _, err := conn.AcceptInvitation(input); err != nil {
+		return fmt.Errorf("Error accepting GuardDuty invitation %s: %s", invitationId, err)
+	}
+
+	d.SetId(detectorId)
+
+	return resourceAwsGuardDutyInviteAccepterRead(d, meta)
+}
+
+func resourceAwsGuardDutyInviteAccepterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	resp, err := conn.GetMasterAccount(&guardduty.GetMasterAccountInput{
+		DetectorId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isGuardDutyDetectorNotFoundErr(err) {
+			log.Printf("[WARN] GuardDuty Detector (%s) not found, removing invite accepter from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if resp.Master == nil {
+		log.Printf("[WARN] GuardDuty Detector (%s) has no master account, removing invite accepter from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("detector_id", d.Id())
+	d.Set("master_account_id", resp.Master.AccountId)
+
+	return nil
+}
+
+func resourceAwsGuardDutyInviteAccepterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	log.Printf("[DEBUG] Disassociating GuardDuty Detector (%s) from master account", d.Id())
+	_, err := conn.DisassociateFromMasterAccount(&guardduty.DisassociateFromMasterAccountInput{
+		DetectorId: aws.String(d.Id()),
+	})
+	if err != nil && !isGuardDutyDetectorNotFoundErr(err) {
+		return fmt.Errorf("Error disassociating GuardDuty Detector (%s) from master account: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSGuardDutyInviteAccepter_basic(t *testing.T) {
+	_, email := testAccAWSGuardDutyMemberPreCheck(t)
+	profile := os.Getenv("AWS_GUARDDUTY_MEMBER_PROFILE")
+	if profile == "" {
+		t.Skip("Environment variable AWS_GUARDDUTY_MEMBER_PROFILE must be set")
+	}
+
+	resourceName := "aws_guardduty_invite_accepter.test"
+
+	// record the initialized providers so that we can check the accepter
+	// through the member account's provider
+	var providers []*schema.Provider
+	providerFactories := map[string]terraform.ResourceProviderFactory{
+		"aws": func() (terraform.ResourceProvider, error) {
+			p := Provider()
+			providers = append(providers, p.(*schema.Provider))
+			return p, nil
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckAwsGuardDutyDetectorDestroyWithProviders(&providers),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccGuardDutyInviteAccepterConfig(email, profile),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsGuardDutyInviteAccepterExistsWithProviders(resourceName, &providers),
+					resource.TestCheckResourceAttrSet(resourceName, "master_account_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsGuardDutyDetectorDestroyWithProviders(providers *[]*schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, provider := range *providers {
+			if provider.Meta() == nil {
+				continue
+			}
+
+			conn := provider.Meta().(*AWSClient).guarddutyconn
+			for _, rs := range s.RootModule().Resources {
+				if rs.Type != "aws_guardduty_detector" {
+					continue
+				}
+
+				_, err := conn.GetDetector(&guardduty.GetDetectorInput{
+					DetectorId: aws.String(rs.Primary.ID),
+				})
+				if err == nil {
+					return fmt.Errorf("GuardDuty Detector %s still exists", rs.Primary.ID)
+				}
+				if !isGuardDutyDetectorNotFoundErr(err) {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsGuardDutyInviteAccepterExistsWithProviders(name string, providers *[]*schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		for _, provider := range *providers {
+			if provider.Meta() == nil {
+				continue
+			}
+
+			conn := provider.Meta().(*AWSClient).guarddutyconn
+			resp, err := conn.GetMasterAccount(&guardduty.GetMasterAccountInput{
+				DetectorId: aws.String(rs.Primary.ID),
+			})
+			if err != nil {
+				if isGuardDutyDetectorNotFoundErr(err) {
+					continue
+				}
+				return err
+			}
+			if resp.Master != nil && aws.StringValue(resp.Master.AccountId) == rs.Primary.Attributes["master_account_id"] {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("GuardDuty Detector %s is not associated with a master account", rs.Primary.ID)
+	}
+}
+
+func testAccGuardDutyInviteAccepterConfig(email, profile string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  alias   = "member"
+  profile = "%s"
+}
+
+resource "aws_guardduty_detector" "master" {}
+
+resource "aws_guardduty_detector" "member" {
+  provider = "aws.member"
+}
+
+resource "aws_guardduty_member" "member" {
+  account_id                 = "${aws_guardduty_detector.member.account_id}"
+  detector_id                = "${aws_guardduty_detector.master.id}"
+  email                      = "%s"
+  invite                     = true
+  disable_email_notification = true
+}
+
+resource "aws_guardduty_invite_accepter" "test" {
+  depends_on = ["aws_guardduty_member.member"]
+  provider   = "aws.member"
+
+  detector_id       = "${aws_guardduty_detector.member.id}"
+  master_account_id = "${aws_guardduty_detector.master.account_id}"
+}
+`, profile, email)
+}
@@ -0,0 +1,262 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsGuardDutyMember() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGuardDutyMemberCreate,
+		Read:   resourceAwsGuardDutyMemberRead,
+		Update: resourceAwsGuardDutyMemberUpdate,
+		Delete: resourceAwsGuardDutyMemberDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"detector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"invite": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"invitation_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"disable_email_notification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"relationship_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsGuardDutyMemberCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	accountId := d.Get("account_id").(string)
+	detectorId := d.Get("detector_id").(string)
+
+	input := &guardduty.CreateMembersInput{
+		DetectorId: aws.String(detectorId),
+		AccountDetails: []*guardduty.AccountDetail{
+			{
+				AccountId: aws.String(accountId),
+				Email:     aws.String(d.Get("email").(string)),
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] Creating GuardDuty Member: %s", input)
+	resp, err := conn.CreateMembers(input)
+	if err != nil {
+		return fmt.Errorf("Error creating GuardDuty Member: %s", err)
+	}
+	if len(resp.UnprocessedAccounts) > 0 {
+		return fmt.Errorf("Error creating GuardDuty Member %s: %s", accountId, aws.StringValue(resp.UnprocessedAccounts[0].Result))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", detectorId, accountId))
+
+	if d.Get("invite").(bool) {
+		if err := inviteGuardDutyMember(conn, d); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsGuardDutyMemberRead(d, meta)
+}
+
+func resourceAwsGuardDutyMemberRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorId, accountId, err := parseGuardDutyMemberId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	member, err := describeGuardDutyMember(conn, detectorId, accountId)
+	if err != nil {
+		if isGuardDutyDetectorNotFoundErr(err) {
+			log.Printf("[WARN] GuardDuty Detector (%s) not found, removing Member (%s) from state", detectorId, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	if member == nil {
+		log.Printf("[WARN] GuardDuty Member (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_id", member.AccountId)
+	d.Set("detector_id", detectorId)
+	d.Set("email", member.Email)
+
+	status := aws.StringValue(member.RelationshipStatus)
+	d.Set("relationship_status", status)
+
+	// Any status past "Created" means an invitation has been sent.
+	d.Set("invite", status != "Created" && status != "Removed" && status != "Resigned")
+
+	return nil
+}
+
+func resourceAwsGuardDutyMemberUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	if d.HasChange("invite") {
+		if d.Get("invite").(bool) {
+			if err := inviteGuardDutyMember(conn, d); err != nil {
+				return err
+			}
+		} else {
+			detectorId, accountId, err := parseGuardDutyMemberId(d.Id())
+			if err != nil {
+				return err
+			}
+
+			log.Printf("[DEBUG] Disassociating GuardDuty Member: %s", d.Id())
+			resp, err := conn.DisassociateMembers(&guardduty.DisassociateMembersInput{
+				DetectorId: aws.String(detectorId),
+				AccountIds: []*string{aws.String(accountId)},
+			})
+			if err != nil {
+				return fmt.Errorf("Error disassociating GuardDuty Member (%s): %s", d.Id(), err)
+			}
+			if len(resp.UnprocessedAccounts) > 0 {
+				return fmt.Errorf("Error disassociating GuardDuty Member (%s): %s", d.Id(), aws.StringValue(resp.UnprocessedAccounts[0].Result))
+			}
+		}
+	}
+
+	return resourceAwsGuardDutyMemberRead(d, meta)
+}
+
+func resourceAwsGuardDutyMemberDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorId, accountId, err := parseGuardDutyMemberId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting GuardDuty Member: %s", d.Id())
+	resp, err := conn.DeleteMembers(&guardduty.DeleteMembersInput{
+		DetectorId: aws.String(detectorId),
+		AccountIds: []*string{aws.String(accountId)},
+	})
+	if err != nil {
+		if isGuardDutyDetectorNotFoundErr(err) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting GuardDuty Member (%s): %s", d.Id(), err)
+	}
+	if len(resp.UnprocessedAccounts) > 0 {
+		return fmt.Errorf("Error deleting GuardDuty Member (%s): %s", d.Id(), aws.StringValue(resp.UnprocessedAccounts[0].Result))
+	}
+
+	return nil
+}
+
+func inviteGuardDutyMember(conn *guardduty.GuardDuty, d *schema.ResourceData) error {
+	detectorId, accountId, err := parseGuardDutyMemberId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.InviteMembersInput{
+		DetectorId:               aws.String(detectorId),
+		AccountIds:               []*string{aws.String(accountId)},
+		DisableEmailNotification: aws.Bool(d.Get("disable_email_notification").(bool)),
+	}
+	if v, ok := d.GetOk("invitation_message"); ok {
+		input.Message = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Inviting GuardDuty Member: %s", input)
+	resp, err := conn.InviteMembers(input)
+	if err != nil {
+		return fmt.Errorf("Error inviting GuardDuty Member (%s): %s", d.Id(), err)
+	}
+	if len(resp.UnprocessedAccounts) > 0 {
+		return fmt.Errorf("Error inviting GuardDuty Member (%s): %s", d.Id(), aws.StringValue(resp.UnprocessedAccounts[0].Result))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Created", "EmailVerificationInProgress"},
+		Target:  []string{"Invited", "Enabled"},
+		Refresh: func() (interface{}, string, error) {
+			member, err := describeGuardDutyMember(conn, detectorId, accountId)
+			if err != nil {
+				return nil, "", err
+			}
+			if member == nil {
+				return nil, "", fmt.Errorf("GuardDuty Member (%s) not found", d.Id())
+			}
+			return member, aws.StringValue(member.RelationshipStatus), nil
+		},
+		Timeout: 1 * time.Minute,
+		Delay:   5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for GuardDuty Member (%s) to be invited: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func describeGuardDutyMember(conn *guardduty.GuardDuty, detectorId, accountId string) (*guardduty.Member, error) {
+	resp, err := conn.GetMembers(&guardduty.GetMembersInput{
+		DetectorId: aws.String(detectorId),
+		AccountIds: []*string{aws.String(accountId)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range resp.Members {
+		if aws.StringValue(m.AccountId) == accountId {
+			return m, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func parseGuardDutyMemberId(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("GuardDuty Member ID must be of the form <detector_id>:<account_id>, got: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,140 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// testAccAWSGuardDutyMemberPreCheck skips the test unless a second account
+// that can be added as a GuardDuty member has been provided.
+func testAccAWSGuardDutyMemberPreCheck(t *testing.T) (string, string) {
+	accountId := os.Getenv("AWS_GUARDDUTY_MEMBER_ACCOUNT_ID")
+	email := os.Getenv("AWS_GUARDDUTY_MEMBER_EMAIL")
+	if accountId == "" || email == "" {
+		t.Skip("Environment variables AWS_GUARDDUTY_MEMBER_ACCOUNT_ID and AWS_GUARDDUTY_MEMBER_EMAIL must be set")
+	}
+	return accountId, email
+}
+
+func TestParseGuardDutyMemberId(t *testing.T) {
+	detectorId, accountId, err := parseGuardDutyMemberId("abc123:111111111111")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if detectorId != "abc123" || accountId != "111111111111" {
+		t.Fatalf("bad: %s, %s", detectorId, accountId)
+	}
+
+	for _, id := range []string{"", "abc123", "abc123:", ":111111111111", "a:b:c"} {
+		if _, _, err := parseGuardDutyMemberId(id); err == nil {
+			t.Fatalf("expected error for %q", id)
+		}
+	}
+}
+
+func TestAccAWSGuardDutyMember_basic(t *testing.T) {
+	accountId, email := testAccAWSGuardDutyMemberPreCheck(t)
+	resourceName := "aws_guardduty_member.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsGuardDutyMemberDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccGuardDutyMemberConfig(accountId, email, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsGuardDutyMemberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_id", accountId),
+					resource.TestCheckResourceAttr(resourceName, "email", email),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", "Created"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccGuardDutyMemberConfig(accountId, email, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsGuardDutyMemberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "invite", "true"),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", "Invited"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"disable_email_notification", "invitation_message"},
+			},
+		},
+	})
+}
+
+func testAccCheckAwsGuardDutyMemberDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_guardduty_member" {
+			continue
+		}
+
+		detectorId, accountId, err := parseGuardDutyMemberId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		member, err := describeGuardDutyMember(conn, detectorId, accountId)
+		if err != nil {
+			if isGuardDutyDetectorNotFoundErr(err) {
+				continue
+			}
+			return err
+		}
+		if member != nil {
+			return fmt.Errorf("GuardDuty Member %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsGuardDutyMemberExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		detectorId, accountId, err := parseGuardDutyMemberId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+		member, err := describeGuardDutyMember(conn, detectorId, accountId)
+		if err != nil {
+			return err
+		}
+		if member == nil {
+			return fmt.Errorf("GuardDuty Member %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccGuardDutyMemberConfig(accountId, email string, invite bool) string {
+	return fmt.Sprintf(`
+resource "aws_guardduty_detector" "test" {}
+
+resource "aws_guardduty_member" "test" {
+  account_id                 = "%s"
+  detector_id                = "${aws_guardduty_detector.test.id}"
+  email                      = "%s"
+  invite                     = %t
+  disable_email_notification = true
+}
+`, accountId, email, invite)
+}
@@ -0,0 +1,87 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsIamAccountAlias() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIamAccountAliasCreate,
+		Read:   resourceAwsIamAccountAliasRead,
+		Delete: resourceAwsIamAccountAliasDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_alias": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAccountAlias,
+			},
+		},
+	}
+}
+
+func resourceAwsIamAccountAliasCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	alias := d.Get("account_alias").(string)
+	log.Printf("[DEBUG] Creating IAM account alias: %s", alias)
+	_, err := conn.CreateAccountAlias(&iam.CreateAccountAliasInput{
+		AccountAlias: aws.String(alias),
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating IAM account alias %q: %s", alias, err)
+	}
+
+	d.SetId(alias)
+
+	return resourceAwsIamAccountAliasRead(d, meta)
+}
+
+func resourceAwsIamAccountAliasRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	resp, err := conn.ListAccountAliases(&iam.ListAccountAliasesInput{})
+	if err != nil {
+		return fmt.Errorf("Error listing IAM account aliases: %s", err)
+	}
+
+	// An account has at most one alias, which can be changed outside of
+	// Terraform.
+	for _, alias := range resp.AccountAliases {
+		if aws.StringValue(alias) == d.Id() {
+			d.Set("account_alias", d.Id())
+			return nil
+		}
+	}
+
+	log.Printf("[WARN] IAM account alias %q not found, removing from state", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceAwsIamAccountAliasDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	log.Printf("[DEBUG] Deleting IAM account alias: %s", d.Id())
+	_, err := conn.DeleteAccountAlias(&iam.DeleteAccountAliasInput{
+		AccountAlias: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "NoSuchEntity", "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting IAM account alias %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSIAMAccountAlias_basic(t *testing.T) {
+	alias := fmt.Sprintf("tf-acc-test-%d", acctest.RandInt())
+	resourceName := "aws_iam_account_alias.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIAMAccountAliasDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSIAMAccountAliasConfig(alias),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMAccountAliasExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_alias", alias),
+				),
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIAMAccountAliasExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		found, err := testAccAWSIAMAccountAliasFound(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("IAM account alias %q not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIAMAccountAliasDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iam_account_alias" {
+			continue
+		}
+
+		found, err := testAccAWSIAMAccountAliasFound(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("IAM account alias %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSIAMAccountAliasFound(alias string) (bool, error) {
+	conn := testAccProvider.Meta().(*AWSClient).iamconn
+
+	resp, err := conn.ListAccountAliases(&iam.ListAccountAliasesInput{})
+	if err != nil {
+		return false, err
+	}
+	for _, a := range resp.AccountAliases {
+		if aws.StringValue(a) == alias {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func testAccAWSIAMAccountAliasConfig(alias string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_account_alias" "test" {
+  account_alias = "%s"
+}
+`, alias)
+}
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"regexp"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -109,6 +110,7 @@ func resourceAwsIamInstanceProfileCreate(d *schema.ResourceData, meta interface{
 		Path:                aws.String(d.Get("path").(string)),
 	}
 
+	start := time.Now()
 	var err error
 	response, err := iamconn.CreateInstanceProfile(request)
 	if err == nil {
@@ -118,7 +120,38 @@ func resourceAwsIamInstanceProfileCreate(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error creating IAM instance profile %s: %s", name, err)
 	}
 
-	return instanceProfileSetRoles(d, iamconn)
+	if err := instanceProfileSetRoles(d, iamconn); err != nil {
+		return err
+	}
+
+	return instanceProfileWaitForPropagation(d, meta, start)
+}
+
+// instanceProfileWaitForPropagation waits until the instance profile can be
+// read back with its roles, and then for the IAM propagation delay.
+func instanceProfileWaitForPropagation(d *schema.ResourceData, meta interface{}, start time.Time) error {
+	iamconn := meta.(*AWSClient).iamconn
+	roles := d.Get("roles").(*schema.Set)
+
+	return iamPropagationWaiter(meta).Wait("IAM instance profile "+d.Id(), start, func() (bool, error) {
+		resp, err := iamconn.GetInstanceProfile(&iam.GetInstanceProfileInput{
+			InstanceProfileName: aws.String(d.Id()),
+		})
+		if isAWSErr(err, "NoSuchEntity", "") {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+
+		found := 0
+		for _, r := range resp.InstanceProfile.Roles {
+			if roles.Contains(*r.RoleName) {
+				found++
+			}
+		}
+		return found == roles.Len(), nil
+	})
 }
 
 func instanceProfileAddRole(iamconn *iam.IAM, profileName, roleName string) error {
@@ -195,7 +228,12 @@ func resourceAwsIamInstanceProfileUpdate(d *schema.ResourceData, meta interface{
 		return nil
 	}
 
-	return instanceProfileSetRoles(d, iamconn)
+	start := time.Now()
+	if err := instanceProfileSetRoles(d, iamconn); err != nil {
+		return err
+	}
+
+	return instanceProfileWaitForPropagation(d, meta, start)
 }
 
 func resourceAwsIamInstanceProfileRead(d *schema.ResourceData, meta interface{}) error {
@@ -0,0 +1,223 @@
+package aws
+
+import (
+	"crypto/sha1"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsIamOpenIDConnectProvider() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIamOpenIDConnectProviderCreate,
+		Read:   resourceAwsIamOpenIDConnectProviderRead,
+		Update: resourceAwsIamOpenIDConnectProviderUpdate,
+		Delete: resourceAwsIamOpenIDConnectProviderDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOpenIdURL,
+			},
+			"client_id_list": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"thumbprint_list": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAwsIamOpenIDConnectProviderCreate(d *schema.ResourceData, meta interface{}) error {
+	iamconn := meta.(*AWSClient).iamconn
+
+	thumbprints, err := iamOpenIDConnectProviderThumbprints(d)
+	if err != nil {
+		return err
+	}
+
+	input := &iam.CreateOpenIDConnectProviderInput{
+		Url:            aws.String(d.Get("url").(string)),
+		ClientIDList:   expandStringList(d.Get("client_id_list").([]interface{})),
+		ThumbprintList: thumbprints,
+	}
+
+	log.Printf("[DEBUG] Creating IAM OpenID Connect Provider: %s", input)
+	out, err := iamconn.CreateOpenIDConnectProvider(input)
+	if err != nil {
+		return fmt.Errorf("Error creating IAM OpenID Connect Provider: %s", err)
+	}
+
+	d.SetId(*out.OpenIDConnectProviderArn)
+
+	return resourceAwsIamOpenIDConnectProviderRead(d, meta)
+}
+
+func resourceAwsIamOpenIDConnectProviderRead(d *schema.ResourceData, meta interface{}) error {
+	iamconn := meta.(*AWSClient).iamconn
+
+	input := &iam.GetOpenIDConnectProviderInput{
+		OpenIDConnectProviderArn: aws.String(d.Id()),
+	}
+	out, err := iamconn.GetOpenIDConnectProvider(input)
+	if err != nil {
+		if iamerr, ok := err.(awserr.Error); ok && iamerr.Code() == "NoSuchEntity" {
+			log.Printf("[WARN] IAM OpenID Connect Provider %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading IAM OpenID Connect Provider %s: %s", d.Id(), err)
+	}
+
+	// The URL is returned without the scheme, which is always https
+	d.Set("arn", d.Id())
+	d.Set("url", "https://"+*out.Url)
+	d.Set("client_id_list", flattenStringList(out.ClientIDList))
+	d.Set("thumbprint_list", flattenStringList(out.ThumbprintList))
+
+	return nil
+}
+
+func resourceAwsIamOpenIDConnectProviderUpdate(d *schema.ResourceData, meta interface{}) error {
+	iamconn := meta.(*AWSClient).iamconn
+	arn := aws.String(d.Id())
+
+	if d.HasChange("client_id_list") {
+		o, n := d.GetChange("client_id_list")
+		os := schema.NewSet(schema.HashString, o.([]interface{}))
+		ns := schema.NewSet(schema.HashString, n.([]interface{}))
+
+		for _, id := range ns.Difference(os).List() {
+			_, err := iamconn.AddClientIDToOpenIDConnectProvider(&iam.AddClientIDToOpenIDConnectProviderInput{
+				OpenIDConnectProviderArn: arn,
+				ClientID:                 aws.String(id.(string)),
+			})
+			if err != nil {
+				return fmt.Errorf("Error adding client ID %q to IAM OpenID Connect Provider: %s", id, err)
+			}
+		}
+
+		for _, id := range os.Difference(ns).List() {
+			_, err := iamconn.RemoveClientIDFromOpenIDConnectProvider(&iam.RemoveClientIDFromOpenIDConnectProviderInput{
+				OpenIDConnectProviderArn: arn,
+				ClientID:                 aws.String(id.(string)),
+			})
+			if err != nil {
+				return fmt.Errorf("Error removing client ID %q from IAM OpenID Connect Provider: %s", id, err)
+			}
+		}
+	}
+
+	if d.HasChange("thumbprint_list") {
+		thumbprints, err := iamOpenIDConnectProviderThumbprints(d)
+		if err != nil {
+			return err
+		}
+
+		_, err = iamconn.UpdateOpenIDConnectProviderThumbprint(&iam.UpdateOpenIDConnectProviderThumbprintInput{
+			OpenIDConnectProviderArn: arn,
+			ThumbprintList:           thumbprints,
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating thumbprints of IAM OpenID Connect Provider: %s", err)
+		}
+	}
+
+	return resourceAwsIamOpenIDConnectProviderRead(d, meta)
+}
+
+func resourceAwsIamOpenIDConnectProviderDelete(d *schema.ResourceData, meta interface{}) error {
+	iamconn := meta.(*AWSClient).iamconn
+
+	input := &iam.DeleteOpenIDConnectProviderInput{
+		OpenIDConnectProviderArn: aws.String(d.Id()),
+	}
+	_, err := iamconn.DeleteOpenIDConnectProvider(input)
+	if err != nil {
+		if iamerr, ok := err.(awserr.Error); ok && iamerr.Code() == "NoSuchEntity" {
+			return nil
+		}
+		return fmt.Errorf("Error deleting IAM OpenID Connect Provider %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// iamOpenIDConnectProviderThumbprints returns the configured thumbprints of
+// the provider. If none are configured, the thumbprint is computed from the
+// certificate chain served at the provider's URL.
+func iamOpenIDConnectProviderThumbprints(d *schema.ResourceData) ([]*string, error) {
+	if v, ok := d.GetOk("thumbprint_list"); ok && len(v.([]interface{})) > 0 {
+		return expandStringList(v.([]interface{})), nil
+	}
+
+	thumbprint, err := fetchOpenIDConnectThumbprint(d.Get("url").(string), nil)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"Error computing the thumbprint of %s, set thumbprint_list explicitly: %s",
+			d.Get("url").(string), err)
+	}
+
+	return []*string{aws.String(thumbprint)}, nil
+}
+
+// fetchOpenIDConnectThumbprint connects to the host of the given OpenID
+// Connect provider URL and returns the thumbprint IAM expects for it: the
+// thumbprint of the top intermediate CA certificate of the chain served.
+func fetchOpenIDConnectThumbprint(rawurl string, config *tls.Config) (string, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return "", err
+	}
+
+	host := u.Host
+	if _, _, err := net.SplitHostPort(host); err != nil {
+		host = net.JoinHostPort(host, "443")
+	}
+
+	conn, err := tls.Dial("tcp", host, config)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	certs := conn.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return "", fmt.Errorf("no certificates served by %s", host)
+	}
+
+	return certificateThumbprint(certs[len(certs)-1]), nil
+}
+
+// certificateThumbprint returns the hex-encoded SHA-1 fingerprint of the
+// given certificate, which is the format IAM uses for thumbprints.
+func certificateThumbprint(cert *x509.Certificate) string {
+	sum := sha1.Sum(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
@@ -104,6 +104,7 @@ func resourceAwsIamRoleCreate(d *schema.ResourceData, meta interface{}) error {
 		AssumeRolePolicyDocument: aws.String(d.Get("assume_role_policy").(string)),
 	}
 
+	start := time.Now()
 	var createResp *iam.CreateRoleOutput
 	err := resource.Retry(30*time.Second, func() *resource.RetryError {
 		var err error
@@ -118,6 +119,18 @@ func resourceAwsIamRoleCreate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("Error creating IAM Role %s: %s", name, err)
 	}
+
+	err = iamPropagationWaiter(meta).Wait("IAM Role "+name, start, func() (bool, error) {
+		_, err := iamconn.GetRole(&iam.GetRoleInput{RoleName: aws.String(name)})
+		if isAWSErr(err, "NoSuchEntity", "") {
+			return false, nil
+		}
+		return err == nil, err
+	})
+	if err != nil {
+		return err
+	}
+
 	return resourceAwsIamRoleReadResult(d, createResp.Role)
 }
 
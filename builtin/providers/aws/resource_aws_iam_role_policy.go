@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -63,12 +64,23 @@ func resourceAwsIamRolePolicyPut(d *schema.ResourceData, meta interface{}) error
 		PolicyDocument: aws.String(d.Get("policy").(string)),
 	}
 
+	start := time.Now()
 	if _, err := iamconn.PutRolePolicy(request); err != nil {
 		return fmt.Errorf("Error putting IAM role policy %s: %s", *request.PolicyName, err)
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", *request.RoleName, *request.PolicyName))
-	return nil
+
+	return iamPropagationWaiter(meta).Wait("IAM role policy "+d.Id(), start, func() (bool, error) {
+		_, err := iamconn.GetRolePolicy(&iam.GetRolePolicyInput{
+			RoleName:   request.RoleName,
+			PolicyName: request.PolicyName,
+		})
+		if isAWSErr(err, "NoSuchEntity", "") {
+			return false, nil
+		}
+		return err == nil, err
+	})
 }
 
 func resourceAwsIamRolePolicyRead(d *schema.ResourceData, meta interface{}) error {
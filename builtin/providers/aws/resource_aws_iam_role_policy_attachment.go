@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -38,11 +39,18 @@ func resourceAwsIamRolePolicyAttachmentCreate(d *schema.ResourceData, meta inter
 	role := d.Get("role").(string)
 	arn := d.Get("policy_arn").(string)
 
+	start := time.Now()
 	err := attachPolicyToRole(conn, role, arn)
 	if err != nil {
 		return fmt.Errorf("[WARN] Error attaching policy %s to IAM Role %s: %v", arn, role, err)
 	}
 
+	// The attachment can't be read back by itself, so there's only the delay
+	desc := fmt.Sprintf("attachment of policy %s to IAM Role %s", arn, role)
+	if err := iamPropagationWaiter(meta).Wait(desc, start, nil); err != nil {
+		return err
+	}
+
 	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", role)))
 	return resourceAwsIamRolePolicyAttachmentRead(d, meta)
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -19,6 +20,10 @@ func resourceAwsKmsAlias() *schema.Resource {
 		Update: resourceAwsKmsAliasUpdate,
 		Delete: resourceAwsKmsAliasDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": &schema.Schema{
 				Type:     schema.TypeString,
@@ -27,6 +32,7 @@ func resourceAwsKmsAlias() *schema.Resource {
 			"name": &schema.Schema{
 				Type:          schema.TypeString,
 				Optional:      true,
+				Computed:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"name_prefix"},
 				ValidateFunc: func(v interface{}, k string) (ws []string, es []error) {
@@ -52,8 +58,9 @@ func resourceAwsKmsAlias() *schema.Resource {
 				},
 			},
 			"target_key_id": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentKmsKeyIds,
 			},
 		},
 	}
@@ -103,6 +110,7 @@ func resourceAwsKmsAliasRead(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Found KMS Alias: %s", alias)
 
 	d.Set("arn", alias.AliasArn)
+	d.Set("name", alias.AliasName)
 	d.Set("target_key_id", alias.TargetKeyId)
 
 	return nil
@@ -121,7 +129,9 @@ func resourceAwsKmsAliasUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsKmsAliasTargetUpdate(conn *kms.KMS, d *schema.ResourceData) error {
-	name := d.Get("name").(string)
+	// The name isn't known from the config if the alias was created
+	// from a name_prefix, so use the ID instead.
+	name := d.Id()
 	targetKeyId := d.Get("target_key_id").(string)
 
 	log.Printf("[DEBUG] KMS alias: %s, update target: %s", name, targetKeyId)
@@ -151,6 +161,29 @@ func resourceAwsKmsAliasDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// suppressEquivalentKmsKeyIds suppresses diffs between a key ID and the
+// ARN of the same key. The target of an alias is always read back as a
+// key ID, but it can be configured with either.
+func suppressEquivalentKmsKeyIds(k, old, new string, d *schema.ResourceData) bool {
+	return kmsKeyIdFromArn(old) == kmsKeyIdFromArn(new)
+}
+
+// kmsKeyIdFromArn returns the key ID of a KMS key ARN such as
+// arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab,
+// or the given value if it isn't a key ARN.
+func kmsKeyIdFromArn(v string) string {
+	if !strings.HasPrefix(v, "arn:") {
+		return v
+	}
+
+	parts := strings.SplitN(v, ":", 6)
+	if len(parts) != 6 || !strings.HasPrefix(parts[5], "key/") {
+		return v
+	}
+
+	return strings.TrimPrefix(parts[5], "key/")
+}
+
 // API by default limits results to 50 aliases
 // This is how we make sure we won't miss any alias
 // See http://docs.aws.amazon.com/kms/latest/APIReference/API_ListAliases.html
@@ -81,6 +81,49 @@ func TestAccAWSKmsAlias_multiple(t *testing.T) {
 	})
 }
 
+func TestAccAWSKmsAlias_arnTarget(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsAliasDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSKmsArnTargetAlias,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsAliasExists("aws_kms_alias.arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestKmsKeyIdFromArn(t *testing.T) {
+	cases := map[string]string{
+		"1234abcd-12ab-34cd-56ef-1234567890ab":                                            "1234abcd-12ab-34cd-56ef-1234567890ab",
+		"arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab":     "1234abcd-12ab-34cd-56ef-1234567890ab",
+		"arn:aws-cn:kms:cn-north-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab": "1234abcd-12ab-34cd-56ef-1234567890ab",
+		"arn:aws:kms:us-west-2:123456789012:alias/my-alias":                               "arn:aws:kms:us-west-2:123456789012:alias/my-alias",
+		"alias/my-alias": "alias/my-alias",
+	}
+
+	for input, expected := range cases {
+		if actual := kmsKeyIdFromArn(input); actual != expected {
+			t.Fatalf("%s: expected %q, got %q", input, expected, actual)
+		}
+	}
+
+	if !suppressEquivalentKmsKeyIds("target_key_id",
+		"1234abcd-12ab-34cd-56ef-1234567890ab",
+		"arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", nil) {
+		t.Fatal("expected the key ID and ARN to be equivalent")
+	}
+	if suppressEquivalentKmsKeyIds("target_key_id",
+		"1234abcd-12ab-34cd-56ef-1234567890ab",
+		"arn:aws:kms:us-west-2:123456789012:key/5678abcd-12ab-34cd-56ef-1234567890ab", nil) {
+		t.Fatal("expected different keys not to be equivalent")
+	}
+}
+
 func testAccCheckAWSKmsAliasDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).kmsconn
 
@@ -168,3 +211,14 @@ resource "aws_kms_alias" "two" {
     name = "alias/tf-acc-key-alias-%s"
     target_key_id = "${aws_kms_key.single.key_id}"
 }`, kmsAliasTimestamp, acctest.RandString(5), acctest.RandString(5))
+
+var testAccAWSKmsArnTargetAlias = fmt.Sprintf(`
+resource "aws_kms_key" "one" {
+    description = "Terraform acc test One %s"
+    deletion_window_in_days = 7
+}
+
+resource "aws_kms_alias" "arn" {
+    name = "alias/tf-acc-key-alias-%s"
+    target_key_id = "${aws_kms_key.one.arn}"
+}`, kmsAliasTimestamp, acctest.RandString(5))
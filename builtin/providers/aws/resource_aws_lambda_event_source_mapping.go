@@ -32,13 +32,13 @@ func resourceAwsLambdaEventSourceMapping() *schema.Resource {
 			},
 			"starting_position": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 			},
 			"batch_size": &schema.Schema{
 				Type:     schema.TypeInt,
 				Optional: true,
-				Default:  100,
+				Computed: true,
 			},
 			"enabled": &schema.Schema{
 				Type:     schema.TypeBool,
@@ -84,11 +84,19 @@ func resourceAwsLambdaEventSourceMappingCreate(d *schema.ResourceData, meta inte
 	log.Printf("[DEBUG] Creating Lambda event source mapping: source %s to function %s", eventSourceArn, functionName)
 
 	params := &lambda.CreateEventSourceMappingInput{
-		EventSourceArn:   aws.String(eventSourceArn),
-		FunctionName:     aws.String(functionName),
-		StartingPosition: aws.String(d.Get("starting_position").(string)),
-		BatchSize:        aws.Int64(int64(d.Get("batch_size").(int))),
-		Enabled:          aws.Bool(d.Get("enabled").(bool)),
+		EventSourceArn: aws.String(eventSourceArn),
+		FunctionName:   aws.String(functionName),
+		Enabled:        aws.Bool(d.Get("enabled").(bool)),
+	}
+
+	// Stream sources (Kinesis, DynamoDB) require a starting position, while
+	// SQS queues reject one. When batch_size is unset the API picks the
+	// default for the source type (100 for streams, 10 for SQS).
+	if v, ok := d.GetOk("starting_position"); ok {
+		params.StartingPosition = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("batch_size"); ok {
+		params.BatchSize = aws.Int64(int64(v.(int)))
 	}
 
 	// IAM profiles and roles can take some time to propagate in AWS:
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -333,3 +334,106 @@ resource "aws_lambda_event_source_mapping" "lambda_event_source_mapping_test" {
 		starting_position = "TRIM_HORIZON"
 }
 `
+
+func TestAccAWSLambdaEventSourceMapping_sqs(t *testing.T) {
+	var conf lambda.EventSourceMappingConfiguration
+	var uuid string
+	rName := fmt.Sprintf("tf_acc_lambda_sqs_%s", acctest.RandString(5))
+	resourceName := "aws_lambda_event_source_mapping.lambda_event_source_mapping_test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLambdaEventSourceMappingDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSLambdaEventSourceMappingConfigSQS(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLambdaEventSourceMappingExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "batch_size", "10"),
+					resource.TestCheckResourceAttr(resourceName, "starting_position", ""),
+					func(s *terraform.State) error {
+						uuid = *conf.UUID
+						return nil
+					},
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSLambdaEventSourceMappingConfigSQS(rName, "batch_size = 5"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLambdaEventSourceMappingExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "batch_size", "5"),
+					func(s *terraform.State) error {
+						if *conf.UUID != uuid {
+							return fmt.Errorf("Lambda event source mapping was recreated (%s != %s)", *conf.UUID, uuid)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSLambdaEventSourceMappingConfigSQS(rName, batchSize string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "iam_for_lambda" {
+    name = "%s"
+    assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "policy_for_role" {
+    name = "%s"
+    role = "${aws_iam_role.iam_for_lambda.id}"
+    policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+      {
+          "Effect": "Allow",
+          "Action": [
+            "sqs:ChangeMessageVisibility",
+            "sqs:DeleteMessage",
+            "sqs:GetQueueAttributes",
+            "sqs:ReceiveMessage"
+          ],
+          "Resource": "${aws_sqs_queue.sqs_queue_test.arn}"
+      }
+  ]
+}
+EOF
+}
+
+resource "aws_sqs_queue" "sqs_queue_test" {
+    name = "%s"
+}
+
+resource "aws_lambda_function" "lambda_function_test" {
+    filename = "test-fixtures/lambdatest.zip"
+    function_name = "%s"
+    role = "${aws_iam_role.iam_for_lambda.arn}"
+    handler = "exports.example"
+}
+
+resource "aws_lambda_event_source_mapping" "lambda_event_source_mapping_test" {
+    event_source_arn = "${aws_sqs_queue.sqs_queue_test.arn}"
+    function_name = "${aws_lambda_function.lambda_function_test.arn}"
+    depends_on = ["aws_iam_role_policy.policy_for_role"]
+    %s
+}
+`, rName, rName, rName, rName, batchSize)
+}
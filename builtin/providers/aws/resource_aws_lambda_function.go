@@ -94,23 +94,54 @@ func resourceAwsLambdaFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"environment": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"variables": &schema.Schema{
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     schema.TypeString,
+						},
+					},
+				},
+			},
+			"kms_key_arn": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"dead_letter_config": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_arn": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
 			"vpc_config": &schema.Schema{
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"subnet_ids": &schema.Schema{
 							Type:     schema.TypeSet,
 							Required: true,
-							ForceNew: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 							Set:      schema.HashString,
 						},
 						"security_group_ids": &schema.Schema{
 							Type:     schema.TypeSet,
 							Required: true,
-							ForceNew: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 							Set:      schema.HashString,
 						},
@@ -196,21 +227,20 @@ func resourceAwsLambdaFunctionCreate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		if config != nil {
-			var subnetIds []*string
-			for _, id := range config["subnet_ids"].(*schema.Set).List() {
-				subnetIds = append(subnetIds, aws.String(id.(string)))
-			}
+			params.VpcConfig = expandLambdaVpcConfig(config)
+		}
+	}
 
-			var securityGroupIds []*string
-			for _, id := range config["security_group_ids"].(*schema.Set).List() {
-				securityGroupIds = append(securityGroupIds, aws.String(id.(string)))
-			}
+	if v, ok := d.GetOk("environment"); ok {
+		params.Environment = expandLambdaEnvironment(v.([]interface{}))
+	}
 
-			params.VpcConfig = &lambda.VpcConfig{
-				SubnetIds:        subnetIds,
-				SecurityGroupIds: securityGroupIds,
-			}
-		}
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		params.KMSKeyArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("dead_letter_config"); ok {
+		params.DeadLetterConfig = expandLambdaDeadLetterConfig(v.([]interface{}))
 	}
 
 	// IAM profiles can take ~10 seconds to propagate in AWS:
@@ -282,6 +312,13 @@ func resourceAwsLambdaFunctionRead(d *schema.ResourceData, meta interface{}) err
 			return fmt.Errorf("Failed setting vpc_config: %s", err)
 		}
 	}
+	if err := d.Set("environment", flattenLambdaEnvironment(function.Environment)); err != nil {
+		return fmt.Errorf("Failed setting environment: %s", err)
+	}
+	d.Set("kms_key_arn", function.KMSKeyArn)
+	if err := d.Set("dead_letter_config", flattenLambdaDeadLetterConfig(function.DeadLetterConfig)); err != nil {
+		return fmt.Errorf("Failed setting dead_letter_config: %s", err)
+	}
 	d.Set("source_code_hash", function.CodeSha256)
 
 	// List is sorted from oldest to latest
@@ -417,6 +454,47 @@ func resourceAwsLambdaFunctionUpdate(d *schema.ResourceData, meta interface{}) e
 		configReq.Timeout = aws.Int64(int64(d.Get("timeout").(int)))
 		configUpdate = true
 	}
+	if d.HasChange("vpc_config") {
+		// Sending empty subnet and security group lists detaches the
+		// function from its VPC.
+		configReq.VpcConfig = &lambda.VpcConfig{
+			SubnetIds:        []*string{},
+			SecurityGroupIds: []*string{},
+		}
+		if v, ok := d.GetOk("vpc_config"); ok {
+			config, err := validateVPCConfig(v)
+			if err != nil {
+				return err
+			}
+			if config != nil {
+				configReq.VpcConfig = expandLambdaVpcConfig(config)
+			}
+		}
+		configUpdate = true
+	}
+	if d.HasChange("environment") {
+		configReq.Environment = expandLambdaEnvironment(d.Get("environment").([]interface{}))
+		if configReq.Environment == nil {
+			configReq.Environment = &lambda.Environment{
+				Variables: map[string]*string{},
+			}
+		}
+		configUpdate = true
+	}
+	if d.HasChange("kms_key_arn") {
+		// An empty ARN reverts to the default service key.
+		configReq.KMSKeyArn = aws.String(d.Get("kms_key_arn").(string))
+		configUpdate = true
+	}
+	if d.HasChange("dead_letter_config") {
+		configReq.DeadLetterConfig = expandLambdaDeadLetterConfig(d.Get("dead_letter_config").([]interface{}))
+		if configReq.DeadLetterConfig == nil {
+			configReq.DeadLetterConfig = &lambda.DeadLetterConfig{
+				TargetArn: aws.String(""),
+			}
+		}
+		configUpdate = true
+	}
 
 	if configUpdate {
 		log.Printf("[DEBUG] Send Update Lambda Function Configuration request: %#v", configReq)
@@ -429,6 +507,10 @@ func resourceAwsLambdaFunctionUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("memory_size")
 		d.SetPartial("role")
 		d.SetPartial("timeout")
+		d.SetPartial("vpc_config")
+		d.SetPartial("environment")
+		d.SetPartial("kms_key_arn")
+		d.SetPartial("dead_letter_config")
 	}
 	d.Partial(false)
 
@@ -450,6 +532,9 @@ func loadFileContent(v string) ([]byte, error) {
 
 func validateVPCConfig(v interface{}) (map[string]interface{}, error) {
 	configs := v.([]interface{})
+	if len(configs) == 0 {
+		return nil, nil
+	}
 	if len(configs) > 1 {
 		return nil, errors.New("Only a single vpc_config block is expected")
 	}
@@ -475,3 +560,38 @@ func validateVPCConfig(v interface{}) (map[string]interface{}, error) {
 
 	return config, nil
 }
+
+func expandLambdaVpcConfig(config map[string]interface{}) *lambda.VpcConfig {
+	return &lambda.VpcConfig{
+		SubnetIds:        expandStringList(config["subnet_ids"].(*schema.Set).List()),
+		SecurityGroupIds: expandStringList(config["security_group_ids"].(*schema.Set).List()),
+	}
+}
+
+func expandLambdaEnvironment(configured []interface{}) *lambda.Environment {
+	if len(configured) == 0 {
+		return nil
+	}
+
+	variables := map[string]*string{}
+	if config, ok := configured[0].(map[string]interface{}); ok {
+		if v, ok := config["variables"].(map[string]interface{}); ok {
+			variables = stringMapToPointers(v)
+		}
+	}
+
+	return &lambda.Environment{
+		Variables: variables,
+	}
+}
+
+func expandLambdaDeadLetterConfig(configured []interface{}) *lambda.DeadLetterConfig {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	config := configured[0].(map[string]interface{})
+	return &lambda.DeadLetterConfig{
+		TargetArn: aws.String(config["target_arn"].(string)),
+	}
+}
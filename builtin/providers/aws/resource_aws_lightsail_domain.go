@@ -0,0 +1,103 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsLightsailDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLightsailDomainCreate,
+		Read:   resourceAwsLightsailDomainRead,
+		Delete: resourceAwsLightsailDomainDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLightsailDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	name := d.Get("domain_name").(string)
+	log.Printf("[DEBUG] Creating Lightsail domain %s", name)
+	out, err := conn.CreateDomain(&lightsail.CreateDomainInput{
+		DomainName: aws.String(name),
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating Lightsail domain: %s", err)
+	}
+
+	d.SetId(name)
+
+	if out.Operation != nil {
+		if err := resourceAwsLightsailWaitForOperation(conn, aws.StringValue(out.Operation.Id)); err != nil {
+			return fmt.Errorf("Error waiting for Lightsail domain %s to be created: %s", name, err)
+		}
+	}
+
+	return resourceAwsLightsailDomainRead(d, meta)
+}
+
+func resourceAwsLightsailDomainRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	out, err := conn.GetDomain(&lightsail.GetDomainInput{
+		DomainName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Lightsail domain %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Lightsail domain %s: %s", d.Id(), err)
+	}
+
+	d.Set("domain_name", out.Domain.Name)
+	d.Set("arn", out.Domain.Arn)
+
+	return nil
+}
+
+func resourceAwsLightsailDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	log.Printf("[DEBUG] Deleting Lightsail domain %s", d.Id())
+	out, err := conn.DeleteDomain(&lightsail.DeleteDomainInput{
+		DomainName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error deleting Lightsail domain %s: %s", d.Id(), err)
+	}
+
+	if out.Operation != nil {
+		if err := resourceAwsLightsailWaitForOperation(conn, aws.StringValue(out.Operation.Id)); err != nil {
+			return fmt.Errorf("Error waiting for Lightsail domain %s to be deleted: %s", d.Id(), err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,96 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLightsailDomain_basic(t *testing.T) {
+	var domain lightsail.Domain
+	lightsailDomainName := fmt.Sprintf("tf-test-lightsail-%s.com", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailDomainDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSLightsailDomainConfig_basic(lightsailDomainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailDomainExists("aws_lightsail_domain.domain_test", &domain),
+					resource.TestCheckResourceAttrSet("aws_lightsail_domain.domain_test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLightsailDomainExists(n string, domain *lightsail.Domain) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lightsail domain ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+		resp, err := conn.GetDomain(&lightsail.GetDomainInput{
+			DomainName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if resp == nil || resp.Domain == nil {
+			return fmt.Errorf("Lightsail domain (%s) not found", rs.Primary.ID)
+		}
+		*domain = *resp.Domain
+		return nil
+	}
+}
+
+func testAccCheckAWSLightsailDomainDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_domain" {
+			continue
+		}
+
+		resp, err := conn.GetDomain(&lightsail.GetDomainInput{
+			DomainName: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			if resp.Domain != nil {
+				return fmt.Errorf("Lightsail domain %q still exists", rs.Primary.ID)
+			}
+		}
+
+		if !isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSLightsailDomainConfig_basic(lightsailDomainName string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_lightsail_domain" "domain_test" {
+  domain_name = "%s"
+}
+`, lightsailDomainName)
+}
@@ -0,0 +1,242 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsLightsailInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLightsailInstanceCreate,
+		Read:   resourceAwsLightsailInstanceRead,
+		Delete: resourceAwsLightsailInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"blueprint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bundle_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional attributes
+			"key_pair_name": {
+				// Lightsail uses a default key pair when none is given
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old == "LightsailDefaultKeyPair" && new == ""
+				},
+			},
+			"user_data": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			// Computed attributes
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cpu_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"ram_size": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"ipv6_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_static_ip": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"private_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLightsailInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	name := d.Get("name").(string)
+	input := &lightsail.CreateInstancesInput{
+		AvailabilityZone: aws.String(d.Get("availability_zone").(string)),
+		BlueprintId:      aws.String(d.Get("blueprint_id").(string)),
+		BundleId:         aws.String(d.Get("bundle_id").(string)),
+		InstanceNames:    []*string{aws.String(name)},
+	}
+
+	if v, ok := d.GetOk("key_pair_name"); ok {
+		input.KeyPairName = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("user_data"); ok {
+		input.UserData = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Lightsail instance: %s", input)
+	out, err := conn.CreateInstances(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Lightsail instance: %s", err)
+	}
+
+	if len(out.Operations) == 0 {
+		return fmt.Errorf("[ERR] No operations found for CreateInstance request")
+	}
+
+	d.SetId(name)
+
+	op := out.Operations[0]
+	if err := resourceAwsLightsailWaitForOperation(conn, aws.StringValue(op.Id)); err != nil {
+		return fmt.Errorf("Error waiting for Lightsail instance %s to be created: %s", name, err)
+	}
+
+	return resourceAwsLightsailInstanceRead(d, meta)
+}
+
+func resourceAwsLightsailInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	out, err := conn.GetInstance(&lightsail.GetInstanceInput{
+		InstanceName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Lightsail instance %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Lightsail instance %s: %s", d.Id(), err)
+	}
+
+	i := out.Instance
+
+	d.Set("name", i.Name)
+	d.Set("blueprint_id", i.BlueprintId)
+	d.Set("bundle_id", i.BundleId)
+	d.Set("key_pair_name", i.SshKeyName)
+	d.Set("arn", i.Arn)
+	d.Set("ipv6_address", i.Ipv6Address)
+	d.Set("is_static_ip", i.IsStaticIp)
+	d.Set("private_ip_address", i.PrivateIpAddress)
+	d.Set("public_ip_address", i.PublicIpAddress)
+	d.Set("username", i.Username)
+	if i.Location != nil {
+		d.Set("availability_zone", i.Location.AvailabilityZone)
+	}
+	if i.CreatedAt != nil {
+		d.Set("created_at", i.CreatedAt.Format(time.RFC3339))
+	}
+	if i.Hardware != nil {
+		d.Set("cpu_count", i.Hardware.CpuCount)
+		d.Set("ram_size", i.Hardware.RamSizeInGb)
+	}
+
+	return nil
+}
+
+func resourceAwsLightsailInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	log.Printf("[DEBUG] Deleting Lightsail instance %s", d.Id())
+	out, err := conn.DeleteInstance(&lightsail.DeleteInstanceInput{
+		InstanceName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error deleting Lightsail instance %s: %s", d.Id(), err)
+	}
+
+	for _, op := range out.Operations {
+		if err := resourceAwsLightsailWaitForOperation(conn, aws.StringValue(op.Id)); err != nil {
+			return fmt.Errorf("Error waiting for Lightsail instance %s to be deleted: %s", d.Id(), err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceAwsLightsailWaitForOperation blocks until the Lightsail operation
+// with the given id has finished. Most Lightsail API calls are asynchronous
+// and return one or more operations that track the actual work.
+func resourceAwsLightsailWaitForOperation(conn *lightsail.Lightsail, id string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{lightsail.OperationStatusNotStarted, lightsail.OperationStatusStarted},
+		Target:     []string{lightsail.OperationStatusCompleted, lightsail.OperationStatusSucceeded},
+		Refresh:    resourceAwsLightsailOperationRefreshFunc(conn, id),
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func resourceAwsLightsailOperationRefreshFunc(conn *lightsail.Lightsail, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		log.Printf("[DEBUG] Checking Lightsail operation %s", id)
+		out, err := conn.GetOperation(&lightsail.GetOperationInput{
+			OperationId: aws.String(id),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		op := out.Operation
+		if aws.StringValue(op.Status) == lightsail.OperationStatusFailed {
+			return op, lightsail.OperationStatusFailed, fmt.Errorf(
+				"Lightsail operation %s failed: %s", id, aws.StringValue(op.ErrorDetails))
+		}
+
+		return op, aws.StringValue(op.Status), nil
+	}
+}
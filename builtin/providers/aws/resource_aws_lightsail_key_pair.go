@@ -0,0 +1,158 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsLightsailKeyPair() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLightsailKeyPairCreate,
+		Read:   resourceAwsLightsailKeyPairRead,
+		Delete: resourceAwsLightsailKeyPairDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+			},
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			// optional fields for importing an existing public key
+			"public_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			// computed fields
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLightsailKeyPairCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	var name string
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	} else if v, ok := d.GetOk("name_prefix"); ok {
+		name = resource.PrefixedUniqueId(v.(string))
+	} else {
+		name = resource.UniqueId()
+	}
+
+	var op *lightsail.Operation
+	if v, ok := d.GetOk("public_key"); ok {
+		// Import the given public key
+		log.Printf("[DEBUG] Importing Lightsail key pair %s", name)
+		out, err := conn.ImportKeyPair(&lightsail.ImportKeyPairInput{
+			KeyPairName:     aws.String(name),
+			PublicKeyBase64: aws.String(v.(string)),
+		})
+		if err != nil {
+			return fmt.Errorf("Error importing Lightsail key pair: %s", err)
+		}
+		op = out.Operation
+	} else {
+		// Let Lightsail generate a key pair. The private key is only ever
+		// returned here, so it has to be stored in the state now.
+		log.Printf("[DEBUG] Creating Lightsail key pair %s", name)
+		out, err := conn.CreateKeyPair(&lightsail.CreateKeyPairInput{
+			KeyPairName: aws.String(name),
+		})
+		if err != nil {
+			return fmt.Errorf("Error creating Lightsail key pair: %s", err)
+		}
+		if out.Operation == nil {
+			return fmt.Errorf("[ERR] No operation found for CreateKeyPair request")
+		}
+		op = out.Operation
+
+		d.Set("public_key", out.PublicKeyBase64)
+		d.Set("private_key", out.PrivateKeyBase64)
+	}
+
+	d.SetId(name)
+
+	if op != nil {
+		if err := resourceAwsLightsailWaitForOperation(conn, aws.StringValue(op.Id)); err != nil {
+			return fmt.Errorf("Error waiting for Lightsail key pair %s to be created: %s", name, err)
+		}
+	}
+
+	return resourceAwsLightsailKeyPairRead(d, meta)
+}
+
+func resourceAwsLightsailKeyPairRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	out, err := conn.GetKeyPair(&lightsail.GetKeyPairInput{
+		KeyPairName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Lightsail key pair %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Lightsail key pair %s: %s", d.Id(), err)
+	}
+
+	d.Set("name", out.KeyPair.Name)
+	d.Set("arn", out.KeyPair.Arn)
+	d.Set("fingerprint", out.KeyPair.Fingerprint)
+
+	return nil
+}
+
+func resourceAwsLightsailKeyPairDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	log.Printf("[DEBUG] Deleting Lightsail key pair %s", d.Id())
+	out, err := conn.DeleteKeyPair(&lightsail.DeleteKeyPairInput{
+		KeyPairName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error deleting Lightsail key pair %s: %s", d.Id(), err)
+	}
+
+	if out.Operation != nil {
+		if err := resourceAwsLightsailWaitForOperation(conn, aws.StringValue(out.Operation.Id)); err != nil {
+			return fmt.Errorf("Error waiting for Lightsail key pair %s to be deleted: %s", d.Id(), err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,171 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLightsailKeyPair_basic(t *testing.T) {
+	var conf lightsail.KeyPair
+	lightsailName := fmt.Sprintf("tf-test-lightsail-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailKeyPairDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSLightsailKeyPairConfig_basic(lightsailName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailKeyPairExists("aws_lightsail_key_pair.lightsail_key_pair_test", &conf),
+					resource.TestCheckResourceAttrSet("aws_lightsail_key_pair.lightsail_key_pair_test", "arn"),
+					resource.TestCheckResourceAttrSet("aws_lightsail_key_pair.lightsail_key_pair_test", "fingerprint"),
+					resource.TestCheckResourceAttrSet("aws_lightsail_key_pair.lightsail_key_pair_test", "public_key"),
+					resource.TestCheckResourceAttrSet("aws_lightsail_key_pair.lightsail_key_pair_test", "private_key"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLightsailKeyPair_imported(t *testing.T) {
+	var conf lightsail.KeyPair
+	lightsailName := fmt.Sprintf("tf-test-lightsail-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailKeyPairDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSLightsailKeyPairConfig_imported(lightsailName, testLightsailKeyPairPubKey1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailKeyPairExists("aws_lightsail_key_pair.lightsail_key_pair_test", &conf),
+					resource.TestCheckResourceAttrSet("aws_lightsail_key_pair.lightsail_key_pair_test", "arn"),
+					resource.TestCheckResourceAttrSet("aws_lightsail_key_pair.lightsail_key_pair_test", "fingerprint"),
+					resource.TestCheckResourceAttr("aws_lightsail_key_pair.lightsail_key_pair_test", "private_key", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLightsailKeyPair_namePrefix(t *testing.T) {
+	var conf1, conf2 lightsail.KeyPair
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailKeyPairDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSLightsailKeyPairConfig_prefixed(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailKeyPairExists("aws_lightsail_key_pair.lightsail_key_pair_test_omit", &conf1),
+					testAccCheckAWSLightsailKeyPairExists("aws_lightsail_key_pair.lightsail_key_pair_test_prefixed", &conf2),
+					resource.TestCheckResourceAttrSet("aws_lightsail_key_pair.lightsail_key_pair_test_omit", "name"),
+					resource.TestCheckResourceAttrSet("aws_lightsail_key_pair.lightsail_key_pair_test_prefixed", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLightsailKeyPairExists(n string, res *lightsail.KeyPair) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lightsail key pair ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+		resp, err := conn.GetKeyPair(&lightsail.GetKeyPairInput{
+			KeyPairName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if resp == nil || resp.KeyPair == nil {
+			return fmt.Errorf("Lightsail key pair (%s) not found", rs.Primary.ID)
+		}
+		*res = *resp.KeyPair
+		return nil
+	}
+}
+
+func testAccCheckAWSLightsailKeyPairDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_key_pair" {
+			continue
+		}
+
+		resp, err := conn.GetKeyPair(&lightsail.GetKeyPairInput{
+			KeyPairName: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			if resp.KeyPair != nil {
+				return fmt.Errorf("Lightsail key pair %q still exists", rs.Primary.ID)
+			}
+		}
+
+		if !isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSLightsailKeyPairConfig_basic(lightsailName string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_lightsail_key_pair" "lightsail_key_pair_test" {
+  name = "%s"
+}
+`, lightsailName)
+}
+
+func testAccAWSLightsailKeyPairConfig_imported(lightsailName, key string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_lightsail_key_pair" "lightsail_key_pair_test" {
+  name       = "%s"
+  public_key = "%s"
+}
+`, lightsailName, key)
+}
+
+func testAccAWSLightsailKeyPairConfig_prefixed() string {
+	return `
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_lightsail_key_pair" "lightsail_key_pair_test_omit" {}
+
+resource "aws_lightsail_key_pair" "lightsail_key_pair_test_prefixed" {
+  name_prefix = "cts"
+}
+`
+}
+
+const testLightsailKeyPairPubKey1 = `ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCtOGBg9ZtwmUA8kzv0QvCLM1D2XjsIa3srHBvSgGZc4wPK8PrJZalvy7WuJxvTOR6cAHUQtM2vhLNdE7wvWUsYWLq6ew/2IyhFVUG55eKXPzzaAHsNCUaSTW2PGuR0T0k/HFcbkvI3cgr8uzhGW1JQRcVpaaDyyzRdDbKWEr7SjRGnsh+BbEEjBHXh6C7TIcaJXgfyK42MBp1bIi0crL1r6mZhZn3wDc0jBBDYFrWEShuT5EfSdCjM7cZ2EbUS6xAGuA4rIkuHsnzY8j40Qk5S+W46SbHCBLe7ixBcDy9Vq4w7r94iV4y4S1ExVg1QfN2fHagh2tTl/kz5/aUy3Kw7 phodgson@thoughtworks.com`
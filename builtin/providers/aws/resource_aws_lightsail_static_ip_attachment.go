@@ -0,0 +1,111 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsLightsailStaticIpAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLightsailStaticIpAttachmentCreate,
+		Read:   resourceAwsLightsailStaticIpAttachmentRead,
+		Delete: resourceAwsLightsailStaticIpAttachmentDelete,
+
+		Schema: map[string]*schema.Schema{
+			"static_ip_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLightsailStaticIpAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	ipName := d.Get("static_ip_name").(string)
+	instanceName := d.Get("instance_name").(string)
+
+	log.Printf("[DEBUG] Attaching Lightsail static IP %s to instance %s", ipName, instanceName)
+	out, err := conn.AttachStaticIp(&lightsail.AttachStaticIpInput{
+		StaticIpName: aws.String(ipName),
+		InstanceName: aws.String(instanceName),
+	})
+	if err != nil {
+		return fmt.Errorf("Error attaching Lightsail static IP %s to instance %s: %s", ipName, instanceName, err)
+	}
+
+	// A static IP can only be attached to a single instance, so its name
+	// identifies the attachment.
+	d.SetId(ipName)
+
+	for _, op := range out.Operations {
+		if err := resourceAwsLightsailWaitForOperation(conn, aws.StringValue(op.Id)); err != nil {
+			return fmt.Errorf("Error waiting for Lightsail static IP %s to be attached: %s", ipName, err)
+		}
+	}
+
+	return resourceAwsLightsailStaticIpAttachmentRead(d, meta)
+}
+
+func resourceAwsLightsailStaticIpAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	out, err := conn.GetStaticIp(&lightsail.GetStaticIpInput{
+		StaticIpName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Lightsail static IP %s not found, removing attachment from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Lightsail static IP %s: %s", d.Id(), err)
+	}
+
+	if !aws.BoolValue(out.StaticIp.IsAttached) {
+		log.Printf("[WARN] Lightsail static IP %s is not attached, removing attachment from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("static_ip_name", out.StaticIp.Name)
+	d.Set("instance_name", out.StaticIp.AttachedTo)
+
+	return nil
+}
+
+func resourceAwsLightsailStaticIpAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	log.Printf("[DEBUG] Detaching Lightsail static IP %s", d.Id())
+	out, err := conn.DetachStaticIp(&lightsail.DetachStaticIpInput{
+		StaticIpName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error detaching Lightsail static IP %s: %s", d.Id(), err)
+	}
+
+	for _, op := range out.Operations {
+		if err := resourceAwsLightsailWaitForOperation(conn, aws.StringValue(op.Id)); err != nil {
+			return fmt.Errorf("Error waiting for Lightsail static IP %s to be detached: %s", d.Id(), err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,247 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsOrganizationsAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsOrganizationsAccountCreate,
+		Read:   resourceAwsOrganizationsAccountRead,
+		Update: resourceAwsOrganizationsAccountUpdate,
+		Delete: resourceAwsOrganizationsAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"iam_user_access_to_billing": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOrganizationsIamUserAccessToBilling,
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"parent_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"joined_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"joined_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsOrganizationsAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	input := &organizations.CreateAccountInput{
+		AccountName: aws.String(d.Get("name").(string)),
+		Email:       aws.String(d.Get("email").(string)),
+	}
+	if v, ok := d.GetOk("iam_user_access_to_billing"); ok {
+		input.IamUserAccessToBilling = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("role_name"); ok {
+		input.RoleName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating AWS Organizations Account: %s", input)
+	resp, err := conn.CreateAccount(input)
+	if err != nil {
+		return fmt.Errorf("Error creating AWS Organizations Account: %s", err)
+	}
+
+	requestId := *resp.CreateAccountStatus.Id
+
+	// Account creation is asynchronous and usually takes a few minutes.
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{organizations.CreateAccountStateInProgress},
+		Target:     []string{organizations.CreateAccountStateSucceeded},
+		Refresh:    resourceAwsOrganizationsAccountStateRefreshFunc(conn, requestId),
+		Timeout:    10 * time.Minute,
+		MinTimeout: 10 * time.Second,
+		Delay:      5 * time.Second,
+	}
+
+	raw, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for AWS Organizations Account (%s) creation: %s", requestId, err)
+	}
+
+	d.SetId(*raw.(*organizations.CreateAccountStatus).AccountId)
+
+	if v, ok := d.GetOk("parent_id"); ok {
+		if err := resourceAwsOrganizationsAccountMove(conn, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsOrganizationsAccountRead(d, meta)
+}
+
+func resourceAwsOrganizationsAccountRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	resp, err := conn.DescribeAccount(&organizations.DescribeAccountInput{
+		AccountId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, organizations.ErrCodeAccountNotFoundException, "") {
+			log.Printf("[WARN] AWS Organizations Account (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	account := resp.Account
+	if account == nil {
+		log.Printf("[WARN] AWS Organizations Account (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	parentId, err := resourceAwsOrganizationsGetParentId(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading parent of AWS Organizations Account (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", account.Arn)
+	d.Set("email", account.Email)
+	d.Set("joined_method", account.JoinedMethod)
+	if account.JoinedTimestamp != nil {
+		d.Set("joined_timestamp", account.JoinedTimestamp.Format(time.RFC3339))
+	}
+	d.Set("name", account.Name)
+	d.Set("parent_id", parentId)
+	d.Set("status", account.Status)
+
+	return nil
+}
+
+func resourceAwsOrganizationsAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	if d.HasChange("parent_id") {
+		if err := resourceAwsOrganizationsAccountMove(conn, d.Id(), d.Get("parent_id").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsOrganizationsAccountRead(d, meta)
+}
+
+func resourceAwsOrganizationsAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	// Removing an account from the organization does not close it; the
+	// account must already have the information required to operate as a
+	// standalone account.
+	log.Printf("[DEBUG] Removing AWS Organizations Account from organization: %s", d.Id())
+	_, err := conn.RemoveAccountFromOrganization(&organizations.RemoveAccountFromOrganizationInput{
+		AccountId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, organizations.ErrCodeAccountNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error removing AWS Organizations Account (%s) from organization: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsOrganizationsAccountStateRefreshFunc(conn *organizations.Organizations, requestId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeCreateAccountStatus(&organizations.DescribeCreateAccountStatusInput{
+			CreateAccountRequestId: aws.String(requestId),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := resp.CreateAccountStatus
+		state := aws.StringValue(status.State)
+		if state == organizations.CreateAccountStateFailed {
+			return status, state, fmt.Errorf("Account creation failed: %s", aws.StringValue(status.FailureReason))
+		}
+
+		return status, state, nil
+	}
+}
+
+func resourceAwsOrganizationsAccountMove(conn *organizations.Organizations, accountId, destinationParentId string) error {
+	sourceParentId, err := resourceAwsOrganizationsGetParentId(conn, accountId)
+	if err != nil {
+		return fmt.Errorf("Error reading parent of AWS Organizations Account (%s): %s", accountId, err)
+	}
+	if sourceParentId == destinationParentId {
+		return nil
+	}
+
+	input := &organizations.MoveAccountInput{
+		AccountId:           aws.String(accountId),
+		SourceParentId:      aws.String(sourceParentId),
+		DestinationParentId: aws.String(destinationParentId),
+	}
+
+	log.Printf("[DEBUG] Moving AWS Organizations Account: %s", input)
+	if _, err := conn.MoveAccount(input); err != nil {
+		return fmt.Errorf("Error moving AWS Organizations Account (%s): %s", accountId, err)
+	}
+
+	return nil
+}
+
+// resourceAwsOrganizationsGetParentId returns the ID of the root or
+// organizational unit that directly contains the given account or OU.
+func resourceAwsOrganizationsGetParentId(conn *organizations.Organizations, childId string) (string, error) {
+	var parentId string
+	err := conn.ListParentsPages(&organizations.ListParentsInput{
+		ChildId: aws.String(childId),
+	}, func(page *organizations.ListParentsOutput, lastPage bool) bool {
+		if len(page.Parents) > 0 {
+			parentId = aws.StringValue(page.Parents[0].Id)
+			return false
+		}
+		return !lastPage
+	})
+	return parentId, err
+}
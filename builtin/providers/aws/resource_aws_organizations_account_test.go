@@ -0,0 +1,109 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// testAccOrganizationsPreCheck skips the test unless it is being run from the
+// master account of an organization, identified by the ID of its root.
+func testAccOrganizationsPreCheck(t *testing.T) string {
+	rootId := os.Getenv("AWS_ORGANIZATIONS_ROOT_ID")
+	if rootId == "" {
+		t.Skip("Environment variable AWS_ORGANIZATIONS_ROOT_ID must be set to run AWS Organizations tests from the master account")
+	}
+	return rootId
+}
+
+// Accounts created by this test cannot be deleted through the API, only
+// removed from the organization, so it additionally requires an explicit
+// opt-in through the email address of the new account.
+func TestAccAWSOrganizationsAccount_basic(t *testing.T) {
+	rootId := testAccOrganizationsPreCheck(t)
+	email := os.Getenv("AWS_ORGANIZATIONS_ACCOUNT_EMAIL")
+	if email == "" {
+		t.Skip("Environment variable AWS_ORGANIZATIONS_ACCOUNT_EMAIL must be set")
+	}
+
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandString(5))
+	resourceName := "aws_organizations_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsOrganizationsAccountDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOrganizationsAccountConfig(rName, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsAccountExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile("^arn:aws:organizations::")),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "email", email),
+					resource.TestCheckResourceAttr(resourceName, "parent_id", rootId),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"iam_user_access_to_billing", "role_name"},
+			},
+		},
+	})
+}
+
+func testAccCheckAwsOrganizationsAccountDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_organizations_account" {
+			continue
+		}
+
+		_, err := conn.DescribeAccount(&organizations.DescribeAccountInput{
+			AccountId: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("AWS Organizations Account %s is still a member of the organization", rs.Primary.ID)
+		}
+		if !isAWSErr(err, organizations.ErrCodeAccountNotFoundException, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsOrganizationsAccountExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+		_, err := conn.DescribeAccount(&organizations.DescribeAccountInput{
+			AccountId: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
+func testAccOrganizationsAccountConfig(name, email string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_account" "test" {
+  name  = "%s"
+  email = "%s"
+}
+`, name, email)
+}
@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsOrganizationsOrganizationalUnit() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsOrganizationsOrganizationalUnitCreate,
+		Read:   resourceAwsOrganizationsOrganizationalUnitRead,
+		Update: resourceAwsOrganizationsOrganizationalUnitUpdate,
+		Delete: resourceAwsOrganizationsOrganizationalUnitDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"parent_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsOrganizationsOrganizationalUnitCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	input := &organizations.CreateOrganizationalUnitInput{
+		Name:     aws.String(d.Get("name").(string)),
+		ParentId: aws.String(d.Get("parent_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating AWS Organizations Organizational Unit: %s", input)
+	resp, err := conn.CreateOrganizationalUnit(input)
+	if err != nil {
+		return fmt.Errorf("Error creating AWS Organizations Organizational Unit: %s", err)
+	}
+
+	d.SetId(*resp.OrganizationalUnit.Id)
+
+	return resourceAwsOrganizationsOrganizationalUnitRead(d, meta)
+}
+
+func resourceAwsOrganizationsOrganizationalUnitRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	resp, err := conn.DescribeOrganizationalUnit(&organizations.DescribeOrganizationalUnitInput{
+		OrganizationalUnitId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, organizations.ErrCodeOrganizationalUnitNotFoundException, "") {
+			log.Printf("[WARN] AWS Organizations Organizational Unit (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	parentId, err := resourceAwsOrganizationsGetParentId(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading parent of AWS Organizations Organizational Unit (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", resp.OrganizationalUnit.Arn)
+	d.Set("name", resp.OrganizationalUnit.Name)
+	d.Set("parent_id", parentId)
+
+	return nil
+}
+
+func resourceAwsOrganizationsOrganizationalUnitUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	if d.HasChange("name") {
+		input := &organizations.UpdateOrganizationalUnitInput{
+			OrganizationalUnitId: aws.String(d.Id()),
+			Name:                 aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating AWS Organizations Organizational Unit: %s", input)
+		if _, err := conn.UpdateOrganizationalUnit(input); err != nil {
+			return fmt.Errorf("Error updating AWS Organizations Organizational Unit (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsOrganizationsOrganizationalUnitRead(d, meta)
+}
+
+func resourceAwsOrganizationsOrganizationalUnitDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	log.Printf("[DEBUG] Deleting AWS Organizations Organizational Unit: %s", d.Id())
+	_, err := conn.DeleteOrganizationalUnit(&organizations.DeleteOrganizationalUnitInput{
+		OrganizationalUnitId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, organizations.ErrCodeOrganizationalUnitNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting AWS Organizations Organizational Unit (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSOrganizationsOrganizationalUnit_basic(t *testing.T) {
+	rootId := testAccOrganizationsPreCheck(t)
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandString(5))
+	resourceName := "aws_organizations_organizational_unit.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsOrganizationsOrganizationalUnitDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOrganizationsOrganizationalUnitConfig(rootId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsOrganizationalUnitExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "parent_id", rootId),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccOrganizationsOrganizationalUnitConfig(rootId, rName+"-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsOrganizationalUnitExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-updated"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsOrganizationsOrganizationalUnitDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_organizations_organizational_unit" {
+			continue
+		}
+
+		_, err := conn.DescribeOrganizationalUnit(&organizations.DescribeOrganizationalUnitInput{
+			OrganizationalUnitId: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("AWS Organizations Organizational Unit %s still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, organizations.ErrCodeOrganizationalUnitNotFoundException, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsOrganizationsOrganizationalUnitExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+		_, err := conn.DescribeOrganizationalUnit(&organizations.DescribeOrganizationalUnitInput{
+			OrganizationalUnitId: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
+func testAccOrganizationsOrganizationalUnitConfig(rootId, name string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organizational_unit" "test" {
+  name      = "%s"
+  parent_id = "%s"
+}
+`, name, rootId)
+}
@@ -0,0 +1,136 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsOrganizationsPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsOrganizationsPolicyCreate,
+		Read:   resourceAwsOrganizationsPolicyRead,
+		Update: resourceAwsOrganizationsPolicyUpdate,
+		Delete: resourceAwsOrganizationsPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"content": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  organizations.PolicyTypeServiceControlPolicy,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsOrganizationsPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	input := &organizations.CreatePolicyInput{
+		Content:     aws.String(d.Get("content").(string)),
+		Description: aws.String(d.Get("description").(string)),
+		Name:        aws.String(d.Get("name").(string)),
+		Type:        aws.String(d.Get("type").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating AWS Organizations Policy: %s", input)
+	resp, err := conn.CreatePolicy(input)
+	if err != nil {
+		return fmt.Errorf("Error creating AWS Organizations Policy: %s", err)
+	}
+
+	d.SetId(*resp.Policy.PolicySummary.Id)
+
+	return resourceAwsOrganizationsPolicyRead(d, meta)
+}
+
+func resourceAwsOrganizationsPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	resp, err := conn.DescribePolicy(&organizations.DescribePolicyInput{
+		PolicyId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, organizations.ErrCodePolicyNotFoundException, "") {
+			log.Printf("[WARN] AWS Organizations Policy (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	summary := resp.Policy.PolicySummary
+	d.Set("arn", summary.Arn)
+	d.Set("content", resp.Policy.Content)
+	d.Set("description", summary.Description)
+	d.Set("name", summary.Name)
+	d.Set("type", summary.Type)
+
+	return nil
+}
+
+func resourceAwsOrganizationsPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	input := &organizations.UpdatePolicyInput{
+		PolicyId: aws.String(d.Id()),
+	}
+	if d.HasChange("content") {
+		input.Content = aws.String(d.Get("content").(string))
+	}
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+	if d.HasChange("name") {
+		input.Name = aws.String(d.Get("name").(string))
+	}
+
+	log.Printf("[DEBUG] Updating AWS Organizations Policy: %s", input)
+	if _, err := conn.UpdatePolicy(input); err != nil {
+		return fmt.Errorf("Error updating AWS Organizations Policy (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsOrganizationsPolicyRead(d, meta)
+}
+
+func resourceAwsOrganizationsPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	log.Printf("[DEBUG] Deleting AWS Organizations Policy: %s", d.Id())
+	_, err := conn.DeletePolicy(&organizations.DeletePolicyInput{
+		PolicyId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, organizations.ErrCodePolicyNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting AWS Organizations Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsOrganizationsPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsOrganizationsPolicyAttachmentCreate,
+		Read:   resourceAwsOrganizationsPolicyAttachmentRead,
+		Delete: resourceAwsOrganizationsPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsOrganizationsPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	policyId := d.Get("policy_id").(string)
+	targetId := d.Get("target_id").(string)
+
+	input := &organizations.AttachPolicyInput{
+		PolicyId: aws.String(policyId),
+		TargetId: aws.String(targetId),
+	}
+
+	log.Printf("[DEBUG] Creating AWS Organizations Policy Attachment: %s", input)
+	if _, err := conn.AttachPolicy(input); err != nil {
+		return fmt.Errorf("Error creating AWS Organizations Policy Attachment: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", targetId, policyId))
+
+	return resourceAwsOrganizationsPolicyAttachmentRead(d, meta)
+}
+
+func resourceAwsOrganizationsPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	targetId, policyId, err := parseOrganizationsPolicyAttachmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var found bool
+	err = conn.ListTargetsForPolicyPages(&organizations.ListTargetsForPolicyInput{
+		PolicyId: aws.String(policyId),
+	}, func(page *organizations.ListTargetsForPolicyOutput, lastPage bool) bool {
+		for _, target := range page.Targets {
+			if aws.StringValue(target.TargetId) == targetId {
+				found = true
+				return false
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		if isAWSErr(err, organizations.ErrCodePolicyNotFoundException, "") {
+			log.Printf("[WARN] AWS Organizations Policy (%s) not found, removing attachment from state", policyId)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if !found {
+		log.Printf("[WARN] AWS Organizations Policy Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("policy_id", policyId)
+	d.Set("target_id", targetId)
+
+	return nil
+}
+
+func resourceAwsOrganizationsPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	targetId, policyId, err := parseOrganizationsPolicyAttachmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting AWS Organizations Policy Attachment: %s", d.Id())
+	_, err = conn.DetachPolicy(&organizations.DetachPolicyInput{
+		PolicyId: aws.String(policyId),
+		TargetId: aws.String(targetId),
+	})
+	if err != nil {
+		if isAWSErr(err, organizations.ErrCodePolicyNotFoundException, "") ||
+			isAWSErr(err, organizations.ErrCodePolicyNotAttachedException, "") ||
+			isAWSErr(err, organizations.ErrCodeTargetNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting AWS Organizations Policy Attachment (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func parseOrganizationsPolicyAttachmentId(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("AWS Organizations Policy Attachment ID must be of the form <target_id>:<policy_id>, got: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
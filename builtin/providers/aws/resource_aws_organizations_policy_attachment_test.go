@@ -0,0 +1,149 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestParseOrganizationsPolicyAttachmentId(t *testing.T) {
+	targetId, policyId, err := parseOrganizationsPolicyAttachmentId("ou-abcd-12345678:p-12345678")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if targetId != "ou-abcd-12345678" || policyId != "p-12345678" {
+		t.Fatalf("bad: %s, %s", targetId, policyId)
+	}
+
+	for _, id := range []string{"", "p-12345678", "ou-abcd-12345678:", ":p-12345678", "a:b:c"} {
+		if _, _, err := parseOrganizationsPolicyAttachmentId(id); err == nil {
+			t.Fatalf("expected error for %q", id)
+		}
+	}
+}
+
+func TestAccAWSOrganizationsPolicyAttachment_organizationalUnit(t *testing.T) {
+	rootId := testAccOrganizationsPreCheck(t)
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandString(5))
+	resourceName := "aws_organizations_policy_attachment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsOrganizationsPolicyAttachmentDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOrganizationsPolicyAttachmentConfig(rootId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsPolicyAttachmentExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "target_id"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsOrganizationsPolicyAttachmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_organizations_policy_attachment" {
+			continue
+		}
+
+		attached, err := testAccAwsOrganizationsPolicyAttached(conn, rs.Primary.ID)
+		if err != nil {
+			if isAWSErr(err, organizations.ErrCodePolicyNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+		if attached {
+			return fmt.Errorf("AWS Organizations Policy Attachment %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsOrganizationsPolicyAttachmentExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+		attached, err := testAccAwsOrganizationsPolicyAttached(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if !attached {
+			return fmt.Errorf("AWS Organizations Policy Attachment %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsOrganizationsPolicyAttached(conn *organizations.Organizations, id string) (bool, error) {
+	targetId, policyId, err := parseOrganizationsPolicyAttachmentId(id)
+	if err != nil {
+		return false, err
+	}
+
+	var found bool
+	err = conn.ListTargetsForPolicyPages(&organizations.ListTargetsForPolicyInput{
+		PolicyId: aws.String(policyId),
+	}, func(page *organizations.ListTargetsForPolicyOutput, lastPage bool) bool {
+		for _, target := range page.Targets {
+			if aws.StringValue(target.TargetId) == targetId {
+				found = true
+				return false
+			}
+		}
+		return !lastPage
+	})
+
+	return found, err
+}
+
+func testAccOrganizationsPolicyAttachmentConfig(rootId, name string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organizational_unit" "test" {
+  name      = "%s"
+  parent_id = "%s"
+}
+
+resource "aws_organizations_policy" "test" {
+  name = "%s"
+
+  content = <<CONTENT
+{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Effect": "Allow",
+    "Action": "*",
+    "Resource": "*"
+  }
+}
+CONTENT
+}
+
+resource "aws_organizations_policy_attachment" "test" {
+  policy_id = "${aws_organizations_policy.test.id}"
+  target_id = "${aws_organizations_organizational_unit.test.id}"
+}
+`, name, rootId, name)
+}
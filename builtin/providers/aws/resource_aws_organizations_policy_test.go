@@ -0,0 +1,105 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSOrganizationsPolicy_basic(t *testing.T) {
+	testAccOrganizationsPreCheck(t)
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandString(5))
+	resourceName := "aws_organizations_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsOrganizationsPolicyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOrganizationsPolicyConfig(rName, "Deny", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "first"),
+					resource.TestCheckResourceAttr(resourceName, "type", "SERVICE_CONTROL_POLICY"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccOrganizationsPolicyConfig(rName, "Allow", "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsOrganizationsPolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_organizations_policy" {
+			continue
+		}
+
+		_, err := conn.DescribePolicy(&organizations.DescribePolicyInput{
+			PolicyId: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("AWS Organizations Policy %s still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, organizations.ErrCodePolicyNotFoundException, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsOrganizationsPolicyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+		_, err := conn.DescribePolicy(&organizations.DescribePolicyInput{
+			PolicyId: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
+func testAccOrganizationsPolicyConfig(name, effect, description string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_policy" "test" {
+  name        = "%s"
+  description = "%s"
+
+  content = <<CONTENT
+{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Effect": "%s",
+    "Action": "ec2:*",
+    "Resource": "*"
+  }
+}
+CONTENT
+}
+`, name, description, effect)
+}
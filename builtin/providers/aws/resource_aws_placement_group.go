@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsPlacementGroup() *schema.Resource {
@@ -31,6 +32,10 @@ func resourceAwsPlacementGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.PlacementStrategyCluster,
+					ec2.PlacementStrategySpread,
+				}, false),
 			},
 		},
 	}
@@ -92,8 +97,18 @@ func resourceAwsPlacementGroupRead(d *schema.ResourceData, meta interface{}) err
 	}
 	out, err := conn.DescribePlacementGroups(&input)
 	if err != nil {
+		if isAWSErr(err, "InvalidPlacementGroup.Unknown", "") {
+			log.Printf("[WARN] EC2 Placement Group %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return err
 	}
+	if len(out.PlacementGroups) == 0 {
+		log.Printf("[WARN] EC2 Placement Group %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 	pg := out.PlacementGroups[0]
 
 	log.Printf("[DEBUG] Received EC2 Placement Group: %s", pg)
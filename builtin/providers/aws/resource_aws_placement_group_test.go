@@ -28,6 +28,24 @@ func TestAccAWSPlacementGroup_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSPlacementGroup_spread(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPlacementGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSPlacementGroupConfig_spread,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPlacementGroupExists("aws_placement_group.pg"),
+					resource.TestCheckResourceAttr(
+						"aws_placement_group.pg", "strategy", "spread"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSPlacementGroupDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ec2conn
 
@@ -104,3 +122,10 @@ resource "aws_placement_group" "pg" {
 	strategy = "cluster"
 }
 `
+
+var testAccAWSPlacementGroupConfig_spread = `
+resource "aws_placement_group" "pg" {
+	name = "tf-test-pg-spread"
+	strategy = "spread"
+}
+`
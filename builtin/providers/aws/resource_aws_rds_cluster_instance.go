@@ -47,6 +47,21 @@ func resourceAwsRDSClusterInstance() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"engine": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "aurora",
+				ValidateFunc: validateRdsClusterEngine,
+			},
+
+			"engine_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
 			"endpoint": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -100,9 +115,10 @@ func resourceAwsRDSClusterInstance() *schema.Resource {
 			},
 
 			"monitoring_interval": &schema.Schema{
-				Type:     schema.TypeInt,
-				Optional: true,
-				Default:  0,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validateDbInstanceMonitoringInterval,
 			},
 
 			"promotion_tier": &schema.Schema{
@@ -123,12 +139,16 @@ func resourceAwsRDSClusterInstanceCreate(d *schema.ResourceData, meta interface{
 	createOpts := &rds.CreateDBInstanceInput{
 		DBInstanceClass:     aws.String(d.Get("instance_class").(string)),
 		DBClusterIdentifier: aws.String(d.Get("cluster_identifier").(string)),
-		Engine:              aws.String("aurora"),
+		Engine:              aws.String(d.Get("engine").(string)),
 		PubliclyAccessible:  aws.Bool(d.Get("publicly_accessible").(bool)),
 		PromotionTier:       aws.Int64(int64(d.Get("promotion_tier").(int))),
 		Tags:                tags,
 	}
 
+	if attr, ok := d.GetOk("engine_version"); ok {
+		createOpts.EngineVersion = aws.String(attr.(string))
+	}
+
 	if attr, ok := d.GetOk("db_parameter_group_name"); ok {
 		createOpts.DBParameterGroupName = aws.String(attr.(string))
 	}
@@ -227,6 +247,8 @@ func resourceAwsRDSClusterInstanceRead(d *schema.ResourceData, meta interface{})
 	d.Set("publicly_accessible", db.PubliclyAccessible)
 	d.Set("cluster_identifier", db.DBClusterIdentifier)
 	d.Set("instance_class", db.DBInstanceClass)
+	d.Set("engine", db.Engine)
+	d.Set("engine_version", db.EngineVersion)
 	d.Set("identifier", db.DBInstanceIdentifier)
 	d.Set("storage_encrypted", db.StorageEncrypted)
 	d.Set("kms_key_id", db.KmsKeyId)
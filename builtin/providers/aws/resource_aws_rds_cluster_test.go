@@ -110,6 +110,30 @@ func TestAccAWSRDSCluster_encrypted(t *testing.T) {
 	})
 }
 
+func TestAccAWSRDSCluster_engineAuroraPostgresql(t *testing.T) {
+	var v rds.DBCluster
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSClusterDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSClusterConfig_engineAuroraPostgresql(acctest.RandInt()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSClusterExists("aws_rds_cluster.default", &v),
+					resource.TestCheckResourceAttr(
+						"aws_rds_cluster.default", "engine", "aurora-postgresql"),
+					resource.TestCheckResourceAttrSet(
+						"aws_rds_cluster.default", "engine_version"),
+					resource.TestCheckResourceAttr(
+						"aws_rds_cluster_instance.default", "engine", "aurora-postgresql"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSRDSCluster_backupsUpdate(t *testing.T) {
 	var v rds.DBCluster
 
@@ -292,6 +316,26 @@ resource "aws_rds_cluster" "default" {
 }`, n)
 }
 
+func testAccAWSClusterConfig_engineAuroraPostgresql(n int) string {
+	return fmt.Sprintf(`
+resource "aws_rds_cluster" "default" {
+  cluster_identifier = "tf-aurora-cluster-%d"
+  engine = "aurora-postgresql"
+  availability_zones = ["us-west-2a","us-west-2b","us-west-2c"]
+  database_name = "mydb"
+  master_username = "foo"
+  master_password = "mustbeeightcharaters"
+}
+
+resource "aws_rds_cluster_instance" "default" {
+  identifier = "tf-aurora-instance-%d"
+  cluster_identifier = "${aws_rds_cluster.default.id}"
+  engine = "${aws_rds_cluster.default.engine}"
+  engine_version = "${aws_rds_cluster.default.engine_version}"
+  instance_class = "db.r4.large"
+}`, n, n)
+}
+
 func testAccAWSClusterConfig_backups(n int) string {
 	return fmt.Sprintf(`
 resource "aws_rds_cluster" "default" {
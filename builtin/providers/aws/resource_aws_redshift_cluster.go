@@ -227,6 +227,29 @@ func resourceAwsRedshiftCluster() *schema.Resource {
 				Computed: true,
 			},
 
+			"snapshot_copy": &schema.Schema{
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_region": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"retention_period": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  7,
+						},
+						"grant_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
 			"snapshot_identifier": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -412,6 +435,12 @@ func resourceAwsRedshiftClusterCreate(d *schema.ResourceData, meta interface{})
 
 	}
 
+	if v, ok := d.GetOk("snapshot_copy"); ok {
+		if err := enableRedshiftSnapshotCopy(d.Id(), v.([]interface{}), conn); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsRedshiftClusterRead(d, meta)
 }
 
@@ -519,6 +548,10 @@ func resourceAwsRedshiftClusterRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("enable_logging", loggingStatus.LoggingEnabled)
 	d.Set("s3_key_prefix", loggingStatus.S3KeyPrefix)
 
+	if err := d.Set("snapshot_copy", flattenRedshiftSnapshotCopy(rsc.ClusterSnapshotCopyStatus)); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Snapshot Copy to state for Redshift Cluster (%s): %s", d.Id(), err)
+	}
+
 	return nil
 }
 
@@ -570,8 +603,8 @@ func resourceAwsRedshiftClusterUpdate(d *schema.ResourceData, meta interface{})
 		requestUpdate = true
 	}
 
-	if d.HasChange("vpc_security_group_ips") {
-		req.VpcSecurityGroupIds = expandStringList(d.Get("vpc_security_group_ips").(*schema.Set).List())
+	if d.HasChange("vpc_security_group_ids") {
+		req.VpcSecurityGroupIds = expandStringList(d.Get("vpc_security_group_ids").(*schema.Set).List())
 		requestUpdate = true
 	}
 
@@ -691,6 +724,13 @@ func resourceAwsRedshiftClusterUpdate(d *schema.ResourceData, meta interface{})
 		d.SetPartial("enable_logging")
 	}
 
+	if d.HasChange("snapshot_copy") {
+		if err := updateRedshiftSnapshotCopy(d, conn); err != nil {
+			return err
+		}
+		d.SetPartial("snapshot_copy")
+	}
+
 	d.Partial(false)
 
 	return resourceAwsRedshiftClusterRead(d, meta)
@@ -718,6 +758,89 @@ func enableRedshiftClusterLogging(d *schema.ResourceData, conn *redshift.Redshif
 	return nil
 }
 
+func enableRedshiftSnapshotCopy(id string, scList []interface{}, conn *redshift.Redshift) error {
+	sc := scList[0].(map[string]interface{})
+
+	input := redshift.EnableSnapshotCopyInput{
+		ClusterIdentifier: aws.String(id),
+		DestinationRegion: aws.String(sc["destination_region"].(string)),
+	}
+	if rp, ok := sc["retention_period"]; ok {
+		input.RetentionPeriod = aws.Int64(int64(rp.(int)))
+	}
+	if gn, ok := sc["grant_name"]; ok && gn.(string) != "" {
+		input.SnapshotCopyGrantName = aws.String(gn.(string))
+	}
+
+	log.Printf("[INFO] Enabling Snapshot Copy for Redshift Cluster %q", id)
+	_, err := conn.EnableSnapshotCopy(&input)
+	if err != nil {
+		return fmt.Errorf("Failed to enable snapshot copy for Redshift Cluster (%s): %s", id, err)
+	}
+	return nil
+}
+
+func updateRedshiftSnapshotCopy(d *schema.ResourceData, conn *redshift.Redshift) error {
+	o, n := d.GetChange("snapshot_copy")
+	os := o.([]interface{})
+	ns := n.([]interface{})
+
+	// Only the retention period can be changed in place; a new destination
+	// region or grant requires turning snapshot copy off and on again.
+	recreate := len(os) == 0 || len(ns) == 0
+	if !recreate {
+		om := os[0].(map[string]interface{})
+		nm := ns[0].(map[string]interface{})
+		recreate = om["destination_region"] != nm["destination_region"] ||
+			om["grant_name"] != nm["grant_name"]
+	}
+
+	if !recreate {
+		log.Printf("[INFO] Modifying Snapshot Copy retention period for Redshift Cluster %q", d.Id())
+		_, err := conn.ModifySnapshotCopyRetentionPeriod(&redshift.ModifySnapshotCopyRetentionPeriodInput{
+			ClusterIdentifier: aws.String(d.Id()),
+			RetentionPeriod:   aws.Int64(int64(ns[0].(map[string]interface{})["retention_period"].(int))),
+		})
+		if err != nil {
+			return fmt.Errorf("Failed to modify snapshot copy retention period for Redshift Cluster (%s): %s", d.Id(), err)
+		}
+		return nil
+	}
+
+	if len(os) > 0 {
+		log.Printf("[INFO] Disabling Snapshot Copy for Redshift Cluster %q", d.Id())
+		_, err := conn.DisableSnapshotCopy(&redshift.DisableSnapshotCopyInput{
+			ClusterIdentifier: aws.String(d.Id()),
+		})
+		if err != nil {
+			return fmt.Errorf("Failed to disable snapshot copy for Redshift Cluster (%s): %s", d.Id(), err)
+		}
+	}
+
+	if len(ns) > 0 {
+		return enableRedshiftSnapshotCopy(d.Id(), ns, conn)
+	}
+	return nil
+}
+
+func flattenRedshiftSnapshotCopy(scs *redshift.ClusterSnapshotCopyStatus) []interface{} {
+	if scs == nil || scs.DestinationRegion == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"destination_region": *scs.DestinationRegion,
+	}
+	if scs.RetentionPeriod != nil {
+		m["retention_period"] = int(*scs.RetentionPeriod)
+	}
+	if scs.SnapshotCopyGrantName != nil {
+		m["grant_name"] = *scs.SnapshotCopyGrantName
+	}
+
+	return []interface{}{m}
+}
+
 func resourceAwsRedshiftClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).redshiftconn
 	log.Printf("[DEBUG] Destroying Redshift Cluster (%s)", d.Id())
@@ -28,6 +28,8 @@ func resourceAwsRoute() *schema.Resource {
 		Delete: resourceAwsRouteDelete,
 		Exists: resourceAwsRouteExists,
 
+		PlanCheck: resourceAwsRoutePlanCheck,
+
 		Schema: map[string]*schema.Schema{
 			"destination_cidr_block": &schema.Schema{
 				Type:     schema.TypeString,
@@ -92,6 +94,18 @@ func resourceAwsRoute() *schema.Resource {
 	}
 }
 
+// resourceAwsRoutePlanCheck detects routes of tables that also declare
+// their routes inline. See resourceAwsRouteTablePlanCheck.
+func resourceAwsRoutePlanCheck(d *schema.ResourceData, meta interface{}) ([]string, error) {
+	m := inlineManagementFromMeta(meta)
+	tableId := d.Get("route_table_id").(string)
+	if m == nil || tableId == "" {
+		return nil, nil
+	}
+
+	return m.Standalone(inlineKindRouteTable, tableId, d.Get("destination_cidr_block").(string))
+}
+
 func resourceAwsRouteCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	var numTargets int
@@ -181,7 +195,7 @@ func resourceAwsRouteCreate(d *schema.ResourceData, meta interface{}) error {
 
 	var route *ec2.Route
 	err = resource.Retry(15*time.Second, func() *resource.RetryError {
-		route, err = findResourceRoute(conn, d.Get("route_table_id").(string), d.Get("destination_cidr_block").(string))
+		route, err = findResourceRoute(conn, nil, d.Get("route_table_id").(string), d.Get("destination_cidr_block").(string))
 		return resource.RetryableError(err)
 	})
 	if err != nil {
@@ -195,7 +209,7 @@ func resourceAwsRouteCreate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceAwsRouteRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
-	route, err := findResourceRoute(conn, d.Get("route_table_id").(string), d.Get("destination_cidr_block").(string))
+	route, err := findResourceRoute(conn, describeCacheFromMeta(meta), d.Get("route_table_id").(string), d.Get("destination_cidr_block").(string))
 	if err != nil {
 		if ec2err, ok := err.(awserr.Error); ok && ec2err.Code() == "InvalidRouteTableID.NotFound" {
 			log.Printf("[WARN] AWS RouteTable not found. Removing Route from state")
@@ -350,10 +364,13 @@ func resourceAwsRouteExists(d *schema.ResourceData, meta interface{}) (bool, err
 		RouteTableIds: []*string{&routeTableId},
 	}
 
-	res, err := conn.DescribeRouteTables(findOpts)
+	out, err := describeCacheFromMeta(meta).Read("ec2", "DescribeRouteTables", findOpts, func() (interface{}, error) {
+		return conn.DescribeRouteTables(findOpts)
+	})
 	if err != nil {
 		return false, fmt.Errorf("Error while checking if route exists: %s", err)
 	}
+	res := out.(*ec2.DescribeRouteTablesOutput)
 
 	if len(res.RouteTables) < 1 || res.RouteTables[0] == nil {
 		log.Printf("[WARN] Route table %s is gone, so route does not exist.",
@@ -376,18 +393,22 @@ func routeIDHash(d *schema.ResourceData, r *ec2.Route) string {
 	return fmt.Sprintf("r-%s%d", d.Get("route_table_id").(string), hashcode.String(*r.DestinationCidrBlock))
 }
 
-// Helper: retrieve a route
-func findResourceRoute(conn *ec2.EC2, rtbid string, cidr string) (*ec2.Route, error) {
+// Helper: retrieve a route. The route table is read through cache, which
+// may be nil to always read it from the API.
+func findResourceRoute(conn *ec2.EC2, cache *describeCache, rtbid string, cidr string) (*ec2.Route, error) {
 	routeTableID := rtbid
 
 	findOpts := &ec2.DescribeRouteTablesInput{
 		RouteTableIds: []*string{&routeTableID},
 	}
 
-	resp, err := conn.DescribeRouteTables(findOpts)
+	out, err := cache.Read("ec2", "DescribeRouteTables", findOpts, func() (interface{}, error) {
+		return conn.DescribeRouteTables(findOpts)
+	})
 	if err != nil {
 		return nil, err
 	}
+	resp := out.(*ec2.DescribeRouteTablesOutput)
 
 	if len(resp.RouteTables) < 1 || resp.RouteTables[0] == nil {
 		return nil, fmt.Errorf("Route table %s is gone, so route does not exist.",
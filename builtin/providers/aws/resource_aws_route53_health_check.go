@@ -1,12 +1,12 @@
 package aws
 
 import (
-	"fmt"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -31,15 +31,28 @@ func resourceAwsRoute53HealthCheck() *schema.Resource {
 				StateFunc: func(val interface{}) string {
 					return strings.ToUpper(val.(string))
 				},
+				ValidateFunc: validation.StringInSlice([]string{
+					route53.HealthCheckTypeHttp,
+					route53.HealthCheckTypeHttps,
+					route53.HealthCheckTypeHttpStrMatch,
+					route53.HealthCheckTypeHttpsStrMatch,
+					route53.HealthCheckTypeTcp,
+					route53.HealthCheckTypeCalculated,
+					route53.HealthCheckTypeCloudwatchMetric,
+				}, true),
 			},
 			"failure_threshold": &schema.Schema{
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 10),
 			},
 			"request_interval": &schema.Schema{
-				Type:     schema.TypeInt,
-				Optional: true,
-				ForceNew: true, // todo this should be updateable but the awslabs route53 service doesnt have the ability
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true, // todo this should be updateable but the awslabs route53 service doesnt have the ability
+				ValidateFunc: validation.IntInSlice([]int{10, 30}),
 			},
 			"ip_address": &schema.Schema{
 				Type:     schema.TypeString,
@@ -77,6 +90,27 @@ func resourceAwsRoute53HealthCheck() *schema.Resource {
 				ForceNew: true,
 			},
 
+			// Only HTTPS checks use SNI, so differences are ignored for
+			// other types.
+			"enable_sni": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return !route53HealthCheckIsHTTPS(d.Get("type").(string))
+				},
+			},
+
+			// The regions health checkers run in. Route 53 uses all of
+			// them when none are given.
+			"regions": &schema.Schema{
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Computed: true,
+				Set:      schema.HashString,
+			},
+
 			"child_healthchecks": &schema.Schema{
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -84,16 +118,9 @@ func resourceAwsRoute53HealthCheck() *schema.Resource {
 				Set:      schema.HashString,
 			},
 			"child_health_threshold": &schema.Schema{
-				Type:     schema.TypeInt,
-				Optional: true,
-				ValidateFunc: func(v interface{}, k string) (ws []string, es []error) {
-					value := v.(int)
-					if value > 256 {
-						es = append(es, fmt.Errorf(
-							"Child HealthThreshold cannot be more than 256"))
-					}
-					return
-				},
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 256),
 			},
 
 			"cloudwatch_alarm_name": &schema.Schema{
@@ -109,6 +136,11 @@ func resourceAwsRoute53HealthCheck() *schema.Resource {
 			"insufficient_data_health_status": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					route53.InsufficientDataHealthStatusHealthy,
+					route53.InsufficientDataHealthStatusUnhealthy,
+					route53.InsufficientDataHealthStatusLastKnownStatus,
+				}, false),
 			},
 
 			"tags": tagsSchema(),
@@ -155,6 +187,14 @@ func resourceAwsRoute53HealthCheckUpdate(d *schema.ResourceData, meta interface{
 		updateHealthCheck.SearchString = aws.String(d.Get("search_string").(string))
 	}
 
+	if d.HasChange("enable_sni") && route53HealthCheckIsHTTPS(d.Get("type").(string)) {
+		updateHealthCheck.EnableSNI = aws.Bool(d.Get("enable_sni").(bool))
+	}
+
+	if d.HasChange("regions") {
+		updateHealthCheck.Regions = expandStringList(d.Get("regions").(*schema.Set).List())
+	}
+
 	if d.HasChange("cloudwatch_alarm_name") || d.HasChange("cloudwatch_alarm_region") {
 		cloudwatchAlarm := &route53.AlarmIdentifier{
 			Name:   aws.String(d.Get("cloudwatch_alarm_name").(string)),
@@ -184,7 +224,7 @@ func resourceAwsRoute53HealthCheckCreate(d *schema.ResourceData, meta interface{
 	conn := meta.(*AWSClient).r53conn
 
 	healthConfig := &route53.HealthCheckConfig{
-		Type: aws.String(d.Get("type").(string)),
+		Type: aws.String(strings.ToUpper(d.Get("type").(string))),
 	}
 
 	if v, ok := d.GetOk("request_interval"); ok {
@@ -215,6 +255,14 @@ func resourceAwsRoute53HealthCheckCreate(d *schema.ResourceData, meta interface{
 		healthConfig.ResourcePath = aws.String(v.(string))
 	}
 
+	if route53HealthCheckIsHTTPS(*healthConfig.Type) {
+		healthConfig.EnableSNI = aws.Bool(d.Get("enable_sni").(bool))
+	}
+
+	if v, ok := d.GetOk("regions"); ok {
+		healthConfig.Regions = expandStringList(v.(*schema.Set).List())
+	}
+
 	if *healthConfig.Type != route53.HealthCheckTypeCalculated && *healthConfig.Type != route53.HealthCheckTypeCloudwatchMetric {
 		if v, ok := d.GetOk("measure_latency"); ok {
 			healthConfig.MeasureLatency = aws.Bool(v.(bool))
@@ -301,7 +349,11 @@ func resourceAwsRoute53HealthCheckRead(d *schema.ResourceData, meta interface{})
 	d.Set("resource_path", updated.ResourcePath)
 	d.Set("measure_latency", updated.MeasureLatency)
 	d.Set("invert_healthcheck", updated.Inverted)
-	d.Set("child_healthchecks", updated.ChildHealthChecks)
+	if route53HealthCheckIsHTTPS(*updated.Type) {
+		d.Set("enable_sni", updated.EnableSNI)
+	}
+	d.Set("regions", flattenStringList(updated.Regions))
+	d.Set("child_healthchecks", flattenStringList(updated.ChildHealthChecks))
 	d.Set("child_health_threshold", updated.HealthThreshold)
 	d.Set("insufficient_data_health_status", updated.InsufficientDataHealthStatus)
 
@@ -353,3 +405,10 @@ func createChildHealthCheckList(s *schema.Set) (nl []*string) {
 
 	return nl
 }
+
+// route53HealthCheckIsHTTPS returns true if a health check of type t
+// connects with HTTPS.
+func route53HealthCheckIsHTTPS(t string) bool {
+	t = strings.ToUpper(t)
+	return t == route53.HealthCheckTypeHttps || t == route53.HealthCheckTypeHttpsStrMatch
+}
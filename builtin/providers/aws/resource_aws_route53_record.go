@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -27,6 +28,9 @@ func resourceAwsRoute53Record() *schema.Resource {
 		Read:   resourceAwsRoute53RecordRead,
 		Update: resourceAwsRoute53RecordUpdate,
 		Delete: resourceAwsRoute53RecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		SchemaVersion: 2,
 		MigrateState:  resourceAwsRoute53RecordMigrateState,
@@ -194,8 +198,9 @@ func resourceAwsRoute53Record() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"weight": &schema.Schema{
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 255),
 						},
 					},
 				},
@@ -221,12 +226,12 @@ func resourceAwsRoute53RecordUpdate(d *schema.ResourceData, meta interface{}) er
 	// Route 53 supports CREATE, DELETE, and UPSERT actions. We use UPSERT, and
 	// AWS dynamically determines if a record should be created or updated.
 	// Amazon Route 53 can update an existing resource record set only when all
-	// of the following values match: Name, Type
-	// (and SetIdentifier, which we don't use yet).
+	// of the following values match: Name, Type and SetIdentifier.
 	// See http://docs.aws.amazon.com/Route53/latest/APIReference/API_ChangeResourceRecordSets_Requests.html#change-rrsets-request-action
 	//
 	// Because we use UPSERT, for resouce update here we simply fall through to
-	// our resource create function.
+	// our resource create function. It replaces the record set instead when
+	// the routing policy changes, which UPSERT can't do.
 	return resourceAwsRoute53RecordCreate(d, meta)
 }
 
@@ -249,17 +254,43 @@ func resourceAwsRoute53RecordCreate(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
+	changes := []*route53.Change{
+		&route53.Change{
+			Action:            aws.String("UPSERT"),
+			ResourceRecordSet: rec,
+		},
+	}
+
+	// Moving a record set to another routing policy requires deleting the
+	// old one, which is done in the same change batch so that the name
+	// keeps resolving.
+	if d.Id() != "" && route53RecordRoutingPolicyChanged(d) {
+		old, err := findRecord(d, meta)
+		switch err {
+		case nil:
+			changes = []*route53.Change{
+				&route53.Change{
+					Action:            aws.String("DELETE"),
+					ResourceRecordSet: old,
+				},
+				&route53.Change{
+					Action:            aws.String("CREATE"),
+					ResourceRecordSet: rec,
+				},
+			}
+		case r53NoRecordsFound:
+			// Nothing to replace
+		default:
+			return err
+		}
+	}
+
 	// Create the new records. We abuse StateChangeConf for this to
 	// retry for us since Route53 sometimes returns errors about another
 	// operation happening at the same time.
 	changeBatch := &route53.ChangeBatch{
 		Comment: aws.String("Managed by Terraform"),
-		Changes: []*route53.Change{
-			&route53.Change{
-				Action:            aws.String("UPSERT"),
-				ResourceRecordSet: rec,
-			},
-		},
+		Changes: changes,
 	}
 
 	req := &route53.ChangeResourceRecordSetsInput{
@@ -289,7 +320,7 @@ func resourceAwsRoute53RecordCreate(d *schema.ResourceData, meta interface{}) er
 
 	d.SetId(strings.Join(vars, "_"))
 
-	err = waitForRoute53RecordSetToSync(conn, cleanChangeID(*changeInfo.Id))
+	err = waitForRoute53RecordSetToSync(conn, cleanChangeID(*changeInfo.Id), route53SyncTimeout(meta))
 	if err != nil {
 		return err
 	}
@@ -324,12 +355,12 @@ func changeRoute53RecordSet(conn *route53.Route53, input *route53.ChangeResource
 	return wait.WaitForState()
 }
 
-func waitForRoute53RecordSetToSync(conn *route53.Route53, requestId string) error {
+func waitForRoute53RecordSetToSync(conn *route53.Route53, requestId string, timeout time.Duration) error {
 	wait := resource.StateChangeConf{
 		Delay:      30 * time.Second,
 		Pending:    []string{"PENDING"},
 		Target:     []string{"INSYNC"},
-		Timeout:    30 * time.Minute,
+		Timeout:    timeout,
 		MinTimeout: 5 * time.Second,
 		Refresh: func() (result interface{}, state string, err error) {
 			changeRequest := &route53.GetChangeInput{
@@ -345,12 +376,15 @@ func waitForRoute53RecordSetToSync(conn *route53.Route53, requestId string) erro
 func resourceAwsRoute53RecordRead(d *schema.ResourceData, meta interface{}) error {
 	// If we don't have a zone ID we're doing an import. Parse it from the ID.
 	if _, ok := d.GetOk("zone_id"); !ok {
-		parts := strings.Split(d.Id(), "_")
-		d.Set("zone_id", parts[0])
-		d.Set("name", parts[1])
-		d.Set("type", parts[2])
-		if len(parts) > 3 {
-			d.Set("set_identifier", parts[3])
+		zone, name, recordType, setIdentifier, err := parseRoute53RecordId(d.Id())
+		if err != nil {
+			return err
+		}
+		d.Set("zone_id", zone)
+		d.Set("name", name)
+		d.Set("type", recordType)
+		if setIdentifier != "" {
+			d.Set("set_identifier", setIdentifier)
 		}
 
 		d.Set("weight", -1)
@@ -469,6 +503,12 @@ func findRecord(d *schema.ResourceData, meta interface{}) (*route53.ResourceReco
 		StartRecordType: aws.String(d.Get("type").(string)),
 	}
 
+	// Record sets sharing a name and type are listed by set identifier, so
+	// start at ours rather than paging through all of them.
+	if v, ok := d.GetOk("set_identifier"); ok {
+		lopts.StartRecordIdentifier = aws.String(v.(string))
+	}
+
 	log.Printf("[DEBUG] List resource records sets for zone: %s, opts: %s",
 		zone, lopts)
 	resp, err := conn.ListResourceRecordSets(lopts)
@@ -538,7 +578,7 @@ func resourceAwsRoute53RecordDelete(d *schema.ResourceData, meta interface{}) er
 		return nil
 	}
 
-	err = waitForRoute53RecordSetToSync(conn, cleanChangeID(*changeInfo.Id))
+	err = waitForRoute53RecordSetToSync(conn, cleanChangeID(*changeInfo.Id), route53SyncTimeout(meta))
 	if err != nil {
 		return err
 	}
@@ -692,6 +732,61 @@ func resourceAwsRoute53RecordBuildSet(d *schema.ResourceData, zoneName string) (
 	return rec, nil
 }
 
+// route53RoutingPolicies are the mutually exclusive routing policy blocks
+// of a record.
+var route53RoutingPolicies = []string{
+	"failover_routing_policy",
+	"geolocation_routing_policy",
+	"latency_routing_policy",
+	"weighted_routing_policy",
+}
+
+// route53RecordRoutingPolicyChanged reports whether the record moves from
+// one routing policy to another, including to or from simple routing.
+// Changes within the same policy, such as a new weight, don't count.
+func route53RecordRoutingPolicyChanged(d *schema.ResourceData) bool {
+	var oldPolicy, newPolicy string
+	for _, k := range route53RoutingPolicies {
+		o, n := d.GetChange(k)
+		if len(o.([]interface{})) > 0 {
+			oldPolicy = k
+		}
+		if len(n.([]interface{})) > 0 {
+			newPolicy = k
+		}
+	}
+	return oldPolicy != newPolicy
+}
+
+// route53RecordTypes are the record types Route 53 supports.
+var route53RecordTypes = map[string]bool{
+	"A": true, "AAAA": true, "CAA": true, "CNAME": true, "MX": true, "NAPTR": true,
+	"NS": true, "PTR": true, "SOA": true, "SPF": true, "SRV": true, "TXT": true,
+}
+
+// parseRoute53RecordId splits a record ID of the form
+// ZONEID_name_TYPE[_SETIDENTIFIER] into its parts. Names and set
+// identifiers may contain underscores, so the record type is found as the
+// first part after the name that is a record type; names are always lower
+// case, so they can't be mistaken for one.
+func parseRoute53RecordId(id string) (zone, name, recordType, setIdentifier string, err error) {
+	parts := strings.Split(id, "_")
+	for i := 2; i < len(parts); i++ {
+		if !route53RecordTypes[parts[i]] {
+			continue
+		}
+
+		zone = parts[0]
+		name = strings.Join(parts[1:i], "_")
+		recordType = parts[i]
+		setIdentifier = strings.Join(parts[i+1:], "_")
+		return
+	}
+
+	err = fmt.Errorf("Unexpected format of ID (%q), expected ZONEID_name_TYPE or ZONEID_name_TYPE_SETIDENTIFIER", id)
+	return
+}
+
 func FQDN(name string) string {
 	n := len(name)
 	if n == 0 || name[n-1] == '.' {
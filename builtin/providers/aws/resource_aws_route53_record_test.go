@@ -52,6 +52,31 @@ func TestExpandRecordName(t *testing.T) {
 	}
 }
 
+func TestParseRoute53RecordId(t *testing.T) {
+	cases := []struct {
+		Input, Zone, Name, Type, Set string
+		Err                          bool
+	}{
+		{"ABCDEF_test.notexample.com_A", "ABCDEF", "test.notexample.com", "A", "", false},
+		{"ABCDEF_test.notexample.com_A_set1", "ABCDEF", "test.notexample.com", "A", "set1", false},
+		{"ABCDEF__dmarc.notexample.com_TXT", "ABCDEF", "_dmarc.notexample.com", "TXT", "", false},
+		{"ABCDEF_www_1.notexample.com_CNAME_us_east", "ABCDEF", "www_1.notexample.com", "CNAME", "us_east", false},
+		{"ABCDEF_test.notexample.com_A_A", "ABCDEF", "test.notexample.com", "A", "A", false},
+		{"ABCDEF_test.notexample.com", "", "", "", "", true},
+		{"ABCDEF_A", "", "", "", "", true},
+	}
+
+	for _, tc := range cases {
+		zone, name, recordType, set, err := parseRoute53RecordId(tc.Input)
+		if (err != nil) != tc.Err {
+			t.Fatalf("%s: expected error %t, got %v", tc.Input, tc.Err, err)
+		}
+		if zone != tc.Zone || name != tc.Name || recordType != tc.Type || set != tc.Set {
+			t.Fatalf("%s: got %q, %q, %q, %q", tc.Input, zone, name, recordType, set)
+		}
+	}
+}
+
 func TestAccAWSRoute53Record_basic(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:      func() { testAccPreCheck(t) },
@@ -214,6 +239,35 @@ func TestAccAWSRoute53Record_weighted_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSRoute53Record_routingPolicyChange(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_route53_record.www",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckRoute53RecordDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRoute53RoutingPolicyChangeWeighted,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53RecordExists("aws_route53_record.www"),
+					resource.TestCheckResourceAttr(
+						"aws_route53_record.www", "weighted_routing_policy.0.weight", "100"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRoute53RoutingPolicyChangeLatency,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53RecordExists("aws_route53_record.www"),
+					resource.TestCheckResourceAttr(
+						"aws_route53_record.www", "weighted_routing_policy.#", "0"),
+					resource.TestCheckResourceAttr(
+						"aws_route53_record.www", "latency_routing_policy.0.region", "us-east-1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSRoute53Record_alias(t *testing.T) {
 	rs := acctest.RandString(10)
 	config := fmt.Sprintf(testAccRoute53ElbAliasRecord, rs)
@@ -1035,3 +1089,39 @@ resource "aws_route53_record" "empty" {
 	records = ["127.0.0.1"]
 }
 `
+
+const testAccRoute53RoutingPolicyChangeWeighted = `
+resource "aws_route53_zone" "main" {
+  name = "notexample.com"
+}
+
+resource "aws_route53_record" "www" {
+  zone_id = "${aws_route53_zone.main.zone_id}"
+  name = "www"
+  type = "CNAME"
+  ttl = "5"
+  weighted_routing_policy {
+    weight = 100
+  }
+  set_identifier = "primary"
+  records = ["dev.notexample.com"]
+}
+`
+
+const testAccRoute53RoutingPolicyChangeLatency = `
+resource "aws_route53_zone" "main" {
+  name = "notexample.com"
+}
+
+resource "aws_route53_record" "www" {
+  zone_id = "${aws_route53_zone.main.zone_id}"
+  name = "www"
+  type = "CNAME"
+  ttl = "5"
+  latency_routing_policy {
+    region = "us-east-1"
+  }
+  set_identifier = "primary"
+  records = ["dev.notexample.com"]
+}
+`
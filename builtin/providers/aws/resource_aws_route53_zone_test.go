@@ -288,7 +288,7 @@ func testAccCreateRandomRoute53RecordsInZoneId(provider *schema.Provider, zone *
 		return err
 	}
 	changeInfo := resp.(*route53.ChangeResourceRecordSetsOutput).ChangeInfo
-	err = waitForRoute53RecordSetToSync(conn, cleanChangeID(*changeInfo.Id))
+	err = waitForRoute53RecordSetToSync(conn, cleanChangeID(*changeInfo.Id), defaultRoute53SyncTimeout)
 	return err
 }
 
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -23,6 +25,7 @@ func resourceAwsRouteTable() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsRouteTableImportState,
 		},
+		PlanCheck: resourceAwsRouteTablePlanCheck,
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": &schema.Schema{
@@ -33,9 +36,16 @@ func resourceAwsRouteTable() *schema.Resource {
 
 			"tags": tagsSchema(),
 
+			"exclusive_routes": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"propagating_vgws": &schema.Schema{
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
@@ -83,6 +93,69 @@ func resourceAwsRouteTable() *schema.Resource {
 	}
 }
 
+// resourceAwsRouteTablePlanCheck detects tables whose routes are managed
+// both inline and with aws_route resources, which keep undoing each other's
+// changes.
+func resourceAwsRouteTablePlanCheck(d *schema.ResourceData, meta interface{}) ([]string, error) {
+	if d.Id() == "" {
+		return nil, nil
+	}
+
+	exclusive := d.Get("exclusive_routes").(bool)
+
+	var warns []string
+	if m := inlineManagementFromMeta(meta); m != nil && (exclusive || d.IsConfigured("route")) {
+		ws, err := m.Inline(inlineKindRouteTable, d.Id(), exclusive)
+		if err != nil {
+			return nil, err
+		}
+		warns = append(warns, ws...)
+	}
+
+	// With exclusive_routes, removing the routes that aren't declared
+	// inline is expected.
+	if !exclusive {
+		if w := resourceAwsRouteTableRemovedRoutesWarning(d); w != "" {
+			warns = append(warns, w)
+		}
+	}
+
+	return warns, nil
+}
+
+// resourceAwsRouteTableRemovedRoutesWarning warns when the inline routes
+// would remove routes that aren't declared inline.
+func resourceAwsRouteTableRemovedRoutesWarning(d *schema.ResourceData) string {
+	o, n := d.GetChange("route")
+	oldRoutes := o.(*schema.Set)
+	newRoutes := n.(*schema.Set)
+	if newRoutes.Len() == 0 {
+		return ""
+	}
+
+	// A route whose CIDR block is still declared is being changed rather
+	// than removed.
+	declared := make(map[string]bool)
+	for _, r := range newRoutes.List() {
+		declared[r.(map[string]interface{})["cidr_block"].(string)] = true
+	}
+	var removed []string
+	for _, r := range oldRoutes.Difference(newRoutes).List() {
+		if cidr := r.(map[string]interface{})["cidr_block"].(string); !declared[cidr] {
+			removed = append(removed, cidr)
+		}
+	}
+	if len(removed) == 0 {
+		return ""
+	}
+
+	sort.Strings(removed)
+	return fmt.Sprintf(
+		"routes to %s aren't declared inline and will be removed. If they're "+
+			"managed by aws_route resources, declare the routes of this table either "+
+			"inline or with aws_route, not both.", strings.Join(removed, ", "))
+}
+
 func resourceAwsRouteTableCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
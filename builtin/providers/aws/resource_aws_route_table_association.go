@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,6 +19,9 @@ func resourceAwsRouteTableAssociation() *schema.Resource {
 		Read:   resourceAwsRouteTableAssociationRead,
 		Update: resourceAwsRouteTableAssociationUpdate,
 		Delete: resourceAwsRouteTableAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsRouteTableAssociationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"subnet_id": &schema.Schema{
@@ -153,3 +157,36 @@ func resourceAwsRouteTableAssociationDelete(d *schema.ResourceData, meta interfa
 
 	return nil
 }
+
+func resourceAwsRouteTableAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format for import: %s. Use 'subnet ID/route table ID'", d.Id())
+	}
+
+	subnetId := parts[0]
+	routeTableId := parts[1]
+
+	log.Printf("[DEBUG] Importing route table association, subnet: %s, route table: %s", subnetId, routeTableId)
+
+	conn := meta.(*AWSClient).ec2conn
+	rtRaw, _, err := resourceAwsRouteTableStateRefreshFunc(conn, routeTableId)()
+	if err != nil {
+		return nil, err
+	}
+	if rtRaw == nil {
+		return nil, fmt.Errorf("Route table %s not found", routeTableId)
+	}
+	rt := rtRaw.(*ec2.RouteTable)
+
+	for _, a := range rt.Associations {
+		if aws.StringValue(a.SubnetId) == subnetId {
+			d.SetId(aws.StringValue(a.RouteTableAssociationId))
+			d.Set("subnet_id", subnetId)
+			d.Set("route_table_id", routeTableId)
+			return []*schema.ResourceData{d}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Subnet %s is not associated with route table %s", subnetId, routeTableId)
+}
@@ -38,6 +38,16 @@ func TestAccAWSRouteTableAssociation_basic(t *testing.T) {
 	})
 }
 
+func TestResourceAwsRouteTableAssociationImport_invalidId(t *testing.T) {
+	for _, id := range []string{"", "subnet-12345678", "subnet-12345678/", "/rtb-12345678", "a/b/c"} {
+		d := resourceAwsRouteTableAssociation().TestResourceData()
+		d.SetId(id)
+		if _, err := resourceAwsRouteTableAssociationImport(d, nil); err == nil {
+			t.Fatalf("expected error for %q", id)
+		}
+	}
+}
+
 func testAccCheckRouteTableAssociationDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ec2conn
 
@@ -252,6 +252,7 @@ func testAccCheckAWSRouteExists(n string, res *ec2.Route) resource.TestCheckFunc
 		conn := testAccProvider.Meta().(*AWSClient).ec2conn
 		r, err := findResourceRoute(
 			conn,
+			nil,
 			rs.Primary.Attributes["route_table_id"],
 			rs.Primary.Attributes["destination_cidr_block"],
 		)
@@ -279,6 +280,7 @@ func testAccCheckAWSRouteDestroy(s *terraform.State) error {
 		conn := testAccProvider.Meta().(*AWSClient).ec2conn
 		route, err := findResourceRoute(
 			conn,
+			nil,
 			rs.Primary.Attributes["route_table_id"],
 			rs.Primary.Attributes["destination_cidr_block"],
 		)
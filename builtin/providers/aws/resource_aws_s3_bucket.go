@@ -294,6 +294,65 @@ func resourceAwsS3Bucket() *schema.Resource {
 				},
 			},
 
+			"replication_configuration": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"rules": &schema.Schema{
+							Type:     schema.TypeSet,
+							Required: true,
+							Set:      rulesHash,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": &schema.Schema{
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateS3BucketReplicationRuleId,
+									},
+									"destination": &schema.Schema{
+										Type:     schema.TypeSet,
+										MaxItems: 1,
+										MinItems: 1,
+										Required: true,
+										Set:      destinationHash,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket": &schema.Schema{
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validateArn,
+												},
+												"storage_class": &schema.Schema{
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validateS3BucketReplicationDestinationStorageClass,
+												},
+											},
+										},
+									},
+									"prefix": &schema.Schema{
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateS3BucketReplicationRulePrefix,
+									},
+									"status": &schema.Schema{
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateS3BucketReplicationRuleStatus,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"force_destroy": &schema.Schema{
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -329,6 +388,12 @@ func resourceAwsS3BucketCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] S3 bucket create: %s, ACL: %s", bucket, acl)
 
+	// Check the replication configuration before creating the bucket, so a
+	// bucket without versioning isn't left behind when it's rejected.
+	if err := validateS3BucketReplicationVersioning(d); err != nil {
+		return err
+	}
+
 	req := &s3.CreateBucketInput{
 		Bucket: aws.String(bucket),
 		ACL:    aws.String(acl),
@@ -399,6 +464,13 @@ func resourceAwsS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 	}
+
+	if d.HasChange("replication_configuration") {
+		if err := resourceAwsS3BucketReplicationConfigurationUpdate(s3conn, d); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("acl") {
 		if err := resourceAwsS3BucketAclUpdate(s3conn, d); err != nil {
 			return err
@@ -748,6 +820,20 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// Read the replication configuration
+	replication, err := s3conn.GetBucketReplication(&s3.GetBucketReplicationInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if err != nil {
+		if awsError, ok := err.(awserr.RequestFailure); ok && awsError.StatusCode() != 404 {
+			return err
+		}
+	}
+	log.Printf("[DEBUG] S3 Bucket: %s, read replication configuration: %v", d.Id(), replication)
+	if err := d.Set("replication_configuration", flattenAwsS3BucketReplicationConfiguration(replication.ReplicationConfiguration)); err != nil {
+		return fmt.Errorf("Error setting replication configuration: %s", err)
+	}
+
 	// Add the region as an attribute
 	location, err := s3conn.GetBucketLocation(
 		&s3.GetBucketLocationInput{
@@ -1384,6 +1470,131 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 	return nil
 }
 
+func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	replicationConfiguration := d.Get("replication_configuration").([]interface{})
+
+	if len(replicationConfiguration) == 0 {
+		i := &s3.DeleteBucketReplicationInput{
+			Bucket: aws.String(bucket),
+		}
+
+		log.Printf("[DEBUG] S3 delete bucket replication configuration: %#v", i)
+		if _, err := s3conn.DeleteBucketReplication(i); err != nil {
+			return fmt.Errorf("Error removing S3 bucket replication: %s", err)
+		}
+		return nil
+	}
+
+	if err := validateS3BucketReplicationVersioning(d); err != nil {
+		return err
+	}
+
+	rc := expandAwsS3BucketReplicationConfiguration(replicationConfiguration[0].(map[string]interface{}))
+	i := &s3.PutBucketReplicationInput{
+		Bucket:                   aws.String(bucket),
+		ReplicationConfiguration: rc,
+	}
+	log.Printf("[DEBUG] S3 put bucket replication configuration: %#v", i)
+
+	_, err := s3conn.PutBucketReplication(i)
+	if err != nil {
+		return fmt.Errorf("Error putting S3 replication configuration: %s", err)
+	}
+
+	return nil
+}
+
+// validateS3BucketReplicationVersioning returns an error if the bucket has a
+// replication configuration but versioning isn't enabled, which S3 requires.
+func validateS3BucketReplicationVersioning(d *schema.ResourceData) error {
+	if len(d.Get("replication_configuration").([]interface{})) == 0 {
+		return nil
+	}
+
+	v := d.Get("versioning").(*schema.Set).List()
+	if len(v) == 0 || !v[0].(map[string]interface{})["enabled"].(bool) {
+		return fmt.Errorf("versioning must be enabled to allow S3 bucket replication")
+	}
+
+	return nil
+}
+
+func expandAwsS3BucketReplicationConfiguration(c map[string]interface{}) *s3.ReplicationConfiguration {
+	rc := &s3.ReplicationConfiguration{
+		Role: aws.String(c["role"].(string)),
+	}
+
+	rules := c["rules"].(*schema.Set).List()
+	rc.Rules = make([]*s3.ReplicationRule, 0, len(rules))
+	for _, v := range rules {
+		rr := v.(map[string]interface{})
+		rule := &s3.ReplicationRule{
+			Prefix: aws.String(rr["prefix"].(string)),
+			Status: aws.String(rr["status"].(string)),
+		}
+
+		if id, ok := rr["id"].(string); ok && id != "" {
+			rule.ID = aws.String(id)
+		}
+
+		destination := rr["destination"].(*schema.Set).List()
+		if len(destination) > 0 {
+			bd := destination[0].(map[string]interface{})
+			rule.Destination = &s3.Destination{
+				Bucket: aws.String(bd["bucket"].(string)),
+			}
+			if storageClass, ok := bd["storage_class"].(string); ok && storageClass != "" {
+				rule.Destination.StorageClass = aws.String(storageClass)
+			}
+		}
+
+		rc.Rules = append(rc.Rules, rule)
+	}
+
+	return rc
+}
+
+func flattenAwsS3BucketReplicationConfiguration(r *s3.ReplicationConfiguration) []map[string]interface{} {
+	if r == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := make(map[string]interface{})
+	if r.Role != nil && *r.Role != "" {
+		m["role"] = *r.Role
+	}
+
+	rules := make([]interface{}, 0, len(r.Rules))
+	for _, v := range r.Rules {
+		t := make(map[string]interface{})
+		if v.Destination != nil {
+			rd := make(map[string]interface{})
+			if v.Destination.Bucket != nil {
+				rd["bucket"] = *v.Destination.Bucket
+			}
+			if v.Destination.StorageClass != nil {
+				rd["storage_class"] = *v.Destination.StorageClass
+			}
+			t["destination"] = schema.NewSet(destinationHash, []interface{}{rd})
+		}
+
+		if v.ID != nil {
+			t["id"] = *v.ID
+		}
+		if v.Prefix != nil {
+			t["prefix"] = *v.Prefix
+		}
+		if v.Status != nil {
+			t["status"] = *v.Status
+		}
+		rules = append(rules, t)
+	}
+	m["rules"] = schema.NewSet(rulesHash, rules)
+
+	return []map[string]interface{}{m}
+}
+
 func normalizeRoutingRules(w []*s3.RoutingRule) (string, error) {
 	withNulls, err := json.Marshal(w)
 	if err != nil {
@@ -1506,3 +1717,35 @@ func transitionHash(v interface{}) int {
 type S3Website struct {
 	Endpoint, Domain string
 }
+
+func rulesHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	if v, ok := m["id"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	if v, ok := m["prefix"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	if v, ok := m["status"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	if v, ok := m["destination"].(*schema.Set); ok && v.Len() > 0 {
+		buf.WriteString(fmt.Sprintf("%d-", destinationHash(v.List()[0])))
+	}
+	return hashcode.String(buf.String())
+}
+
+func destinationHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	if v, ok := m["bucket"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	if v, ok := m["storage_class"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	return hashcode.String(buf.String())
+}
@@ -16,7 +16,7 @@ import (
 
 func resourceAwsS3BucketNotification() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsS3BucketNotificationPut,
+		Create: resourceAwsS3BucketNotificationCreate,
 		Read:   resourceAwsS3BucketNotificationRead,
 		Update: resourceAwsS3BucketNotificationPut,
 		Delete: resourceAwsS3BucketNotificationDelete,
@@ -56,8 +56,11 @@ func resourceAwsS3BucketNotification() *schema.Resource {
 						"events": &schema.Schema{
 							Type:     schema.TypeSet,
 							Required: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateS3BucketNotificationEvent,
+							},
+							Set: schema.HashString,
 						},
 					},
 				},
@@ -88,8 +91,11 @@ func resourceAwsS3BucketNotification() *schema.Resource {
 						"events": &schema.Schema{
 							Type:     schema.TypeSet,
 							Required: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateS3BucketNotificationEvent,
+							},
+							Set: schema.HashString,
 						},
 					},
 				},
@@ -120,8 +126,11 @@ func resourceAwsS3BucketNotification() *schema.Resource {
 						"events": &schema.Schema{
 							Type:     schema.TypeSet,
 							Required: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateS3BucketNotificationEvent,
+							},
+							Set: schema.HashString,
 						},
 					},
 				},
@@ -130,6 +139,31 @@ func resourceAwsS3BucketNotification() *schema.Resource {
 	}
 }
 
+func resourceAwsS3BucketNotificationCreate(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+
+	// A bucket has a single notification configuration, which is replaced
+	// as a whole. Refuse to create over an existing one, which would
+	// silently drop notifications that are managed somewhere else.
+	existing, err := s3conn.GetBucketNotificationConfiguration(&s3.GetBucketNotificationConfigurationRequest{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading S3 bucket %q notification configuration: %s", bucket, err)
+	}
+	if len(existing.TopicConfigurations) > 0 || len(existing.QueueConfigurations) > 0 ||
+		len(existing.LambdaFunctionConfigurations) > 0 {
+		return fmt.Errorf(
+			"S3 bucket %q already has a notification configuration. A bucket has only one\n"+
+				"notification configuration, so all of its notifications must be declared in a single\n"+
+				"aws_s3_bucket_notification. Import the existing configuration with\n"+
+				"`terraform import` or remove it before creating this resource.", bucket)
+	}
+
+	return resourceAwsS3BucketNotificationPut(d, meta)
+}
+
 func resourceAwsS3BucketNotificationPut(d *schema.ResourceData, meta interface{}) error {
 	s3conn := meta.(*AWSClient).s3conn
 	bucket := d.Get("bucket").(string)
@@ -293,6 +327,10 @@ func resourceAwsS3BucketNotificationPut(d *schema.ResourceData, meta interface{}
 		lambdaConfigs = append(lambdaConfigs, lc)
 	}
 
+	if err := validateS3BucketNotificationOverlap(topicConfigs, queueConfigs, lambdaConfigs); err != nil {
+		return err
+	}
+
 	notificationConfiguration := &s3.NotificationConfiguration{}
 	if len(lambdaConfigs) > 0 {
 		notificationConfiguration.LambdaFunctionConfigurations = lambdaConfigs
@@ -396,6 +434,87 @@ func resourceAwsS3BucketNotificationRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
+// s3NotificationTarget is a notification configuration of any kind, used to
+// check that the configurations of a bucket don't overlap.
+type s3NotificationTarget struct {
+	Id     string
+	Events []*string
+	Filter *s3.NotificationConfigurationFilter
+}
+
+// validateS3BucketNotificationOverlap returns an error if two notification
+// configurations share an event type and their filters can match the same
+// object. S3 rejects such a configuration with an error that doesn't say
+// which configurations overlap.
+func validateS3BucketNotificationOverlap(
+	topics []*s3.TopicConfiguration,
+	queues []*s3.QueueConfiguration,
+	lambdas []*s3.LambdaFunctionConfiguration) error {
+	var targets []s3NotificationTarget
+	for _, c := range topics {
+		targets = append(targets, s3NotificationTarget{*c.Id, c.Events, c.Filter})
+	}
+	for _, c := range queues {
+		targets = append(targets, s3NotificationTarget{*c.Id, c.Events, c.Filter})
+	}
+	for _, c := range lambdas {
+		targets = append(targets, s3NotificationTarget{*c.Id, c.Events, c.Filter})
+	}
+
+	for i, a := range targets {
+		for _, b := range targets[i+1:] {
+			event, ok := s3NotificationEventsOverlap(a.Events, b.Events)
+			if !ok {
+				continue
+			}
+
+			aPrefix, aSuffix := s3NotificationFilterRules(a.Filter)
+			bPrefix, bSuffix := s3NotificationFilterRules(b.Filter)
+			if (strings.HasPrefix(aPrefix, bPrefix) || strings.HasPrefix(bPrefix, aPrefix)) &&
+				(strings.HasSuffix(aSuffix, bSuffix) || strings.HasSuffix(bSuffix, aSuffix)) {
+				return fmt.Errorf(
+					"notification configurations %q and %q overlap: both are for %s and "+
+						"their filters can match the same objects", a.Id, b.Id, event)
+			}
+		}
+	}
+
+	return nil
+}
+
+// s3NotificationEventsOverlap returns an event that is in both lists, taking
+// wildcards such as "s3:ObjectCreated:*" into account.
+func s3NotificationEventsOverlap(a, b []*string) (string, bool) {
+	for _, ea := range a {
+		for _, eb := range b {
+			x, y := *ea, *eb
+			if x == y ||
+				(strings.HasSuffix(x, ":*") && strings.HasPrefix(y, strings.TrimSuffix(x, "*"))) ||
+				(strings.HasSuffix(y, ":*") && strings.HasPrefix(x, strings.TrimSuffix(y, "*"))) {
+				return x, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+func s3NotificationFilterRules(filter *s3.NotificationConfigurationFilter) (prefix, suffix string) {
+	if filter == nil || filter.Key == nil {
+		return
+	}
+
+	for _, r := range filter.Key.FilterRules {
+		switch strings.ToLower(*r.Name) {
+		case "prefix":
+			prefix = *r.Value
+		case "suffix":
+			suffix = *r.Value
+		}
+	}
+	return
+}
+
 func flattenNotificationConfigurationFilter(filter *s3.NotificationConfigurationFilter) map[string]interface{} {
 	filterRules := map[string]interface{}{}
 	for _, f := range filter.Key.FilterRules {
@@ -133,6 +133,78 @@ func TestAccAWSS3Bucket_NotificationWithoutFilter(t *testing.T) {
 	})
 }
 
+func TestValidateS3BucketNotificationOverlap(t *testing.T) {
+	filter := func(prefix, suffix string) *s3.NotificationConfigurationFilter {
+		return &s3.NotificationConfigurationFilter{
+			Key: &s3.KeyFilter{
+				FilterRules: []*s3.FilterRule{
+					&s3.FilterRule{Name: aws.String("Prefix"), Value: aws.String(prefix)},
+					&s3.FilterRule{Name: aws.String("Suffix"), Value: aws.String(suffix)},
+				},
+			},
+		}
+	}
+	topic := &s3.TopicConfiguration{
+		Id:     aws.String("topic"),
+		Events: []*string{aws.String("s3:ObjectCreated:*")},
+		Filter: filter("images/", ".png"),
+	}
+
+	cases := []struct {
+		Queue   *s3.QueueConfiguration
+		Overlap bool
+	}{
+		{
+			Queue: &s3.QueueConfiguration{
+				Id:     aws.String("queue"),
+				Events: []*string{aws.String("s3:ObjectCreated:Put")},
+			},
+			Overlap: true,
+		},
+		{
+			Queue: &s3.QueueConfiguration{
+				Id:     aws.String("queue"),
+				Events: []*string{aws.String("s3:ObjectCreated:Put")},
+				Filter: filter("images/thumbs/", ""),
+			},
+			Overlap: true,
+		},
+		{
+			Queue: &s3.QueueConfiguration{
+				Id:     aws.String("queue"),
+				Events: []*string{aws.String("s3:ObjectCreated:Put")},
+				Filter: filter("videos/", ".png"),
+			},
+			Overlap: false,
+		},
+		{
+			Queue: &s3.QueueConfiguration{
+				Id:     aws.String("queue"),
+				Events: []*string{aws.String("s3:ObjectCreated:Put")},
+				Filter: filter("images/", ".jpg"),
+			},
+			Overlap: false,
+		},
+		{
+			Queue: &s3.QueueConfiguration{
+				Id:     aws.String("queue"),
+				Events: []*string{aws.String("s3:ObjectRemoved:*")},
+			},
+			Overlap: false,
+		},
+	}
+
+	for i, tc := range cases {
+		err := validateS3BucketNotificationOverlap(
+			[]*s3.TopicConfiguration{topic},
+			[]*s3.QueueConfiguration{tc.Queue},
+			nil)
+		if (err != nil) != tc.Overlap {
+			t.Fatalf("%d: expected overlap %t, got: %v", i, tc.Overlap, err)
+		}
+	}
+}
+
 func testAccCheckAWSS3BucketNotificationDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).s3conn
 
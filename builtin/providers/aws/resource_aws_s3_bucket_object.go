@@ -2,6 +2,7 @@ package aws
 
 import (
 	"bytes"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"log"
@@ -73,13 +74,28 @@ func resourceAwsS3BucketObject() *schema.Resource {
 			"source": &schema.Schema{
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"content"},
+				ConflictsWith: []string{"content", "content_base64"},
 			},
 
 			"content": &schema.Schema{
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"source"},
+				ConflictsWith: []string{"source", "content_base64"},
+			},
+
+			"content_base64": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"source", "content"},
+				ValidateFunc:  validateS3BucketObjectContentBase64,
+			},
+
+			// source_hash is only stored, so that a change to it causes the
+			// object to be uploaded again. It's the way to detect changes to
+			// the source of KMS encrypted objects, whose etag isn't an MD5.
+			"source_hash": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
 			},
 
 			"storage_class": &schema.Schema{
@@ -89,6 +105,13 @@ func resourceAwsS3BucketObject() *schema.Resource {
 				ValidateFunc: validateS3BucketObjectStorageClassType,
 			},
 
+			"server_side_encryption": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateS3BucketObjectServerSideEncryption,
+			},
+
 			"kms_key_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -96,9 +119,10 @@ func resourceAwsS3BucketObject() *schema.Resource {
 
 			"etag": &schema.Schema{
 				Type: schema.TypeString,
-				// This will conflict with SSE-C and SSE-KMS encryption and multi-part upload
+				// This conflicts with SSE-C and SSE-KMS encryption and multi-part upload
 				// if/when it's actually implemented. The Etag then won't match raw-file MD5.
 				// See http://docs.aws.amazon.com/AmazonS3/latest/API/RESTCommonResponseHeaders.html
+				// Use source_hash to detect changes of encrypted objects instead.
 				Optional:      true,
 				Computed:      true,
 				ConflictsWith: []string{"kms_key_id"},
@@ -132,8 +156,28 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 	} else if v, ok := d.GetOk("content"); ok {
 		content := v.(string)
 		body = bytes.NewReader([]byte(content))
+	} else if v, ok := d.GetOk("content_base64"); ok {
+		content, err := base64.StdEncoding.DecodeString(v.(string))
+		if err != nil {
+			return fmt.Errorf("Error decoding content_base64: %s", err)
+		}
+		body = bytes.NewReader(content)
 	} else {
-		return fmt.Errorf("Must specify \"source\" or \"content\" field")
+		return fmt.Errorf("Must specify \"source\", \"content\" or \"content_base64\" field")
+	}
+
+	sse := d.Get("server_side_encryption").(string)
+	if _, ok := d.GetOk("kms_key_id"); ok {
+		if sse != "" && sse != s3.ServerSideEncryptionAwsKms {
+			return fmt.Errorf("kms_key_id can only be used with %q server_side_encryption, got %q",
+				s3.ServerSideEncryptionAwsKms, sse)
+		}
+		sse = s3.ServerSideEncryptionAwsKms
+	}
+	if _, ok := d.GetOk("etag"); ok && d.HasChange("etag") && sse == s3.ServerSideEncryptionAwsKms {
+		return fmt.Errorf("etag can't be used with %q server_side_encryption, "+
+			"because the ETag of the object isn't an MD5 of its content. Use source_hash instead.",
+			s3.ServerSideEncryptionAwsKms)
 	}
 
 	bucket := d.Get("bucket").(string)
@@ -170,9 +214,12 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		putInput.ContentDisposition = aws.String(v.(string))
 	}
 
+	if sse != "" {
+		putInput.ServerSideEncryption = aws.String(sse)
+	}
+
 	if v, ok := d.GetOk("kms_key_id"); ok {
 		putInput.SSEKMSKeyId = aws.String(v.(string))
-		putInput.ServerSideEncryption = aws.String("aws:kms")
 	}
 
 	resp, err := s3conn.PutObject(putInput)
@@ -217,7 +264,13 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("content_language", resp.ContentLanguage)
 	d.Set("content_type", resp.ContentType)
 	d.Set("version_id", resp.VersionId)
-	d.Set("kms_key_id", resp.SSEKMSKeyId)
+	d.Set("server_side_encryption", resp.ServerSideEncryption)
+
+	// Objects encrypted with aws:kms but without a kms_key_id use the
+	// default key, which isn't tracked to avoid a diff against the config.
+	if _, ok := d.GetOk("kms_key_id"); ok {
+		d.Set("kms_key_id", resp.SSEKMSKeyId)
+	}
 	d.Set("etag", strings.Trim(*resp.ETag, `"`))
 
 	// The "STANDARD" (which is also the default) storage
@@ -327,3 +380,22 @@ func validateS3BucketObjectStorageClassType(v interface{}, k string) (ws []strin
 	}
 	return
 }
+
+func validateS3BucketObjectServerSideEncryption(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value != s3.ServerSideEncryptionAes256 && value != s3.ServerSideEncryptionAwsKms {
+		errors = append(errors, fmt.Errorf(
+			"%q contains an invalid Server Side Encryption value %q. Valid values are %q and %q",
+			k, value, s3.ServerSideEncryptionAes256, s3.ServerSideEncryptionAwsKms))
+	}
+	return
+}
+
+func validateS3BucketObjectContentBase64(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := base64.StdEncoding.DecodeString(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q must be base64 encoded: %s", k, err))
+	}
+	return
+}
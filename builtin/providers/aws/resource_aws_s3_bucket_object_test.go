@@ -267,6 +267,112 @@ func TestAccAWSS3BucketObject_kms(t *testing.T) {
 	})
 }
 
+func TestAccAWSS3BucketObject_sse(t *testing.T) {
+	rInt := acctest.RandInt()
+	var obj s3.GetObjectOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSS3BucketObjectConfig_withSSE(rInt, "AES256", "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &obj),
+					resource.TestCheckResourceAttr(
+						"aws_s3_bucket_object.object", "server_side_encryption", "AES256"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSS3BucketObjectConfig_withSSE(rInt, "aws:kms", "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &obj),
+					resource.TestCheckResourceAttr(
+						"aws_s3_bucket_object.object", "server_side_encryption", "aws:kms"),
+					resource.TestCheckResourceAttr(
+						"aws_s3_bucket_object.object", "source_hash", "v2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketObject_contentBase64(t *testing.T) {
+	rInt := acctest.RandInt()
+	var obj s3.GetObjectOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSS3BucketObjectConfig_contentBase64(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &obj),
+					resource.TestCheckResourceAttr(
+						"aws_s3_bucket_object.object", "etag", "baab6c16d9143523b7865d46896e4596"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceAWSS3BucketObjectServerSideEncryption_validation(t *testing.T) {
+	var testCases = []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "AES256",
+			ErrCount: 0,
+		},
+		{
+			Value:    "aws:kms",
+			ErrCount: 0,
+		},
+		{
+			Value:    "aes256",
+			ErrCount: 1,
+		},
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		_, errors := validateS3BucketObjectServerSideEncryption(tc.Value, "server_side_encryption")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestResourceAWSS3BucketObjectContentBase64_validation(t *testing.T) {
+	var testCases = []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "c29tZV9jb250ZW50",
+			ErrCount: 0,
+		},
+		{
+			Value:    "not base64!",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		_, errors := validateS3BucketObjectContentBase64(tc.Value, "content_base64")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
 func TestAccAWSS3BucketObject_acl(t *testing.T) {
 	rInt := acctest.RandInt()
 	var obj s3.GetObjectOutput
@@ -561,6 +667,36 @@ resource "aws_s3_bucket_object" "object" {
 `, randInt)
 }
 
+func testAccAWSS3BucketObjectConfig_withSSE(randInt int, sse, sourceHash string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket" {
+	bucket = "tf-object-test-bucket-%d"
+}
+
+resource "aws_s3_bucket_object" "object" {
+	bucket = "${aws_s3_bucket.object_bucket.bucket}"
+	key = "test-key"
+	content = "stuff"
+	server_side_encryption = "%s"
+	source_hash = "%s"
+}
+`, randInt, sse, sourceHash)
+}
+
+func testAccAWSS3BucketObjectConfig_contentBase64(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket" {
+	bucket = "tf-object-test-bucket-%d"
+}
+
+resource "aws_s3_bucket_object" "object" {
+	bucket = "${aws_s3_bucket.object_bucket.bucket}"
+	key = "test-key"
+	content_base64 = "${base64encode("some_content")}"
+}
+`, randInt)
+}
+
 func testAccAWSS3BucketObjectConfig_acl(randInt int, acl string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "object_bucket" {
@@ -491,6 +491,50 @@ func TestAccAWSS3Bucket_Logging(t *testing.T) {
 	})
 }
 
+func TestAccAWSS3Bucket_Replication(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSS3BucketConfigReplication(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExists("aws_s3_bucket.bucket"),
+					resource.TestCheckResourceAttr(
+						"aws_s3_bucket.bucket", "replication_configuration.#", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_s3_bucket.bucket", "replication_configuration.0.rules.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSS3BucketConfigReplicationWithoutRule(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExists("aws_s3_bucket.bucket"),
+					resource.TestCheckResourceAttr(
+						"aws_s3_bucket.bucket", "replication_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3Bucket_ReplicationWithoutVersioning(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccAWSS3BucketConfigReplicationNoVersioning(rInt),
+				ExpectError: regexp.MustCompile(`versioning must be enabled`),
+			},
+		},
+	})
+}
+
 func TestAccAWSS3Bucket_Lifecycle(t *testing.T) {
 	rInt := acctest.RandInt()
 	resource.Test(t, resource.TestCase{
@@ -1187,3 +1231,100 @@ resource "aws_s3_bucket" "bucket" {
 }
 `, randInt)
 }
+
+const testAccAWSS3BucketConfigReplicationBasic = `
+provider "aws" {
+	alias  = "euwest"
+	region = "eu-west-1"
+}
+
+resource "aws_iam_role" "role" {
+	name = "tf-iam-role-replication-%d"
+	assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "s3.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_s3_bucket" "destination" {
+	provider = "aws.euwest"
+	bucket   = "tf-test-bucket-destination-%d"
+
+	versioning {
+		enabled = true
+	}
+}
+`
+
+func testAccAWSS3BucketConfigReplication(randInt int) string {
+	return fmt.Sprintf(testAccAWSS3BucketConfigReplicationBasic+`
+resource "aws_s3_bucket" "bucket" {
+	bucket = "tf-test-bucket-%d"
+	acl    = "private"
+
+	versioning {
+		enabled = true
+	}
+
+	replication_configuration {
+		role = "${aws_iam_role.role.arn}"
+		rules {
+			id     = "foobar"
+			prefix = "foo"
+			status = "Enabled"
+
+			destination {
+				bucket        = "${aws_s3_bucket.destination.arn}"
+				storage_class = "STANDARD"
+			}
+		}
+	}
+}
+`, randInt, randInt, randInt)
+}
+
+func testAccAWSS3BucketConfigReplicationWithoutRule(randInt int) string {
+	return fmt.Sprintf(testAccAWSS3BucketConfigReplicationBasic+`
+resource "aws_s3_bucket" "bucket" {
+	bucket = "tf-test-bucket-%d"
+	acl    = "private"
+
+	versioning {
+		enabled = true
+	}
+}
+`, randInt, randInt, randInt)
+}
+
+func testAccAWSS3BucketConfigReplicationNoVersioning(randInt int) string {
+	return fmt.Sprintf(testAccAWSS3BucketConfigReplicationBasic+`
+resource "aws_s3_bucket" "bucket" {
+	bucket = "tf-test-bucket-%d"
+	acl    = "private"
+
+	replication_configuration {
+		role = "${aws_iam_role.role.arn}"
+		rules {
+			id     = "foobar"
+			prefix = "foo"
+			status = "Enabled"
+
+			destination {
+				bucket = "${aws_s3_bucket.destination.arn}"
+			}
+		}
+	}
+}
+`, randInt, randInt, randInt)
+}
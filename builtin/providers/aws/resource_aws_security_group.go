@@ -27,6 +27,8 @@ func resourceAwsSecurityGroup() *schema.Resource {
 			State: resourceAwsSecurityGroupImportState,
 		},
 
+		PlanCheck: resourceAwsSecurityGroupPlanCheck,
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:          schema.TypeString,
@@ -120,6 +122,12 @@ func resourceAwsSecurityGroup() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+
+						"description": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateSecurityGroupRuleDescription,
+						},
 					},
 				},
 				Set: resourceAwsSecurityGroupRuleHash,
@@ -171,6 +179,12 @@ func resourceAwsSecurityGroup() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+
+						"description": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateSecurityGroupRuleDescription,
+						},
 					},
 				},
 				Set: resourceAwsSecurityGroupRuleHash,
@@ -181,11 +195,34 @@ func resourceAwsSecurityGroup() *schema.Resource {
 				Computed: true,
 			},
 
+			"exclusive_rules": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
 }
 
+// resourceAwsSecurityGroupPlanCheck detects groups whose rules are managed
+// both inline and with aws_security_group_rule resources, which keep
+// undoing each other's changes.
+func resourceAwsSecurityGroupPlanCheck(d *schema.ResourceData, meta interface{}) ([]string, error) {
+	m := inlineManagementFromMeta(meta)
+	if m == nil || d.Id() == "" {
+		return nil, nil
+	}
+
+	exclusive := d.Get("exclusive_rules").(bool)
+	if !exclusive && !d.IsConfigured("ingress") && !d.IsConfigured("egress") {
+		return nil, nil
+	}
+
+	return m.Inline(inlineKindSecurityGroup, d.Id(), exclusive)
+}
+
 func resourceAwsSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
@@ -389,6 +426,9 @@ func resourceAwsSecurityGroupDelete(d *schema.ResourceData, meta interface{}) er
 	})
 }
 
+// resourceAwsSecurityGroupRuleHash hashes an ingress or egress rule. The
+// description isn't part of the hash, so that changing only the description
+// of a rule updates it in place instead of revoking and authorizing it.
 func resourceAwsSecurityGroupRuleHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
@@ -440,6 +480,8 @@ func resourceAwsSecurityGroupRuleHash(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
+// resourceAwsSecurityGroupIPPermGather groups the permissions of a security
+// group into rules by protocol, ports and description.
 func resourceAwsSecurityGroupIPPermGather(groupId string, permissions []*ec2.IpPermission, ownerId *string) []map[string]interface{} {
 	ruleMap := make(map[string]map[string]interface{})
 	for _, perm := range permissions {
@@ -451,66 +493,60 @@ func resourceAwsSecurityGroupIPPermGather(groupId string, permissions []*ec2.IpP
 			toPort = *v
 		}
 
-		k := fmt.Sprintf("%s-%d-%d", *perm.IpProtocol, fromPort, toPort)
-		m, ok := ruleMap[k]
-		if !ok {
-			m = make(map[string]interface{})
-			ruleMap[k] = m
+		rule := func(description *string) map[string]interface{} {
+			desc := aws.StringValue(description)
+			k := fmt.Sprintf("%s-%d-%d-%s", *perm.IpProtocol, fromPort, toPort, desc)
+			m, ok := ruleMap[k]
+			if !ok {
+				m = make(map[string]interface{})
+				ruleMap[k] = m
+
+				m["from_port"] = fromPort
+				m["to_port"] = toPort
+				m["protocol"] = *perm.IpProtocol
+				if desc != "" {
+					m["description"] = desc
+				}
+			}
+			return m
 		}
 
-		m["from_port"] = fromPort
-		m["to_port"] = toPort
-		m["protocol"] = *perm.IpProtocol
-
-		if len(perm.IpRanges) > 0 {
+		for _, ip := range perm.IpRanges {
+			m := rule(ip.Description)
 			raw, ok := m["cidr_blocks"]
 			if !ok {
 				raw = make([]string, 0, len(perm.IpRanges))
 			}
-			list := raw.([]string)
-
-			for _, ip := range perm.IpRanges {
-				list = append(list, *ip.CidrIp)
-			}
-
-			m["cidr_blocks"] = list
+			m["cidr_blocks"] = append(raw.([]string), *ip.CidrIp)
 		}
 
-		if len(perm.PrefixListIds) > 0 {
+		for _, pl := range perm.PrefixListIds {
+			m := rule(pl.Description)
 			raw, ok := m["prefix_list_ids"]
 			if !ok {
 				raw = make([]string, 0, len(perm.PrefixListIds))
 			}
-			list := raw.([]string)
-
-			for _, pl := range perm.PrefixListIds {
-				list = append(list, *pl.PrefixListId)
-			}
-
-			m["prefix_list_ids"] = list
+			m["prefix_list_ids"] = append(raw.([]string), *pl.PrefixListId)
 		}
 
-		groups := flattenSecurityGroups(perm.UserIdGroupPairs, ownerId)
-		for i, g := range groups {
+		for _, pair := range perm.UserIdGroupPairs {
+			m := rule(pair.Description)
+			g := flattenSecurityGroups([]*ec2.UserIdGroupPair{pair}, ownerId)[0]
 			if *g.GroupId == groupId {
-				groups[i], groups = groups[len(groups)-1], groups[:len(groups)-1]
 				m["self"] = true
+				continue
 			}
-		}
 
-		if len(groups) > 0 {
 			raw, ok := m["security_groups"]
 			if !ok {
 				raw = schema.NewSet(schema.HashString, nil)
 			}
 			list := raw.(*schema.Set)
 
-			for _, g := range groups {
-				if g.GroupName != nil {
-					list.Add(*g.GroupName)
-				} else {
-					list.Add(*g.GroupId)
-				}
+			if g.GroupName != nil {
+				list.Add(*g.GroupName)
+			} else {
+				list.Add(*g.GroupId)
 			}
 
 			m["security_groups"] = list
@@ -621,6 +657,55 @@ func resourceAwsSecurityGroupUpdateRules(
 				}
 			}
 		}
+
+		// Rules whose description is the only change keep their hash, so
+		// they're neither revoked nor authorized above.
+		oldRules := make(map[int]map[string]interface{})
+		for _, raw := range os.List() {
+			oldRules[os.F(raw)] = raw.(map[string]interface{})
+		}
+		var described []interface{}
+		for _, raw := range ns.List() {
+			m := raw.(map[string]interface{})
+			if o, ok := oldRules[ns.F(raw)]; ok && ruleDescription(o) != ruleDescription(m) {
+				described = append(described, m)
+			}
+		}
+
+		if len(described) > 0 {
+			update, err := expandIPPerms(group, described)
+			if err != nil {
+				return err
+			}
+
+			log.Printf("[DEBUG] Updating security group %#v %s rule descriptions: %#v",
+				group, ruleset, update)
+
+			conn := meta.(*AWSClient).ec2conn
+			if ruleset == "egress" {
+				req := &ec2.UpdateSecurityGroupRuleDescriptionsEgressInput{
+					GroupId:       group.GroupId,
+					IpPermissions: update,
+				}
+				_, err = conn.UpdateSecurityGroupRuleDescriptionsEgress(req)
+			} else {
+				req := &ec2.UpdateSecurityGroupRuleDescriptionsIngressInput{
+					GroupId:       group.GroupId,
+					IpPermissions: update,
+				}
+				if group.VpcId == nil || *group.VpcId == "" {
+					req.GroupId = nil
+					req.GroupName = group.GroupName
+				}
+				_, err = conn.UpdateSecurityGroupRuleDescriptionsIngress(req)
+			}
+
+			if err != nil {
+				return fmt.Errorf(
+					"Error updating security group %s rule descriptions: %s",
+					ruleset, err)
+			}
+		}
 	}
 	return nil
 }
@@ -698,7 +783,7 @@ func matchRules(rType string, local []interface{}, remote []map[string]interface
 			// hash this remote rule and compare it for a match consideration with the
 			// local rule we're examining
 			rHash := idHash(rType, r["protocol"].(string), r["to_port"].(int64), r["from_port"].(int64), remoteSelfVal)
-			if rHash == localHash {
+			if rHash == localHash && ruleDescription(r) == ruleDescription(l) {
 				var numExpectedCidrs, numExpectedPrefixLists, numExpectedSGs, numRemoteCidrs, numRemotePrefixLists, numRemoteSGs int
 				var matchingCidrs []string
 				var matchingSGs []string
@@ -919,6 +1004,14 @@ func matchRules(rType string, local []interface{}, remote []map[string]interface
 	return saves
 }
 
+// ruleDescription returns the description of an ingress or egress rule.
+func ruleDescription(m map[string]interface{}) string {
+	if v, ok := m["description"].(string); ok {
+		return v
+	}
+	return ""
+}
+
 // Creates a unique hash for the type, ports, and protocol, used as a key in
 // maps
 func idHash(rType, protocol string, toPort, fromPort int64, self bool) string {
@@ -21,8 +21,11 @@ func resourceAwsSecurityGroupRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsSecurityGroupRuleCreate,
 		Read:   resourceAwsSecurityGroupRuleRead,
+		Update: resourceAwsSecurityGroupRuleUpdate,
 		Delete: resourceAwsSecurityGroupRuleDelete,
 
+		PlanCheck: resourceAwsSecurityGroupRulePlanCheck,
+
 		SchemaVersion: 2,
 		MigrateState:  resourceAwsSecurityGroupRuleMigrateState,
 
@@ -88,10 +91,30 @@ func resourceAwsSecurityGroupRule() *schema.Resource {
 				ForceNew:      true,
 				ConflictsWith: []string{"cidr_blocks"},
 			},
+
+			"description": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateSecurityGroupRuleDescription,
+			},
 		},
 	}
 }
 
+// resourceAwsSecurityGroupRulePlanCheck detects rules of groups that also
+// declare their rules inline. See resourceAwsSecurityGroupPlanCheck.
+func resourceAwsSecurityGroupRulePlanCheck(d *schema.ResourceData, meta interface{}) ([]string, error) {
+	m := inlineManagementFromMeta(meta)
+	sgId := d.Get("security_group_id").(string)
+	if m == nil || sgId == "" {
+		return nil, nil
+	}
+
+	rule := fmt.Sprintf("%s %s %d-%d", d.Get("type").(string), d.Get("protocol").(string),
+		d.Get("from_port").(int), d.Get("to_port").(int))
+	return m.Standalone(inlineKindSecurityGroup, sgId, rule)
+}
+
 func resourceAwsSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	sg_id := d.Get("security_group_id").(string)
@@ -99,7 +122,7 @@ func resourceAwsSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}
 	awsMutexKV.Lock(sg_id)
 	defer awsMutexKV.Unlock(sg_id)
 
-	sg, err := findResourceSecurityGroup(conn, sg_id)
+	sg, err := findResourceSecurityGroup(conn, nil, sg_id)
 	if err != nil {
 		return err
 	}
@@ -165,7 +188,7 @@ information and instructions for recovery. Error message: %s`, sg_id, awsErr.Mes
 	log.Printf("[DEBUG] Computed group rule ID %s", id)
 
 	retErr := resource.Retry(5*time.Minute, func() *resource.RetryError {
-		sg, err := findResourceSecurityGroup(conn, sg_id)
+		sg, err := findResourceSecurityGroup(conn, nil, sg_id)
 
 		if err != nil {
 			log.Printf("[DEBUG] Error finding Security Group (%s) for Rule (%s): %s", sg_id, id, err)
@@ -205,7 +228,7 @@ information and instructions for recovery. Error message: %s`, sg_id, awsErr.Mes
 func resourceAwsSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	sg_id := d.Get("security_group_id").(string)
-	sg, err := findResourceSecurityGroup(conn, sg_id)
+	sg, err := findResourceSecurityGroup(conn, describeCacheFromMeta(meta), sg_id)
 	if _, notFound := err.(securityGroupNotFound); notFound {
 		// The security group containing this rule no longer exists.
 		d.SetId("")
@@ -254,9 +277,61 @@ func resourceAwsSecurityGroupRuleRead(d *schema.ResourceData, meta interface{})
 	if err := setFromIPPerm(d, sg, p); err != nil {
 		return errwrap.Wrapf("Error setting IP Permission for Security Group Rule: {{err}}", err)
 	}
+	d.Set("description", descriptionFromIPPerm(p, rule, isVPC))
 	return nil
 }
 
+func resourceAwsSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("description") {
+		conn := meta.(*AWSClient).ec2conn
+		sg_id := d.Get("security_group_id").(string)
+
+		awsMutexKV.Lock(sg_id)
+		defer awsMutexKV.Unlock(sg_id)
+
+		sg, err := findResourceSecurityGroup(conn, nil, sg_id)
+		if err != nil {
+			return err
+		}
+
+		perm, err := expandIPPerm(d, sg)
+		if err != nil {
+			return err
+		}
+
+		ruleType := d.Get("type").(string)
+		log.Printf("[DEBUG] Updating description of security group %s %s rule: %s",
+			sg_id, ruleType, perm)
+
+		switch ruleType {
+		case "ingress":
+			req := &ec2.UpdateSecurityGroupRuleDescriptionsIngressInput{
+				GroupId:       sg.GroupId,
+				IpPermissions: []*ec2.IpPermission{perm},
+			}
+			if sg.VpcId == nil || *sg.VpcId == "" {
+				req.GroupId = nil
+				req.GroupName = sg.GroupName
+			}
+			_, err = conn.UpdateSecurityGroupRuleDescriptionsIngress(req)
+		case "egress":
+			req := &ec2.UpdateSecurityGroupRuleDescriptionsEgressInput{
+				GroupId:       sg.GroupId,
+				IpPermissions: []*ec2.IpPermission{perm},
+			}
+			_, err = conn.UpdateSecurityGroupRuleDescriptionsEgress(req)
+		}
+
+		if err != nil {
+			return fmt.Errorf(
+				"Error updating description of security group %s rule: %s",
+				sg_id, err)
+		}
+	}
+
+	return resourceAwsSecurityGroupRuleRead(d, meta)
+}
+
 func resourceAwsSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	sg_id := d.Get("security_group_id").(string)
@@ -264,7 +339,7 @@ func resourceAwsSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}
 	awsMutexKV.Lock(sg_id)
 	defer awsMutexKV.Unlock(sg_id)
 
-	sg, err := findResourceSecurityGroup(conn, sg_id)
+	sg, err := findResourceSecurityGroup(conn, nil, sg_id)
 	if err != nil {
 		return err
 	}
@@ -313,17 +388,22 @@ func resourceAwsSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func findResourceSecurityGroup(conn *ec2.EC2, id string) (*ec2.SecurityGroup, error) {
+// findResourceSecurityGroup reads a security group through cache, which may
+// be nil to always read it from the API.
+func findResourceSecurityGroup(conn *ec2.EC2, cache *describeCache, id string) (*ec2.SecurityGroup, error) {
 	req := &ec2.DescribeSecurityGroupsInput{
 		GroupIds: []*string{aws.String(id)},
 	}
-	resp, err := conn.DescribeSecurityGroups(req)
+	out, err := cache.Read("ec2", "DescribeSecurityGroups", req, func() (interface{}, error) {
+		return conn.DescribeSecurityGroups(req)
+	})
 	if err, ok := err.(awserr.Error); ok && err.Code() == "InvalidGroup.NotFound" {
 		return nil, securityGroupNotFound{id, nil}
 	}
 	if err != nil {
 		return nil, err
 	}
+	resp := out.(*ec2.DescribeSecurityGroupsOutput)
 	if resp == nil {
 		return nil, securityGroupNotFound{id, nil}
 	}
@@ -429,6 +509,43 @@ func findRuleMatch(p *ec2.IpPermission, rules []*ec2.IpPermission, isVPC bool) *
 	return rule
 }
 
+// descriptionFromIPPerm returns the description of the rule p found in
+// rule. Every source of p has the same description, so the first one found
+// is used.
+func descriptionFromIPPerm(p, rule *ec2.IpPermission, isVPC bool) string {
+	for _, ip := range p.IpRanges {
+		for _, rip := range rule.IpRanges {
+			if *ip.CidrIp == *rip.CidrIp {
+				return aws.StringValue(rip.Description)
+			}
+		}
+	}
+
+	for _, pl := range p.PrefixListIds {
+		for _, rpl := range rule.PrefixListIds {
+			if *pl.PrefixListId == *rpl.PrefixListId {
+				return aws.StringValue(rpl.Description)
+			}
+		}
+	}
+
+	for _, pair := range p.UserIdGroupPairs {
+		for _, rpair := range rule.UserIdGroupPairs {
+			if isVPC {
+				if *pair.GroupId == *rpair.GroupId {
+					return aws.StringValue(rpair.Description)
+				}
+			} else {
+				if *pair.GroupName == *rpair.GroupName {
+					return aws.StringValue(rpair.Description)
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
 func ipPermissionIDHash(sg_id, ruleType string, ip *ec2.IpPermission) string {
 	var buf bytes.Buffer
 	buf.WriteString(fmt.Sprintf("%s-", sg_id))
@@ -494,6 +611,11 @@ func expandIPPerm(d *schema.ResourceData, sg *ec2.SecurityGroup) (*ec2.IpPermiss
 	protocol := protocolForValue(d.Get("protocol").(string))
 	perm.IpProtocol = aws.String(protocol)
 
+	var description *string
+	if v, ok := d.GetOk("description"); ok {
+		description = aws.String(v.(string))
+	}
+
 	// build a group map that behaves like a set
 	groups := make(map[string]bool)
 	if raw, ok := d.GetOk("source_security_group_id"); ok {
@@ -523,8 +645,9 @@ func expandIPPerm(d *schema.ResourceData, sg *ec2.SecurityGroup) (*ec2.IpPermiss
 			}
 
 			perm.UserIdGroupPairs[i] = &ec2.UserIdGroupPair{
-				GroupId: aws.String(id),
-				UserId:  aws.String(ownerId),
+				GroupId:     aws.String(id),
+				UserId:      aws.String(ownerId),
+				Description: description,
 			}
 
 			if sg.VpcId == nil || *sg.VpcId == "" {
@@ -543,7 +666,10 @@ func expandIPPerm(d *schema.ResourceData, sg *ec2.SecurityGroup) (*ec2.IpPermiss
 			if !ok {
 				return nil, fmt.Errorf("empty element found in cidr_blocks - consider using the compact function")
 			}
-			perm.IpRanges[i] = &ec2.IpRange{CidrIp: aws.String(cidrIP)}
+			perm.IpRanges[i] = &ec2.IpRange{
+				CidrIp:      aws.String(cidrIP),
+				Description: description,
+			}
 		}
 	}
 
@@ -555,7 +681,10 @@ func expandIPPerm(d *schema.ResourceData, sg *ec2.SecurityGroup) (*ec2.IpPermiss
 			if !ok {
 				return nil, fmt.Errorf("empty element found in prefix_list_ids - consider using the compact function")
 			}
-			perm.PrefixListIds[i] = &ec2.PrefixListId{PrefixListId: aws.String(prefixListID)}
+			perm.PrefixListIds[i] = &ec2.PrefixListId{
+				PrefixListId: aws.String(prefixListID),
+				Description:  description,
+			}
 		}
 	}
 
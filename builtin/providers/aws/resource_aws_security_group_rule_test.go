@@ -493,6 +493,36 @@ func TestAccAWSSecurityGroupRule_PrefixListEgress(t *testing.T) {
 	})
 }
 
+func TestAccAWSSecurityGroupRule_Description(t *testing.T) {
+	var group ec2.SecurityGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSSecurityGroupRuleDescriptionConfig("Egress to the VPC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupRuleExists("aws_security_group.web", &group),
+					testAccCheckAWSSecurityGroupRuleAttributes("aws_security_group_rule.egress_1", &group, nil, "egress"),
+					resource.TestCheckResourceAttr(
+						"aws_security_group_rule.egress_1", "description", "Egress to the VPC"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSSecurityGroupRuleDescriptionConfig("Egress to the internal network"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupRuleExists("aws_security_group.web", &group),
+					testAccCheckAWSSecurityGroupRuleAttributes("aws_security_group_rule.egress_1", &group, nil, "egress"),
+					resource.TestCheckResourceAttr(
+						"aws_security_group_rule.egress_1", "description", "Egress to the internal network"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSSecurityGroupRuleDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ec2conn
 
@@ -768,6 +798,30 @@ resource "aws_security_group_rule" "egress_1" {
 }
 `
 
+func testAccAWSSecurityGroupRuleDescriptionConfig(description string) string {
+	return fmt.Sprintf(`
+resource "aws_security_group" "web" {
+  name = "terraform_acceptance_test_desc_example"
+  description = "Used in the terraform acceptance tests"
+
+  tags {
+    Name = "tf-acc-test"
+  }
+}
+
+resource "aws_security_group_rule" "egress_1" {
+  type = "egress"
+  protocol = "tcp"
+  from_port = 80
+  to_port = 8000
+  cidr_blocks = ["10.0.0.0/8"]
+  description = "%s"
+
+  security_group_id = "${aws_security_group.web.id}"
+}
+`, description)
+}
+
 const testAccAWSSecurityGroupRuleConfigMultiIngress = `
 resource "aws_security_group" "web" {
   name = "terraform_acceptance_test_example_2"
@@ -254,6 +254,109 @@ func TestResourceAwsSecurityGroupIPPermGather(t *testing.T) {
 	}
 }
 
+func TestResourceAwsSecurityGroupIPPermGather_description(t *testing.T) {
+	raw := []*ec2.IpPermission{
+		&ec2.IpPermission{
+			IpProtocol: aws.String("tcp"),
+			FromPort:   aws.Int64(int64(80)),
+			ToPort:     aws.Int64(int64(80)),
+			IpRanges: []*ec2.IpRange{
+				&ec2.IpRange{CidrIp: aws.String("10.0.0.0/8"), Description: aws.String("internal")},
+				&ec2.IpRange{CidrIp: aws.String("192.168.0.0/16"), Description: aws.String("internal")},
+				&ec2.IpRange{CidrIp: aws.String("0.0.0.0/0")},
+			},
+			UserIdGroupPairs: []*ec2.UserIdGroupPair{
+				&ec2.UserIdGroupPair{GroupId: aws.String("sg-11111"), Description: aws.String("self")},
+			},
+		},
+	}
+
+	out := resourceAwsSecurityGroupIPPermGather("sg-11111", raw, aws.String("12345"))
+	if len(out) != 3 {
+		t.Fatalf("expected a rule per description, got: %#v", out)
+	}
+
+	for _, r := range out {
+		switch ruleDescription(r) {
+		case "internal":
+			if !reflect.DeepEqual(r["cidr_blocks"], []string{"10.0.0.0/8", "192.168.0.0/16"}) {
+				t.Fatalf("bad: %#v", r)
+			}
+		case "self":
+			if r["self"] != true || r["cidr_blocks"] != nil {
+				t.Fatalf("bad: %#v", r)
+			}
+		case "":
+			if !reflect.DeepEqual(r["cidr_blocks"], []string{"0.0.0.0/0"}) {
+				t.Fatalf("bad: %#v", r)
+			}
+		default:
+			t.Fatalf("bad: %#v", r)
+		}
+	}
+}
+
+func TestMatchRules_description(t *testing.T) {
+	local := []interface{}{
+		map[string]interface{}{
+			"protocol":    "tcp",
+			"from_port":   80,
+			"to_port":     80,
+			"cidr_blocks": []interface{}{"10.0.0.0/8"},
+			"description": "old",
+		},
+	}
+	remote := []map[string]interface{}{
+		map[string]interface{}{
+			"protocol":    "tcp",
+			"from_port":   int64(80),
+			"to_port":     int64(80),
+			"cidr_blocks": []string{"10.0.0.0/8"},
+			"description": "new",
+		},
+	}
+
+	// A rule whose description was changed outside of Terraform is read
+	// with its new description
+	saves := matchRules("ingress", local, remote)
+	if len(saves) != 1 || ruleDescription(saves[0]) != "new" {
+		t.Fatalf("bad: %#v", saves)
+	}
+}
+
+func TestAccAWSSecurityGroup_ruleDescription(t *testing.T) {
+	var group ec2.SecurityGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSSecurityGroupConfigRuleDescription("Ingress from the VPC", "Egress to the VPC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists("aws_security_group.web", &group),
+					resource.TestCheckResourceAttr(
+						"aws_security_group.web", "ingress.3629188364.description", "Ingress from the VPC"),
+					resource.TestCheckResourceAttr(
+						"aws_security_group.web", "egress.3629188364.description", "Egress to the VPC"),
+				),
+			},
+			// Changing only the descriptions keeps the rules
+			resource.TestStep{
+				Config: testAccAWSSecurityGroupConfigRuleDescription("Ingress from the network", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists("aws_security_group.web", &group),
+					resource.TestCheckResourceAttr(
+						"aws_security_group.web", "ingress.3629188364.description", "Ingress from the network"),
+					resource.TestCheckResourceAttr(
+						"aws_security_group.web", "egress.3629188364.description", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSSecurityGroup_basic(t *testing.T) {
 	var group ec2.SecurityGroup
 
@@ -1178,6 +1281,40 @@ resource "aws_security_group" "web" {
 }
 `
 
+func testAccAWSSecurityGroupConfigRuleDescription(ingress, egress string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "foo" {
+  cidr_block = "10.1.0.0/16"
+}
+
+resource "aws_security_group" "web" {
+  name = "terraform_acceptance_test_desc_example"
+  description = "Used in the terraform acceptance tests"
+  vpc_id = "${aws_vpc.foo.id}"
+
+  ingress {
+    protocol = "6"
+    from_port = 80
+    to_port = 8000
+    cidr_blocks = ["10.0.0.0/8"]
+    description = "%s"
+  }
+
+  egress {
+    protocol = "tcp"
+    from_port = 80
+    to_port = 8000
+    cidr_blocks = ["10.0.0.0/8"]
+    description = "%s"
+  }
+
+  tags {
+    Name = "tf-acc-test"
+  }
+}
+`, ingress, egress)
+}
+
 const testAccAWSSecurityGroupConfigChange = `
 resource "aws_vpc" "foo" {
   cidr_block = "10.1.0.0/16"
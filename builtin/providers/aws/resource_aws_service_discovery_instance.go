@@ -0,0 +1,109 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsServiceDiscoveryInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceDiscoveryInstancePut,
+		Read:   resourceAwsServiceDiscoveryInstanceRead,
+		Update: resourceAwsServiceDiscoveryInstancePut,
+		Delete: resourceAwsServiceDiscoveryInstanceDelete,
+
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"attributes": {
+				Type:     schema.TypeMap,
+				Required: true,
+			},
+		},
+	}
+}
+
+// resourceAwsServiceDiscoveryInstancePut handles both create and update, as
+// RegisterInstance replaces the attributes of an existing registration.
+func resourceAwsServiceDiscoveryInstancePut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	instanceId := d.Get("instance_id").(string)
+	input := &servicediscovery.RegisterInstanceInput{
+		ServiceId:        aws.String(d.Get("service_id").(string)),
+		InstanceId:       aws.String(instanceId),
+		Attributes:       stringMapToPointers(d.Get("attributes").(map[string]interface{})),
+		CreatorRequestId: aws.String(resource.UniqueId()),
+	}
+
+	log.Printf("[DEBUG] Registering Service Discovery Instance: %s", input)
+	resp, err := conn.RegisterInstance(input)
+	if err != nil {
+		return fmt.Errorf("Error registering Service Discovery Instance (%s): %s", instanceId, err)
+	}
+
+	if _, err := waitForServiceDiscoveryOperation(conn, *resp.OperationId); err != nil {
+		return err
+	}
+
+	d.SetId(instanceId)
+
+	return resourceAwsServiceDiscoveryInstanceRead(d, meta)
+}
+
+func resourceAwsServiceDiscoveryInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	resp, err := conn.GetInstance(&servicediscovery.GetInstanceInput{
+		ServiceId:  aws.String(d.Get("service_id").(string)),
+		InstanceId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, servicediscovery.ErrCodeInstanceNotFound, "") ||
+			isAWSErr(err, servicediscovery.ErrCodeServiceNotFound, "") {
+			log.Printf("[WARN] Service Discovery Instance (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("instance_id", resp.Instance.Id)
+	if err := d.Set("attributes", pointersMapToStringList(resp.Instance.Attributes)); err != nil {
+		return fmt.Errorf("Error setting attributes: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceDiscoveryInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	log.Printf("[DEBUG] Deregistering Service Discovery Instance: %s", d.Id())
+	resp, err := conn.DeregisterInstance(&servicediscovery.DeregisterInstanceInput{
+		ServiceId:  aws.String(d.Get("service_id").(string)),
+		InstanceId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, servicediscovery.ErrCodeInstanceNotFound, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deregistering Service Discovery Instance (%s): %s", d.Id(), err)
+	}
+
+	_, err = waitForServiceDiscoveryOperation(conn, *resp.OperationId)
+	return err
+}
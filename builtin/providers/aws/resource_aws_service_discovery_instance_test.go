@@ -0,0 +1,116 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceDiscoveryInstance_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resourceName := "aws_service_discovery_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceDiscoveryInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccServiceDiscoveryInstanceConfig(rName, "10.0.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceDiscoveryInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "instance_id", "tf-acc-"+rName),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.AWS_INSTANCE_IPV4", "10.0.0.1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccServiceDiscoveryInstanceConfig(rName, "10.0.0.2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceDiscoveryInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attributes.AWS_INSTANCE_IPV4", "10.0.0.2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsServiceDiscoveryInstanceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sdconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_service_discovery_instance" {
+			continue
+		}
+
+		_, err := conn.GetInstance(&servicediscovery.GetInstanceInput{
+			ServiceId:  aws.String(rs.Primary.Attributes["service_id"]),
+			InstanceId: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("Service Discovery Instance %s still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, servicediscovery.ErrCodeInstanceNotFound, "") &&
+			!isAWSErr(err, servicediscovery.ErrCodeServiceNotFound, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsServiceDiscoveryInstanceExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sdconn
+		_, err := conn.GetInstance(&servicediscovery.GetInstanceInput{
+			ServiceId:  aws.String(rs.Primary.Attributes["service_id"]),
+			InstanceId: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
+func testAccServiceDiscoveryInstanceConfig(rName, ip string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_service_discovery_private_dns_namespace" "test" {
+  name = "%s.example.com"
+  vpc  = "${aws_vpc.test.id}"
+}
+
+resource "aws_service_discovery_service" "test" {
+  name = "tf-acc-%s"
+
+  dns_config {
+    namespace_id = "${aws_service_discovery_private_dns_namespace.test.id}"
+
+    dns_records {
+      ttl  = 10
+      type = "A"
+    }
+  }
+}
+
+resource "aws_service_discovery_instance" "test" {
+  service_id  = "${aws_service_discovery_service.test.id}"
+  instance_id = "tf-acc-%s"
+
+  attributes {
+    AWS_INSTANCE_IPV4 = "%s"
+  }
+}
+`, rName, rName, rName, ip)
+}
@@ -0,0 +1,159 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsServiceDiscoveryPrivateDnsNamespace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceDiscoveryPrivateDnsNamespaceCreate,
+		Read:   resourceAwsServiceDiscoveryPrivateDnsNamespaceRead,
+		Delete: resourceAwsServiceDiscoveryPrivateDnsNamespaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hosted_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceDiscoveryPrivateDnsNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	input := &servicediscovery.CreatePrivateDnsNamespaceInput{
+		Name:             aws.String(d.Get("name").(string)),
+		Vpc:              aws.String(d.Get("vpc").(string)),
+		CreatorRequestId: aws.String(resource.UniqueId()),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Service Discovery Private DNS Namespace: %s", input)
+	resp, err := conn.CreatePrivateDnsNamespace(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Service Discovery Private DNS Namespace: %s", err)
+	}
+
+	op, err := waitForServiceDiscoveryOperation(conn, *resp.OperationId)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(aws.StringValue(op.Targets[servicediscovery.OperationTargetTypeNamespace]))
+
+	return resourceAwsServiceDiscoveryPrivateDnsNamespaceRead(d, meta)
+}
+
+func resourceAwsServiceDiscoveryPrivateDnsNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	resp, err := conn.GetNamespace(&servicediscovery.GetNamespaceInput{
+		Id: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, servicediscovery.ErrCodeNamespaceNotFound, "") {
+			log.Printf("[WARN] Service Discovery Private DNS Namespace (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	ns := resp.Namespace
+	d.Set("name", ns.Name)
+	d.Set("description", ns.Description)
+	d.Set("arn", ns.Arn)
+	if ns.Properties != nil && ns.Properties.DnsProperties != nil {
+		d.Set("hosted_zone", ns.Properties.DnsProperties.HostedZoneId)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceDiscoveryPrivateDnsNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	log.Printf("[DEBUG] Deleting Service Discovery Private DNS Namespace: %s", d.Id())
+	resp, err := conn.DeleteNamespace(&servicediscovery.DeleteNamespaceInput{
+		Id: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, servicediscovery.ErrCodeNamespaceNotFound, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Service Discovery Private DNS Namespace (%s): %s", d.Id(), err)
+	}
+
+	_, err = waitForServiceDiscoveryOperation(conn, *resp.OperationId)
+	return err
+}
+
+// waitForServiceDiscoveryOperation polls an asynchronous Service Discovery
+// operation until it completes, returning an error if the operation failed.
+func waitForServiceDiscoveryOperation(conn *servicediscovery.ServiceDiscovery, operationId string) (*servicediscovery.Operation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{servicediscovery.OperationStatusSubmitted, servicediscovery.OperationStatusPending},
+		Target:  []string{servicediscovery.OperationStatusSuccess},
+		Refresh: serviceDiscoveryOperationRefreshFunc(conn, operationId),
+		Timeout: 5 * time.Minute,
+		Delay:   5 * time.Second,
+	}
+
+	op, err := stateConf.WaitForState()
+	if err != nil {
+		return nil, fmt.Errorf("Error waiting for Service Discovery operation (%s) to complete: %s", operationId, err)
+	}
+
+	return op.(*servicediscovery.Operation), nil
+}
+
+func serviceDiscoveryOperationRefreshFunc(conn *servicediscovery.ServiceDiscovery, operationId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.GetOperation(&servicediscovery.GetOperationInput{
+			OperationId: aws.String(operationId),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		op := resp.Operation
+		status := aws.StringValue(op.Status)
+		if status == servicediscovery.OperationStatusFail {
+			return op, status, fmt.Errorf("%s: %s", aws.StringValue(op.ErrorCode), aws.StringValue(op.ErrorMessage))
+		}
+
+		return op, status, nil
+	}
+}
@@ -0,0 +1,91 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceDiscoveryPrivateDnsNamespace_basic(t *testing.T) {
+	rName := acctest.RandString(5) + ".example.com"
+	resourceName := "aws_service_discovery_private_dns_namespace.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceDiscoveryPrivateDnsNamespaceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccServiceDiscoveryPrivateDnsNamespaceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceDiscoveryPrivateDnsNamespaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "hosted_zone"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"vpc"},
+			},
+		},
+	})
+}
+
+func testAccCheckAwsServiceDiscoveryPrivateDnsNamespaceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sdconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_service_discovery_private_dns_namespace" {
+			continue
+		}
+
+		_, err := conn.GetNamespace(&servicediscovery.GetNamespaceInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("Service Discovery Private DNS Namespace %s still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, servicediscovery.ErrCodeNamespaceNotFound, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsServiceDiscoveryPrivateDnsNamespaceExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sdconn
+		_, err := conn.GetNamespace(&servicediscovery.GetNamespaceInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
+func testAccServiceDiscoveryPrivateDnsNamespaceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_service_discovery_private_dns_namespace" "test" {
+  name        = "%s"
+  description = "test"
+  vpc         = "${aws_vpc.test.id}"
+}
+`, rName)
+}
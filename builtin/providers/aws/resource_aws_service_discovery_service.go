@@ -0,0 +1,335 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsServiceDiscoveryService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceDiscoveryServiceCreate,
+		Read:   resourceAwsServiceDiscoveryServiceRead,
+		Update: resourceAwsServiceDiscoveryServiceUpdate,
+		Delete: resourceAwsServiceDiscoveryServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dns_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"namespace_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"dns_records": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ttl": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateServiceDiscoveryRecordType,
+									},
+								},
+							},
+						},
+						"routing_policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      servicediscovery.RoutingPolicyMultivalue,
+							ValidateFunc: validateServiceDiscoveryRoutingPolicy,
+						},
+					},
+				},
+			},
+			"health_check_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failure_threshold": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"resource_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateServiceDiscoveryHealthCheckType,
+						},
+					},
+				},
+			},
+			"health_check_custom_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failure_threshold": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceDiscoveryServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	input := &servicediscovery.CreateServiceInput{
+		Name:             aws.String(d.Get("name").(string)),
+		CreatorRequestId: aws.String(resource.UniqueId()),
+		DnsConfig:        expandServiceDiscoveryDnsConfig(d.Get("dns_config").([]interface{})[0].(map[string]interface{})),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("health_check_config"); ok {
+		input.HealthCheckConfig = expandServiceDiscoveryHealthCheckConfig(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("health_check_custom_config"); ok {
+		input.HealthCheckCustomConfig = expandServiceDiscoveryHealthCheckCustomConfig(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Service Discovery Service: %s", input)
+	resp, err := conn.CreateService(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Service Discovery Service: %s", err)
+	}
+
+	d.SetId(*resp.Service.Id)
+
+	return resourceAwsServiceDiscoveryServiceRead(d, meta)
+}
+
+func resourceAwsServiceDiscoveryServiceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	resp, err := conn.GetService(&servicediscovery.GetServiceInput{
+		Id: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, servicediscovery.ErrCodeServiceNotFound, "") {
+			log.Printf("[WARN] Service Discovery Service (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	service := resp.Service
+	d.Set("name", service.Name)
+	d.Set("description", service.Description)
+	d.Set("arn", service.Arn)
+	if err := d.Set("dns_config", flattenServiceDiscoveryDnsConfig(service.DnsConfig, service.NamespaceId)); err != nil {
+		return fmt.Errorf("Error setting dns_config: %s", err)
+	}
+	if err := d.Set("health_check_config", flattenServiceDiscoveryHealthCheckConfig(service.HealthCheckConfig)); err != nil {
+		return fmt.Errorf("Error setting health_check_config: %s", err)
+	}
+	if err := d.Set("health_check_custom_config", flattenServiceDiscoveryHealthCheckCustomConfig(service.HealthCheckCustomConfig)); err != nil {
+		return fmt.Errorf("Error setting health_check_custom_config: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceDiscoveryServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	// UpdateService replaces the whole service definition, so the full set of
+	// updatable fields is always sent.
+	dnsConfig := d.Get("dns_config").([]interface{})[0].(map[string]interface{})
+	change := &servicediscovery.ServiceChange{
+		DnsConfig: &servicediscovery.DnsConfigChange{
+			DnsRecords: expandServiceDiscoveryDnsRecords(dnsConfig["dns_records"].([]interface{})),
+		},
+	}
+	if v, ok := d.GetOk("description"); ok {
+		change.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("health_check_config"); ok {
+		change.HealthCheckConfig = expandServiceDiscoveryHealthCheckConfig(v.([]interface{}))
+	}
+
+	input := &servicediscovery.UpdateServiceInput{
+		Id:      aws.String(d.Id()),
+		Service: change,
+	}
+
+	log.Printf("[DEBUG] Updating Service Discovery Service: %s", input)
+	resp, err := conn.UpdateService(input)
+	if err != nil {
+		return fmt.Errorf("Error updating Service Discovery Service (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitForServiceDiscoveryOperation(conn, *resp.OperationId); err != nil {
+		return err
+	}
+
+	return resourceAwsServiceDiscoveryServiceRead(d, meta)
+}
+
+func resourceAwsServiceDiscoveryServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	log.Printf("[DEBUG] Deleting Service Discovery Service: %s", d.Id())
+	_, err := conn.DeleteService(&servicediscovery.DeleteServiceInput{
+		Id: aws.String(d.Id()),
+	})
+	if err != nil && !isAWSErr(err, servicediscovery.ErrCodeServiceNotFound, "") {
+		return fmt.Errorf("Error deleting Service Discovery Service (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandServiceDiscoveryDnsConfig(configured map[string]interface{}) *servicediscovery.DnsConfig {
+	return &servicediscovery.DnsConfig{
+		NamespaceId:   aws.String(configured["namespace_id"].(string)),
+		DnsRecords:    expandServiceDiscoveryDnsRecords(configured["dns_records"].([]interface{})),
+		RoutingPolicy: aws.String(configured["routing_policy"].(string)),
+	}
+}
+
+func expandServiceDiscoveryDnsRecords(configured []interface{}) []*servicediscovery.DnsRecord {
+	records := make([]*servicediscovery.DnsRecord, 0, len(configured))
+	for _, raw := range configured {
+		m := raw.(map[string]interface{})
+		records = append(records, &servicediscovery.DnsRecord{
+			TTL:  aws.Int64(int64(m["ttl"].(int))),
+			Type: aws.String(m["type"].(string)),
+		})
+	}
+	return records
+}
+
+func expandServiceDiscoveryHealthCheckConfig(configured []interface{}) *servicediscovery.HealthCheckConfig {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+	m := configured[0].(map[string]interface{})
+
+	config := &servicediscovery.HealthCheckConfig{}
+	if v, ok := m["failure_threshold"].(int); ok && v > 0 {
+		config.FailureThreshold = aws.Int64(int64(v))
+	}
+	if v, ok := m["resource_path"].(string); ok && v != "" {
+		config.ResourcePath = aws.String(v)
+	}
+	if v, ok := m["type"].(string); ok && v != "" {
+		config.Type = aws.String(v)
+	}
+	return config
+}
+
+func expandServiceDiscoveryHealthCheckCustomConfig(configured []interface{}) *servicediscovery.HealthCheckCustomConfig {
+	if len(configured) == 0 {
+		return nil
+	}
+
+	config := &servicediscovery.HealthCheckCustomConfig{}
+	if m, ok := configured[0].(map[string]interface{}); ok {
+		if v, ok := m["failure_threshold"].(int); ok && v > 0 {
+			config.FailureThreshold = aws.Int64(int64(v))
+		}
+	}
+	return config
+}
+
+func flattenServiceDiscoveryDnsConfig(config *servicediscovery.DnsConfig, namespaceId *string) []map[string]interface{} {
+	if config == nil {
+		return nil
+	}
+
+	// The namespace ID within DnsConfig is deprecated in favour of the
+	// service-level field, so prefer the latter when it is set.
+	if namespaceId == nil {
+		namespaceId = config.NamespaceId
+	}
+
+	records := make([]map[string]interface{}, 0, len(config.DnsRecords))
+	for _, r := range config.DnsRecords {
+		records = append(records, map[string]interface{}{
+			"ttl":  int(aws.Int64Value(r.TTL)),
+			"type": aws.StringValue(r.Type),
+		})
+	}
+
+	return []map[string]interface{}{
+		{
+			"namespace_id":   aws.StringValue(namespaceId),
+			"dns_records":    records,
+			"routing_policy": aws.StringValue(config.RoutingPolicy),
+		},
+	}
+}
+
+func flattenServiceDiscoveryHealthCheckConfig(config *servicediscovery.HealthCheckConfig) []map[string]interface{} {
+	if config == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"failure_threshold": int(aws.Int64Value(config.FailureThreshold)),
+			"resource_path":     aws.StringValue(config.ResourcePath),
+			"type":              aws.StringValue(config.Type),
+		},
+	}
+}
+
+func flattenServiceDiscoveryHealthCheckCustomConfig(config *servicediscovery.HealthCheckCustomConfig) []map[string]interface{} {
+	if config == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"failure_threshold": int(aws.Int64Value(config.FailureThreshold)),
+		},
+	}
+}
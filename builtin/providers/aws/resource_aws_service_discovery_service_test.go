@@ -0,0 +1,116 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceDiscoveryService_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resourceName := "aws_service_discovery_service.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceDiscoveryServiceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccServiceDiscoveryServiceConfig(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceDiscoveryServiceExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "dns_config.0.dns_records.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dns_config.0.dns_records.0.type", "A"),
+					resource.TestCheckResourceAttr(resourceName, "dns_config.0.dns_records.0.ttl", "5"),
+					resource.TestCheckResourceAttr(resourceName, "dns_config.0.routing_policy", "MULTIVALUE"),
+					resource.TestCheckResourceAttr(resourceName, "health_check_custom_config.0.failure_threshold", "5"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccServiceDiscoveryServiceConfig(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceDiscoveryServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "dns_config.0.dns_records.0.ttl", "10"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsServiceDiscoveryServiceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sdconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_service_discovery_service" {
+			continue
+		}
+
+		_, err := conn.GetService(&servicediscovery.GetServiceInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("Service Discovery Service %s still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, servicediscovery.ErrCodeServiceNotFound, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsServiceDiscoveryServiceExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sdconn
+		_, err := conn.GetService(&servicediscovery.GetServiceInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
+func testAccServiceDiscoveryServiceConfig(rName string, ttl int) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_service_discovery_private_dns_namespace" "test" {
+  name = "%s.example.com"
+  vpc  = "${aws_vpc.test.id}"
+}
+
+resource "aws_service_discovery_service" "test" {
+  name = "tf-acc-%s"
+
+  dns_config {
+    namespace_id = "${aws_service_discovery_private_dns_namespace.test.id}"
+
+    dns_records {
+      ttl  = %d
+      type = "A"
+    }
+  }
+
+  health_check_custom_config {
+    failure_threshold = 5
+  }
+}
+`, rName, rName, ttl)
+}
@@ -0,0 +1,92 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSfnActivity() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSfnActivityCreate,
+		Read:   resourceAwsSfnActivityRead,
+		Delete: resourceAwsSfnActivityDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSfnActivityName,
+			},
+
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSfnActivityCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sfnconn
+
+	input := &sfn.CreateActivityInput{
+		Name: aws.String(d.Get("name").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Step Function activity: %s", input)
+	out, err := conn.CreateActivity(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Step Function activity: %s", err)
+	}
+
+	d.SetId(aws.StringValue(out.ActivityArn))
+	return resourceAwsSfnActivityRead(d, meta)
+}
+
+func resourceAwsSfnActivityRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sfnconn
+
+	out, err := conn.DescribeActivity(&sfn.DescribeActivityInput{
+		ActivityArn: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, sfn.ErrCodeActivityDoesNotExist, "") {
+			log.Printf("[WARN] Step Function activity %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Step Function activity %s: %s", d.Id(), err)
+	}
+
+	d.Set("name", out.Name)
+	if out.CreationDate != nil {
+		d.Set("creation_date", out.CreationDate.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceAwsSfnActivityDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sfnconn
+
+	log.Printf("[DEBUG] Deleting Step Function activity %s", d.Id())
+	_, err := conn.DeleteActivity(&sfn.DeleteActivityInput{
+		ActivityArn: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting Step Function activity %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
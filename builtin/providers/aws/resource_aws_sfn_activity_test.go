@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSfnActivity_basic(t *testing.T) {
+	name := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSfnActivityDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSSfnActivityBasicConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSfnActivityExists("aws_sfn_activity.foo"),
+					resource.TestCheckResourceAttr("aws_sfn_activity.foo", "name", name),
+					resource.TestCheckResourceAttrSet("aws_sfn_activity.foo", "creation_date"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSfnActivity_importBasic(t *testing.T) {
+	name := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSfnActivityDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSSfnActivityBasicConfig(name),
+			},
+			resource.TestStep{
+				ResourceName:      "aws_sfn_activity.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSfnActivityExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Step Function activity ID set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sfnconn
+		_, err := conn.DescribeActivity(&sfn.DescribeActivityInput{
+			ActivityArn: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
+func testAccCheckAWSSfnActivityDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sfnconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sfn_activity" {
+			continue
+		}
+
+		_, err := conn.DescribeActivity(&sfn.DescribeActivityInput{
+			ActivityArn: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("Step Function activity %s still exists", rs.Primary.ID)
+		}
+		if !isAWSErr(err, sfn.ErrCodeActivityDoesNotExist, "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSSfnActivityBasicConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_sfn_activity" "foo" {
+  name = "%s"
+}
+`, name)
+}
@@ -0,0 +1,157 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSfnStateMachine() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSfnStateMachineCreate,
+		Read:   resourceAwsSfnStateMachineRead,
+		Update: resourceAwsSfnStateMachineUpdate,
+		Delete: resourceAwsSfnStateMachineDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSfnStateMachineName,
+			},
+
+			"definition": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+
+			"role_arn": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSfnStateMachineCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sfnconn
+
+	input := &sfn.CreateStateMachineInput{
+		Definition: aws.String(d.Get("definition").(string)),
+		Name:       aws.String(d.Get("name").(string)),
+		RoleArn:    aws.String(d.Get("role_arn").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Step Function state machine: %s", input)
+
+	var out *sfn.CreateStateMachineOutput
+	// A freshly created IAM role takes a while before Step Functions is
+	// allowed to assume it.
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		var err error
+		out, err = conn.CreateStateMachine(input)
+		if err != nil {
+			if isAWSErr(err, "AccessDeniedException", "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating Step Function state machine: %s", err)
+	}
+
+	d.SetId(aws.StringValue(out.StateMachineArn))
+	return resourceAwsSfnStateMachineRead(d, meta)
+}
+
+func resourceAwsSfnStateMachineRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sfnconn
+
+	out, err := conn.DescribeStateMachine(&sfn.DescribeStateMachineInput{
+		StateMachineArn: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, sfn.ErrCodeStateMachineDoesNotExist, "") {
+			log.Printf("[WARN] Step Function state machine %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Step Function state machine %s: %s", d.Id(), err)
+	}
+
+	if aws.StringValue(out.Status) == sfn.StateMachineStatusDeleting {
+		log.Printf("[WARN] Step Function state machine %s is being deleted, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("definition", out.Definition)
+	d.Set("name", out.Name)
+	d.Set("role_arn", out.RoleArn)
+	d.Set("status", out.Status)
+	if out.CreationDate != nil {
+		d.Set("creation_date", out.CreationDate.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceAwsSfnStateMachineUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sfnconn
+
+	input := &sfn.UpdateStateMachineInput{
+		StateMachineArn: aws.String(d.Id()),
+	}
+	if d.HasChange("definition") {
+		input.Definition = aws.String(d.Get("definition").(string))
+	}
+	if d.HasChange("role_arn") {
+		input.RoleArn = aws.String(d.Get("role_arn").(string))
+	}
+
+	log.Printf("[DEBUG] Updating Step Function state machine: %s", input)
+	if _, err := conn.UpdateStateMachine(input); err != nil {
+		return fmt.Errorf("Error updating Step Function state machine %s: %s", d.Id(), err)
+	}
+
+	return resourceAwsSfnStateMachineRead(d, meta)
+}
+
+func resourceAwsSfnStateMachineDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sfnconn
+
+	log.Printf("[DEBUG] Deleting Step Function state machine %s", d.Id())
+	_, err := conn.DeleteStateMachine(&sfn.DeleteStateMachineInput{
+		StateMachineArn: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting Step Function state machine %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsSpotFleetRequest() *schema.Resource {
@@ -252,12 +253,20 @@ func resourceAwsSpotFleetRequest() *schema.Resource {
 				Optional: true,
 				Default:  "lowestPrice",
 				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.AllocationStrategyLowestPrice,
+					ec2.AllocationStrategyDiversified,
+				}, false),
 			},
 			"excess_capacity_termination_policy": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
 				Default:  "Default",
 				ForceNew: false,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.ExcessCapacityTerminationPolicyDefault,
+					ec2.ExcessCapacityTerminationPolicyNoTermination,
+				}, true),
 			},
 			"spot_price": &schema.Schema{
 				Type:     schema.TypeString,
@@ -270,14 +279,16 @@ func resourceAwsSpotFleetRequest() *schema.Resource {
 				ForceNew: true,
 			},
 			"valid_from": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateASGScheduleTimestamp,
 			},
 			"valid_until": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateASGScheduleTimestamp,
 			},
 			"spot_request_state": &schema.Schema{
 				Type:     schema.TypeString,
@@ -666,6 +677,12 @@ func resourceAwsSpotFleetRequestRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
+	if len(resp.SpotFleetRequestConfigs) == 0 {
+		log.Printf("[WARN] Spot Fleet Request (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	sfr := resp.SpotFleetRequestConfigs[0]
 
 	// if the request is cancelled, then it is gone
@@ -909,36 +926,49 @@ func resourceAwsSpotFleetRequestUpdate(d *schema.ResourceData, meta interface{})
 	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_ModifySpotFleetRequest.html
 	conn := meta.(*AWSClient).ec2conn
 
-	d.Partial(true)
-
 	req := &ec2.ModifySpotFleetRequestInput{
 		SpotFleetRequestId: aws.String(d.Id()),
 	}
 
-	if val, ok := d.GetOk("target_capacity"); ok {
-		req.TargetCapacity = aws.Int64(int64(val.(int)))
+	if d.HasChange("target_capacity") {
+		req.TargetCapacity = aws.Int64(int64(d.Get("target_capacity").(int)))
 	}
 
-	if val, ok := d.GetOk("excess_capacity_termination_policy"); ok {
-		req.ExcessCapacityTerminationPolicy = aws.String(val.(string))
+	if d.HasChange("excess_capacity_termination_policy") {
+		req.ExcessCapacityTerminationPolicy = aws.String(d.Get("excess_capacity_termination_policy").(string))
 	}
 
-	resp, err := conn.ModifySpotFleetRequest(req)
-	if err == nil && aws.BoolValue(resp.Return) {
-		// TODO: rollback to old values?
+	log.Printf("[DEBUG] Modifying Spot Fleet Request: %s", req)
+	if _, err := conn.ModifySpotFleetRequest(req); err != nil {
+		return fmt.Errorf("Error modifying spot fleet request (%s): %s", d.Id(), err)
 	}
 
-	return nil
+	log.Println("[INFO] Waiting for Spot Fleet Request to be modified")
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"modifying"},
+		Target:     []string{"active"},
+		Refresh:    resourceAwsSpotFleetRequestStateRefreshFunc(d, meta),
+		Timeout:    10 * time.Minute,
+		MinTimeout: 10 * time.Second,
+		Delay:      5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return err
+	}
+
+	return resourceAwsSpotFleetRequestRead(d, meta)
 }
 
 func resourceAwsSpotFleetRequestDelete(d *schema.ResourceData, meta interface{}) error {
 	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_CancelSpotFleetRequests.html
 	conn := meta.(*AWSClient).ec2conn
+	terminateInstances := d.Get("terminate_instances_with_expiration").(bool)
 
 	log.Printf("[INFO] Cancelling spot fleet request: %s", d.Id())
 	resp, err := conn.CancelSpotFleetRequests(&ec2.CancelSpotFleetRequestsInput{
 		SpotFleetRequestIds: []*string{aws.String(d.Id())},
-		TerminateInstances:  aws.Bool(d.Get("terminate_instances_with_expiration").(bool)),
+		TerminateInstances:  aws.Bool(terminateInstances),
 	})
 
 	if err != nil {
@@ -953,15 +983,43 @@ func resourceAwsSpotFleetRequestDelete(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	// A request that's already gone, or already being cancelled, doesn't
+	// need to be cancelled again
+	for _, u := range resp.UnsuccessfulFleetRequests {
+		if aws.StringValue(u.SpotFleetRequestId) != d.Id() || u.Error == nil {
+			continue
+		}
+
+		switch aws.StringValue(u.Error.Code) {
+		case ec2.CancelBatchErrorCodeFleetRequestIdDoesNotExist,
+			ec2.CancelBatchErrorCodeFleetRequestNotInCancellableState:
+			log.Printf("[DEBUG] Spot Fleet request (%s) is already cancelled: %s",
+				d.Id(), aws.StringValue(u.Error.Message))
+			found = true
+		default:
+			return fmt.Errorf("Error cancelling spot fleet request (%s): %s",
+				d.Id(), aws.StringValue(u.Error.Message))
+		}
+	}
+
 	if !found {
 		return fmt.Errorf("[ERR] Spot Fleet request (%s) was not found to be successfully canceled, dangling resources may exit", d.Id())
 	}
 
+	// The instances of the fleet keep running unless they're terminated
+	// with it, so there is nothing to wait for.
+	if !terminateInstances {
+		return nil
+	}
+
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
 		resp, err := conn.DescribeSpotFleetInstances(&ec2.DescribeSpotFleetInstancesInput{
 			SpotFleetRequestId: aws.String(d.Id()),
 		})
 		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "InvalidSpotFleetRequestID.NotFound" {
+				return nil
+			}
 			return resource.NonRetryableError(err)
 		}
 
@@ -54,6 +54,42 @@ func TestAccAWSSpotFleetRequest_changePriceForcesNewRequest(t *testing.T) {
 	})
 }
 
+func TestAccAWSSpotFleetRequest_updateTargetCapacity(t *testing.T) {
+	var before, after ec2.SpotFleetRequestConfig
+	rName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSpotFleetRequestDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSSpotFleetRequestConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSpotFleetRequestExists(
+						"aws_spot_fleet_request.foo", &before),
+					resource.TestCheckResourceAttr(
+						"aws_spot_fleet_request.foo", "target_capacity", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSSpotFleetRequestConfigTargetCapacity(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSpotFleetRequestExists(
+						"aws_spot_fleet_request.foo", &after),
+					resource.TestCheckResourceAttr(
+						"aws_spot_fleet_request.foo", "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(
+						"aws_spot_fleet_request.foo", "target_capacity", "3"),
+					resource.TestCheckResourceAttr(
+						"aws_spot_fleet_request.foo", "excess_capacity_termination_policy", "NoTermination"),
+					testAccCheckAWSSpotFleetRequestConfigNotRecreated(t, &before, &after),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSSpotFleetRequest_lowestPriceAzOrSubnetInRegion(t *testing.T) {
 	var sfr ec2.SpotFleetRequestConfig
 	rName := acctest.RandString(10)
@@ -310,6 +346,17 @@ func testAccCheckAWSSpotFleetRequestConfigRecreated(t *testing.T,
 	}
 }
 
+func testAccCheckAWSSpotFleetRequestConfigNotRecreated(t *testing.T,
+	before, after *ec2.SpotFleetRequestConfig) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(before.SpotFleetRequestId) != aws.StringValue(after.SpotFleetRequestId) {
+			t.Fatalf("Expected Spot Fleet Request to be updated in place, but it was recreated as %s",
+				aws.StringValue(after.SpotFleetRequestId))
+		}
+		return nil
+	}
+}
+
 func testAccCheckAWSSpotFleetRequestExists(
 	n string, sfr *ec2.SpotFleetRequestConfig) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -448,6 +495,58 @@ resource "aws_spot_fleet_request" "foo" {
 `, rName, rName)
 }
 
+func testAccAWSSpotFleetRequestConfigTargetCapacity(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_key_pair" "debugging" {
+	key_name = "tmp-key-%s"
+	public_key = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQD3F6tyPEFEzV0LX3X8BsXdMsQz1x2cEikKDEY0aIj41qgxMCP/iteneqXSIFZBp5vizPvaoIR3Um9xK7PGoW8giupGn+EPuxIA4cDM4vzOqOkiMPhz5XK0whEjkVzTo4+S0puvDZuwIsdiW9mxhJc7tgBNL0cYlWSYVkz4G/fslNfRPW5mYAM49f4fhtxPb5ok4Q2Lg9dPKVHO/Bgeu5woMc7RY0p1ej6D4CKFE6lymSDJpW0YHX/wqE9+cfEauh7xZcG0q9t2ta6F6fmX0agvpFyZo8aFbXeUBr7osSCJNgvavWbM/06niWrOvYX2xwWdhXmXSrbX8ZbabVohBK41 phodgson@thoughtworks.com"
+}
+
+resource "aws_iam_policy_attachment" "test-attach" {
+    name = "test-attachment"
+    roles = ["${aws_iam_role.test-role.name}"]
+    policy_arn = "arn:aws:iam::aws:policy/service-role/AmazonEC2SpotFleetRole"
+}
+
+resource "aws_iam_role" "test-role" {
+    name = "test-role-%s"
+    assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": [
+          "spotfleet.amazonaws.com",
+          "ec2.amazonaws.com"
+        ]
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_spot_fleet_request" "foo" {
+    iam_fleet_role = "${aws_iam_role.test-role.arn}"
+    spot_price = "0.005"
+    target_capacity = 3
+    excess_capacity_termination_policy = "NoTermination"
+    valid_until = "2019-11-04T20:44:20Z"
+    terminate_instances_with_expiration = true
+    launch_specification {
+        instance_type = "m1.small"
+        ami = "ami-d06a90b0"
+        key_name = "${aws_key_pair.debugging.key_name}"
+    }
+    depends_on = ["aws_iam_policy_attachment.test-attach"]
+}
+`, rName, rName)
+}
+
 func testAccAWSSpotFleetRequestConfigChangeSpotBidPrice(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_key_pair" "debugging" {
@@ -18,6 +18,7 @@ func resourceAwsVolumeAttachment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsVolumeAttachmentCreate,
 		Read:   resourceAwsVolumeAttachmentRead,
+		Update: resourceAwsVolumeAttachmentUpdate,
 		Delete: resourceAwsVolumeAttachmentDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -44,6 +45,16 @@ func resourceAwsVolumeAttachment() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+
+			"skip_destroy": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"stop_instance_before_detaching": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -153,12 +164,41 @@ func resourceAwsVolumeAttachmentRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+func resourceAwsVolumeAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Attaching Volume (%s) is updating which does nothing but updates a few params in state", d.Id())
+	return nil
+}
+
 func resourceAwsVolumeAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
+	if d.Get("skip_destroy").(bool) {
+		log.Printf("[DEBUG] Skipping detach of Volume Attachment (%s), removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	vID := d.Get("volume_id").(string)
 	iID := d.Get("instance_id").(string)
 
+	// A terminated instance has already released its volumes, so there is
+	// nothing left to detach.
+	_, state, err := InstanceStateRefreshFunc(conn, iID)()
+	if err != nil {
+		return fmt.Errorf("Error reading Instance (%s) state: %s", iID, err)
+	}
+	if state == "" || state == "terminated" {
+		log.Printf("[DEBUG] Instance (%s) is gone, removing Volume Attachment (%s) from state", iID, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if d.Get("stop_instance_before_detaching").(bool) {
+		if err := stopVolumeAttachmentInstance(conn, iID); err != nil {
+			return err
+		}
+	}
+
 	opts := &ec2.DetachVolumeInput{
 		Device:     aws.String(d.Get("device_name").(string)),
 		InstanceId: aws.String(iID),
@@ -166,8 +206,13 @@ func resourceAwsVolumeAttachmentDelete(d *schema.ResourceData, meta interface{})
 		Force:      aws.Bool(d.Get("force_detach").(bool)),
 	}
 
-	_, err := conn.DetachVolume(opts)
+	_, err = conn.DetachVolume(opts)
 	if err != nil {
+		if isAWSErr(err, "InvalidAttachment.NotFound", "") || isAWSErr(err, "InvalidVolume.NotFound", "") {
+			log.Printf("[DEBUG] Volume (%s) is no longer attached to Instance (%s)", vID, iID)
+			d.SetId("")
+			return nil
+		}
 		return fmt.Errorf("Failed to detach Volume (%s) from Instance (%s): %s",
 			vID, iID, err)
 	}
@@ -191,6 +236,32 @@ func resourceAwsVolumeAttachmentDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func stopVolumeAttachmentInstance(conn *ec2.EC2, instanceID string) error {
+	log.Printf("[DEBUG] Stopping Instance (%s) before detaching volume", instanceID)
+	_, err := conn.StopInstances(&ec2.StopInstancesInput{
+		InstanceIds: []*string{aws.String(instanceID)},
+	})
+	if err != nil {
+		return fmt.Errorf("Error stopping Instance (%s): %s", instanceID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending", "running", "stopping"},
+		Target:     []string{"stopped"},
+		Refresh:    InstanceStateRefreshFunc(conn, instanceID),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for Instance (%s) to stop: %s", instanceID, err)
+	}
+
+	return nil
+}
+
 func volumeAttachmentID(name, volumeID, instanceID string) string {
 	var buf bytes.Buffer
 	buf.WriteString(fmt.Sprintf("%s-", name))
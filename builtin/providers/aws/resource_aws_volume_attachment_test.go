@@ -36,6 +36,60 @@ func TestAccAWSVolumeAttachment_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSVolumeAttachment_skipDestroy(t *testing.T) {
+	var i ec2.Instance
+	var v ec2.Volume
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVolumeAttachmentDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVolumeAttachmentConfigSkipDestroy,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"aws_volume_attachment.ebs_att", "device_name", "/dev/sdh"),
+					resource.TestCheckResourceAttr(
+						"aws_volume_attachment.ebs_att", "skip_destroy", "true"),
+					testAccCheckInstanceExists(
+						"aws_instance.web", &i),
+					testAccCheckVolumeExists(
+						"aws_ebs_volume.example", &v),
+					testAccCheckVolumeAttachmentExists(
+						"aws_volume_attachment.ebs_att", &i, &v),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSVolumeAttachment_stopInstanceBeforeDetaching(t *testing.T) {
+	var i ec2.Instance
+	var v ec2.Volume
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVolumeAttachmentDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVolumeAttachmentConfigStopInstance,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"aws_volume_attachment.ebs_att", "stop_instance_before_detaching", "true"),
+					testAccCheckInstanceExists(
+						"aws_instance.web", &i),
+					testAccCheckVolumeExists(
+						"aws_ebs_volume.example", &v),
+					testAccCheckVolumeAttachmentExists(
+						"aws_volume_attachment.ebs_att", &i, &v),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckVolumeAttachmentExists(n string, i *ec2.Instance, v *ec2.Volume) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -91,3 +145,51 @@ resource "aws_volume_attachment" "ebs_att" {
 	instance_id = "${aws_instance.web.id}"
 }
 `
+
+const testAccVolumeAttachmentConfigSkipDestroy = `
+resource "aws_instance" "web" {
+  ami               = "ami-21f78e11"
+  availability_zone = "us-west-2a"
+  instance_type     = "t1.micro"
+
+  tags {
+    Name = "HelloWorld"
+  }
+}
+
+resource "aws_ebs_volume" "example" {
+  availability_zone = "us-west-2a"
+  size              = 1
+}
+
+resource "aws_volume_attachment" "ebs_att" {
+  device_name  = "/dev/sdh"
+  volume_id    = "${aws_ebs_volume.example.id}"
+  instance_id  = "${aws_instance.web.id}"
+  skip_destroy = true
+}
+`
+
+const testAccVolumeAttachmentConfigStopInstance = `
+resource "aws_instance" "web" {
+  ami               = "ami-21f78e11"
+  availability_zone = "us-west-2a"
+  instance_type     = "t1.micro"
+
+  tags {
+    Name = "HelloWorld"
+  }
+}
+
+resource "aws_ebs_volume" "example" {
+  availability_zone = "us-west-2a"
+  size              = 1
+}
+
+resource "aws_volume_attachment" "ebs_att" {
+  device_name                    = "/dev/sdh"
+  volume_id                      = "${aws_ebs_volume.example.id}"
+  instance_id                    = "${aws_instance.web.id}"
+  stop_instance_before_detaching = true
+}
+`
@@ -1,7 +1,9 @@
 package aws
 
 import (
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -14,6 +16,9 @@ func resourceAwsVpcDhcpOptionsAssociation() *schema.Resource {
 		Read:   resourceAwsVpcDhcpOptionsAssociationRead,
 		Update: resourceAwsVpcDhcpOptionsAssociationUpdate,
 		Delete: resourceAwsVpcDhcpOptionsAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsVpcDhcpOptionsAssociationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": &schema.Schema{
@@ -76,6 +81,37 @@ func resourceAwsVpcDhcpOptionsAssociationRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
+// Associations are imported by VPC ID (or by the "<dhcp options id>-<vpc id>"
+// form used as the resource ID), picking up whatever DHCP Options set is
+// currently associated with that VPC.
+func resourceAwsVpcDhcpOptionsAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	vpcId := d.Id()
+	if i := strings.Index(vpcId, "-vpc-"); i != -1 {
+		vpcId = vpcId[i+1:]
+	}
+
+	vpcRaw, _, err := VPCStateRefreshFunc(conn, vpcId)()
+	if err != nil {
+		return nil, err
+	}
+	if vpcRaw == nil {
+		return nil, fmt.Errorf("VPC %s not found", vpcId)
+	}
+
+	vpc := vpcRaw.(*ec2.Vpc)
+	if vpc.DhcpOptionsId == nil || *vpc.DhcpOptionsId == "default" {
+		return nil, fmt.Errorf("VPC %s has no DHCP Options set associated", vpcId)
+	}
+
+	d.SetId(*vpc.DhcpOptionsId + "-" + *vpc.VpcId)
+	d.Set("vpc_id", vpc.VpcId)
+	d.Set("dhcp_options_id", vpc.DhcpOptionsId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 // DHCP Options Asociations cannot be updated.
 func resourceAwsVpcDhcpOptionsAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
 	return resourceAwsVpcDhcpOptionsAssociationCreate(d, meta)
@@ -187,14 +187,16 @@ func resourceVPCPeeringConnectionOptionsModify(d *schema.ResourceData, meta inte
 		VpcPeeringConnectionId: aws.String(d.Id()),
 	}
 
-	if v, ok := d.GetOk("accepter"); ok {
+	// Each account may only modify the options of its own side of a
+	// cross-account connection, so unchanged options aren't sent.
+	if v, ok := d.GetOk("accepter"); ok && d.HasChange("accepter") {
 		if s := v.(*schema.Set); len(s.List()) > 0 {
 			co := s.List()[0].(map[string]interface{})
 			modifyOpts.AccepterPeeringConnectionOptions = expandPeeringOptions(co)
 		}
 	}
 
-	if v, ok := d.GetOk("requester"); ok {
+	if v, ok := d.GetOk("requester"); ok && d.HasChange("requester") {
 		if s := v.(*schema.Set); len(s.List()) > 0 {
 			co := s.List()[0].(map[string]interface{})
 			modifyOpts.RequesterPeeringConnectionOptions = expandPeeringOptions(co)
@@ -236,6 +238,19 @@ func resourceAwsVPCPeeringUpdate(d *schema.ResourceData, meta interface{}) error
 				return errwrap.Wrapf("Unable to accept VPC Peering Connection: {{err}}", err)
 			}
 			log.Printf("[DEBUG] VPC Peering Connection accept status: %s", status)
+
+			// Options can only be modified once the connection is active.
+			stateConf := &resource.StateChangeConf{
+				Pending: []string{"pending-acceptance", "provisioning"},
+				Target:  []string{"active"},
+				Refresh: resourceAwsVPCPeeringConnectionStateRefreshFunc(conn, d.Id()),
+				Timeout: 1 * time.Minute,
+			}
+			if _, err := stateConf.WaitForState(); err != nil {
+				return errwrap.Wrapf(fmt.Sprintf(
+					"Error waiting for VPC Peering Connection (%s) to become active: {{err}}",
+					d.Id()), err)
+			}
 		}
 	}
 
@@ -0,0 +1,78 @@
+package aws
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceAwsVpcPeeringConnectionAccepter manages the accepter's side of a
+// VPC peering connection, usually one requested from another account. It
+// shares its Read and Update with aws_vpc_peering_connection.
+func resourceAwsVpcPeeringConnectionAccepter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVPCPeeringAccepterCreate,
+		Read:   resourceAwsVPCPeeringAccepterRead,
+		Update: resourceAwsVPCPeeringUpdate,
+		Delete: resourceAwsVPCPeeringAccepterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpc_peering_connection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"auto_accept": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"accept_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"peer_vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"peer_owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"accepter":  vpcPeeringConnectionOptionsSchema(),
+			"requester": vpcPeeringConnectionOptionsSchema(),
+			"tags":      tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsVPCPeeringAccepterCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("vpc_peering_connection_id").(string))
+
+	return resourceAwsVPCPeeringUpdate(d, meta)
+}
+
+func resourceAwsVPCPeeringAccepterRead(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceAwsVPCPeeringRead(d, meta); err != nil {
+		return err
+	}
+	if d.Id() != "" {
+		d.Set("vpc_peering_connection_id", d.Id())
+	}
+
+	return nil
+}
+
+func resourceAwsVPCPeeringAccepterDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] Will not delete VPC Peering Connection %s. It is only removed "+
+		"from the state; delete it with its aws_vpc_peering_connection resource.", d.Id())
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSVPCPeeringConnectionAccepter_sameAccount(t *testing.T) {
+	var connection ec2.VpcPeeringConnection
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAwsVPCPeeringConnectionAccepterSameAccountConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcPeeringConnectionExists(
+						"aws_vpc_peering_connection_accepter.peer",
+						&connection),
+					resource.TestCheckResourceAttr(
+						"aws_vpc_peering_connection_accepter.peer", "accept_status", "active"),
+					resource.TestCheckResourceAttr(
+						"aws_vpc_peering_connection_accepter.peer", "tags.Side", "Accepter"),
+					resource.TestCheckResourceAttr(
+						"aws_vpc_peering_connection_accepter.peer",
+						"accepter.1102046665.allow_remote_vpc_dns_resolution", "true"),
+					resource.TestCheckResourceAttrSet(
+						"aws_route.peer", "vpc_peering_connection_id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAwsVPCPeeringConnectionAccepterSameAccountConfig = `
+resource "aws_vpc" "main" {
+	cidr_block = "10.0.0.0/16"
+	enable_dns_hostnames = true
+	tags {
+		Name = "TestAccAWSVPCPeeringConnectionAccepter_sameAccount"
+	}
+}
+
+resource "aws_vpc" "peer" {
+	cidr_block = "10.1.0.0/16"
+	enable_dns_hostnames = true
+}
+
+// Requester's side of the connection.
+resource "aws_vpc_peering_connection" "main" {
+	vpc_id = "${aws_vpc.main.id}"
+	peer_vpc_id = "${aws_vpc.peer.id}"
+	auto_accept = false
+	tags {
+		Side = "Requester"
+	}
+}
+
+// Accepter's side of the connection.
+resource "aws_vpc_peering_connection_accepter" "peer" {
+	vpc_peering_connection_id = "${aws_vpc_peering_connection.main.id}"
+	auto_accept = true
+
+	accepter {
+		allow_remote_vpc_dns_resolution = true
+	}
+
+	tags {
+		Side = "Accepter"
+	}
+}
+
+resource "aws_route" "main" {
+	route_table_id = "${aws_vpc.main.main_route_table_id}"
+	destination_cidr_block = "${aws_vpc.peer.cidr_block}"
+	vpc_peering_connection_id = "${aws_vpc_peering_connection_accepter.peer.id}"
+}
+
+resource "aws_route" "peer" {
+	route_table_id = "${aws_vpc.peer.main_route_table_id}"
+	destination_cidr_block = "${aws_vpc.main.cidr_block}"
+	vpc_peering_connection_id = "${aws_vpc_peering_connection_accepter.peer.id}"
+}
+`
@@ -0,0 +1,116 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsVpnGatewayRoutePropagation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpnGatewayRoutePropagationEnable,
+		Read:   resourceAwsVpnGatewayRoutePropagationRead,
+		Delete: resourceAwsVpnGatewayRoutePropagationDisable,
+
+		Schema: map[string]*schema.Schema{
+			"vpn_gateway_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"route_table_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsVpnGatewayRoutePropagationEnable(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	gwID := d.Get("vpn_gateway_id").(string)
+	rtID := d.Get("route_table_id").(string)
+
+	log.Printf("[INFO] Enabling VGW propagation from %s to %s", gwID, rtID)
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.EnableVgwRoutePropagation(&ec2.EnableVgwRoutePropagationInput{
+			GatewayId:    aws.String(gwID),
+			RouteTableId: aws.String(rtID),
+		})
+		if err != nil {
+			// The gateway is usually still attaching to the VPC of the
+			// route table.
+			if isAWSErr(err, "Gateway.NotAttached", "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error enabling VGW propagation from %s to %s: %s",
+			gwID, rtID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s_%s", gwID, rtID))
+	return resourceAwsVpnGatewayRoutePropagationRead(d, meta)
+}
+
+func resourceAwsVpnGatewayRoutePropagationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	gwID := d.Get("vpn_gateway_id").(string)
+	rtID := d.Get("route_table_id").(string)
+
+	log.Printf("[INFO] Reading route table %s to check for VPN gateway %s", rtID, gwID)
+	rtRaw, _, err := resourceAwsRouteTableStateRefreshFunc(conn, rtID)()
+	if err != nil {
+		return err
+	}
+	if rtRaw == nil {
+		log.Printf("[INFO] Route table %q doesn't exist, so dropping %q route propagation from state", rtID, gwID)
+		d.SetId("")
+		return nil
+	}
+
+	rt := rtRaw.(*ec2.RouteTable)
+	for _, vgw := range rt.PropagatingVgws {
+		if *vgw.GatewayId == gwID {
+			return nil
+		}
+	}
+
+	log.Printf("[INFO] %s is no longer propagating to %s, so dropping route propagation from state", rtID, gwID)
+	d.SetId("")
+	return nil
+}
+
+func resourceAwsVpnGatewayRoutePropagationDisable(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	gwID := d.Get("vpn_gateway_id").(string)
+	rtID := d.Get("route_table_id").(string)
+
+	log.Printf("[INFO] Disabling VGW propagation from %s to %s", gwID, rtID)
+	_, err := conn.DisableVgwRoutePropagation(&ec2.DisableVgwRoutePropagationInput{
+		GatewayId:    aws.String(gwID),
+		RouteTableId: aws.String(rtID),
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidRouteTableID.NotFound", "") {
+			return nil
+		}
+		return fmt.Errorf("Error disabling VGW propagation from %s to %s: %s",
+			gwID, rtID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,111 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSVPNGatewayRoutePropagation_basic(t *testing.T) {
+	var rtID, gwID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_vpn_gateway_route_propagation.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckVPNGatewayRoutePropagationDestroy(&rtID, &gwID),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSVPNGatewayRoutePropagation_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPNGatewayRoutePropagationExists(
+						"aws_vpn_gateway_route_propagation.foo", &rtID, &gwID),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVPNGatewayRoutePropagationExists(n string, rtID, gwID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		*rtID = rs.Primary.Attributes["route_table_id"]
+		*gwID = rs.Primary.Attributes["vpn_gateway_id"]
+
+		propagating, err := testAccVPNGatewayRoutePropagating(*rtID, *gwID)
+		if err != nil {
+			return err
+		}
+		if !propagating {
+			return fmt.Errorf("VPN Gateway %q is not propagating to %q", *gwID, *rtID)
+		}
+
+		return nil
+	}
+}
+
+// The route table is destroyed along with the propagation, so this checks
+// that the propagation was disabled before the route table went away.
+func testAccCheckVPNGatewayRoutePropagationDestroy(rtID, gwID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		propagating, err := testAccVPNGatewayRoutePropagating(*rtID, *gwID)
+		if err != nil {
+			return err
+		}
+		if propagating {
+			return fmt.Errorf("VPN Gateway %q is still propagating to %q", *gwID, *rtID)
+		}
+
+		return nil
+	}
+}
+
+func testAccVPNGatewayRoutePropagating(rtID, gwID string) (bool, error) {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	rtRaw, _, err := resourceAwsRouteTableStateRefreshFunc(conn, rtID)()
+	if err != nil {
+		return false, err
+	}
+	if rtRaw == nil {
+		return false, nil
+	}
+
+	for _, vgw := range rtRaw.(*ec2.RouteTable).PropagatingVgws {
+		if *vgw.GatewayId == gwID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+const testAccAWSVPNGatewayRoutePropagation_basic = `
+resource "aws_vpc" "foo" {
+	cidr_block = "10.1.0.0/16"
+}
+
+resource "aws_vpn_gateway" "foo" {
+	vpc_id = "${aws_vpc.foo.id}"
+}
+
+resource "aws_route_table" "foo" {
+	vpc_id = "${aws_vpc.foo.id}"
+}
+
+resource "aws_vpn_gateway_route_propagation" "foo" {
+	vpn_gateway_id = "${aws_vpn_gateway.foo.id}"
+	route_table_id = "${aws_route_table.foo.id}"
+}
+`
@@ -0,0 +1,186 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsWafRegionalIPSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWafRegionalIPSetCreate,
+		Read:   resourceAwsWafRegionalIPSetRead,
+		Update: resourceAwsWafRegionalIPSetUpdate,
+		Delete: resourceAwsWafRegionalIPSetDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ip_set_descriptor": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateWafIPSetDescriptorType,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsWafRegionalIPSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	region := meta.(*AWSClient).region
+
+	wr := newWafRegionalRetryer(conn, region)
+	out, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		params := &waf.CreateIPSetInput{
+			ChangeToken: token,
+			Name:        aws.String(d.Get("name").(string)),
+		}
+		return conn.CreateIPSet(params)
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating WAF Regional IPSet: %s", err)
+	}
+	resp := out.(*waf.CreateIPSetOutput)
+
+	d.SetId(*resp.IPSet.IPSetId)
+
+	return resourceAwsWafRegionalIPSetUpdate(d, meta)
+}
+
+func resourceAwsWafRegionalIPSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+
+	resp, err := conn.GetIPSet(&waf.GetIPSetInput{
+		IPSetId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, waf.ErrCodeNonexistentItemException, "") {
+			log.Printf("[WARN] WAF Regional IPSet (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	var descriptors []map[string]interface{}
+	for _, descriptor := range resp.IPSet.IPSetDescriptors {
+		descriptors = append(descriptors, map[string]interface{}{
+			"type":  *descriptor.Type,
+			"value": *descriptor.Value,
+		})
+	}
+
+	d.Set("name", resp.IPSet.Name)
+	d.Set("ip_set_descriptor", descriptors)
+
+	return nil
+}
+
+func resourceAwsWafRegionalIPSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	region := meta.(*AWSClient).region
+
+	if d.HasChange("ip_set_descriptor") {
+		o, n := d.GetChange("ip_set_descriptor")
+		updates := diffWafIPSetDescriptors(o.(*schema.Set), n.(*schema.Set))
+
+		if err := updateWafRegionalIPSet(conn, region, d.Id(), updates); err != nil {
+			return fmt.Errorf("Error updating WAF Regional IPSet: %s", err)
+		}
+	}
+
+	return resourceAwsWafRegionalIPSetRead(d, meta)
+}
+
+func resourceAwsWafRegionalIPSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	region := meta.(*AWSClient).region
+
+	// The descriptors must be removed before the IPSet can be deleted
+	oldDescriptors := d.Get("ip_set_descriptor").(*schema.Set)
+	if oldDescriptors.Len() > 0 {
+		empty := oldDescriptors.Difference(oldDescriptors)
+		updates := diffWafIPSetDescriptors(oldDescriptors, empty)
+		if err := updateWafRegionalIPSet(conn, region, d.Id(), updates); err != nil {
+			return fmt.Errorf("Error removing descriptors from WAF Regional IPSet: %s", err)
+		}
+	}
+
+	wr := newWafRegionalRetryer(conn, region)
+	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		return conn.DeleteIPSet(&waf.DeleteIPSetInput{
+			ChangeToken: token,
+			IPSetId:     aws.String(d.Id()),
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting WAF Regional IPSet: %s", err)
+	}
+
+	return nil
+}
+
+func updateWafRegionalIPSet(conn *wafregional.WAFRegional, region, id string, updates []*waf.IPSetUpdate) error {
+	if len(updates) == 0 {
+		return nil
+	}
+
+	wr := newWafRegionalRetryer(conn, region)
+	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		return conn.UpdateIPSet(&waf.UpdateIPSetInput{
+			ChangeToken: token,
+			IPSetId:     aws.String(id),
+			Updates:     updates,
+		})
+	})
+	return err
+}
+
+// diffWafIPSetDescriptors returns the updates that turn the old set of
+// IPSet descriptors into the new one.
+func diffWafIPSetDescriptors(oldD, newD *schema.Set) []*waf.IPSetUpdate {
+	var updates []*waf.IPSetUpdate
+
+	for _, od := range oldD.Difference(newD).List() {
+		updates = append(updates, &waf.IPSetUpdate{
+			Action:          aws.String(waf.ChangeActionDelete),
+			IPSetDescriptor: expandWafIPSetDescriptor(od.(map[string]interface{})),
+		})
+	}
+
+	for _, nd := range newD.Difference(oldD).List() {
+		updates = append(updates, &waf.IPSetUpdate{
+			Action:          aws.String(waf.ChangeActionInsert),
+			IPSetDescriptor: expandWafIPSetDescriptor(nd.(map[string]interface{})),
+		})
+	}
+
+	return updates
+}
+
+func expandWafIPSetDescriptor(m map[string]interface{}) *waf.IPSetDescriptor {
+	return &waf.IPSetDescriptor{
+		Type:  aws.String(m["type"].(string)),
+		Value: aws.String(m["value"].(string)),
+	}
+}
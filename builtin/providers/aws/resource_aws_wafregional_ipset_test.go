@@ -0,0 +1,143 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWafRegionalIPSet_basic(t *testing.T) {
+	var v waf.IPSet
+	ipsetName := fmt.Sprintf("ip-set-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafRegionalIPSetDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSWafRegionalIPSetConfig(ipsetName, "192.0.7.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegionalIPSetExists("aws_wafregional_ipset.ipset", &v),
+					resource.TestCheckResourceAttr("aws_wafregional_ipset.ipset", "name", ipsetName),
+					resource.TestCheckResourceAttr("aws_wafregional_ipset.ipset", "ip_set_descriptor.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSWafRegionalIPSetConfig(ipsetName, "192.0.8.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegionalIPSetExists("aws_wafregional_ipset.ipset", &v),
+					resource.TestCheckResourceAttr("aws_wafregional_ipset.ipset", "ip_set_descriptor.#", "1"),
+					testAccCheckAWSWafRegionalIPSetDescriptor(&v, "192.0.8.0/24"),
+				),
+			},
+		},
+	})
+}
+
+func TestDiffWafIPSetDescriptors(t *testing.T) {
+	descriptors := func(values ...string) *schema.Set {
+		s := resourceAwsWafRegionalIPSet().Schema["ip_set_descriptor"].ZeroValue().(*schema.Set)
+		for _, v := range values {
+			s.Add(map[string]interface{}{"type": "IPV4", "value": v})
+		}
+		return s
+	}
+
+	updates := diffWafIPSetDescriptors(
+		descriptors("10.0.0.0/8", "192.168.0.0/16"),
+		descriptors("192.168.0.0/16", "172.16.0.0/12"))
+	if len(updates) != 2 {
+		t.Fatalf("bad: %s", updates)
+	}
+	if *updates[0].Action != waf.ChangeActionDelete || *updates[0].IPSetDescriptor.Value != "10.0.0.0/8" {
+		t.Fatalf("bad: %s", updates[0])
+	}
+	if *updates[1].Action != waf.ChangeActionInsert || *updates[1].IPSetDescriptor.Value != "172.16.0.0/12" {
+		t.Fatalf("bad: %s", updates[1])
+	}
+
+	if updates := diffWafIPSetDescriptors(descriptors("10.0.0.0/8"), descriptors("10.0.0.0/8")); len(updates) != 0 {
+		t.Fatalf("bad: %s", updates)
+	}
+}
+
+func testAccCheckAWSWafRegionalIPSetDescriptor(v *waf.IPSet, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, d := range v.IPSetDescriptors {
+			if *d.Value == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("IPSet descriptor %s not found: %s", value, v.IPSetDescriptors)
+	}
+}
+
+func testAccCheckAWSWafRegionalIPSetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_wafregional_ipset" {
+			continue
+		}
+
+		resp, err := conn.GetIPSet(&waf.GetIPSetInput{
+			IPSetId: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			if *resp.IPSet.IPSetId == rs.Primary.ID {
+				return fmt.Errorf("WAF Regional IPSet %s still exists", rs.Primary.ID)
+			}
+		}
+
+		if isAWSErr(err, waf.ErrCodeNonexistentItemException, "") {
+			continue
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func testAccCheckAWSWafRegionalIPSetExists(n string, v *waf.IPSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WAF Regional IPSet ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		resp, err := conn.GetIPSet(&waf.GetIPSetInput{
+			IPSetId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*v = *resp.IPSet
+		return nil
+	}
+}
+
+func testAccAWSWafRegionalIPSetConfig(name, cidr string) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_ipset" "ipset" {
+  name = "%s"
+
+  ip_set_descriptor {
+    type  = "IPV4"
+    value = "%s"
+  }
+}`, name, cidr)
+}
@@ -0,0 +1,200 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsWafRegionalRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWafRegionalRuleCreate,
+		Read:   resourceAwsWafRegionalRuleRead,
+		Update: resourceAwsWafRegionalRuleUpdate,
+		Delete: resourceAwsWafRegionalRuleDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"metric_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateWafMetricName,
+			},
+			"predicate": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"negated": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"data_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateWafPredicateType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsWafRegionalRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	region := meta.(*AWSClient).region
+
+	wr := newWafRegionalRetryer(conn, region)
+	out, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		params := &waf.CreateRuleInput{
+			ChangeToken: token,
+			MetricName:  aws.String(d.Get("metric_name").(string)),
+			Name:        aws.String(d.Get("name").(string)),
+		}
+		return conn.CreateRule(params)
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating WAF Regional Rule: %s", err)
+	}
+	resp := out.(*waf.CreateRuleOutput)
+
+	d.SetId(*resp.Rule.RuleId)
+
+	return resourceAwsWafRegionalRuleUpdate(d, meta)
+}
+
+func resourceAwsWafRegionalRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+
+	resp, err := conn.GetRule(&waf.GetRuleInput{
+		RuleId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, waf.ErrCodeNonexistentItemException, "") {
+			log.Printf("[WARN] WAF Regional Rule (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	var predicates []map[string]interface{}
+	for _, predicate := range resp.Rule.Predicates {
+		predicates = append(predicates, map[string]interface{}{
+			"negated": *predicate.Negated,
+			"type":    *predicate.Type,
+			"data_id": *predicate.DataId,
+		})
+	}
+
+	d.Set("name", resp.Rule.Name)
+	d.Set("metric_name", resp.Rule.MetricName)
+	d.Set("predicate", predicates)
+
+	return nil
+}
+
+func resourceAwsWafRegionalRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	region := meta.(*AWSClient).region
+
+	if d.HasChange("predicate") {
+		o, n := d.GetChange("predicate")
+		updates := diffWafRulePredicates(o.(*schema.Set), n.(*schema.Set))
+
+		if err := updateWafRegionalRule(conn, region, d.Id(), updates); err != nil {
+			return fmt.Errorf("Error updating WAF Regional Rule: %s", err)
+		}
+	}
+
+	return resourceAwsWafRegionalRuleRead(d, meta)
+}
+
+func resourceAwsWafRegionalRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	region := meta.(*AWSClient).region
+
+	// The predicates must be removed before the Rule can be deleted
+	oldPredicates := d.Get("predicate").(*schema.Set)
+	if oldPredicates.Len() > 0 {
+		empty := oldPredicates.Difference(oldPredicates)
+		updates := diffWafRulePredicates(oldPredicates, empty)
+		if err := updateWafRegionalRule(conn, region, d.Id(), updates); err != nil {
+			return fmt.Errorf("Error removing predicates from WAF Regional Rule: %s", err)
+		}
+	}
+
+	wr := newWafRegionalRetryer(conn, region)
+	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		return conn.DeleteRule(&waf.DeleteRuleInput{
+			ChangeToken: token,
+			RuleId:      aws.String(d.Id()),
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting WAF Regional Rule: %s", err)
+	}
+
+	return nil
+}
+
+func updateWafRegionalRule(conn *wafregional.WAFRegional, region, id string, updates []*waf.RuleUpdate) error {
+	if len(updates) == 0 {
+		return nil
+	}
+
+	wr := newWafRegionalRetryer(conn, region)
+	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		return conn.UpdateRule(&waf.UpdateRuleInput{
+			ChangeToken: token,
+			RuleId:      aws.String(id),
+			Updates:     updates,
+		})
+	})
+	return err
+}
+
+// diffWafRulePredicates returns the updates that turn the old set of rule
+// predicates into the new one.
+func diffWafRulePredicates(oldP, newP *schema.Set) []*waf.RuleUpdate {
+	var updates []*waf.RuleUpdate
+
+	for _, op := range oldP.Difference(newP).List() {
+		updates = append(updates, &waf.RuleUpdate{
+			Action:    aws.String(waf.ChangeActionDelete),
+			Predicate: expandWafPredicate(op.(map[string]interface{})),
+		})
+	}
+
+	for _, np := range newP.Difference(oldP).List() {
+		updates = append(updates, &waf.RuleUpdate{
+			Action:    aws.String(waf.ChangeActionInsert),
+			Predicate: expandWafPredicate(np.(map[string]interface{})),
+		})
+	}
+
+	return updates
+}
+
+func expandWafPredicate(m map[string]interface{}) *waf.Predicate {
+	return &waf.Predicate{
+		Negated: aws.Bool(m["negated"].(bool)),
+		Type:    aws.String(m["type"].(string)),
+		DataId:  aws.String(m["data_id"].(string)),
+	}
+}
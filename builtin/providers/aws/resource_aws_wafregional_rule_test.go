@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWafRegionalRule_basic(t *testing.T) {
+	var v waf.Rule
+	wafRuleName := fmt.Sprintf("wafrule%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafRegionalRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSWafRegionalRuleConfig(wafRuleName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegionalRuleExists("aws_wafregional_rule.wafrule", &v),
+					resource.TestCheckResourceAttr("aws_wafregional_rule.wafrule", "name", wafRuleName),
+					resource.TestCheckResourceAttr("aws_wafregional_rule.wafrule", "metric_name", wafRuleName),
+					resource.TestCheckResourceAttr("aws_wafregional_rule.wafrule", "predicate.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSWafRegionalRuleConfig(wafRuleName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegionalRuleExists("aws_wafregional_rule.wafrule", &v),
+					resource.TestCheckResourceAttr("aws_wafregional_rule.wafrule", "predicate.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSWafRegionalRuleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_wafregional_rule" {
+			continue
+		}
+
+		resp, err := conn.GetRule(&waf.GetRuleInput{
+			RuleId: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			if *resp.Rule.RuleId == rs.Primary.ID {
+				return fmt.Errorf("WAF Regional Rule %s still exists", rs.Primary.ID)
+			}
+		}
+
+		if isAWSErr(err, waf.ErrCodeNonexistentItemException, "") {
+			continue
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func testAccCheckAWSWafRegionalRuleExists(n string, v *waf.Rule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WAF Regional Rule ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		resp, err := conn.GetRule(&waf.GetRuleInput{
+			RuleId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*v = *resp.Rule
+		return nil
+	}
+}
+
+func testAccAWSWafRegionalRuleConfig(name string, negated bool) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_ipset" "ipset" {
+  name = "%[1]s"
+
+  ip_set_descriptor {
+    type  = "IPV4"
+    value = "192.0.7.0/24"
+  }
+}
+
+resource "aws_wafregional_rule" "wafrule" {
+  name        = "%[1]s"
+  metric_name = "%[1]s"
+
+  predicate {
+    data_id = "${aws_wafregional_ipset.ipset.id}"
+    negated = %[2]t
+    type    = "IPMatch"
+  }
+}`, name, negated)
+}
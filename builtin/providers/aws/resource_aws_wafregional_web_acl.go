@@ -0,0 +1,268 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsWafRegionalWebAcl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWafRegionalWebAclCreate,
+		Read:   resourceAwsWafRegionalWebAclRead,
+		Update: resourceAwsWafRegionalWebAclUpdate,
+		Delete: resourceAwsWafRegionalWebAclDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"metric_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateWafMetricName,
+			},
+			"default_action": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateWafActionType,
+						},
+					},
+				},
+			},
+			"rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateWafActionType,
+									},
+								},
+							},
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"rule_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsWafRegionalWebAclCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	region := meta.(*AWSClient).region
+
+	wr := newWafRegionalRetryer(conn, region)
+	out, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		params := &waf.CreateWebACLInput{
+			ChangeToken:   token,
+			DefaultAction: expandWafAction(d.Get("default_action").([]interface{})),
+			MetricName:    aws.String(d.Get("metric_name").(string)),
+			Name:          aws.String(d.Get("name").(string)),
+		}
+		return conn.CreateWebACL(params)
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating WAF Regional Web ACL: %s", err)
+	}
+	resp := out.(*waf.CreateWebACLOutput)
+
+	d.SetId(*resp.WebACL.WebACLId)
+
+	// The default action was set on creation, only the rules are left
+	if rules := d.Get("rule").(*schema.Set); rules.Len() > 0 {
+		empty := rules.Difference(rules)
+		err := updateWafRegionalWebAcl(conn, region, d.Id(), nil, diffWafWebAclRules(empty, rules))
+		if err != nil {
+			return fmt.Errorf("Error adding rules to WAF Regional Web ACL: %s", err)
+		}
+	}
+
+	return resourceAwsWafRegionalWebAclRead(d, meta)
+}
+
+func resourceAwsWafRegionalWebAclRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+
+	resp, err := conn.GetWebACL(&waf.GetWebACLInput{
+		WebACLId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, waf.ErrCodeNonexistentItemException, "") {
+			log.Printf("[WARN] WAF Regional Web ACL (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	var rules []map[string]interface{}
+	for _, rule := range resp.WebACL.Rules {
+		m := map[string]interface{}{
+			"priority": int(*rule.Priority),
+			"rule_id":  *rule.RuleId,
+		}
+		if rule.Action != nil {
+			m["action"] = flattenWafAction(rule.Action)
+		}
+		rules = append(rules, m)
+	}
+
+	d.Set("name", resp.WebACL.Name)
+	d.Set("metric_name", resp.WebACL.MetricName)
+	d.Set("default_action", flattenWafAction(resp.WebACL.DefaultAction))
+	d.Set("rule", rules)
+
+	return nil
+}
+
+func resourceAwsWafRegionalWebAclUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	region := meta.(*AWSClient).region
+
+	if d.HasChange("default_action") || d.HasChange("rule") {
+		o, n := d.GetChange("rule")
+		updates := diffWafWebAclRules(o.(*schema.Set), n.(*schema.Set))
+
+		var defaultAction *waf.WafAction
+		if d.HasChange("default_action") {
+			defaultAction = expandWafAction(d.Get("default_action").([]interface{}))
+		}
+
+		if err := updateWafRegionalWebAcl(conn, region, d.Id(), defaultAction, updates); err != nil {
+			return fmt.Errorf("Error updating WAF Regional Web ACL: %s", err)
+		}
+	}
+
+	return resourceAwsWafRegionalWebAclRead(d, meta)
+}
+
+func resourceAwsWafRegionalWebAclDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	region := meta.(*AWSClient).region
+
+	// The rules must be removed before the Web ACL can be deleted
+	oldRules := d.Get("rule").(*schema.Set)
+	if oldRules.Len() > 0 {
+		empty := oldRules.Difference(oldRules)
+		err := updateWafRegionalWebAcl(conn, region, d.Id(), nil, diffWafWebAclRules(oldRules, empty))
+		if err != nil {
+			return fmt.Errorf("Error removing rules from WAF Regional Web ACL: %s", err)
+		}
+	}
+
+	wr := newWafRegionalRetryer(conn, region)
+	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		return conn.DeleteWebACL(&waf.DeleteWebACLInput{
+			ChangeToken: token,
+			WebACLId:    aws.String(d.Id()),
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting WAF Regional Web ACL: %s", err)
+	}
+
+	return nil
+}
+
+// updateWafRegionalWebAcl applies the rule updates to the Web ACL. The
+// default action is only changed if it is non-nil.
+func updateWafRegionalWebAcl(conn *wafregional.WAFRegional, region, id string, defaultAction *waf.WafAction, updates []*waf.WebACLUpdate) error {
+	if defaultAction == nil && len(updates) == 0 {
+		return nil
+	}
+
+	wr := newWafRegionalRetryer(conn, region)
+	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		return conn.UpdateWebACL(&waf.UpdateWebACLInput{
+			ChangeToken:   token,
+			DefaultAction: defaultAction,
+			WebACLId:      aws.String(id),
+			Updates:       updates,
+		})
+	})
+	return err
+}
+
+// diffWafWebAclRules returns the updates that turn the old set of Web ACL
+// rules into the new one.
+func diffWafWebAclRules(oldR, newR *schema.Set) []*waf.WebACLUpdate {
+	var updates []*waf.WebACLUpdate
+
+	for _, or := range oldR.Difference(newR).List() {
+		updates = append(updates, &waf.WebACLUpdate{
+			Action:        aws.String(waf.ChangeActionDelete),
+			ActivatedRule: expandWafActivatedRule(or.(map[string]interface{})),
+		})
+	}
+
+	for _, nr := range newR.Difference(oldR).List() {
+		updates = append(updates, &waf.WebACLUpdate{
+			Action:        aws.String(waf.ChangeActionInsert),
+			ActivatedRule: expandWafActivatedRule(nr.(map[string]interface{})),
+		})
+	}
+
+	return updates
+}
+
+func expandWafActivatedRule(m map[string]interface{}) *waf.ActivatedRule {
+	return &waf.ActivatedRule{
+		Action:   expandWafAction(m["action"].([]interface{})),
+		Priority: aws.Int64(int64(m["priority"].(int))),
+		RuleId:   aws.String(m["rule_id"].(string)),
+	}
+}
+
+func expandWafAction(l []interface{}) *waf.WafAction {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	return &waf.WafAction{
+		Type: aws.String(m["type"].(string)),
+	}
+}
+
+func flattenWafAction(a *waf.WafAction) []interface{} {
+	if a == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type": *a.Type,
+		},
+	}
+}
@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsWafRegionalWebAclAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWafRegionalWebAclAssociationCreate,
+		Read:   resourceAwsWafRegionalWebAclAssociationRead,
+		Delete: resourceAwsWafRegionalWebAclAssociationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"web_acl_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_arn": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsWafRegionalWebAclAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+
+	webAclId := d.Get("web_acl_id").(string)
+	resourceArn := d.Get("resource_arn").(string)
+
+	log.Printf("[INFO] Associating WAF Regional Web ACL %s with %s", webAclId, resourceArn)
+	_, err := conn.AssociateWebACL(&wafregional.AssociateWebACLInput{
+		WebACLId:    aws.String(webAclId),
+		ResourceArn: aws.String(resourceArn),
+	})
+	if err != nil {
+		return fmt.Errorf("Error associating WAF Regional Web ACL %s with %s: %s", webAclId, resourceArn, err)
+	}
+
+	// A resource can only be associated with a single Web ACL, so the
+	// pair identifies the association.
+	d.SetId(fmt.Sprintf("%s:%s", webAclId, resourceArn))
+
+	return resourceAwsWafRegionalWebAclAssociationRead(d, meta)
+}
+
+func resourceAwsWafRegionalWebAclAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+
+	webAclId, resourceArn, err := parseWafRegionalWebAclAssociationId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.GetWebACLForResource(&wafregional.GetWebACLForResourceInput{
+		ResourceArn: aws.String(resourceArn),
+	})
+	if err != nil {
+		if isAWSErr(err, waf.ErrCodeNonexistentItemException, "") {
+			log.Printf("[WARN] WAF Regional Web ACL association (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	if resp.WebACLSummary == nil || aws.StringValue(resp.WebACLSummary.WebACLId) != webAclId {
+		log.Printf("[WARN] WAF Regional Web ACL association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("web_acl_id", webAclId)
+	d.Set("resource_arn", resourceArn)
+
+	return nil
+}
+
+func resourceAwsWafRegionalWebAclAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+
+	resourceArn := d.Get("resource_arn").(string)
+
+	log.Printf("[INFO] Disassociating WAF Regional Web ACL from %s", resourceArn)
+	_, err := conn.DisassociateWebACL(&wafregional.DisassociateWebACLInput{
+		ResourceArn: aws.String(resourceArn),
+	})
+	if err != nil && !isAWSErr(err, waf.ErrCodeNonexistentItemException, "") {
+		return fmt.Errorf("Error disassociating WAF Regional Web ACL from %s: %s", resourceArn, err)
+	}
+
+	return nil
+}
+
+// parseWafRegionalWebAclAssociationId splits the ID of an association into
+// the Web ACL ID and the resource ARN, which itself contains colons.
+func parseWafRegionalWebAclAssociationId(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected WEB_ACL_ID:RESOURCE_ARN", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,128 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWafRegionalWebAclAssociation_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafRegionalWebAclAssociationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSWafRegionalWebAclAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegionalWebAclAssociationExists("aws_wafregional_web_acl_association.foo"),
+				),
+			},
+		},
+	})
+}
+
+func TestParseWafRegionalWebAclAssociationId(t *testing.T) {
+	webAclId, resourceArn, err := parseWafRegionalWebAclAssociationId(
+		"a1b2c3:arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/foo/50dc6c495c0c9188")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if webAclId != "a1b2c3" {
+		t.Fatalf("bad: %s", webAclId)
+	}
+	if resourceArn != "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/foo/50dc6c495c0c9188" {
+		t.Fatalf("bad: %s", resourceArn)
+	}
+
+	for _, id := range []string{"", "a1b2c3", ":arn", "a1b2c3:"} {
+		if _, _, err := parseWafRegionalWebAclAssociationId(id); err == nil {
+			t.Fatalf("expected an error for %q", id)
+		}
+	}
+}
+
+func testAccCheckAWSWafRegionalWebAclAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_wafregional_web_acl_association" {
+			continue
+		}
+
+		resp, err := conn.GetWebACLForResource(&wafregional.GetWebACLForResourceInput{
+			ResourceArn: aws.String(rs.Primary.Attributes["resource_arn"]),
+		})
+		if err == nil && resp.WebACLSummary != nil {
+			return fmt.Errorf("WAF Regional Web ACL association %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSWafRegionalWebAclAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		resp, err := conn.GetWebACLForResource(&wafregional.GetWebACLForResourceInput{
+			ResourceArn: aws.String(rs.Primary.Attributes["resource_arn"]),
+		})
+		if err != nil {
+			return err
+		}
+
+		if resp.WebACLSummary == nil || *resp.WebACLSummary.WebACLId != rs.Primary.Attributes["web_acl_id"] {
+			return fmt.Errorf("WAF Regional Web ACL association %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSWafRegionalWebAclAssociationConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_web_acl" "foo" {
+  name        = "%[1]s"
+  metric_name = "tfacc%[2]s"
+
+  default_action {
+    type = "ALLOW"
+  }
+}
+
+data "aws_availability_zones" "available" {}
+
+resource "aws_vpc" "foo" {
+  cidr_block = "10.1.0.0/16"
+}
+
+resource "aws_subnet" "foo" {
+  count             = 2
+  vpc_id            = "${aws_vpc.foo.id}"
+  cidr_block        = "10.1.${count.index}.0/24"
+  availability_zone = "${element(data.aws_availability_zones.available.names, count.index)}"
+}
+
+resource "aws_alb" "foo" {
+  name     = "%[1]s"
+  internal = true
+  subnets  = ["${aws_subnet.foo.*.id}"]
+}
+
+resource "aws_wafregional_web_acl_association" "foo" {
+  web_acl_id   = "${aws_wafregional_web_acl.foo.id}"
+  resource_arn = "${aws_alb.foo.arn}"
+}`, name, acctest.RandString(8))
+}
@@ -0,0 +1,134 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWafRegionalWebAcl_basic(t *testing.T) {
+	var v waf.WebACL
+	wafAclName := fmt.Sprintf("wafacl%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafRegionalWebAclDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSWafRegionalWebAclConfig(wafAclName, "ALLOW"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegionalWebAclExists("aws_wafregional_web_acl.waf_acl", &v),
+					resource.TestCheckResourceAttr("aws_wafregional_web_acl.waf_acl", "name", wafAclName),
+					resource.TestCheckResourceAttr("aws_wafregional_web_acl.waf_acl", "default_action.0.type", "ALLOW"),
+					resource.TestCheckResourceAttr("aws_wafregional_web_acl.waf_acl", "rule.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSWafRegionalWebAclConfig(wafAclName, "BLOCK"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafRegionalWebAclExists("aws_wafregional_web_acl.waf_acl", &v),
+					resource.TestCheckResourceAttr("aws_wafregional_web_acl.waf_acl", "default_action.0.type", "BLOCK"),
+					resource.TestCheckResourceAttr("aws_wafregional_web_acl.waf_acl", "rule.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSWafRegionalWebAclDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_wafregional_web_acl" {
+			continue
+		}
+
+		resp, err := conn.GetWebACL(&waf.GetWebACLInput{
+			WebACLId: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			if *resp.WebACL.WebACLId == rs.Primary.ID {
+				return fmt.Errorf("WAF Regional Web ACL %s still exists", rs.Primary.ID)
+			}
+		}
+
+		if isAWSErr(err, waf.ErrCodeNonexistentItemException, "") {
+			continue
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func testAccCheckAWSWafRegionalWebAclExists(n string, v *waf.WebACL) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WAF Regional Web ACL ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		resp, err := conn.GetWebACL(&waf.GetWebACLInput{
+			WebACLId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*v = *resp.WebACL
+		return nil
+	}
+}
+
+func testAccAWSWafRegionalWebAclConfig(name, defaultAction string) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_ipset" "ipset" {
+  name = "%[1]s"
+
+  ip_set_descriptor {
+    type  = "IPV4"
+    value = "192.0.7.0/24"
+  }
+}
+
+resource "aws_wafregional_rule" "wafrule" {
+  name        = "%[1]s"
+  metric_name = "%[1]s"
+
+  predicate {
+    data_id = "${aws_wafregional_ipset.ipset.id}"
+    negated = false
+    type    = "IPMatch"
+  }
+}
+
+resource "aws_wafregional_web_acl" "waf_acl" {
+  name        = "%[1]s"
+  metric_name = "%[1]s"
+
+  default_action {
+    type = "%[2]s"
+  }
+
+  rule {
+    action {
+      type = "BLOCK"
+    }
+
+    priority = 1
+    rule_id  = "${aws_wafregional_rule.wafrule.id}"
+  }
+}`, name, defaultAction)
+}
@@ -0,0 +1,140 @@
+package aws
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	// throttleMinDelay is the initial shared backoff applied after the first
+	// throttling error; throttleMaxDelay caps how far it can grow.
+	throttleMinDelay = 250 * time.Millisecond
+	throttleMaxDelay = 30 * time.Second
+)
+
+// throttleState tracks API throttling across every client created from a
+// single provider configuration. When one request is throttled all requests
+// back off together, instead of each one hitting the rate limit on its own
+// and burning through its retries. This matters most for large plans that
+// refresh many route tables, security groups or Route53 records in parallel.
+type throttleState struct {
+	mu    sync.Mutex
+	delay time.Duration
+	until time.Time
+
+	// now is overridden in tests
+	now func() time.Time
+}
+
+func newThrottleState() *throttleState {
+	return &throttleState{now: time.Now}
+}
+
+// throttled records a throttling error, doubling the shared backoff.
+func (t *throttleState) throttled() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.delay *= 2
+	if t.delay < throttleMinDelay {
+		t.delay = throttleMinDelay
+	}
+	if t.delay > throttleMaxDelay {
+		t.delay = throttleMaxDelay
+	}
+	t.until = t.now().Add(t.delay)
+}
+
+// succeeded records a successful request, halving the shared backoff
+// so that throughput recovers once the API stops throttling.
+func (t *throttleState) succeeded() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.delay == 0 {
+		return
+	}
+	t.delay /= 2
+	if t.delay < throttleMinDelay {
+		t.delay = 0
+	}
+}
+
+// wait returns how long a request should wait before being sent.
+func (t *throttleState) wait() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.delay == 0 {
+		return 0
+	}
+	if d := t.until.Sub(t.now()); d > 0 {
+		return d
+	}
+	return 0
+}
+
+// awsRetryer wraps the SDK's default retryer, feeding throttling errors
+// into the shared throttleState and never retrying sooner than it allows.
+type awsRetryer struct {
+	client.DefaultRetryer
+	throttle *throttleState
+}
+
+func newAwsRetryer(maxRetries int, throttle *throttleState) awsRetryer {
+	return awsRetryer{
+		DefaultRetryer: client.DefaultRetryer{NumMaxRetries: maxRetries},
+		throttle:       throttle,
+	}
+}
+
+func (r awsRetryer) ShouldRetry(req *request.Request) bool {
+	retry := r.DefaultRetryer.ShouldRetry(req)
+	if retry && req.IsErrorThrottle() {
+		r.throttle.throttled()
+	}
+	return retry
+}
+
+func (r awsRetryer) RetryRules(req *request.Request) time.Duration {
+	delay := r.DefaultRetryer.RetryRules(req)
+	if w := r.throttle.wait(); w > delay {
+		delay = w
+	}
+	return delay
+}
+
+// addThrottleHandlers delays new requests while the API is throttling us
+// and relaxes the shared backoff as requests succeed again. Retries are
+// already delayed through awsRetryer.RetryRules, so only the first attempt
+// waits here.
+func addThrottleHandlers(h *request.Handlers, throttle *throttleState) {
+	h.Send.PushFrontNamed(request.NamedHandler{
+		Name: "terraform.ThrottleWaitHandler",
+		Fn: func(r *request.Request) {
+			if r.RetryCount > 0 {
+				return
+			}
+			if d := throttle.wait(); d > 0 {
+				log.Printf("[DEBUG] Delaying %s/%s by %s due to API throttling",
+					r.ClientInfo.ServiceName, r.Operation.Name, d)
+				if err := aws.SleepWithContext(r.Context(), d); err != nil {
+					r.Error = err
+				}
+			}
+		},
+	})
+	h.Complete.PushBackNamed(request.NamedHandler{
+		Name: "terraform.ThrottleCompleteHandler",
+		Fn: func(r *request.Request) {
+			if r.Error == nil {
+				throttle.succeeded()
+			}
+		},
+	})
+}
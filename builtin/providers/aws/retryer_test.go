@@ -0,0 +1,92 @@
+package aws
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestThrottleState(t *testing.T) {
+	now := time.Unix(0, 0)
+	throttle := newThrottleState()
+	throttle.now = func() time.Time { return now }
+
+	if d := throttle.wait(); d != 0 {
+		t.Fatalf("expected no wait before throttling, got %s", d)
+	}
+
+	throttle.throttled()
+	if d := throttle.wait(); d != throttleMinDelay {
+		t.Fatalf("expected %s wait, got %s", throttleMinDelay, d)
+	}
+
+	throttle.throttled()
+	if d := throttle.wait(); d != 2*throttleMinDelay {
+		t.Fatalf("expected %s wait, got %s", 2*throttleMinDelay, d)
+	}
+
+	// The wait shrinks as time passes
+	now = now.Add(throttleMinDelay)
+	if d := throttle.wait(); d != throttleMinDelay {
+		t.Fatalf("expected %s wait, got %s", throttleMinDelay, d)
+	}
+
+	// The backoff is capped
+	for i := 0; i < 20; i++ {
+		throttle.throttled()
+	}
+	if d := throttle.wait(); d != throttleMaxDelay {
+		t.Fatalf("expected %s wait, got %s", throttleMaxDelay, d)
+	}
+
+	// Successful requests relax the backoff until it is gone
+	for i := 0; i < 20; i++ {
+		throttle.succeeded()
+	}
+	if d := throttle.wait(); d != 0 {
+		t.Fatalf("expected no wait after recovering, got %s", d)
+	}
+}
+
+func TestAwsRetryer(t *testing.T) {
+	now := time.Unix(0, 0)
+	throttle := newThrottleState()
+	throttle.now = func() time.Time { return now }
+	retryer := newAwsRetryer(11, throttle)
+
+	if retryer.MaxRetries() != 11 {
+		t.Fatalf("expected 11 max retries, got %d", retryer.MaxRetries())
+	}
+
+	notFound := &request.Request{
+		HTTPResponse: &http.Response{StatusCode: 400},
+		Error:        awserr.New("InvalidRouteTableID.NotFound", "not found", nil),
+	}
+	if retryer.ShouldRetry(notFound) {
+		t.Fatal("expected non-throttling client errors not to be retried")
+	}
+	if d := throttle.wait(); d != 0 {
+		t.Fatalf("expected no shared backoff, got %s", d)
+	}
+
+	limited := &request.Request{
+		HTTPResponse: &http.Response{StatusCode: 503},
+		Error:        awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil),
+	}
+	if !retryer.ShouldRetry(limited) {
+		t.Fatal("expected RequestLimitExceeded to be retried")
+	}
+	if d := throttle.wait(); d != throttleMinDelay {
+		t.Fatalf("expected %s shared backoff, got %s", throttleMinDelay, d)
+	}
+
+	for i := 0; i < 10; i++ {
+		retryer.ShouldRetry(limited)
+	}
+	if d := retryer.RetryRules(limited); d < throttleMaxDelay {
+		t.Fatalf("expected retry delay of at least %s, got %s", throttleMaxDelay, d)
+	}
+}
@@ -12,8 +12,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/batch"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecs"
@@ -170,6 +174,11 @@ func expandIPPerms(
 				*perm.FromPort, *perm.ToPort)
 		}
 
+		var description *string
+		if v, ok := m["description"].(string); ok && v != "" {
+			description = aws.String(v)
+		}
+
 		var groups []string
 		if raw, ok := m["security_groups"]; ok {
 			list := raw.(*schema.Set).List()
@@ -194,7 +203,8 @@ func expandIPPerms(
 				}
 
 				perm.UserIdGroupPairs[i] = &ec2.UserIdGroupPair{
-					GroupId: aws.String(id),
+					GroupId:     aws.String(id),
+					Description: description,
 				}
 
 				if ownerId != "" {
@@ -211,14 +221,20 @@ func expandIPPerms(
 		if raw, ok := m["cidr_blocks"]; ok {
 			list := raw.([]interface{})
 			for _, v := range list {
-				perm.IpRanges = append(perm.IpRanges, &ec2.IpRange{CidrIp: aws.String(v.(string))})
+				perm.IpRanges = append(perm.IpRanges, &ec2.IpRange{
+					CidrIp:      aws.String(v.(string)),
+					Description: description,
+				})
 			}
 		}
 
 		if raw, ok := m["prefix_list_ids"]; ok {
 			list := raw.([]interface{})
 			for _, v := range list {
-				perm.PrefixListIds = append(perm.PrefixListIds, &ec2.PrefixListId{PrefixListId: aws.String(v.(string))})
+				perm.PrefixListIds = append(perm.PrefixListIds, &ec2.PrefixListId{
+					PrefixListId: aws.String(v.(string)),
+					Description:  description,
+				})
 			}
 		}
 
@@ -294,6 +310,10 @@ func expandOptionConfiguration(configured []interface{}) ([]*rds.OptionConfigura
 			}
 		}
 
+		if raw, ok := data["version"]; ok && raw.(string) != "" {
+			o.OptionVersion = aws.String(raw.(string))
+		}
+
 		if raw, ok := data["db_security_group_memberships"]; ok {
 			memberships := expandStringList(raw.(*schema.Set).List())
 			if len(memberships) > 0 {
@@ -436,6 +456,57 @@ func expandStepAdjustments(configured []interface{}) ([]*autoscaling.StepAdjustm
 	return adjustments, nil
 }
 
+// Takes the result of flatmap.Expand for a target tracking configuration and
+// returns a *autoscaling.TargetTrackingConfiguration.
+func expandTargetTrackingConfiguration(configured []interface{}) *autoscaling.TargetTrackingConfiguration {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+	data := configured[0].(map[string]interface{})
+
+	c := &autoscaling.TargetTrackingConfiguration{
+		TargetValue: aws.Float64(data["target_value"].(float64)),
+	}
+	if v, ok := data["disable_scale_in"]; ok {
+		c.DisableScaleIn = aws.Bool(v.(bool))
+	}
+
+	if v, ok := data["predefined_metric_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		spec := v[0].(map[string]interface{})
+		c.PredefinedMetricSpecification = &autoscaling.PredefinedMetricSpecification{
+			PredefinedMetricType: aws.String(spec["predefined_metric_type"].(string)),
+		}
+		if label, ok := spec["resource_label"].(string); ok && label != "" {
+			c.PredefinedMetricSpecification.ResourceLabel = aws.String(label)
+		}
+	}
+
+	if v, ok := data["customized_metric_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		spec := v[0].(map[string]interface{})
+		c.CustomizedMetricSpecification = &autoscaling.CustomizedMetricSpecification{
+			MetricName: aws.String(spec["metric_name"].(string)),
+			Namespace:  aws.String(spec["namespace"].(string)),
+			Statistic:  aws.String(spec["statistic"].(string)),
+		}
+		if unit, ok := spec["unit"].(string); ok && unit != "" {
+			c.CustomizedMetricSpecification.Unit = aws.String(unit)
+		}
+		if dims, ok := spec["metric_dimension"].([]interface{}); ok {
+			for _, raw := range dims {
+				dim := raw.(map[string]interface{})
+				c.CustomizedMetricSpecification.Dimensions = append(
+					c.CustomizedMetricSpecification.Dimensions,
+					&autoscaling.MetricDimension{
+						Name:  aws.String(dim["name"].(string)),
+						Value: aws.String(dim["value"].(string)),
+					})
+			}
+		}
+	}
+
+	return c
+}
+
 // Flattens a health check into something that flatmap.Flatten()
 // can handle
 func flattenHealthCheck(check *elb.HealthCheck) []map[string]interface{} {
@@ -591,6 +662,91 @@ func flattenEcsContainerDefinitions(definitions []*ecs.ContainerDefinition) (str
 	return string(byteArray[:n]), nil
 }
 
+// Takes JSON in a string. Decodes JSON into a batch.ContainerProperties
+// compatible object
+func expandBatchContainerProperties(rawProperties string) (*batch.ContainerProperties, error) {
+	var props *batch.ContainerProperties
+
+	err := json.Unmarshal([]byte(rawProperties), &props)
+	if err != nil {
+		return nil, fmt.Errorf("Error decoding JSON: %s", err)
+	}
+
+	return props, nil
+}
+
+// Encodes a batch.ContainerProperties into a JSON string
+func flattenBatchContainerProperties(props *batch.ContainerProperties) (string, error) {
+	byteArray, err := json.Marshal(props)
+	if err != nil {
+		return "", fmt.Errorf("Error encoding to JSON: %s", err)
+	}
+
+	return string(byteArray), nil
+}
+
+// Reports whether two container properties JSON documents describe the
+// same container once the defaults the Batch API fills in are ignored.
+func batchContainerPropertiesAreEquivalent(a, b string) (bool, error) {
+	propsA, err := expandBatchContainerProperties(a)
+	if err != nil {
+		return false, err
+	}
+	propsB, err := expandBatchContainerProperties(b)
+	if err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(
+		canonicalBatchContainerProperties(propsA),
+		canonicalBatchContainerProperties(propsB),
+	), nil
+}
+
+func canonicalBatchContainerProperties(props *batch.ContainerProperties) *batch.ContainerProperties {
+	if props == nil {
+		return &batch.ContainerProperties{}
+	}
+
+	c := *props
+	if len(c.Command) == 0 {
+		c.Command = nil
+	}
+	if len(c.Environment) == 0 {
+		c.Environment = nil
+	} else {
+		env := make([]*batch.KeyValuePair, len(c.Environment))
+		copy(env, c.Environment)
+		sort.Sort(batchKeyValuePairs(env))
+		c.Environment = env
+	}
+	if len(c.MountPoints) == 0 {
+		c.MountPoints = nil
+	}
+	if len(c.Ulimits) == 0 {
+		c.Ulimits = nil
+	}
+	if len(c.Volumes) == 0 {
+		c.Volumes = nil
+	}
+	if !aws.BoolValue(c.Privileged) {
+		c.Privileged = nil
+	}
+	if !aws.BoolValue(c.ReadonlyRootFilesystem) {
+		c.ReadonlyRootFilesystem = nil
+	}
+
+	return &c
+}
+
+type batchKeyValuePairs []*batch.KeyValuePair
+
+func (s batchKeyValuePairs) Len() int      { return len(s) }
+func (s batchKeyValuePairs) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s batchKeyValuePairs) Less(i, j int) bool {
+	return aws.StringValue(s[i].Name) < aws.StringValue(s[j].Name)
+}
+
 // Flattens an array of Options into a []map[string]interface{}
 func flattenOptions(list []*rds.Option) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0, len(list))
@@ -603,6 +759,9 @@ func flattenOptions(list []*rds.Option) []map[string]interface{} {
 			if i.Port != nil {
 				r["port"] = int(*i.Port)
 			}
+			if i.OptionVersion != nil {
+				r["version"] = *i.OptionVersion
+			}
 			if i.VpcSecurityGroupMemberships != nil {
 				vpcs := make([]string, 0, len(i.VpcSecurityGroupMemberships))
 				for _, vpc := range i.VpcSecurityGroupMemberships {
@@ -800,6 +959,49 @@ func flattenStepAdjustments(adjustments []*autoscaling.StepAdjustment) []map[str
 	return result
 }
 
+// Flattens a target tracking configuration into a list of
+// map[string]interface.
+func flattenTargetTrackingConfiguration(c *autoscaling.TargetTrackingConfiguration) []map[string]interface{} {
+	if c == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"target_value":     aws.Float64Value(c.TargetValue),
+		"disable_scale_in": aws.BoolValue(c.DisableScaleIn),
+	}
+
+	if spec := c.PredefinedMetricSpecification; spec != nil {
+		m["predefined_metric_specification"] = []map[string]interface{}{
+			{
+				"predefined_metric_type": aws.StringValue(spec.PredefinedMetricType),
+				"resource_label":         aws.StringValue(spec.ResourceLabel),
+			},
+		}
+	}
+
+	if spec := c.CustomizedMetricSpecification; spec != nil {
+		dims := make([]map[string]interface{}, 0, len(spec.Dimensions))
+		for _, dim := range spec.Dimensions {
+			dims = append(dims, map[string]interface{}{
+				"name":  aws.StringValue(dim.Name),
+				"value": aws.StringValue(dim.Value),
+			})
+		}
+		m["customized_metric_specification"] = []map[string]interface{}{
+			{
+				"metric_dimension": dims,
+				"metric_name":      aws.StringValue(spec.MetricName),
+				"namespace":        aws.StringValue(spec.Namespace),
+				"statistic":        aws.StringValue(spec.Statistic),
+				"unit":             aws.StringValue(spec.Unit),
+			},
+		}
+	}
+
+	return []map[string]interface{}{m}
+}
+
 func flattenResourceRecords(recs []*route53.ResourceRecord) []string {
 	strs := make([]string, 0, len(recs))
 	for _, r := range recs {
@@ -970,6 +1172,30 @@ func flattenLambdaVpcConfigResponse(s *lambda.VpcConfigResponse) []map[string]in
 	return []map[string]interface{}{settings}
 }
 
+func flattenLambdaEnvironment(s *lambda.EnvironmentResponse) []map[string]interface{} {
+	if s == nil || len(s.Variables) == 0 {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"variables": pointersMapToStringList(s.Variables),
+		},
+	}
+}
+
+func flattenLambdaDeadLetterConfig(s *lambda.DeadLetterConfig) []map[string]interface{} {
+	if s == nil || s.TargetArn == nil || *s.TargetArn == "" {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"target_arn": *s.TargetArn,
+		},
+	}
+}
+
 func flattenDSConnectSettings(
 	customerDnsIps []*string,
 	s *directoryservice.DirectoryConnectSettingsDescription) []map[string]interface{} {
@@ -1418,6 +1644,30 @@ func flattenApiGatewayThrottleSettings(settings *apigateway.ThrottleSettings) []
 	return result
 }
 
+func expandApiGatewayEndpointConfiguration(l []interface{}) *apigateway.EndpointConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &apigateway.EndpointConfiguration{
+		Types: expandStringList(m["types"].([]interface{})),
+	}
+}
+
+func flattenApiGatewayEndpointConfiguration(ec *apigateway.EndpointConfiguration) []interface{} {
+	if ec == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"types": flattenStringList(ec.Types),
+	}
+
+	return []interface{}{m}
+}
+
 // TODO: refactor some of these helper functions and types in the terraform/helper packages
 
 // getStringPtr returns a *string version of the value taken from m, where m
@@ -1612,3 +1862,269 @@ func normalizeJsonString(jsonString interface{}) (string, error) {
 	bytes, _ := json.Marshal(j)
 	return string(bytes[:]), nil
 }
+
+func expandCognitoUserPoolPasswordPolicy(config map[string]interface{}) *cognitoidentityprovider.PasswordPolicyType {
+	return &cognitoidentityprovider.PasswordPolicyType{
+		MinimumLength:    aws.Int64(int64(config["minimum_length"].(int))),
+		RequireLowercase: aws.Bool(config["require_lowercase"].(bool)),
+		RequireNumbers:   aws.Bool(config["require_numbers"].(bool)),
+		RequireSymbols:   aws.Bool(config["require_symbols"].(bool)),
+		RequireUppercase: aws.Bool(config["require_uppercase"].(bool)),
+	}
+}
+
+func flattenCognitoUserPoolPasswordPolicy(s *cognitoidentityprovider.PasswordPolicyType) []map[string]interface{} {
+	m := map[string]interface{}{
+		"minimum_length":    int(aws.Int64Value(s.MinimumLength)),
+		"require_lowercase": aws.BoolValue(s.RequireLowercase),
+		"require_numbers":   aws.BoolValue(s.RequireNumbers),
+		"require_symbols":   aws.BoolValue(s.RequireSymbols),
+		"require_uppercase": aws.BoolValue(s.RequireUppercase),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func expandCognitoUserPoolLambdaConfig(config map[string]interface{}) *cognitoidentityprovider.LambdaConfigType {
+	configs := &cognitoidentityprovider.LambdaConfigType{}
+
+	if v, ok := config["create_auth_challenge"]; ok && v.(string) != "" {
+		configs.CreateAuthChallenge = aws.String(v.(string))
+	}
+	if v, ok := config["custom_message"]; ok && v.(string) != "" {
+		configs.CustomMessage = aws.String(v.(string))
+	}
+	if v, ok := config["define_auth_challenge"]; ok && v.(string) != "" {
+		configs.DefineAuthChallenge = aws.String(v.(string))
+	}
+	if v, ok := config["post_authentication"]; ok && v.(string) != "" {
+		configs.PostAuthentication = aws.String(v.(string))
+	}
+	if v, ok := config["post_confirmation"]; ok && v.(string) != "" {
+		configs.PostConfirmation = aws.String(v.(string))
+	}
+	if v, ok := config["pre_authentication"]; ok && v.(string) != "" {
+		configs.PreAuthentication = aws.String(v.(string))
+	}
+	if v, ok := config["pre_sign_up"]; ok && v.(string) != "" {
+		configs.PreSignUp = aws.String(v.(string))
+	}
+	if v, ok := config["verify_auth_challenge_response"]; ok && v.(string) != "" {
+		configs.VerifyAuthChallengeResponse = aws.String(v.(string))
+	}
+
+	return configs
+}
+
+func flattenCognitoUserPoolLambdaConfig(s *cognitoidentityprovider.LambdaConfigType) []map[string]interface{} {
+	m := map[string]interface{}{
+		"create_auth_challenge":          aws.StringValue(s.CreateAuthChallenge),
+		"custom_message":                 aws.StringValue(s.CustomMessage),
+		"define_auth_challenge":          aws.StringValue(s.DefineAuthChallenge),
+		"post_authentication":            aws.StringValue(s.PostAuthentication),
+		"post_confirmation":              aws.StringValue(s.PostConfirmation),
+		"pre_authentication":             aws.StringValue(s.PreAuthentication),
+		"pre_sign_up":                    aws.StringValue(s.PreSignUp),
+		"verify_auth_challenge_response": aws.StringValue(s.VerifyAuthChallengeResponse),
+	}
+
+	// An empty lambda_config block isn't meaningful, so don't report one.
+	for _, v := range m {
+		if v.(string) != "" {
+			return []map[string]interface{}{m}
+		}
+	}
+
+	return nil
+}
+
+func expandCognitoUserPoolSchema(inputs []interface{}) []*cognitoidentityprovider.SchemaAttributeType {
+	configs := make([]*cognitoidentityprovider.SchemaAttributeType, 0, len(inputs))
+
+	for _, input := range inputs {
+		param := input.(map[string]interface{})
+		config := &cognitoidentityprovider.SchemaAttributeType{
+			AttributeDataType:      aws.String(param["attribute_data_type"].(string)),
+			DeveloperOnlyAttribute: aws.Bool(param["developer_only_attribute"].(bool)),
+			Mutable:                aws.Bool(param["mutable"].(bool)),
+			Name:                   aws.String(param["name"].(string)),
+			Required:               aws.Bool(param["required"].(bool)),
+		}
+
+		if v, ok := param["number_attribute_constraints"]; ok && len(v.([]interface{})) > 0 {
+			data := v.([]interface{})[0].(map[string]interface{})
+			config.NumberAttributeConstraints = &cognitoidentityprovider.NumberAttributeConstraintsType{}
+			if v := data["min_value"].(string); v != "" {
+				config.NumberAttributeConstraints.MinValue = aws.String(v)
+			}
+			if v := data["max_value"].(string); v != "" {
+				config.NumberAttributeConstraints.MaxValue = aws.String(v)
+			}
+		}
+
+		if v, ok := param["string_attribute_constraints"]; ok && len(v.([]interface{})) > 0 {
+			data := v.([]interface{})[0].(map[string]interface{})
+			config.StringAttributeConstraints = &cognitoidentityprovider.StringAttributeConstraintsType{}
+			if v := data["min_length"].(string); v != "" {
+				config.StringAttributeConstraints.MinLength = aws.String(v)
+			}
+			if v := data["max_length"].(string); v != "" {
+				config.StringAttributeConstraints.MaxLength = aws.String(v)
+			}
+		}
+
+		configs = append(configs, config)
+	}
+
+	return configs
+}
+
+func expandCognitoIdentityProviders(s *schema.Set) []*cognitoidentity.Provider {
+	ips := make([]*cognitoidentity.Provider, 0)
+
+	for _, v := range s.List() {
+		s := v.(map[string]interface{})
+
+		ip := &cognitoidentity.Provider{}
+
+		if sv, ok := s["client_id"].(string); ok {
+			ip.ClientId = aws.String(sv)
+		}
+
+		if sv, ok := s["provider_name"].(string); ok {
+			ip.ProviderName = aws.String(sv)
+		}
+
+		if sv, ok := s["server_side_token_check"].(bool); ok {
+			ip.ServerSideTokenCheck = aws.Bool(sv)
+		}
+
+		ips = append(ips, ip)
+	}
+
+	return ips
+}
+
+func flattenCognitoIdentityProviders(ips []*cognitoidentity.Provider) []map[string]interface{} {
+	values := make([]map[string]interface{}, 0)
+
+	for _, v := range ips {
+		ip := make(map[string]interface{})
+
+		if v == nil {
+			return nil
+		}
+
+		if v.ClientId != nil {
+			ip["client_id"] = *v.ClientId
+		}
+
+		if v.ProviderName != nil {
+			ip["provider_name"] = *v.ProviderName
+		}
+
+		if v.ServerSideTokenCheck != nil {
+			ip["server_side_token_check"] = *v.ServerSideTokenCheck
+		}
+
+		values = append(values, ip)
+	}
+
+	return values
+}
+
+func expandDmsMongoDbSettings(configured []interface{}) *dms.MongoDbSettings {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+	m := configured[0].(map[string]interface{})
+
+	settings := &dms.MongoDbSettings{}
+	if v := m["auth_type"].(string); v != "" {
+		settings.AuthType = aws.String(v)
+	}
+	if v := m["auth_mechanism"].(string); v != "" {
+		settings.AuthMechanism = aws.String(v)
+	}
+	if v := m["auth_source"].(string); v != "" {
+		settings.AuthSource = aws.String(v)
+	}
+	if v := m["nesting_level"].(string); v != "" {
+		settings.NestingLevel = aws.String(v)
+	}
+	if v := m["extract_doc_id"].(string); v != "" {
+		settings.ExtractDocId = aws.String(v)
+	}
+	if v := m["docs_to_investigate"].(string); v != "" {
+		settings.DocsToInvestigate = aws.String(v)
+	}
+
+	return settings
+}
+
+func flattenDmsMongoDbSettings(settings *dms.MongoDbSettings) []map[string]interface{} {
+	if settings == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"auth_type":           aws.StringValue(settings.AuthType),
+		"auth_mechanism":      aws.StringValue(settings.AuthMechanism),
+		"auth_source":         aws.StringValue(settings.AuthSource),
+		"nesting_level":       aws.StringValue(settings.NestingLevel),
+		"extract_doc_id":      aws.StringValue(settings.ExtractDocId),
+		"docs_to_investigate": aws.StringValue(settings.DocsToInvestigate),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func expandDmsS3Settings(configured []interface{}) *dms.S3Settings {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+	m := configured[0].(map[string]interface{})
+
+	settings := &dms.S3Settings{}
+	if v := m["service_access_role_arn"].(string); v != "" {
+		settings.ServiceAccessRoleArn = aws.String(v)
+	}
+	if v := m["external_table_definition"].(string); v != "" {
+		settings.ExternalTableDefinition = aws.String(v)
+	}
+	if v := m["csv_row_delimiter"].(string); v != "" {
+		settings.CsvRowDelimiter = aws.String(v)
+	}
+	if v := m["csv_delimiter"].(string); v != "" {
+		settings.CsvDelimiter = aws.String(v)
+	}
+	if v := m["bucket_folder"].(string); v != "" {
+		settings.BucketFolder = aws.String(v)
+	}
+	if v := m["bucket_name"].(string); v != "" {
+		settings.BucketName = aws.String(v)
+	}
+	if v := m["compression_type"].(string); v != "" {
+		settings.CompressionType = aws.String(v)
+	}
+
+	return settings
+}
+
+func flattenDmsS3Settings(settings *dms.S3Settings) []map[string]interface{} {
+	if settings == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"service_access_role_arn":   aws.StringValue(settings.ServiceAccessRoleArn),
+		"external_table_definition": aws.StringValue(settings.ExternalTableDefinition),
+		"csv_row_delimiter":         aws.StringValue(settings.CsvRowDelimiter),
+		"csv_delimiter":             aws.StringValue(settings.CsvDelimiter),
+		"bucket_folder":             aws.StringValue(settings.BucketFolder),
+		"bucket_name":               aws.StringValue(settings.BucketName),
+		"compression_type":          aws.StringValue(settings.CompressionType),
+	}
+
+	return []map[string]interface{}{m}
+}
@@ -8,6 +8,9 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/aws/aws-sdk-go/service/elb"
@@ -798,6 +801,89 @@ func TestFlattenStepAdjustments(t *testing.T) {
 	}
 }
 
+func TestExpandTargetTrackingConfiguration(t *testing.T) {
+	expanded := []interface{}{
+		map[string]interface{}{
+			"target_value":     40.0,
+			"disable_scale_in": true,
+			"predefined_metric_specification": []interface{}{
+				map[string]interface{}{
+					"predefined_metric_type": "ASGAverageCPUUtilization",
+					"resource_label":         "",
+				},
+			},
+			"customized_metric_specification": []interface{}{},
+		},
+	}
+	result := expandTargetTrackingConfiguration(expanded)
+
+	expected := &autoscaling.TargetTrackingConfiguration{
+		TargetValue:    aws.Float64(40.0),
+		DisableScaleIn: aws.Bool(true),
+		PredefinedMetricSpecification: &autoscaling.PredefinedMetricSpecification{
+			PredefinedMetricType: aws.String("ASGAverageCPUUtilization"),
+		},
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			result,
+			expected)
+	}
+
+	if result := expandTargetTrackingConfiguration(nil); result != nil {
+		t.Fatalf("expected nil, got %#v", result)
+	}
+}
+
+func TestFlattenTargetTrackingConfiguration(t *testing.T) {
+	config := &autoscaling.TargetTrackingConfiguration{
+		TargetValue: aws.Float64(100.0),
+		CustomizedMetricSpecification: &autoscaling.CustomizedMetricSpecification{
+			Dimensions: []*autoscaling.MetricDimension{
+				&autoscaling.MetricDimension{
+					Name:  aws.String("fuga"),
+					Value: aws.String("fuga"),
+				},
+			},
+			MetricName: aws.String("hoge"),
+			Namespace:  aws.String("hoge"),
+			Statistic:  aws.String("Average"),
+		},
+	}
+
+	result := flattenTargetTrackingConfiguration(config)
+	expected := []map[string]interface{}{
+		{
+			"target_value":     100.0,
+			"disable_scale_in": false,
+			"customized_metric_specification": []map[string]interface{}{
+				{
+					"metric_dimension": []map[string]interface{}{
+						{"name": "fuga", "value": "fuga"},
+					},
+					"metric_name": "hoge",
+					"namespace":   "hoge",
+					"statistic":   "Average",
+					"unit":        "",
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			result,
+			expected)
+	}
+
+	if flattenTargetTrackingConfiguration(nil) != nil {
+		t.Fatal("expected nil for a nil configuration")
+	}
+}
+
 func TestFlattenResourceRecords(t *testing.T) {
 	expanded := []*route53.ResourceRecord{
 		&route53.ResourceRecord{
@@ -1168,3 +1254,154 @@ func TestNormalizeJsonString(t *testing.T) {
 		t.Fatalf("Got:\n\n%s\n\nExpected:\n\n%s\n", expected, invalidJson)
 	}
 }
+
+func TestBatchContainerPropertiesAreEquivalent(t *testing.T) {
+	cases := []struct {
+		Name       string
+		A, B       string
+		Equivalent bool
+	}{
+		{
+			Name:       "whitespace and key order",
+			A:          `{"image": "busybox", "vcpus": 1, "memory": 128}`,
+			B:          `{"memory":128,"image":"busybox","vcpus":1}`,
+			Equivalent: true,
+		},
+		{
+			Name: "API defaults",
+			A:    `{"image": "busybox", "vcpus": 1, "memory": 128}`,
+			B: `{"image": "busybox", "vcpus": 1, "memory": 128, "command": [],
+				"environment": [], "mountPoints": [], "ulimits": [], "volumes": [],
+				"privileged": false, "readonlyRootFilesystem": false}`,
+			Equivalent: true,
+		},
+		{
+			Name: "environment order",
+			A: `{"image": "busybox", "environment": [
+				{"name": "A", "value": "1"}, {"name": "B", "value": "2"}]}`,
+			B: `{"image": "busybox", "environment": [
+				{"name": "B", "value": "2"}, {"name": "A", "value": "1"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "different memory",
+			A:          `{"image": "busybox", "memory": 128}`,
+			B:          `{"image": "busybox", "memory": 256}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "command order matters",
+			A:          `{"image": "busybox", "command": ["ls", "-la"]}`,
+			B:          `{"image": "busybox", "command": ["-la", "ls"]}`,
+			Equivalent: false,
+		},
+	}
+
+	for _, tc := range cases {
+		equivalent, err := batchContainerPropertiesAreEquivalent(tc.A, tc.B)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.Name, err)
+		}
+		if equivalent != tc.Equivalent {
+			t.Fatalf("%s: expected equivalent to be %t", tc.Name, tc.Equivalent)
+		}
+	}
+
+	if _, err := batchContainerPropertiesAreEquivalent(`{"image": `, `{}`); err == nil {
+		t.Fatalf("expected an error for invalid JSON")
+	}
+}
+
+func TestFlattenCognitoIdentityProviders(t *testing.T) {
+	expanded := []*cognitoidentity.Provider{
+		&cognitoidentity.Provider{
+			ClientId:             aws.String("7lhlkkfbfb4q5kpp90urffao"),
+			ProviderName:         aws.String("cognito-idp.us-east-1.amazonaws.com/us-east-1_Zr231apJu"),
+			ServerSideTokenCheck: aws.Bool(false),
+		},
+	}
+
+	result := flattenCognitoIdentityProviders(expanded)
+
+	expected := []map[string]interface{}{
+		map[string]interface{}{
+			"client_id":               "7lhlkkfbfb4q5kpp90urffao",
+			"provider_name":           "cognito-idp.us-east-1.amazonaws.com/us-east-1_Zr231apJu",
+			"server_side_token_check": false,
+		},
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			result,
+			expected)
+	}
+}
+
+func TestFlattenCognitoUserPoolLambdaConfig(t *testing.T) {
+	if result := flattenCognitoUserPoolLambdaConfig(&cognitoidentityprovider.LambdaConfigType{}); result != nil {
+		t.Fatalf("expected no lambda_config for an empty config, got %#v", result)
+	}
+
+	result := flattenCognitoUserPoolLambdaConfig(&cognitoidentityprovider.LambdaConfigType{
+		PreSignUp: aws.String("arn:aws:lambda:us-east-1:123456789012:function:pre_sign_up"),
+	})
+	if len(result) != 1 {
+		t.Fatalf("expected one lambda_config, got %#v", result)
+	}
+	if v := result[0]["pre_sign_up"]; v != "arn:aws:lambda:us-east-1:123456789012:function:pre_sign_up" {
+		t.Fatalf("bad pre_sign_up: %#v", v)
+	}
+	if v := result[0]["custom_message"]; v != "" {
+		t.Fatalf("bad custom_message: %#v", v)
+	}
+}
+
+func TestExpandDmsS3Settings(t *testing.T) {
+	if result := expandDmsS3Settings([]interface{}{}); result != nil {
+		t.Fatalf("expected no S3 settings, got %#v", result)
+	}
+
+	result := expandDmsS3Settings([]interface{}{
+		map[string]interface{}{
+			"service_access_role_arn":   "arn:aws:iam::123456789012:role/dms",
+			"external_table_definition": "",
+			"csv_row_delimiter":         "\\n",
+			"csv_delimiter":             ",",
+			"bucket_folder":             "",
+			"bucket_name":               "bucket",
+			"compression_type":          "GZIP",
+		},
+	})
+	expected := &dms.S3Settings{
+		ServiceAccessRoleArn: aws.String("arn:aws:iam::123456789012:role/dms"),
+		CsvRowDelimiter:      aws.String("\\n"),
+		CsvDelimiter:         aws.String(","),
+		BucketName:           aws.String("bucket"),
+		CompressionType:      aws.String("GZIP"),
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", result, expected)
+	}
+}
+
+func TestFlattenDmsMongoDbSettings(t *testing.T) {
+	if result := flattenDmsMongoDbSettings(nil); result != nil {
+		t.Fatalf("expected no mongodb_settings, got %#v", result)
+	}
+
+	result := flattenDmsMongoDbSettings(&dms.MongoDbSettings{
+		AuthType:     aws.String("password"),
+		NestingLevel: aws.String("none"),
+	})
+	if len(result) != 1 {
+		t.Fatalf("expected one mongodb_settings, got %#v", result)
+	}
+	if v := result[0]["auth_type"]; v != "password" {
+		t.Fatalf("bad auth_type: %#v", v)
+	}
+	if v := result[0]["auth_source"]; v != "" {
+		t.Fatalf("bad auth_source: %#v", v)
+	}
+}
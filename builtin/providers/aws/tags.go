@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"fmt"
 	"log"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 // tagsSchema returns the schema to use for tags.
@@ -30,6 +32,45 @@ func tagsSchemaComputed() *schema.Schema {
 	}
 }
 
+// validateRequiredTags checks that resources with tags set every tag key
+// required by the tag_policy of the provider.
+func validateRequiredTags(
+	info *terraform.InstanceInfo, r *schema.Resource,
+	c *terraform.ResourceConfig, meta interface{}) error {
+	client, ok := meta.(*AWSClient)
+	if !ok || len(client.requiredTagKeys) == 0 {
+		return nil
+	}
+
+	// Resources that can't be tagged are ignored, as are tags that aren't
+	// known until apply.
+	if _, ok := r.Schema["tags"]; !ok {
+		return nil
+	}
+	if c.IsComputed("tags") {
+		return nil
+	}
+
+	var tags map[string]interface{}
+	if v, ok := c.Get("tags"); ok {
+		tags, _ = v.(map[string]interface{})
+	}
+
+	var missing []string
+	for _, k := range client.requiredTagKeys {
+		if _, ok := tags[k]; !ok {
+			missing = append(missing, k)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf(
+			"%s: missing tags required by the tag_policy: %s",
+			info.Id, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
 func setElbV2Tags(conn *elbv2.ELBV2, d *schema.ResourceData) error {
 	if d.HasChange("tags") {
 		oraw, nraw := d.GetChange("tags")
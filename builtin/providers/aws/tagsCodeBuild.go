@@ -0,0 +1,31 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+)
+
+// tagsFromMapCodeBuild returns the tags for the given map of data.
+// CodeBuild replaces the full set of tags on update, so unlike other
+// services no diffing is required.
+func tagsFromMapCodeBuild(m map[string]interface{}) []*codebuild.Tag {
+	result := make([]*codebuild.Tag, 0, len(m))
+	for k, v := range m {
+		result = append(result, &codebuild.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return result
+}
+
+// tagsToMapCodeBuild turns the list of tags into a map.
+func tagsToMapCodeBuild(ts []*codebuild.Tag) map[string]string {
+	result := make(map[string]string)
+	for _, t := range ts {
+		result[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	return result
+}
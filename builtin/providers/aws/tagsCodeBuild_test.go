@@ -0,0 +1,26 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTagsCodeBuild_roundTrip(t *testing.T) {
+	in := map[string]interface{}{
+		"foo":  "bar",
+		"Name": "test",
+	}
+
+	out := tagsToMapCodeBuild(tagsFromMapCodeBuild(in))
+	expected := map[string]string{
+		"foo":  "bar",
+		"Name": "test",
+	}
+	if !reflect.DeepEqual(out, expected) {
+		t.Fatalf("bad: %#v", out)
+	}
+
+	if len(tagsFromMapCodeBuild(map[string]interface{}{})) != 0 {
+		t.Fatal("expected no tags for an empty map")
+	}
+}
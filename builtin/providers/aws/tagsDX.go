@@ -0,0 +1,128 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// buildDXARN returns the ARN of a Direct Connect resource, such as
+// "dxcon/dxcon-abcd1234", which tags are attached to.
+func buildDXARN(resource string, meta interface{}) (string, error) {
+	client := meta.(*AWSClient)
+	if client.partition == "" {
+		return "", fmt.Errorf("Unable to construct Direct Connect ARN because of missing AWS partition")
+	}
+	if client.accountid == "" {
+		return "", fmt.Errorf("Unable to construct Direct Connect ARN because of missing AWS Account ID")
+	}
+	arn := fmt.Sprintf("arn:%s:directconnect:%s:%s:%s",
+		client.partition, client.region, client.accountid, resource)
+	return arn, nil
+}
+
+// setTags is a helper to set the tags for a resource. It expects the
+// tags field to be named "tags"
+func setTagsDX(conn *directconnect.DirectConnect, d *schema.ResourceData, arn string) error {
+	if d.HasChange("tags") {
+		oraw, nraw := d.GetChange("tags")
+		o := oraw.(map[string]interface{})
+		n := nraw.(map[string]interface{})
+		create, remove := diffTagsDX(tagsFromMapDX(o), tagsFromMapDX(n))
+
+		// Set tags
+		if len(remove) > 0 {
+			log.Printf("[DEBUG] Removing tags: %#v", remove)
+			k := make([]*string, 0, len(remove))
+			for _, t := range remove {
+				k = append(k, t.Key)
+			}
+			_, err := conn.UntagResource(&directconnect.UntagResourceInput{
+				ResourceArn: aws.String(arn),
+				TagKeys:     k,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if len(create) > 0 {
+			log.Printf("[DEBUG] Creating tags: %#v", create)
+			_, err := conn.TagResource(&directconnect.TagResourceInput{
+				ResourceArn: aws.String(arn),
+				Tags:        create,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// getTagsDX reads the tags of the resource with the given ARN into the
+// "tags" field.
+func getTagsDX(conn *directconnect.DirectConnect, d *schema.ResourceData, arn string) error {
+	resp, err := conn.DescribeTags(&directconnect.DescribeTagsInput{
+		ResourceArns: []*string{aws.String(arn)},
+	})
+	if err != nil {
+		return err
+	}
+
+	var tags []*directconnect.Tag
+	if len(resp.ResourceTags) == 1 && aws.StringValue(resp.ResourceTags[0].ResourceArn) == arn {
+		tags = resp.ResourceTags[0].Tags
+	}
+
+	return d.Set("tags", tagsToMapDX(tags))
+}
+
+// diffTags takes our tags locally and the ones remotely and returns
+// the set of tags that must be created, and the set of tags that must
+// be destroyed.
+func diffTagsDX(oldTags, newTags []*directconnect.Tag) ([]*directconnect.Tag, []*directconnect.Tag) {
+	// First, we're creating everything we have
+	create := make(map[string]interface{})
+	for _, t := range newTags {
+		create[*t.Key] = *t.Value
+	}
+
+	// Build the list of what to remove
+	var remove []*directconnect.Tag
+	for _, t := range oldTags {
+		old, ok := create[*t.Key]
+		if !ok || old != *t.Value {
+			// Delete it!
+			remove = append(remove, t)
+		}
+	}
+
+	return tagsFromMapDX(create), remove
+}
+
+// tagsFromMap returns the tags for the given map of data.
+func tagsFromMapDX(m map[string]interface{}) []*directconnect.Tag {
+	var result []*directconnect.Tag
+	for k, v := range m {
+		result = append(result, &directconnect.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return result
+}
+
+// tagsToMap turns the list of tags into a map.
+func tagsToMapDX(ts []*directconnect.Tag) map[string]string {
+	result := make(map[string]string)
+	for _, t := range ts {
+		result[*t.Key] = *t.Value
+	}
+
+	return result
+}
@@ -0,0 +1,90 @@
+package aws
+
+import (
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// setTagsACM is a helper to set the tags for a certificate. It expects the
+// tags field to be named "tags"
+func setTagsACM(conn *acm.ACM, d *schema.ResourceData) error {
+	if d.HasChange("tags") {
+		oraw, nraw := d.GetChange("tags")
+		o := oraw.(map[string]interface{})
+		n := nraw.(map[string]interface{})
+		create, remove := diffTagsACM(tagsFromMapACM(o), tagsFromMapACM(n))
+
+		// Set tags
+		if len(remove) > 0 {
+			log.Printf("[DEBUG] Removing tags: %#v", remove)
+			_, err := conn.RemoveTagsFromCertificate(&acm.RemoveTagsFromCertificateInput{
+				CertificateArn: aws.String(d.Id()),
+				Tags:           remove,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if len(create) > 0 {
+			log.Printf("[DEBUG] Creating tags: %#v", create)
+			_, err := conn.AddTagsToCertificate(&acm.AddTagsToCertificateInput{
+				CertificateArn: aws.String(d.Id()),
+				Tags:           create,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// diffTags takes our tags locally and the ones remotely and returns
+// the set of tags that must be created, and the set of tags that must
+// be destroyed.
+func diffTagsACM(oldTags, newTags []*acm.Tag) ([]*acm.Tag, []*acm.Tag) {
+	// First, we're creating everything we have
+	create := make(map[string]interface{})
+	for _, t := range newTags {
+		create[*t.Key] = aws.StringValue(t.Value)
+	}
+
+	// Build the list of what to remove
+	var remove []*acm.Tag
+	for _, t := range oldTags {
+		old, ok := create[*t.Key]
+		if !ok || old != aws.StringValue(t.Value) {
+			// Delete it!
+			remove = append(remove, t)
+		}
+	}
+
+	return tagsFromMapACM(create), remove
+}
+
+// tagsFromMap returns the tags for the given map of data.
+func tagsFromMapACM(m map[string]interface{}) []*acm.Tag {
+	var result []*acm.Tag
+	for k, v := range m {
+		result = append(result, &acm.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return result
+}
+
+// tagsToMap turns the list of tags into a map.
+func tagsToMapACM(ts []*acm.Tag) map[string]string {
+	result := make(map[string]string)
+	for _, t := range ts {
+		result[*t.Key] = aws.StringValue(t.Value)
+	}
+
+	return result
+}
@@ -6,7 +6,9 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -61,6 +63,80 @@ func TestDiffTags(t *testing.T) {
 	}
 }
 
+func TestValidateRequiredTags(t *testing.T) {
+	cases := []struct {
+		Type         string
+		Config       map[string]interface{}
+		RequiredKeys []string
+		ExpectError  bool
+	}{
+		// No tag policy
+		{
+			Type:   "aws_vpc",
+			Config: map[string]interface{}{"cidr_block": "10.0.0.0/16"},
+		},
+
+		// All required tags set
+		{
+			Type: "aws_vpc",
+			Config: map[string]interface{}{
+				"cidr_block": "10.0.0.0/16",
+				"tags": map[string]interface{}{
+					"Owner":   "ops",
+					"Project": "terraform",
+				},
+			},
+			RequiredKeys: []string{"Owner", "Project"},
+		},
+
+		// A required tag is missing
+		{
+			Type: "aws_vpc",
+			Config: map[string]interface{}{
+				"cidr_block": "10.0.0.0/16",
+				"tags": map[string]interface{}{
+					"Owner": "ops",
+				},
+			},
+			RequiredKeys: []string{"Owner", "Project"},
+			ExpectError:  true,
+		},
+
+		// No tags at all
+		{
+			Type:         "aws_vpc",
+			Config:       map[string]interface{}{"cidr_block": "10.0.0.0/16"},
+			RequiredKeys: []string{"Owner"},
+			ExpectError:  true,
+		},
+
+		// Resources that can't be tagged are ignored
+		{
+			Type:         "aws_route",
+			Config:       map[string]interface{}{"route_table_id": "rtb-12345678"},
+			RequiredKeys: []string{"Owner"},
+		},
+	}
+
+	p := Provider().(*schema.Provider)
+	for i, tc := range cases {
+		raw, err := config.NewRawConfig(tc.Config)
+		if err != nil {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+
+		info := &terraform.InstanceInfo{Id: tc.Type + ".foo", Type: tc.Type}
+		meta := &AWSClient{requiredTagKeys: tc.RequiredKeys}
+		err = validateRequiredTags(info, p.ResourcesMap[tc.Type], terraform.NewResourceConfig(raw), meta)
+		if err != nil && !tc.ExpectError {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+		if err == nil && tc.ExpectError {
+			t.Fatalf("%d: expected error", i)
+		}
+	}
+}
+
 // testAccCheckTags can be used to check the tags on a resource.
 func testAccCheckTags(
 	ts *[]*ec2.Tag, key string, value string) resource.TestCheckFunc {
@@ -3,10 +3,23 @@ package aws
 import (
 	"fmt"
 	"net"
+	"net/url"
 	"regexp"
+	"strconv"
 	"time"
 
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -226,6 +239,15 @@ func validateCloudWatchEventTargetId(v interface{}, k string) (ws []string, erro
 	return
 }
 
+func validateCloudWatchEventTargetEcsTaskCount(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if value < 1 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be at least 1: %d", k, value))
+	}
+	return
+}
+
 func validateLambdaFunctionName(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if len(value) > 140 {
@@ -288,6 +310,24 @@ func validateAwsAccountId(v interface{}, k string) (ws []string, errors []error)
 	return
 }
 
+func validateAmazonSideAsn(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	// http://docs.aws.amazon.com/directconnect/latest/APIReference/API_CreateDirectConnectGateway.html
+	asn, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%q) must be a 64-bit integer", k, v))
+		return
+	}
+
+	if (asn < 64512) || (asn > 65534 && asn < 4200000000) || (asn > 4294967294) {
+		errors = append(errors, fmt.Errorf(
+			"%q (%q) must be in the range 64512 to 65534 or 4200000000 to 4294967294", k, v))
+	}
+
+	return
+}
+
 func validateArn(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
@@ -443,6 +483,72 @@ func validateS3BucketLifecycleStorageClass(v interface{}, k string) (ws []string
 	return
 }
 
+func validateS3BucketReplicationRuleId(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) > 255 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be longer than 255 characters: %q", k, value))
+	}
+
+	return
+}
+
+func validateS3BucketReplicationRulePrefix(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) > 1024 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be longer than 1024 characters: %q", k, value))
+	}
+
+	return
+}
+
+func validateS3BucketReplicationDestinationStorageClass(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value != s3.StorageClassStandard && value != s3.StorageClassStandardIa && value != s3.StorageClassReducedRedundancy {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of %q, %q or %q", k, s3.StorageClassStandard, s3.StorageClassStandardIa, s3.StorageClassReducedRedundancy))
+	}
+
+	return
+}
+
+func validateS3BucketReplicationRuleStatus(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value != s3.ReplicationRuleStatusEnabled && value != s3.ReplicationRuleStatusDisabled {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of %q or %q", k, s3.ReplicationRuleStatusEnabled, s3.ReplicationRuleStatusDisabled))
+	}
+
+	return
+}
+
+func validateS3BucketNotificationEvent(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	events := []string{
+		s3.EventS3ReducedRedundancyLostObject,
+		s3.EventS3ObjectCreated,
+		s3.EventS3ObjectCreatedPut,
+		s3.EventS3ObjectCreatedPost,
+		s3.EventS3ObjectCreatedCopy,
+		s3.EventS3ObjectCreatedCompleteMultipartUpload,
+		s3.EventS3ObjectRemoved,
+		s3.EventS3ObjectRemovedDelete,
+		s3.EventS3ObjectRemovedDeleteMarkerCreated,
+		s3.EventS3ObjectRestorePost,
+		s3.EventS3ObjectRestoreCompleted,
+	}
+	for _, e := range events {
+		if value == e {
+			return
+		}
+	}
+
+	errors = append(errors, fmt.Errorf(
+		"%q contains an invalid event %q, must be one of %q", k, value, events))
+	return
+}
+
 func validateS3BucketLifecycleRuleId(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if len(value) > 255 {
@@ -499,3 +605,917 @@ func validateApiGatewayIntegrationType(v interface{}, k string) (ws []string, er
 	}
 	return
 }
+
+func validateOpenIdURL(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	u, err := url.Parse(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q has to be a valid URL", k))
+		return
+	}
+	if u.Scheme != "https" {
+		errors = append(errors, fmt.Errorf("%q has to use HTTPS scheme (i.e. begin with https://)", k))
+	}
+	if len(u.Query()) > 0 {
+		errors = append(errors, fmt.Errorf("%q cannot contain query parameters per the OIDC standard", k))
+	}
+	return
+}
+
+func validateApiGatewayEndpointType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value != apigateway.EndpointTypeEdge && value != apigateway.EndpointTypeRegional {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of %q or %q", k, apigateway.EndpointTypeEdge, apigateway.EndpointTypeRegional))
+	}
+	return
+}
+
+func validateAcmValidationMethod(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value != acm.ValidationMethodDns && value != acm.ValidationMethodEmail {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of %q or %q", k, acm.ValidationMethodDns, acm.ValidationMethodEmail))
+	}
+	return
+}
+
+func validateWafIPSetDescriptorType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value != waf.IPSetDescriptorTypeIpv4 && value != waf.IPSetDescriptorTypeIpv6 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of %q or %q", k, waf.IPSetDescriptorTypeIpv4, waf.IPSetDescriptorTypeIpv6))
+	}
+	return
+}
+
+func validateWafActionType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	switch value {
+	case waf.WafActionTypeAllow, waf.WafActionTypeBlock, waf.WafActionTypeCount:
+	default:
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of %q, %q or %q", k,
+			waf.WafActionTypeAllow, waf.WafActionTypeBlock, waf.WafActionTypeCount))
+	}
+	return
+}
+
+func validateWafMetricName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[0-9A-Za-z]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"only alphanumeric characters allowed in %q: %q", k, value))
+	}
+	return
+}
+
+func validateWafPredicateType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	switch value {
+	case waf.PredicateTypeIpmatch, waf.PredicateTypeByteMatch, waf.PredicateTypeSqlInjectionMatch,
+		waf.PredicateTypeGeoMatch, waf.PredicateTypeSizeConstraint, waf.PredicateTypeXssMatch,
+		waf.PredicateTypeRegexMatch:
+	default:
+		errors = append(errors, fmt.Errorf("%q contains an invalid predicate type %q", k, value))
+	}
+	return
+}
+
+func validateAthenaDatabaseName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[_a-z0-9]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"only lowercase alphanumeric characters and underscores allowed in %q: %q", k, value))
+	}
+	return
+}
+
+func validateBatchName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[0-9a-zA-Z_-]{1,128}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be up to 128 letters, numbers, hyphens or underscores: %q", k, value))
+	}
+	return
+}
+
+func validateBatchState(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	switch value {
+	case batch.CEStateEnabled, batch.CEStateDisabled:
+	default:
+		errors = append(errors, fmt.Errorf(
+			"%q must be either %q or %q", k, batch.CEStateEnabled, batch.CEStateDisabled))
+	}
+	return
+}
+
+func validateBatchComputeEnvironmentType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	switch value {
+	case batch.CETypeManaged, batch.CETypeUnmanaged:
+	default:
+		errors = append(errors, fmt.Errorf(
+			"%q must be either %q or %q", k, batch.CETypeManaged, batch.CETypeUnmanaged))
+	}
+	return
+}
+
+func validateBatchComputeResourceType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	switch value {
+	case batch.CRTypeEc2, batch.CRTypeSpot:
+	default:
+		errors = append(errors, fmt.Errorf(
+			"%q must be either %q or %q", k, batch.CRTypeEc2, batch.CRTypeSpot))
+	}
+	return
+}
+
+func validateBatchJobDefinitionType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value != batch.JobDefinitionTypeContainer {
+		errors = append(errors, fmt.Errorf(
+			"%q must be %q", k, batch.JobDefinitionTypeContainer))
+	}
+	return
+}
+
+func validateBatchContainerProperties(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := expandBatchContainerProperties(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+	}
+	return
+}
+
+func validateSfnActivityName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 || len(value) > 80 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 80 characters", k))
+	}
+	return
+}
+
+func validateSfnStateMachineName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 || len(value) > 80 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 80 characters", k))
+	}
+	if !regexp.MustCompile(`^[a-zA-Z0-9-_]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be composed with only these characters [a-zA-Z0-9-_]: %v", k, value))
+	}
+	return
+}
+
+func validateCognitoUserPoolName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 || len(value) > 128 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 128 characters", k))
+	}
+	if !regexp.MustCompile(`^[\w\s+=,.@-]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be composed of letters, numbers, spaces and the characters +=,.@-: %q", k, value))
+	}
+	return
+}
+
+func validateCognitoUserPoolMfaConfiguration(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		"OFF":      true,
+		"ON":       true,
+		"OPTIONAL": true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of OFF, ON or OPTIONAL: %q", k, value))
+	}
+	return
+}
+
+func validateCognitoUserPoolAttributeDataType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		"Boolean":  true,
+		"DateTime": true,
+		"Number":   true,
+		"String":   true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of Boolean, DateTime, Number or String: %q", k, value))
+	}
+	return
+}
+
+func validateCognitoUserPoolClientAuthFlow(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		"ADMIN_NO_SRP_AUTH":     true,
+		"CUSTOM_AUTH_FLOW_ONLY": true,
+		"USER_PASSWORD_AUTH":    true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of ADMIN_NO_SRP_AUTH, CUSTOM_AUTH_FLOW_ONLY or USER_PASSWORD_AUTH: %q", k, value))
+	}
+	return
+}
+
+func validateCognitoIdentityPoolName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 || len(value) > 128 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 128 characters", k))
+	}
+	if !regexp.MustCompile(`^[\w _]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must contain only alphanumeric characters, underscores and spaces: %q", k, value))
+	}
+	return
+}
+
+func validateCognitoIdentityPoolRoles(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(map[string]interface{})
+	for role := range value {
+		if role != "authenticated" && role != "unauthenticated" {
+			errors = append(errors, fmt.Errorf(
+				"%q keys must be authenticated or unauthenticated: %q", k, role))
+		}
+	}
+	return
+}
+
+func validateDmsEndpointId(v interface{}, k string) (ws []string, errors []error) {
+	return validateDmsIdentifier(v, k, 255)
+}
+
+func validateDmsReplicationInstanceId(v interface{}, k string) (ws []string, errors []error) {
+	return validateDmsIdentifier(v, k, 63)
+}
+
+func validateDmsReplicationTaskId(v interface{}, k string) (ws []string, errors []error) {
+	return validateDmsIdentifier(v, k, 255)
+}
+
+// validateDmsIdentifier checks the naming rules shared by DMS endpoints,
+// replication instances and replication tasks. DMS stores identifiers in
+// lower case, so upper case characters are rejected to avoid perpetual diffs.
+func validateDmsIdentifier(v interface{}, k string, maxLength int) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) > maxLength {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be longer than %d characters", k, maxLength))
+	}
+	if !regexp.MustCompile(`^[0-9a-z-]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"only lowercase alphanumeric characters and hyphens allowed in %q", k))
+	}
+	if !regexp.MustCompile(`^[a-z]`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"first character of %q must be a letter", k))
+	}
+	if regexp.MustCompile(`--`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot contain two consecutive hyphens", k))
+	}
+	if regexp.MustCompile(`-$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot end with a hyphen", k))
+	}
+	return
+}
+
+func validateDmsReplicationSubnetGroupId(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) > 255 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be longer than 255 characters", k))
+	}
+	if !regexp.MustCompile(`^[0-9a-z._ -]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"only lowercase alphanumeric characters, periods, spaces, underscores and hyphens allowed in %q", k))
+	}
+	if value == "default" {
+		errors = append(errors, fmt.Errorf(
+			"%q must not be default", k))
+	}
+	return
+}
+
+func validateDmsEndpointType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		"source": true,
+		"target": true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of source or target: %q", k, value))
+	}
+	return
+}
+
+func validateDmsEndpointEngineName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		"aurora":    true,
+		"dynamodb":  true,
+		"mariadb":   true,
+		"mongodb":   true,
+		"mysql":     true,
+		"oracle":    true,
+		"postgres":  true,
+		"redshift":  true,
+		"s3":        true,
+		"sqlserver": true,
+		"sybase":    true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of aurora, dynamodb, mariadb, mongodb, mysql, oracle, postgres, redshift, s3, sqlserver or sybase: %q", k, value))
+	}
+	return
+}
+
+func validateDmsEndpointSslMode(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		"none":        true,
+		"require":     true,
+		"verify-ca":   true,
+		"verify-full": true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of none, require, verify-ca or verify-full: %q", k, value))
+	}
+	return
+}
+
+func validateDmsReplicationTaskMigrationType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		"cdc":               true,
+		"full-load":         true,
+		"full-load-and-cdc": true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of cdc, full-load or full-load-and-cdc: %q", k, value))
+	}
+	return
+}
+
+func validateAwsCodeBuildProjectName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 2 || len(value) > 255 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be between 2 and 255 characters: %q", k, value))
+	}
+	if !regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9\-_]*$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must start with a letter or number and may only contain alphanumeric characters, hyphens and underscores: %q", k, value))
+	}
+	return
+}
+
+func validateAwsCodeBuildProjectDescription(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) > 255 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 255 characters", k))
+	}
+	return
+}
+
+func validateAwsCodeBuildTimeout(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if value < 5 || value > 480 {
+		errors = append(errors, fmt.Errorf("%q must be between 5 and 480 minutes", k))
+	}
+	return
+}
+
+func validateAwsCodeBuildArtifactsType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		"CODEPIPELINE": true,
+		"NO_ARTIFACTS": true,
+		"S3":           true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of CODEPIPELINE, NO_ARTIFACTS or S3: %q", k, value))
+	}
+	return
+}
+
+func validateAwsCodeBuildArtifactsNamespaceType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		"BUILD_ID": true,
+		"NONE":     true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of BUILD_ID or NONE: %q", k, value))
+	}
+	return
+}
+
+func validateAwsCodeBuildArtifactsPackaging(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		"NONE": true,
+		"ZIP":  true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of NONE or ZIP: %q", k, value))
+	}
+	return
+}
+
+func validateAwsCodeBuildComputeType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		"BUILD_GENERAL1_SMALL":  true,
+		"BUILD_GENERAL1_MEDIUM": true,
+		"BUILD_GENERAL1_LARGE":  true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of BUILD_GENERAL1_SMALL, BUILD_GENERAL1_MEDIUM or BUILD_GENERAL1_LARGE: %q", k, value))
+	}
+	return
+}
+
+func validateAwsCodeBuildEnvironmentType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		"LINUX_CONTAINER":   true,
+		"WINDOWS_CONTAINER": true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of LINUX_CONTAINER or WINDOWS_CONTAINER: %q", k, value))
+	}
+	return
+}
+
+func validateAwsCodeBuildEnvironmentVariableType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		"PARAMETER_STORE": true,
+		"PLAINTEXT":       true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of PARAMETER_STORE or PLAINTEXT: %q", k, value))
+	}
+	return
+}
+
+func validateAwsCodeBuildSourceType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		"BITBUCKET":         true,
+		"CODECOMMIT":        true,
+		"CODEPIPELINE":      true,
+		"GITHUB":            true,
+		"GITHUB_ENTERPRISE": true,
+		"NO_SOURCE":         true,
+		"S3":                true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of BITBUCKET, CODECOMMIT, CODEPIPELINE, GITHUB, GITHUB_ENTERPRISE, NO_SOURCE or S3: %q", k, value))
+	}
+	return
+}
+
+func validateAwsCodeBuildSourceAuthType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value != "OAUTH" {
+		errors = append(errors, fmt.Errorf("%q must be OAUTH: %q", k, value))
+	}
+	return
+}
+
+func validateAwsCodePipelineEncryptionKeyType(v interface{}, k string) (ws []string, errors []error) {
+	if v.(string) != "KMS" {
+		errors = append(errors, fmt.Errorf("CodePipeline: encryption_key type can only be KMS"))
+	}
+	return
+}
+
+func validateAwsCodePipelineArtifactStoreType(v interface{}, k string) (ws []string, errors []error) {
+	if v.(string) != "S3" {
+		errors = append(errors, fmt.Errorf("CodePipeline: artifact_store type can only be S3"))
+	}
+	return
+}
+
+func validateAwsCodePipelineActionCategory(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		"Approval": true,
+		"Build":    true,
+		"Deploy":   true,
+		"Invoke":   true,
+		"Source":   true,
+		"Test":     true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of Approval, Build, Deploy, Invoke, Source or Test: %q", k, value))
+	}
+	return
+}
+
+func validateAwsCodePipelineActionOwner(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		"AWS":        true,
+		"Custom":     true,
+		"ThirdParty": true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of AWS, Custom or ThirdParty: %q", k, value))
+	}
+	return
+}
+
+func validateDirectoryServiceTrustDirection(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		directoryservice.TrustDirectionOneWayOutgoing: true,
+		directoryservice.TrustDirectionOneWayIncoming: true,
+		directoryservice.TrustDirectionTwoWay:         true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of \"One-Way: Outgoing\", \"One-Way: Incoming\" or \"Two-Way\": %q", k, value))
+	}
+	return
+}
+
+func validateDirectoryServiceTrustType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		directoryservice.TrustTypeForest:   true,
+		directoryservice.TrustTypeExternal: true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of Forest or External: %q", k, value))
+	}
+	return
+}
+
+func validateDirectoryServiceSelectiveAuth(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		directoryservice.SelectiveAuthEnabled:  true,
+		directoryservice.SelectiveAuthDisabled: true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of Enabled or Disabled: %q", k, value))
+	}
+	return
+}
+
+func validateDaxClusterName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if (len(value) < 1) || (len(value) > 20) {
+		errors = append(errors, fmt.Errorf(
+			"%q must contain from 1 to 20 alphanumeric characters or hyphens", k))
+	}
+	if !regexp.MustCompile(`^[0-9a-zA-Z-]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"only alphanumeric characters and hyphens allowed in %q", k))
+	}
+	if !regexp.MustCompile(`^[a-zA-Z]`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"first character of %q must be a letter", k))
+	}
+	if regexp.MustCompile(`--`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot contain two consecutive hyphens", k))
+	}
+	if regexp.MustCompile(`-$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot end with a hyphen", k))
+	}
+	return
+}
+
+func validateGameliftOperatingSystem(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		gamelift.OperatingSystemAmazonLinux: true,
+		gamelift.OperatingSystemWindows2012: true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of AMAZON_LINUX or WINDOWS_2012: %q", k, value))
+	}
+	return
+}
+
+func validateGameliftIpProtocol(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		gamelift.IpProtocolTcp: true,
+		gamelift.IpProtocolUdp: true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of TCP or UDP: %q", k, value))
+	}
+	return
+}
+
+func validateGameliftProtectionPolicy(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		gamelift.ProtectionPolicyFullProtection: true,
+		gamelift.ProtectionPolicyNoProtection:   true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of FullProtection or NoProtection: %q", k, value))
+	}
+	return
+}
+
+func validateServiceDiscoveryRoutingPolicy(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		servicediscovery.RoutingPolicyMultivalue: true,
+		servicediscovery.RoutingPolicyWeighted:   true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of MULTIVALUE or WEIGHTED: %q", k, value))
+	}
+	return
+}
+
+func validateServiceDiscoveryRecordType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		servicediscovery.RecordTypeSrv:   true,
+		servicediscovery.RecordTypeA:     true,
+		servicediscovery.RecordTypeAaaa:  true,
+		servicediscovery.RecordTypeCname: true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of SRV, A, AAAA or CNAME: %q", k, value))
+	}
+	return
+}
+
+func validateServiceDiscoveryHealthCheckType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		servicediscovery.HealthCheckTypeHttp:  true,
+		servicediscovery.HealthCheckTypeHttps: true,
+		servicediscovery.HealthCheckTypeTcp:   true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of HTTP, HTTPS or TCP: %q", k, value))
+	}
+	return
+}
+
+func validateGuardDutyFindingPublishingFrequency(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		guardduty.FindingPublishingFrequencyFifteenMinutes: true,
+		guardduty.FindingPublishingFrequencyOneHour:        true,
+		guardduty.FindingPublishingFrequencySixHours:       true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of FIFTEEN_MINUTES, ONE_HOUR or SIX_HOURS: %q", k, value))
+	}
+	return
+}
+
+func validateOrganizationsIamUserAccessToBilling(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		organizations.IAMUserAccessToBillingAllow: true,
+		organizations.IAMUserAccessToBillingDeny:  true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of ALLOW or DENY: %q", k, value))
+	}
+	return
+}
+
+func validateBudgetsBudgetType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		budgets.BudgetTypeCost:          true,
+		budgets.BudgetTypeUsage:         true,
+		budgets.BudgetTypeRiUtilization: true,
+		budgets.BudgetTypeRiCoverage:    true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of COST, USAGE, RI_UTILIZATION or RI_COVERAGE: %q", k, value))
+	}
+	return
+}
+
+func validateBudgetsTimeUnit(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		budgets.TimeUnitDaily:     true,
+		budgets.TimeUnitMonthly:   true,
+		budgets.TimeUnitQuarterly: true,
+		budgets.TimeUnitAnnually:  true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of DAILY, MONTHLY, QUARTERLY or ANNUALLY: %q", k, value))
+	}
+	return
+}
+
+func validateBudgetsComparisonOperator(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		budgets.ComparisonOperatorGreaterThan: true,
+		budgets.ComparisonOperatorLessThan:    true,
+		budgets.ComparisonOperatorEqualTo:     true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of GREATER_THAN, LESS_THAN or EQUAL_TO: %q", k, value))
+	}
+	return
+}
+
+func validateBudgetsThresholdType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		budgets.ThresholdTypePercentage:    true,
+		budgets.ThresholdTypeAbsoluteValue: true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of PERCENTAGE or ABSOLUTE_VALUE: %q", k, value))
+	}
+	return
+}
+
+func validateBudgetsNotificationType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		budgets.NotificationTypeActual:     true,
+		budgets.NotificationTypeForecasted: true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of ACTUAL or FORECASTED: %q", k, value))
+	}
+	return
+}
+
+func validateBudgetsTimePeriod(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, err := time.Parse(awsBudgetsBudgetTimePeriodLayout, value); err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q must be in the format YYYY-MM-DD_hh:mm: %q", k, value))
+	}
+	return
+}
+
+func validateEcsPlacementStrategyType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		ecs.PlacementStrategyTypeRandom:  true,
+		ecs.PlacementStrategyTypeSpread:  true,
+		ecs.PlacementStrategyTypeBinpack: true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of random, spread or binpack: %q", k, value))
+	}
+	return
+}
+
+func validateEcsPlacementConstraintType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		ecs.PlacementConstraintTypeDistinctInstance: true,
+		ecs.PlacementConstraintTypeMemberOf:         true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of distinctInstance or memberOf: %q", k, value))
+	}
+	return
+}
+
+func validateRdsClusterEngine(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]bool{
+		"aurora":            true,
+		"aurora-mysql":      true,
+		"aurora-postgresql": true,
+	}
+	if !validTypes[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of aurora, aurora-mysql or aurora-postgresql: %q", k, value))
+	}
+	return
+}
+
+func validateDbInstanceMonitoringInterval(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	validIntervals := map[int]bool{
+		0:  true,
+		1:  true,
+		5:  true,
+		10: true,
+		15: true,
+		30: true,
+		60: true,
+	}
+	if !validIntervals[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of 0, 1, 5, 10, 15, 30 or 60: %d", k, value))
+	}
+	return
+}
+
+func validateAccountAlias(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	// http://docs.aws.amazon.com/IAM/latest/APIReference/API_CreateAccountAlias.html
+	if len(value) < 3 || len(value) > 63 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be between 3 and 63 characters: %q", k, value))
+	}
+	if !regexp.MustCompile(`^[a-z0-9](?:[a-z0-9-]*[a-z0-9])?$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must contain only lowercase alphanumeric characters and hyphens, "+
+				"and must start and end with an alphanumeric character: %q", k, value))
+	}
+	if regexp.MustCompile(`--`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot contain two consecutive hyphens: %q", k, value))
+	}
+	return
+}
+
+func validateDuration(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be parsed as a duration: %s", k, err))
+		return
+	}
+	if duration < 0 {
+		errors = append(errors, fmt.Errorf(
+			"%q must not be negative: %q", k, value))
+	}
+	return
+}
+
+func validateRegexp(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, err := regexp.Compile(value); err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q is not a valid regular expression: %s", k, err))
+	}
+	return
+}
+
+func validateSecurityGroupRuleDescription(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) > 255 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be longer than 255 characters: %q", k, value))
+	}
+
+	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_IpRange.html
+	pattern := `^[A-Za-z0-9 \.\_\-\:\/\(\)\#\,\@\[\]\+\=\;\{\}\!\$\*]*$`
+	if !regexp.MustCompile(pattern).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q doesn't comply with restrictions (%q): %q",
+			k, pattern, value))
+	}
+	return
+}
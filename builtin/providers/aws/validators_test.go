@@ -185,6 +185,75 @@ func TestValidateAwsAccountId(t *testing.T) {
 	}
 }
 
+func TestValidateAmazonSideAsn(t *testing.T) {
+	validAsns := []string{
+		"64512",
+		"64513",
+		"65533",
+		"65534",
+		"4200000000",
+		"4200000001",
+		"4294967293",
+		"4294967294",
+	}
+	for _, v := range validAsns {
+		_, errors := validateAmazonSideAsn(v, "amazon_side_asn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid ASN: %q", v, errors)
+		}
+	}
+
+	invalidAsns := []string{
+		"1",
+		"ABCDEFG",
+		"",
+		"7224",
+		"9059",
+		"64511",
+		"65535",
+		"4199999999",
+		"4294967295",
+		"9999999999",
+	}
+	for _, v := range invalidAsns {
+		_, errors := validateAmazonSideAsn(v, "amazon_side_asn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid ASN", v)
+		}
+	}
+}
+
+func TestValidateAccountAlias(t *testing.T) {
+	validAliases := []string{
+		"tf-alias",
+		"0tf-alias1",
+		"abc",
+		strings.Repeat("a", 63),
+	}
+	for _, v := range validAliases {
+		_, errors := validateAccountAlias(v, "account_alias")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid account alias: %q", v, errors)
+		}
+	}
+
+	invalidAliases := []string{
+		"tf",
+		"-tf",
+		"tf-",
+		"TF-Alias",
+		"tf--alias",
+		"tf_alias",
+		strings.Repeat("a", 64),
+	}
+	for _, v := range invalidAliases {
+		_, errors := validateAccountAlias(v, "account_alias")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid account alias", v)
+		}
+	}
+}
+
 func TestValidateArn(t *testing.T) {
 	validNames := []string{
 		"arn:aws:elasticbeanstalk:us-east-1:123456789012:environment/My App/MyEnvironment", // Beanstalk
@@ -687,3 +756,900 @@ func TestValidateApiGatewayIntegrationType(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateOpenIdURL(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "http://wrong.scheme.com",
+			ErrCount: 1,
+		},
+		{
+			Value:    "ftp://wrong.scheme.co.uk",
+			ErrCount: 1,
+		},
+		{
+			Value:    "%@invalidUrl",
+			ErrCount: 1,
+		},
+		{
+			Value:    "https://example.com/?query=param",
+			ErrCount: 1,
+		},
+		{
+			Value:    "https://accounts.google.com",
+			ErrCount: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateOpenIdURL(tc.Value, "url")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d of OpenID URL validation errors for %q, got %d",
+				tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestValidateApiGatewayEndpointType(t *testing.T) {
+	validTypes := []string{"EDGE", "REGIONAL"}
+	for _, v := range validTypes {
+		_, errors := validateApiGatewayEndpointType(v, "types")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid endpoint type: %q", v, errors)
+		}
+	}
+
+	invalidTypes := []string{"edge", "GLOBAL", ""}
+	for _, v := range invalidTypes {
+		_, errors := validateApiGatewayEndpointType(v, "types")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid endpoint type", v)
+		}
+	}
+}
+
+func TestValidateAcmValidationMethod(t *testing.T) {
+	validMethods := []string{"DNS", "EMAIL"}
+	for _, v := range validMethods {
+		_, errors := validateAcmValidationMethod(v, "validation_method")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid validation method: %q", v, errors)
+		}
+	}
+
+	invalidMethods := []string{"dns", "HTTP", ""}
+	for _, v := range invalidMethods {
+		_, errors := validateAcmValidationMethod(v, "validation_method")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid validation method", v)
+		}
+	}
+}
+
+func TestValidateWafMetricName(t *testing.T) {
+	validNames := []string{"testRule", "testRule123", "TESTRULE"}
+	for _, v := range validNames {
+		_, errors := validateWafMetricName(v, "metric_name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid metric name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{"test-rule", "test rule", "test_rule", ""}
+	for _, v := range invalidNames {
+		_, errors := validateWafMetricName(v, "metric_name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid metric name", v)
+		}
+	}
+}
+
+func TestValidateAthenaDatabaseName(t *testing.T) {
+	validNames := []string{"analytics", "web_logs", "logs2017"}
+	for _, v := range validNames {
+		_, errors := validateAthenaDatabaseName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Athena database name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{"Analytics", "web-logs", "web logs", ""}
+	for _, v := range invalidNames {
+		_, errors := validateAthenaDatabaseName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Athena database name", v)
+		}
+	}
+}
+
+func TestValidateBatchName(t *testing.T) {
+	validNames := []string{"sample", "sample_123", "Sample-Queue", strings.Repeat("a", 128)}
+	for _, v := range validNames {
+		_, errors := validateBatchName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Batch name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{"", "sample queue", "sample.queue", strings.Repeat("a", 129)}
+	for _, v := range invalidNames {
+		_, errors := validateBatchName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Batch name", v)
+		}
+	}
+}
+
+func TestValidateSfnActivityName(t *testing.T) {
+	validNames := []string{"foo", "FooBar123", "foo bar", strings.Repeat("W", 80)}
+	for _, v := range validNames {
+		_, errors := validateSfnActivityName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Step Function activity name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{"", strings.Repeat("W", 81)}
+	for _, v := range invalidNames {
+		_, errors := validateSfnActivityName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Step Function activity name", v)
+		}
+	}
+}
+
+func TestValidateSfnStateMachineName(t *testing.T) {
+	validNames := []string{"foo", "BAR", "FooBar123", "FooBar123Baz-_", strings.Repeat("W", 80)}
+	for _, v := range validNames {
+		_, errors := validateSfnStateMachineName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Step Function state machine name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{"", "foo bar", "foo.bar", "foo!", strings.Repeat("W", 81)}
+	for _, v := range invalidNames {
+		_, errors := validateSfnStateMachineName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Step Function state machine name", v)
+		}
+	}
+}
+
+func TestValidateCognitoUserPoolName(t *testing.T) {
+	validNames := []string{"foo", "Foo Bar", "foo_bar-baz", "foo+bar=baz,qux.quux@corge", strings.Repeat("W", 128)}
+	for _, v := range validNames {
+		_, errors := validateCognitoUserPoolName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Cognito user pool name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{"", "foo!", "foo/bar", strings.Repeat("W", 129)}
+	for _, v := range invalidNames {
+		_, errors := validateCognitoUserPoolName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Cognito user pool name", v)
+		}
+	}
+}
+
+func TestValidateCognitoIdentityPoolName(t *testing.T) {
+	validNames := []string{"foo", "foo bar", "foo_bar", "FooBar123", strings.Repeat("W", 128)}
+	for _, v := range validNames {
+		_, errors := validateCognitoIdentityPoolName(v, "identity_pool_name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Cognito identity pool name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{"", "foo-bar", "foo.bar", "foo!", strings.Repeat("W", 129)}
+	for _, v := range invalidNames {
+		_, errors := validateCognitoIdentityPoolName(v, "identity_pool_name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Cognito identity pool name", v)
+		}
+	}
+}
+
+func TestValidateCognitoIdentityPoolRoles(t *testing.T) {
+	valid := []map[string]interface{}{
+		{"authenticated": "arn:aws:iam::123456789012:role/auth"},
+		{"authenticated": "arn:aws:iam::123456789012:role/auth", "unauthenticated": "arn:aws:iam::123456789012:role/unauth"},
+	}
+	for _, v := range valid {
+		_, errors := validateCognitoIdentityPoolRoles(v, "roles")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be valid Cognito identity pool roles: %q", v, errors)
+		}
+	}
+
+	invalid := []map[string]interface{}{
+		{"admin": "arn:aws:iam::123456789012:role/admin"},
+	}
+	for _, v := range invalid {
+		_, errors := validateCognitoIdentityPoolRoles(v, "roles")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be invalid Cognito identity pool roles", v)
+		}
+	}
+}
+
+func TestValidateDmsIdentifiers(t *testing.T) {
+	validIds := []string{"a", "test-endpoint", "replication-1", strings.Repeat("w", 63)}
+	for _, v := range validIds {
+		_, errors := validateDmsReplicationInstanceId(v, "replication_instance_id")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid DMS identifier: %q", v, errors)
+		}
+	}
+
+	invalidIds := []string{"", "1-endpoint", "Endpoint", "end--point", "endpoint-", "end_point", strings.Repeat("w", 64)}
+	for _, v := range invalidIds {
+		_, errors := validateDmsReplicationInstanceId(v, "replication_instance_id")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid DMS identifier", v)
+		}
+	}
+
+	_, errors := validateDmsEndpointId(strings.Repeat("w", 255), "endpoint_id")
+	if len(errors) != 0 {
+		t.Fatalf("255 character endpoint id should be valid: %q", errors)
+	}
+	_, errors = validateDmsReplicationTaskId(strings.Repeat("w", 256), "replication_task_id")
+	if len(errors) == 0 {
+		t.Fatal("256 character replication task id should be invalid")
+	}
+}
+
+func TestValidateDmsReplicationSubnetGroupId(t *testing.T) {
+	validIds := []string{"subnet-group", "subnet_group", "subnet.group 1"}
+	for _, v := range validIds {
+		_, errors := validateDmsReplicationSubnetGroupId(v, "replication_subnet_group_id")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid DMS replication subnet group id: %q", v, errors)
+		}
+	}
+
+	invalidIds := []string{"", "default", "Subnet", "subnet!", strings.Repeat("w", 256)}
+	for _, v := range invalidIds {
+		_, errors := validateDmsReplicationSubnetGroupId(v, "replication_subnet_group_id")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid DMS replication subnet group id", v)
+		}
+	}
+}
+
+func TestValidateDmsEndpointEngineName(t *testing.T) {
+	validNames := []string{"mysql", "postgres", "s3", "mongodb", "dynamodb"}
+	for _, v := range validNames {
+		_, errors := validateDmsEndpointEngineName(v, "engine_name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid DMS engine name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{"", "MySQL", "cassandra"}
+	for _, v := range invalidNames {
+		_, errors := validateDmsEndpointEngineName(v, "engine_name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid DMS engine name", v)
+		}
+	}
+}
+
+func TestValidateDmsReplicationTaskMigrationType(t *testing.T) {
+	validTypes := []string{"cdc", "full-load", "full-load-and-cdc"}
+	for _, v := range validTypes {
+		_, errors := validateDmsReplicationTaskMigrationType(v, "migration_type")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid DMS migration type: %q", v, errors)
+		}
+	}
+
+	invalidTypes := []string{"", "full", "full-load-cdc"}
+	for _, v := range invalidTypes {
+		_, errors := validateDmsReplicationTaskMigrationType(v, "migration_type")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid DMS migration type", v)
+		}
+	}
+}
+
+func TestValidateAwsCodeBuildProjectName(t *testing.T) {
+	validNames := []string{"ab", "test-project", "test_project_1", "1project"}
+	for _, v := range validNames {
+		_, errors := validateAwsCodeBuildProjectName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid CodeBuild project name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{"", "a", "-project", "test project", strings.Repeat("a", 256)}
+	for _, v := range invalidNames {
+		_, errors := validateAwsCodeBuildProjectName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid CodeBuild project name", v)
+		}
+	}
+}
+
+func TestValidateAwsCodeBuildTimeout(t *testing.T) {
+	for _, v := range []int{5, 60, 480} {
+		_, errors := validateAwsCodeBuildTimeout(v, "build_timeout")
+		if len(errors) != 0 {
+			t.Fatalf("%d should be a valid CodeBuild timeout: %q", v, errors)
+		}
+	}
+
+	for _, v := range []int{0, 4, 481} {
+		_, errors := validateAwsCodeBuildTimeout(v, "build_timeout")
+		if len(errors) == 0 {
+			t.Fatalf("%d should be an invalid CodeBuild timeout", v)
+		}
+	}
+}
+
+func TestValidateAwsCodeBuildSourceType(t *testing.T) {
+	validTypes := []string{"CODECOMMIT", "CODEPIPELINE", "GITHUB", "S3", "BITBUCKET"}
+	for _, v := range validTypes {
+		_, errors := validateAwsCodeBuildSourceType(v, "source.0.type")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid CodeBuild source type: %q", v, errors)
+		}
+	}
+
+	invalidTypes := []string{"", "github", "GITLAB"}
+	for _, v := range invalidTypes {
+		_, errors := validateAwsCodeBuildSourceType(v, "source.0.type")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid CodeBuild source type", v)
+		}
+	}
+}
+
+func TestValidateAwsCodePipelineActionCategory(t *testing.T) {
+	validTypes := []string{"Approval", "Build", "Deploy", "Invoke", "Source", "Test"}
+	for _, v := range validTypes {
+		_, errors := validateAwsCodePipelineActionCategory(v, "category")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid CodePipeline action category: %q", v, errors)
+		}
+	}
+
+	invalidTypes := []string{"", "source", "Release"}
+	for _, v := range invalidTypes {
+		_, errors := validateAwsCodePipelineActionCategory(v, "category")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid CodePipeline action category", v)
+		}
+	}
+}
+
+func TestValidateAwsCodePipelineActionOwner(t *testing.T) {
+	validTypes := []string{"AWS", "Custom", "ThirdParty"}
+	for _, v := range validTypes {
+		_, errors := validateAwsCodePipelineActionOwner(v, "owner")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid CodePipeline action owner: %q", v, errors)
+		}
+	}
+
+	invalidTypes := []string{"", "aws", "Amazon"}
+	for _, v := range invalidTypes {
+		_, errors := validateAwsCodePipelineActionOwner(v, "owner")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid CodePipeline action owner", v)
+		}
+	}
+}
+
+func TestValidateDirectoryServiceTrustDirection(t *testing.T) {
+	validTypes := []string{"One-Way: Outgoing", "One-Way: Incoming", "Two-Way"}
+	for _, v := range validTypes {
+		_, errors := validateDirectoryServiceTrustDirection(v, "trust_direction")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Directory Service trust direction: %q", v, errors)
+		}
+	}
+
+	invalidTypes := []string{"", "Outgoing", "two-way"}
+	for _, v := range invalidTypes {
+		_, errors := validateDirectoryServiceTrustDirection(v, "trust_direction")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Directory Service trust direction", v)
+		}
+	}
+}
+
+func TestValidateDirectoryServiceTrustType(t *testing.T) {
+	validTypes := []string{"Forest", "External"}
+	for _, v := range validTypes {
+		_, errors := validateDirectoryServiceTrustType(v, "trust_type")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Directory Service trust type: %q", v, errors)
+		}
+	}
+
+	invalidTypes := []string{"", "forest", "Realm"}
+	for _, v := range invalidTypes {
+		_, errors := validateDirectoryServiceTrustType(v, "trust_type")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Directory Service trust type", v)
+		}
+	}
+}
+
+func TestValidateDirectoryServiceSelectiveAuth(t *testing.T) {
+	validTypes := []string{"Enabled", "Disabled"}
+	for _, v := range validTypes {
+		_, errors := validateDirectoryServiceSelectiveAuth(v, "selective_auth")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Directory Service selective auth value: %q", v, errors)
+		}
+	}
+
+	invalidTypes := []string{"", "enabled", "true"}
+	for _, v := range invalidTypes {
+		_, errors := validateDirectoryServiceSelectiveAuth(v, "selective_auth")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Directory Service selective auth value", v)
+		}
+	}
+}
+
+func TestValidateDaxClusterName(t *testing.T) {
+	validNames := []string{"tf-dax", "TfDax1", "a"}
+	for _, v := range validNames {
+		_, errors := validateDaxClusterName(v, "cluster_name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid DAX cluster name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"1tf-dax",
+		"tf--dax",
+		"tf-dax-",
+		"tf_dax",
+		"tf-dax-cluster-name-too-long",
+	}
+	for _, v := range invalidNames {
+		_, errors := validateDaxClusterName(v, "cluster_name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid DAX cluster name", v)
+		}
+	}
+}
+
+func TestValidateGameliftOperatingSystem(t *testing.T) {
+	validTypes := []string{"AMAZON_LINUX", "WINDOWS_2012"}
+	for _, v := range validTypes {
+		_, errors := validateGameliftOperatingSystem(v, "operating_system")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Gamelift operating system: %q", v, errors)
+		}
+	}
+
+	invalidTypes := []string{"", "amazon_linux", "WINDOWS_2016"}
+	for _, v := range invalidTypes {
+		_, errors := validateGameliftOperatingSystem(v, "operating_system")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Gamelift operating system", v)
+		}
+	}
+}
+
+func TestValidateGameliftIpProtocol(t *testing.T) {
+	validTypes := []string{"TCP", "UDP"}
+	for _, v := range validTypes {
+		_, errors := validateGameliftIpProtocol(v, "protocol")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Gamelift IP protocol: %q", v, errors)
+		}
+	}
+
+	invalidTypes := []string{"", "tcp", "ICMP"}
+	for _, v := range invalidTypes {
+		_, errors := validateGameliftIpProtocol(v, "protocol")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Gamelift IP protocol", v)
+		}
+	}
+}
+
+func TestValidateGameliftProtectionPolicy(t *testing.T) {
+	validTypes := []string{"FullProtection", "NoProtection"}
+	for _, v := range validTypes {
+		_, errors := validateGameliftProtectionPolicy(v, "new_game_session_protection_policy")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Gamelift protection policy: %q", v, errors)
+		}
+	}
+
+	invalidTypes := []string{"", "noprotection", "Partial"}
+	for _, v := range invalidTypes {
+		_, errors := validateGameliftProtectionPolicy(v, "new_game_session_protection_policy")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Gamelift protection policy", v)
+		}
+	}
+}
+
+func TestValidateServiceDiscoveryRoutingPolicy(t *testing.T) {
+	validTypes := []string{"MULTIVALUE", "WEIGHTED"}
+	for _, v := range validTypes {
+		_, errors := validateServiceDiscoveryRoutingPolicy(v, "routing_policy")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Service Discovery routing policy: %q", v, errors)
+		}
+	}
+
+	invalidTypes := []string{"", "multivalue", "LATENCY"}
+	for _, v := range invalidTypes {
+		_, errors := validateServiceDiscoveryRoutingPolicy(v, "routing_policy")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Service Discovery routing policy", v)
+		}
+	}
+}
+
+func TestValidateServiceDiscoveryRecordType(t *testing.T) {
+	validTypes := []string{"SRV", "A", "AAAA", "CNAME"}
+	for _, v := range validTypes {
+		_, errors := validateServiceDiscoveryRecordType(v, "type")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Service Discovery record type: %q", v, errors)
+		}
+	}
+
+	invalidTypes := []string{"", "a", "MX", "TXT"}
+	for _, v := range invalidTypes {
+		_, errors := validateServiceDiscoveryRecordType(v, "type")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Service Discovery record type", v)
+		}
+	}
+}
+
+func TestValidateServiceDiscoveryHealthCheckType(t *testing.T) {
+	validTypes := []string{"HTTP", "HTTPS", "TCP"}
+	for _, v := range validTypes {
+		_, errors := validateServiceDiscoveryHealthCheckType(v, "type")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Service Discovery health check type: %q", v, errors)
+		}
+	}
+
+	invalidTypes := []string{"", "http", "UDP"}
+	for _, v := range invalidTypes {
+		_, errors := validateServiceDiscoveryHealthCheckType(v, "type")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Service Discovery health check type", v)
+		}
+	}
+}
+
+func TestValidateGuardDutyFindingPublishingFrequency(t *testing.T) {
+	validTypes := []string{"FIFTEEN_MINUTES", "ONE_HOUR", "SIX_HOURS"}
+	for _, v := range validTypes {
+		_, errors := validateGuardDutyFindingPublishingFrequency(v, "finding_publishing_frequency")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid GuardDuty finding publishing frequency: %q", v, errors)
+		}
+	}
+
+	invalidTypes := []string{"", "one_hour", "TWELVE_HOURS"}
+	for _, v := range invalidTypes {
+		_, errors := validateGuardDutyFindingPublishingFrequency(v, "finding_publishing_frequency")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid GuardDuty finding publishing frequency", v)
+		}
+	}
+}
+
+func TestValidateOrganizationsIamUserAccessToBilling(t *testing.T) {
+	validTypes := []string{"ALLOW", "DENY"}
+	for _, v := range validTypes {
+		_, errors := validateOrganizationsIamUserAccessToBilling(v, "iam_user_access_to_billing")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid IAM user access to billing setting: %q", v, errors)
+		}
+	}
+
+	invalidTypes := []string{"", "allow", "true"}
+	for _, v := range invalidTypes {
+		_, errors := validateOrganizationsIamUserAccessToBilling(v, "iam_user_access_to_billing")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid IAM user access to billing setting", v)
+		}
+	}
+}
+
+func TestValidateCloudWatchEventTargetEcsTaskCount(t *testing.T) {
+	validCounts := []int{1, 10}
+	for _, v := range validCounts {
+		_, errors := validateCloudWatchEventTargetEcsTaskCount(v, "task_count")
+		if len(errors) != 0 {
+			t.Fatalf("%d should be a valid ECS task count: %q", v, errors)
+		}
+	}
+
+	invalidCounts := []int{0, -1}
+	for _, v := range invalidCounts {
+		_, errors := validateCloudWatchEventTargetEcsTaskCount(v, "task_count")
+		if len(errors) == 0 {
+			t.Fatalf("%d should be an invalid ECS task count", v)
+		}
+	}
+}
+
+func TestValidateBudgetsBudgetType(t *testing.T) {
+	validTypes := []string{"COST", "USAGE", "RI_UTILIZATION", "RI_COVERAGE"}
+	for _, v := range validTypes {
+		_, errors := validateBudgetsBudgetType(v, "budget_type")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid budget type: %q", v, errors)
+		}
+	}
+
+	invalidTypes := []string{"", "cost", "SAVINGS"}
+	for _, v := range invalidTypes {
+		_, errors := validateBudgetsBudgetType(v, "budget_type")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid budget type", v)
+		}
+	}
+}
+
+func TestValidateBudgetsTimePeriod(t *testing.T) {
+	validTimes := []string{"2017-01-01_12:00", "2087-06-15_00:00"}
+	for _, v := range validTimes {
+		_, errors := validateBudgetsTimePeriod(v, "time_period_start")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid budget time period: %q", v, errors)
+		}
+	}
+
+	invalidTimes := []string{"", "2017-01-01", "2017-01-01T12:00:00Z", "01/01/2017_12:00"}
+	for _, v := range invalidTimes {
+		_, errors := validateBudgetsTimePeriod(v, "time_period_start")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid budget time period", v)
+		}
+	}
+}
+
+func TestValidateEcsPlacementStrategyType(t *testing.T) {
+	validTypes := []string{"random", "spread", "binpack"}
+	for _, v := range validTypes {
+		_, errors := validateEcsPlacementStrategyType(v, "type")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid ECS placement strategy type: %q", v, errors)
+		}
+	}
+
+	invalidTypes := []string{"", "Random", "pack"}
+	for _, v := range invalidTypes {
+		_, errors := validateEcsPlacementStrategyType(v, "type")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid ECS placement strategy type", v)
+		}
+	}
+}
+
+func TestValidateEcsPlacementConstraintType(t *testing.T) {
+	validTypes := []string{"distinctInstance", "memberOf"}
+	for _, v := range validTypes {
+		_, errors := validateEcsPlacementConstraintType(v, "type")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid ECS placement constraint type: %q", v, errors)
+		}
+	}
+
+	invalidTypes := []string{"", "distinct_instance", "MemberOf"}
+	for _, v := range invalidTypes {
+		_, errors := validateEcsPlacementConstraintType(v, "type")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid ECS placement constraint type", v)
+		}
+	}
+}
+
+func TestValidateRdsClusterEngine(t *testing.T) {
+	validEngines := []string{"aurora", "aurora-mysql", "aurora-postgresql"}
+	for _, v := range validEngines {
+		_, errors := validateRdsClusterEngine(v, "engine")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid RDS Cluster engine: %q", v, errors)
+		}
+	}
+
+	invalidEngines := []string{"", "mysql", "postgres", "Aurora"}
+	for _, v := range invalidEngines {
+		_, errors := validateRdsClusterEngine(v, "engine")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid RDS Cluster engine", v)
+		}
+	}
+}
+
+func TestValidateDbInstanceMonitoringInterval(t *testing.T) {
+	validIntervals := []int{0, 1, 5, 10, 15, 30, 60}
+	for _, v := range validIntervals {
+		_, errors := validateDbInstanceMonitoringInterval(v, "monitoring_interval")
+		if len(errors) != 0 {
+			t.Fatalf("%d should be a valid monitoring interval: %q", v, errors)
+		}
+	}
+
+	invalidIntervals := []int{-1, 2, 20, 90}
+	for _, v := range invalidIntervals {
+		_, errors := validateDbInstanceMonitoringInterval(v, "monitoring_interval")
+		if len(errors) == 0 {
+			t.Fatalf("%d should be an invalid monitoring interval", v)
+		}
+	}
+}
+
+func TestValidateS3BucketReplicationRuleStatus(t *testing.T) {
+	validStatuses := []string{
+		"Enabled",
+		"Disabled",
+	}
+	for _, v := range validStatuses {
+		_, errors := validateS3BucketReplicationRuleStatus(v, "status")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be valid status: %q", v, errors)
+		}
+	}
+
+	invalidStatuses := []string{
+		"enabled",
+		"Suspended",
+	}
+	for _, v := range invalidStatuses {
+		_, errors := validateS3BucketReplicationRuleStatus(v, "status")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be invalid status", v)
+		}
+	}
+}
+
+func TestValidateS3BucketReplicationDestinationStorageClass(t *testing.T) {
+	validStorageClass := []string{
+		"STANDARD",
+		"STANDARD_IA",
+		"REDUCED_REDUNDANCY",
+	}
+	for _, v := range validStorageClass {
+		_, errors := validateS3BucketReplicationDestinationStorageClass(v, "storage_class")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be valid storage class: %q", v, errors)
+		}
+	}
+
+	invalidStorageClass := []string{
+		"GLACIER",
+		"1234",
+	}
+	for _, v := range invalidStorageClass {
+		_, errors := validateS3BucketReplicationDestinationStorageClass(v, "storage_class")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be invalid storage class", v)
+		}
+	}
+}
+
+func TestValidateS3BucketNotificationEvent(t *testing.T) {
+	validEvents := []string{
+		"s3:ObjectCreated:*",
+		"s3:ObjectRemoved:Delete",
+		"s3:ReducedRedundancyLostObject",
+	}
+	for _, v := range validEvents {
+		_, errors := validateS3BucketNotificationEvent(v, "events")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid event: %q", v, errors)
+		}
+	}
+
+	invalidEvents := []string{
+		"s3:ObjectCreated",
+		"ObjectCreated:Put",
+		"s3:objectcreated:put",
+	}
+	for _, v := range invalidEvents {
+		_, errors := validateS3BucketNotificationEvent(v, "events")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid event", v)
+		}
+	}
+}
+
+func TestValidateDuration(t *testing.T) {
+	validDurations := []string{
+		"0s",
+		"30s",
+		"2m",
+		"1h30m",
+	}
+	for _, v := range validDurations {
+		_, errors := validateDuration(v, "iam_delay")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid duration: %q", v, errors)
+		}
+	}
+
+	invalidDurations := []string{
+		"",
+		"30",
+		"two minutes",
+		"-5s",
+	}
+	for _, v := range invalidDurations {
+		_, errors := validateDuration(v, "iam_delay")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid duration", v)
+		}
+	}
+}
+
+func TestValidateRegexp(t *testing.T) {
+	validRegexps := []string{
+		"",
+		"^amzn-ami-hvm-",
+		"^ubuntu/images/hvm-ssd/ubuntu-xenial-16.04-amd64-server-\\d+$",
+	}
+	for _, v := range validRegexps {
+		_, errors := validateRegexp(v, "name_regex")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid regular expression: %q", v, errors)
+		}
+	}
+
+	invalidRegexps := []string{
+		"[",
+		"amzn-ami-(hvm",
+		"*-x86_64-gp2",
+	}
+	for _, v := range invalidRegexps {
+		_, errors := validateRegexp(v, "name_regex")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid regular expression", v)
+		}
+	}
+}
+
+func TestValidateSecurityGroupRuleDescription(t *testing.T) {
+	validDescriptions := []string{
+		"testrule",
+		"testRule",
+		"testRule 123",
+		`testRule 123 ._-:/()#,@[]+=;{}!$*`,
+	}
+	for _, v := range validDescriptions {
+		_, errors := validateSecurityGroupRuleDescription(v, "description")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid security group rule description: %q", v, errors)
+		}
+	}
+
+	invalidDescriptions := []string{
+		"`",
+		"%%",
+		`\`,
+		strings.Repeat("W", 256),
+	}
+	for _, v := range invalidDescriptions {
+		_, errors := validateSecurityGroupRuleDescription(v, "description")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid security group rule description", v)
+		}
+	}
+}
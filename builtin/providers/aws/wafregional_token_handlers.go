@@ -0,0 +1,52 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// wafRegionalRetryer runs WAF Regional changes, each of which requires a
+// change token. Only one change token can be in use at a time per region,
+// so changes are serialized, and retried when the token went stale.
+type wafRegionalRetryer struct {
+	Connection *wafregional.WAFRegional
+	Region     string
+}
+
+type withRegionalTokenFunc func(token *string) (interface{}, error)
+
+func (t *wafRegionalRetryer) RetryWithToken(f withRegionalTokenFunc) (interface{}, error) {
+	key := fmt.Sprintf("wafregional-%s", t.Region)
+	awsMutexKV.Lock(key)
+	defer awsMutexKV.Unlock(key)
+
+	var out interface{}
+	err := resource.Retry(15*time.Minute, func() *resource.RetryError {
+		tokenOut, err := t.Connection.GetChangeToken(&waf.GetChangeTokenInput{})
+		if err != nil {
+			return resource.NonRetryableError(
+				fmt.Errorf("Failed to acquire change token: %s", err))
+		}
+
+		out, err = f(tokenOut.ChangeToken)
+		if err != nil {
+			if isAWSErr(err, waf.ErrCodeStaleDataException, "") {
+				log.Printf("[DEBUG] WAF Regional change token is stale, retrying: %s", err)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	return out, err
+}
+
+func newWafRegionalRetryer(conn *wafregional.WAFRegional, region string) *wafRegionalRetryer {
+	return &wafRegionalRetryer{Connection: conn, Region: region}
+}
@@ -0,0 +1,255 @@
+package okta
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+// Client is a minimal client for the Okta management API, covering the
+// group and application endpoints the provider manages.
+type Client struct {
+	BaseUrl  string // Location of the Okta organization
+	ApiToken string // API token used to authenticate
+	Http     *http.Client
+}
+
+// ErrNotFound is returned when the API responds with a 404 for an object.
+var ErrNotFound = fmt.Errorf("Okta object not found")
+
+type errorResponse struct {
+	ErrorCode    string `json:"errorCode"`
+	ErrorSummary string `json:"errorSummary"`
+}
+
+// GroupProfile is the profile of an Okta group.
+type GroupProfile struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// Group is an Okta group.
+type Group struct {
+	Id      string       `json:"id,omitempty"`
+	Profile GroupProfile `json:"profile"`
+}
+
+// SamlSignOn holds the SAML 2.0 settings of a custom application.
+type SamlSignOn struct {
+	DefaultRelayState     string `json:"defaultRelayState"`
+	SsoAcsUrl             string `json:"ssoAcsUrl"`
+	Recipient             string `json:"recipient"`
+	Destination           string `json:"destination"`
+	Audience              string `json:"audience"`
+	SubjectNameIdTemplate string `json:"subjectNameIdTemplate"`
+	SubjectNameIdFormat   string `json:"subjectNameIdFormat"`
+	ResponseSigned        bool   `json:"responseSigned"`
+	AssertionSigned       bool   `json:"assertionSigned"`
+	SignatureAlgorithm    string `json:"signatureAlgorithm"`
+	DigestAlgorithm       string `json:"digestAlgorithm"`
+	HonorForceAuthn       bool   `json:"honorForceAuthn"`
+	AuthnContextClassRef  string `json:"authnContextClassRef"`
+}
+
+// AppSettings holds the sign on settings of an application.
+type AppSettings struct {
+	SignOn *SamlSignOn `json:"signOn,omitempty"`
+}
+
+// App is an Okta application.
+type App struct {
+	Id         string      `json:"id,omitempty"`
+	Name       string      `json:"name,omitempty"`
+	Label      string      `json:"label"`
+	Status     string      `json:"status,omitempty"`
+	SignOnMode string      `json:"signOnMode"`
+	Settings   AppSettings `json:"settings"`
+}
+
+// AppGroupAssignment assigns a group to an application.
+type AppGroupAssignment struct {
+	Id       string `json:"id,omitempty"`
+	Priority int    `json:"priority"`
+}
+
+// NewClient returns a new Okta client
+func NewClient(baseUrl, apiToken string) *Client {
+	return &Client{
+		BaseUrl:  strings.TrimRight(baseUrl, "/"),
+		ApiToken: apiToken,
+		Http:     cleanhttp.DefaultClient(),
+	}
+}
+
+// Creates a new request with necessary headers
+func (c *Client) newRequest(method, endpoint string, body interface{}) (*http.Request, error) {
+	var bodyReader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		bodyReader = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequest(method, c.BaseUrl+"/api/v1"+endpoint, bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("Error during creation of request: %s", err)
+	}
+
+	req.Header.Add("Authorization", "SSWS "+c.ApiToken)
+	req.Header.Add("Accept", "application/json")
+	if body != nil {
+		req.Header.Add("Content-Type", "application/json")
+	}
+
+	return req, nil
+}
+
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	resp, err := c.Http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, ErrNotFound
+	}
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+
+		var e errorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&e); err != nil || e.ErrorSummary == "" {
+			return nil, fmt.Errorf("Okta API error: %s", resp.Status)
+		}
+		return nil, fmt.Errorf("Okta API error (%s): %s", e.ErrorCode, e.ErrorSummary)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) do(method, endpoint string, in, out interface{}) error {
+	req, err := c.newRequest(method, endpoint, in)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.send(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if out != nil {
+		return json.NewDecoder(resp.Body).Decode(out)
+	}
+	return nil
+}
+
+// CreateGroup creates a new group
+func (c *Client) CreateGroup(g *Group) (*Group, error) {
+	var out Group
+	if err := c.do("POST", "/groups", g, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGroup returns the group with the given ID
+func (c *Client) GetGroup(id string) (*Group, error) {
+	var out Group
+	if err := c.do("GET", "/groups/"+id, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateGroup replaces the profile of an existing group
+func (c *Client) UpdateGroup(g *Group) error {
+	return c.do("PUT", "/groups/"+g.Id, g, nil)
+}
+
+// DeleteGroup removes the group with the given ID
+func (c *Client) DeleteGroup(id string) error {
+	return c.do("DELETE", "/groups/"+id, nil, nil)
+}
+
+// CreateApp creates and activates a new application
+func (c *Client) CreateApp(a *App) (*App, error) {
+	var out App
+	if err := c.do("POST", "/apps?activate=true", a, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetApp returns the application with the given ID
+func (c *Client) GetApp(id string) (*App, error) {
+	var out App
+	if err := c.do("GET", "/apps/"+id, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateApp replaces the settings of an existing application
+func (c *Client) UpdateApp(a *App) error {
+	return c.do("PUT", "/apps/"+a.Id, a, nil)
+}
+
+// DeleteApp deactivates and then removes the application with the given ID.
+// Okta refuses to delete active applications.
+func (c *Client) DeleteApp(id string) error {
+	if err := c.do("POST", "/apps/"+id+"/lifecycle/deactivate", nil, nil); err != nil {
+		return err
+	}
+	return c.do("DELETE", "/apps/"+id, nil, nil)
+}
+
+// GetAppSamlMetadata returns the SAML IdP metadata document of an
+// application, which service providers such as AWS IAM consume.
+func (c *Client) GetAppSamlMetadata(id string) (string, error) {
+	req, err := c.newRequest("GET", "/apps/"+id+"/sso/saml/metadata", nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/xml")
+
+	resp, err := c.send(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// PutAppGroupAssignment assigns a group to an application
+func (c *Client) PutAppGroupAssignment(appId, groupId string, a *AppGroupAssignment) error {
+	return c.do("PUT", fmt.Sprintf("/apps/%s/groups/%s", appId, groupId), a, nil)
+}
+
+// GetAppGroupAssignment returns the assignment of a group to an application
+func (c *Client) GetAppGroupAssignment(appId, groupId string) (*AppGroupAssignment, error) {
+	var out AppGroupAssignment
+	if err := c.do("GET", fmt.Sprintf("/apps/%s/groups/%s", appId, groupId), nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteAppGroupAssignment removes a group from an application
+func (c *Client) DeleteAppGroupAssignment(appId, groupId string) error {
+	return c.do("DELETE", fmt.Sprintf("/apps/%s/groups/%s", appId, groupId), nil, nil)
+}
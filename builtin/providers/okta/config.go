@@ -0,0 +1,29 @@
+package okta
+
+import (
+	"fmt"
+	"log"
+)
+
+type Config struct {
+	Organization string
+	BaseUrl      string
+	ApiToken     string
+}
+
+// Client returns a new client for accessing the Okta API
+func (c *Config) Client() (*Client, error) {
+	baseUrl := c.BaseUrl
+	if baseUrl == "" {
+		if c.Organization == "" {
+			return nil, fmt.Errorf("Either org_name or base_url must be set")
+		}
+		baseUrl = fmt.Sprintf("https://%s.okta.com", c.Organization)
+	}
+
+	client := NewClient(baseUrl, c.ApiToken)
+
+	log.Printf("[INFO] Okta Client configured for %s", baseUrl)
+
+	return client, nil
+}
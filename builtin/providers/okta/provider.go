@@ -0,0 +1,49 @@
+package okta
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func Provider() terraform.ResourceProvider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"org_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OKTA_ORG_NAME", ""),
+				Description: "Name of the Okta organization, e.g. dev-123456",
+			},
+			"base_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OKTA_BASE_URL", ""),
+				Description: "URL of the Okta organization, overriding org_name",
+			},
+			"api_token": {
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OKTA_API_TOKEN", nil),
+				Description: "API token used to authenticate",
+			},
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"okta_app_group_assignment": resourceOktaAppGroupAssignment(),
+			"okta_group":                resourceOktaGroup(),
+			"okta_saml_app":             resourceOktaSamlApp(),
+		},
+
+		ConfigureFunc: providerConfigure,
+	}
+}
+
+func providerConfigure(data *schema.ResourceData) (interface{}, error) {
+	config := Config{
+		Organization: data.Get("org_name").(string),
+		BaseUrl:      data.Get("base_url").(string),
+		ApiToken:     data.Get("api_token").(string),
+	}
+
+	return config.Client()
+}
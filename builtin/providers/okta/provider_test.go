@@ -0,0 +1,47 @@
+package okta
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// To run these acceptance tests, you will need access to an Okta organization.
+//
+// Set the OKTA_API_TOKEN environment variable along with either OKTA_ORG_NAME
+// or OKTA_BASE_URL before running the tests.
+//
+// You can run the tests like this:
+//    make testacc TEST=./builtin/providers/okta
+
+var testAccProviders map[string]terraform.ResourceProvider
+var testAccProvider *schema.Provider
+
+func init() {
+	testAccProvider = Provider().(*schema.Provider)
+	testAccProviders = map[string]terraform.ResourceProvider{
+		"okta": testAccProvider,
+	}
+}
+
+func TestProvider(t *testing.T) {
+	if err := Provider().(*schema.Provider).InternalValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestProvider_impl(t *testing.T) {
+	var _ terraform.ResourceProvider = Provider()
+}
+
+func testAccPreCheck(t *testing.T) {
+	if v := os.Getenv("OKTA_API_TOKEN"); v == "" {
+		t.Fatal("OKTA_API_TOKEN must be set for acceptance tests")
+	}
+
+	if os.Getenv("OKTA_ORG_NAME") == "" && os.Getenv("OKTA_BASE_URL") == "" {
+		t.Fatal("OKTA_ORG_NAME or OKTA_BASE_URL must be set for acceptance tests")
+	}
+}
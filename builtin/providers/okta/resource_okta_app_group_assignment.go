@@ -0,0 +1,121 @@
+package okta
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceOktaAppGroupAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOktaAppGroupAssignmentCreate,
+		Read:   resourceOktaAppGroupAssignmentRead,
+		Update: resourceOktaAppGroupAssignmentUpdate,
+		Delete: resourceOktaAppGroupAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceOktaAppGroupAssignmentImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"priority": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+		},
+	}
+}
+
+func resourceOktaAppGroupAssignmentPut(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	appId := d.Get("app_id").(string)
+	groupId := d.Get("group_id").(string)
+	assignment := &AppGroupAssignment{
+		Priority: d.Get("priority").(int),
+	}
+
+	log.Printf("[DEBUG] Assigning Okta group %s to app %s: %#v", groupId, appId, assignment)
+	return client.PutAppGroupAssignment(appId, groupId, assignment)
+}
+
+func resourceOktaAppGroupAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceOktaAppGroupAssignmentPut(d, meta); err != nil {
+		return fmt.Errorf("Failed to assign Okta group to app: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("app_id").(string), d.Get("group_id").(string)))
+
+	return resourceOktaAppGroupAssignmentRead(d, meta)
+}
+
+func resourceOktaAppGroupAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	appId := d.Get("app_id").(string)
+	groupId := d.Get("group_id").(string)
+
+	assignment, err := client.GetAppGroupAssignment(appId, groupId)
+	if err != nil {
+		if err == ErrNotFound {
+			log.Printf("[WARN] Okta group assignment %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Okta group assignment %s: %s", d.Id(), err)
+	}
+
+	d.Set("priority", assignment.Priority)
+
+	return nil
+}
+
+func resourceOktaAppGroupAssignmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceOktaAppGroupAssignmentPut(d, meta); err != nil {
+		return fmt.Errorf("Failed to update Okta group assignment: %s", err)
+	}
+
+	return resourceOktaAppGroupAssignmentRead(d, meta)
+}
+
+func resourceOktaAppGroupAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	appId := d.Get("app_id").(string)
+	groupId := d.Get("group_id").(string)
+
+	log.Printf("[DEBUG] Removing Okta group %s from app %s", groupId, appId)
+	if err := client.DeleteAppGroupAssignment(appId, groupId); err != nil && err != ErrNotFound {
+		return fmt.Errorf("Error removing Okta group assignment %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceOktaAppGroupAssignmentImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected APP_ID/GROUP_ID", d.Id())
+	}
+
+	d.Set("app_id", parts[0])
+	d.Set("group_id", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,102 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOktaAppGroupAssignment_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOktaAppGroupAssignmentDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOktaAppGroupAssignmentConfig(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOktaAppGroupAssignmentExists("okta_app_group_assignment.test"),
+					resource.TestCheckResourceAttr(
+						"okta_app_group_assignment.test", "priority", "0"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccOktaAppGroupAssignmentConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOktaAppGroupAssignmentExists("okta_app_group_assignment.test"),
+					resource.TestCheckResourceAttr(
+						"okta_app_group_assignment.test", "priority", "1"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "okta_app_group_assignment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckOktaAppGroupAssignmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		appId := rs.Primary.Attributes["app_id"]
+		groupId := rs.Primary.Attributes["group_id"]
+
+		client := testAccProvider.Meta().(*Client)
+		if _, err := client.GetAppGroupAssignment(appId, groupId); err != nil {
+			return fmt.Errorf("Error retrieving group assignment %s: %s", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOktaAppGroupAssignmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "okta_app_group_assignment" {
+			continue
+		}
+
+		_, err := client.GetAppGroupAssignment(
+			rs.Primary.Attributes["app_id"], rs.Primary.Attributes["group_id"])
+		if err == nil {
+			return fmt.Errorf("Group assignment still exists: %s", rs.Primary.ID)
+		}
+		if err != ErrNotFound {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccOktaAppGroupAssignmentConfig(name string, priority int) string {
+	return fmt.Sprintf(`
+resource "okta_group" "test" {
+    name = "%s"
+}
+
+resource "okta_saml_app" "test" {
+    label = "%s"
+    sso_url = "https://signin.aws.amazon.com/saml"
+    audience = "urn:amazon:webservices"
+}
+
+resource "okta_app_group_assignment" "test" {
+    app_id = "${okta_saml_app.test.id}"
+    group_id = "${okta_group.test.id}"
+    priority = %d
+}`, name, name, priority)
+}
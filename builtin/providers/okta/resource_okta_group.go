@@ -0,0 +1,101 @@
+package okta
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceOktaGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOktaGroupCreate,
+		Read:   resourceOktaGroupRead,
+		Update: resourceOktaGroupUpdate,
+		Delete: resourceOktaGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func buildOktaGroup(d *schema.ResourceData) *Group {
+	return &Group{
+		Id: d.Id(),
+		Profile: GroupProfile{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+		},
+	}
+}
+
+func resourceOktaGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	group := buildOktaGroup(d)
+	log.Printf("[DEBUG] Creating Okta group: %#v", group)
+
+	created, err := client.CreateGroup(group)
+	if err != nil {
+		return fmt.Errorf("Failed to create Okta group: %s", err)
+	}
+
+	d.SetId(created.Id)
+
+	return resourceOktaGroupRead(d, meta)
+}
+
+func resourceOktaGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	group, err := client.GetGroup(d.Id())
+	if err != nil {
+		if err == ErrNotFound {
+			log.Printf("[WARN] Okta group %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Okta group %s: %s", d.Id(), err)
+	}
+
+	d.Set("name", group.Profile.Name)
+	d.Set("description", group.Profile.Description)
+
+	return nil
+}
+
+func resourceOktaGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	group := buildOktaGroup(d)
+	log.Printf("[DEBUG] Updating Okta group: %#v", group)
+
+	if err := client.UpdateGroup(group); err != nil {
+		return fmt.Errorf("Failed to update Okta group: %s", err)
+	}
+
+	return resourceOktaGroupRead(d, meta)
+}
+
+func resourceOktaGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	log.Printf("[DEBUG] Deleting Okta group: %s", d.Id())
+	if err := client.DeleteGroup(d.Id()); err != nil && err != ErrNotFound {
+		return fmt.Errorf("Error deleting Okta group %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOktaGroup_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOktaGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOktaGroupConfig(rName, "Managed by Terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOktaGroupExists("okta_group.test"),
+					resource.TestCheckResourceAttr(
+						"okta_group.test", "name", rName),
+					resource.TestCheckResourceAttr(
+						"okta_group.test", "description", "Managed by Terraform"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccOktaGroupConfig(rName, "Updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOktaGroupExists("okta_group.test"),
+					resource.TestCheckResourceAttr(
+						"okta_group.test", "description", "Updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOktaGroup_importBasic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOktaGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOktaGroupConfig(rName, "Managed by Terraform"),
+			},
+
+			resource.TestStep{
+				ResourceName:      "okta_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckOktaGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No group ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Client)
+		if _, err := client.GetGroup(rs.Primary.ID); err != nil {
+			return fmt.Errorf("Error retrieving group %s: %s", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOktaGroupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "okta_group" {
+			continue
+		}
+
+		_, err := client.GetGroup(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Group still exists: %s", rs.Primary.ID)
+		}
+		if err != ErrNotFound {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccOktaGroupConfig(name, description string) string {
+	return fmt.Sprintf(`
+resource "okta_group" "test" {
+    name = "%s"
+    description = "%s"
+}`, name, description)
+}
@@ -0,0 +1,234 @@
+package okta
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceOktaSamlApp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOktaSamlAppCreate,
+		Read:   resourceOktaSamlAppRead,
+		Update: resourceOktaSamlAppUpdate,
+		Delete: resourceOktaSamlAppDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"label": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"sso_url": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"recipient": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"destination": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"audience": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"default_relay_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"subject_name_id_template": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "${user.userName}",
+			},
+
+			"subject_name_id_format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified",
+			},
+
+			"response_signed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"assertion_signed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"signature_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "RSA_SHA256",
+				ValidateFunc: validation.StringInSlice([]string{"RSA_SHA1", "RSA_SHA256"}, false),
+			},
+
+			"digest_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "SHA256",
+				ValidateFunc: validation.StringInSlice([]string{"SHA1", "SHA256"}, false),
+			},
+
+			"honor_force_authn": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"authn_context_class_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport",
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"metadata": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildOktaSamlApp(d *schema.ResourceData) *App {
+	signOn := &SamlSignOn{
+		DefaultRelayState:     d.Get("default_relay_state").(string),
+		SsoAcsUrl:             d.Get("sso_url").(string),
+		Recipient:             d.Get("sso_url").(string),
+		Destination:           d.Get("sso_url").(string),
+		Audience:              d.Get("audience").(string),
+		SubjectNameIdTemplate: d.Get("subject_name_id_template").(string),
+		SubjectNameIdFormat:   d.Get("subject_name_id_format").(string),
+		ResponseSigned:        d.Get("response_signed").(bool),
+		AssertionSigned:       d.Get("assertion_signed").(bool),
+		SignatureAlgorithm:    d.Get("signature_algorithm").(string),
+		DigestAlgorithm:       d.Get("digest_algorithm").(string),
+		HonorForceAuthn:       d.Get("honor_force_authn").(bool),
+		AuthnContextClassRef:  d.Get("authn_context_class_ref").(string),
+	}
+
+	// The recipient and destination default to the SSO URL, as they do in
+	// the Okta admin console.
+	if v, ok := d.GetOk("recipient"); ok {
+		signOn.Recipient = v.(string)
+	}
+	if v, ok := d.GetOk("destination"); ok {
+		signOn.Destination = v.(string)
+	}
+
+	return &App{
+		Id:         d.Id(),
+		Label:      d.Get("label").(string),
+		SignOnMode: "SAML_2_0",
+		Settings: AppSettings{
+			SignOn: signOn,
+		},
+	}
+}
+
+func resourceOktaSamlAppCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	app := buildOktaSamlApp(d)
+	log.Printf("[DEBUG] Creating Okta SAML app: %#v", app)
+
+	created, err := client.CreateApp(app)
+	if err != nil {
+		return fmt.Errorf("Failed to create Okta SAML app: %s", err)
+	}
+
+	d.SetId(created.Id)
+
+	return resourceOktaSamlAppRead(d, meta)
+}
+
+func resourceOktaSamlAppRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	app, err := client.GetApp(d.Id())
+	if err != nil {
+		if err == ErrNotFound {
+			log.Printf("[WARN] Okta app %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Okta app %s: %s", d.Id(), err)
+	}
+
+	if app.SignOnMode != "SAML_2_0" || app.Settings.SignOn == nil {
+		return fmt.Errorf("Okta app %s is not a SAML 2.0 application", d.Id())
+	}
+
+	signOn := app.Settings.SignOn
+	d.Set("label", app.Label)
+	d.Set("name", app.Name)
+	d.Set("sso_url", signOn.SsoAcsUrl)
+	d.Set("recipient", signOn.Recipient)
+	d.Set("destination", signOn.Destination)
+	d.Set("audience", signOn.Audience)
+	d.Set("default_relay_state", signOn.DefaultRelayState)
+	d.Set("subject_name_id_template", signOn.SubjectNameIdTemplate)
+	d.Set("subject_name_id_format", signOn.SubjectNameIdFormat)
+	d.Set("response_signed", signOn.ResponseSigned)
+	d.Set("assertion_signed", signOn.AssertionSigned)
+	d.Set("signature_algorithm", signOn.SignatureAlgorithm)
+	d.Set("digest_algorithm", signOn.DigestAlgorithm)
+	d.Set("honor_force_authn", signOn.HonorForceAuthn)
+	d.Set("authn_context_class_ref", signOn.AuthnContextClassRef)
+
+	metadata, err := client.GetAppSamlMetadata(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading SAML metadata of Okta app %s: %s", d.Id(), err)
+	}
+	d.Set("metadata", metadata)
+
+	return nil
+}
+
+func resourceOktaSamlAppUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	app := buildOktaSamlApp(d)
+	log.Printf("[DEBUG] Updating Okta SAML app: %#v", app)
+
+	if err := client.UpdateApp(app); err != nil {
+		return fmt.Errorf("Failed to update Okta SAML app: %s", err)
+	}
+
+	return resourceOktaSamlAppRead(d, meta)
+}
+
+func resourceOktaSamlAppDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	log.Printf("[DEBUG] Deleting Okta app: %s", d.Id())
+	if err := client.DeleteApp(d.Id()); err != nil && err != ErrNotFound {
+		return fmt.Errorf("Error deleting Okta app %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOktaSamlApp_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOktaSamlAppDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOktaSamlAppConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOktaSamlAppExists("okta_saml_app.test"),
+					resource.TestCheckResourceAttr(
+						"okta_saml_app.test", "label", rName),
+					resource.TestCheckResourceAttr(
+						"okta_saml_app.test", "recipient", "https://signin.aws.amazon.com/saml"),
+					resource.TestCheckResourceAttrSet(
+						"okta_saml_app.test", "metadata"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOktaSamlAppExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No app ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Client)
+		if _, err := client.GetApp(rs.Primary.ID); err != nil {
+			return fmt.Errorf("Error retrieving app %s: %s", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOktaSamlAppDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "okta_saml_app" {
+			continue
+		}
+
+		_, err := client.GetApp(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("App still exists: %s", rs.Primary.ID)
+		}
+		if err != ErrNotFound {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccOktaSamlAppConfig(name string) string {
+	return fmt.Sprintf(`
+resource "okta_saml_app" "test" {
+    label = "%s"
+    sso_url = "https://signin.aws.amazon.com/saml"
+    audience = "urn:amazon:webservices"
+}`, name)
+}
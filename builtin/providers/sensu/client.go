@@ -0,0 +1,206 @@
+package sensu
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+// Client is a minimal client for the Sensu core/v2 HTTP API, covering the
+// entity and check endpoints the provider manages.
+type Client struct {
+	ApiUrl    string // Location of the Sensu backend API
+	Namespace string // Namespace resources are registered in
+	Http      *http.Client
+
+	apiKey      string
+	accessToken string
+}
+
+// ErrNotFound is returned when the API responds with a 404 for an object.
+var ErrNotFound = fmt.Errorf("Sensu object not found")
+
+// Metadata is the common object metadata shared by Sensu resources.
+type Metadata struct {
+	Name        string            `json:"name"`
+	Namespace   string            `json:"namespace"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Entity is a Sensu entity. The provider manages proxy entities, which
+// represent hosts that are monitored without running a Sensu agent.
+type Entity struct {
+	Metadata      Metadata `json:"metadata"`
+	EntityClass   string   `json:"entity_class"`
+	Subscriptions []string `json:"subscriptions"`
+	Deregister    bool     `json:"deregister"`
+}
+
+// Check is a Sensu check definition.
+type Check struct {
+	Metadata        Metadata `json:"metadata"`
+	Command         string   `json:"command"`
+	Subscriptions   []string `json:"subscriptions"`
+	Handlers        []string `json:"handlers"`
+	Interval        int      `json:"interval"`
+	Timeout         int      `json:"timeout,omitempty"`
+	Publish         bool     `json:"publish"`
+	ProxyEntityName string   `json:"proxy_entity_name,omitempty"`
+	RoundRobin      bool     `json:"round_robin"`
+}
+
+type authResponse struct {
+	AccessToken string `json:"access_token"`
+}
+
+// NewClient returns a new Sensu client. Either an API key or a username
+// and password must be supplied; the latter are exchanged for an access
+// token up front.
+func NewClient(apiUrl, namespace, apiKey, username, password string) (*Client, error) {
+	client := &Client{
+		ApiUrl:    strings.TrimRight(apiUrl, "/"),
+		Namespace: namespace,
+		Http:      cleanhttp.DefaultClient(),
+		apiKey:    apiKey,
+	}
+
+	if apiKey == "" {
+		if err := client.authenticate(username, password); err != nil {
+			return nil, err
+		}
+	}
+
+	return client, nil
+}
+
+func (c *Client) authenticate(username, password string) error {
+	req, err := http.NewRequest("GET", c.ApiUrl+"/auth", nil)
+	if err != nil {
+		return fmt.Errorf("Error during creation of request: %s", err)
+	}
+	req.SetBasicAuth(username, password)
+
+	resp, err := c.Http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Error authenticating to Sensu: %s", resp.Status)
+	}
+
+	var auth authResponse
+	if err := json.NewDecoder(resp.Body).Decode(&auth); err != nil {
+		return err
+	}
+	c.accessToken = auth.AccessToken
+
+	return nil
+}
+
+// Creates a new request against the namespaced core/v2 API
+func (c *Client) newRequest(method, kind, name string, body interface{}) (*http.Request, error) {
+	urlStr := fmt.Sprintf("%s/api/core/v2/namespaces/%s/%s/%s",
+		c.ApiUrl, url.QueryEscape(c.Namespace), kind, url.QueryEscape(name))
+
+	var bodyReader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		bodyReader = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequest(method, urlStr, bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("Error during creation of request: %s", err)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Add("Authorization", "Key "+c.apiKey)
+	} else {
+		req.Header.Add("Authorization", "Bearer "+c.accessToken)
+	}
+	req.Header.Add("Accept", "application/json")
+	if body != nil {
+		req.Header.Add("Content-Type", "application/json")
+	}
+
+	return req, nil
+}
+
+func (c *Client) do(method, kind, name string, in, out interface{}) error {
+	req, err := c.newRequest(method, kind, name, in)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.Http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+	if resp.StatusCode >= 400 {
+		msg, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("Sensu API error (%s): %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	if out != nil {
+		return json.NewDecoder(resp.Body).Decode(out)
+	}
+	return nil
+}
+
+// PutEntity creates or replaces an entity
+func (c *Client) PutEntity(e *Entity) error {
+	e.Metadata.Namespace = c.Namespace
+	return c.do("PUT", "entities", e.Metadata.Name, e, nil)
+}
+
+// GetEntity returns the entity with the given name
+func (c *Client) GetEntity(name string) (*Entity, error) {
+	var e Entity
+	if err := c.do("GET", "entities", name, nil, &e); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// DeleteEntity deregisters the entity with the given name
+func (c *Client) DeleteEntity(name string) error {
+	return c.do("DELETE", "entities", name, nil, nil)
+}
+
+// PutCheck creates or replaces a check definition
+func (c *Client) PutCheck(check *Check) error {
+	check.Metadata.Namespace = c.Namespace
+	return c.do("PUT", "checks", check.Metadata.Name, check, nil)
+}
+
+// GetCheck returns the check definition with the given name
+func (c *Client) GetCheck(name string) (*Check, error) {
+	var check Check
+	if err := c.do("GET", "checks", name, nil, &check); err != nil {
+		return nil, err
+	}
+	return &check, nil
+}
+
+// DeleteCheck removes the check definition with the given name
+func (c *Client) DeleteCheck(name string) error {
+	return c.do("DELETE", "checks", name, nil, nil)
+}
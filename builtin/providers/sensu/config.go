@@ -0,0 +1,30 @@
+package sensu
+
+import (
+	"fmt"
+	"log"
+)
+
+type Config struct {
+	ApiUrl    string
+	Namespace string
+	ApiKey    string
+	Username  string
+	Password  string
+}
+
+// Client returns a new client for accessing the Sensu API
+func (c *Config) Client() (*Client, error) {
+	if c.ApiKey == "" && (c.Username == "" || c.Password == "") {
+		return nil, fmt.Errorf("Either api_key or both username and password must be set")
+	}
+
+	client, err := NewClient(c.ApiUrl, c.Namespace, c.ApiKey, c.Username, c.Password)
+	if err != nil {
+		return nil, fmt.Errorf("Error setting up Sensu client: %s", err)
+	}
+
+	log.Printf("[INFO] Sensu Client configured for server %s", c.ApiUrl)
+
+	return client, nil
+}
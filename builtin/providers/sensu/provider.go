@@ -0,0 +1,62 @@
+package sensu
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func Provider() terraform.ResourceProvider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"api_url": {
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SENSU_API_URL", nil),
+				Description: "Location of the Sensu backend API",
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SENSU_NAMESPACE", "default"),
+				Description: "Sensu namespace to manage objects in",
+			},
+			"api_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SENSU_API_KEY", ""),
+				Description: "API key used to authenticate",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SENSU_USERNAME", ""),
+				Description: "Username used to authenticate when no API key is set",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SENSU_PASSWORD", ""),
+				Description: "Password used to authenticate when no API key is set",
+			},
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"sensu_check":  resourceSensuCheck(),
+			"sensu_entity": resourceSensuEntity(),
+		},
+
+		ConfigureFunc: providerConfigure,
+	}
+}
+
+func providerConfigure(data *schema.ResourceData) (interface{}, error) {
+	config := Config{
+		ApiUrl:    data.Get("api_url").(string),
+		Namespace: data.Get("namespace").(string),
+		ApiKey:    data.Get("api_key").(string),
+		Username:  data.Get("username").(string),
+		Password:  data.Get("password").(string),
+	}
+
+	return config.Client()
+}
@@ -0,0 +1,48 @@
+package sensu
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// To run these acceptance tests, you will need access to a Sensu backend.
+//
+// Set the SENSU_API_URL environment variable along with either SENSU_API_KEY
+// or SENSU_USERNAME and SENSU_PASSWORD before running the tests.
+//
+// You can run the tests like this:
+//    make testacc TEST=./builtin/providers/sensu
+
+var testAccProviders map[string]terraform.ResourceProvider
+var testAccProvider *schema.Provider
+
+func init() {
+	testAccProvider = Provider().(*schema.Provider)
+	testAccProviders = map[string]terraform.ResourceProvider{
+		"sensu": testAccProvider,
+	}
+}
+
+func TestProvider(t *testing.T) {
+	if err := Provider().(*schema.Provider).InternalValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestProvider_impl(t *testing.T) {
+	var _ terraform.ResourceProvider = Provider()
+}
+
+func testAccPreCheck(t *testing.T) {
+	if v := os.Getenv("SENSU_API_URL"); v == "" {
+		t.Fatal("SENSU_API_URL must be set for acceptance tests")
+	}
+
+	if os.Getenv("SENSU_API_KEY") == "" &&
+		(os.Getenv("SENSU_USERNAME") == "" || os.Getenv("SENSU_PASSWORD") == "") {
+		t.Fatal("SENSU_API_KEY, or SENSU_USERNAME and SENSU_PASSWORD, must be set for acceptance tests")
+	}
+}
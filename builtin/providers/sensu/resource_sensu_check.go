@@ -0,0 +1,161 @@
+package sensu
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceSensuCheck() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSensuCheckCreate,
+		Read:   resourceSensuCheckRead,
+		Update: resourceSensuCheckUpdate,
+		Delete: resourceSensuCheckDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"command": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"subscriptions": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"handlers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"interval": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  60,
+			},
+
+			"timeout": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"publish": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"round_robin": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"proxy_entity_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func buildSensuCheck(d *schema.ResourceData) *Check {
+	return &Check{
+		Metadata: Metadata{
+			Name:   d.Get("name").(string),
+			Labels: expandStringMap(d.Get("labels").(map[string]interface{})),
+		},
+		Command:         d.Get("command").(string),
+		Subscriptions:   expandStringList(d.Get("subscriptions").([]interface{})),
+		Handlers:        expandStringList(d.Get("handlers").([]interface{})),
+		Interval:        d.Get("interval").(int),
+		Timeout:         d.Get("timeout").(int),
+		Publish:         d.Get("publish").(bool),
+		RoundRobin:      d.Get("round_robin").(bool),
+		ProxyEntityName: d.Get("proxy_entity_name").(string),
+	}
+}
+
+func resourceSensuCheckCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	check := buildSensuCheck(d)
+	log.Printf("[DEBUG] Creating Sensu check: %#v", check)
+
+	if err := client.PutCheck(check); err != nil {
+		return fmt.Errorf("Failed to create Sensu check: %s", err)
+	}
+
+	d.SetId(check.Metadata.Name)
+
+	return resourceSensuCheckRead(d, meta)
+}
+
+func resourceSensuCheckRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	check, err := client.GetCheck(d.Id())
+	if err != nil {
+		if err == ErrNotFound {
+			log.Printf("[WARN] Sensu check %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Sensu check %s: %s", d.Id(), err)
+	}
+
+	d.Set("name", check.Metadata.Name)
+	d.Set("command", check.Command)
+	d.Set("subscriptions", check.Subscriptions)
+	d.Set("handlers", check.Handlers)
+	d.Set("interval", check.Interval)
+	d.Set("timeout", check.Timeout)
+	d.Set("publish", check.Publish)
+	d.Set("round_robin", check.RoundRobin)
+	d.Set("proxy_entity_name", check.ProxyEntityName)
+	d.Set("labels", check.Metadata.Labels)
+
+	return nil
+}
+
+func resourceSensuCheckUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	check := buildSensuCheck(d)
+	log.Printf("[DEBUG] Updating Sensu check: %#v", check)
+
+	if err := client.PutCheck(check); err != nil {
+		return fmt.Errorf("Failed to update Sensu check: %s", err)
+	}
+
+	return resourceSensuCheckRead(d, meta)
+}
+
+func resourceSensuCheckDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	log.Printf("[DEBUG] Deleting Sensu check: %s", d.Id())
+	if err := client.DeleteCheck(d.Id()); err != nil && err != ErrNotFound {
+		return fmt.Errorf("Error deleting Sensu check %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package sensu
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccSensuCheck_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSensuCheckDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSensuCheckConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSensuCheckExists("sensu_check.http"),
+					resource.TestCheckResourceAttr(
+						"sensu_check.http", "interval", "30"),
+					resource.TestCheckResourceAttr(
+						"sensu_check.http", "proxy_entity_name", "tf-acc-web-02"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSensuCheckExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No check ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Client)
+		if _, err := client.GetCheck(rs.Primary.ID); err != nil {
+			return fmt.Errorf("Error retrieving check %s: %s", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSensuCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sensu_check" {
+			continue
+		}
+
+		_, err := client.GetCheck(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Check still exists: %s", rs.Primary.ID)
+		}
+		if err != ErrNotFound {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const testAccSensuCheckConfig_basic = `
+resource "sensu_entity" "web" {
+    name = "tf-acc-web-02"
+    subscriptions = ["proxy"]
+}
+
+resource "sensu_check" "http" {
+    name = "tf-acc-check-http"
+    command = "check-http.rb -u http://${sensu_entity.web.name}"
+    subscriptions = ["proxy"]
+    interval = 30
+    proxy_entity_name = "${sensu_entity.web.name}"
+}`
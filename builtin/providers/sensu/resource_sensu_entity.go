@@ -0,0 +1,117 @@
+package sensu
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceSensuEntity() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSensuEntityCreate,
+		Read:   resourceSensuEntityRead,
+		Update: resourceSensuEntityUpdate,
+		Delete: resourceSensuEntityDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"subscriptions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"deregister": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func buildSensuEntity(d *schema.ResourceData) *Entity {
+	return &Entity{
+		Metadata: Metadata{
+			Name:   d.Get("name").(string),
+			Labels: expandStringMap(d.Get("labels").(map[string]interface{})),
+		},
+		EntityClass:   "proxy",
+		Subscriptions: expandStringList(d.Get("subscriptions").([]interface{})),
+		Deregister:    d.Get("deregister").(bool),
+	}
+}
+
+func resourceSensuEntityCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	entity := buildSensuEntity(d)
+	log.Printf("[DEBUG] Creating Sensu entity: %#v", entity)
+
+	if err := client.PutEntity(entity); err != nil {
+		return fmt.Errorf("Failed to create Sensu entity: %s", err)
+	}
+
+	d.SetId(entity.Metadata.Name)
+
+	return resourceSensuEntityRead(d, meta)
+}
+
+func resourceSensuEntityRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	entity, err := client.GetEntity(d.Id())
+	if err != nil {
+		if err == ErrNotFound {
+			log.Printf("[WARN] Sensu entity %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Sensu entity %s: %s", d.Id(), err)
+	}
+
+	d.Set("name", entity.Metadata.Name)
+	d.Set("subscriptions", entity.Subscriptions)
+	d.Set("labels", entity.Metadata.Labels)
+	d.Set("deregister", entity.Deregister)
+
+	return nil
+}
+
+func resourceSensuEntityUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	entity := buildSensuEntity(d)
+	log.Printf("[DEBUG] Updating Sensu entity: %#v", entity)
+
+	if err := client.PutEntity(entity); err != nil {
+		return fmt.Errorf("Failed to update Sensu entity: %s", err)
+	}
+
+	return resourceSensuEntityRead(d, meta)
+}
+
+func resourceSensuEntityDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	log.Printf("[DEBUG] Deregistering Sensu entity: %s", d.Id())
+	if err := client.DeleteEntity(d.Id()); err != nil && err != ErrNotFound {
+		return fmt.Errorf("Error deregistering Sensu entity %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
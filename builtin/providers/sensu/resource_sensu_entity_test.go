@@ -0,0 +1,119 @@
+package sensu
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccSensuEntity_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSensuEntityDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSensuEntityConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSensuEntityExists("sensu_entity.web"),
+					resource.TestCheckResourceAttr(
+						"sensu_entity.web", "subscriptions.#", "1"),
+					resource.TestCheckResourceAttr(
+						"sensu_entity.web", "labels.role", "web"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccSensuEntityConfig_updated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSensuEntityExists("sensu_entity.web"),
+					resource.TestCheckResourceAttr(
+						"sensu_entity.web", "subscriptions.#", "2"),
+					resource.TestCheckResourceAttr(
+						"sensu_entity.web", "deregister", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSensuEntity_importBasic(t *testing.T) {
+	resourceName := "sensu_entity.web"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSensuEntityDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSensuEntityConfig_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSensuEntityExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No entity ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Client)
+		if _, err := client.GetEntity(rs.Primary.ID); err != nil {
+			return fmt.Errorf("Error retrieving entity %s: %s", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSensuEntityDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sensu_entity" {
+			continue
+		}
+
+		_, err := client.GetEntity(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Entity still exists: %s", rs.Primary.ID)
+		}
+		if err != ErrNotFound {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const testAccSensuEntityConfig_basic = `
+resource "sensu_entity" "web" {
+    name = "tf-acc-web-01"
+    subscriptions = ["web"]
+    labels {
+        role = "web"
+    }
+}`
+
+const testAccSensuEntityConfig_updated = `
+resource "sensu_entity" "web" {
+    name = "tf-acc-web-01"
+    subscriptions = ["web", "linux"]
+    deregister = true
+    labels {
+        role = "web"
+    }
+}`
@@ -0,0 +1,23 @@
+package sensu
+
+// Takes the result of a TypeList of strings and returns a []string
+func expandStringList(configured []interface{}) []string {
+	vs := make([]string, 0, len(configured))
+	for _, v := range configured {
+		vs = append(vs, v.(string))
+	}
+	return vs
+}
+
+// Takes the result of a TypeMap of strings and returns a map[string]string
+func expandStringMap(configured map[string]interface{}) map[string]string {
+	if len(configured) == 0 {
+		return nil
+	}
+
+	vs := make(map[string]string, len(configured))
+	for k, v := range configured {
+		vs[k] = v.(string)
+	}
+	return vs
+}
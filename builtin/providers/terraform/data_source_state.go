@@ -1,11 +1,14 @@
 package terraform
 
 import (
+	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/state/remote"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 func dataSourceRemoteState() *schema.Resource {
@@ -33,6 +36,12 @@ func dataSourceRemoteState() *schema.Resource {
 				Optional: true,
 			},
 
+			"required_outputs": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: validateRequiredOutputs,
+			},
+
 			"__has_dynamic_attributes": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -73,6 +82,9 @@ func dataSourceRemoteStateRead(d *schema.ResourceData, meta interface{}) error {
 	outputMap := make(map[string]interface{})
 
 	remoteState := state.State()
+	if err := checkRequiredOutputs(d, remoteState); err != nil {
+		return err
+	}
 	if remoteState.Empty() {
 		log.Println("[DEBUG] empty remote state")
 		return nil
@@ -89,3 +101,55 @@ func dataSourceRemoteStateRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	return nil
 }
+
+// validateRequiredOutputs checks that required_outputs maps output names to
+// the types outputs can have.
+func validateRequiredOutputs(v interface{}, k string) (ws []string, errors []error) {
+	for name, raw := range v.(map[string]interface{}) {
+		switch raw {
+		case "string", "list", "map", "":
+		default:
+			errors = append(errors, fmt.Errorf(
+				"%s: type of output %q must be string, list or map, got %q", k, name, raw))
+		}
+	}
+	return
+}
+
+// checkRequiredOutputs verifies that the remote state has every output in
+// required_outputs, and that each has the required type. An empty type
+// only requires the output to exist. This lets a configuration fail when
+// an output it depends on is renamed or changed upstream, rather than when
+// the missing value is first used.
+func checkRequiredOutputs(d *schema.ResourceData, s *terraform.State) error {
+	required := d.Get("required_outputs").(map[string]interface{})
+	if len(required) == 0 {
+		return nil
+	}
+
+	var outputs map[string]*terraform.OutputState
+	if !s.Empty() {
+		outputs = s.RootModule().Outputs
+	}
+
+	var errs []error
+	for name, raw := range required {
+		typ := raw.(string)
+		output, ok := outputs[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf(
+				"remote state has no output %q", name))
+			continue
+		}
+		if typ != "" && output.Type != typ {
+			errs = append(errs, fmt.Errorf(
+				"remote state output %q is of type %s, but %s is required",
+				name, output.Type, typ))
+		}
+	}
+
+	if len(errs) > 0 {
+		return &multierror.Error{Errors: errs}
+	}
+	return nil
+}
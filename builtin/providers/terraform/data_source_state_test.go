@@ -2,6 +2,7 @@ package terraform
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -43,6 +44,48 @@ func TestState_complexOutputs(t *testing.T) {
 	})
 }
 
+func TestState_requiredOutputs(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccState_requiredOutputs,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStateValue(
+						"data.terraform_remote_state.foo", "map.key", "test"),
+				),
+			},
+		},
+	})
+}
+
+func TestState_requiredOutputsMissing(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccState_requiredOutputsMissing,
+				ExpectError: regexp.MustCompile(`remote state has no output "vpc_id"`),
+			},
+		},
+	})
+}
+
+func TestState_requiredOutputsWrongType(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccState_requiredOutputsWrongType,
+				ExpectError: regexp.MustCompile(`output "set" is of type list, but map is required`),
+			},
+		},
+	})
+}
+
 func testAccCheckStateValue(id, name, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[id]
@@ -80,3 +123,44 @@ resource "terraform_remote_state" "foo" {
 		path = "./test-fixtures/complex_outputs.tfstate"
 	}
 }`
+
+const testAccState_requiredOutputs = `
+data "terraform_remote_state" "foo" {
+	backend = "local"
+
+	config {
+		path = "./test-fixtures/complex_outputs.tfstate"
+	}
+
+	required_outputs {
+		map = "map"
+		set = "list"
+		computed_map = ""
+	}
+}`
+
+const testAccState_requiredOutputsMissing = `
+data "terraform_remote_state" "foo" {
+	backend = "local"
+
+	config {
+		path = "./test-fixtures/complex_outputs.tfstate"
+	}
+
+	required_outputs {
+		vpc_id = "string"
+	}
+}`
+
+const testAccState_requiredOutputsWrongType = `
+data "terraform_remote_state" "foo" {
+	backend = "local"
+
+	config {
+		path = "./test-fixtures/complex_outputs.tfstate"
+	}
+
+	required_outputs {
+		set = "map"
+	}
+}`
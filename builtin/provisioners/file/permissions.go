@@ -0,0 +1,130 @@
+package file
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/communicator"
+	"github.com/hashicorp/terraform/communicator/remote"
+)
+
+// parsePermissions parses the permissions argument, an octal file mode
+// such as "0600".
+func parsePermissions(raw string) (os.FileMode, error) {
+	mode, err := strconv.ParseUint(raw, 8, 32)
+	if err != nil || mode > 0777 {
+		return 0, fmt.Errorf(
+			"'permissions' must be an octal file mode between 0000 and 0777, got %q", raw)
+	}
+	return os.FileMode(mode), nil
+}
+
+// permissionsCommand returns the remote command that gives the files
+// uploaded from src to dst the given mode. The command depends on the
+// connection type, as Windows hosts reached with WinRM have ACLs instead
+// of file modes.
+func permissionsCommand(connType, src, dst string, mode os.FileMode) (string, error) {
+	info, err := os.Stat(src)
+	if err != nil {
+		return "", err
+	}
+
+	if connType == "winrm" {
+		return icaclsCommand(dst, mode, info.IsDir()), nil
+	}
+
+	// Over SSH, a directory without a trailing slash is uploaded into the
+	// destination rather than replacing it.
+	target := dst
+	if info.IsDir() && !strings.HasSuffix(src, "/") {
+		target = path.Join(dst, path.Base(strings.Replace(src, "\\", "/", -1)))
+	}
+	return chmodCommand(target, mode, info.IsDir()), nil
+}
+
+// chmodCommand returns the command setting the mode of path on a Unix host.
+func chmodCommand(path string, mode os.FileMode, dir bool) string {
+	flags := ""
+	if dir {
+		flags = "-R "
+	}
+	quoted := "'" + strings.Replace(path, "'", `'"'"'`, -1) + "'"
+	return fmt.Sprintf("chmod %s%04o %s", flags, mode, quoted)
+}
+
+// icaclsCommand returns the command giving path the Windows ACL closest to
+// mode. The owner bits are granted to the connecting user, the group bits
+// to the built-in Users group and the other bits to Everyone. Inherited
+// entries are removed so nobody else keeps access to the files.
+func icaclsCommand(path string, mode os.FileMode, dir bool) string {
+	args := []string{`"` + path + `"`, "/inheritance:r"}
+	inherit := ""
+	if dir {
+		args = append(args, "/T")
+		inherit = "(OI)(CI)"
+	}
+
+	grants := []struct {
+		sid  string
+		bits os.FileMode
+	}{
+		{`%USERDOMAIN%\%USERNAME%`, mode >> 6 & 07},
+		{"*S-1-5-32-545", mode >> 3 & 07}, // BUILTIN\Users
+		{"*S-1-1-0", mode & 07},           // Everyone
+	}
+	for _, g := range grants {
+		right := aclRight(g.bits)
+		if right == "" {
+			continue
+		}
+		args = append(args, "/grant:r", fmt.Sprintf(`"%s:%s%s"`, g.sid, inherit, right))
+	}
+
+	return "icacls " + strings.Join(args, " ")
+}
+
+// aclRight maps the rwx bits of a file mode to an icacls permission mask.
+// Write without read has no simple right, so generic rights are used.
+func aclRight(bits os.FileMode) string {
+	switch bits {
+	case 07:
+		return "F"
+	case 06:
+		return "M"
+	case 05:
+		return "RX"
+	case 04:
+		return "R"
+	case 03:
+		return "(GW,GE)"
+	case 02:
+		return "W"
+	case 01:
+		return "(GE)"
+	default:
+		return ""
+	}
+}
+
+// runCommand runs command on the remote host and fails if it exits with a
+// non-zero status.
+func runCommand(comm communicator.Communicator, command string) error {
+	var stderr bytes.Buffer
+	cmd := &remote.Cmd{
+		Command: command,
+		Stderr:  &stderr,
+	}
+	if err := comm.Start(cmd); err != nil {
+		return fmt.Errorf("Error setting permissions: %s", err)
+	}
+	cmd.Wait()
+	if cmd.ExitStatus != 0 {
+		return fmt.Errorf("Error setting permissions: %q exited with status %d: %s",
+			command, cmd.ExitStatus, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
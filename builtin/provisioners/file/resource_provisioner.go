@@ -41,7 +41,25 @@ func (p *ResourceProvisioner) Apply(
 	if !ok {
 		return fmt.Errorf("Unsupported 'destination' type! Must be string.")
 	}
-	return p.copyFiles(comm, src, dst)
+
+	// Get the command setting the permissions, if any
+	var permCmd string
+	if pRaw, ok := c.Config["permissions"]; ok {
+		perms, ok := pRaw.(string)
+		if !ok {
+			return fmt.Errorf("Unsupported 'permissions' type! Must be string.")
+		}
+		mode, err := parsePermissions(perms)
+		if err != nil {
+			return err
+		}
+		permCmd, err = permissionsCommand(s.Ephemeral.ConnInfo["type"], src, dst, mode)
+		if err != nil {
+			return err
+		}
+	}
+
+	return p.copyFiles(comm, src, dst, permCmd)
 }
 
 // Validate checks if the required arguments are configured
@@ -54,6 +72,18 @@ func (p *ResourceProvisioner) Validate(c *terraform.ResourceConfig) (ws []string
 			numDst++
 		case "source", "content":
 			numSrc++
+		case "permissions":
+			if c.IsComputed(name) {
+				continue
+			}
+			perms, ok := c.Raw[name].(string)
+			if !ok {
+				es = append(es, fmt.Errorf("'permissions' must be a string, such as \"0600\""))
+				continue
+			}
+			if _, err := parsePermissions(perms); err != nil {
+				es = append(es, err)
+			}
 		default:
 			es = append(es, fmt.Errorf("Unknown configuration '%s'", name))
 		}
@@ -97,8 +127,9 @@ func (p *ResourceProvisioner) getSrc(c *terraform.ResourceConfig) (string, bool,
 	return expansion, false, err
 }
 
-// copyFiles is used to copy the files from a source to a destination
-func (p *ResourceProvisioner) copyFiles(comm communicator.Communicator, src, dst string) error {
+// copyFiles is used to copy the files from a source to a destination,
+// running permCmd afterwards if it isn't empty
+func (p *ResourceProvisioner) copyFiles(comm communicator.Communicator, src, dst, permCmd string) error {
 	// Wait and retry until we establish the connection
 	err := retryFunc(comm.Timeout(), func() error {
 		err := comm.Connect(nil)
@@ -119,7 +150,7 @@ func (p *ResourceProvisioner) copyFiles(comm communicator.Communicator, src, dst
 		if err := comm.UploadDir(dst, src); err != nil {
 			return fmt.Errorf("Upload failed: %v", err)
 		}
-		return nil
+		return p.setPermissions(comm, permCmd)
 	}
 
 	// We're uploading a file...
@@ -133,7 +164,16 @@ func (p *ResourceProvisioner) copyFiles(comm communicator.Communicator, src, dst
 	if err != nil {
 		return fmt.Errorf("Upload failed: %v", err)
 	}
-	return err
+	return p.setPermissions(comm, permCmd)
+}
+
+// setPermissions runs permCmd on the remote host if it isn't empty
+func (p *ResourceProvisioner) setPermissions(comm communicator.Communicator, permCmd string) error {
+	if permCmd == "" {
+		return nil
+	}
+	log.Printf("[DEBUG] Setting permissions: %s", permCmd)
+	return runCommand(comm, permCmd)
 }
 
 // retryFunc is used to retry a function for a given duration
@@ -1,8 +1,12 @@
 package file
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/hashicorp/terraform/communicator"
 	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -71,6 +75,125 @@ func TestResourceProvider_Validate_bad_to_many_src(t *testing.T) {
 	}
 }
 
+func TestResourceProvider_Validate_good_permissions(t *testing.T) {
+	c := testConfig(t, map[string]interface{}{
+		"source":      "/tmp/foo",
+		"destination": "/tmp/bar",
+		"permissions": "0600",
+	})
+	p := new(ResourceProvisioner)
+	warn, errs := p.Validate(c)
+	if len(warn) > 0 {
+		t.Fatalf("Warnings: %v", warn)
+	}
+	if len(errs) > 0 {
+		t.Fatalf("Errors: %v", errs)
+	}
+}
+
+func TestResourceProvider_Validate_bad_permissions(t *testing.T) {
+	for _, perms := range []interface{}{"0800", "1777", "rw-------", 600} {
+		c := testConfig(t, map[string]interface{}{
+			"source":      "/tmp/foo",
+			"destination": "/tmp/bar",
+			"permissions": perms,
+		})
+		p := new(ResourceProvisioner)
+		_, errs := p.Validate(c)
+		if len(errs) == 0 {
+			t.Fatalf("%v: should have errors", perms)
+		}
+	}
+}
+
+func TestResourceProvider_copyFiles_permissions(t *testing.T) {
+	f, err := ioutil.TempFile("", "tf-file")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString("hello")
+	f.Close()
+
+	cmd := "chmod 0600 '/tmp/bar'"
+	comm := &communicator.MockCommunicator{
+		Commands: map[string]bool{cmd: true},
+		Uploads:  map[string]string{"/tmp/bar": "hello"},
+	}
+
+	p := new(ResourceProvisioner)
+	if err := p.copyFiles(comm, f.Name(), "/tmp/bar", cmd); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := p.copyFiles(comm, f.Name(), "/tmp/bar", "chmod 0644 '/tmp/bar'"); err == nil {
+		t.Fatalf("should fail on an unknown command")
+	}
+}
+
+func TestPermissionsCommand(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-file")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "key")
+	if err := ioutil.WriteFile(file, []byte("secret"), 0644); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	cases := []struct {
+		ConnType string
+		Src      string
+		Dst      string
+		Mode     os.FileMode
+		Expected string
+	}{
+		{"ssh", file, "/etc/key", 0600, "chmod 0600 '/etc/key'"},
+		{"", file, "/tmp/it's", 0644, `chmod 0644 '/tmp/it'"'"'s'`},
+		{"ssh", dir + "/", "/srv/app", 0755, "chmod -R 0755 '/srv/app'"},
+		{"ssh", dir, "/srv", 0750, "chmod -R 0750 '/srv/" + filepath.Base(dir) + "'"},
+		{
+			"winrm", file, `C:\key`, 0600,
+			`icacls "C:\key" /inheritance:r /grant:r "%USERDOMAIN%\%USERNAME%:M"`,
+		},
+		{
+			"winrm", dir, `C:\app`, 0754,
+			`icacls "C:\app" /inheritance:r /T` +
+				` /grant:r "%USERDOMAIN%\%USERNAME%:(OI)(CI)F"` +
+				` /grant:r "*S-1-5-32-545:(OI)(CI)RX"` +
+				` /grant:r "*S-1-1-0:(OI)(CI)R"`,
+		},
+	}
+
+	for i, tc := range cases {
+		actual, err := permissionsCommand(tc.ConnType, tc.Src, tc.Dst, tc.Mode)
+		if err != nil {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+		if actual != tc.Expected {
+			t.Fatalf("%d: expected:\n%s\n\ngot:\n%s", i, tc.Expected, actual)
+		}
+	}
+}
+
+func TestACLRight(t *testing.T) {
+	cases := map[os.FileMode]string{
+		07: "F",
+		06: "M",
+		05: "RX",
+		04: "R",
+		03: "(GW,GE)",
+		02: "W",
+		01: "(GE)",
+		00: "",
+	}
+	for bits, expected := range cases {
+		if actual := aclRight(bits); actual != expected {
+			t.Fatalf("%o: expected %q, got %q", bits, expected, actual)
+		}
+	}
+}
+
 func testConfig(
 	t *testing.T,
 	c map[string]interface{}) *terraform.ResourceConfig {
@@ -0,0 +1,101 @@
+package kms
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// VariableSource returns a terraform.VariableSource that decrypts files
+// encrypted with AWS KMS. Locations are the path of a file, relative to the
+// root module. The file contains the ciphertext returned by the KMS Encrypt
+// API, either as is or base64 encoded as the AWS CLI prints it.
+//
+// Credentials and the region are taken from the environment and the shared
+// configuration files, like the AWS CLI does.
+func VariableSource() terraform.VariableSource {
+	return &variableSource{}
+}
+
+// decrypter is the part of the KMS API the source uses.
+type decrypter interface {
+	Decrypt(*kms.DecryptInput) (*kms.DecryptOutput, error)
+}
+
+type variableSource struct {
+	once    sync.Once
+	conn    decrypter
+	connErr error
+}
+
+func (s *variableSource) ReadVariable(location string, dir string) (string, error) {
+	path := location
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	blob := data
+	if decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data))); err == nil {
+		blob = decoded
+	}
+	if len(blob) == 0 {
+		return "", fmt.Errorf("%s is empty", location)
+	}
+
+	conn, err := s.client()
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := conn.Decrypt(&kms.DecryptInput{
+		CiphertextBlob: blob,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error decrypting %s: %s", location, err)
+	}
+
+	// Secrets are usually encrypted from a file or echo, which end them
+	// with a newline that isn't part of the secret.
+	return strings.TrimSuffix(string(resp.Plaintext), "\n"), nil
+}
+
+// client returns the KMS client, creating it the first time.
+func (s *variableSource) client() (decrypter, error) {
+	s.once.Do(func() {
+		if s.conn != nil {
+			return
+		}
+
+		// The SDK reads AWS_REGION, but not the AWS_DEFAULT_REGION the
+		// AWS CLI uses.
+		var config aws.Config
+		if region := os.Getenv("AWS_DEFAULT_REGION"); region != "" {
+			config.Region = aws.String(region)
+		}
+
+		sess, err := session.NewSessionWithOptions(session.Options{
+			Config:            config,
+			SharedConfigState: session.SharedConfigEnable,
+		})
+		if err != nil {
+			s.connErr = fmt.Errorf("error creating AWS session: %s", err)
+			return
+		}
+		s.conn = kms.New(sess)
+	})
+
+	return s.conn, s.connErr
+}
@@ -0,0 +1,83 @@
+package kms
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestVariableSource_impl(t *testing.T) {
+	var _ terraform.VariableSource = VariableSource()
+}
+
+// mockDecrypter decrypts the ciphertext "encrypted:PLAINTEXT".
+type mockDecrypter struct{}
+
+func (mockDecrypter) Decrypt(input *kms.DecryptInput) (*kms.DecryptOutput, error) {
+	prefix := []byte("encrypted:")
+	if !bytes.HasPrefix(input.CiphertextBlob, prefix) {
+		return nil, fmt.Errorf("InvalidCiphertextException")
+	}
+	return &kms.DecryptOutput{
+		Plaintext: bytes.TrimPrefix(input.CiphertextBlob, prefix),
+	}, nil
+}
+
+func TestVariableSourceReadVariable(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"raw.enc":     "encrypted:hunter2\n",
+		"base64.enc":  base64.StdEncoding.EncodeToString([]byte("encrypted:swordfish")) + "\n",
+		"invalid.enc": "hunter2",
+		"empty.enc":   "",
+	}
+	for name, content := range files {
+		err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	cases := []struct {
+		Location string
+		Value    string
+		Err      string
+	}{
+		{"raw.enc", "hunter2", ""},
+		{"base64.enc", "swordfish", ""},
+		{filepath.Join(dir, "raw.enc"), "hunter2", ""},
+		{"invalid.enc", "", "InvalidCiphertextException"},
+		{"empty.enc", "", "is empty"},
+		{"nope.enc", "", "no such file"},
+	}
+
+	s := &variableSource{conn: mockDecrypter{}}
+	for _, tc := range cases {
+		v, err := s.ReadVariable(tc.Location, dir)
+		if tc.Err != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.Err) {
+				t.Fatalf("%s: expected error %q, got %v", tc.Location, tc.Err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: err: %s", tc.Location, err)
+		}
+		if v != tc.Value {
+			t.Fatalf("%s: bad: %q", tc.Location, v)
+		}
+	}
+}
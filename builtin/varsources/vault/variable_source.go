@@ -0,0 +1,166 @@
+package vault
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/go-rootcerts"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mitchellh/go-homedir"
+)
+
+// defaultAddr is the address of Vault if VAULT_ADDR isn't set, the same as
+// the Vault CLI's.
+const defaultAddr = "https://127.0.0.1:8200"
+
+// VariableSource returns a terraform.VariableSource that reads secrets from
+// Vault. Locations are the path of a secret followed by the field to read,
+// such as "secret/db#password". The field defaults to "value".
+//
+// Vault is configured like the Vault CLI, with the VAULT_ADDR, VAULT_TOKEN,
+// VAULT_CACERT, VAULT_CAPATH and VAULT_SKIP_VERIFY environment variables.
+// Without VAULT_TOKEN, the token saved by "vault auth" is used.
+func VariableSource() terraform.VariableSource {
+	return &variableSource{}
+}
+
+type variableSource struct{}
+
+// secretResponse is the response of Vault to reading a secret.
+type secretResponse struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors []string               `json:"errors"`
+}
+
+func (s *variableSource) ReadVariable(location string, dir string) (string, error) {
+	path, field := location, "value"
+	if idx := strings.Index(location, "#"); idx >= 0 {
+		path, field = location[:idx], location[idx+1:]
+	}
+	path = strings.Trim(path, "/")
+	if path == "" || field == "" {
+		return "", fmt.Errorf(
+			"location must have the form PATH or PATH#FIELD, got %q", location)
+	}
+
+	token, err := vaultToken()
+	if err != nil {
+		return "", err
+	}
+	client, err := vaultClient()
+	if err != nil {
+		return "", err
+	}
+
+	addr := os.Getenv("VAULT_ADDR")
+	if addr == "" {
+		addr = defaultAddr
+	}
+	req, err := http.NewRequest(
+		"GET", strings.TrimRight(addr, "/")+"/v1/"+path, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", token)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var secret secretResponse
+	decodeErr := json.NewDecoder(resp.Body).Decode(&secret)
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return "", fmt.Errorf("no secret at %s", path)
+	case resp.StatusCode != http.StatusOK:
+		return "", fmt.Errorf("Vault returned %s: %s",
+			resp.Status, strings.Join(secret.Errors, ", "))
+	case decodeErr != nil:
+		return "", fmt.Errorf("error decoding the response of Vault: %s", decodeErr)
+	}
+
+	// Version 2 of the key/value backend nests the secret in the data of
+	// the response, next to its metadata.
+	data := secret.Data
+	if inner, ok := data["data"].(map[string]interface{}); ok {
+		if _, ok := data["metadata"]; ok {
+			data = inner
+		}
+	}
+
+	raw, ok := data[field]
+	if !ok {
+		return "", fmt.Errorf("secret %s has no field %q", path, field)
+	}
+
+	// Lists and maps are passed on as JSON, which variables of those types
+	// accept.
+	if v, ok := raw.(string); ok {
+		return v, nil
+	}
+	v, err := json.Marshal(raw)
+	if err != nil {
+		return "", err
+	}
+	return string(v), nil
+}
+
+// vaultToken returns the token from VAULT_TOKEN, or the one the Vault CLI
+// saved in the home directory.
+func vaultToken() (string, error) {
+	if token := os.Getenv("VAULT_TOKEN"); token != "" {
+		return token, nil
+	}
+
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", err
+	}
+	token, err := ioutil.ReadFile(filepath.Join(home, ".vault-token"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", fmt.Errorf(
+				"no Vault token: set VAULT_TOKEN or authenticate with \"vault auth\"")
+		}
+		return "", err
+	}
+
+	return strings.TrimSpace(string(token)), nil
+}
+
+func vaultClient() (*http.Client, error) {
+	tlsConfig := &tls.Config{}
+	err := rootcerts.ConfigureTLS(tlsConfig, &rootcerts.Config{
+		CAFile: os.Getenv("VAULT_CACERT"),
+		CAPath: os.Getenv("VAULT_CAPATH"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error loading Vault CA certificates: %s", err)
+	}
+
+	if v := os.Getenv("VAULT_SKIP_VERIFY"); v != "" {
+		skip, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("VAULT_SKIP_VERIFY must be a boolean: %s", err)
+		}
+		tlsConfig.InsecureSkipVerify = skip
+	}
+
+	transport := cleanhttp.DefaultTransport()
+	transport.TLSClientConfig = tlsConfig
+	return &http.Client{
+		Transport: transport,
+		Timeout:   60 * time.Second,
+	}, nil
+}
@@ -0,0 +1,91 @@
+package vault
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestVariableSource_impl(t *testing.T) {
+	var _ terraform.VariableSource = VariableSource()
+}
+
+func TestVariableSourceReadVariable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "root" {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"errors": ["permission denied"]}`))
+			return
+		}
+
+		switch r.URL.Path {
+		case "/v1/secret/db":
+			w.Write([]byte(`{"data": {"value": "hunter2", "password": "swordfish", "zones": ["a", "b"]}}`))
+		case "/v1/secret/data/db":
+			w.Write([]byte(`{"data": {"data": {"password": "swordfish"}, "metadata": {"version": 1}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors": []}`))
+		}
+	}))
+	defer server.Close()
+
+	defer setenv("VAULT_ADDR", server.URL)()
+	defer setenv("VAULT_TOKEN", "root")()
+
+	cases := []struct {
+		Location string
+		Value    string
+		Err      string
+	}{
+		{"secret/db", "hunter2", ""},
+		{"secret/db#password", "swordfish", ""},
+		{"/secret/db#zones", `["a","b"]`, ""},
+		{"secret/data/db#password", "swordfish", ""},
+		{"secret/db#nope", "", `no field "nope"`},
+		{"secret/nope", "", "no secret at secret/nope"},
+		{"secret/db#", "", "must have the form"},
+	}
+
+	s := VariableSource()
+	for _, tc := range cases {
+		v, err := s.ReadVariable(tc.Location, "")
+		if tc.Err != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.Err) {
+				t.Fatalf("%s: expected error %q, got %v", tc.Location, tc.Err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: err: %s", tc.Location, err)
+		}
+		if v != tc.Value {
+			t.Fatalf("%s: bad: %s", tc.Location, v)
+		}
+	}
+
+	// Errors of Vault are reported
+	defer setenv("VAULT_TOKEN", "nope")()
+	_, err := s.ReadVariable("secret/db", "")
+	if err == nil || !strings.Contains(err.Error(), "permission denied") {
+		t.Fatalf("bad: %v", err)
+	}
+}
+
+// setenv sets an environment variable and returns a function that
+// restores its previous value.
+func setenv(k, v string) func() {
+	old, ok := os.LookupEnv(k)
+	os.Setenv(k, v)
+	return func() {
+		if ok {
+			os.Setenv(k, old)
+		} else {
+			os.Unsetenv(k)
+		}
+	}
+}
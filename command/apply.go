@@ -6,10 +6,12 @@ import (
 	"os"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-getter"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -27,7 +29,10 @@ type ApplyCommand struct {
 }
 
 func (c *ApplyCommand) Run(args []string) int {
-	var destroyForce, refresh bool
+	var destroyForce, refresh, refreshData bool
+	var stageBy string
+	var deadline time.Duration
+	start := time.Now()
 	args = c.Meta.process(args, true)
 
 	cmdName := "apply"
@@ -38,18 +43,45 @@ func (c *ApplyCommand) Run(args []string) int {
 	cmdFlags := c.Meta.flagSet(cmdName)
 	if c.Destroy {
 		cmdFlags.BoolVar(&destroyForce, "force", false, "force")
+	} else {
+		cmdFlags.StringVar(&stageBy, "stage-by", "", "stage-by")
 	}
 	cmdFlags.BoolVar(&refresh, "refresh", true, "refresh")
+	cmdFlags.BoolVar(&refreshData, "refresh-data", false, "refresh-data")
+	cmdFlags.DurationVar(&deadline, "deadline", 0, "deadline")
 	cmdFlags.IntVar(
 		&c.Meta.parallelism, "parallelism", DefaultParallelism, "parallelism")
 	cmdFlags.StringVar(&c.Meta.statePath, "state", DefaultStateFilename, "path")
 	cmdFlags.StringVar(&c.Meta.stateOutPath, "state-out", "", "path")
 	cmdFlags.StringVar(&c.Meta.backupPath, "backup", "", "path")
+	cmdFlags.BoolVar(&c.Meta.stateLock, "lock", true, "lock state")
+	cmdFlags.DurationVar(&c.Meta.stateLockTimeout, "lock-timeout", 0, "lock timeout")
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
 	if err := cmdFlags.Parse(args); err != nil {
 		return 1
 	}
 
+	defer c.Meta.startMetrics(cmdName)()
+
+	if os.Getenv(schema.MockEnvVar) != "" {
+		c.Ui.Error(fmt.Sprintf(
+			"Can't %s while %s is set, since the providers only return\n"+
+				"placeholder values.", cmdName, schema.MockEnvVar))
+		return 1
+	}
+
+	switch stageBy {
+	case "", terraform.StageByDepth, terraform.StageByModule:
+	default:
+		c.Ui.Error(fmt.Sprintf(
+			"-stage-by must be %q or %q", terraform.StageByDepth, terraform.StageByModule))
+		return 1
+	}
+	if stageBy != "" && !c.Meta.input {
+		c.Ui.Error("-stage-by asks to confirm each stage, so it can't be used with -input=false.")
+		return 1
+	}
+
 	pwd, err := os.Getwd()
 	if err != nil {
 		c.Ui.Error(fmt.Sprintf("Error getting pwd: %s", err))
@@ -73,7 +105,8 @@ func (c *ApplyCommand) Run(args []string) int {
 	// Prepare the extra hooks to count resources
 	countHook := new(CountHook)
 	stateHook := new(StateHook)
-	c.Meta.extraHooks = []terraform.Hook{countHook, stateHook}
+	warningHook := new(WarningHook)
+	c.Meta.extraHooks = []terraform.Hook{countHook, stateHook, warningHook}
 
 	if !c.Destroy && maybeInit {
 		// Do a detect to determine if we need to do an init + apply.
@@ -96,11 +129,15 @@ func (c *ApplyCommand) Run(args []string) int {
 	}
 
 	// Build the context based on the arguments given
+	defer c.Meta.unlockState()
 	ctx, planned, err := c.Context(contextOpts{
 		Destroy:     c.Destroy,
 		Path:        configPath,
 		StatePath:   c.Meta.statePath,
 		Parallelism: c.Meta.parallelism,
+		Deadline:    deadline,
+		RefreshData: refreshData,
+		Operation:   cmdName,
 	})
 	if err != nil {
 		c.Ui.Error(err.Error())
@@ -181,45 +218,104 @@ func (c *ApplyCommand) Run(args []string) int {
 		stateHook.State = state
 	}
 
-	// Start the apply in a goroutine so that we can be interrupted.
+	// Split the plan into stages if we were asked to. Each stage is
+	// confirmed and applied in turn, otherwise the plan is applied at once
+	// as a single unnamed stage.
+	stages := []*terraform.PlanStage{nil}
+	if stageBy != "" {
+		stages, err = ctx.Stages(stageBy)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error splitting plan into stages: %s", err))
+			return 1
+		}
+		if len(stages) == 0 {
+			stages = []*terraform.PlanStage{nil}
+		}
+	}
+
 	var state *terraform.State
 	var applyErr error
-	doneCh := make(chan struct{})
-	go func() {
-		defer close(doneCh)
-		state, applyErr = ctx.Apply()
-	}()
-
-	// Wait for the apply to finish or for us to be interrupted so
-	// we can handle it properly.
-	err = nil
-	select {
-	case <-c.ShutdownCh:
-		c.Ui.Output("Interrupt received. Gracefully shutting down...")
-
-		// Stop execution
-		go ctx.Stop()
-
-		// Still get the result, since there is still one
+	for i, stage := range stages {
+		if stage != nil {
+			ok, err := c.confirmStage(stage, i, len(stages))
+			if err != nil {
+				c.Ui.Error(fmt.Sprintf("Error asking for confirmation: %s", err))
+				return 1
+			}
+			if !ok {
+				if i == 0 {
+					c.Ui.Output("Apply cancelled.")
+					return 1
+				}
+
+				// Earlier stages were applied, so the saved plan no longer
+				// matches the state.
+				c.Ui.Output(fmt.Sprintf(
+					"Apply stopped before stage %d of %d. Create a new plan to\n"+
+						"apply the remaining changes.", i+1, len(stages)))
+				c.notify(c.applyNotification(cmdName, configPath, fmt.Errorf(
+					"apply stopped before stage %d of %d (%s)",
+					i+1, len(stages), stage.Name), start, countHook))
+				return 1
+			}
+		}
+
+		// Start the apply in a goroutine so that we can be interrupted.
+		doneCh := make(chan struct{})
+		go func() {
+			defer close(doneCh)
+			if stage == nil {
+				state, applyErr = ctx.Apply()
+			} else {
+				state, applyErr = ctx.ApplyStage(stage)
+			}
+		}()
+
+		// Wait for the apply to finish or for us to be interrupted so
+		// we can handle it properly.
+		interrupted := false
 		select {
 		case <-c.ShutdownCh:
-			c.Ui.Error(
-				"Two interrupts received. Exiting immediately. Note that data\n" +
-					"loss may have occurred.")
-			return 1
+			c.Ui.Output("Interrupt received. Gracefully shutting down...")
+			interrupted = true
+
+			// Stop execution
+			go ctx.Stop()
+
+			// Still get the result, since there is still one
+			select {
+			case <-c.ShutdownCh:
+				c.Ui.Error(
+					"Two interrupts received. Exiting immediately. Note that data\n" +
+						"loss may have occurred.")
+				return 1
+			case <-doneCh:
+			}
 		case <-doneCh:
 		}
-	case <-doneCh:
-	}
 
-	// Persist the state
-	if state != nil {
-		if err := c.Meta.PersistState(state); err != nil {
-			c.Ui.Error(fmt.Sprintf("Failed to save state: %s", err))
-			return 1
+		// Persist the state
+		if state != nil {
+			if err := c.Meta.PersistState(state); err != nil {
+				c.Ui.Error(fmt.Sprintf("Failed to save state: %s", err))
+				c.notify(c.applyNotification(
+					cmdName, configPath, multierror.Append(applyErr, err), start, countHook))
+				return 1
+			}
+		}
+
+		if applyErr == nil && interrupted && i < len(stages)-1 {
+			applyErr = fmt.Errorf(
+				"Interrupted after stage %d of %d, the remaining stages were not applied.",
+				i+1, len(stages))
+		}
+		if applyErr != nil || interrupted {
+			break
 		}
 	}
 
+	outputProviderWarnings(c.Ui, warningHook)
+
 	if applyErr != nil {
 		c.Ui.Error(fmt.Sprintf(
 			"Error applying plan:\n\n"+
@@ -229,6 +325,7 @@ func (c *ApplyCommand) Run(args []string) int {
 				"any resources that successfully completed. Please address the error\n"+
 				"above and apply again to incrementally change your infrastructure.",
 			multierror.Flatten(applyErr)))
+		c.notify(c.applyNotification(cmdName, configPath, applyErr, start, countHook))
 		return 1
 	}
 
@@ -263,9 +360,50 @@ func (c *ApplyCommand) Run(args []string) int {
 		}
 	}
 
+	c.notify(c.applyNotification(cmdName, configPath, nil, start, countHook))
+
 	return 0
 }
 
+// applyNotification returns the notification for an apply or destroy
+// with the counts of the resources that were changed.
+func (c *ApplyCommand) applyNotification(
+	operation, path string, err error, start time.Time, h *CountHook) *Notification {
+	h.Lock()
+	defer h.Unlock()
+
+	n := c.newNotification(operation, path, err, start)
+	n.Added = h.Added
+	n.Changed = h.Changed
+	n.Destroyed = h.Removed
+	return n
+}
+
+// confirmStage lists the resources changed by a stage and asks whether
+// to apply it.
+func (c *ApplyCommand) confirmStage(
+	stage *terraform.PlanStage, i, n int) (bool, error) {
+	var desc bytes.Buffer
+	desc.WriteString("Terraform will change the following resources:\n")
+	for _, r := range stage.Resources {
+		desc.WriteString("\t")
+		desc.WriteString(r)
+		desc.WriteString("\n")
+	}
+	desc.WriteString("Only 'yes' will be accepted to confirm.")
+
+	v, err := c.UIInput().Input(&terraform.InputOpts{
+		Id:          fmt.Sprintf("stage-%d", i),
+		Query:       fmt.Sprintf("Apply stage %d of %d (%s)?", i+1, n, stage.Name),
+		Description: desc.String(),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return v == "yes", nil
+}
+
 func (c *ApplyCommand) Help() string {
 	if c.Destroy {
 		return c.helpDestroy()
@@ -306,8 +444,17 @@ Options:
                          modifying. Defaults to the "-state-out" path with
                          ".backup" extension. Set to "-" to disable backup.
 
+  -deadline=duration     Maximum time the apply may take, such as "45m". Once
+                         it passes, operations in progress are abandoned and
+                         no new ones are started. Defaults to no limit.
+
   -input=true            Ask for input for variables if not directly set.
 
+  -lock=true             Lock the state file when locking is supported.
+
+  -lock-timeout=0s       Duration to wait for a state lock held by another
+                         operation, such as "5m". Defaults to failing at once.
+
   -no-color              If specified, output won't contain any color.
 
   -parallelism=n         Limit the number of concurrent operations.
@@ -316,6 +463,15 @@ Options:
   -refresh=true          Update state prior to checking for differences. This
                          has no effect if a plan file is given to apply.
 
+  -refresh-data          Read every data source during refresh. By default a
+                         data source is read again only if its arguments or
+                         its provider's configuration changed.
+
+  -stage-by=mode         Apply the plan in stages, asking to confirm each one.
+                         "depth" groups resources by how many changed
+                         resources they depend on, "module" applies each
+                         module on its own after the modules it depends on.
+
   -state=path            Path to read and save state (unless state-out
                          is specified). Defaults to "terraform.tfstate".
 
@@ -327,6 +483,10 @@ Options:
                          resource and its dependencies. This flag can be used
                          multiple times.
 
+  -target-selector=sel   Target every resource in the state matching a selector,
+                         e.g. 'aws_instance.*[tags.Team="payments"]'. This flag
+                         can be used multiple times.
+
   -var 'foo=bar'         Set a variable in the Terraform configuration. This
                          flag can be set multiple times.
 
@@ -351,8 +511,17 @@ Options:
                          modifying. Defaults to the "-state-out" path with
                          ".backup" extension. Set to "-" to disable backup.
 
+  -deadline=duration     Maximum time the destroy may take, such as "45m". Once
+                         it passes, operations in progress are abandoned and
+                         no new ones are started. Defaults to no limit.
+
   -force                 Don't ask for input for destroy confirmation.
 
+  -lock=true             Lock the state file when locking is supported.
+
+  -lock-timeout=0s       Duration to wait for a state lock held by another
+                         operation, such as "5m". Defaults to failing at once.
+
   -no-color              If specified, output won't contain any color.
 
   -parallelism=n         Limit the number of concurrent operations.
@@ -361,6 +530,10 @@ Options:
   -refresh=true          Update state prior to checking for differences. This
                          has no effect if a plan file is given to apply.
 
+  -refresh-data          Read every data source during refresh. By default a
+                         data source is read again only if its arguments or
+                         its provider's configuration changed.
+
   -state=path            Path to read and save state (unless state-out
                          is specified). Defaults to "terraform.tfstate".
 
@@ -372,6 +545,10 @@ Options:
                          resource and its dependencies. This flag can be used
                          multiple times.
 
+  -target-selector=sel   Target every resource in the state matching a selector,
+                         e.g. 'aws_instance.*[tags.Team="payments"]'. This flag
+                         can be used multiple times.
+
   -var 'foo=bar'         Set a variable in the Terraform configuration. This
                          flag can be set multiple times.
 
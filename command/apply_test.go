@@ -2,7 +2,9 @@ package command
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
@@ -10,12 +12,15 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform/helper/metrics"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/mitchellh/cli"
 )
@@ -188,6 +193,54 @@ func TestApply_defaultState(t *testing.T) {
 	}
 }
 
+func TestApply_deadline(t *testing.T) {
+	statePath := testTempFile(t)
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &ApplyCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	p.ApplyFn = func(
+		*terraform.InstanceInfo,
+		*terraform.InstanceState,
+		*terraform.InstanceDiff) (*terraform.InstanceState, error) {
+		<-doneCh
+		return &terraform.InstanceState{ID: "foo"}, nil
+	}
+	p.DiffFn = func(
+		*terraform.InstanceInfo,
+		*terraform.InstanceState,
+		*terraform.ResourceConfig) (*terraform.InstanceDiff, error) {
+		return &terraform.InstanceDiff{
+			Attributes: map[string]*terraform.ResourceAttrDiff{
+				"ami": &terraform.ResourceAttrDiff{
+					New: "bar",
+				},
+			},
+		}, nil
+	}
+
+	args := []string{
+		"-state", statePath,
+		"-deadline", "10ms",
+		testFixturePath("apply"),
+	}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+
+	if !strings.Contains(ui.ErrorWriter.String(), "Deadline of 10ms exceeded") {
+		t.Fatalf("bad: %s", ui.ErrorWriter.String())
+	}
+}
+
 func TestApply_error(t *testing.T) {
 	statePath := testTempFile(t)
 
@@ -676,6 +729,205 @@ func TestApply_refresh(t *testing.T) {
 	}
 }
 
+func TestApply_stages(t *testing.T) {
+	cases := []struct {
+		Answers  []string
+		Code     int
+		Resource []string
+	}{
+		{
+			[]string{"yes", "yes"},
+			0,
+			[]string{"test_instance.bar", "test_instance.foo"},
+		},
+		{
+			[]string{"yes", "no"},
+			1,
+			[]string{"test_instance.foo"},
+		},
+		{
+			[]string{"no"},
+			1,
+			nil,
+		},
+	}
+
+	for i, tc := range cases {
+		statePath := testTempFile(t)
+
+		var answers []string
+		for _, a := range tc.Answers {
+			answers = append(answers, a+"\n")
+		}
+		defaultInputReader = &testLineReader{Lines: answers}
+		defaultInputWriter = new(bytes.Buffer)
+
+		p := testProvider()
+		p.DiffFn = func(
+			*terraform.InstanceInfo,
+			*terraform.InstanceState,
+			*terraform.ResourceConfig) (*terraform.InstanceDiff, error) {
+			return &terraform.InstanceDiff{
+				Attributes: map[string]*terraform.ResourceAttrDiff{
+					"ami": &terraform.ResourceAttrDiff{New: "bar"},
+				},
+			}, nil
+		}
+		p.ApplyFn = func(
+			info *terraform.InstanceInfo,
+			s *terraform.InstanceState,
+			d *terraform.InstanceDiff) (*terraform.InstanceState, error) {
+			return &terraform.InstanceState{ID: info.Id}, nil
+		}
+
+		ui := new(cli.MockUi)
+		c := &ApplyCommand{
+			Meta: Meta{
+				ContextOpts: testCtxConfig(p),
+				Ui:          ui,
+			},
+		}
+
+		args := []string{
+			"-state", statePath,
+			"-stage-by", "depth",
+			testFixturePath("apply-stages"),
+		}
+		if code := c.Run(args); code != tc.Code {
+			t.Fatalf("%d: bad: %d\n\n%s", i, code, ui.ErrorWriter.String())
+		}
+
+		prompts := defaultInputWriter.(*bytes.Buffer).String()
+		if !strings.Contains(prompts, "Apply stage 1 of 2 (depth 0)?") {
+			t.Fatalf("%d: bad: %s", i, prompts)
+		}
+
+		var resources []string
+		if f, err := os.Open(statePath); err == nil {
+			state, err := terraform.ReadState(f)
+			f.Close()
+			if err != nil {
+				t.Fatalf("%d: err: %s", i, err)
+			}
+			for k := range state.RootModule().Resources {
+				resources = append(resources, k)
+			}
+			sort.Strings(resources)
+		}
+		if !reflect.DeepEqual(resources, tc.Resource) {
+			t.Fatalf("%d: expected %v, got %v", i, tc.Resource, resources)
+		}
+	}
+
+	defaultInputReader = nil
+	defaultInputWriter = nil
+}
+
+func TestApply_stagesNoInput(t *testing.T) {
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &ApplyCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-input=false",
+		"-stage-by", "module",
+		testFixturePath("apply-stages"),
+	}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: %d", code)
+	}
+	if !strings.Contains(ui.ErrorWriter.String(), "-input=false") {
+		t.Fatalf("bad: %s", ui.ErrorWriter.String())
+	}
+}
+
+func TestApply_mockProviders(t *testing.T) {
+	os.Setenv(schema.MockEnvVar, "1")
+	defer os.Unsetenv(schema.MockEnvVar)
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &ApplyCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-state", testTempFile(t),
+		testFixturePath("apply"),
+	}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: %d", code)
+	}
+	if p.ApplyCalled {
+		t.Fatal("apply should not be called")
+	}
+}
+
+func TestApply_metrics(t *testing.T) {
+	metricsPath := testTempFile(t)
+	os.Setenv(MetricsPathEnvVar, metricsPath)
+	defer os.Unsetenv(MetricsPathEnvVar)
+
+	// The provider reports its API calls like a provider plugin would.
+	p := testProvider()
+	p.ApplyFn = func(
+		info *terraform.InstanceInfo,
+		s *terraform.InstanceState,
+		d *terraform.InstanceDiff) (*terraform.InstanceState, error) {
+		metrics.RecordAPICall("test", "CreateInstance", metrics.Counts{Calls: 1, Retries: 1})
+		metrics.Flush()
+		return &terraform.InstanceState{ID: "foo"}, nil
+	}
+
+	ui := new(cli.MockUi)
+	c := &ApplyCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-state", testTempFile(t),
+		testFixturePath("apply"),
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	if v := os.Getenv(metrics.DirEnvVar); v != "" {
+		t.Fatalf("%s should be unset after the run, got %q", metrics.DirEnvVar, v)
+	}
+
+	raw, err := ioutil.ReadFile(metricsPath)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	var report metricsReport
+	if err := json.Unmarshal(raw, &report); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if report.Command != "apply" {
+		t.Fatalf("bad command: %q", report.Command)
+	}
+	if len(report.Resources) != 1 || report.Resources[0].Address != "test_instance.foo" {
+		t.Fatalf("bad resources: %s", raw)
+	}
+	expected := metrics.Counts{Calls: 1, Retries: 1}
+	if p, ok := report.Providers["test"]; !ok || p.Counts != expected {
+		t.Fatalf("bad providers: %s", raw)
+	}
+}
+
 func TestApply_shutdown(t *testing.T) {
 	stopped := false
 	stopCh := make(chan struct{})
@@ -1432,3 +1684,19 @@ const testApplyStateDiffStr = `
 ID = bar
 Tainted = false
 `
+
+// testLineReader is an io.Reader that returns one line per Read, since
+// UIInput wraps its reader in a new bufio.Reader for every question.
+type testLineReader struct {
+	Lines []string
+}
+
+func (r *testLineReader) Read(p []byte) (int, error) {
+	if len(r.Lines) == 0 {
+		return 0, io.EOF
+	}
+
+	n := copy(p, r.Lines[0])
+	r.Lines = r.Lines[1:]
+	return n, nil
+}
@@ -17,6 +17,18 @@ const DefaultDataDir = ".terraform"
 // DefaultStateFilename is the default filename used for the state file.
 const DefaultStateFilename = "terraform.tfstate"
 
+// DefaultEnvName is the name of the environment that is used when no
+// other environment was selected.
+const DefaultEnvName = "default"
+
+// DefaultEnvDir is the directory, relative to the state file or remote
+// state cache, where the states of non-default environments are stored.
+const DefaultEnvDir = "terraform.tfstate.d"
+
+// DefaultEnvFile is the file within the data directory that holds the
+// name of the currently selected environment.
+const DefaultEnvFile = "environment"
+
 // DefaultVarsFilename is the default filename used for vars
 const DefaultVarsFilename = "terraform.tfvars"
 
@@ -27,6 +39,20 @@ const DefaultBackupExtension = ".backup"
 // operations as it walks the dependency graph.
 const DefaultParallelism = 10
 
+// outputProviderWarnings shows the warnings that providers returned with
+// their diffs. Unlike validation warnings, they're shown after the plan.
+func outputProviderWarnings(ui cli.Ui, h *WarningHook) {
+	ws := h.Warnings()
+	if len(ws) == 0 {
+		return
+	}
+
+	ui.Warn("\nWarnings from providers:\n")
+	for _, w := range ws {
+		ui.Warn(fmt.Sprintf("  * %s", w))
+	}
+}
+
 func validateContext(ctx *terraform.Context, ui cli.Ui) bool {
 	log.Println("[INFO] Validating the context...")
 	ws, es := ctx.Validate()
@@ -0,0 +1,196 @@
+package command
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/repl"
+	"github.com/mattn/go-isatty"
+)
+
+// ConsoleCommand is a Command implementation that starts an interactive
+// console that can be used to try expressions with the current config.
+type ConsoleCommand struct {
+	Meta
+
+	// When this channel receives a value, the console exits.
+	ShutdownCh <-chan struct{}
+}
+
+func (c *ConsoleCommand) Run(args []string) int {
+	args = c.Meta.process(args, true)
+	cmdFlags := c.Meta.flagSet("console")
+	cmdFlags.StringVar(&c.Meta.statePath, "state", DefaultStateFilename, "path")
+	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+
+	pwd, err := os.Getwd()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error getting pwd: %s", err))
+		return 1
+	}
+
+	var configPath string
+	args = cmdFlags.Args()
+	if len(args) > 1 {
+		c.Ui.Error("The console command expects at most one argument.")
+		cmdFlags.Usage()
+		return 1
+	} else if len(args) == 1 {
+		configPath = args[0]
+	} else {
+		configPath = pwd
+	}
+
+	// The console is useful for trying out functions even without any
+	// configuration, so an empty directory gets an empty module.
+	if empty, err := config.IsEmptyDir(configPath); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error checking configuration path: %s", err))
+		return 1
+	} else if empty {
+		configPath = ""
+	}
+
+	// Build the context based on the arguments given
+	ctx, _, err := c.Context(contextOpts{
+		Path:      configPath,
+		StatePath: c.Meta.statePath,
+	})
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+
+	session := &repl.Session{
+		Interpolater: ctx.Interpolater(),
+	}
+
+	var r io.Reader = os.Stdin
+	if defaultInputReader != nil {
+		r = defaultInputReader
+	}
+
+	// When input isn't a terminal, such as `echo "1 + 5" | terraform
+	// console`, evaluate every line and stop at the first error.
+	if r != os.Stdin || !isatty.IsTerminal(os.Stdin.Fd()) {
+		return c.modePiped(session, r)
+	}
+
+	return c.modeInteractive(session, r)
+}
+
+func (c *ConsoleCommand) modePiped(session *repl.Session, r io.Reader) int {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		result, err := session.Handle(strings.TrimSpace(scanner.Text()))
+		if err == repl.ErrSessionExit {
+			return 0
+		}
+		if err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+
+		if result != "" {
+			c.Ui.Output(result)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error reading input: %s", err))
+		return 1
+	}
+
+	return 0
+}
+
+func (c *ConsoleCommand) modeInteractive(session *repl.Session, r io.Reader) int {
+	// Read lines in the background so that an interrupt can end the
+	// session while we're blocked waiting for input.
+	lineCh := make(chan string)
+	doneCh := make(chan struct{})
+	go func() {
+		defer close(doneCh)
+		scanner := bufio.NewScanner(r)
+		for scanner.Scan() {
+			lineCh <- scanner.Text()
+		}
+	}()
+
+	for {
+		fmt.Fprint(os.Stdout, "> ")
+
+		var line string
+		select {
+		case line = <-lineCh:
+		case <-doneCh:
+			// Control-D: end the line we were prompting on.
+			fmt.Fprintln(os.Stdout)
+			return 0
+		case <-c.ShutdownCh:
+			fmt.Fprintln(os.Stdout)
+			return 0
+		}
+
+		result, err := session.Handle(line)
+		if err == repl.ErrSessionExit {
+			return 0
+		}
+		if err != nil {
+			c.Ui.Error(err.Error())
+			continue
+		}
+
+		if result != "" {
+			c.Ui.Output(result)
+		}
+	}
+}
+
+func (c *ConsoleCommand) Help() string {
+	helpText := `
+Usage: terraform console [options] [DIR]
+
+  Starts an interactive console for experimenting with Terraform
+  interpolations.
+
+  This will open an interactive console that you can use to type
+  interpolations into and inspect their values. This command loads the
+  current state. This lets you explore and test interpolations before
+  using them in future configurations.
+
+  This command will never modify your state.
+
+  DIR can be set to a directory with a Terraform state to load. By
+  default, this will default to the current working directory.
+
+  Input can also be piped in, in which case every line is evaluated
+  and the command exits with an error at the first failure:
+
+      echo "cidrsubnet(var.vpc_cidr, 8, 3)" | terraform console
+
+Options:
+
+  -state=path         Path to read state. Defaults to "terraform.tfstate"
+
+  -var 'foo=bar'      Set a variable in the Terraform configuration. This
+                      flag can be set multiple times.
+
+  -var-file=foo       Set variables in the Terraform configuration from
+                      a file. If "terraform.tfvars" is present, it will be
+                      automatically loaded if this flag is not specified.
+
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ConsoleCommand) Synopsis() string {
+	return "Interactive console for Terraform interpolations"
+}
@@ -0,0 +1,143 @@
+package command
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mitchellh/cli"
+)
+
+func TestConsole_basic(t *testing.T) {
+	tmp, cwd := testCwd(t)
+	defer testFixCwd(t, tmp, cwd)
+
+	defaultInputReader = bytes.NewBufferString("1 + 5\n")
+	defer func() { defaultInputReader = nil }()
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &ConsoleCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	if code := c.Run(nil); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	actual := strings.TrimSpace(ui.OutputWriter.String())
+	if actual != "6" {
+		t.Fatalf("bad: %q", actual)
+	}
+}
+
+func TestConsole_variables(t *testing.T) {
+	defaultInputReader = bytes.NewBufferString(
+		"var.foo\ncidrsubnet(var.vpc_cidr, 8, 3)\n")
+	defer func() { defaultInputReader = nil }()
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &ConsoleCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-var", "foo=bar",
+		testFixturePath("console"),
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	actual := strings.TrimSpace(ui.OutputWriter.String())
+	expected := "bar\n10.0.3.0/24"
+	if actual != expected {
+		t.Fatalf("bad: %q", actual)
+	}
+}
+
+func TestConsole_state(t *testing.T) {
+	state := &terraform.State{
+		Modules: []*terraform.ModuleState{
+			&terraform.ModuleState{
+				Path: []string{"root"},
+				Resources: map[string]*terraform.ResourceState{
+					"test_instance.foo": &terraform.ResourceState{
+						Type: "test_instance",
+						Primary: &terraform.InstanceState{
+							ID: "bar",
+							Attributes: map[string]string{
+								"id": "bar",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	statePath := testStateFile(t, state)
+
+	defaultInputReader = bytes.NewBufferString("test_instance.foo.id\n")
+	defer func() { defaultInputReader = nil }()
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &ConsoleCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+		"-var", "foo=bar",
+		testFixturePath("console"),
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	actual := strings.TrimSpace(ui.OutputWriter.String())
+	if actual != "bar" {
+		t.Fatalf("bad: %q", actual)
+	}
+}
+
+func TestConsole_error(t *testing.T) {
+	tmp, cwd := testCwd(t)
+	defer testFixCwd(t, tmp, cwd)
+
+	defaultInputReader = bytes.NewBufferString("nope(\"foo\")\n1 + 5\n")
+	defer func() { defaultInputReader = nil }()
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &ConsoleCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	if code := c.Run(nil); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+
+	if !strings.Contains(ui.ErrorWriter.String(), "unknown function") {
+		t.Fatalf("bad: %s", ui.ErrorWriter.String())
+	}
+
+	// Evaluation stops at the first error
+	if out := ui.OutputWriter.String(); out != "" {
+		t.Fatalf("bad: %q", out)
+	}
+}
@@ -0,0 +1,122 @@
+package command
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/mitchellh/cli"
+)
+
+// EnvCommand is a Command implementation that just shows help for
+// the subcommands nested below it.
+type EnvCommand struct {
+	Meta
+}
+
+func (c *EnvCommand) Run(args []string) int {
+	return cli.RunResultHelp
+}
+
+func (c *EnvCommand) Help() string {
+	helpText := `
+Usage: terraform env <subcommand> [options] [args]
+
+  This command has subcommands for managing state environments.
+
+  Environments allow a single configuration to have multiple named
+  states, for example one for each of "dev", "staging" and "prod".
+  Every configuration starts out in the "default" environment. The name
+  of the current environment is available in the configuration as
+  "${terraform.env}".
+
+  Environments are supported for local state and for the "azure",
+  "consul", "etcd", "gcs", "local" and "s3" remote state backends.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *EnvCommand) Synopsis() string {
+	return "Environment management"
+}
+
+// validEnvName matches the names that are allowed for environments. Names
+// are used as part of paths and remote state keys, so they are limited to
+// a safe set of characters.
+var validEnvName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`)
+
+// envStateDir returns the directory holding the states, or the remote
+// state caches, of the non-default environments.
+func (m *Meta) envStateDir() string {
+	remotePath := filepath.Join(m.DataDir(), DefaultStateFilename)
+	if _, err := os.Stat(remotePath); err == nil {
+		return filepath.Join(m.DataDir(), DefaultEnvDir)
+	}
+
+	return DefaultEnvDir
+}
+
+// envList returns the sorted names of all known environments, including
+// the default environment.
+func (m *Meta) envList() ([]string, error) {
+	envs := []string{DefaultEnvName}
+
+	entries, err := ioutil.ReadDir(m.envStateDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return envs, nil
+		}
+
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() && entry.Name() != DefaultEnvName {
+			envs = append(envs, entry.Name())
+		}
+	}
+
+	sort.Strings(envs[1:])
+	return envs, nil
+}
+
+// envExists returns true if the named environment exists.
+func (m *Meta) envExists(env string) (bool, error) {
+	envs, err := m.envList()
+	if err != nil {
+		return false, err
+	}
+
+	for _, e := range envs {
+		if e == env {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// envArg validates the single environment name given as an argument.
+func envArg(args []string) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf("Expected a single argument: NAME.")
+	}
+
+	env := args[0]
+	if !validEnvName.MatchString(env) {
+		return "", fmt.Errorf(
+			"Invalid environment name %q. Names must start with a letter or\n"+
+				"digit and may only contain letters, digits, '-' and '_'.", env)
+	}
+
+	return env, nil
+}
+
+const errEnvNotFound = `Environment %q doesn't exist!
+
+You can create this environment with the "terraform env new" command.`
@@ -0,0 +1,255 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mitchellh/cli"
+)
+
+func TestEnv_createAndList(t *testing.T) {
+	tmp, cwd := testCwd(t)
+	defer testFixCwd(t, tmp, cwd)
+
+	for _, env := range []string{"test_a", "test_b", "test_c"} {
+		ui := new(cli.MockUi)
+		c := &EnvNewCommand{Meta: Meta{Ui: ui}}
+		if code := c.Run([]string{env}); code != 0 {
+			t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+		}
+	}
+
+	ui := new(cli.MockUi)
+	c := &EnvListCommand{Meta: Meta{Ui: ui}}
+	if code := c.Run(nil); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	actual := strings.TrimSpace(ui.OutputWriter.String())
+	expected := "default\n  test_a\n  test_b\n* test_c"
+	if actual != expected {
+		t.Fatalf("\nexpected: %q\nactual:  %q", expected, actual)
+	}
+}
+
+func TestEnv_createExisting(t *testing.T) {
+	tmp, cwd := testCwd(t)
+	defer testFixCwd(t, tmp, cwd)
+
+	for _, env := range []string{"test", "test", DefaultEnvName} {
+		ui := new(cli.MockUi)
+		c := &EnvNewCommand{Meta: Meta{Ui: ui}}
+		code := c.Run([]string{env})
+		if env == "test" && code == 0 {
+			continue
+		}
+		if code != 1 {
+			t.Fatalf("%s: bad: %d\n\n%s", env, code, ui.OutputWriter.String())
+		}
+	}
+}
+
+func TestEnv_createInvalid(t *testing.T) {
+	tmp, cwd := testCwd(t)
+	defer testFixCwd(t, tmp, cwd)
+
+	for _, env := range []string{"", "../foo", "foo/bar", "-foo"} {
+		ui := new(cli.MockUi)
+		c := &EnvNewCommand{Meta: Meta{Ui: ui}}
+		if code := c.Run([]string{"--", env}); code == 0 {
+			t.Fatalf("%q: should fail", env)
+		}
+	}
+}
+
+func TestEnv_select(t *testing.T) {
+	tmp, cwd := testCwd(t)
+	defer testFixCwd(t, tmp, cwd)
+
+	ui := new(cli.MockUi)
+	newCmd := &EnvNewCommand{Meta: Meta{Ui: ui}}
+	if code := newCmd.Run([]string{"test"}); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	ui = new(cli.MockUi)
+	selectCmd := &EnvSelectCommand{Meta: Meta{Ui: ui}}
+	if code := selectCmd.Run([]string{DefaultEnvName}); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+	if env := selectCmd.Env(); env != DefaultEnvName {
+		t.Fatalf("bad env: %s", env)
+	}
+
+	ui = new(cli.MockUi)
+	selectCmd = &EnvSelectCommand{Meta: Meta{Ui: ui}}
+	if code := selectCmd.Run([]string{"test"}); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+	if env := selectCmd.Env(); env != "test" {
+		t.Fatalf("bad env: %s", env)
+	}
+
+	ui = new(cli.MockUi)
+	selectCmd = &EnvSelectCommand{Meta: Meta{Ui: ui}}
+	if code := selectCmd.Run([]string{"nope"}); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+}
+
+func TestEnv_delete(t *testing.T) {
+	tmp, cwd := testCwd(t)
+	defer testFixCwd(t, tmp, cwd)
+
+	ui := new(cli.MockUi)
+	newCmd := &EnvNewCommand{Meta: Meta{Ui: ui}}
+	if code := newCmd.Run([]string{"test"}); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	// The current environment can't be deleted
+	ui = new(cli.MockUi)
+	delCmd := &EnvDeleteCommand{Meta: Meta{Ui: ui}}
+	if code := delCmd.Run([]string{"test"}); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+
+	if err := delCmd.SetEnv(DefaultEnvName); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	ui = new(cli.MockUi)
+	delCmd = &EnvDeleteCommand{Meta: Meta{Ui: ui}}
+	if code := delCmd.Run([]string{"test"}); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	if _, err := os.Stat(filepath.Join(DefaultEnvDir, "test")); !os.IsNotExist(err) {
+		t.Fatalf("environment should be deleted: %s", err)
+	}
+
+	// The default environment can never be deleted
+	ui = new(cli.MockUi)
+	delCmd = &EnvDeleteCommand{Meta: Meta{Ui: ui}}
+	if code := delCmd.Run([]string{DefaultEnvName}); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+}
+
+func TestEnv_deleteWithState(t *testing.T) {
+	tmp, cwd := testCwd(t)
+	defer testFixCwd(t, tmp, cwd)
+
+	path := filepath.Join(DefaultEnvDir, "test", DefaultStateFilename)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	err = terraform.WriteState(testState(), f)
+	f.Close()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	ui := new(cli.MockUi)
+	delCmd := &EnvDeleteCommand{Meta: Meta{Ui: ui}}
+	if code := delCmd.Run([]string{"test"}); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+
+	ui = new(cli.MockUi)
+	delCmd = &EnvDeleteCommand{Meta: Meta{Ui: ui}}
+	if code := delCmd.Run([]string{"-force", "test"}); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	if _, err := os.Stat(filepath.Join(DefaultEnvDir, "test")); !os.IsNotExist(err) {
+		t.Fatalf("environment should be deleted: %s", err)
+	}
+}
+
+func TestEnv_applyLocal(t *testing.T) {
+	tmp, cwd := testCwd(t)
+	defer testFixCwd(t, tmp, cwd)
+
+	ui := new(cli.MockUi)
+	newCmd := &EnvNewCommand{Meta: Meta{Ui: ui}}
+	if code := newCmd.Run([]string{"test"}); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	p := testProvider()
+	ui = new(cli.MockUi)
+	c := &ApplyCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{testFixturePath("apply-terraform-env")}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	if _, err := os.Stat(DefaultStateFilename); !os.IsNotExist(err) {
+		t.Fatalf("default state should not be written: %s", err)
+	}
+
+	statePath := filepath.Join(DefaultEnvDir, "test", DefaultStateFilename)
+	testStateOutput(t, statePath, strings.TrimSpace(testEnvApplyStateStr))
+}
+
+func TestEnv_applyRemote(t *testing.T) {
+	tmp, cwd := testCwd(t)
+	defer testFixCwd(t, tmp, cwd)
+
+	remotePath := filepath.Join(tmp, "remote.tfstate")
+	s := terraform.NewState()
+	s.Remote = &terraform.RemoteState{
+		Type:   "local",
+		Config: map[string]string{"path": remotePath},
+	}
+	testStateFileRemote(t, s)
+
+	ui := new(cli.MockUi)
+	newCmd := &EnvNewCommand{Meta: Meta{Ui: ui}}
+	if code := newCmd.Run([]string{"test"}); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	p := testProvider()
+	ui = new(cli.MockUi)
+	c := &ApplyCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{testFixturePath("apply-terraform-env")}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	if _, err := os.Stat(remotePath); !os.IsNotExist(err) {
+		t.Fatalf("default remote state should not be written: %s", err)
+	}
+
+	envRemotePath := filepath.Join(
+		remotePath+".d", "test", filepath.Base(remotePath))
+	testStateOutput(t, envRemotePath, strings.TrimSpace(testEnvApplyStateStr))
+}
+
+const testEnvApplyStateStr = `
+<no state>
+Outputs:
+
+output = test
+`
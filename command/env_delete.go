@@ -0,0 +1,140 @@
+package command
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform/state/remote"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mitchellh/cli"
+)
+
+// EnvDeleteCommand is a Command implementation that deletes an environment
+// along with its state.
+type EnvDeleteCommand struct {
+	Meta
+}
+
+func (c *EnvDeleteCommand) Run(args []string) int {
+	args = c.Meta.process(args, true)
+
+	var force bool
+	cmdFlags := c.Meta.flagSet("env delete")
+	cmdFlags.BoolVar(&force, "force", false, "force")
+	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+
+	env, err := envArg(cmdFlags.Args())
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return cli.RunResultHelp
+	}
+
+	if env == DefaultEnvName {
+		c.Ui.Error(fmt.Sprintf("The %q environment can't be deleted.", DefaultEnvName))
+		return 1
+	}
+
+	if env == c.Env() {
+		c.Ui.Error(fmt.Sprintf(
+			"Environment %q is the current environment and can't be deleted.\n"+
+				"Select a different environment with \"terraform env select\" first.", env))
+		return 1
+	}
+
+	exists, err := c.envExists(env)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error listing environments: %s", err))
+		return 1
+	}
+	if !exists {
+		c.Ui.Error(fmt.Sprintf(errEnvNotFound, env))
+		return 1
+	}
+
+	// Load the state of the environment being deleted rather than the
+	// current one, so we can check whether it still manages resources.
+	opts := c.StateOpts()
+	opts.LocalPath = envStatePath(DefaultStateFilename, env)
+	opts.LocalPathOut = ""
+	opts.Env = env
+	result, err := State(opts)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error loading state: %s", err))
+		return 1
+	}
+
+	if result.State != nil && !force {
+		if stateHasResources(result.State.State()) {
+			c.Ui.Error(fmt.Sprintf(strings.TrimSpace(errEnvNotEmpty), env))
+			return 1
+		}
+	}
+
+	if result.Remote != nil {
+		if durable, ok := result.Remote.Durable.(*remote.State); ok {
+			if err := durable.Client.Delete(); err != nil {
+				c.Ui.Error(fmt.Sprintf("Error deleting remote state: %s", err))
+				return 1
+			}
+		}
+	}
+
+	if err := os.RemoveAll(filepath.Join(c.envStateDir(), env)); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error deleting environment: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(c.Colorize().Color(fmt.Sprintf(
+		"[reset][green]Deleted environment %q!", env)))
+	return 0
+}
+
+func (c *EnvDeleteCommand) Help() string {
+	helpText := `
+Usage: terraform env delete [OPTIONS] NAME
+
+  Delete an environment along with its state. The current environment
+  and the "default" environment can't be deleted.
+
+Options:
+
+  -force    Delete the environment even if its state still manages
+            resources. The resources are not destroyed and Terraform
+            will no longer track them.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *EnvDeleteCommand) Synopsis() string {
+	return "Delete an environment"
+}
+
+// stateHasResources returns true if any module of the state has resources.
+func stateHasResources(s *terraform.State) bool {
+	if s == nil {
+		return false
+	}
+
+	for _, m := range s.Modules {
+		if len(m.Resources) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+const errEnvNotEmpty = `
+Environment %q is not empty!
+
+Deleting it would lose track of the resources it manages, so they
+would have to be removed manually. Destroy the resources with
+"terraform destroy" first, or delete the environment anyway with
+the -force flag.
+`
@@ -0,0 +1,59 @@
+package command
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// EnvListCommand is a Command implementation that lists the available
+// environments.
+type EnvListCommand struct {
+	Meta
+}
+
+func (c *EnvListCommand) Run(args []string) int {
+	args = c.Meta.process(args, true)
+
+	cmdFlags := c.Meta.flagSet("env list")
+	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+
+	envs, err := c.envList()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error listing environments: %s", err))
+		return 1
+	}
+
+	current := c.Env()
+
+	var out bytes.Buffer
+	for _, env := range envs {
+		if env == current {
+			out.WriteString("* ")
+		} else {
+			out.WriteString("  ")
+		}
+		out.WriteString(env + "\n")
+	}
+
+	c.Ui.Output(out.String())
+	return 0
+}
+
+func (c *EnvListCommand) Help() string {
+	helpText := `
+Usage: terraform env list
+
+  List the available environments. The current environment is marked
+  with an asterisk.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *EnvListCommand) Synopsis() string {
+	return "List environments"
+}
@@ -0,0 +1,76 @@
+package command
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mitchellh/cli"
+)
+
+// EnvNewCommand is a Command implementation that creates a new environment
+// and switches to it.
+type EnvNewCommand struct {
+	Meta
+}
+
+func (c *EnvNewCommand) Run(args []string) int {
+	args = c.Meta.process(args, true)
+
+	cmdFlags := c.Meta.flagSet("env new")
+	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+
+	env, err := envArg(cmdFlags.Args())
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return cli.RunResultHelp
+	}
+
+	exists, err := c.envExists(env)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error listing environments: %s", err))
+		return 1
+	}
+	if exists {
+		c.Ui.Error(fmt.Sprintf("Environment %q already exists!", env))
+		return 1
+	}
+
+	// The environment exists as soon as its state directory does. The
+	// state itself is written by the first operation that modifies it.
+	if err := os.MkdirAll(filepath.Join(c.envStateDir(), env), 0755); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error creating environment: %s", err))
+		return 1
+	}
+
+	if err := c.SetEnv(env); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error selecting environment: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(c.Colorize().Color(fmt.Sprintf(
+		"[reset][green][bold]Created and switched to environment %q!\n\n"+
+			"[reset][green]You're now on a new, empty environment. Environments isolate\n"+
+			"their state, so if you run \"terraform plan\" Terraform will not see\n"+
+			"any existing state for this configuration.", env)))
+	return 0
+}
+
+func (c *EnvNewCommand) Help() string {
+	helpText := `
+Usage: terraform env new NAME
+
+  Create a new environment and switch to it. The new environment starts
+  out with an empty state.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *EnvNewCommand) Synopsis() string {
+	return "Create a new environment"
+}
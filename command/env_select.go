@@ -0,0 +1,69 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mitchellh/cli"
+)
+
+// EnvSelectCommand is a Command implementation that changes the current
+// environment.
+type EnvSelectCommand struct {
+	Meta
+}
+
+func (c *EnvSelectCommand) Run(args []string) int {
+	args = c.Meta.process(args, true)
+
+	cmdFlags := c.Meta.flagSet("env select")
+	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+
+	env, err := envArg(cmdFlags.Args())
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return cli.RunResultHelp
+	}
+
+	if env == c.Env() {
+		c.Ui.Output(fmt.Sprintf("Already on environment %q", env))
+		return 0
+	}
+
+	exists, err := c.envExists(env)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error listing environments: %s", err))
+		return 1
+	}
+	if !exists {
+		c.Ui.Error(fmt.Sprintf(errEnvNotFound, env))
+		return 1
+	}
+
+	if err := c.SetEnv(env); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error selecting environment: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(c.Colorize().Color(fmt.Sprintf(
+		"[reset][green]Switched to environment %q!", env)))
+	return 0
+}
+
+func (c *EnvSelectCommand) Help() string {
+	helpText := `
+Usage: terraform env select NAME
+
+  Change the current environment. All following commands operate on the
+  state of the selected environment.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *EnvSelectCommand) Synopsis() string {
+	return "Select an environment"
+}
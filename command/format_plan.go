@@ -167,6 +167,24 @@ func formatPlanModuleExpand(
 				} else {
 					u = attrDiff.Old
 				}
+
+				// Changes to documents such as policies, container
+				// definitions or user data are much easier to review
+				// as a line diff than as two giant strings.
+				if !attrDiff.Sensitive && !attrDiff.NewComputed {
+					diff, ok := formatStructuredAttrDiff(
+						u, v, "        ", opts.Color)
+					if ok {
+						buf.WriteString(fmt.Sprintf(
+							"    %s:%s <structured diff>%s\n",
+							attrK,
+							strings.Repeat(" ", keyLen-len(attrK)),
+							updateMsg))
+						buf.WriteString(diff)
+						continue
+					}
+				}
+
 				buf.WriteString(fmt.Sprintf(
 					"    %s:%s %#v => %#v%s\n",
 					attrK,
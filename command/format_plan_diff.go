@@ -0,0 +1,220 @@
+package command
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/mitchellh/colorstring"
+)
+
+const (
+	// structuredDiffContext is the number of unchanged lines shown around
+	// each change in a structured attribute diff.
+	structuredDiffContext = 3
+
+	// structuredDiffMaxLines is the largest number of lines on either side
+	// that we'll attempt to diff. Larger values fall back to the normal
+	// single line rendering.
+	structuredDiffMaxLines = 2000
+)
+
+// formatStructuredAttrDiff renders the change of an attribute whose old and
+// new values both contain structured text, such as JSON documents, base64
+// encoded text or heredocs, as a line-level diff. The second return value
+// is false if the values aren't structured, in which case the caller should
+// render the change as usual.
+func formatStructuredAttrDiff(
+	old, new string, indent string, color *colorstring.Colorize) (string, bool) {
+	if old == new {
+		return "", false
+	}
+
+	oldText, ok := structuredText(old)
+	if !ok {
+		return "", false
+	}
+	newText, ok := structuredText(new)
+	if !ok {
+		return "", false
+	}
+
+	oldLines := strings.Split(oldText, "\n")
+	newLines := strings.Split(newText, "\n")
+	if len(oldLines) > structuredDiffMaxLines || len(newLines) > structuredDiffMaxLines {
+		return "", false
+	}
+
+	ops := diffLines(oldLines, newLines)
+
+	// Only show lines that are within the context of a change, eliding
+	// the rest so that large documents stay readable.
+	show := make([]bool, len(ops))
+	for i, op := range ops {
+		if op.Kind == ' ' {
+			continue
+		}
+		for j := i - structuredDiffContext; j <= i+structuredDiffContext; j++ {
+			if j >= 0 && j < len(ops) {
+				show[j] = true
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	elided := false
+	for i, op := range ops {
+		if !show[i] {
+			if !elided {
+				buf.WriteString(fmt.Sprintf("%s  ...\n", indent))
+				elided = true
+			}
+			continue
+		}
+		elided = false
+
+		switch op.Kind {
+		case '+':
+			buf.WriteString(indent + color.Color("[green]+ ") + op.Line)
+		case '-':
+			buf.WriteString(indent + color.Color("[red]- ") + op.Line)
+		default:
+			buf.WriteString(indent + "  " + op.Line)
+		}
+		buf.WriteString(color.Color("[reset]\n"))
+	}
+
+	return buf.String(), true
+}
+
+// structuredText returns the given attribute value as multi-line text
+// suitable for a line diff. JSON documents are re-indented with sorted keys
+// so that formatting and ordering differences don't show up as changes,
+// and base64 encoded text is decoded.
+func structuredText(v string) (string, bool) {
+	if s, ok := jsonText(v); ok {
+		return s, true
+	}
+
+	if decoded, ok := base64Text(v); ok {
+		if s, ok := jsonText(decoded); ok {
+			return s, true
+		}
+		return strings.TrimRight(decoded, "\n"), true
+	}
+
+	if strings.Contains(strings.TrimSpace(v), "\n") {
+		return strings.TrimRight(v, "\n"), true
+	}
+
+	return "", false
+}
+
+// jsonText returns the indented form of v if it is a JSON object or array.
+func jsonText(v string) (string, bool) {
+	trimmed := strings.TrimSpace(v)
+	if !strings.HasPrefix(trimmed, "{") && !strings.HasPrefix(trimmed, "[") {
+		return "", false
+	}
+
+	var raw interface{}
+	if err := json.Unmarshal([]byte(trimmed), &raw); err != nil {
+		return "", false
+	}
+
+	result, err := json.MarshalIndent(raw, "", "  ")
+	if err != nil {
+		return "", false
+	}
+
+	return string(result), true
+}
+
+// base64Text returns the decoded form of v if it is base64 encoded,
+// printable text. Short values are ignored since many ordinary strings
+// happen to be valid base64.
+func base64Text(v string) (string, bool) {
+	if len(v) < 16 || len(v)%4 != 0 {
+		return "", false
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(v)
+	if err != nil || !utf8.Valid(decoded) {
+		return "", false
+	}
+
+	s := string(decoded)
+	for _, r := range s {
+		if !unicode.IsPrint(r) && !unicode.IsSpace(r) {
+			return "", false
+		}
+	}
+
+	// Decoded text that isn't a document or multiple lines is more likely
+	// to be an opaque value that happens to decode cleanly.
+	if !strings.Contains(strings.TrimSpace(s), "\n") {
+		if _, ok := jsonText(s); !ok {
+			return "", false
+		}
+	}
+
+	return s, true
+}
+
+// diffLine is a single line of a line diff. Kind is '+' for added lines,
+// '-' for removed lines and ' ' for unchanged lines.
+type diffLine struct {
+	Kind byte
+	Line string
+}
+
+// diffLines computes a line diff between a and b using the longest common
+// subsequence of lines.
+func diffLines(a, b []string) []diffLine {
+	// lcs[i][j] is the length of the longest common subsequence of
+	// a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	result := make([]diffLine, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			result = append(result, diffLine{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			result = append(result, diffLine{'-', a[i]})
+			i++
+		default:
+			result = append(result, diffLine{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		result = append(result, diffLine{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		result = append(result, diffLine{'+', b[j]})
+	}
+
+	return result
+}
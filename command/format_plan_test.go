@@ -89,3 +89,145 @@ func TestFormatPlan_nestedDataSource(t *testing.T) {
 		t.Fatalf("expected:\n\n%s\n\ngot:\n\n%s", expected, actual)
 	}
 }
+
+// Test that changes to JSON documents are rendered as a line diff
+func TestFormatPlan_structuredDiffJSON(t *testing.T) {
+	plan := &terraform.Plan{
+		Diff: &terraform.Diff{
+			Modules: []*terraform.ModuleDiff{
+				&terraform.ModuleDiff{
+					Path: []string{"root"},
+					Resources: map[string]*terraform.InstanceDiff{
+						"aws_iam_policy.foo": &terraform.InstanceDiff{
+							Attributes: map[string]*terraform.ResourceAttrDiff{
+								"policy": &terraform.ResourceAttrDiff{
+									Old: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject"}]}`,
+									New: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*"}]}`,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	opts := &FormatPlanOpts{
+		Plan: plan,
+		Color: &colorstring.Colorize{
+			Colors:  colorstring.DefaultColors,
+			Disable: true,
+		},
+	}
+
+	actual := FormatPlan(opts)
+
+	expected := strings.TrimSpace(`
+~ aws_iam_policy.foo
+    policy: <structured diff>
+          {
+            "Statement": [
+              {
+        -       "Action": "s3:GetObject",
+        +       "Action": "s3:*",
+                "Effect": "Allow"
+              }
+            ],
+          ...
+	`)
+	if actual != expected {
+		t.Fatalf("expected:\n\n%s\n\ngot:\n\n%s", expected, actual)
+	}
+}
+
+// Test that changes to base64 encoded text are rendered as a line diff
+func TestFormatPlan_structuredDiffBase64(t *testing.T) {
+	plan := &terraform.Plan{
+		Diff: &terraform.Diff{
+			Modules: []*terraform.ModuleDiff{
+				&terraform.ModuleDiff{
+					Path: []string{"root"},
+					Resources: map[string]*terraform.InstanceDiff{
+						"aws_launch_configuration.foo": &terraform.InstanceDiff{
+							Attributes: map[string]*terraform.ResourceAttrDiff{
+								"user_data_base64": &terraform.ResourceAttrDiff{
+									// "#!/bin/bash\necho hello\n"
+									Old: "IyEvYmluL2Jhc2gKZWNobyBoZWxsbwo=",
+									// "#!/bin/bash\necho goodbye\n"
+									New:         "IyEvYmluL2Jhc2gKZWNobyBnb29kYnllCg==",
+									RequiresNew: true,
+								},
+							},
+							Destroy: true,
+						},
+					},
+				},
+			},
+		},
+	}
+	opts := &FormatPlanOpts{
+		Plan: plan,
+		Color: &colorstring.Colorize{
+			Colors:  colorstring.DefaultColors,
+			Disable: true,
+		},
+	}
+
+	actual := FormatPlan(opts)
+
+	expected := strings.TrimSpace(`
+-/+ aws_launch_configuration.foo
+    user_data_base64: <structured diff> (forces new resource)
+          #!/bin/bash
+        - echo hello
+        + echo goodbye
+	`)
+	if actual != expected {
+		t.Fatalf("expected:\n\n%s\n\ngot:\n\n%s", expected, actual)
+	}
+}
+
+// Test that ordinary strings and sensitive values keep the single line format
+func TestFormatPlan_structuredDiffFallback(t *testing.T) {
+	plan := &terraform.Plan{
+		Diff: &terraform.Diff{
+			Modules: []*terraform.ModuleDiff{
+				&terraform.ModuleDiff{
+					Path: []string{"root"},
+					Resources: map[string]*terraform.InstanceDiff{
+						"aws_instance.foo": &terraform.InstanceDiff{
+							Attributes: map[string]*terraform.ResourceAttrDiff{
+								"ami": &terraform.ResourceAttrDiff{
+									Old: "ami-abcd1234",
+									New: "ami-1234abcd",
+								},
+								"secret": &terraform.ResourceAttrDiff{
+									Old:       `{"a":"b"}`,
+									New:       `{"a":"c"}`,
+									Sensitive: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	opts := &FormatPlanOpts{
+		Plan: plan,
+		Color: &colorstring.Colorize{
+			Colors:  colorstring.DefaultColors,
+			Disable: true,
+		},
+	}
+
+	actual := FormatPlan(opts)
+
+	expected := strings.TrimSpace(`
+~ aws_instance.foo
+    ami:    "ami-abcd1234" => "ami-1234abcd"
+    secret: "<sensitive>" => "<sensitive>" (attribute changed)
+	`)
+	if actual != expected {
+		t.Fatalf("expected:\n\n%s\n\ngot:\n\n%s", expected, actual)
+	}
+}
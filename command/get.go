@@ -49,8 +49,9 @@ func (c *GetCommand) Run(args []string) int {
 	}
 
 	_, _, err := c.Context(contextOpts{
-		Path:    path,
-		GetMode: mode,
+		Path:          path,
+		GetMode:       mode,
+		RecordPlugins: true,
 	})
 	if err != nil {
 		c.Ui.Error(fmt.Sprintf("Error loading Terraform: %s", err))
@@ -67,6 +68,10 @@ Usage: terraform get [options] PATH
   Downloads and installs modules needed for the configuration given by
   PATH.
 
+  Also selects the newest installed version of each provider plugin that
+  satisfies the configuration's version constraints, and records the
+  selection so that later commands use exactly the same plugins.
+
   This recursively downloads all modules needed, such as modules
   imported by modules imported by the root and so on. If a module is
   already downloaded, it will not be redownloaded or checked for updates
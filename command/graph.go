@@ -19,6 +19,9 @@ func (c *GraphCommand) Run(args []string) int {
 	var moduleDepth int
 	var verbose bool
 	var drawCycles bool
+	var format string
+	var from string
+	var dependents bool
 
 	args = c.Meta.process(args, false)
 
@@ -26,6 +29,9 @@ func (c *GraphCommand) Run(args []string) int {
 	c.addModuleDepthFlag(cmdFlags, &moduleDepth)
 	cmdFlags.BoolVar(&verbose, "verbose", false, "verbose")
 	cmdFlags.BoolVar(&drawCycles, "draw-cycles", false, "draw-cycles")
+	cmdFlags.StringVar(&format, "format", terraform.GraphFormatDot, "format")
+	cmdFlags.StringVar(&from, "from", "", "from")
+	cmdFlags.BoolVar(&dependents, "dependents", false, "dependents")
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
 	if err := cmdFlags.Parse(args); err != nil {
 		return 1
@@ -67,10 +73,12 @@ func (c *GraphCommand) Run(args []string) int {
 		return 1
 	}
 
-	graphStr, err := terraform.GraphDot(g, &terraform.GraphDotOpts{
+	graphStr, err := terraform.GraphExport(g, format, &terraform.GraphDotOpts{
 		DrawCycles: drawCycles,
 		MaxDepth:   moduleDepth,
 		Verbose:    verbose,
+		From:       from,
+		Dependents: dependents,
 	})
 	if err != nil {
 		c.Ui.Error(fmt.Sprintf("Error converting graph: %s", err))
@@ -89,15 +97,26 @@ Usage: terraform graph [options] [DIR]
   Outputs the visual dependency graph of Terraform resources according to
   configuration files in DIR (or the current directory if omitted).
 
-  The graph is outputted in DOT format. The typical program that can
-  read this format is GraphViz, but many web services are also available
-  to read this format.
+  The graph is outputted in DOT format by default. The typical program that
+  can read this format is GraphViz, but many web services are also available
+  to read this format. It can also be outputted as JSON, for other tools to
+  process, or as a Mermaid flowchart, for documentation.
 
 Options:
 
+  -dependents          With -from, show the nodes that depend on the given
+                       address instead of the nodes it depends on. This shows
+                       what a change to it can affect.
+
   -draw-cycles         Highlight any cycles in the graph with colored edges.
                        This helps when diagnosing cycle errors.
 
+  -format=dot          The output format: "dot", "json" or "mermaid".
+
+  -from=ADDRESS        Only show the nodes reachable from the resource or
+                       module at ADDRESS, such as "aws_instance.web" or
+                       "module.network".
+
   -module-depth=n      The maximum depth to expand modules. By default this is
                        -1, which will expand resources within all modules.
 
@@ -1,6 +1,7 @@
 package command
 
 import (
+	"encoding/json"
 	"os"
 	"strings"
 	"testing"
@@ -103,3 +104,84 @@ func TestGraph_plan(t *testing.T) {
 		t.Fatalf("doesn't look like digraph: %s", output)
 	}
 }
+
+func TestGraph_json(t *testing.T) {
+	ui := new(cli.MockUi)
+	c := &GraphCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-format=json",
+		testFixturePath("graph"),
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: \n%s", ui.ErrorWriter.String())
+	}
+
+	var doc struct {
+		Nodes []map[string]string
+		Edges []map[string]interface{}
+	}
+	if err := json.Unmarshal(ui.OutputWriter.Bytes(), &doc); err != nil {
+		t.Fatalf("err: %s\n\n%s", err, ui.OutputWriter.String())
+	}
+
+	found := false
+	for _, n := range doc.Nodes {
+		if n["address"] == "test_instance.foo" {
+			found = true
+		}
+	}
+	if !found || len(doc.Edges) == 0 {
+		t.Fatalf("bad: %#v", doc)
+	}
+}
+
+func TestGraph_from(t *testing.T) {
+	ui := new(cli.MockUi)
+	c := &GraphCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-format=mermaid",
+		"-from=provider.test",
+		"-dependents",
+		testFixturePath("graph"),
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: \n%s", ui.ErrorWriter.String())
+	}
+
+	output := ui.OutputWriter.String()
+	if !strings.HasPrefix(output, "graph TD\n") ||
+		!strings.Contains(output, `["test_instance.foo"]`) ||
+		strings.Contains(output, "root") {
+		t.Fatalf("bad:\n\n%s", output)
+	}
+}
+
+func TestGraph_badFormat(t *testing.T) {
+	ui := new(cli.MockUi)
+	c := &GraphCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-format=svg",
+		testFixturePath("graph"),
+	}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: \n%s", ui.OutputWriter.String())
+	}
+}
@@ -0,0 +1,175 @@
+package command
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// MetricsHook is a hook that measures how long each resource spends being
+// refreshed, diffed, applied and provisioned, for the run metrics file.
+type MetricsHook struct {
+	resources map[string]*resourceMetrics
+	started   map[metricsHookKey]time.Time
+
+	// now is overridden in tests
+	now func() time.Time
+
+	sync.Mutex
+	terraform.NilHook
+}
+
+type metricsHookKey struct {
+	id        string
+	operation string
+}
+
+// resourceMetrics is the time a resource spent in each operation.
+type resourceMetrics struct {
+	Refresh time.Duration
+	Diff    time.Duration
+	Apply   time.Duration
+
+	// Provision is part of Apply, as a resource is applied until its
+	// provisioners are done.
+	Provision time.Duration
+}
+
+func (m *resourceMetrics) Total() time.Duration {
+	return m.Refresh + m.Diff + m.Apply
+}
+
+func (h *MetricsHook) start(n *terraform.InstanceInfo, operation string) (terraform.HookAction, error) {
+	h.Lock()
+	defer h.Unlock()
+
+	if h.started == nil {
+		h.started = make(map[metricsHookKey]time.Time)
+	}
+	h.started[metricsHookKey{n.HumanId(), operation}] = h.timeNow()
+
+	return terraform.HookActionContinue, nil
+}
+
+func (h *MetricsHook) stop(n *terraform.InstanceInfo, operation string) (terraform.HookAction, error) {
+	h.Lock()
+	defer h.Unlock()
+
+	id := n.HumanId()
+	key := metricsHookKey{id, operation}
+	start, ok := h.started[key]
+	if !ok {
+		return terraform.HookActionContinue, nil
+	}
+	delete(h.started, key)
+
+	if h.resources == nil {
+		h.resources = make(map[string]*resourceMetrics)
+	}
+	r, ok := h.resources[id]
+	if !ok {
+		r = new(resourceMetrics)
+		h.resources[id] = r
+	}
+
+	// A resource is diffed again when a plan is applied, so the times of
+	// an operation are summed.
+	d := h.timeNow().Sub(start)
+	switch operation {
+	case "refresh":
+		r.Refresh += d
+	case "diff":
+		r.Diff += d
+	case "apply":
+		r.Apply += d
+	case "provision":
+		r.Provision += d
+	}
+
+	return terraform.HookActionContinue, nil
+}
+
+func (h *MetricsHook) timeNow() time.Time {
+	if h.now != nil {
+		return h.now()
+	}
+	return time.Now()
+}
+
+func (h *MetricsHook) PreRefresh(
+	n *terraform.InstanceInfo, s *terraform.InstanceState) (terraform.HookAction, error) {
+	return h.start(n, "refresh")
+}
+
+func (h *MetricsHook) PostRefresh(
+	n *terraform.InstanceInfo, s *terraform.InstanceState) (terraform.HookAction, error) {
+	return h.stop(n, "refresh")
+}
+
+func (h *MetricsHook) PreDiff(
+	n *terraform.InstanceInfo, s *terraform.InstanceState) (terraform.HookAction, error) {
+	return h.start(n, "diff")
+}
+
+func (h *MetricsHook) PostDiff(
+	n *terraform.InstanceInfo, d *terraform.InstanceDiff) (terraform.HookAction, error) {
+	return h.stop(n, "diff")
+}
+
+func (h *MetricsHook) PreApply(
+	n *terraform.InstanceInfo,
+	s *terraform.InstanceState,
+	d *terraform.InstanceDiff) (terraform.HookAction, error) {
+	return h.start(n, "apply")
+}
+
+func (h *MetricsHook) PostApply(
+	n *terraform.InstanceInfo,
+	s *terraform.InstanceState,
+	e error) (terraform.HookAction, error) {
+	return h.stop(n, "apply")
+}
+
+func (h *MetricsHook) PreProvisionResource(
+	n *terraform.InstanceInfo, s *terraform.InstanceState) (terraform.HookAction, error) {
+	return h.start(n, "provision")
+}
+
+func (h *MetricsHook) PostProvisionResource(
+	n *terraform.InstanceInfo, s *terraform.InstanceState) (terraform.HookAction, error) {
+	return h.stop(n, "provision")
+}
+
+// Report returns the times measured for each resource, slowest first.
+func (h *MetricsHook) Report() []*metricsResource {
+	h.Lock()
+	defer h.Unlock()
+
+	result := make([]*metricsResource, 0, len(h.resources))
+	for id, r := range h.resources {
+		result = append(result, &metricsResource{
+			Address:   id,
+			Total:     r.Total().Seconds(),
+			Refresh:   r.Refresh.Seconds(),
+			Diff:      r.Diff.Seconds(),
+			Apply:     r.Apply.Seconds(),
+			Provision: r.Provision.Seconds(),
+		})
+	}
+	sort.Sort(metricsResourcesBySlowest(result))
+
+	return result
+}
+
+type metricsResourcesBySlowest []*metricsResource
+
+func (s metricsResourcesBySlowest) Len() int      { return len(s) }
+func (s metricsResourcesBySlowest) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s metricsResourcesBySlowest) Less(i, j int) bool {
+	if s[i].Total != s[j].Total {
+		return s[i].Total > s[j].Total
+	}
+	return s[i].Address < s[j].Address
+}
@@ -0,0 +1,72 @@
+package command
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestMetricsHook_impl(t *testing.T) {
+	var _ terraform.Hook = new(MetricsHook)
+}
+
+func TestMetricsHook(t *testing.T) {
+	now := time.Unix(0, 0)
+	h := &MetricsHook{now: func() time.Time { return now }}
+
+	foo := &terraform.InstanceInfo{Id: "test_instance.foo"}
+	bar := &terraform.InstanceInfo{Id: "test_instance.bar"}
+	baz := &terraform.InstanceInfo{Id: "test_instance.baz"}
+
+	h.PreRefresh(foo, nil)
+	h.PreRefresh(bar, nil)
+	now = now.Add(2 * time.Second)
+	h.PostRefresh(foo, nil)
+	h.PostRefresh(bar, nil)
+
+	// A resource diffed twice has the times of both diffs.
+	for i := 0; i < 2; i++ {
+		h.PreDiff(foo, nil)
+		now = now.Add(time.Second)
+		h.PostDiff(foo, nil)
+	}
+
+	h.PreApply(foo, nil, nil)
+	h.PreApply(baz, nil, nil)
+	now = now.Add(3 * time.Second)
+	h.PostApply(baz, nil, nil)
+	h.PreProvisionResource(foo, nil)
+	now = now.Add(time.Second)
+	h.PostProvisionResource(foo, nil)
+	h.PostApply(foo, nil, nil)
+
+	expected := []*metricsResource{
+		&metricsResource{
+			Address:   "test_instance.foo",
+			Total:     8,
+			Refresh:   2,
+			Diff:      2,
+			Apply:     4,
+			Provision: 1,
+		},
+		&metricsResource{
+			Address: "test_instance.baz",
+			Total:   3,
+			Apply:   3,
+		},
+		&metricsResource{
+			Address: "test_instance.bar",
+			Total:   2,
+			Refresh: 2,
+		},
+	}
+	actual := h.Report()
+	if !reflect.DeepEqual(actual, expected) {
+		for _, r := range actual {
+			t.Logf("%#v", r)
+		}
+		t.Fatal("bad report")
+	}
+}
@@ -0,0 +1,61 @@
+package command
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// WarningHook is a hook that collects the warnings that providers return
+// with their diffs, so they can be shown after the plan.
+type WarningHook struct {
+	warnings map[string][]string
+
+	sync.Mutex
+	terraform.NilHook
+}
+
+func (h *WarningHook) PostDiff(
+	n *terraform.InstanceInfo,
+	d *terraform.InstanceDiff) (terraform.HookAction, error) {
+	if d == nil || len(d.Warnings) == 0 {
+		return terraform.HookActionContinue, nil
+	}
+
+	h.Lock()
+	defer h.Unlock()
+
+	if h.warnings == nil {
+		h.warnings = make(map[string][]string)
+	}
+
+	// A resource is diffed again when a plan is applied, so the warnings
+	// are keyed by resource to show them once.
+	h.warnings[n.HumanId()] = d.Warnings
+
+	return terraform.HookActionContinue, nil
+}
+
+// Warnings returns the collected warnings, each prefixed with the resource
+// it's about and sorted by resource.
+func (h *WarningHook) Warnings() []string {
+	h.Lock()
+	defer h.Unlock()
+
+	ids := make([]string, 0, len(h.warnings))
+	for id := range h.warnings {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var result []string
+	for _, id := range ids {
+		for _, w := range h.warnings[id] {
+			result = append(result, fmt.Sprintf("%s: %s", id, w))
+		}
+	}
+
+	return result
+}
@@ -35,6 +35,7 @@ import (
 	mailgunprovider "github.com/hashicorp/terraform/builtin/providers/mailgun"
 	mysqlprovider "github.com/hashicorp/terraform/builtin/providers/mysql"
 	nullprovider "github.com/hashicorp/terraform/builtin/providers/null"
+	oktaprovider "github.com/hashicorp/terraform/builtin/providers/okta"
 	openstackprovider "github.com/hashicorp/terraform/builtin/providers/openstack"
 	packetprovider "github.com/hashicorp/terraform/builtin/providers/packet"
 	postgresqlprovider "github.com/hashicorp/terraform/builtin/providers/postgresql"
@@ -43,6 +44,7 @@ import (
 	randomprovider "github.com/hashicorp/terraform/builtin/providers/random"
 	rundeckprovider "github.com/hashicorp/terraform/builtin/providers/rundeck"
 	scalewayprovider "github.com/hashicorp/terraform/builtin/providers/scaleway"
+	sensuprovider "github.com/hashicorp/terraform/builtin/providers/sensu"
 	softlayerprovider "github.com/hashicorp/terraform/builtin/providers/softlayer"
 	statuscakeprovider "github.com/hashicorp/terraform/builtin/providers/statuscake"
 	templateprovider "github.com/hashicorp/terraform/builtin/providers/template"
@@ -92,6 +94,7 @@ var InternalProviders = map[string]plugin.ProviderFunc{
 	"mailgun":      mailgunprovider.Provider,
 	"mysql":        mysqlprovider.Provider,
 	"null":         nullprovider.Provider,
+	"okta":         oktaprovider.Provider,
 	"openstack":    openstackprovider.Provider,
 	"packet":       packetprovider.Provider,
 	"postgresql":   postgresqlprovider.Provider,
@@ -100,6 +103,7 @@ var InternalProviders = map[string]plugin.ProviderFunc{
 	"random":       randomprovider.Provider,
 	"rundeck":      rundeckprovider.Provider,
 	"scaleway":     scalewayprovider.Provider,
+	"sensu":        sensuprovider.Provider,
 	"softlayer":    softlayerprovider.Provider,
 	"statuscake":   statuscakeprovider.Provider,
 	"template":     templateprovider.Provider,
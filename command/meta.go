@@ -5,9 +5,13 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"log"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/go-getter"
 	"github.com/hashicorp/terraform/config/module"
@@ -23,14 +27,34 @@ type Meta struct {
 	ContextOpts *terraform.ContextOpts
 	Ui          cli.Ui
 
+	// GlobalPluginDirs are the directories outside of the working directory
+	// that are searched for versioned plugins, in order of preference.
+	GlobalPluginDirs []string
+
+	// ModuleCacheDir is the directory of the module cache shared by all
+	// working directories. Modules aren't cached if it's empty.
+	ModuleCacheDir string
+
+	// Notifier sends notifications when an apply or destroy finishes. It
+	// is nil if notifications aren't configured.
+	Notifier *Notifier
+
 	// State read when calling `Context`. This is available after calling
 	// `Context`.
 	state       state.State
 	stateResult *StateResult
 
+	// ID of the lock held on state, if any. This is set by `Context` when
+	// stateLock is true and the state supports locking.
+	stateLockID string
+
 	// This can be set by the command itself to provide extra hooks.
 	extraHooks []terraform.Hook
 
+	// metricsHook measures resources for the run metrics file. It is set
+	// by startMetrics if metrics were asked for.
+	metricsHook *MetricsHook
+
 	// This can be set by tests to change some directories
 	dataDir string
 
@@ -41,7 +65,8 @@ type Meta struct {
 	variables     map[string]interface{}
 
 	// Targets for this context (private)
-	targets []string
+	targets         []string
+	targetSelectors []string
 
 	color bool
 	oldUi cli.Ui
@@ -63,12 +88,23 @@ type Meta struct {
 	//
 	// parallelism is used to control the number of concurrent operations
 	// allowed when walking the graph
-	statePath    string
-	stateOutPath string
-	backupPath   string
-	parallelism  int
+	//
+	// stateLock is set to false to disable state locking
+	//
+	// stateLockTimeout is how long to wait for a state lock held by
+	// someone else before giving up. Zero fails immediately.
+	statePath        string
+	stateOutPath     string
+	backupPath       string
+	parallelism      int
+	stateLock        bool
+	stateLockTimeout time.Duration
 }
 
+// stateLockRetryInterval is the longest time lockState waits between
+// attempts to acquire a state lock held by someone else.
+var stateLockRetryInterval = 10 * time.Second
+
 // initStatePaths is used to initialize the default values for
 // statePath, stateOutPath, and backupPath
 func (m *Meta) initStatePaths() {
@@ -101,6 +137,8 @@ func (m *Meta) Colorize() *colorstring.Colorize {
 // options used to initialize this meta configuration.
 func (m *Meta) Context(copts contextOpts) (*terraform.Context, bool, error) {
 	opts := m.contextOpts()
+	opts.Deadline = copts.Deadline
+	opts.RefreshData = copts.RefreshData
 
 	// First try to just read the plan directly from the path given.
 	f, err := os.Open(copts.Path)
@@ -109,7 +147,8 @@ func (m *Meta) Context(copts contextOpts) (*terraform.Context, bool, error) {
 		f.Close()
 		if err == nil {
 			// Setup our state
-			state, statePath, err := StateFromPlan(m.statePath, m.stateOutPath, plan)
+			state, statePath, err := StateFromPlan(
+				m.localStatePath(), m.remoteStatePath(), m.stateOutPath, plan)
 			if err != nil {
 				return nil, false, fmt.Errorf("Error loading plan: %s", err)
 			}
@@ -117,6 +156,10 @@ func (m *Meta) Context(copts contextOpts) (*terraform.Context, bool, error) {
 			// Set our state
 			m.state = state
 
+			if err := m.lockState(state, copts.Operation); err != nil {
+				return nil, false, err
+			}
+
 			// this is used for printing the saved location later
 			if m.stateOutPath == "" {
 				m.stateOutPath = statePath
@@ -130,6 +173,11 @@ func (m *Meta) Context(copts contextOpts) (*terraform.Context, bool, error) {
 						"variable values, create a new plan file.")
 			}
 
+			opts.Providers, err = m.providerFactories(plan.Module, copts.RecordPlugins)
+			if err != nil {
+				return nil, false, err
+			}
+
 			ctx, err := plan.Context(opts)
 			return ctx, true, err
 		}
@@ -149,6 +197,17 @@ func (m *Meta) Context(copts contextOpts) (*terraform.Context, bool, error) {
 		return nil, false, err
 	}
 
+	if err := m.lockState(state, copts.Operation); err != nil {
+		return nil, false, err
+	}
+	if m.stateLockID != "" {
+		// Re-read the state now that we hold the lock, since it may
+		// have been changed by whoever held the lock before us.
+		if err := state.RefreshState(); err != nil {
+			return nil, false, fmt.Errorf("Error reloading state: %s", err)
+		}
+	}
+
 	// Load the root module
 	var mod *module.Tree
 	if copts.Path != "" {
@@ -170,13 +229,90 @@ func (m *Meta) Context(copts contextOpts) (*terraform.Context, bool, error) {
 		return nil, false, err
 	}
 
+	opts.Providers, err = m.providerFactories(mod, copts.RecordPlugins)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := m.selectTargets(state.State()); err != nil {
+		return nil, false, err
+	}
+
 	opts.Module = mod
 	opts.Parallelism = copts.Parallelism
 	opts.State = state.State()
+	opts.Targets = m.targets
 	ctx, err := terraform.NewContext(opts)
 	return ctx, false, err
 }
 
+// selectTargets resolves the -target-selector flags against the given state
+// and adds the addresses of the selected resources to the targets.
+func (m *Meta) selectTargets(state *terraform.State) error {
+	seen := make(map[string]bool)
+	for _, t := range m.targets {
+		seen[t] = true
+	}
+
+	for _, raw := range m.targetSelectors {
+		selector, err := terraform.ParseTargetSelector(raw)
+		if err != nil {
+			return err
+		}
+
+		addrs, err := selector.Select(state)
+		if err != nil {
+			return fmt.Errorf("Error evaluating target selector %q: %s", raw, err)
+		}
+
+		// An empty target list means "everything", so a selector that
+		// matches nothing must not silently widen the operation.
+		if len(addrs) == 0 {
+			return fmt.Errorf(
+				"Target selector %q matched no resources in the state.", raw)
+		}
+
+		for _, addr := range addrs {
+			log.Printf("[INFO] Target selector %q selected %s", raw, addr)
+			if !seen[addr] {
+				seen[addr] = true
+				m.targets = append(m.targets, addr)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Env returns the name of the currently selected state environment.
+func (m *Meta) Env() string {
+	raw, err := ioutil.ReadFile(filepath.Join(m.DataDir(), DefaultEnvFile))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("[ERROR] Error reading selected environment: %s", err)
+		}
+
+		return DefaultEnvName
+	}
+
+	env := strings.TrimSpace(string(raw))
+	if env == "" {
+		return DefaultEnvName
+	}
+
+	return env
+}
+
+// SetEnv saves the given environment as the selected state environment.
+func (m *Meta) SetEnv(env string) error {
+	if err := os.MkdirAll(m.DataDir(), 0755); err != nil {
+		return err
+	}
+
+	path := filepath.Join(m.DataDir(), DefaultEnvFile)
+	return ioutil.WriteFile(path, []byte(env), 0644)
+}
+
 // DataDir returns the directory where local data will be stored.
 func (m *Meta) DataDir() string {
 	dataDir := DefaultDataDir
@@ -249,21 +385,143 @@ func (m *Meta) StateRaw(opts *StateOpts) (*StateResult, error) {
 	return result, nil
 }
 
-// StateOpts returns the default state options
-func (m *Meta) StateOpts() *StateOpts {
-	localPath := m.statePath
-	if localPath == "" {
-		localPath = DefaultStateFilename
+// lockState acquires a lock on the given state for the named operation.
+// Nothing is done if locking was disabled with -lock=false or the state
+// storage doesn't support locking. The lock is released by unlockState.
+//
+// If the state is locked by someone else, lockState waits for up to
+// stateLockTimeout for the lock to be released, telling the user who holds
+// it in the meantime.
+func (m *Meta) lockState(s state.State, operation string) error {
+	if !m.stateLock {
+		return nil
+	}
+
+	locker, ok := s.(state.Locker)
+	if !ok {
+		return nil
+	}
+
+	info := state.NewLockInfo()
+	info.Operation = operation
+
+	deadline := time.Now().Add(m.stateLockTimeout)
+	interval := time.Second
+	var holder string
+	for {
+		lockID, err := locker.Lock(info)
+		if err == nil {
+			m.stateLockID = lockID
+			return nil
+		}
+
+		lockErr, ok := err.(*state.LockError)
+		if !ok || lockErr.Info == nil || m.stateLockTimeout <= 0 {
+			return fmt.Errorf("Error locking state: %s", err)
+		}
+
+		remaining := deadline.Sub(time.Now())
+		if remaining <= 0 {
+			return fmt.Errorf(
+				"Error locking state: timed out after %s waiting for the lock "+
+					"to be released.\n\n%s", m.stateLockTimeout, err)
+		}
+
+		// Report the holder of the lock when we start waiting, and again
+		// whenever the lock changes hands.
+		if lockErr.Info.ID != holder {
+			if holder != "" {
+				m.Ui.Output("The state lock was released, but was taken by another operation first.")
+			}
+			holder = lockErr.Info.ID
+			m.Ui.Output(lockWaitMessage(lockErr.Info, remaining))
+		}
+
+		if interval > stateLockRetryInterval {
+			interval = stateLockRetryInterval
+		}
+		if interval > remaining {
+			interval = remaining
+		}
+		time.Sleep(interval)
+		interval *= 2
 	}
-	remotePath := filepath.Join(m.DataDir(), DefaultStateFilename)
+}
 
+// lockWaitMessage describes the state lock held by someone else while we
+// wait for it.
+func lockWaitMessage(info *state.LockInfo, remaining time.Duration) string {
+	msg := fmt.Sprintf("The state is locked by %s", info.Who)
+	if info.Operation != "" {
+		msg += fmt.Sprintf(", running %q", info.Operation)
+	}
+	if !info.Created.IsZero() {
+		held := time.Since(info.Created) / time.Second * time.Second
+		msg += fmt.Sprintf(", held for %s", held)
+	}
+	return fmt.Sprintf(
+		"%s (lock ID %s).\nWaiting up to %s for the lock to be released...",
+		msg, info.ID, remaining/time.Second*time.Second)
+}
+
+// unlockState releases the state lock acquired by lockState, if any.
+// Errors are reported to the UI since there is nothing more the caller
+// can do about them at that point.
+func (m *Meta) unlockState() {
+	if m.stateLockID == "" {
+		return
+	}
+
+	if locker, ok := m.state.(state.Locker); ok {
+		if err := locker.Unlock(m.stateLockID); err != nil {
+			m.Ui.Error(fmt.Sprintf(
+				"Error releasing the state lock: %s\n\n"+
+					"The state may still be locked. Once you have verified that no\n"+
+					"other operation is in progress, the lock can be released with:\n\n"+
+					"    terraform force-unlock %s", err, m.stateLockID))
+		}
+	}
+
+	m.stateLockID = ""
+}
+
+// StateOpts returns the default state options
+func (m *Meta) StateOpts() *StateOpts {
 	return &StateOpts{
-		LocalPath:     localPath,
+		LocalPath:     m.localStatePath(),
 		LocalPathOut:  m.stateOutPath,
-		RemotePath:    remotePath,
+		RemotePath:    filepath.Join(m.DataDir(), DefaultStateFilename),
 		RemoteRefresh: true,
 		BackupPath:    m.backupPath,
+		Env:           m.Env(),
+	}
+}
+
+// localStatePath returns the path of the local state file. Unless a
+// different path was given, the state of a non-default environment is
+// stored in its own directory.
+func (m *Meta) localStatePath() string {
+	path := m.statePath
+	if path == "" {
+		path = DefaultStateFilename
+	}
+
+	if env := m.Env(); path == DefaultStateFilename && env != DefaultEnvName {
+		path = envStatePath(DefaultStateFilename, env)
 	}
+
+	return path
+}
+
+// remoteStatePath returns the path of the remote state cache for the
+// selected environment.
+func (m *Meta) remoteStatePath() string {
+	path := filepath.Join(m.DataDir(), DefaultStateFilename)
+	if env := m.Env(); env != DefaultEnvName {
+		path = envStatePath(path, env)
+	}
+
+	return path
 }
 
 // UIInput returns a UIInput object to be used for asking for input.
@@ -298,6 +556,9 @@ func (m *Meta) contextOpts() *terraform.ContextOpts {
 	opts.Hooks[0] = m.uiHook()
 	copy(opts.Hooks[1:], m.ContextOpts.Hooks)
 	copy(opts.Hooks[len(m.ContextOpts.Hooks)+1:], m.extraHooks)
+	if m.metricsHook != nil {
+		opts.Hooks = append(opts.Hooks, m.metricsHook)
+	}
 
 	vs := make(map[string]interface{})
 	for k, v := range opts.Variables {
@@ -312,6 +573,7 @@ func (m *Meta) contextOpts() *terraform.ContextOpts {
 	opts.Variables = vs
 	opts.Targets = m.targets
 	opts.UIInput = m.UIInput()
+	opts.Meta = &terraform.ContextMeta{Env: m.Env()}
 
 	return &opts
 }
@@ -323,6 +585,7 @@ func (m *Meta) flagSet(n string) *flag.FlagSet {
 	f.Var((*FlagTypedKV)(&m.variables), "var", "variables")
 	f.Var((*FlagKVFile)(&m.variables), "var-file", "variable file")
 	f.Var((*FlagStringSlice)(&m.targets), "target", "resource to target")
+	f.Var((*FlagStringSlice)(&m.targetSelectors), "target-selector", "resources to target")
 
 	if m.autoKey != "" {
 		f.Var((*FlagKVFile)(&m.autoVariables), m.autoKey, "variable file")
@@ -350,11 +613,19 @@ func (m *Meta) flagSet(n string) *flag.FlagSet {
 // moduleStorage returns the module.Storage implementation used to store
 // modules for commands.
 func (m *Meta) moduleStorage(root string) getter.Storage {
-	return &uiModuleStorage{
-		Storage: &getter.FolderStorage{
+	var s getter.Storage = &module.FolderStorage{
+		StorageDir: filepath.Join(root, "modules"),
+	}
+	if m.ModuleCacheDir != "" {
+		s = &module.CacheStorage{
 			StorageDir: filepath.Join(root, "modules"),
-		},
-		Ui: m.Ui,
+			CacheDir:   m.ModuleCacheDir,
+		}
+	}
+
+	return &uiModuleStorage{
+		Storage: s,
+		Ui:      m.Ui,
 	}
 }
 
@@ -462,4 +733,19 @@ type contextOpts struct {
 
 	// Number of concurrent operations allowed
 	Parallelism int
+
+	// Deadline is the maximum duration of an apply, or zero for no limit.
+	Deadline time.Duration
+
+	// RefreshData is set when data sources must be read again during
+	// refresh even if their inputs didn't change.
+	RefreshData bool
+
+	// Operation is the name of the command being run. It is recorded in
+	// the state lock, if one is taken.
+	Operation string
+
+	// RecordPlugins is set when the selected provider plugins should be
+	// written to the plugin lock file rather than checked against it.
+	RecordPlugins bool
 }
@@ -1,14 +1,19 @@
 package command
 
 import (
+	"errors"
 	"flag"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform/state"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/mitchellh/cli"
 )
 
 func TestMetaColorize(t *testing.T) {
@@ -268,3 +273,93 @@ func TestMeta_addModuleDepthFlag(t *testing.T) {
 		}
 	}
 }
+
+// testLockedState is a state that is locked by each of holders in turn
+// before it can be locked.
+type testLockedState struct {
+	state.InmemState
+
+	holders  []*state.LockInfo
+	attempts int
+}
+
+func (s *testLockedState) Lock(info *state.LockInfo) (string, error) {
+	s.attempts++
+	if len(s.holders) > 0 {
+		holder := s.holders[0]
+		s.holders = s.holders[1:]
+		return "", &state.LockError{Err: errors.New("state locked"), Info: holder}
+	}
+	return info.ID, nil
+}
+
+func (s *testLockedState) Unlock(id string) error {
+	return nil
+}
+
+func TestMetaLockState_wait(t *testing.T) {
+	defer func(d time.Duration) { stateLockRetryInterval = d }(stateLockRetryInterval)
+	stateLockRetryInterval = 10 * time.Millisecond
+
+	first := state.NewLockInfo()
+	first.Operation = "apply"
+	second := state.NewLockInfo()
+	second.Operation = "refresh"
+	s := &testLockedState{holders: []*state.LockInfo{first, first, second}}
+
+	ui := new(cli.MockUi)
+	m := &Meta{Ui: ui, stateLock: true, stateLockTimeout: time.Minute}
+	if err := m.lockState(s, "plan"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if m.stateLockID == "" {
+		t.Fatal("lock should be held")
+	}
+	if s.attempts != 4 {
+		t.Fatalf("expected 4 attempts, got %d", s.attempts)
+	}
+
+	output := ui.OutputWriter.String()
+	for _, expected := range []string{
+		first.ID, `running "apply"`,
+		"taken by another operation",
+		second.ID, `running "refresh"`,
+	} {
+		if !strings.Contains(output, expected) {
+			t.Fatalf("output should contain %q:\n\n%s", expected, output)
+		}
+	}
+}
+
+func TestMetaLockState_timeout(t *testing.T) {
+	defer func(d time.Duration) { stateLockRetryInterval = d }(stateLockRetryInterval)
+	stateLockRetryInterval = 10 * time.Millisecond
+
+	holders := make([]*state.LockInfo, 1000)
+	for i := range holders {
+		holders[i] = state.NewLockInfo()
+	}
+	s := &testLockedState{holders: holders}
+
+	m := &Meta{Ui: new(cli.MockUi), stateLock: true, stateLockTimeout: 50 * time.Millisecond}
+	err := m.lockState(s, "plan")
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Fatalf("expected timeout, got: %v", err)
+	}
+	if m.stateLockID != "" {
+		t.Fatal("lock should not be held")
+	}
+}
+
+func TestMetaLockState_noTimeout(t *testing.T) {
+	s := &testLockedState{holders: []*state.LockInfo{state.NewLockInfo()}}
+
+	m := &Meta{Ui: new(cli.MockUi), stateLock: true}
+	err := m.lockState(s, "plan")
+	if err == nil || !strings.Contains(err.Error(), "Error locking state") {
+		t.Fatalf("expected lock error, got: %v", err)
+	}
+	if s.attempts != 1 {
+		t.Fatalf("expected 1 attempt, got %d", s.attempts)
+	}
+}
@@ -0,0 +1,97 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/metrics"
+)
+
+// MetricsPathEnvVar is the environment variable that, if set, is the path
+// of a file that plan, apply, destroy and refresh write metrics of the
+// run to. The file never leaves this machine.
+const MetricsPathEnvVar = "TF_METRICS_PATH"
+
+// metricsReport is the content of the run metrics file.
+type metricsReport struct {
+	Command   string  `json:"command"`
+	StartTime string  `json:"start_time"`
+	Duration  float64 `json:"duration_seconds"`
+
+	// Resources are the times measured for each resource, slowest first.
+	Resources []*metricsResource `json:"resources,omitempty"`
+
+	// Providers are the API call counts reported by the providers. These
+	// can't be attributed to resources, since resources share the API
+	// clients of their provider.
+	Providers map[string]*metrics.Provider `json:"providers,omitempty"`
+}
+
+// metricsResource is the time a resource spent in each operation of a run,
+// in seconds. Provisioning time is part of the apply time.
+type metricsResource struct {
+	Address   string  `json:"address"`
+	Total     float64 `json:"total_seconds"`
+	Refresh   float64 `json:"refresh_seconds"`
+	Diff      float64 `json:"diff_seconds"`
+	Apply     float64 `json:"apply_seconds"`
+	Provision float64 `json:"provision_seconds"`
+}
+
+// startMetrics starts collecting the metrics of a run of the given command
+// if TF_METRICS_PATH is set. The returned function writes the metrics file
+// and must be called once the run is over, which is easiest with defer.
+//
+// Failing to collect or write metrics is only a warning, as it never
+// changes the outcome of the run.
+func (m *Meta) startMetrics(command string) func() {
+	path := os.Getenv(MetricsPathEnvVar)
+	if path == "" {
+		return func() {}
+	}
+
+	start := time.Now()
+
+	// Providers inherit the environment, so this is how they learn where
+	// to write their API call counts.
+	dir, err := ioutil.TempDir("", "tf-metrics")
+	if err != nil {
+		m.Ui.Warn(fmt.Sprintf("Error collecting run metrics: %s", err))
+		return func() {}
+	}
+	os.Setenv(metrics.DirEnvVar, dir)
+
+	m.metricsHook = new(MetricsHook)
+
+	return func() {
+		os.Unsetenv(metrics.DirEnvVar)
+		defer os.RemoveAll(dir)
+
+		report := &metricsReport{
+			Command:   command,
+			StartTime: start.UTC().Format(time.RFC3339),
+			Duration:  time.Since(start).Seconds(),
+			Resources: m.metricsHook.Report(),
+		}
+
+		providers, err := metrics.ReadDir(dir)
+		if err != nil {
+			m.Ui.Warn(fmt.Sprintf("Error reading provider metrics: %s", err))
+		}
+		if len(providers) > 0 {
+			report.Providers = providers
+		}
+
+		raw, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			m.Ui.Warn(fmt.Sprintf("Error encoding run metrics: %s", err))
+			return
+		}
+		if err := ioutil.WriteFile(path, append(raw, '\n'), 0644); err != nil {
+			m.Ui.Warn(fmt.Sprintf("Error writing run metrics to %s: %s", path, err))
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package command
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/config/module"
+)
+
+// ModulesSchemaCommand is a Command implementation that outputs the input
+// variables and outputs of every module in a configuration as JSON.
+type ModulesSchemaCommand struct {
+	Meta
+}
+
+// modulesSchema is the JSON document written by "terraform modules schema".
+type modulesSchema struct {
+	Modules []*moduleSchema `json:"modules"`
+}
+
+type moduleSchema struct {
+	Path      []string          `json:"path"`
+	Source    string            `json:"source,omitempty"`
+	Variables []*variableSchema `json:"variables"`
+	Outputs   []*outputSchema   `json:"outputs"`
+}
+
+type variableSchema struct {
+	Name        string      `json:"name"`
+	Type        string      `json:"type"`
+	Default     interface{} `json:"default"`
+	Required    bool        `json:"required"`
+	Description string      `json:"description,omitempty"`
+}
+
+type outputSchema struct {
+	Name        string `json:"name"`
+	Type        string `json:"type,omitempty"`
+	Description string `json:"description,omitempty"`
+	Sensitive   bool   `json:"sensitive"`
+}
+
+func (c *ModulesSchemaCommand) Run(args []string) int {
+	args = c.Meta.process(args, false)
+
+	cmdFlags := flag.NewFlagSet("modules schema", flag.ContinueOnError)
+	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+
+	var path string
+	args = cmdFlags.Args()
+	if len(args) > 1 {
+		c.Ui.Error("The modules schema command expects at most one argument.\n")
+		cmdFlags.Usage()
+		return 1
+	} else if len(args) == 1 {
+		path = args[0]
+	} else {
+		var err error
+		path, err = os.Getwd()
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error getting pwd: %s", err))
+			return 1
+		}
+	}
+
+	mod, err := module.NewTreeModule("", path)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error loading config: %s", err))
+		return 1
+	}
+
+	// Modules must already have been fetched with "terraform get", we
+	// never download anything just to describe the configuration.
+	if err := mod.Load(c.moduleStorage(c.DataDir()), module.GetModeNone); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error loading modules: %s", err))
+		return 1
+	}
+
+	// Validation also determines which outputs are sensitive.
+	if err := mod.Validate(); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error validating config: %s", err))
+		return 1
+	}
+
+	schema := &modulesSchema{}
+	modulesSchemaWalk(schema, mod, nil, "")
+
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error encoding schema: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(string(data))
+	return 0
+}
+
+// modulesSchemaWalk appends the schema of the given tree and all of its
+// children, in name order, to the result. Variables and outputs are listed
+// in the order they are declared.
+func modulesSchemaWalk(
+	result *modulesSchema, t *module.Tree, path []string, source string) {
+	cfg := t.Config()
+	m := &moduleSchema{
+		Path:      path,
+		Source:    source,
+		Variables: make([]*variableSchema, 0, len(cfg.Variables)),
+		Outputs:   make([]*outputSchema, 0, len(cfg.Outputs)),
+	}
+	if m.Path == nil {
+		m.Path = []string{}
+	}
+
+	for _, v := range cfg.Variables {
+		m.Variables = append(m.Variables, &variableSchema{
+			Name:        v.Name,
+			Type:        v.Type().Printable(),
+			Default:     v.Default,
+			Required:    v.Required(),
+			Description: v.Description,
+		})
+	}
+
+	for _, o := range cfg.Outputs {
+		m.Outputs = append(m.Outputs, &outputSchema{
+			Name:        o.Name,
+			Type:        o.DeclaredType,
+			Description: o.Description,
+			Sensitive:   o.Sensitive,
+		})
+	}
+
+	result.Modules = append(result.Modules, m)
+
+	sources := make(map[string]string)
+	for _, child := range t.Modules() {
+		sources[child.Name] = child.Source
+	}
+
+	children := t.Children()
+	names := make([]string, 0, len(children))
+	for name := range children {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		childPath := make([]string, len(path), len(path)+1)
+		copy(childPath, path)
+		childPath = append(childPath, name)
+		modulesSchemaWalk(result, children[name], childPath, sources[name])
+	}
+}
+
+func (c *ModulesSchemaCommand) Help() string {
+	helpText := `
+Usage: terraform modules schema [DIR]
+
+  Outputs the input variables and outputs of the root module in DIR (or
+  the current directory if omitted) and of every module it uses, as JSON.
+
+  Each variable is listed with its type, default value and description,
+  and each output with its description and whether it is sensitive. This
+  is meant for generating module catalogs and documentation from the
+  configuration itself.
+
+  Modules must have been downloaded with "terraform get" beforehand.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ModulesSchemaCommand) Synopsis() string {
+	return "Output the variables and outputs of modules as JSON"
+}
@@ -0,0 +1,121 @@
+package command
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/mitchellh/cli"
+)
+
+func TestModulesSchema(t *testing.T) {
+	dataDir := tempDir(t)
+	path := testFixturePath("modules-schema")
+
+	getUi := new(cli.MockUi)
+	get := &GetCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          getUi,
+			dataDir:     dataDir,
+		},
+	}
+	if code := get.Run([]string{path}); code != 0 {
+		t.Fatalf("bad: \n%s", getUi.ErrorWriter.String())
+	}
+
+	ui := new(cli.MockUi)
+	c := &ModulesSchemaCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+			dataDir:     dataDir,
+		},
+	}
+	if code := c.Run([]string{path}); code != 0 {
+		t.Fatalf("bad: \n%s", ui.ErrorWriter.String())
+	}
+
+	var actual modulesSchema
+	if err := json.Unmarshal(ui.OutputWriter.Bytes(), &actual); err != nil {
+		t.Fatalf("err: %s\n\n%s", err, ui.OutputWriter.String())
+	}
+
+	expected := modulesSchema{
+		Modules: []*moduleSchema{
+			&moduleSchema{
+				Path: []string{},
+				Variables: []*variableSchema{
+					&variableSchema{
+						Name:        "region",
+						Type:        "string",
+						Required:    true,
+						Description: "The region to deploy into",
+					},
+					&variableSchema{
+						Name:    "zones",
+						Type:    "list",
+						Default: []interface{}{"a", "b"},
+					},
+				},
+				Outputs: []*outputSchema{
+					&outputSchema{
+						Name:        "address",
+						Description: "The address of the service",
+						Sensitive:   true,
+					},
+				},
+			},
+			&moduleSchema{
+				Path:   []string{"child"},
+				Source: "./child",
+				Variables: []*variableSchema{
+					&variableSchema{
+						Name:    "tags",
+						Type:    "map",
+						Default: map[string]interface{}{},
+					},
+				},
+				Outputs: []*outputSchema{
+					&outputSchema{
+						Name: "id",
+						Type: "string",
+					},
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad:\n\n%s", ui.OutputWriter.String())
+	}
+}
+
+func TestModulesSchema_notDownloaded(t *testing.T) {
+	ui := new(cli.MockUi)
+	c := &ModulesSchemaCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+			dataDir:     tempDir(t),
+		},
+	}
+
+	if code := c.Run([]string{testFixturePath("modules-schema")}); code != 1 {
+		t.Fatalf("bad: \n%s", ui.OutputWriter.String())
+	}
+}
+
+func TestModulesSchema_multipleArgs(t *testing.T) {
+	ui := new(cli.MockUi)
+	c := &ModulesSchemaCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+
+	if code := c.Run([]string{"bad", "bad"}); code != 1 {
+		t.Fatalf("bad: \n%s", ui.OutputWriter.String())
+	}
+}
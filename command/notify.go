@@ -0,0 +1,298 @@
+package command
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/user"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Notification events. An event is the operation followed by its outcome.
+const (
+	NotifyApplySucceeded   = "apply_succeeded"
+	NotifyApplyFailed      = "apply_failed"
+	NotifyDestroySucceeded = "destroy_succeeded"
+	NotifyDestroyFailed    = "destroy_failed"
+)
+
+// Notification types.
+const (
+	NotificationTypeWebhook = "webhook"
+	NotificationTypeSlack   = "slack"
+	NotificationTypeSNS     = "sns"
+)
+
+// notifyTimeout is the longest we wait for a single notification to be
+// delivered before giving up on it.
+const notifyTimeout = 10 * time.Second
+
+// NotificationConfig is a destination that is notified when an apply or
+// destroy finishes. It is configured with "notification" blocks in the
+// CLI configuration file.
+type NotificationConfig struct {
+	Name string `hcl:"-"`
+
+	// Type is "webhook", "slack" or "sns". It defaults to "webhook",
+	// which posts the Notification as JSON to URL.
+	Type string `hcl:"type"`
+
+	// URL is the address to post to for the webhook and slack types.
+	URL string `hcl:"url"`
+
+	// TopicARN is the SNS topic to publish to for the sns type.
+	TopicARN string `hcl:"topic_arn"`
+
+	// Events limits the events that are sent. All events are sent if
+	// this is empty.
+	Events []string `hcl:"events"`
+}
+
+// Validate checks that the notification is complete.
+func (c *NotificationConfig) Validate() error {
+	switch c.Type {
+	case "", NotificationTypeWebhook, NotificationTypeSlack:
+		if c.URL == "" {
+			return fmt.Errorf("notification %q: url is required", c.Name)
+		}
+	case NotificationTypeSNS:
+		if _, err := snsTopicRegion(c.TopicARN); err != nil {
+			return fmt.Errorf("notification %q: %s", c.Name, err)
+		}
+	default:
+		return fmt.Errorf(
+			"notification %q: type must be one of %q, %q or %q, got %q",
+			c.Name, NotificationTypeWebhook, NotificationTypeSlack,
+			NotificationTypeSNS, c.Type)
+	}
+
+	for _, e := range c.Events {
+		switch e {
+		case NotifyApplySucceeded, NotifyApplyFailed,
+			NotifyDestroySucceeded, NotifyDestroyFailed:
+		default:
+			return fmt.Errorf("notification %q: unknown event %q", c.Name, e)
+		}
+	}
+
+	return nil
+}
+
+// wants returns true if the given event should be sent to this destination.
+func (c *NotificationConfig) wants(event string) bool {
+	if len(c.Events) == 0 {
+		return true
+	}
+
+	for _, e := range c.Events {
+		if e == event {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Notifier holds the notification destinations from the CLI configuration.
+// It is shared by all commands and filled in once the configuration has
+// been loaded.
+type Notifier struct {
+	Configs []*NotificationConfig
+
+	// Client is used for webhook and slack notifications. It defaults to
+	// a client with a short timeout.
+	Client *http.Client
+}
+
+// Notification is the summary of an apply or destroy that is sent to
+// the configured destinations.
+type Notification struct {
+	Event       string   `json:"event"`
+	User        string   `json:"user"`
+	Host        string   `json:"host"`
+	Path        string   `json:"path"`
+	Environment string   `json:"environment"`
+	Added       int      `json:"added"`
+	Changed     int      `json:"changed"`
+	Destroyed   int      `json:"destroyed"`
+	Duration    float64  `json:"duration_seconds"`
+	Errors      []string `json:"errors,omitempty"`
+}
+
+// Summary returns a single line describing the notification.
+func (n *Notification) Summary() string {
+	op := "Apply"
+	if strings.HasPrefix(n.Event, "destroy") {
+		op = "Destroy"
+	}
+
+	outcome := "succeeded"
+	if len(n.Errors) > 0 {
+		outcome = "failed"
+	}
+
+	return fmt.Sprintf(
+		"%s %s for %s in environment %q by %s@%s after %s: +%d ~%d -%d",
+		op, outcome, n.Path, n.Environment, n.User, n.Host,
+		time.Duration(n.Duration*float64(time.Second)).String(),
+		n.Added, n.Changed, n.Destroyed)
+}
+
+// Notify sends the notification to every destination that wants its
+// event. Delivery failures are returned but never stop the others.
+func (n *Notifier) Notify(notification *Notification) []error {
+	if n == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, c := range n.Configs {
+		if !c.wants(notification.Event) {
+			continue
+		}
+
+		var err error
+		switch c.Type {
+		case NotificationTypeSNS:
+			err = n.publishSNS(c, notification)
+		default:
+			err = n.post(c, notification)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("notification %q: %s", c.Name, err))
+		}
+	}
+
+	return errs
+}
+
+func (n *Notifier) post(c *NotificationConfig, notification *Notification) error {
+	var body interface{} = notification
+	if c.Type == NotificationTypeSlack {
+		text := notification.Summary()
+		if len(notification.Errors) > 0 {
+			text += "\n```\n" + strings.Join(notification.Errors, "\n") + "\n```"
+		}
+		body = map[string]string{"text": text}
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	client := n.Client
+	if client == nil {
+		client = &http.Client{Timeout: notifyTimeout}
+	}
+
+	log.Printf("[DEBUG] Sending %s notification %q", notification.Event, c.Name)
+	resp, err := client.Post(c.URL, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected response status: %s", resp.Status)
+	}
+
+	return nil
+}
+
+func (n *Notifier) publishSNS(c *NotificationConfig, notification *Notification) error {
+	region, err := snsTopicRegion(c.TopicARN)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	// Credentials come from the usual AWS environment variables, shared
+	// credentials file or instance profile.
+	sess, err := session.NewSession(&aws.Config{
+		Region: aws.String(region),
+		HTTPClient: &http.Client{
+			Timeout: notifyTimeout,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Publishing %s notification %q", notification.Event, c.Name)
+	_, err = sns.New(sess).Publish(&sns.PublishInput{
+		TopicArn: aws.String(c.TopicARN),
+		Subject:  aws.String(truncate(notification.Summary(), 100)),
+		Message:  aws.String(string(data)),
+	})
+	return err
+}
+
+// newNotification returns a notification for the given operation on the
+// configuration at path with the details of who ran it filled in.
+func (m *Meta) newNotification(
+	operation, path string, err error, start time.Time) *Notification {
+	event := operation + "_succeeded"
+	if err != nil {
+		event = operation + "_failed"
+	}
+
+	n := &Notification{
+		Event:       event,
+		Path:        path,
+		Environment: m.Env(),
+		Duration:    time.Since(start).Seconds(),
+	}
+	if u, err := user.Current(); err == nil {
+		n.User = u.Username
+	}
+	n.Host, _ = os.Hostname()
+	if merr, ok := multierror.Flatten(err).(*multierror.Error); ok {
+		for _, e := range merr.Errors {
+			n.Errors = append(n.Errors, e.Error())
+		}
+	} else if err != nil {
+		n.Errors = []string{err.Error()}
+	}
+
+	return n
+}
+
+// notify sends the notification, reporting any delivery failures as
+// warnings since they shouldn't fail the command.
+func (m *Meta) notify(n *Notification) {
+	for _, err := range m.Notifier.Notify(n) {
+		m.Ui.Warn(fmt.Sprintf("Failed to send notification: %s", err))
+	}
+}
+
+// snsTopicRegion returns the region of the SNS topic with the given ARN,
+// which has the form "arn:aws:sns:REGION:ACCOUNT:NAME".
+func snsTopicRegion(topicARN string) (string, error) {
+	parts := strings.Split(topicARN, ":")
+	if len(parts) != 6 || parts[0] != "arn" || parts[2] != "sns" || parts[3] == "" {
+		return "", fmt.Errorf("topic_arn %q is not an SNS topic ARN", topicARN)
+	}
+
+	return parts[3], nil
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n-3] + "..."
+}
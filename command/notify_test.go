@@ -0,0 +1,207 @@
+package command
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+)
+
+func TestNotifier_webhook(t *testing.T) {
+	var received []*Notification
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var n Notification
+		if err := json.NewDecoder(r.Body).Decode(&n); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		received = append(received, &n)
+	}))
+	defer ts.Close()
+
+	notifier := &Notifier{
+		Configs: []*NotificationConfig{
+			&NotificationConfig{Name: "all", URL: ts.URL},
+			&NotificationConfig{
+				Name:   "failures",
+				URL:    ts.URL,
+				Events: []string{NotifyApplyFailed},
+			},
+		},
+	}
+
+	errs := notifier.Notify(&Notification{
+		Event:     NotifyApplySucceeded,
+		Added:     2,
+		Changed:   1,
+		Destroyed: 3,
+	})
+	if len(errs) > 0 {
+		t.Fatalf("err: %v", errs)
+	}
+
+	if len(received) != 1 {
+		t.Fatalf("expected only the unfiltered destination, got %d", len(received))
+	}
+	n := received[0]
+	if n.Event != NotifyApplySucceeded || n.Added != 2 || n.Changed != 1 || n.Destroyed != 3 {
+		t.Fatalf("bad: %#v", n)
+	}
+}
+
+func TestNotifier_slack(t *testing.T) {
+	var body map[string]string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}))
+	defer ts.Close()
+
+	notifier := &Notifier{
+		Configs: []*NotificationConfig{
+			&NotificationConfig{Name: "ops", Type: NotificationTypeSlack, URL: ts.URL},
+		},
+	}
+
+	errs := notifier.Notify(&Notification{
+		Event:       NotifyDestroyFailed,
+		User:        "alice",
+		Host:        "ci",
+		Path:        "/src/infra",
+		Environment: "prod",
+		Destroyed:   1,
+		Duration:    90,
+		Errors:      []string{"aws_instance.web: timeout"},
+	})
+	if len(errs) > 0 {
+		t.Fatalf("err: %v", errs)
+	}
+
+	expected := "Destroy failed for /src/infra in environment \"prod\" by alice@ci after 1m30s: +0 ~0 -1\n" +
+		"```\naws_instance.web: timeout\n```"
+	if body["text"] != expected {
+		t.Fatalf("bad:\n\n%s", body["text"])
+	}
+}
+
+func TestNotifier_error(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ioutil.ReadAll(r.Body)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	notifier := &Notifier{
+		Configs: []*NotificationConfig{
+			&NotificationConfig{Name: "broken", URL: ts.URL},
+		},
+	}
+
+	errs := notifier.Notify(&Notification{Event: NotifyApplySucceeded})
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), `notification "broken"`) {
+		t.Fatalf("bad: %v", errs)
+	}
+}
+
+func TestNotificationConfig_Validate(t *testing.T) {
+	cases := []struct {
+		Config *NotificationConfig
+		Err    string
+	}{
+		{
+			&NotificationConfig{URL: "https://example.com"},
+			"",
+		},
+		{
+			&NotificationConfig{Type: NotificationTypeSlack},
+			"url is required",
+		},
+		{
+			&NotificationConfig{
+				Type:     NotificationTypeSNS,
+				TopicARN: "arn:aws:sns:us-east-1:123456789012:deploys",
+			},
+			"",
+		},
+		{
+			&NotificationConfig{
+				Type:     NotificationTypeSNS,
+				TopicARN: "arn:aws:sqs:us-east-1:123456789012:deploys",
+			},
+			"not an SNS topic ARN",
+		},
+		{
+			&NotificationConfig{Type: "email"},
+			"type must be one of",
+		},
+		{
+			&NotificationConfig{
+				URL:    "https://example.com",
+				Events: []string{NotifyApplyFailed, "plan"},
+			},
+			`unknown event "plan"`,
+		},
+	}
+
+	for i, tc := range cases {
+		err := tc.Config.Validate()
+		if (err != nil) != (tc.Err != "") {
+			t.Fatalf("%d: unexpected err: %v", i, err)
+		}
+		if err != nil && !strings.Contains(err.Error(), tc.Err) {
+			t.Fatalf("%d: expected %q in: %s", i, tc.Err, err)
+		}
+	}
+}
+
+func TestApply_notify(t *testing.T) {
+	statePath := testTempFile(t)
+
+	var received *Notification
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = new(Notification)
+		if err := json.NewDecoder(r.Body).Decode(received); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}))
+	defer ts.Close()
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &ApplyCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+			Notifier: &Notifier{
+				Configs: []*NotificationConfig{
+					&NotificationConfig{Name: "test", URL: ts.URL},
+				},
+			},
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+		testFixturePath("apply"),
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	if received == nil {
+		t.Fatal("no notification was sent")
+	}
+	if received.Event != NotifyApplySucceeded || received.Added != 1 {
+		t.Fatalf("bad: %#v", received)
+	}
+	if received.Path != testFixturePath("apply") {
+		t.Fatalf("bad: %s", received.Path)
+	}
+	if received.Environment != "default" {
+		t.Fatalf("bad: %#v", received)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -16,7 +17,7 @@ type PlanCommand struct {
 }
 
 func (c *PlanCommand) Run(args []string) int {
-	var destroy, refresh, detailed bool
+	var destroy, refresh, refreshData, detailed, mock bool
 	var outPath string
 	var moduleDepth int
 
@@ -25,17 +26,36 @@ func (c *PlanCommand) Run(args []string) int {
 	cmdFlags := c.Meta.flagSet("plan")
 	cmdFlags.BoolVar(&destroy, "destroy", false, "destroy")
 	cmdFlags.BoolVar(&refresh, "refresh", true, "refresh")
+	cmdFlags.BoolVar(&refreshData, "refresh-data", false, "refresh-data")
 	c.addModuleDepthFlag(cmdFlags, &moduleDepth)
 	cmdFlags.StringVar(&outPath, "out", "", "path")
 	cmdFlags.IntVar(
 		&c.Meta.parallelism, "parallelism", DefaultParallelism, "parallelism")
 	cmdFlags.StringVar(&c.Meta.statePath, "state", DefaultStateFilename, "path")
 	cmdFlags.BoolVar(&detailed, "detailed-exitcode", false, "detailed-exitcode")
+	cmdFlags.BoolVar(&c.Meta.stateLock, "lock", true, "lock state")
+	cmdFlags.DurationVar(&c.Meta.stateLockTimeout, "lock-timeout", 0, "lock timeout")
+	cmdFlags.BoolVar(&mock, "mock-providers", false, "mock-providers")
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
 	if err := cmdFlags.Parse(args); err != nil {
 		return 1
 	}
 
+	defer c.Meta.startMetrics("plan")()
+
+	// Providers inherit the environment, so this is how they learn to
+	// return placeholders instead of calling their APIs.
+	if mock && os.Getenv(schema.MockEnvVar) == "" {
+		os.Setenv(schema.MockEnvVar, "1")
+		defer os.Unsetenv(schema.MockEnvVar)
+	}
+	if os.Getenv(schema.MockEnvVar) != "" && outPath != "" {
+		c.Ui.Error(
+			"A plan made with mock providers contains placeholder values and\n" +
+				"can't be applied, so it can't be saved with -out.")
+		return 1
+	}
+
 	var path string
 	args = cmdFlags.Args()
 	if len(args) > 1 {
@@ -55,13 +75,17 @@ func (c *PlanCommand) Run(args []string) int {
 	}
 
 	countHook := new(CountHook)
-	c.Meta.extraHooks = []terraform.Hook{countHook}
+	warningHook := new(WarningHook)
+	c.Meta.extraHooks = []terraform.Hook{countHook, warningHook}
 
+	defer c.Meta.unlockState()
 	ctx, _, err := c.Context(contextOpts{
 		Destroy:     destroy,
 		Path:        path,
 		StatePath:   c.Meta.statePath,
 		Parallelism: c.Meta.parallelism,
+		RefreshData: refreshData,
+		Operation:   "plan",
 	})
 	if err != nil {
 		c.Ui.Error(err.Error())
@@ -114,6 +138,7 @@ func (c *PlanCommand) Run(args []string) int {
 				"could not detect any differences between your configuration and\n" +
 				"the real physical resources that exist. As a result, Terraform\n" +
 				"doesn't need to do anything.")
+		outputProviderWarnings(c.Ui, warningHook)
 		return 0
 	}
 
@@ -137,6 +162,7 @@ func (c *PlanCommand) Run(args []string) int {
 		countHook.ToAdd+countHook.ToRemoveAndAdd,
 		countHook.ToChange,
 		countHook.ToRemove+countHook.ToRemoveAndAdd)))
+	outputProviderWarnings(c.Ui, warningHook)
 
 	if detailed {
 		return 2
@@ -168,6 +194,15 @@ Options:
 
   -input=true         Ask for input for variables if not directly set.
 
+  -lock=true          Lock the state file when locking is supported.
+
+  -lock-timeout=0s    Duration to wait for a state lock held by another
+                      operation, such as "5m". Defaults to failing at once.
+
+  -mock-providers     Plan without calling any provider APIs or needing
+                      credentials. Existing resources aren't refreshed and
+                      data sources return placeholder values.
+
   -module-depth=n     Specifies the depth of modules to show in the output.
                       This does not affect the plan itself, only the output
                       shown. By default, this is -1, which will expand all.
@@ -181,6 +216,10 @@ Options:
 
   -refresh=true       Update state prior to checking for differences.
 
+  -refresh-data       Read every data source during refresh. By default a
+                      data source is read again only if its arguments or
+                      its provider's configuration changed.
+
   -state=statefile    Path to a Terraform state file to use to look
                       up Terraform-managed resources. By default it will
                       use the state "terraform.tfstate" if it exists.
@@ -189,6 +228,11 @@ Options:
                       resource and its dependencies. This flag can be used
                       multiple times.
 
+  -target-selector=sel
+                      Target every resource in the state matching a selector,
+                      e.g. 'aws_instance.*[tags.Team="payments"]'. This flag
+                      can be used multiple times.
+
   -var 'foo=bar'      Set a variable in the Terraform configuration. This
                       flag can be set multiple times.
 
@@ -5,9 +5,11 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/mitchellh/cli"
 )
@@ -123,6 +125,63 @@ func TestPlan_noState(t *testing.T) {
 	}
 }
 
+func TestPlan_mockProviders(t *testing.T) {
+	var mockEnv string
+	p := testProvider()
+	p.ConfigureFn = func(*terraform.ResourceConfig) error {
+		mockEnv = os.Getenv(schema.MockEnvVar)
+		return nil
+	}
+	ui := new(cli.MockUi)
+	c := &PlanCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-mock-providers",
+		testFixturePath("plan"),
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	if mockEnv == "" {
+		t.Fatal("providers should have been configured in mock mode")
+	}
+	if v := os.Getenv(schema.MockEnvVar); v != "" {
+		t.Fatalf("mock mode should be reset, got %q", v)
+	}
+}
+
+func TestPlan_mockProvidersOut(t *testing.T) {
+	outPath := testTempFile(t)
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &PlanCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-mock-providers",
+		"-out", outPath,
+		testFixturePath("plan"),
+	}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: %d", code)
+	}
+
+	if _, err := os.Stat(outPath); err == nil {
+		t.Fatal("plan should not be written")
+	}
+}
+
 func TestPlan_outPath(t *testing.T) {
 	tf, err := ioutil.TempFile("", "tf")
 	if err != nil {
@@ -212,6 +271,43 @@ func TestPlan_outPathNoChange(t *testing.T) {
 	}
 }
 
+func TestPlan_providerWarnings(t *testing.T) {
+	p := testProvider()
+	p.DiffFn = func(
+		info *terraform.InstanceInfo,
+		s *terraform.InstanceState,
+		c *terraform.ResourceConfig) (*terraform.InstanceDiff, error) {
+		return &terraform.InstanceDiff{
+			Attributes: map[string]*terraform.ResourceAttrDiff{
+				"ami": &terraform.ResourceAttrDiff{New: "bar"},
+			},
+			Warnings: []string{"ami is deprecated"},
+		}, nil
+	}
+	ui := new(cli.MockUi)
+	c := &PlanCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		testFixturePath("plan"),
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	output := ui.ErrorWriter.String()
+	if !strings.Contains(output, "Warnings from providers") {
+		t.Fatalf("bad: %s", output)
+	}
+	if !strings.Contains(output, "test_instance.foo: ami is deprecated") {
+		t.Fatalf("bad: %s", output)
+	}
+}
+
 func TestPlan_refresh(t *testing.T) {
 	p := testProvider()
 	ui := new(cli.MockUi)
@@ -276,6 +372,62 @@ func TestPlan_state(t *testing.T) {
 	}
 }
 
+func TestPlan_targetSelector(t *testing.T) {
+	statePath := testStateFile(t, testState())
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &PlanCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+		"-target-selector", "test_instance.*",
+		testFixturePath("plan"),
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	expected := []string{"test_instance.foo"}
+	if !reflect.DeepEqual(c.Meta.targets, expected) {
+		t.Fatalf("bad targets: %#v", c.Meta.targets)
+	}
+}
+
+func TestPlan_targetSelectorNoMatch(t *testing.T) {
+	statePath := testStateFile(t, testState())
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &PlanCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+		"-target-selector", `test_instance.*[ami="nope"]`,
+		testFixturePath("plan"),
+	}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+
+	if !strings.Contains(ui.ErrorWriter.String(), "matched no resources") {
+		t.Fatalf("bad: %s", ui.ErrorWriter.String())
+	}
+	if p.DiffCalled {
+		t.Fatal("diff should not be called")
+	}
+}
+
 func TestPlan_stateDefault(t *testing.T) {
 	originalState := testState()
 
@@ -0,0 +1,303 @@
+package command
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/config/module"
+	tfplugin "github.com/hashicorp/terraform/plugin"
+	"github.com/hashicorp/terraform/plugin/discovery"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// DefaultPluginLockFile is the name of the file within the plugin directory
+// that records which provider plugins were selected for the working
+// directory.
+const DefaultPluginLockFile = "lock.json"
+
+// pluginLock is the on-disk record of the provider plugins that were
+// selected for a working directory, keyed by provider name.
+type pluginLock map[string]pluginLockEntry
+
+type pluginLockEntry struct {
+	Version string `json:"version"`
+	SHA256  string `json:"sha256"`
+}
+
+// pluginDir is the directory within the data directory where plugins
+// specific to this working directory are kept. It is split by OS and
+// architecture so that a data directory can be shared between machines.
+func (m *Meta) pluginDir() string {
+	return filepath.Join(m.DataDir(), "plugins", runtime.GOOS+"_"+runtime.GOARCH)
+}
+
+// pluginDirs returns the directories searched for versioned plugins, in
+// order of preference.
+func (m *Meta) pluginDirs() []string {
+	dirs := []string{m.pluginDir()}
+	return append(dirs, m.GlobalPluginDirs...)
+}
+
+func (m *Meta) pluginLockPath() string {
+	return filepath.Join(m.pluginDir(), DefaultPluginLockFile)
+}
+
+// readPluginLock reads the plugin lock file. A missing lock file is not an
+// error and results in an empty lock.
+func (m *Meta) readPluginLock() (pluginLock, error) {
+	lock := make(pluginLock)
+
+	raw, err := ioutil.ReadFile(m.pluginLockPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return lock, nil
+		}
+		return nil, err
+	}
+
+	if err := json.Unmarshal(raw, &lock); err != nil {
+		return nil, fmt.Errorf("Error parsing %s: %s", m.pluginLockPath(), err)
+	}
+
+	return lock, nil
+}
+
+func (m *Meta) writePluginLock(lock pluginLock) error {
+	raw, err := json.MarshalIndent(lock, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(m.pluginDir(), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(m.pluginLockPath(), raw, 0644)
+}
+
+// providerFactories returns the provider factories to use for the given
+// module tree.
+//
+// Every provider the configuration needs is resolved to the newest
+// discovered plugin that satisfies all of the version constraints placed on
+// it. Providers without any constraints fall back to the factories given
+// in ContextOpts (those compiled into Terraform or found by the legacy
+// discovery) when no versioned plugin is installed.
+//
+// If record is true the selected plugins are written to the plugin lock
+// file. Otherwise, any plugins recorded in the lock file are preferred and
+// verified against their recorded checksums.
+func (m *Meta) providerFactories(mod *module.Tree, record bool) (map[string]terraform.ResourceProviderFactory, error) {
+	result := make(map[string]terraform.ResourceProviderFactory)
+	for k, v := range m.ContextOpts.Providers {
+		result[k] = v
+	}
+
+	reqd, err := moduleProviderRequirements(mod)
+	if err != nil {
+		return nil, err
+	}
+	if len(reqd) == 0 {
+		return result, nil
+	}
+
+	lock := make(pluginLock)
+	if !record {
+		lock, err = m.readPluginLock()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	available, invalid := discovery.FindPlugins("provider", m.pluginDirs()).ValidateVersions()
+	for p := range invalid {
+		log.Printf("[WARN] ignoring provider plugin %s with invalid version %q", p.Path, p.Version)
+	}
+
+	// Sort the names so that errors are reported in a stable order.
+	candidates := available.ConstrainVersions(reqd)
+	names := make([]string, 0, len(candidates))
+	for name := range candidates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	selected := make(pluginLock)
+	var errs []string
+	for _, name := range names {
+		metas := candidates[name]
+
+		if entry, ok := lock[name]; ok {
+			v, err := discovery.VersionStr(entry.Version).Parse()
+			if err != nil {
+				return nil, fmt.Errorf(
+					"provider.%s: invalid version %q in %s",
+					name, entry.Version, m.pluginLockPath())
+			}
+
+			if metas.WithVersion(v).Count() == 0 {
+				errs = append(errs, fmt.Sprintf(
+					"provider.%s: version %s was selected by \"terraform get\", but is no "+
+						"longer installed or no longer allowed by the configuration",
+					name, entry.Version))
+				continue
+			}
+			metas = metas.WithVersion(v)
+		}
+
+		if metas.Count() == 0 {
+			if reqd[name].Unconstrained() {
+				// Use the built-in or legacy plugin, if there is one.
+				continue
+			}
+
+			errs = append(errs, fmt.Sprintf(
+				"provider.%s: no suitable version installed\n"+
+					"  version requirements: %q\n"+
+					"  versions installed: %s",
+				name, reqd[name].String(), installedVersions(available.WithName(name))))
+			continue
+		}
+
+		meta := metas.Newest()
+		digest, err := meta.SHA256()
+		if err != nil {
+			return nil, fmt.Errorf("Error reading provider plugin %s: %s", meta.Path, err)
+		}
+		sum := hex.EncodeToString(digest)
+
+		if entry, ok := lock[name]; ok && entry.SHA256 != sum {
+			errs = append(errs, fmt.Sprintf(
+				"provider.%s: checksum of %s does not match the one recorded by "+
+					"\"terraform get\"",
+				name, meta.Path))
+			continue
+		}
+
+		log.Printf("[INFO] using provider plugin %s for %s (version %s)", meta.Path, name, meta.Version)
+		result[name] = providerFactory(meta)
+		selected[name] = pluginLockEntry{
+			Version: string(meta.Version),
+			SHA256:  sum,
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, fmt.Errorf(
+			"Error resolving provider plugins:\n\n%s\n\n"+
+				"Run \"terraform get\" after installing the required plugins into\n"+
+				"%s to select them for this configuration.",
+			strings.Join(errs, "\n"), m.pluginDir())
+	}
+
+	if record {
+		if err := m.writePluginLock(selected); err != nil {
+			return nil, fmt.Errorf("Error writing %s: %s", m.pluginLockPath(), err)
+		}
+	}
+
+	return result, nil
+}
+
+// moduleProviderRequirements walks the module tree and returns the version
+// constraints for each provider that is either configured or used by a
+// resource. Providers that are only used get no constraints.
+func moduleProviderRequirements(mod *module.Tree) (discovery.PluginRequirements, error) {
+	reqd := make(discovery.PluginRequirements)
+	if mod == nil {
+		return reqd, nil
+	}
+
+	if c := mod.Config(); c != nil {
+		for _, pc := range c.ProviderConfigs {
+			constraints := discovery.AllVersions
+			if pc.Version != "" {
+				var err error
+				constraints, err = discovery.ParseConstraints(pc.Version)
+				if err != nil {
+					return nil, fmt.Errorf("provider.%s: %s", pc.FullName(), err)
+				}
+			}
+
+			reqd = reqd.Merge(discovery.PluginRequirements{pc.Name: constraints})
+		}
+
+		for _, r := range c.Resources {
+			name := resourceProviderName(r.Type, r.Provider)
+			if name == "" {
+				continue
+			}
+
+			reqd = reqd.Merge(discovery.PluginRequirements{name: discovery.AllVersions})
+		}
+	}
+
+	for _, child := range mod.Children() {
+		childReqd, err := moduleProviderRequirements(child)
+		if err != nil {
+			return nil, err
+		}
+
+		reqd = reqd.Merge(childReqd)
+	}
+
+	return reqd, nil
+}
+
+// resourceProviderName returns the name of the provider plugin for a
+// resource of the given type that may be using an aliased provider.
+func resourceProviderName(t, alias string) string {
+	if alias != "" {
+		if idx := strings.IndexRune(alias, '.'); idx != -1 {
+			return alias[:idx]
+		}
+		return alias
+	}
+
+	idx := strings.IndexRune(t, '_')
+	if idx == -1 {
+		return ""
+	}
+
+	return t[:idx]
+}
+
+func installedVersions(s discovery.PluginMetaSet) string {
+	if s.Count() == 0 {
+		return "none"
+	}
+
+	versions := make([]string, 0, s.Count())
+	for p := range s {
+		versions = append(versions, string(p.Version))
+	}
+	sort.Strings(versions)
+
+	return strings.Join(versions, ", ")
+}
+
+func providerFactory(meta discovery.PluginMeta) terraform.ResourceProviderFactory {
+	client := tfplugin.Client(meta)
+
+	return func() (terraform.ResourceProvider, error) {
+		rpcClient, err := client.Client()
+		if err != nil {
+			return nil, err
+		}
+
+		raw, err := rpcClient.Dispense(tfplugin.ProviderPluginName)
+		if err != nil {
+			return nil, err
+		}
+
+		return raw.(terraform.ResourceProvider), nil
+	}
+}
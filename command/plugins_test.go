@@ -0,0 +1,110 @@
+package command
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/plugin/discovery"
+)
+
+func TestModuleProviderRequirements(t *testing.T) {
+	reqd, err := moduleProviderRequirements(testModule(t, "plugins-version"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if len(reqd) != 2 {
+		t.Fatalf("bad: %#v", reqd)
+	}
+
+	test := reqd["test"]
+	for v, want := range map[string]bool{
+		"0.9.0": false,
+		"1.0.0": true,
+		"1.5.0": true,
+		"2.0.0": false,
+	} {
+		if got := test.Allows(discovery.VersionStr(v).MustParse()); got != want {
+			t.Errorf("%s allows %s: got %t; want %t", test, v, got, want)
+		}
+	}
+
+	if !reqd["other"].Unconstrained() {
+		t.Fatalf("other should be unconstrained: %s", reqd["other"])
+	}
+}
+
+func TestMetaProviderFactories(t *testing.T) {
+	m := &Meta{
+		ContextOpts: testCtxConfig(testProvider()),
+		dataDir:     testTempDir(t),
+	}
+	mod := testModule(t, "plugins-version")
+
+	// Nothing installed yet, so the constraint can't be met by the
+	// built-in "test" provider.
+	_, err := m.providerFactories(mod, true)
+	if err == nil {
+		t.Fatal("should error")
+	}
+	if !strings.Contains(err.Error(), "no suitable version installed") {
+		t.Fatalf("bad: %s", err)
+	}
+
+	testPluginFile(t, m.pluginDir(), "terraform-provider-test_v0.9.0")
+	testPluginFile(t, m.pluginDir(), "terraform-provider-test_v1.0.0")
+	testPluginFile(t, m.pluginDir(), "terraform-provider-test_v1.2.0")
+	testPluginFile(t, m.pluginDir(), "terraform-provider-test_v2.0.0")
+
+	ps, err := m.providerFactories(mod, true)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, ok := ps["test"]; !ok {
+		t.Fatalf("missing test provider: %#v", ps)
+	}
+
+	lock, err := m.readPluginLock()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if got := lock["test"].Version; got != "1.2.0" {
+		t.Fatalf("bad recorded version: %q", got)
+	}
+	if _, ok := lock["other"]; ok {
+		t.Fatalf("built-in providers should not be recorded: %#v", lock)
+	}
+
+	// A newer allowed version doesn't change the recorded selection.
+	testPluginFile(t, m.pluginDir(), "terraform-provider-test_v1.5.0")
+	if _, err := m.providerFactories(mod, false); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Changing the selected plugin must fail verification.
+	path := filepath.Join(m.pluginDir(), "terraform-provider-test_v1.2.0")
+	if err := ioutil.WriteFile(path, []byte("changed"), 0755); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	_, err = m.providerFactories(mod, false)
+	if err == nil {
+		t.Fatal("should error")
+	}
+	if !strings.Contains(err.Error(), "checksum") {
+		t.Fatalf("bad: %s", err)
+	}
+}
+
+func testPluginFile(t *testing.T, dir, name string) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, []byte(name), 0755); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
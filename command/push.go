@@ -183,7 +183,14 @@ func (c *PushCommand) Run(args []string) int {
 	// the variables we're going to get.
 	// We are going to keep these separate from the atlas variables until
 	// upload, so we can notify the user which local variables we're sending.
-	serializedVars, err := tfVars(ctx.Variables())
+	// Secrets read from variable sources are never uploaded.
+	localVars := make(map[string]interface{})
+	for k, v := range ctx.Variables() {
+		if _, ok := ctx.SecretVariables()[k]; !ok {
+			localVars[k] = v
+		}
+	}
+	serializedVars, err := tfVars(localVars)
 	if err != nil {
 		c.Ui.Error(fmt.Sprintf(
 			"An error has occurred while serializing the variables for uploading:\n"+
@@ -302,7 +309,7 @@ func (c *PushCommand) Run(args []string) int {
 	opts := &pushUpsertOptions{
 		Name:      name,
 		Archive:   archiveR,
-		Variables: ctx.Variables(),
+		Variables: localVars,
 		TFVars:    uploadVars,
 	}
 
@@ -73,6 +73,66 @@ func TestPush_good(t *testing.T) {
 	}
 }
 
+func TestPush_varSource(t *testing.T) {
+	tmp, cwd := testCwd(t)
+	defer testFixCwd(t, tmp, cwd)
+
+	// Create remote state file, this should be pulled
+	conf, srv := testRemoteState(t, testState(), 200)
+	defer srv.Close()
+
+	// Persist local remote state
+	s := terraform.NewState()
+	s.Serial = 5
+	s.Remote = conf
+	testStateFileRemote(t, s)
+
+	// Path where the archive will be "uploaded" to
+	archivePath := testTempFile(t)
+	defer os.Remove(archivePath)
+
+	source := &terraform.MockVariableSource{ReadVariableReturn: "hunter2"}
+	ctxOpts := testCtxConfig(testProvider())
+	ctxOpts.VariableSources = map[string]terraform.VariableSource{
+		"secret": source,
+	}
+
+	client := &mockPushClient{File: archivePath}
+	ui := new(cli.MockUi)
+	c := &PushCommand{
+		Meta: Meta{
+			ContextOpts: ctxOpts,
+			Ui:          ui,
+		},
+
+		client: client,
+	}
+
+	args := []string{
+		"-vcs=false",
+		"-var", "region=us-east-1",
+		testFixturePath("push-var-source"),
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	if !source.ReadVariableCalled {
+		t.Fatal("should read the secret")
+	}
+
+	// The secret must not be uploaded
+	variables := map[string]interface{}{"region": "us-east-1"}
+	if !reflect.DeepEqual(client.UpsertOptions.Variables, variables) {
+		t.Fatalf("bad: %#v", client.UpsertOptions.Variables)
+	}
+	for _, v := range client.UpsertOptions.TFVars {
+		if v.Key == "password" {
+			t.Fatalf("bad: %#v", client.UpsertOptions.TFVars)
+		}
+	}
+}
+
 func TestPush_noUploadModules(t *testing.T) {
 	// Path where the archive will be "uploaded" to
 	archivePath := testTempFile(t)
@@ -16,6 +16,7 @@ type RefreshCommand struct {
 }
 
 func (c *RefreshCommand) Run(args []string) int {
+	var refreshData bool
 	args = c.Meta.process(args, true)
 
 	cmdFlags := c.Meta.flagSet("refresh")
@@ -23,11 +24,16 @@ func (c *RefreshCommand) Run(args []string) int {
 	cmdFlags.IntVar(&c.Meta.parallelism, "parallelism", 0, "parallelism")
 	cmdFlags.StringVar(&c.Meta.stateOutPath, "state-out", "", "path")
 	cmdFlags.StringVar(&c.Meta.backupPath, "backup", "", "path")
+	cmdFlags.BoolVar(&c.Meta.stateLock, "lock", true, "lock state")
+	cmdFlags.DurationVar(&c.Meta.stateLockTimeout, "lock-timeout", 0, "lock timeout")
+	cmdFlags.BoolVar(&refreshData, "refresh-data", false, "refresh-data")
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
 	if err := cmdFlags.Parse(args); err != nil {
 		return 1
 	}
 
+	defer c.Meta.startMetrics("refresh")()
+
 	var configPath string
 	args = cmdFlags.Args()
 	if len(args) > 1 {
@@ -80,10 +86,13 @@ func (c *RefreshCommand) Run(args []string) int {
 	}
 
 	// Build the context based on the arguments given
+	defer c.Meta.unlockState()
 	ctx, _, err := c.Context(contextOpts{
 		Path:        configPath,
 		StatePath:   c.Meta.statePath,
 		Parallelism: c.Meta.parallelism,
+		RefreshData: refreshData,
+		Operation:   "refresh",
 	})
 	if err != nil {
 		c.Ui.Error(err.Error())
@@ -137,8 +146,17 @@ Options:
 
   -input=true         Ask for input for variables if not directly set.
 
+  -lock=true          Lock the state file when locking is supported.
+
+  -lock-timeout=0s    Duration to wait for a state lock held by another
+                      operation, such as "5m". Defaults to failing at once.
+
   -no-color           If specified, output won't contain any color.
 
+  -refresh-data       Read every data source. By default a data source is
+                      read again only if its arguments or its provider's
+                      configuration changed.
+
   -state=path         Path to read and save state (unless state-out
                       is specified). Defaults to "terraform.tfstate".
 
@@ -149,6 +167,11 @@ Options:
                       resource and its dependencies. This flag can be used
                       multiple times.
 
+  -target-selector=sel
+                      Target every resource in the state matching a selector,
+                      e.g. 'aws_instance.*[tags.Team="payments"]'. This flag
+                      can be used multiple times.
+
   -var 'foo=bar'      Set a variable in the Terraform configuration. This
                       flag can be set multiple times.
 
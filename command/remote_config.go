@@ -45,6 +45,16 @@ func (c *RemoteConfigCommand) Run(args []string) int {
 		return 1
 	}
 
+	// Remote state is configured once for all environments, and the
+	// environments derive their configuration from it.
+	if env := c.Env(); env != DefaultEnvName {
+		c.Ui.Error(fmt.Sprintf(
+			"Remote state can only be configured in the %q environment.\n"+
+				"The current environment is %q. Run \"terraform env select %s\"\n"+
+				"and try again.", DefaultEnvName, env, DefaultEnvName))
+		return 1
+	}
+
 	// Lowercase the type
 	c.remoteConf.Type = strings.ToLower(c.remoteConf.Type)
 
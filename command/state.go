@@ -33,6 +33,12 @@ type StateOpts struct {
 	// it is assumed to be the path where the state is stored locally
 	// plus the DefaultBackupExtension.
 	BackupPath string
+
+	// Env is the name of the selected state environment. For any
+	// environment other than the default, the remote state configured at
+	// RemotePath is adjusted to point to the state of the environment,
+	// which is cached separately next to RemotePath.
+	Env string
 }
 
 // StateResult is the result of calling State and holds various different
@@ -66,12 +72,17 @@ func State(opts *StateOpts) (*StateResult, error) {
 
 	// Get the remote state cache path
 	if opts.RemotePath != "" {
-		result.RemotePath = opts.RemotePath
+		remotePath := opts.RemotePath
+		isEnv := opts.Env != "" && opts.Env != DefaultEnvName
+		if isEnv {
+			remotePath = envStatePath(opts.RemotePath, opts.Env)
+		}
+		result.RemotePath = remotePath
 
 		var remote *state.CacheState
 		if opts.RemoteCacheOnly {
 			// Setup the in-memory state
-			ls := &state.LocalState{Path: opts.RemotePath}
+			ls := &state.LocalState{Path: remotePath}
 			if err := ls.RefreshState(); err != nil {
 				return nil, err
 			}
@@ -90,9 +101,16 @@ func State(opts *StateOpts) (*StateResult, error) {
 		} else {
 			if _, err := os.Stat(opts.RemotePath); err == nil {
 				// We have a remote state, initialize that.
-				remote, err = remoteStateFromPath(
-					opts.RemotePath,
-					opts.RemoteRefresh)
+				if isEnv {
+					remote, err = remoteEnvStateFromPath(
+						opts.RemotePath,
+						opts.Env,
+						opts.RemoteRefresh)
+				} else {
+					remote, err = remoteStateFromPath(
+						opts.RemotePath,
+						opts.RemoteRefresh)
+				}
 				if err != nil {
 					return nil, err
 				}
@@ -101,7 +119,7 @@ func State(opts *StateOpts) (*StateResult, error) {
 
 		if remote != nil {
 			result.State = remote
-			result.StatePath = opts.RemotePath
+			result.StatePath = remotePath
 			result.Remote = remote
 		}
 	}
@@ -168,8 +186,11 @@ func State(opts *StateOpts) (*StateResult, error) {
 }
 
 // StateFromPlan gets our state from the plan.
+//
+// localPath is the path to where state would be if stored locally and
+// remotePath is the path where the remote state cache would be stored.
 func StateFromPlan(
-	localPath, outPath string,
+	localPath, remotePath, outPath string,
 	plan *terraform.Plan) (state.State, string, error) {
 	var result state.State
 	resultPath := localPath
@@ -179,7 +200,7 @@ func StateFromPlan(
 
 		// It looks like we have a remote state in the plan, so
 		// we have to initialize that.
-		resultPath = filepath.Join(DefaultDataDir, DefaultStateFilename)
+		resultPath = remotePath
 		result, err = remoteState(plan.State, resultPath, false)
 		if err != nil {
 			return nil, "", err
@@ -278,3 +299,65 @@ func remoteStateFromPath(path string, refresh bool) (*state.CacheState, error) {
 
 	return remoteState(localState, path, refresh)
 }
+
+// remoteEnvStateFromPath loads the remote state of a non-default
+// environment. The remote configuration is taken from the default
+// environment's cache at path and adjusted for the environment.
+func remoteEnvStateFromPath(path, env string, refresh bool) (*state.CacheState, error) {
+	local := &state.LocalState{Path: path}
+	if err := local.RefreshState(); err != nil {
+		return nil, err
+	}
+	localState := local.State()
+	if localState == nil || localState.Remote == nil {
+		return nil, fmt.Errorf("Remote state cache has no remote info")
+	}
+
+	remoteType := strings.ToLower(localState.Remote.Type)
+	conf, err := remote.EnvConfig(remoteType, localState.Remote.Config, env)
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf(
+			"Error configuring remote state for environment '%s': {{err}}",
+			env), err)
+	}
+	envRemote := &terraform.RemoteState{Type: remoteType, Config: conf}
+
+	// Start from whatever we have cached for the environment, pointing it
+	// at the environment's remote state.
+	envPath := envStatePath(path, env)
+	envLocal := &state.LocalState{Path: envPath}
+	if err := envLocal.RefreshState(); err != nil {
+		return nil, err
+	}
+	envState := envLocal.State()
+	if envState == nil {
+		envState = terraform.NewState()
+	}
+	envState.Remote = envRemote
+
+	cache, err := remoteState(envState, envPath, refresh)
+	if err != nil {
+		return nil, err
+	}
+
+	// A new environment has no remote state yet, and states pushed before
+	// might not carry the remote info. Record it in the cache so that plans
+	// and later runs know where the state of this environment lives.
+	if s := cache.State(); s == nil || s.Remote == nil {
+		if s == nil {
+			s = envState
+		}
+		s.Remote = envRemote
+		if err := cache.WriteState(s); err != nil {
+			return nil, err
+		}
+	}
+
+	return cache, nil
+}
+
+// envStatePath returns the path of the state file of the given
+// non-default environment, based on the path of the default state file.
+func envStatePath(path, env string) string {
+	return filepath.Join(filepath.Dir(path), DefaultEnvDir, env, filepath.Base(path))
+}
@@ -0,0 +1,112 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mitchellh/cli"
+)
+
+// StateSwapCommand is a Command implementation that swaps the state of
+// two modules.
+type StateSwapCommand struct {
+	Meta
+	StateMeta
+}
+
+func (c *StateSwapCommand) Run(args []string) int {
+	args = c.Meta.process(args, true)
+
+	var backupPath string
+	cmdFlags := c.Meta.flagSet("state swap")
+	cmdFlags.StringVar(&backupPath, "backup", "", "backup")
+	cmdFlags.StringVar(&c.Meta.statePath, "state", DefaultStateFilename, "path")
+	if err := cmdFlags.Parse(args); err != nil {
+		return cli.RunResultHelp
+	}
+	args = cmdFlags.Args()
+	if len(args) != 2 {
+		c.Ui.Error("Exactly two arguments expected.\n")
+		return cli.RunResultHelp
+	}
+
+	state, err := c.StateMeta.State(&c.Meta)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf(errStateLoadingState, err))
+		return cli.RunResultHelp
+	}
+
+	stateReal := state.State()
+	if stateReal == nil {
+		c.Ui.Error(fmt.Sprintf(errStateNotFound))
+		return 1
+	}
+
+	if err := stateReal.SwapModules(args[0], args[1]); err != nil {
+		c.Ui.Error(fmt.Sprintf(errStateSwap, err))
+		return 1
+	}
+
+	// The swap is written in a single write of the state, so either both
+	// modules are swapped or neither is.
+	if err := state.WriteState(stateReal); err != nil {
+		c.Ui.Error(fmt.Sprintf(errStateSwapPersist, err))
+		return 1
+	}
+
+	if err := state.PersistState(); err != nil {
+		c.Ui.Error(fmt.Sprintf(errStateSwapPersist, err))
+		return 1
+	}
+
+	c.Ui.Output(fmt.Sprintf("Swapped %s with %s", args[0], args[1]))
+	return 0
+}
+
+func (c *StateSwapCommand) Help() string {
+	helpText := `
+Usage: terraform state swap [options] ADDRESS ADDRESS
+
+  Swap the state of two modules.
+
+  The resources of the first module are bound to the second module and
+  the other way around, along with the resources of their child modules.
+  No infrastructure is created or destroyed.
+
+  This command is useful for blue/green deployments, where two modules with
+  the same configuration trade places, such as "module.blue" and
+  "module.green". Both modules must already exist in the state.
+
+  This command creates a timestamped backup of the state on every invocation.
+  This can't be disabled. Due to the destructive nature of this command,
+  the backup is ensured by Terraform for safety reasons.
+
+Options:
+
+  -backup=PATH        Path where Terraform should write the backup
+                      state. This can't be disabled. If not set, Terraform
+                      will write it to the same path as the statefile with
+                      a backup extension.
+
+  -state=statefile    Path to a Terraform state file to use to look
+                      up Terraform-managed resources. By default it will
+                      use the state "terraform.tfstate" if it exists.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *StateSwapCommand) Synopsis() string {
+	return "Swap the state of two modules"
+}
+
+const errStateSwap = `Error swapping modules: %s
+
+Please ensure both addresses are modules that exist in the state. No
+state was persisted. Your existing state is untouched.`
+
+const errStateSwapPersist = `Error saving the state: %s
+
+The state wasn't saved properly. If the error happening after a partial
+write occurred, a backup file will have been created. Otherwise, the state
+is in the same state it was when the operation started.`
@@ -0,0 +1,8 @@
+resource "test_instance" "foo" {
+    ami = "bar"
+}
+
+resource "test_instance" "bar" {
+    ami = "baz"
+    depends_on = ["test_instance.foo"]
+}
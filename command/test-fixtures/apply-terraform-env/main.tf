@@ -0,0 +1,3 @@
+output "output" {
+    value = "${terraform.env}"
+}
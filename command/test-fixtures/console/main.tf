@@ -0,0 +1,7 @@
+variable "foo" {}
+
+variable "vpc_cidr" {
+  default = "10.0.0.0/16"
+}
+
+resource "test_instance" "foo" {}
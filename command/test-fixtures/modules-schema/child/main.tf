@@ -0,0 +1,9 @@
+variable "tags" {
+  type    = "map"
+  default = {}
+}
+
+output "id" {
+  value = "child"
+  type  = "string"
+}
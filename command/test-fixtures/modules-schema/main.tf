@@ -0,0 +1,17 @@
+variable "region" {
+  description = "The region to deploy into"
+}
+
+variable "zones" {
+  default = ["a", "b"]
+}
+
+module "child" {
+  source = "./child"
+}
+
+output "address" {
+  value       = "${var.region}"
+  description = "The address of the service"
+  sensitive   = true
+}
@@ -0,0 +1,5 @@
+provider "test" {
+  version = "< 2.0.0"
+}
+
+resource "other_instance" "bar" {}
@@ -0,0 +1,9 @@
+provider "test" {
+  version = ">= 1.0.0"
+}
+
+resource "test_instance" "foo" {}
+
+module "child" {
+  source = "./child"
+}
@@ -0,0 +1,11 @@
+variable "password" {
+    source = "secret://db#password"
+}
+
+variable "region" {}
+
+resource "aws_instance" "foo" {}
+
+atlas {
+    name = "foo"
+}
@@ -0,0 +1,7 @@
+resource "test_instance" "foo" {
+    ami = "bar"
+}
+
+output "ami" {
+    value = "${test_instance.foo.ami}"
+}
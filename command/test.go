@@ -0,0 +1,507 @@
+package command
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/hcl"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/config/module"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// testAssertionsExt is the extension of the files that hold the assert
+// blocks of a test case.
+const testAssertionsExt = ".tftest"
+
+// TestCommand is a Command implementation that applies test case
+// configurations, checks assertions against the result and destroys
+// them again.
+type TestCommand struct {
+	Meta
+}
+
+// testAssertions is the contents of a test case's .tftest files.
+type testAssertions struct {
+	Asserts []*testAssert `hcl:"assert"`
+}
+
+// testAssert is a single assert block. It checks either an output of the
+// test case or an attribute of a resource in its state, against either an
+// exact value or a regular expression.
+type testAssert struct {
+	Name      string      `hcl:",key"`
+	Output    string      `hcl:"output"`
+	Resource  string      `hcl:"resource"`
+	Attribute string      `hcl:"attribute"`
+	Equals    interface{} `hcl:"equals"`
+	Matches   string      `hcl:"matches"`
+}
+
+func (c *TestCommand) Run(args []string) int {
+	var destroy bool
+
+	args = c.Meta.process(args, false)
+
+	cmdFlags := c.Meta.flagSet("test")
+	cmdFlags.BoolVar(&destroy, "destroy", true, "destroy")
+	cmdFlags.IntVar(
+		&c.Meta.parallelism, "parallelism", DefaultParallelism, "parallelism")
+	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+
+	dir := "test"
+	args = cmdFlags.Args()
+	if len(args) > 1 {
+		c.Ui.Error("The test command expects at most one argument.")
+		cmdFlags.Usage()
+		return 1
+	} else if len(args) == 1 {
+		dir = args[0]
+	}
+
+	cases, err := testCaseDirs(dir)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error finding test cases: %s", err))
+		return 1
+	}
+	if len(cases) == 0 {
+		c.Ui.Error(fmt.Sprintf(
+			"No test cases found in %s. A test case is a directory with a\n"+
+				"Terraform configuration and one or more %s files.",
+			dir, testAssertionsExt))
+		return 1
+	}
+
+	failed := 0
+	for _, path := range cases {
+		if !c.runCase(path, destroy) {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		c.Ui.Error(c.Colorize().Color(fmt.Sprintf(
+			"\n[reset][bold][red]%d of %d test cases failed.", failed, len(cases))))
+		return 1
+	}
+
+	c.Ui.Output(c.Colorize().Color(fmt.Sprintf(
+		"\n[reset][bold][green]All %d test cases passed.", len(cases))))
+	return 0
+}
+
+// runCase applies the test case in path, checks its assertions and
+// destroys it. It returns true if the test case passed.
+func (c *TestCommand) runCase(path string, destroy bool) bool {
+	c.Ui.Output(c.Colorize().Color(fmt.Sprintf(
+		"[reset][bold]Test case %s:", path)))
+
+	asserts, err := loadTestAssertions(path)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error loading assertions: %s", err))
+		return false
+	}
+
+	// Each test case gets its own data directory and state, so that test
+	// cases never touch the state of the working directory or each other.
+	dataDir, err := ioutil.TempDir("", "tf-test")
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error creating test directory: %s", err))
+		return false
+	}
+	statePath := filepath.Join(dataDir, DefaultStateFilename)
+
+	state, applyErr := c.applyCase(path, dataDir, nil)
+	if state != nil {
+		if err := testWriteState(statePath, state); err != nil {
+			c.Ui.Error(fmt.Sprintf(
+				"Error saving state, resources created by this test case must\n"+
+					"be destroyed manually: %s", err))
+			return false
+		}
+	}
+
+	passed := applyErr == nil
+	if applyErr != nil {
+		c.Ui.Error(fmt.Sprintf("Error applying test case: %s", applyErr))
+	} else {
+		for _, a := range asserts {
+			if err := a.Check(state); err != nil {
+				passed = false
+				c.Ui.Output(c.Colorize().Color(fmt.Sprintf(
+					"  [red]FAIL[reset] %s: %s", a.Name, err)))
+			} else {
+				c.Ui.Output(c.Colorize().Color(fmt.Sprintf(
+					"  [green]PASS[reset] %s", a.Name)))
+			}
+		}
+	}
+
+	if !destroy {
+		c.Ui.Output(fmt.Sprintf("  Not destroying, the state is in %s", statePath))
+		return passed
+	}
+	if state == nil || state.Empty() {
+		os.RemoveAll(dataDir)
+		return passed
+	}
+
+	state, err = c.applyCase(path, dataDir, state)
+	if state != nil {
+		if werr := testWriteState(statePath, state); werr != nil {
+			err = multierror.Append(err, werr)
+		}
+	}
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf(
+			"Error destroying test case, the state is in %s: %s", statePath, err))
+		return false
+	}
+
+	os.RemoveAll(dataDir)
+	return passed
+}
+
+// applyCase applies the configuration of the test case in path. If state
+// is given, the resources in it are destroyed instead.
+func (c *TestCommand) applyCase(
+	path, dataDir string, state *terraform.State) (*terraform.State, error) {
+	mod, err := module.NewTreeModule("", path)
+	if err != nil {
+		return nil, fmt.Errorf("Error loading config: %s", err)
+	}
+	if err := mod.Load(c.moduleStorage(dataDir), module.GetModeGet); err != nil {
+		return nil, fmt.Errorf("Error downloading modules: %s", err)
+	}
+	if err := mod.Validate(); err != nil {
+		return nil, err
+	}
+
+	opts := c.contextOpts()
+	opts.Providers, err = c.providerFactories(mod, false)
+	if err != nil {
+		return nil, err
+	}
+	opts.Module = mod
+	opts.Parallelism = c.parallelism
+	opts.Destroy = state != nil
+	opts.State = state
+	if opts.State == nil {
+		opts.State = terraform.NewState()
+	}
+
+	// Test cases read the terraform.tfvars next to them.
+	varPath := filepath.Join(path, DefaultVarsFilename)
+	if _, err := os.Stat(varPath); err == nil {
+		vs, err := loadKVFile(varPath)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range vs {
+			if _, ok := c.variables[k]; !ok {
+				opts.Variables[k] = v
+			}
+		}
+	}
+
+	ctx, err := terraform.NewContext(opts)
+	if err != nil {
+		return nil, err
+	}
+	if !validateContext(ctx, c.Ui) {
+		return nil, fmt.Errorf("The test case configuration is invalid.")
+	}
+	if _, err := ctx.Plan(); err != nil {
+		return nil, fmt.Errorf("Error creating plan: %s", err)
+	}
+
+	return ctx.Apply()
+}
+
+// Check checks the assertion against the state of an applied test case.
+func (a *testAssert) Check(state *terraform.State) error {
+	var actual interface{}
+	switch {
+	case a.Output != "":
+		output, ok := state.RootModule().Outputs[a.Output]
+		if !ok {
+			return fmt.Errorf("output %q not found", a.Output)
+		}
+		actual = output.Value
+	default:
+		v, err := testResourceAttribute(state, a.Resource, a.Attribute)
+		if err != nil {
+			return err
+		}
+		actual = v
+	}
+
+	if a.Matches != "" {
+		s, ok := actual.(string)
+		if !ok {
+			return fmt.Errorf("%s is a %T, not a string", a.subject(), actual)
+		}
+		re, err := regexp.Compile(a.Matches)
+		if err != nil {
+			return err
+		}
+		if !re.MatchString(s) {
+			return fmt.Errorf("%s is %q, which doesn't match %q", a.subject(), s, a.Matches)
+		}
+		return nil
+	}
+
+	expected := testNormalizeValue(a.Equals)
+	if !reflect.DeepEqual(testNormalizeValue(actual), expected) {
+		return fmt.Errorf("%s is %#v, expected %#v", a.subject(), actual, expected)
+	}
+	return nil
+}
+
+// Validate checks that the assertion is complete.
+func (a *testAssert) Validate() error {
+	if (a.Output == "") == (a.Resource == "") {
+		return fmt.Errorf("assert %q: exactly one of output or resource must be set", a.Name)
+	}
+	if a.Resource != "" && a.Attribute == "" {
+		return fmt.Errorf("assert %q: attribute must be set with resource", a.Name)
+	}
+	if (a.Equals == nil) == (a.Matches == "") {
+		return fmt.Errorf("assert %q: exactly one of equals or matches must be set", a.Name)
+	}
+	if a.Matches != "" {
+		if _, err := regexp.Compile(a.Matches); err != nil {
+			return fmt.Errorf("assert %q: invalid matches: %s", a.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (a *testAssert) subject() string {
+	if a.Output != "" {
+		return fmt.Sprintf("output %q", a.Output)
+	}
+
+	return fmt.Sprintf("%s.%s", a.Resource, a.Attribute)
+}
+
+// testResourceAttribute returns the value of an attribute of the primary
+// instance of the resource at the given address in the state.
+func testResourceAttribute(state *terraform.State, address, attr string) (string, error) {
+	addr, err := terraform.ParseResourceAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	path := append([]string{"root"}, addr.Path...)
+	mod := state.ModuleByPath(path)
+	if mod == nil {
+		return "", fmt.Errorf("resource %s not found", address)
+	}
+
+	key := fmt.Sprintf("%s.%s", addr.Type, addr.Name)
+	if addr.Mode == config.DataResourceMode {
+		key = "data." + key
+	}
+	keys := []string{key, key + ".0"}
+	if addr.Index >= 0 {
+		keys = []string{fmt.Sprintf("%s.%d", key, addr.Index)}
+		if addr.Index == 0 {
+			keys = append(keys, key)
+		}
+	}
+
+	for _, k := range keys {
+		rs, ok := mod.Resources[k]
+		if !ok || rs.Primary == nil {
+			continue
+		}
+
+		if v, ok := rs.Primary.Attributes[attr]; ok {
+			return v, nil
+		}
+		if attr == "id" {
+			return rs.Primary.ID, nil
+		}
+		return "", fmt.Errorf("resource %s has no attribute %q", address, attr)
+	}
+
+	return "", fmt.Errorf("resource %s not found", address)
+}
+
+// testNormalizeValue makes values from the state comparable with values
+// decoded from HCL: scalars become strings and single-element lists of
+// maps, which is how HCL decodes a map, become maps.
+func testNormalizeValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case []map[string]interface{}:
+		if len(v) == 1 {
+			return testNormalizeValue(v[0])
+		}
+		result := make([]interface{}, len(v))
+		for i, m := range v {
+			result[i] = testNormalizeValue(m)
+		}
+		return result
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			result[k] = testNormalizeValue(e)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, e := range v {
+			result[i] = testNormalizeValue(e)
+		}
+		return result
+	case []string:
+		result := make([]interface{}, len(v))
+		for i, e := range v {
+			result[i] = e
+		}
+		return result
+	case map[string]string:
+		result := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			result[k] = e
+		}
+		return result
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// loadTestAssertions loads the assert blocks from the .tftest files in
+// the test case directory.
+func loadTestAssertions(dir string) ([]*testAssert, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*"+testAssertionsExt))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	var result []*testAssert
+	for _, path := range paths {
+		d, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		var f testAssertions
+		if err := hcl.Decode(&f, string(d)); err != nil {
+			return nil, fmt.Errorf("Error parsing %s: %s", path, err)
+		}
+		for _, a := range f.Asserts {
+			if err := a.Validate(); err != nil {
+				return nil, fmt.Errorf("%s: %s", path, err)
+			}
+		}
+
+		result = append(result, f.Asserts...)
+	}
+
+	return result, nil
+}
+
+// testCaseDirs returns the test cases in dir. If dir has .tftest files
+// it is a test case itself, otherwise each subdirectory with .tftest files
+// is a test case.
+func testCaseDirs(dir string) ([]string, error) {
+	isCase := func(path string) (bool, error) {
+		matches, err := filepath.Glob(filepath.Join(path, "*"+testAssertionsExt))
+		return len(matches) > 0, err
+	}
+
+	if ok, err := isCase(dir); err != nil || ok {
+		if ok {
+			return []string{dir}, nil
+		}
+		return nil, err
+	}
+
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []string
+	for _, info := range infos {
+		if !info.IsDir() || strings.HasPrefix(info.Name(), ".") {
+			continue
+		}
+
+		path := filepath.Join(dir, info.Name())
+		ok, err := isCase(path)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			result = append(result, path)
+		}
+	}
+
+	return result, nil
+}
+
+func testWriteState(path string, state *terraform.State) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return terraform.WriteState(state, f)
+}
+
+func (c *TestCommand) Help() string {
+	helpText := `
+Usage: terraform test [options] [DIR]
+
+  Runs the test cases of a module.
+
+  A test case is a directory with a Terraform configuration, which usually
+  calls the module being tested, and one or more .tftest files with assert
+  blocks. Each test case is applied with its own empty state, its assertions
+  are checked against the outputs and resources in that state, and it is
+  then destroyed.
+
+  DIR is a test case or a directory of test cases. It defaults to "test".
+
+Options:
+
+  -destroy=true          Destroy each test case after checking it. Set to
+                         false to keep the resources for debugging.
+
+  -no-color              If specified, output won't contain any color.
+
+  -parallelism=n         Limit the number of concurrent operations.
+                         Defaults to 10.
+
+  -var 'foo=bar'         Set a variable in the test cases. This flag can be
+                         set multiple times.
+
+  -var-file=foo          Set variables in the test cases from a file. Each
+                         test case also reads the "terraform.tfvars" in its
+                         directory.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *TestCommand) Synopsis() string {
+	return "Runs the test cases of a module"
+}
@@ -0,0 +1,183 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mitchellh/cli"
+)
+
+func TestTest(t *testing.T) {
+	p := testTestProvider()
+	ui := new(cli.MockUi)
+	c := &TestCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		testFixturePath("test/passing"),
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	output := ui.OutputWriter.String()
+	for _, s := range []string{"PASS output", "PASS attribute", "All 1 test cases passed"} {
+		if !strings.Contains(output, s) {
+			t.Fatalf("expected %q in output:\n\n%s", s, output)
+		}
+	}
+
+	if !p.ApplyCalled {
+		t.Fatal("apply should be called")
+	}
+	if destroyed := testTestDestroyed(p); !destroyed {
+		t.Fatal("test case should be destroyed")
+	}
+}
+
+func TestTest_dir(t *testing.T) {
+	p := testTestProvider()
+	ui := new(cli.MockUi)
+	c := &TestCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		testFixturePath("test"),
+	}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+
+	output := ui.OutputWriter.String()
+	if !strings.Contains(output, `FAIL output: output "ami" is "bar", expected "baz"`) {
+		t.Fatalf("bad:\n\n%s", output)
+	}
+	if !strings.Contains(ui.ErrorWriter.String(), "2 of 3 test cases failed") {
+		t.Fatalf("bad:\n\n%s", ui.ErrorWriter.String())
+	}
+	if !strings.Contains(ui.ErrorWriter.String(), "exactly one of output or resource") {
+		t.Fatalf("bad:\n\n%s", ui.ErrorWriter.String())
+	}
+}
+
+func TestTest_noCases(t *testing.T) {
+	ui := new(cli.MockUi)
+	c := &TestCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		testFixturePath("apply"),
+	}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+	if !strings.Contains(ui.ErrorWriter.String(), "No test cases found") {
+		t.Fatalf("bad:\n\n%s", ui.ErrorWriter.String())
+	}
+}
+
+func TestTestResourceAttribute(t *testing.T) {
+	state := &terraform.State{
+		Modules: []*terraform.ModuleState{
+			&terraform.ModuleState{
+				Path: []string{"root"},
+				Resources: map[string]*terraform.ResourceState{
+					"test_instance.foo": &terraform.ResourceState{
+						Type: "test_instance",
+						Primary: &terraform.InstanceState{
+							ID:         "foo",
+							Attributes: map[string]string{"ami": "bar"},
+						},
+					},
+				},
+			},
+			&terraform.ModuleState{
+				Path: []string{"root", "child"},
+				Resources: map[string]*terraform.ResourceState{
+					"test_instance.foo.1": &terraform.ResourceState{
+						Type: "test_instance",
+						Primary: &terraform.InstanceState{
+							ID:         "baz",
+							Attributes: map[string]string{"id": "baz"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		Address, Attribute string
+		Value              string
+		Err                bool
+	}{
+		{"test_instance.foo", "ami", "bar", false},
+		{"test_instance.foo", "id", "foo", false},
+		{"test_instance.foo[0]", "ami", "bar", false},
+		{"test_instance.foo", "nope", "", true},
+		{"test_instance.bar", "ami", "", true},
+		{"module.child.test_instance.foo[1]", "id", "baz", false},
+		{"module.child.test_instance.foo", "id", "", true},
+		{"module.other.test_instance.foo", "id", "", true},
+	}
+
+	for _, tc := range cases {
+		v, err := testResourceAttribute(state, tc.Address, tc.Attribute)
+		if (err != nil) != tc.Err {
+			t.Fatalf("%s.%s: err: %s", tc.Address, tc.Attribute, err)
+		}
+		if v != tc.Value {
+			t.Fatalf("%s.%s: expected %q, got %q", tc.Address, tc.Attribute, tc.Value, v)
+		}
+	}
+}
+
+func testTestProvider() *terraform.MockResourceProvider {
+	p := testProvider()
+	p.DiffFn = func(
+		info *terraform.InstanceInfo,
+		s *terraform.InstanceState,
+		c *terraform.ResourceConfig) (*terraform.InstanceDiff, error) {
+		return &terraform.InstanceDiff{
+			Attributes: map[string]*terraform.ResourceAttrDiff{
+				"ami": &terraform.ResourceAttrDiff{
+					New: "bar",
+				},
+			},
+		}, nil
+	}
+	p.ApplyFn = func(
+		info *terraform.InstanceInfo,
+		s *terraform.InstanceState,
+		d *terraform.InstanceDiff) (*terraform.InstanceState, error) {
+		if d.Destroy {
+			return nil, nil
+		}
+
+		return &terraform.InstanceState{
+			ID:         "foo",
+			Attributes: map[string]string{"ami": "bar"},
+		}, nil
+	}
+
+	return p
+}
+
+// testTestDestroyed returns true if the last apply of the provider was a
+// destroy.
+func testTestDestroyed(p *terraform.MockResourceProvider) bool {
+	return p.ApplyDiff != nil && p.ApplyDiff.Destroy
+}
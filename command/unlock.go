@@ -0,0 +1,123 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/state"
+	"github.com/hashicorp/terraform/state/remote"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// UnlockCommand is a cli.Command implementation that manually releases
+// a lock held on the remote state.
+type UnlockCommand struct {
+	Meta
+}
+
+func (c *UnlockCommand) Run(args []string) int {
+	args = c.Meta.process(args, false)
+
+	var force bool
+	cmdFlags := c.Meta.flagSet("force-unlock")
+	cmdFlags.BoolVar(&force, "force", false, "force")
+	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+
+	args = cmdFlags.Args()
+	if len(args) != 1 {
+		c.Ui.Error("The force-unlock command expects exactly one argument: the lock ID.")
+		cmdFlags.Usage()
+		return 1
+	}
+	lockID := args[0]
+
+	// Locks are only supported by remote state, so load the remote state
+	// configured in the data directory.
+	result, err := c.StateRaw(c.StateOpts())
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed to load state: %s", err))
+		return 1
+	}
+
+	if !stateSupportsLocking(result.Remote) {
+		c.Ui.Error("The configured remote state does not support locking.")
+		return 1
+	}
+
+	if !force {
+		desc := "Terraform will remove the lock on the remote state.\n" +
+			"This will allow local Terraform commands to modify this state, even though it\n" +
+			"may still be in use. Only 'yes' will be accepted to confirm."
+
+		v, err := c.UIInput().Input(&terraform.InputOpts{
+			Id:          "force-unlock",
+			Query:       "Do you really want to force-unlock?",
+			Description: desc,
+		})
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error asking for confirmation: %s", err))
+			return 1
+		}
+		if v != "yes" {
+			c.Ui.Output("force-unlock cancelled.")
+			return 1
+		}
+	}
+
+	if err := result.Remote.Unlock(lockID); err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed to unlock state: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(c.Colorize().Color(strings.TrimSpace(outputUnlockSuccess)))
+	return 0
+}
+
+func (c *UnlockCommand) Help() string {
+	helpText := `
+Usage: terraform force-unlock [options] LOCK_ID
+
+  Manually unlock the remote state for the current configuration.
+
+  This will not modify your infrastructure. This command removes the lock on
+  the state, using the LOCK_ID reported by the operation that failed to
+  acquire or release it. This should only be used when an operation was
+  interrupted and the lock was left behind.
+
+Options:
+
+  -force                 Don't ask for input for unlock confirmation.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *UnlockCommand) Synopsis() string {
+	return "Manually unlock the terraform state"
+}
+
+// stateSupportsLocking reports whether the remote state is backed by a
+// client that can be locked.
+func stateSupportsLocking(s *state.CacheState) bool {
+	if s == nil {
+		return false
+	}
+
+	durable, ok := s.Durable.(*remote.State)
+	if !ok {
+		return false
+	}
+
+	_, ok = durable.Client.(remote.ClientLocker)
+	return ok
+}
+
+const outputUnlockSuccess = `
+[reset][bold][green]Terraform state has been successfully unlocked![reset][green]
+
+The state has been unlocked, and Terraform commands should now be able to
+obtain a new lock on the remote state.
+`
@@ -0,0 +1,173 @@
+package command
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/state"
+	"github.com/hashicorp/terraform/state/remote"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mitchellh/cli"
+)
+
+// testRemoteLockClient registers an in-memory remote client that supports
+// locking, and writes a remote state file in the cwd that uses it. Use
+// `testCwd` to change into a temp cwd.
+func testRemoteLockClient(t *testing.T) (*remote.InmemClient, func()) {
+	client := &remote.InmemClient{}
+	remote.BuiltinClients["_inmem_lock"] = func(map[string]string) (remote.Client, error) {
+		return client, nil
+	}
+
+	s := terraform.NewState()
+	s.Remote = &terraform.RemoteState{Type: "_inmem_lock"}
+	testStateFileRemote(t, s)
+
+	var buf bytes.Buffer
+	if err := terraform.WriteState(s, &buf); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := client.Put(buf.Bytes()); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	return client, func() { delete(remote.BuiltinClients, "_inmem_lock") }
+}
+
+func TestUnlock(t *testing.T) {
+	tmp, cwd := testCwd(t)
+	defer testFixCwd(t, tmp, cwd)
+
+	client, cleanup := testRemoteLockClient(t)
+	defer cleanup()
+
+	info := state.NewLockInfo()
+	client.LockInfo = info
+
+	ui := new(cli.MockUi)
+	c := &UnlockCommand{
+		Meta: Meta{
+			Ui: ui,
+		},
+	}
+
+	args := []string{"-force", info.ID}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	if client.LockInfo != nil {
+		t.Fatalf("state still locked: %#v", client.LockInfo)
+	}
+}
+
+func TestUnlock_badID(t *testing.T) {
+	tmp, cwd := testCwd(t)
+	defer testFixCwd(t, tmp, cwd)
+
+	client, cleanup := testRemoteLockClient(t)
+	defer cleanup()
+
+	info := state.NewLockInfo()
+	client.LockInfo = info
+
+	ui := new(cli.MockUi)
+	c := &UnlockCommand{
+		Meta: Meta{
+			Ui: ui,
+		},
+	}
+
+	args := []string{"-force", "not-the-lock-id"}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+
+	if client.LockInfo != info {
+		t.Fatal("state should still be locked")
+	}
+}
+
+func TestUnlock_noRemote(t *testing.T) {
+	tmp, cwd := testCwd(t)
+	defer testFixCwd(t, tmp, cwd)
+
+	ui := new(cli.MockUi)
+	c := &UnlockCommand{
+		Meta: Meta{
+			Ui: ui,
+		},
+	}
+
+	args := []string{"-force", "foo"}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+
+	if !strings.Contains(ui.ErrorWriter.String(), "does not support locking") {
+		t.Fatalf("bad: %s", ui.ErrorWriter.String())
+	}
+}
+
+func TestPlan_stateLocked(t *testing.T) {
+	tmp, cwd := testCwd(t)
+	defer testFixCwd(t, tmp, cwd)
+
+	client, cleanup := testRemoteLockClient(t)
+	defer cleanup()
+
+	client.LockInfo = state.NewLockInfo()
+	client.LockInfo.Operation = "apply"
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &PlanCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{testFixturePath("plan")}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+	if !strings.Contains(ui.ErrorWriter.String(), "Error locking state") {
+		t.Fatalf("bad: %s", ui.ErrorWriter.String())
+	}
+
+	// Planning without the lock succeeds
+	ui = new(cli.MockUi)
+	c.Meta.Ui = ui
+	args = []string{"-lock=false", testFixturePath("plan")}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+}
+
+func TestPlan_stateLockReleased(t *testing.T) {
+	tmp, cwd := testCwd(t)
+	defer testFixCwd(t, tmp, cwd)
+
+	client, cleanup := testRemoteLockClient(t)
+	defer cleanup()
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &PlanCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{testFixturePath("plan")}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	if client.LockInfo != nil {
+		t.Fatalf("lock not released: %#v", client.LockInfo)
+	}
+}
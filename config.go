@@ -8,12 +8,14 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/go-plugin"
 	"github.com/hashicorp/hcl"
 	"github.com/hashicorp/terraform/command"
 	tfplugin "github.com/hashicorp/terraform/plugin"
+	"github.com/hashicorp/terraform/plugin/discovery"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/kardianos/osext"
 	"github.com/mitchellh/cli"
@@ -29,6 +31,10 @@ type Config struct {
 
 	DisableCheckpoint          bool `hcl:"disable_checkpoint"`
 	DisableCheckpointSignature bool `hcl:"disable_checkpoint_signature"`
+
+	// Notifications are sent when an apply or destroy finishes, keyed
+	// by the name of their "notification" block.
+	Notifications map[string]*command.NotificationConfig `hcl:"notification"`
 }
 
 // BuiltinConfig is the built-in defaults for the configuration. These
@@ -38,6 +44,10 @@ var BuiltinConfig Config
 // ContextOpts are the global ContextOpts we use to initialize the CLI.
 var ContextOpts terraform.ContextOpts
 
+// Notifier is the global Notifier shared by the commands. Its destinations
+// are set once the CLI configuration has been loaded.
+var Notifier command.Notifier
+
 // ConfigFile returns the default path to the configuration file.
 //
 // On Unix-like systems this is the ".terraformrc" file in the home directory.
@@ -74,6 +84,13 @@ func LoadConfig(path string) (*Config, error) {
 		return nil, err
 	}
 
+	for name, n := range result.Notifications {
+		n.Name = name
+		if err := n.Validate(); err != nil {
+			return nil, fmt.Errorf("Error in %s: %s", path, err)
+		}
+	}
+
 	return &result, nil
 }
 
@@ -82,7 +99,7 @@ func LoadConfig(path string) (*Config, error) {
 //
 // We look in the following places for plugins:
 //
-// 1. Terraform configuration path
+// 1. Terraform configuration path, then its <OS>_<ARCH> subdirectory
 // 2. Path where Terraform is installed
 // 3. Path where Terraform is invoked
 //
@@ -100,6 +117,13 @@ func (c *Config) Discover(ui cli.Ui) error {
 		if err := c.discover(filepath.Join(dir, "plugins")); err != nil {
 			return err
 		}
+
+		// Plugins built for this platform take precedence, so that a
+		// plugin directory can be shared between machines.
+		machineDir := filepath.Join(dir, "plugins", discovery.CurrentPlatform.String())
+		if err := c.discover(machineDir); err != nil {
+			return err
+		}
 	}
 
 	// Next, look in the same directory as the Terraform executable, usually
@@ -159,6 +183,45 @@ func (c *Config) Discover(ui cli.Ui) error {
 	return nil
 }
 
+// globalPluginDirs returns the directories outside of the working directory
+// that are searched for versioned plugins, in order of preference.
+func globalPluginDirs() []string {
+	var ret []string
+
+	// Look in ~/.terraform.d/plugins/<OS>_<ARCH> and ~/.terraform.d/plugins/
+	// first, then next to the Terraform executable.
+	dir, err := ConfigDir()
+	if err != nil {
+		log.Printf("[ERR] Error finding global config directory: %s", err)
+	} else {
+		ret = append(ret,
+			filepath.Join(dir, "plugins", discovery.CurrentPlatform.String()),
+			filepath.Join(dir, "plugins"))
+	}
+
+	exePath, err := osext.Executable()
+	if err != nil {
+		log.Printf("[ERR] Error loading exe directory: %s", err)
+	} else {
+		ret = append(ret, filepath.Dir(exePath))
+	}
+
+	return ret
+}
+
+// moduleCacheDir returns the directory of the module cache shared by all
+// working directories, ~/.terraform.d/modules. It returns an empty string,
+// which disables the cache, if the directory can't be found.
+func moduleCacheDir() string {
+	dir, err := ConfigDir()
+	if err != nil {
+		log.Printf("[ERR] Error finding global config directory: %s", err)
+		return ""
+	}
+
+	return filepath.Join(dir, "modules")
+}
+
 // Merge merges two configurations and returns a third entirely
 // new configuration with the two merged.
 func (c1 *Config) Merge(c2 *Config) *Config {
@@ -184,9 +247,35 @@ func (c1 *Config) Merge(c2 *Config) *Config {
 		result.Provisioners[k] = v
 	}
 
+	if len(c1.Notifications) > 0 || len(c2.Notifications) > 0 {
+		result.Notifications = make(map[string]*command.NotificationConfig)
+		for k, v := range c1.Notifications {
+			result.Notifications[k] = v
+		}
+		for k, v := range c2.Notifications {
+			result.Notifications[k] = v
+		}
+	}
+
 	return &result
 }
 
+// NotificationConfigs returns the configured notifications sorted by name.
+func (c *Config) NotificationConfigs() []*command.NotificationConfig {
+	names := make([]string, 0, len(c.Notifications))
+	for name := range c.Notifications {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make([]*command.NotificationConfig, len(names))
+	for i, name := range names {
+		result[i] = c.Notifications[name]
+	}
+
+	return result
+}
+
 func (c *Config) discover(path string) error {
 	var err error
 
@@ -224,6 +313,15 @@ func (c *Config) discoverSingle(glob string, m *map[string]string) error {
 
 	for _, match := range matches {
 		file := filepath.Base(match)
+		if !isPluginFile(file) {
+			log.Printf("[DEBUG] Ignoring non-executable plugin file: %s", match)
+			continue
+		}
+
+		if p := discovery.ExecutablePlatform(match); !discovery.CurrentPlatform.Runs(p) {
+			log.Printf("[WARN] Ignoring plugin %s built for %s", match, p)
+			continue
+		}
 
 		// If the filename has a ".", trim up to there
 		if idx := strings.Index(file, "."); idx >= 0 {
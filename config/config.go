@@ -7,8 +7,10 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/hil"
 	"github.com/hashicorp/hil/ast"
 	"github.com/hashicorp/terraform/helper/hilmapstructure"
@@ -53,7 +55,9 @@ type AtlasConfig struct {
 type Module struct {
 	Name      string
 	Source    string
+	RawCount  *RawConfig
 	RawConfig *RawConfig
+	DependsOn []string
 }
 
 // ProviderConfig is the configuration for a resource provider.
@@ -63,6 +67,7 @@ type Module struct {
 type ProviderConfig struct {
 	Name      string
 	Alias     string
+	Version   string
 	RawConfig *RawConfig
 }
 
@@ -71,15 +76,16 @@ type ProviderConfig struct {
 // usual "create, read, update, delete" operations, depending on
 // the given Mode.
 type Resource struct {
-	Mode         ResourceMode // which operations the resource supports
-	Name         string
-	Type         string
-	RawCount     *RawConfig
-	RawConfig    *RawConfig
-	Provisioners []*Provisioner
-	Provider     string
-	DependsOn    []string
-	Lifecycle    ResourceLifecycle
+	Mode           ResourceMode // which operations the resource supports
+	Name           string
+	Type           string
+	RawCount       *RawConfig
+	RawConfig      *RawConfig
+	Provisioners   []*Provisioner
+	Postconditions []*Postcondition
+	Provider       string
+	DependsOn      []string
+	Lifecycle      ResourceLifecycle
 }
 
 // Copy returns a copy of this Resource. Helpful for avoiding shared
@@ -87,19 +93,23 @@ type Resource struct {
 // interpolation.
 func (r *Resource) Copy() *Resource {
 	n := &Resource{
-		Mode:         r.Mode,
-		Name:         r.Name,
-		Type:         r.Type,
-		RawCount:     r.RawCount.Copy(),
-		RawConfig:    r.RawConfig.Copy(),
-		Provisioners: make([]*Provisioner, 0, len(r.Provisioners)),
-		Provider:     r.Provider,
-		DependsOn:    make([]string, len(r.DependsOn)),
-		Lifecycle:    *r.Lifecycle.Copy(),
+		Mode:           r.Mode,
+		Name:           r.Name,
+		Type:           r.Type,
+		RawCount:       r.RawCount.Copy(),
+		RawConfig:      r.RawConfig.Copy(),
+		Provisioners:   make([]*Provisioner, 0, len(r.Provisioners)),
+		Postconditions: make([]*Postcondition, 0, len(r.Postconditions)),
+		Provider:       r.Provider,
+		DependsOn:      make([]string, len(r.DependsOn)),
+		Lifecycle:      *r.Lifecycle.Copy(),
 	}
 	for _, p := range r.Provisioners {
 		n.Provisioners = append(n.Provisioners, p.Copy())
 	}
+	for _, p := range r.Postconditions {
+		n.Postconditions = append(n.Postconditions, p.Copy())
+	}
 	copy(n.DependsOn, r.DependsOn)
 	return n
 }
@@ -110,6 +120,16 @@ type ResourceLifecycle struct {
 	CreateBeforeDestroy bool     `mapstructure:"create_before_destroy"`
 	PreventDestroy      bool     `mapstructure:"prevent_destroy"`
 	IgnoreChanges       []string `mapstructure:"ignore_changes"`
+
+	// Timeout is the maximum duration, as accepted by time.ParseDuration,
+	// that core will wait for a single create, update or destroy of this
+	// resource before giving up on it. An empty string means no limit.
+	Timeout string `mapstructure:"timeout"`
+
+	// AlwaysRefresh makes a data source be read on every refresh, even if
+	// neither its arguments nor its provider's configuration changed since
+	// it was last read. It's only valid for data sources.
+	AlwaysRefresh bool `mapstructure:"always_refresh"`
 }
 
 // Copy returns a copy of this ResourceLifecycle
@@ -118,6 +138,8 @@ func (r *ResourceLifecycle) Copy() *ResourceLifecycle {
 		CreateBeforeDestroy: r.CreateBeforeDestroy,
 		PreventDestroy:      r.PreventDestroy,
 		IgnoreChanges:       make([]string, len(r.IgnoreChanges)),
+		Timeout:             r.Timeout,
+		AlwaysRefresh:       r.AlwaysRefresh,
 	}
 	copy(n.IgnoreChanges, r.IgnoreChanges)
 	return n
@@ -139,22 +161,54 @@ func (p *Provisioner) Copy() *Provisioner {
 	}
 }
 
+// Postcondition is a check on a resource or output that must hold once
+// it has been applied. The "condition" key of the RawConfig must
+// interpolate to true, otherwise the apply fails with the "error_message".
+//
+// For resources, a Timeout can be given. The resource is then refreshed
+// and the condition checked again until it holds or the timeout passes,
+// which allows waiting for infrastructure to become ready.
+type Postcondition struct {
+	RawConfig *RawConfig
+	Timeout   string
+}
+
+// Copy returns a copy of this Postcondition
+func (p *Postcondition) Copy() *Postcondition {
+	return &Postcondition{
+		RawConfig: p.RawConfig.Copy(),
+		Timeout:   p.Timeout,
+	}
+}
+
 // Variable is a variable defined within the configuration.
+//
+// Source is where the value of a root module variable is read from when
+// it isn't set otherwise, such as "vault://secret/db#password". It's empty
+// for variables that don't have one.
 type Variable struct {
 	Name         string
 	DeclaredType string `mapstructure:"type"`
 	Default      interface{}
 	Description  string
+	Source       string
 }
 
 // Output is an output defined within the configuration. An output is
 // resulting data that is highlighted by Terraform when finished. An
 // output marked Sensitive will be output in a masked form following
 // application, but will still be available in state.
+//
+// DeclaredType is the type the output promises to its consumers, such as
+// configurations reading it with terraform_remote_state. It's empty if no
+// type was declared.
 type Output struct {
-	Name      string
-	Sensitive bool
-	RawConfig *RawConfig
+	Name           string
+	Description    string
+	DeclaredType   string
+	Sensitive      bool
+	RawConfig      *RawConfig
+	Postconditions []*Postcondition
 }
 
 // VariableType is the type of value a variable is holding, and returned
@@ -201,6 +255,50 @@ func (r *Module) Id() string {
 	return fmt.Sprintf("%s", r.Name)
 }
 
+// Counted returns true if the module has count set, in which case it is
+// expanded into instances addressed by index.
+func (m *Module) Counted() bool {
+	return m.RawCount != nil
+}
+
+// Count returns the count of this module. Modules without count have a
+// single instance.
+func (m *Module) Count() (int, error) {
+	if m.RawCount == nil {
+		return 1, nil
+	}
+
+	v, err := strconv.ParseInt(m.RawCount.Value().(string), 0, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(v), nil
+}
+
+// ModuleInstanceName returns the name used within module paths for the
+// instance with the given index of a counted module, such as "foo[1]".
+func ModuleInstanceName(name string, index int) string {
+	return fmt.Sprintf("%s[%d]", name, index)
+}
+
+// ParseModuleInstanceName splits a name within a module path into the
+// name of the module and the index of the instance. The index is -1 if the
+// name doesn't refer to an instance of a counted module.
+func ParseModuleInstanceName(s string) (string, int) {
+	idx := strings.LastIndex(s, "[")
+	if idx == -1 || !strings.HasSuffix(s, "]") {
+		return s, -1
+	}
+
+	index, err := strconv.ParseInt(s[idx+1:len(s)-1], 10, 0)
+	if err != nil || index < 0 {
+		return s, -1
+	}
+
+	return s[:idx], int(index)
+}
+
 // Count returns the count of this resource.
 func (r *Resource) Count() (int, error) {
 	v, err := strconv.ParseInt(r.RawCount.Value().(string), 0, 0)
@@ -262,6 +360,18 @@ func (c *Config) Validate() error {
 			return "", nil
 		}
 
+		if v.Source != "" {
+			if v.Default != nil {
+				errs = append(errs, fmt.Errorf(
+					"Variable '%s': cannot have both a default and a source",
+					v.Name))
+			}
+			if _, _, err := v.SourceLocation(); err != nil {
+				errs = append(errs, fmt.Errorf(
+					"Variable '%s': %s", v.Name, err))
+			}
+		}
+
 		w := &interpolationWalker{F: fn}
 		if v.Default != nil {
 			if err := reflectwalk.Walk(v.Default, w); err == nil {
@@ -310,6 +420,13 @@ func (c *Config) Validate() error {
 						source,
 						v.FullKey()))
 				}
+			case *TerraformVariable:
+				if v.Field != "env" {
+					errs = append(errs, fmt.Errorf(
+						"%s: invalid terraform variable: %s",
+						source,
+						v.FullKey()))
+				}
 			}
 		}
 	}
@@ -325,6 +442,14 @@ func (c *Config) Validate() error {
 			continue
 		}
 
+		if p.Version != "" {
+			if _, err := version.NewConstraint(p.Version); err != nil {
+				errs = append(errs, fmt.Errorf(
+					"provider.%s: invalid version constraint %q: %s",
+					name, p.Version, err))
+			}
+		}
+
 		providerSet[name] = struct{}{}
 	}
 
@@ -396,18 +521,59 @@ func (c *Config) Validate() error {
 				m.Id(), k))
 		}
 
-		// Check for invalid count variables
+		// Check for invalid count variables. Instances of counted modules
+		// can use their index.
 		for _, v := range m.RawConfig.Variables {
 			switch v.(type) {
 			case *CountVariable:
-				errs = append(errs, fmt.Errorf(
-					"%s: count variables are only valid within resources", m.Name))
+				if !m.Counted() {
+					errs = append(errs, fmt.Errorf(
+						"%s: count variables are only valid within resources "+
+							"and modules with count", m.Name))
+				}
 			case *SelfVariable:
 				errs = append(errs, fmt.Errorf(
 					"%s: self variables are only valid within resources", m.Name))
 			}
 		}
 
+		// Verify the count. Modules are expanded before any resources are
+		// created, so the count can only depend on variables.
+		if m.Counted() {
+			for _, v := range m.RawCount.Variables {
+				switch v.(type) {
+				case *TerraformVariable:
+					// Good
+				case *UserVariable:
+					// Good
+				default:
+					errs = append(errs, fmt.Errorf(
+						"%s: module count can only reference variables: %s",
+						m.Id(),
+						v.FullKey()))
+				}
+			}
+
+			// Interpolate with a fixed number to verify that its a number.
+			m.RawCount.interpolate(func(root ast.Node) (interface{}, error) {
+				result, err := hil.Eval(
+					hil.FixedValueTransform(
+						root, &ast.LiteralNode{Value: "5", Typex: ast.TypeString}),
+					nil)
+				if err != nil {
+					return "", err
+				}
+
+				return result.Value, nil
+			})
+			if _, err := m.Count(); err != nil {
+				errs = append(errs, fmt.Errorf(
+					"%s: module count must be an integer",
+					m.Id()))
+			}
+			m.RawCount.init()
+		}
+
 		// Update the raw configuration to only contain the string values
 		m.RawConfig, err = NewRawConfig(raw)
 		if err != nil {
@@ -427,11 +593,25 @@ func (c *Config) Validate() error {
 				continue
 			}
 
-			if _, ok := modules[mv.Name]; !ok {
+			m, ok := modules[mv.Name]
+			if !ok {
 				errs = append(errs, fmt.Errorf(
 					"%s: unknown module referenced: %s",
 					source,
 					mv.Name))
+				continue
+			}
+
+			switch {
+			case m.Counted() && !mv.Multi:
+				errs = append(errs, fmt.Errorf(
+					"%s: module %s has count set, reference its outputs with "+
+						"module.%s.*.%s or module.%s.INDEX.%s",
+					source, mv.Name, mv.Name, mv.Field, mv.Name, mv.Field))
+			case !m.Counted() && mv.Multi:
+				errs = append(errs, fmt.Errorf(
+					"%s: module %s doesn't have count set: %s",
+					source, mv.Name, mv.FullKey()))
 			}
 		}
 	}
@@ -454,6 +634,19 @@ func (c *Config) Validate() error {
 	}
 	dupped = nil
 
+	// Verify depends on of modules points to resources and modules that
+	// all exist. This can only be done once all resources are known.
+	for _, m := range c.Modules {
+		for _, d := range m.DependsOn {
+			if d == "module."+m.Name {
+				errs = append(errs, fmt.Errorf(
+					"%s: module can't depend on itself", m.Id()))
+			}
+		}
+
+		errs = append(errs, validateDependsOn(m.Id(), m.DependsOn, resources, modules)...)
+	}
+
 	// Validate resources
 	for n, r := range resources {
 		// Verify count variables
@@ -470,15 +663,21 @@ func (c *Config) Validate() error {
 					n,
 					v.FullKey()))
 			case *ResourceVariable:
-				errs = append(errs, fmt.Errorf(
-					"%s: resource count can't reference resource variable: %s",
-					n,
-					v.FullKey()))
+				// Other resources are fine as long as their values are
+				// known when planning, which is checked at plan time.
+				if v.(*ResourceVariable).ResourceId() == n {
+					errs = append(errs, fmt.Errorf(
+						"%s: resource count can't reference itself: %s",
+						n,
+						v.FullKey()))
+				}
 			case *SimpleVariable:
 				errs = append(errs, fmt.Errorf(
 					"%s: resource count can't reference variable: %s",
 					n,
 					v.FullKey()))
+			case *TerraformVariable:
+				// Good
 			case *UserVariable:
 				// Good
 			default:
@@ -508,25 +707,8 @@ func (c *Config) Validate() error {
 		}
 		r.RawCount.init()
 
-		// Verify depends on points to resources that all exist
-		for _, d := range r.DependsOn {
-			// Check if we contain interpolations
-			rc, err := NewRawConfig(map[string]interface{}{
-				"value": d,
-			})
-			if err == nil && len(rc.Variables) > 0 {
-				errs = append(errs, fmt.Errorf(
-					"%s: depends on value cannot contain interpolations: %s",
-					n, d))
-				continue
-			}
-
-			if _, ok := resources[d]; !ok {
-				errs = append(errs, fmt.Errorf(
-					"%s: resource depends on non-existent resource '%s'",
-					n, d))
-			}
-		}
+		// Verify depends on points to resources and modules that all exist
+		errs = append(errs, validateDependsOn(n, r.DependsOn, resources, modules)...)
 
 		// Verify provider points to a provider that is configured
 		if r.Provider != "" {
@@ -579,6 +761,21 @@ func (c *Config) Validate() error {
 						"together with a wildcard: %s", n, v))
 			}
 		}
+
+		// Verify the timeout is a valid, positive duration
+		if r.Lifecycle.Timeout != "" {
+			d, err := time.ParseDuration(r.Lifecycle.Timeout)
+			if err != nil {
+				errs = append(errs, fmt.Errorf(
+					"%s: timeout must be a valid duration such as \"30m\": %s",
+					n, err))
+			} else if d <= 0 {
+				errs = append(errs, fmt.Errorf(
+					"%s: timeout must be greater than zero", n))
+			}
+		}
+
+		errs = append(errs, validatePostconditions(n, r.Postconditions, true)...)
 	}
 
 	for source, vs := range vars {
@@ -645,12 +842,20 @@ func (c *Config) Validate() error {
 					"%s: output is missing required 'value' key", o.Name))
 			}
 
+			if o.DeclaredType != "" {
+				if err := c.validateOutputType(o); err != nil {
+					errs = append(errs, err)
+				}
+			}
+
 			for _, v := range o.RawConfig.Variables {
 				if _, ok := v.(*CountVariable); ok {
 					errs = append(errs, fmt.Errorf(
 						"%s: count variables are only valid within resources", o.Name))
 				}
 			}
+
+			errs = append(errs, validatePostconditions(o.Name, o.Postconditions, false)...)
 		}
 	}
 
@@ -673,6 +878,12 @@ func (c *Config) Validate() error {
 			continue
 		}
 
+		// Resource postconditions can refer to the resource itself
+		if strings.HasPrefix(source, "resource") &&
+			strings.Contains(source, "postcondition") {
+			continue
+		}
+
 		for _, v := range rc.Variables {
 			if _, ok := v.(*SelfVariable); ok {
 				errs = append(errs, fmt.Errorf(
@@ -688,6 +899,97 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+// validateOutputType checks that the declared type of the output is known,
+// and that its value is of that type when that can be told without
+// evaluating it: when it's a literal, or a single reference to a variable.
+func (c *Config) validateOutputType(o *Output) error {
+	declaredType, ok := typeStringMap[o.DeclaredType]
+	if !ok {
+		return fmt.Errorf(
+			"%s: output type must be string, list or map - '%s' is not a valid type",
+			o.Name, o.DeclaredType)
+	}
+
+	actual := VariableTypeUnknown
+	switch v := o.RawConfig.Raw["value"].(type) {
+	case []interface{}:
+		actual = VariableTypeList
+	case map[string]interface{}, []map[string]interface{}:
+		actual = VariableTypeMap
+	case string:
+		if len(o.RawConfig.Variables) == 0 {
+			actual = VariableTypeString
+			break
+		}
+
+		// A value of just "${var.foo}" has the type of the variable
+		if len(o.RawConfig.Variables) != 1 {
+			break
+		}
+		for _, rawV := range o.RawConfig.Variables {
+			uv, ok := rawV.(*UserVariable)
+			if !ok || uv.Elem != "" || v != fmt.Sprintf("${%s}", uv.FullKey()) {
+				break
+			}
+			for _, variable := range c.Variables {
+				if variable.Name == uv.Name {
+					actual = variable.Type()
+				}
+			}
+		}
+	}
+
+	if actual != VariableTypeUnknown && actual != declaredType {
+		return fmt.Errorf(
+			"%s: output is declared as type %s, but its value is of type %s",
+			o.Name, o.DeclaredType, actual.Printable())
+	}
+
+	return nil
+}
+
+// validatePostconditions validates the postconditions of the resource or
+// output with the given name. Timeouts are only allowed on resources.
+func validatePostconditions(n string, ps []*Postcondition, allowTimeout bool) []error {
+	var errs []error
+	for i, p := range ps {
+		for k := range p.RawConfig.Raw {
+			if k != "condition" && k != "error_message" {
+				errs = append(errs, fmt.Errorf(
+					"%s: postcondition #%d has invalid key: %s", n, i+1, k))
+			}
+		}
+
+		if _, ok := p.RawConfig.Raw["condition"]; !ok {
+			errs = append(errs, fmt.Errorf(
+				"%s: postcondition #%d is missing required 'condition' key", n, i+1))
+		}
+
+		if p.Timeout == "" {
+			continue
+		}
+
+		if !allowTimeout {
+			errs = append(errs, fmt.Errorf(
+				"%s: postcondition #%d: timeout is only valid in resource postconditions",
+				n, i+1))
+			continue
+		}
+
+		d, err := time.ParseDuration(p.Timeout)
+		if err != nil {
+			errs = append(errs, fmt.Errorf(
+				"%s: postcondition #%d: timeout must be a valid duration such as \"5m\": %s",
+				n, i+1, err))
+		} else if d <= 0 {
+			errs = append(errs, fmt.Errorf(
+				"%s: postcondition #%d: timeout must be greater than zero", n, i+1))
+		}
+	}
+
+	return errs
+}
+
 // InterpolatedVariables is a helper that returns a mapping of all the interpolated
 // variables within the configuration. This is used to verify references
 // are valid in the Validate step.
@@ -708,6 +1010,9 @@ func (c *Config) rawConfigs() map[string]*RawConfig {
 	for _, m := range c.Modules {
 		source := fmt.Sprintf("module '%s'", m.Name)
 		result[source] = m.RawConfig
+		if m.Counted() {
+			result[source+" count"] = m.RawCount
+		}
 	}
 
 	for _, pc := range c.ProviderConfigs {
@@ -726,11 +1031,21 @@ func (c *Config) rawConfigs() map[string]*RawConfig {
 				source, p.Type, i+1)
 			result[subsource] = p.RawConfig
 		}
+
+		for i, p := range rc.Postconditions {
+			subsource := fmt.Sprintf("%s postcondition (#%d)", source, i+1)
+			result[subsource] = p.RawConfig
+		}
 	}
 
 	for _, o := range c.Outputs {
 		source := fmt.Sprintf("output '%s'", o.Name)
 		result[source] = o.RawConfig
+
+		for i, p := range o.Postconditions {
+			subsource := fmt.Sprintf("%s postcondition (#%d)", source, i+1)
+			result[subsource] = p.RawConfig
+		}
 	}
 
 	return result
@@ -773,12 +1088,17 @@ func (c *Config) validateVarContextFn(
 		}
 
 		for _, v := range vars {
-			rv, ok := v.(*ResourceVariable)
-			if !ok {
+			splat := false
+			switch v := v.(type) {
+			case *ResourceVariable:
+				splat = v.Multi && v.Index == -1
+			case *ModuleVariable:
+				splat = v.Multi && v.Index == -1
+			default:
 				return
 			}
 
-			if rv.Multi && rv.Index == -1 {
+			if splat {
 				*errs = append(*errs, fmt.Errorf(
 					"%s: use of the splat ('*') operator must be wrapped in a list declaration",
 					source))
@@ -787,6 +1107,45 @@ func (c *Config) validateVarContextFn(
 	}
 }
 
+// validateDependsOn verifies that the depends_on values of the resource or
+// module named n reference resources or whole modules that exist.
+func validateDependsOn(
+	n string,
+	dependsOn []string,
+	resources map[string]*Resource,
+	modules map[string]*Module) []error {
+	var errs []error
+	for _, d := range dependsOn {
+		// Check if we contain interpolations
+		rc, err := NewRawConfig(map[string]interface{}{
+			"value": d,
+		})
+		if err == nil && len(rc.Variables) > 0 {
+			errs = append(errs, fmt.Errorf(
+				"%s: depends on value cannot contain interpolations: %s",
+				n, d))
+			continue
+		}
+
+		if strings.HasPrefix(d, "module.") {
+			if _, ok := modules[d[len("module."):]]; !ok {
+				errs = append(errs, fmt.Errorf(
+					"%s: depends on non-existent module '%s'",
+					n, d))
+			}
+			continue
+		}
+
+		if _, ok := resources[d]; !ok {
+			errs = append(errs, fmt.Errorf(
+				"%s: resource depends on non-existent resource '%s'",
+				n, d))
+		}
+	}
+
+	return errs
+}
+
 func (m *Module) mergerName() string {
 	return m.Id()
 }
@@ -802,6 +1161,14 @@ func (m *Module) mergerMerge(other merger) merger {
 		result.Source = m2.Source
 	}
 
+	if m2.RawCount != nil {
+		result.RawCount = m2.RawCount
+	}
+
+	if len(m2.DependsOn) > 0 {
+		result.DependsOn = m2.DependsOn
+	}
+
 	return &result
 }
 
@@ -816,6 +1183,16 @@ func (o *Output) mergerMerge(m merger) merger {
 	result.Name = o2.Name
 	result.RawConfig = result.RawConfig.merge(o2.RawConfig)
 
+	if len(o2.Postconditions) > 0 {
+		result.Postconditions = o2.Postconditions
+	}
+	if o2.Description != "" {
+		result.Description = o2.Description
+	}
+	if o2.DeclaredType != "" {
+		result.DeclaredType = o2.DeclaredType
+	}
+
 	return &result
 }
 
@@ -841,6 +1218,9 @@ func (c *ProviderConfig) mergerMerge(m merger) merger {
 	result := *c
 	result.Name = c2.Name
 	result.RawConfig = result.RawConfig.merge(c2.RawConfig)
+	if c2.Version != "" {
+		result.Version = c2.Version
+	}
 
 	return &result
 }
@@ -866,6 +1246,10 @@ func (r *Resource) mergerMerge(m merger) merger {
 		result.Provisioners = r2.Provisioners
 	}
 
+	if len(r2.Postconditions) > 0 {
+		result.Postconditions = r2.Postconditions
+	}
+
 	return &result
 }
 
@@ -883,10 +1267,26 @@ func (v *Variable) Merge(v2 *Variable) *Variable {
 	if v2.Description != "" {
 		result.Description = v2.Description
 	}
+	if v2.Source != "" {
+		result.Source = v2.Source
+	}
 
 	return &result
 }
 
+// SourceLocation splits the source of the variable into the type of the
+// source and the location of the value within it: "vault://secret/db"
+// is the location "secret/db" of the "vault" source.
+func (v *Variable) SourceLocation() (string, string, error) {
+	idx := strings.Index(v.Source, "://")
+	if idx <= 0 || idx+3 == len(v.Source) {
+		return "", "", fmt.Errorf(
+			"source must have the form TYPE://LOCATION, got %q", v.Source)
+	}
+
+	return v.Source[:idx], v.Source[idx+3:], nil
+}
+
 var typeStringMap = map[string]VariableType{
 	"string": VariableTypeString,
 	"map":    VariableTypeMap,
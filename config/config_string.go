@@ -76,10 +76,20 @@ func modulesStr(ms []*Module) string {
 		sort.Strings(ks)
 
 		result += fmt.Sprintf("  source = %s\n", m.Source)
+		if m.Counted() {
+			result += fmt.Sprintf("  count = %s\n", m.RawCount.Value())
+		}
 
 		for _, k := range ks {
 			result += fmt.Sprintf("  %s\n", k)
 		}
+
+		if len(m.DependsOn) > 0 {
+			result += fmt.Sprintf("  dependsOn\n")
+			for _, d := range m.DependsOn {
+				result += fmt.Sprintf("    %s\n", d)
+			}
+		}
 	}
 
 	return strings.TrimSpace(result)
@@ -144,6 +144,27 @@ func TestConfigValidate_badDependsOn(t *testing.T) {
 	}
 }
 
+func TestConfigValidate_dependsOnModule(t *testing.T) {
+	c := testConfig(t, "validate-depends-on-module")
+	if err := c.Validate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestConfigValidate_dependsOnModuleBad(t *testing.T) {
+	c := testConfig(t, "validate-depends-on-module-bad")
+	if err := c.Validate(); err == nil {
+		t.Fatal("should not be valid")
+	}
+}
+
+func TestConfigValidate_dependsOnModuleSelf(t *testing.T) {
+	c := testConfig(t, "validate-depends-on-module-self")
+	if err := c.Validate(); err == nil {
+		t.Fatal("should not be valid")
+	}
+}
+
 func TestConfigValidate_countInt(t *testing.T) {
 	c := testConfig(t, "validate-count-int")
 	if err := c.Validate(); err != nil {
@@ -190,13 +211,20 @@ func TestConfigValidate_countNotInt(t *testing.T) {
 
 func TestConfigValidate_countResourceVar(t *testing.T) {
 	c := testConfig(t, "validate-count-resource-var")
-	if err := c.Validate(); err == nil {
-		t.Fatal("should not be valid")
+	if err := c.Validate(); err != nil {
+		t.Fatalf("err: %s", err)
 	}
 }
 
 func TestConfigValidate_countResourceVarMulti(t *testing.T) {
 	c := testConfig(t, "validate-count-resource-var-multi")
+	if err := c.Validate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestConfigValidate_countResourceVarSelf(t *testing.T) {
+	c := testConfig(t, "validate-count-resource-var-self")
 	if err := c.Validate(); err == nil {
 		t.Fatal("should not be valid")
 	}
@@ -265,6 +293,59 @@ func TestConfigValidate_ignoreChangesBad(t *testing.T) {
 	}
 }
 
+func TestConfigValidate_lifecycleTimeout(t *testing.T) {
+	c := testConfig(t, "validate-lifecycle-timeout")
+	if err := c.Validate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if v := c.Resources[0].Lifecycle.Timeout; v != "30m" {
+		t.Fatalf("bad: %q", v)
+	}
+}
+
+func TestConfigValidate_lifecycleTimeoutBad(t *testing.T) {
+	c := testConfig(t, "validate-lifecycle-timeout-bad")
+	if err := c.Validate(); err == nil {
+		t.Fatal("should not be valid")
+	}
+}
+
+func TestConfigValidate_postcondition(t *testing.T) {
+	c := testConfig(t, "validate-postcondition")
+	if err := c.Validate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestConfigValidate_postconditionBad(t *testing.T) {
+	c := testConfig(t, "validate-postcondition-bad")
+	err := c.Validate()
+	if err == nil {
+		t.Fatal("should not be valid")
+	}
+	if !strings.Contains(err.Error(), "missing required 'condition' key") {
+		t.Fatalf("bad: %s", err)
+	}
+	if !strings.Contains(err.Error(), "timeout must be a valid duration") {
+		t.Fatalf("bad: %s", err)
+	}
+}
+
+func TestConfigValidate_postconditionOutputTimeout(t *testing.T) {
+	c := testConfig(t, "validate-postcondition-output-timeout")
+	err := c.Validate()
+	if err == nil {
+		t.Fatal("should not be valid")
+	}
+	if !strings.Contains(err.Error(), "timeout is only valid in resource postconditions") {
+		t.Fatalf("bad: %s", err)
+	}
+	if !strings.Contains(err.Error(), "cannot contain self-reference") {
+		t.Fatalf("bad: %s", err)
+	}
+}
+
 func TestConfigValidate_moduleNameBad(t *testing.T) {
 	c := testConfig(t, "validate-module-name-bad")
 	if err := c.Validate(); err == nil {
@@ -307,6 +388,48 @@ func TestConfigValidate_moduleVarSelf(t *testing.T) {
 	}
 }
 
+func TestConfigValidate_moduleCount(t *testing.T) {
+	c := testConfig(t, "validate-module-count")
+	if err := c.Validate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestConfigValidate_moduleCountNotInt(t *testing.T) {
+	c := testConfig(t, "validate-module-count-not-int")
+	if err := c.Validate(); err == nil {
+		t.Fatal("should not be valid")
+	}
+}
+
+func TestConfigValidate_moduleCountResourceVar(t *testing.T) {
+	c := testConfig(t, "validate-module-count-resource-var")
+	if err := c.Validate(); err == nil {
+		t.Fatal("should not be valid")
+	}
+}
+
+func TestConfigValidate_moduleCountVarPlain(t *testing.T) {
+	c := testConfig(t, "validate-module-count-var-plain")
+	if err := c.Validate(); err == nil {
+		t.Fatal("should not be valid")
+	}
+}
+
+func TestConfigValidate_moduleCountVarUncounted(t *testing.T) {
+	c := testConfig(t, "validate-module-count-var-uncounted")
+	err := c.Validate()
+	if err == nil {
+		t.Fatal("should not be valid")
+	}
+
+	for _, expected := range []string{"modules with count", "doesn't have count set"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Fatalf("expected error to contain %q, got: %s", expected, err)
+		}
+	}
+}
+
 func TestConfigValidate_nil(t *testing.T) {
 	var c Config
 	if err := c.Validate(); err != nil {
@@ -328,6 +451,64 @@ func TestConfigValidate_outputDuplicate(t *testing.T) {
 	}
 }
 
+func TestConfigValidate_outputType(t *testing.T) {
+	c := testConfig(t, "validate-output-type")
+	if err := c.Validate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestConfigValidate_outputTypeBad(t *testing.T) {
+	c := testConfig(t, "validate-output-type-bad")
+	if err := c.Validate(); err == nil {
+		t.Fatal("should not be valid")
+	}
+}
+
+func TestConfigValidate_outputTypeMismatch(t *testing.T) {
+	c := testConfig(t, "validate-output-type-mismatch")
+	if err := c.Validate(); err == nil {
+		t.Fatal("should not be valid")
+	}
+}
+
+func TestConfigValidate_outputTypeVarMismatch(t *testing.T) {
+	c := testConfig(t, "validate-output-type-var-mismatch")
+	if err := c.Validate(); err == nil {
+		t.Fatal("should not be valid")
+	}
+}
+
+func TestConfigValidate_varSource(t *testing.T) {
+	c := testConfig(t, "validate-var-source")
+	if err := c.Validate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	v := c.Variables[0]
+	typ, location, err := v.SourceLocation()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if typ != "vault" || location != "secret/db#password" {
+		t.Fatalf("bad: %q %q", typ, location)
+	}
+}
+
+func TestConfigValidate_varSourceDefault(t *testing.T) {
+	c := testConfig(t, "validate-var-source-default")
+	if err := c.Validate(); err == nil {
+		t.Fatal("should not be valid")
+	}
+}
+
+func TestConfigValidate_varSourceBad(t *testing.T) {
+	c := testConfig(t, "validate-var-source-bad")
+	if err := c.Validate(); err == nil {
+		t.Fatal("should not be valid")
+	}
+}
+
 func TestConfigValidate_pathVar(t *testing.T) {
 	c := testConfig(t, "validate-path-var")
 	if err := c.Validate(); err != nil {
@@ -342,6 +523,20 @@ func TestConfigValidate_pathVarInvalid(t *testing.T) {
 	}
 }
 
+func TestConfigValidate_terraformVar(t *testing.T) {
+	c := testConfig(t, "validate-terraform-var")
+	if err := c.Validate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestConfigValidate_terraformVarInvalid(t *testing.T) {
+	c := testConfig(t, "validate-terraform-var-invalid")
+	if err := c.Validate(); err == nil {
+		t.Fatal("should not be valid")
+	}
+}
+
 func TestConfigValidate_providerMulti(t *testing.T) {
 	c := testConfig(t, "validate-provider-multi")
 	if err := c.Validate(); err == nil {
@@ -370,6 +565,20 @@ func TestConfigValidate_providerMultiRefBad(t *testing.T) {
 	}
 }
 
+func TestConfigValidate_providerVersionGood(t *testing.T) {
+	c := testConfig(t, "validate-provider-version-good")
+	if err := c.Validate(); err != nil {
+		t.Fatalf("should be valid: %s", err)
+	}
+}
+
+func TestConfigValidate_providerVersionInvalid(t *testing.T) {
+	c := testConfig(t, "validate-provider-version-invalid")
+	if err := c.Validate(); err == nil {
+		t.Fatal("should not be valid")
+	}
+}
+
 func TestConfigValidate_provConnSplatOther(t *testing.T) {
 	c := testConfig(t, "validate-prov-conn-splat-other")
 	if err := c.Validate(); err != nil {
@@ -517,6 +726,33 @@ func TestConfigValidate_varModuleInvalid(t *testing.T) {
 	}
 }
 
+func TestParseModuleInstanceName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Name  string
+		Index int
+	}{
+		{"foo", "foo", -1},
+		{"foo[0]", "foo", 0},
+		{"foo[12]", "foo", 12},
+		{"foo[*]", "foo[*]", -1},
+		{"foo[-1]", "foo[-1]", -1},
+	}
+
+	for _, tc := range cases {
+		name, index := ParseModuleInstanceName(tc.Input)
+		if name != tc.Name || index != tc.Index {
+			t.Fatalf("%s: bad: %s %d", tc.Input, name, index)
+		}
+
+		if tc.Index != -1 {
+			if actual := ModuleInstanceName(name, index); actual != tc.Input {
+				t.Fatalf("%s: bad: %s", tc.Input, actual)
+			}
+		}
+	}
+}
+
 func TestNameRegexp(t *testing.T) {
 	cases := []struct {
 		Input string
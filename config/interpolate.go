@@ -36,7 +36,11 @@ const (
 type ModuleVariable struct {
 	Name  string
 	Field string
-	key   string
+
+	Multi bool // True if multi-variable: module.foo.*.output
+	Index int  // Index for multi-variable: module.foo.1.output == 1
+
+	key string
 }
 
 // A PathVariable is a variable that references path information about the
@@ -77,6 +81,13 @@ type SelfVariable struct {
 	key string
 }
 
+// TerraformVariable is a "terraform."-prefixed variable used to access
+// metadata about the Terraform run, such as "${terraform.env}".
+type TerraformVariable struct {
+	Field string
+	key   string
+}
+
 // SimpleVariable is an unprefixed variable, which can show up when users have
 // strings they are passing down to resources that use interpolation
 // internally. The template_file resource is an example of this.
@@ -101,6 +112,8 @@ func NewInterpolatedVariable(v string) (InterpolatedVariable, error) {
 		return NewPathVariable(v)
 	} else if strings.HasPrefix(v, "self.") {
 		return NewSelfVariable(v)
+	} else if strings.HasPrefix(v, "terraform.") {
+		return NewTerraformVariable(v)
 	} else if strings.HasPrefix(v, "var.") {
 		return NewUserVariable(v)
 	} else if strings.HasPrefix(v, "module.") {
@@ -138,9 +151,35 @@ func NewModuleVariable(key string) (*ModuleVariable, error) {
 			key)
 	}
 
+	field := parts[2]
+	multi := false
+	index := -1
+
+	// Instances of counted modules are referenced the same way as
+	// instances of counted resources: module.foo.*.output or
+	// module.foo.1.output.
+	if idx := strings.Index(field, "."); idx != -1 {
+		indexStr := field[:idx]
+		multi = indexStr == "*"
+
+		if !multi {
+			indexInt, err := strconv.ParseInt(indexStr, 0, 0)
+			if err == nil {
+				multi = true
+				index = int(indexInt)
+			}
+		}
+
+		if multi {
+			field = field[idx+1:]
+		}
+	}
+
 	return &ModuleVariable{
 		Name:  parts[1],
-		Field: parts[2],
+		Field: field,
+		Multi: multi,
+		Index: index,
 		key:   key,
 	}, nil
 }
@@ -262,6 +301,22 @@ func (v *SelfVariable) GoString() string {
 	return fmt.Sprintf("*%#v", *v)
 }
 
+func NewTerraformVariable(key string) (*TerraformVariable, error) {
+	field := key[len("terraform."):]
+	return &TerraformVariable{
+		Field: field,
+		key:   key,
+	}, nil
+}
+
+func (v *TerraformVariable) FullKey() string {
+	return v.key
+}
+
+func (v *TerraformVariable) GoString() string {
+	return fmt.Sprintf("*%#v", *v)
+}
+
 func NewSimpleVariable(key string) (*SimpleVariable, error) {
 	return &SimpleVariable{key}, nil
 }
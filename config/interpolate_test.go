@@ -27,10 +27,33 @@ func TestNewInterpolatedVariable(t *testing.T) {
 			&ModuleVariable{
 				Name:  "foo",
 				Field: "bar",
+				Index: -1,
 				key:   "module.foo.bar",
 			},
 			false,
 		},
+		{
+			"module.foo.*.bar",
+			&ModuleVariable{
+				Name:  "foo",
+				Field: "bar",
+				Multi: true,
+				Index: -1,
+				key:   "module.foo.*.bar",
+			},
+			false,
+		},
+		{
+			"module.foo.2.bar",
+			&ModuleVariable{
+				Name:  "foo",
+				Field: "bar",
+				Multi: true,
+				Index: 2,
+				key:   "module.foo.2.bar",
+			},
+			false,
+		},
 		{
 			"count.index",
 			&CountVariable{
@@ -63,6 +86,14 @@ func TestNewInterpolatedVariable(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"terraform.env",
+			&TerraformVariable{
+				Field: "env",
+				key:   "terraform.env",
+			},
+			false,
+		},
 	}
 
 	for i, tc := range cases {
@@ -33,6 +33,7 @@ func (t *hclConfigurable) Config() (*Config, error) {
 		Default      interface{}
 		Description  string
 		DeclaredType string   `hcl:"type"`
+		Source       string
 		Fields       []string `hcl:",decodedFields"`
 	}
 
@@ -77,6 +78,7 @@ func (t *hclConfigurable) Config() (*Config, error) {
 				DeclaredType: v.DeclaredType,
 				Default:      v.Default,
 				Description:  v.Description,
+				Source:       v.Source,
 			}
 
 			if err := newVar.ValidateTypeAndDefault(); err != nil {
@@ -288,6 +290,8 @@ func loadModulesHcl(list *ast.ObjectList) ([]*Module, error) {
 
 		// Remove the fields we handle specially
 		delete(config, "source")
+		delete(config, "count")
+		delete(config, "depends_on")
 
 		rawConfig, err := NewRawConfig(config)
 		if err != nil {
@@ -297,6 +301,28 @@ func loadModulesHcl(list *ast.ObjectList) ([]*Module, error) {
 				err)
 		}
 
+		// If we have a count, then figure it out. Unlike resources, a
+		// module without count isn't expanded into instances at all.
+		var countConfig *RawConfig
+		if o := listVal.Filter("count"); len(o.Items) > 0 {
+			var count string
+			err = hcl.DecodeObject(&count, o.Items[0].Val)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Error parsing count for %s: %s",
+					k,
+					err)
+			}
+
+			countConfig, err = NewRawConfig(map[string]interface{}{
+				"count": count,
+			})
+			if err != nil {
+				return nil, err
+			}
+			countConfig.Key = "count"
+		}
+
 		// If we have a count, then figure it out
 		var source string
 		if o := listVal.Filter("source"); len(o.Items) > 0 {
@@ -309,10 +335,24 @@ func loadModulesHcl(list *ast.ObjectList) ([]*Module, error) {
 			}
 		}
 
+		// If we have depends fields, then add those in
+		var dependsOn []string
+		if o := listVal.Filter("depends_on"); len(o.Items) > 0 {
+			err := hcl.DecodeObject(&dependsOn, o.Items[0].Val)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Error reading depends_on for %s: %s",
+					k,
+					err)
+			}
+		}
+
 		result = append(result, &Module{
 			Name:      k,
 			Source:    source,
+			RawCount:  countConfig,
 			RawConfig: rawConfig,
+			DependsOn: dependsOn,
 		})
 	}
 
@@ -337,6 +377,32 @@ func loadOutputsHcl(list *ast.ObjectList) ([]*Output, error) {
 			return nil, err
 		}
 
+		// Postconditions are handled separately
+		delete(config, "postcondition")
+
+		// The description is documentation, not something to interpolate
+		var description string
+		if v, ok := config["description"]; ok {
+			description, ok = v.(string)
+			if !ok {
+				return nil, fmt.Errorf(
+					"output %s: description must be a string", n)
+			}
+			delete(config, "description")
+		}
+
+		// The type is a contract with consumers of the output, and is
+		// checked against the value rather than interpolated
+		var declaredType string
+		if v, ok := config["type"]; ok {
+			declaredType, ok = v.(string)
+			if !ok {
+				return nil, fmt.Errorf(
+					"output %s: type must be a string", n)
+			}
+			delete(config, "type")
+		}
+
 		rawConfig, err := NewRawConfig(config)
 		if err != nil {
 			return nil, fmt.Errorf(
@@ -345,9 +411,25 @@ func loadOutputsHcl(list *ast.ObjectList) ([]*Output, error) {
 				err)
 		}
 
+		var postconditions []*Postcondition
+		if ot, ok := item.Val.(*ast.ObjectType); ok {
+			if o := ot.List.Filter("postcondition"); len(o.Items) > 0 {
+				postconditions, err = loadPostconditionsHcl(o)
+				if err != nil {
+					return nil, fmt.Errorf(
+						"Error reading postconditions for output %s: %s",
+						n,
+						err)
+				}
+			}
+		}
+
 		result = append(result, &Output{
-			Name:      n,
-			RawConfig: rawConfig,
+			Name:           n,
+			Description:    description,
+			DeclaredType:   declaredType,
+			RawConfig:      rawConfig,
+			Postconditions: postconditions,
 		})
 	}
 
@@ -380,6 +462,7 @@ func loadProvidersHcl(list *ast.ObjectList) ([]*ProviderConfig, error) {
 		}
 
 		delete(config, "alias")
+		delete(config, "version")
 
 		rawConfig, err := NewRawConfig(config)
 		if err != nil {
@@ -401,9 +484,22 @@ func loadProvidersHcl(list *ast.ObjectList) ([]*ProviderConfig, error) {
 			}
 		}
 
+		// If we have a version field then extract it
+		var version string
+		if a := listVal.Filter("version"); len(a.Items) > 0 {
+			err := hcl.DecodeObject(&version, a.Items[0].Val)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Error reading version for provider[%s]: %s",
+					n,
+					err)
+			}
+		}
+
 		result = append(result, &ProviderConfig{
 			Name:      n,
 			Alias:     alias,
+			Version:   version,
 			RawConfig: rawConfig,
 		})
 	}
@@ -458,6 +554,7 @@ func loadDataResourcesHcl(list *ast.ObjectList) ([]*Resource, error) {
 		delete(config, "depends_on")
 		delete(config, "provider")
 		delete(config, "count")
+		delete(config, "lifecycle")
 
 		rawConfig, err := NewRawConfig(config)
 		if err != nil {
@@ -514,6 +611,34 @@ func loadDataResourcesHcl(list *ast.ObjectList) ([]*Resource, error) {
 			}
 		}
 
+		// Data sources support only the lifecycle settings that are
+		// about reading them
+		var lifecycle ResourceLifecycle
+		if o := listVal.Filter("lifecycle"); len(o.Items) > 0 {
+			valid := []string{"always_refresh"}
+			if err := checkHCLKeys(o.Items[0].Val, valid); err != nil {
+				return nil, multierror.Prefix(err, fmt.Sprintf(
+					"data.%s[%s]:", t, k))
+			}
+
+			var raw map[string]interface{}
+			if err = hcl.DecodeObject(&raw, o.Items[0].Val); err != nil {
+				return nil, fmt.Errorf(
+					"Error parsing lifecycle for %s[%s]: %s",
+					t,
+					k,
+					err)
+			}
+
+			if err := mapstructure.WeakDecode(raw, &lifecycle); err != nil {
+				return nil, fmt.Errorf(
+					"Error parsing lifecycle for %s[%s]: %s",
+					t,
+					k,
+					err)
+			}
+		}
+
 		result = append(result, &Resource{
 			Mode:         DataResourceMode,
 			Name:         k,
@@ -523,7 +648,7 @@ func loadDataResourcesHcl(list *ast.ObjectList) ([]*Resource, error) {
 			Provider:     provider,
 			Provisioners: []*Provisioner{},
 			DependsOn:    dependsOn,
-			Lifecycle:    ResourceLifecycle{},
+			Lifecycle:    lifecycle,
 		})
 	}
 
@@ -620,6 +745,7 @@ func loadManagedResourcesHcl(list *ast.ObjectList) ([]*Resource, error) {
 		delete(config, "provisioner")
 		delete(config, "provider")
 		delete(config, "lifecycle")
+		delete(config, "postcondition")
 
 		rawConfig, err := NewRawConfig(config)
 		if err != nil {
@@ -690,6 +816,20 @@ func loadManagedResourcesHcl(list *ast.ObjectList) ([]*Resource, error) {
 			}
 		}
 
+		// If we have postconditions, then parse those out
+		var postconditions []*Postcondition
+		if o := listVal.Filter("postcondition"); len(o.Items) > 0 {
+			var err error
+			postconditions, err = loadPostconditionsHcl(o)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Error reading postconditions for %s[%s]: %s",
+					t,
+					k,
+					err)
+			}
+		}
+
 		// If we have a provider, then parse it out
 		var provider string
 		if o := listVal.Filter("provider"); len(o.Items) > 0 {
@@ -708,7 +848,7 @@ func loadManagedResourcesHcl(list *ast.ObjectList) ([]*Resource, error) {
 		var lifecycle ResourceLifecycle
 		if o := listVal.Filter("lifecycle"); len(o.Items) > 0 {
 			// Check for invalid keys
-			valid := []string{"create_before_destroy", "ignore_changes", "prevent_destroy"}
+			valid := []string{"create_before_destroy", "ignore_changes", "prevent_destroy", "timeout"}
 			if err := checkHCLKeys(o.Items[0].Val, valid); err != nil {
 				return nil, multierror.Prefix(err, fmt.Sprintf(
 					"%s[%s]:", t, k))
@@ -733,15 +873,47 @@ func loadManagedResourcesHcl(list *ast.ObjectList) ([]*Resource, error) {
 		}
 
 		result = append(result, &Resource{
-			Mode:         ManagedResourceMode,
-			Name:         k,
-			Type:         t,
-			RawCount:     countConfig,
-			RawConfig:    rawConfig,
-			Provisioners: provisioners,
-			Provider:     provider,
-			DependsOn:    dependsOn,
-			Lifecycle:    lifecycle,
+			Mode:           ManagedResourceMode,
+			Name:           k,
+			Type:           t,
+			RawCount:       countConfig,
+			RawConfig:      rawConfig,
+			Provisioners:   provisioners,
+			Postconditions: postconditions,
+			Provider:       provider,
+			DependsOn:      dependsOn,
+			Lifecycle:      lifecycle,
+		})
+	}
+
+	return result, nil
+}
+
+func loadPostconditionsHcl(list *ast.ObjectList) ([]*Postcondition, error) {
+	result := make([]*Postcondition, 0, len(list.Items))
+	for _, item := range list.Items {
+		var config map[string]interface{}
+		if err := hcl.DecodeObject(&config, item.Val); err != nil {
+			return nil, err
+		}
+
+		// The timeout isn't interpolated, handle it separately
+		var timeout string
+		if v, ok := config["timeout"]; ok {
+			if err := mapstructure.WeakDecode(v, &timeout); err != nil {
+				return nil, fmt.Errorf("timeout: %s", err)
+			}
+			delete(config, "timeout")
+		}
+
+		rawConfig, err := NewRawConfig(config)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &Postcondition{
+			RawConfig: rawConfig,
+			Timeout:   timeout,
 		})
 	}
 
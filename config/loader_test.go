@@ -54,6 +54,36 @@ func TestLoadFile_lifecycleKeyCheck(t *testing.T) {
 	t.Logf("err: %s", err)
 }
 
+func TestLoadFile_dataSourceLifecycle(t *testing.T) {
+	c, err := LoadFile(filepath.Join(fixtureDir, "data-source-lifecycle.tf"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if len(c.Resources) != 1 {
+		t.Fatalf("bad: %#v", c.Resources)
+	}
+	r := c.Resources[0]
+	if !r.Lifecycle.AlwaysRefresh {
+		t.Fatal("always_refresh should be set")
+	}
+	if _, ok := r.RawConfig.Raw["lifecycle"]; ok {
+		t.Fatal("lifecycle should not be part of the config")
+	}
+
+	// Only always_refresh is valid for data sources
+	_, err = LoadFile(filepath.Join(fixtureDir, "data-source-lifecycle-bad.tf"))
+	if err == nil {
+		t.Fatal("should have error")
+	}
+
+	// and only for data sources
+	_, err = LoadFile(filepath.Join(fixtureDir, "lifecycle-always-refresh-managed.tf"))
+	if err == nil {
+		t.Fatal("should have error")
+	}
+}
+
 func TestLoadFile_resourceArityMistake(t *testing.T) {
 	_, err := LoadFile(filepath.Join(fixtureDir, "resource-arity-mistake.tf"))
 	if err == nil {
@@ -282,6 +312,38 @@ func TestLoadFileBasic_modules(t *testing.T) {
 	}
 }
 
+func TestLoadFileBasic_modulesCount(t *testing.T) {
+	c, err := LoadFile(filepath.Join(fixtureDir, "modules-count.tf"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if c == nil {
+		t.Fatal("config should not be nil")
+	}
+
+	actual := modulesStr(c.Modules)
+	if actual != strings.TrimSpace(modulesCountModulesStr) {
+		t.Fatalf("bad:\n%s", actual)
+	}
+}
+
+func TestLoadFileBasic_modulesDependsOn(t *testing.T) {
+	c, err := LoadFile(filepath.Join(fixtureDir, "modules-depends-on.tf"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if c == nil {
+		t.Fatal("config should not be nil")
+	}
+
+	actual := modulesStr(c.Modules)
+	if actual != strings.TrimSpace(modulesDependsOnModulesStr) {
+		t.Fatalf("bad:\n%s", actual)
+	}
+}
+
 func TestLoadJSONBasic(t *testing.T) {
 	raw, err := ioutil.ReadFile(filepath.Join(fixtureDir, "basic.tf.json"))
 	if err != nil {
@@ -390,6 +452,25 @@ func TestLoadFileBasic_jsonNoName(t *testing.T) {
 	}
 }
 
+func TestLoadFile_providerVersion(t *testing.T) {
+	c, err := LoadFile(filepath.Join(fixtureDir, "provider-version.tf"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if len(c.ProviderConfigs) != 1 {
+		t.Fatalf("bad: %#v", c.ProviderConfigs)
+	}
+
+	pc := c.ProviderConfigs[0]
+	if pc.Version != "~> 0.1.0" {
+		t.Fatalf("bad version: %q", pc.Version)
+	}
+	if _, ok := pc.RawConfig.Raw["version"]; ok {
+		t.Fatalf("version should not be in the raw config: %#v", pc.RawConfig.Raw)
+	}
+}
+
 func TestLoadFile_variables(t *testing.T) {
 	c, err := LoadFile(filepath.Join(fixtureDir, "variables.tf"))
 	if err != nil {
@@ -688,6 +769,86 @@ func TestLoadFile_ignoreChanges(t *testing.T) {
 	}
 }
 
+func TestLoadFile_postconditions(t *testing.T) {
+	c, err := LoadFile(filepath.Join(fixtureDir, "postcondition.tf"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if c == nil {
+		t.Fatal("config should not be nil")
+	}
+
+	r := c.Resources[0]
+	if len(r.Postconditions) != 2 {
+		t.Fatalf("bad: %#v", r.Postconditions)
+	}
+	if _, ok := r.RawConfig.Raw["postcondition"]; ok {
+		t.Fatalf("postcondition should not be in the resource config: %#v", r.RawConfig.Raw)
+	}
+
+	p := r.Postconditions[0]
+	if p.Timeout != "5m" {
+		t.Fatalf("bad: %#v", p)
+	}
+	if _, ok := p.RawConfig.Raw["timeout"]; ok {
+		t.Fatalf("timeout should not be in the raw config: %#v", p.RawConfig.Raw)
+	}
+	if v := p.RawConfig.Raw["error_message"]; v != "no healthy instances" {
+		t.Fatalf("bad: %#v", v)
+	}
+
+	if p := r.Postconditions[1]; p.Timeout != "" {
+		t.Fatalf("bad: %#v", p)
+	}
+
+	o := c.Outputs[0]
+	if len(o.Postconditions) != 1 {
+		t.Fatalf("bad: %#v", o.Postconditions)
+	}
+	if _, ok := o.RawConfig.Raw["postcondition"]; ok {
+		t.Fatalf("postcondition should not be in the output config: %#v", o.RawConfig.Raw)
+	}
+}
+
+func TestLoadFile_outputDescription(t *testing.T) {
+	c, err := LoadFile(filepath.Join(fixtureDir, "output-description.tf"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if err := c.Validate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	o := c.Outputs[0]
+	if o.Description != "The address of the web load balancer" {
+		t.Fatalf("bad: %#v", o.Description)
+	}
+	if _, ok := o.RawConfig.Raw["description"]; ok {
+		t.Fatalf("description should not be in the output config: %#v", o.RawConfig.Raw)
+	}
+}
+
+func TestLoad_outputType(t *testing.T) {
+	c, err := LoadFile(filepath.Join(fixtureDir, "output-type.tf"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if err := c.Validate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	o := c.Outputs[0]
+	if o.DeclaredType != "list" {
+		t.Fatalf("bad: %#v", o.DeclaredType)
+	}
+	if _, ok := o.RawConfig.Raw["type"]; ok {
+		t.Fatalf("type should not be in the output config: %#v", o.RawConfig.Raw)
+	}
+}
+
 func TestLoad_preventDestroyString(t *testing.T) {
 	c, err := LoadFile(filepath.Join(fixtureDir, "prevent-destroy-string.tf"))
 	if err != nil {
@@ -1038,6 +1199,21 @@ bar
   memory
 `
 
+const modulesCountModulesStr = `
+bar
+  source = baz
+  count = 3
+  memory
+`
+
+const modulesDependsOnModulesStr = `
+bar
+  source = baz
+  dependsOn
+    module.foo
+    aws_instance.web
+`
+
 const provisionerResourcesStr = `
 aws_instance.web (x1)
   ami
@@ -0,0 +1,307 @@
+package module
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-getter"
+	urlhelper "github.com/hashicorp/go-getter/helper/url"
+)
+
+// CacheStorage is a getter.Storage that keeps every module it downloads in
+// a cache shared by all working directories, so that a module used by many
+// configurations is only downloaded once.
+//
+// The cache is content addressed: the files of a module are stored in a
+// directory named after the SHA-256 hash of their content, and each source
+// refers to the content it last downloaded. The hash is checked every time
+// a module is taken from the cache, and a module whose files don't match
+// it is downloaded again.
+//
+// Local sources (file://) aren't cached, since they're already on disk.
+type CacheStorage struct {
+	// StorageDir is where the modules of the working directory are
+	// stored. It's laid out like getter.FolderStorage, so modules already
+	// downloaded with it are found.
+	StorageDir string
+
+	// CacheDir is the shared cache directory.
+	CacheDir string
+
+	lock    sync.Mutex
+	sources map[string]*sync.Mutex
+}
+
+// Dir implements getter.Storage.
+func (s *CacheStorage) Dir(key string) (string, bool, error) {
+	d := s.dir(key)
+	if _, err := os.Stat(d); err != nil {
+		if os.IsNotExist(err) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	return d, true, nil
+}
+
+// Get implements getter.Storage.
+func (s *CacheStorage) Get(key string, source string, update bool) error {
+	dst := s.dir(key)
+	if !update {
+		if _, err := os.Stat(dst); err == nil {
+			return nil
+		} else if !os.IsNotExist(err) {
+			return fmt.Errorf("Error reading module directory: %s", err)
+		}
+	}
+
+	if strings.HasPrefix(source, "file://") {
+		return getLocal(dst, source)
+	}
+
+	// Modules with the same source may be fetched concurrently. Only one
+	// of them downloads it, the others take it from the cache.
+	l := s.sourceLock(source)
+	l.Lock()
+	defer l.Unlock()
+
+	var contentDir string
+	if !update {
+		contentDir = s.cached(source)
+	}
+	if contentDir == "" {
+		var err error
+		contentDir, err = s.fetch(source)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := os.RemoveAll(longPath(dst)); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dst, 0755); err != nil {
+		return err
+	}
+	return copyDir(dst, contentDir)
+}
+
+// getLocal links dst to the local module at source, with a symlink or, on
+// Windows, a junction. Junctions need cmd.exe and can't point to network
+// shares, so on Windows the module is copied if it can't be linked. Such a
+// copy is replaced on the next get.
+func getLocal(dst, source string) error {
+	if fi, err := os.Lstat(dst); err == nil && fi.Mode()&os.ModeSymlink == 0 {
+		if err := os.RemoveAll(longPath(dst)); err != nil {
+			return err
+		}
+	}
+
+	err := getter.Get(dst, source)
+	if err == nil || runtime.GOOS != "windows" {
+		return err
+	}
+	log.Printf("[WARN] Error linking module %s, copying it instead: %s", source, err)
+
+	u, err := urlhelper.Parse(source)
+	if err != nil {
+		return err
+	}
+	path := u.Path
+	if u.RawPath != "" {
+		path = u.RawPath
+	}
+
+	// The junction may have been created before the error
+	if err := os.Remove(dst); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := os.MkdirAll(dst, 0755); err != nil {
+		return err
+	}
+	return copyDir(dst, path)
+}
+
+// dir returns the directory of the module with the given key in the
+// working directory.
+func (s *CacheStorage) dir(key string) string {
+	return storageDir(s.StorageDir, key)
+}
+
+func (s *CacheStorage) sourceLock(source string) *sync.Mutex {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.sources == nil {
+		s.sources = make(map[string]*sync.Mutex)
+	}
+	l, ok := s.sources[source]
+	if !ok {
+		l = new(sync.Mutex)
+		s.sources[source] = l
+	}
+	return l
+}
+
+// sourcePath returns the path of the file that records the content hash
+// of what source last downloaded.
+func (s *CacheStorage) sourcePath(source string) string {
+	sum := sha256.Sum256([]byte(source))
+	return filepath.Join(s.CacheDir, "sources", hex.EncodeToString(sum[:]))
+}
+
+func (s *CacheStorage) contentDir(hash string) string {
+	return filepath.Join(s.CacheDir, "content", hash)
+}
+
+// cached returns the directory of the cached content of source, or an
+// empty string if it isn't cached or its content doesn't match its hash.
+func (s *CacheStorage) cached(source string) string {
+	raw, err := ioutil.ReadFile(s.sourcePath(source))
+	if err != nil {
+		return ""
+	}
+
+	hash := strings.TrimSpace(string(raw))
+	dir := s.contentDir(hash)
+	actual, err := hashDir(dir)
+	if err != nil {
+		return ""
+	}
+	if actual != hash {
+		log.Printf(
+			"[WARN] Cached module %s doesn't match its checksum, downloading it again",
+			source)
+		return ""
+	}
+
+	log.Printf("[DEBUG] Using cached module %s from %s", source, dir)
+	return dir
+}
+
+// fetch downloads source into the cache and returns the directory of its
+// content.
+func (s *CacheStorage) fetch(source string) (string, error) {
+	if err := os.MkdirAll(s.CacheDir, 0755); err != nil {
+		return "", err
+	}
+
+	tmpDir, err := ioutil.TempDir(s.CacheDir, ".tmp")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Getters expect to create the directory they download to
+	downloadDir := filepath.Join(tmpDir, "download")
+	if err := getter.Get(downloadDir, source); err != nil {
+		return "", err
+	}
+
+	hash, err := hashDir(downloadDir)
+	if err != nil {
+		return "", err
+	}
+
+	// Move the content into place unless identical content is cached
+	// already, e.g. from another source.
+	dir := s.contentDir(hash)
+	if actual, err := hashDir(dir); err != nil || actual != hash {
+		stagingDir := filepath.Join(tmpDir, "content")
+		if err := os.MkdirAll(stagingDir, 0755); err != nil {
+			return "", err
+		}
+		if err := copyDir(stagingDir, downloadDir); err != nil {
+			return "", err
+		}
+		if err := os.MkdirAll(filepath.Dir(dir), 0755); err != nil {
+			return "", err
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			return "", err
+		}
+		if err := os.Rename(stagingDir, dir); err != nil {
+			return "", err
+		}
+	}
+
+	// Record the content of the source last, so that a source never
+	// refers to incomplete content.
+	sourcePath := s.sourcePath(source)
+	if err := os.MkdirAll(filepath.Dir(sourcePath), 0755); err != nil {
+		return "", err
+	}
+	tmpSource := filepath.Join(tmpDir, "source")
+	if err := ioutil.WriteFile(tmpSource, []byte(hash+"\n"), 0644); err != nil {
+		return "", err
+	}
+	if err := os.Rename(tmpSource, sourcePath); err != nil {
+		return "", err
+	}
+
+	return dir, nil
+}
+
+// hashDir returns the SHA-256 hash of the files in dir that copyDir copies:
+// their paths, whether they're executable, and their content.
+func hashDir(dir string) (string, error) {
+	dir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	walkFn := func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == dir {
+			return nil
+		}
+
+		if strings.HasPrefix(filepath.Base(path), ".") {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if info.IsDir() {
+			fmt.Fprintf(h, "dir %s\n", rel)
+			return nil
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		fileHash := sha256.New()
+		if _, err := io.Copy(fileHash, f); err != nil {
+			return err
+		}
+		fmt.Fprintf(h, "file %s %t %x\n", rel, info.Mode()&0111 != 0, fileHash.Sum(nil))
+		return nil
+	}
+	if err := filepath.Walk(dir, walkFn); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,104 @@
+package module
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-getter"
+)
+
+func init() {
+	// A getter that copies local directories, so that tests can use local
+	// sources that go through the cache.
+	getter.Getters["testcopy"] = &getter.FileGetter{Copy: true}
+}
+
+func TestCacheStorage(t *testing.T) {
+	srcDir := tempDir(t)
+	defer os.RemoveAll(srcDir)
+	if err := os.MkdirAll(srcDir, 0755); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	writeFile := func(content string) {
+		if err := ioutil.WriteFile(filepath.Join(srcDir, "main.tf"), []byte(content), 0644); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+	writeFile("# v1\n")
+
+	cacheDir := tempDir(t)
+	defer os.RemoveAll(cacheDir)
+	source := "testcopy::file://" + filepath.ToSlash(srcDir)
+
+	get := func(update bool) string {
+		s := &CacheStorage{StorageDir: tempDir(t), CacheDir: cacheDir}
+		defer os.RemoveAll(s.StorageDir)
+
+		if err := s.Get("root.foo-foo", source, update); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		dir, ok, err := s.Dir("root.foo-foo")
+		if err != nil || !ok {
+			t.Fatalf("module not found: %t, %s", ok, err)
+		}
+		raw, err := ioutil.ReadFile(filepath.Join(dir, "main.tf"))
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		return string(raw)
+	}
+
+	if actual := get(false); actual != "# v1\n" {
+		t.Fatalf("bad: %q", actual)
+	}
+
+	// Another working directory gets the cached module
+	writeFile("# v2\n")
+	if actual := get(false); actual != "# v1\n" {
+		t.Fatalf("module should come from the cache: %q", actual)
+	}
+
+	// Updating downloads it again
+	if actual := get(true); actual != "# v2\n" {
+		t.Fatalf("module should be updated: %q", actual)
+	}
+
+	// A cached module that doesn't match its checksum is downloaded again
+	writeFile("# v3\n")
+	s := &CacheStorage{CacheDir: cacheDir}
+	raw, err := ioutil.ReadFile(s.sourcePath(source))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	contentDir := s.contentDir(string(raw[:len(raw)-1]))
+	if err := ioutil.WriteFile(filepath.Join(contentDir, "main.tf"), []byte("# corrupt\n"), 0644); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if actual := get(false); actual != "# v3\n" {
+		t.Fatalf("corrupt module should be downloaded again: %q", actual)
+	}
+}
+
+func TestHashDir(t *testing.T) {
+	a, err := hashDir(filepath.Join(fixtureDir, "basic"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	b, err := hashDir(filepath.Join(fixtureDir, "basic-dot"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if a == b {
+		t.Fatal("different content should have different hashes")
+	}
+
+	again, err := hashDir(filepath.Join(fixtureDir, "basic"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if a != again {
+		t.Fatalf("hash should be stable: %s != %s", a, again)
+	}
+}
@@ -15,6 +15,11 @@ func copyDir(dst, src string) error {
 		return err
 	}
 
+	// Modules nested in .terraform/modules easily exceed the length
+	// Windows limits paths to.
+	src = longPath(src)
+	dst = longPath(dst)
+
 	walkFn := func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
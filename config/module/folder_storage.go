@@ -0,0 +1,56 @@
+package module
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/go-getter"
+)
+
+// FolderStorage is a getter.Storage that stores modules in a directory,
+// laid out like getter.FolderStorage. Unlike getter.FolderStorage, local
+// modules that can't be linked on Windows are copied. See getLocal.
+type FolderStorage struct {
+	// StorageDir is the directory where the modules will be stored.
+	StorageDir string
+}
+
+// Dir implements getter.Storage.
+func (s *FolderStorage) Dir(key string) (string, bool, error) {
+	d := storageDir(s.StorageDir, key)
+	if _, err := os.Stat(d); err != nil {
+		if os.IsNotExist(err) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	return d, true, nil
+}
+
+// Get implements getter.Storage.
+func (s *FolderStorage) Get(key string, source string, update bool) error {
+	dst := storageDir(s.StorageDir, key)
+	if !update {
+		if _, err := os.Stat(dst); err == nil {
+			return nil
+		} else if !os.IsNotExist(err) {
+			return fmt.Errorf("Error reading module directory: %s", err)
+		}
+	}
+
+	if strings.HasPrefix(source, "file://") {
+		return getLocal(dst, source)
+	}
+	return getter.Get(dst, source)
+}
+
+// storageDir returns the directory of the module with the given key in
+// storageDir, using the same naming as getter.FolderStorage.
+func storageDir(storageDir string, key string) string {
+	sum := md5.Sum([]byte(key))
+	return filepath.Join(storageDir, hex.EncodeToString(sum[:]))
+}
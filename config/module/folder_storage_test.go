@@ -0,0 +1,48 @@
+package module
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFolderStorage_local(t *testing.T) {
+	srcDir := tempDir(t)
+	defer os.RemoveAll(srcDir)
+	if err := os.MkdirAll(srcDir, 0755); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(srcDir, "main.tf"), []byte("# v1\n"), 0644); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	s := &FolderStorage{StorageDir: tempDir(t)}
+	defer os.RemoveAll(s.StorageDir)
+	source := "file://" + filepath.ToSlash(srcDir)
+
+	// A copy left by an earlier get, such as on Windows when the module
+	// couldn't be linked, is replaced when updating.
+	dst := storageDir(s.StorageDir, "root.foo-foo")
+	if err := os.MkdirAll(dst, 0755); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dst, "main.tf"), []byte("# old\n"), 0644); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if err := s.Get("root.foo-foo", source, true); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	dir, ok, err := s.Dir("root.foo-foo")
+	if err != nil || !ok {
+		t.Fatalf("module not found: %t, %s", ok, err)
+	}
+	raw, err := ioutil.ReadFile(filepath.Join(dir, "main.tf"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if string(raw) != "# v1\n" {
+		t.Fatalf("bad: %q", raw)
+	}
+}
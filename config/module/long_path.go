@@ -0,0 +1,9 @@
+// +build !windows
+
+package module
+
+// longPath returns path in a form that isn't limited in length. Only
+// Windows limits the length of paths.
+func longPath(path string) string {
+	return path
+}
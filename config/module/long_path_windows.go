@@ -0,0 +1,29 @@
+// +build windows
+
+package module
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// longPath returns path in a form that isn't limited in length. The Win32
+// API limits paths to 260 characters (MAX_PATH) unless they're absolute
+// and prefixed with \\?\, which modules nested in .terraform easily
+// exceed.
+func longPath(path string) string {
+	if strings.HasPrefix(path, `\\?\`) {
+		return path
+	}
+
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return path
+	}
+
+	// UNC paths, \\server\share, become \\?\UNC\server\share
+	if strings.HasPrefix(abs, `\\`) {
+		return `\\?\UNC\` + abs[2:]
+	}
+	return `\\?\` + abs
+}
@@ -0,0 +1,25 @@
+// +build windows
+
+package module
+
+import (
+	"testing"
+)
+
+func TestLongPath(t *testing.T) {
+	cases := []struct {
+		Input  string
+		Output string
+	}{
+		{`C:\foo\bar`, `\\?\C:\foo\bar`},
+		{`C:\foo\..\bar`, `\\?\C:\bar`},
+		{`\\server\share\foo`, `\\?\UNC\server\share\foo`},
+		{`\\?\C:\foo`, `\\?\C:\foo`},
+	}
+
+	for _, tc := range cases {
+		if actual := longPath(tc.Input); actual != tc.Output {
+			t.Fatalf("%s: bad: %s", tc.Input, actual)
+		}
+	}
+}
@@ -0,0 +1,3 @@
+variable "password" {
+    source = "vault://secret/db#password"
+}
@@ -15,6 +15,27 @@ import (
 // RootName is the name of the root tree.
 const RootName = "root"
 
+// maxParallelGets is the maximum number of modules fetched at once.
+const maxParallelGets = 8
+
+// getSemaphore limits the number of modules fetched at once across every
+// tree being loaded.
+var getSemaphore = make(chan struct{}, maxParallelGets)
+
+// moduleGet is a module that is fetched while loading a tree.
+type moduleGet struct {
+	Module *Module
+	Path   []string
+	Key    string
+	Source string
+	SubDir string
+
+	// Set once the module is fetched
+	Dir   string
+	Found bool
+	Err   error
+}
+
 // Tree represents the module import tree of configurations.
 //
 // This Tree structure can be used to get (download) new modules, load
@@ -64,13 +85,16 @@ func (t *Tree) Config() *config.Config {
 	return t.config
 }
 
-// Child returns the child with the given path (by name).
+// Child returns the child with the given path (by name). Instances of
+// counted modules, such as "foo[1]", resolve to the tree of the module
+// they are an instance of.
 func (t *Tree) Child(path []string) *Tree {
 	if len(path) == 0 {
 		return t
 	}
 
-	c := t.Children()[path[0]]
+	name, _ := config.ParseModuleInstanceName(path[0])
+	c := t.Children()[name]
 	if c == nil {
 		return nil
 	}
@@ -142,12 +166,16 @@ func (t *Tree) Load(s getter.Storage, mode GetMode) error {
 	modules := t.Modules()
 	children := make(map[string]*Tree)
 
-	// Go through all the modules and get the directory for them.
-	for _, m := range modules {
-		if _, ok := children[m.Name]; ok {
+	// Work out where every module comes from first, so that they can be
+	// fetched concurrently.
+	gets := make([]*moduleGet, len(modules))
+	names := make(map[string]struct{})
+	for i, m := range modules {
+		if _, ok := names[m.Name]; ok {
 			return fmt.Errorf(
 				"module %s: duplicated. module names must be unique", m.Name)
 		}
+		names[m.Name] = struct{}{}
 
 		// Determine the path to this child
 		path := make([]string, len(t.path), len(t.path)+1)
@@ -168,37 +196,75 @@ func (t *Tree) Load(s getter.Storage, mode GetMode) error {
 			subDir = filepath.Join(subDir2, subDir)
 		}
 
-		// Get the directory where this module is so we can load it
 		key := strings.Join(path, ".")
 		key = fmt.Sprintf("root.%s-%s", key, m.Source)
-		dir, ok, err := getStorage(s, key, source, mode)
-		if err != nil {
-			return err
+
+		gets[i] = &moduleGet{
+			Module: m,
+			Path:   path,
+			Key:    key,
+			Source: source,
+			SubDir: subDir,
 		}
-		if !ok {
+	}
+
+	// Get the directory where each module is so we can load it
+	var wg sync.WaitGroup
+	for _, g := range gets {
+		wg.Add(1)
+		go func(g *moduleGet) {
+			defer wg.Done()
+
+			getSemaphore <- struct{}{}
+			defer func() { <-getSemaphore }()
+
+			g.Dir, g.Found, g.Err = getStorage(s, g.Key, g.Source, mode)
+		}(g)
+	}
+	wg.Wait()
+
+	for _, g := range gets {
+		m := g.Module
+		if g.Err != nil {
+			return g.Err
+		}
+		if !g.Found {
 			return fmt.Errorf(
 				"module %s: not found, may need to be downloaded using 'terraform get'", m.Name)
 		}
 
 		// If we have a subdirectory, then merge that in
-		if subDir != "" {
-			dir = filepath.Join(dir, subDir)
+		dir := g.Dir
+		if g.SubDir != "" {
+			dir = filepath.Join(dir, g.SubDir)
 		}
 
 		// Load the configurations.Dir(source)
-		children[m.Name], err = NewTreeModule(m.Name, dir)
+		child, err := NewTreeModule(m.Name, dir)
 		if err != nil {
 			return fmt.Errorf(
 				"module %s: %s", m.Name, err)
 		}
 
 		// Set the path of this child
-		children[m.Name].path = path
+		child.path = g.Path
+		children[m.Name] = child
 	}
 
-	// Go through all the children and load them.
-	for _, c := range children {
-		if err := c.Load(s, mode); err != nil {
+	// Go through all the children and load them concurrently. Errors
+	// are reported in module order, so that they don't depend on timing.
+	errs := make([]error, len(gets))
+	for i, g := range gets {
+		wg.Add(1)
+		go func(i int, c *Tree) {
+			defer wg.Done()
+			errs[i] = c.Load(s, mode)
+		}(i, children[g.Module.Name])
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
 			return err
 		}
 	}
@@ -299,6 +365,16 @@ func (t *Tree) Validate() error {
 		for _, v := range tree.config.Variables {
 			varMap[v.Name] = struct{}{}
 
+			// Sources are only read for the variables of the root module,
+			// the others get their values from the module block.
+			if v.Source != "" {
+				newErr.Err = fmt.Errorf(
+					"module %s: variable %s can't have a source, only variables "+
+						"of the root module can",
+					m.Name, v.Name)
+				return newErr
+			}
+
 			if v.Required() {
 				requiredMap[v.Name] = struct{}{}
 			}
@@ -319,6 +395,17 @@ func (t *Tree) Validate() error {
 
 		// If we have any required left over, they aren't set.
 		for k, _ := range requiredMap {
+			// The count parameter of a module block is the number of
+			// instances of the module, so it can't set a variable.
+			if k == "count" {
+				newErr.Err = fmt.Errorf(
+					"module %s: required variable count not set. count is "+
+						"reserved for the number of instances of a module, "+
+						"rename the variable to be able to set it",
+					m.Name)
+				return newErr
+			}
+
 			newErr.Err = fmt.Errorf(
 				"module %s: required variable %s not set",
 				m.Name, k)
@@ -53,6 +53,13 @@ func TestTreeChild(t *testing.T) {
 	} else if !reflect.DeepEqual(c.Path(), []string{"foo", "bar"}) {
 		t.Fatalf("bad: %#v", c.Path())
 	}
+
+	// Instances of counted modules resolve to the module
+	if c := tree.Child([]string{"foo[1]", "bar"}); c == nil {
+		t.Fatal("should not be nil")
+	} else if c.Name() != "bar" {
+		t.Fatalf("bad: %#v", c.Name())
+	}
 }
 
 func TestTreeLoad(t *testing.T) {
@@ -354,6 +361,38 @@ func TestTreeValidate_requiredChildVar(t *testing.T) {
 	}
 }
 
+func TestTreeValidate_childVarSource(t *testing.T) {
+	tree := NewTree("", testConfig(t, "validate-child-var-source"))
+
+	if err := tree.Load(testStorage(t), GetModeGet); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	err := tree.Validate()
+	if err == nil {
+		t.Fatal("should error")
+	}
+	if !strings.Contains(err.Error(), "can't have a source") {
+		t.Fatalf("bad: %s", err)
+	}
+}
+
+func TestTreeValidate_requiredChildVarCount(t *testing.T) {
+	tree := NewTree("", testConfig(t, "validate-required-var-count"))
+
+	if err := tree.Load(testStorage(t), GetModeGet); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	err := tree.Validate()
+	if err == nil {
+		t.Fatal("should error")
+	}
+	if !strings.Contains(err.Error(), "reserved") {
+		t.Fatalf("bad: %s", err)
+	}
+}
+
 const treeLoadStr = `
 root
   foo (path: foo)
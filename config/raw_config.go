@@ -137,11 +137,24 @@ func (r *RawConfig) Interpolate(vs map[string]ast.Variable) error {
 		if err != nil {
 			return "", err
 		}
+		splats := make(map[string]struct{})
 		for _, v := range vars {
 			varVal, ok := vs[v.FullKey()]
 			if ok && varVal.Value == UnknownVariableValue {
 				return UnknownVariableValue, nil
 			}
+
+			if rv, ok := v.(*ResourceVariable); ok && rv.Multi && rv.Index == -1 {
+				splats[v.FullKey()] = struct{}{}
+			}
+		}
+
+		// Splats of resources that aren't created yet are lists with a
+		// known number of unknown elements. The only thing that can be
+		// known about them is their length, so any other use makes the
+		// whole value computed.
+		if unknownElementsUsed(root, splats, vs) {
+			return UnknownVariableValue, nil
 		}
 
 		// None of the variables we need are computed, meaning we should
@@ -321,6 +334,60 @@ type gobRawConfig struct {
 	Raw map[string]interface{}
 }
 
+// unknownElementsUsed returns true if any of the given splat variables has
+// unknown elements and is used in root for anything other than as the
+// argument of the length function.
+func unknownElementsUsed(
+	root ast.Node, splats map[string]struct{}, vs map[string]ast.Variable) bool {
+	lengthArgs := make(map[ast.Node]struct{})
+	var accesses []*ast.VariableAccess
+	root.Accept(func(n ast.Node) ast.Node {
+		switch n := n.(type) {
+		case *ast.Call:
+			if n.Func == "length" && len(n.Args) == 1 {
+				lengthArgs[n.Args[0]] = struct{}{}
+			}
+		case *ast.VariableAccess:
+			accesses = append(accesses, n)
+		}
+
+		return n
+	})
+
+	for _, n := range accesses {
+		if _, ok := splats[n.Name]; !ok {
+			continue
+		}
+		if !hasUnknownElements(vs[n.Name]) {
+			continue
+		}
+		if _, ok := lengthArgs[n]; !ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasUnknownElements returns true if v is a list with unknown elements.
+func hasUnknownElements(v ast.Variable) bool {
+	if v.Type != ast.TypeList {
+		return false
+	}
+
+	elems, ok := v.Value.([]ast.Variable)
+	if !ok {
+		return false
+	}
+	for _, e := range elems {
+		if e.Value == UnknownVariableValue {
+			return true
+		}
+	}
+
+	return false
+}
+
 // langEvalConfig returns the evaluation configuration we use to execute.
 func langEvalConfig(vs map[string]ast.Variable) *hil.EvalConfig {
 	funcMap := make(map[string]ast.Function)
@@ -303,6 +303,45 @@ func TestRawConfig_unknownPartial(t *testing.T) {
 	}
 }
 
+func TestRawConfig_unknownSplatLength(t *testing.T) {
+	raw := map[string]interface{}{
+		"count": "${length(aws_instance.web.*.id) * 2}",
+		"ids":   "${join(\",\", aws_instance.web.*.id)}",
+	}
+
+	rc, err := NewRawConfig(raw)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	vars := map[string]ast.Variable{
+		"aws_instance.web.*.id": ast.Variable{
+			Type: ast.TypeList,
+			Value: []ast.Variable{
+				ast.Variable{Type: ast.TypeString, Value: "i-abc123"},
+				ast.Variable{Type: ast.TypeString, Value: UnknownVariableValue},
+			},
+		},
+	}
+	if err := rc.Interpolate(vars); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	actual := rc.Config()
+	expected := map[string]interface{}{
+		"count": "4",
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: %#v", actual)
+	}
+
+	expectedKeys := []string{"ids"}
+	if !reflect.DeepEqual(rc.UnknownKeys(), expectedKeys) {
+		t.Fatalf("bad: %#v", rc.UnknownKeys())
+	}
+}
+
 func TestRawConfigValue(t *testing.T) {
 	raw := map[string]interface{}{
 		"foo": "${var.bar}",
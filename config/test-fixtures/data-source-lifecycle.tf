@@ -0,0 +1,7 @@
+data "aws_ami" "web" {
+    owners = ["self"]
+
+    lifecycle {
+        always_refresh = true
+    }
+}
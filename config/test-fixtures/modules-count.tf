@@ -0,0 +1,5 @@
+module "bar" {
+    source = "baz"
+    count = 3
+    memory = "1G"
+}
@@ -0,0 +1,4 @@
+module "bar" {
+    source = "baz"
+    depends_on = ["module.foo", "aws_instance.web"]
+}
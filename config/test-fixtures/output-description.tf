@@ -0,0 +1,8 @@
+variable "region" {
+  description = "The region to deploy into"
+}
+
+output "address" {
+  value       = "${var.region}"
+  description = "The address of the web load balancer"
+}
@@ -0,0 +1,9 @@
+variable "subnets" {
+  type = "list"
+}
+
+output "subnet_ids" {
+  value       = "${var.subnets}"
+  type        = "list"
+  description = "The IDs of the subnets"
+}
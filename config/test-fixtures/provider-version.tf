@@ -0,0 +1,4 @@
+provider "aws" {
+  version = "~> 0.1.0"
+  region  = "us-east-1"
+}
@@ -0,0 +1,4 @@
+module "app" {
+    source = "./app"
+    depends_on = ["module.app"]
+}
@@ -0,0 +1,18 @@
+module "db" {
+    source = "./db"
+}
+
+module "app" {
+    source = "./app"
+    depends_on = ["module.db", "aws_instance.bastion"]
+}
+
+resource "aws_instance" "bastion" {}
+
+resource "aws_instance" "web" {
+    depends_on = ["module.app"]
+}
+
+data "aws_ami" "web" {
+    depends_on = ["module.db"]
+}
@@ -0,0 +1,5 @@
+resource "aws_instance" "web" {
+  lifecycle {
+    timeout = "30m"
+  }
+}
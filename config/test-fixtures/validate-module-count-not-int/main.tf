@@ -0,0 +1,4 @@
+module "foo" {
+    source = "./foo"
+    count = "nope"
+}
@@ -0,0 +1,6 @@
+resource "aws_instance" "foo" {}
+
+module "foo" {
+    source = "./foo"
+    count = "${aws_instance.foo.count}"
+}
@@ -0,0 +1,8 @@
+module "foo" {
+    source = "./foo"
+    bar = "${count.index}"
+}
+
+output "ids" {
+    value = ["${module.foo.*.id}"]
+}
@@ -0,0 +1,17 @@
+variable "azs" {
+    default = ["us-east-1a", "us-east-1b"]
+}
+
+module "subnet" {
+    source = "./subnet"
+    count = "${length(var.azs)}"
+    az = "${element(var.azs, count.index)}"
+}
+
+output "ids" {
+    value = ["${module.subnet.*.id}"]
+}
+
+output "first" {
+    value = "${module.subnet.0.id}"
+}
@@ -0,0 +1,4 @@
+output "region" {
+  value = "us-east-1"
+  type  = "number"
+}
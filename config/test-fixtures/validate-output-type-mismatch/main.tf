@@ -0,0 +1,4 @@
+output "names" {
+  value = ["a", "b"]
+  type  = "string"
+}
@@ -0,0 +1,8 @@
+variable "zones" {
+  type = "list"
+}
+
+output "zones" {
+  value = "${var.zones}"
+  type  = "map"
+}
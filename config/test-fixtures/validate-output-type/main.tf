@@ -0,0 +1,34 @@
+variable "zones" {
+  type = "list"
+}
+
+variable "tags" {
+  type = "map"
+}
+
+resource "aws_instance" "web" {}
+
+output "zones" {
+  value = "${var.zones}"
+  type  = "list"
+}
+
+output "tags" {
+  value = "${var.tags}"
+  type  = "map"
+}
+
+output "region" {
+  value = "us-east-1"
+  type  = "string"
+}
+
+output "names" {
+  value = ["a", "b"]
+  type  = "list"
+}
+
+output "ip" {
+  value = "${aws_instance.web.private_ip}"
+  type  = "string"
+}
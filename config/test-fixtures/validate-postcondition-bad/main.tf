@@ -0,0 +1,8 @@
+resource "aws_elb" "web" {
+  name = "web"
+
+  postcondition {
+    error_message = "no condition"
+    timeout       = "soon"
+  }
+}
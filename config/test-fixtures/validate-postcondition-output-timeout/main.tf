@@ -0,0 +1,12 @@
+resource "aws_elb" "web" {
+  name = "web"
+}
+
+output "address" {
+  value = "${aws_elb.web.dns_name}"
+
+  postcondition {
+    condition = "${length(self.dns_name)}"
+    timeout   = "5m"
+  }
+}
@@ -0,0 +1,22 @@
+resource "aws_elb" "web" {
+  name = "web"
+
+  postcondition {
+    condition     = "${length(self.instances)}"
+    error_message = "no healthy instances"
+    timeout       = "5m"
+  }
+
+  postcondition {
+    condition = "${length(self.name)}"
+  }
+}
+
+output "address" {
+  value = "${aws_elb.web.dns_name}"
+
+  postcondition {
+    condition     = "${length(aws_elb.web.dns_name)}"
+    error_message = "no address"
+  }
+}
@@ -0,0 +1,3 @@
+provider "aws" {
+  version = ">= 0.1.0, < 0.2.0"
+}
@@ -0,0 +1,3 @@
+variable "password" {
+    source = "secret/db#password"
+}
@@ -0,0 +1,4 @@
+variable "password" {
+    default = "hunter2"
+    source  = "vault://secret/db#password"
+}
@@ -0,0 +1,8 @@
+variable "password" {
+    source = "vault://secret/db#password"
+}
+
+variable "api_keys" {
+    type   = "map"
+    source = "kms://secrets/api_keys.enc"
+}
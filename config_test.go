@@ -3,7 +3,10 @@ package main
 import (
 	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform/command"
 )
 
 // This is the directory where our test fixtures are.
@@ -27,6 +30,42 @@ func TestLoadConfig(t *testing.T) {
 	}
 }
 
+func TestLoadConfig_notifications(t *testing.T) {
+	c, err := LoadConfig(filepath.Join(fixtureDir, "config-notifications"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []*command.NotificationConfig{
+		&command.NotificationConfig{
+			Name:     "audit",
+			Type:     "sns",
+			TopicARN: "arn:aws:sns:us-west-2:123456789012:terraform",
+		},
+		&command.NotificationConfig{
+			Name:   "ops",
+			Type:   "slack",
+			URL:    "https://hooks.slack.com/services/T000/B000/XXXX",
+			Events: []string{"apply_failed", "destroy_succeeded"},
+		},
+	}
+
+	actual := c.NotificationConfigs()
+	if !reflect.DeepEqual(actual, expected) {
+		for _, n := range actual {
+			t.Logf("%#v", n)
+		}
+		t.Fatal("bad notifications")
+	}
+}
+
+func TestLoadConfig_notificationsInvalid(t *testing.T) {
+	_, err := LoadConfig(filepath.Join(fixtureDir, "config-notifications-invalid"))
+	if err == nil || !strings.Contains(err.Error(), `unknown event "plan"`) {
+		t.Fatalf("bad: %v", err)
+	}
+}
+
 func TestConfig_Merge(t *testing.T) {
 	c1 := &Config{
 		Providers: map[string]string{
@@ -66,3 +105,26 @@ func TestConfig_Merge(t *testing.T) {
 		t.Fatalf("bad: %#v", actual)
 	}
 }
+
+func TestConfig_MergeNotifications(t *testing.T) {
+	c1 := &Config{
+		Notifications: map[string]*command.NotificationConfig{
+			"ops":   &command.NotificationConfig{URL: "https://example.com/old"},
+			"audit": &command.NotificationConfig{URL: "https://example.com/audit"},
+		},
+	}
+
+	c2 := &Config{
+		Notifications: map[string]*command.NotificationConfig{
+			"ops": &command.NotificationConfig{URL: "https://example.com/new"},
+		},
+	}
+
+	actual := c1.Merge(c2)
+	if len(actual.Notifications) != 2 {
+		t.Fatalf("bad: %#v", actual.Notifications)
+	}
+	if u := actual.Notifications["ops"].URL; u != "https://example.com/new" {
+		t.Fatalf("bad: %s", u)
+	}
+}
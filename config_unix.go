@@ -30,6 +30,13 @@ func configDir() (string, error) {
 	return filepath.Join(dir, ".terraform.d"), nil
 }
 
+// isPluginFile returns true if file, the name of a file matching the name
+// of a plugin, is an executable plugin. Any file can be executable, so
+// all of them are.
+func isPluginFile(file string) bool {
+	return true
+}
+
 func homeDir() (string, error) {
 	// First prefer the HOME environmental variable
 	if home := os.Getenv("HOME"); home != "" {
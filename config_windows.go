@@ -4,6 +4,7 @@ package main
 
 import (
 	"path/filepath"
+	"strings"
 	"syscall"
 	"unsafe"
 )
@@ -33,6 +34,14 @@ func configDir() (string, error) {
 	return filepath.Join(dir, "terraform.d"), nil
 }
 
+// isPluginFile returns true if file, the name of a file matching the name
+// of a plugin, is an executable plugin. Only .exe files are executable, so
+// that files such as terraform-provider-aws.exe.old or the .pdb debugging
+// symbols next to a plugin aren't taken for it.
+func isPluginFile(file string) bool {
+	return strings.EqualFold(filepath.Ext(file), ".exe")
+}
+
 func homeDir() (string, error) {
 	b := make([]uint16, syscall.MAX_PATH)
 
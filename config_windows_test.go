@@ -0,0 +1,23 @@
+// +build windows
+
+package main
+
+import (
+	"testing"
+)
+
+func TestIsPluginFile(t *testing.T) {
+	cases := map[string]bool{
+		"terraform-provider-aws.exe":     true,
+		"terraform-provider-aws.EXE":     true,
+		"terraform-provider-aws.exe.old": false,
+		"terraform-provider-aws.pdb":     false,
+		"terraform-provider-aws":         false,
+	}
+
+	for file, expected := range cases {
+		if actual := isPluginFile(file); actual != expected {
+			t.Fatalf("%s: expected %t, got %t", file, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+// Package metrics collects the API call counts that providers report for
+// the run metrics file Terraform writes when TF_METRICS_PATH is set.
+//
+// Providers run in their own processes, so each one writes its counts to
+// a file of its own in the directory named by DirEnvVar, which Terraform
+// sets for the providers it starts. Terraform merges these files into the
+// metrics file once the run is over.
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// DirEnvVar is the environment variable naming the directory providers
+// write their counts to. Nothing is recorded when it isn't set.
+const DirEnvVar = "TF_PROVIDER_METRICS_DIR"
+
+// Counts are the API call counts of a single operation, or the totals of
+// a provider.
+type Counts struct {
+	// Calls is the number of API calls made, not counting retries.
+	Calls int `json:"calls"`
+
+	// Retries is the number of times calls were retried, and Throttles
+	// how many of the attempts were rejected by API rate limiting.
+	Retries   int `json:"retries"`
+	Throttles int `json:"throttles"`
+
+	// Errors is the number of calls that failed after any retries.
+	Errors int `json:"errors"`
+}
+
+func (c *Counts) add(o Counts) {
+	c.Calls += o.Calls
+	c.Retries += o.Retries
+	c.Throttles += o.Throttles
+	c.Errors += o.Errors
+}
+
+// Provider holds the API call counts of a provider, in total and for each
+// API operation.
+type Provider struct {
+	Counts
+	Operations map[string]*Counts `json:"operations"`
+}
+
+func (p *Provider) add(operation string, c Counts) {
+	p.Counts.add(c)
+
+	if p.Operations == nil {
+		p.Operations = make(map[string]*Counts)
+	}
+	op, ok := p.Operations[operation]
+	if !ok {
+		op = new(Counts)
+		p.Operations[operation] = op
+	}
+	op.add(c)
+}
+
+func (p *Provider) merge(o *Provider) {
+	for name, c := range o.Operations {
+		p.add(name, *c)
+	}
+}
+
+var (
+	lock      sync.Mutex
+	providers map[string]*Provider
+	dirty     bool
+)
+
+// Enabled returns true if API calls are being recorded.
+func Enabled() bool {
+	return os.Getenv(DirEnvVar) != ""
+}
+
+// RecordAPICall adds c to the counts of the given provider and operation.
+// The operation is named by the provider, e.g. "ec2.DescribeInstances".
+func RecordAPICall(provider, operation string, c Counts) {
+	if !Enabled() {
+		return
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	if providers == nil {
+		providers = make(map[string]*Provider)
+	}
+	p, ok := providers[provider]
+	if !ok {
+		p = new(Provider)
+		providers[provider] = p
+	}
+	p.add(operation, c)
+	dirty = true
+}
+
+// Flush writes the counts recorded so far to this process' file in the
+// metrics directory. Providers can be stopped at any time once they're
+// done, so this is called after every operation that may call APIs.
+// Errors are only logged, as metrics must never fail a run.
+func Flush() {
+	dir := os.Getenv(DirEnvVar)
+	if dir == "" {
+		return
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	if !dirty {
+		return
+	}
+
+	raw, err := json.Marshal(providers)
+	if err != nil {
+		log.Printf("[WARN] Error encoding API call metrics: %s", err)
+		return
+	}
+
+	// Write to a temporary file first so that Terraform never reads a
+	// partially written one.
+	path := filepath.Join(dir, fmt.Sprintf("%d.json", os.Getpid()))
+	tmpPath := path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, raw, 0600); err != nil {
+		log.Printf("[WARN] Error writing API call metrics: %s", err)
+		return
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		log.Printf("[WARN] Error writing API call metrics: %s", err)
+		return
+	}
+
+	dirty = false
+}
+
+// ReadDir reads the counts written by providers to dir, merging those of
+// providers of the same name.
+func ReadDir(dir string) (map[string]*Provider, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]*Provider)
+	for _, f := range files {
+		if !strings.HasSuffix(f.Name(), ".json") {
+			continue
+		}
+
+		raw, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		var ps map[string]*Provider
+		if err := json.Unmarshal(raw, &ps); err != nil {
+			return nil, fmt.Errorf("Error reading %s: %s", f.Name(), err)
+		}
+
+		for name, p := range ps {
+			if _, ok := result[name]; !ok {
+				result[name] = new(Provider)
+			}
+			result[name].merge(p)
+		}
+	}
+
+	return result, nil
+}
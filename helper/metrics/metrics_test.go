@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestRecordAPICall_disabled(t *testing.T) {
+	os.Unsetenv(DirEnvVar)
+	providers = nil
+
+	RecordAPICall("aws", "ec2.DescribeInstances", Counts{Calls: 1})
+	if providers != nil {
+		t.Fatalf("recorded while disabled: %#v", providers)
+	}
+}
+
+func TestFlushReadDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-metrics")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	os.Setenv(DirEnvVar, dir)
+	defer os.Unsetenv(DirEnvVar)
+	providers = nil
+
+	RecordAPICall("aws", "ec2.DescribeInstances", Counts{Calls: 1, Retries: 2})
+	RecordAPICall("aws", "ec2.DescribeInstances", Counts{Calls: 1, Errors: 1})
+	RecordAPICall("aws", "ec2.DescribeInstances", Counts{Throttles: 1})
+	RecordAPICall("aws", "iam.GetUser", Counts{Calls: 1})
+	Flush()
+
+	// Another provider process writing counts for the same provider.
+	other := `{"aws": {"calls": 1, "operations": {"iam.GetUser": {"calls": 1}}}}`
+	if err := ioutil.WriteFile(filepath.Join(dir, "1.json"), []byte(other), 0600); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	actual, err := ReadDir(dir)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := map[string]*Provider{
+		"aws": &Provider{
+			Counts: Counts{Calls: 4, Retries: 2, Throttles: 1, Errors: 1},
+			Operations: map[string]*Counts{
+				"ec2.DescribeInstances": &Counts{Calls: 2, Retries: 2, Throttles: 1, Errors: 1},
+				"iam.GetUser":           &Counts{Calls: 2},
+			},
+		},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: %#v", actual["aws"])
+	}
+}
@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// mockDataApply is used in place of ReadDataApply in mock mode. Rather
+// than reading the data source, every computed attribute that isn't set
+// in the configuration gets a placeholder value from mockValue.
+func (r *Resource) mockDataApply(
+	info *terraform.InstanceInfo,
+	d *terraform.InstanceDiff) (*terraform.InstanceState, error) {
+	data, err := schemaMap(r.Schema).Data(nil, d)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[INFO] Returning placeholder values for %s", info.Id)
+	for k, s := range r.Schema {
+		if !s.Computed || s.Removed != "" {
+			continue
+		}
+		if _, ok := data.GetOk(k); ok {
+			continue
+		}
+
+		if err := data.Set(k, mockValue(k, s)); err != nil {
+			return nil, fmt.Errorf("%s: error setting placeholder for %s: %s", info.Id, k, err)
+		}
+	}
+
+	data.SetId(fmt.Sprintf("mock-%s", info.Type))
+	return data.State(), nil
+}
+
+// mockValue returns a placeholder value for the attribute k with the given
+// schema. Strings are "mock-" followed by the attribute name, numbers are
+// 1 and lists and sets have a single element, so that they can be indexed
+// and counted by the configuration that uses them.
+func mockValue(k string, s *Schema) interface{} {
+	switch s.Type {
+	case TypeBool:
+		return false
+	case TypeInt:
+		return 1
+	case TypeFloat:
+		return 1.0
+	case TypeString:
+		return fmt.Sprintf("mock-%s", k)
+	case TypeMap:
+		return map[string]interface{}{}
+	case TypeList, TypeSet:
+		switch e := s.Elem.(type) {
+		case *Schema:
+			return []interface{}{mockValue(k, e)}
+		case *Resource:
+			m := make(map[string]interface{})
+			for ek, es := range e.Schema {
+				if es.Removed != "" {
+					continue
+				}
+				m[ek] = mockValue(ek, es)
+			}
+			return []interface{}{m}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package schema
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestProvider_mock(t *testing.T) {
+	os.Setenv(MockEnvVar, "1")
+	defer os.Unsetenv(MockEnvVar)
+
+	fail := func(d *ResourceData, meta interface{}) error {
+		return fmt.Errorf("no API calls in mock mode")
+	}
+	p := &Provider{
+		ConfigureFunc: func(d *ResourceData) (interface{}, error) {
+			return nil, fmt.Errorf("no credentials")
+		},
+		ResourcesMap: map[string]*Resource{
+			"foo": &Resource{
+				Schema: map[string]*Schema{
+					"bar": &Schema{
+						Type:     TypeString,
+						Optional: true,
+					},
+				},
+				Create: fail,
+				Read:   fail,
+				Delete: fail,
+				Importer: &ResourceImporter{
+					State: ImportStatePassthrough,
+				},
+			},
+		},
+		DataSourcesMap: map[string]*Resource{
+			"baz": &Resource{
+				Schema: map[string]*Schema{
+					"name": &Schema{
+						Type:     TypeString,
+						Required: true,
+					},
+					"region": &Schema{
+						Type:     TypeString,
+						Optional: true,
+						Computed: true,
+					},
+					"arn": &Schema{
+						Type:     TypeString,
+						Computed: true,
+					},
+					"size": &Schema{
+						Type:     TypeInt,
+						Computed: true,
+					},
+					"ids": &Schema{
+						Type:     TypeList,
+						Computed: true,
+						Elem:     &Schema{Type: TypeString},
+					},
+					"zones": &Schema{
+						Type:     TypeSet,
+						Computed: true,
+						Elem:     &Schema{Type: TypeString},
+						Set:      HashString,
+					},
+					"tags": &Schema{
+						Type:     TypeMap,
+						Computed: true,
+					},
+					"rule": &Schema{
+						Type:     TypeList,
+						Computed: true,
+						Elem: &Resource{
+							Schema: map[string]*Schema{
+								"port": &Schema{
+									Type:     TypeInt,
+									Computed: true,
+								},
+								"enabled": &Schema{
+									Type:     TypeBool,
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+				Read: fail,
+			},
+		},
+	}
+
+	if err := p.Configure(terraform.NewResourceConfig(nil)); err != nil {
+		t.Fatalf("configure: %s", err)
+	}
+
+	info := &terraform.InstanceInfo{Id: "foo.a", Type: "foo"}
+	state := &terraform.InstanceState{
+		ID:         "a",
+		Attributes: map[string]string{"bar": "baz"},
+	}
+	refreshed, err := p.Refresh(info, state)
+	if err != nil {
+		t.Fatalf("refresh: %s", err)
+	}
+	if !reflect.DeepEqual(refreshed, state) {
+		t.Fatalf("bad refresh: %#v", refreshed)
+	}
+
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"bar": &terraform.ResourceAttrDiff{New: "qux"},
+		},
+	}
+	if _, err := p.Apply(info, nil, diff); err == nil {
+		t.Fatal("apply should error")
+	}
+	if _, err := p.ImportState(info, "a"); err == nil {
+		t.Fatal("import should error")
+	}
+
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"name":   "web",
+		"region": "us-west-2",
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	dataInfo := &terraform.InstanceInfo{Id: "data.baz.a", Type: "baz"}
+	dataDiff, err := p.ReadDataDiff(dataInfo, terraform.NewResourceConfig(raw))
+	if err != nil {
+		t.Fatalf("diff: %s", err)
+	}
+	dataState, err := p.ReadDataApply(dataInfo, dataDiff)
+	if err != nil {
+		t.Fatalf("read: %s", err)
+	}
+
+	expected := map[string]string{
+		"id":               "mock-baz",
+		"name":             "web",
+		"region":           "us-west-2",
+		"arn":              "mock-arn",
+		"size":             "1",
+		"ids.#":            "1",
+		"ids.0":            "mock-ids",
+		"zones.#":          "1",
+		"zones.1799767577": "mock-zones",
+		"tags.%":           "0",
+		"rule.#":           "1",
+		"rule.0.port":      "1",
+		"rule.0.enabled":   "false",
+	}
+	if !reflect.DeepEqual(dataState.Attributes, expected) {
+		t.Fatalf("bad:\n\nexpected: %#v\n\ngot: %#v", expected, dataState.Attributes)
+	}
+}
@@ -3,9 +3,12 @@ package schema
 import (
 	"errors"
 	"fmt"
+	"log"
+	"os"
 	"sort"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/metrics"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -48,9 +51,32 @@ type Provider struct {
 	// See the ConfigureFunc documentation for more information.
 	ConfigureFunc ConfigureFunc
 
+	// DiffValidateFunc is an optional function that is called with the
+	// configuration of a resource before it is diffed. Unlike the
+	// validation of the resource schema it runs after the provider is
+	// configured, so it can enforce rules that depend on provider-level
+	// settings. Returning an error fails the plan.
+	//
+	// See the DiffValidateFunc documentation for more information.
+	DiffValidateFunc DiffValidateFunc
+
+	// ReadMetaFunc is an optional function that returns the meta value
+	// passed to resources and data sources for read-only operations:
+	// refresh, data source reads and import. It is given the meta value
+	// returned by ConfigureFunc. This allows a provider to plan and refresh
+	// with separate, more restricted credentials than it applies with.
+	ReadMetaFunc ReadMetaFunc
+
 	meta interface{}
 }
 
+// MockEnvVar is the environment variable that puts providers into mock
+// mode when it is set. In mock mode a provider never calls an API: it
+// isn't configured, refreshing returns the existing state and data sources
+// return placeholder values derived from their schema. This allows plans
+// to be tested without credentials. Applying and importing fail.
+const MockEnvVar = "TF_MOCK_PROVIDERS"
+
 // ConfigureFunc is the function used to configure a Provider.
 //
 // The interface{} value returned by this function is stored and passed into
@@ -59,6 +85,19 @@ type Provider struct {
 // structure, etc.
 type ConfigureFunc func(*ResourceData) (interface{}, error)
 
+// ReadMetaFunc is the function used to derive the meta value for read-only
+// operations from the meta value returned by ConfigureFunc.
+type ReadMetaFunc func(interface{}) interface{}
+
+// DiffValidateFunc is the function used to validate the configuration of
+// a resource in the context of the configured Provider.
+//
+// It is given the instance being diffed, the Resource that will compute
+// the diff, the resource configuration and the meta value returned by
+// ConfigureFunc.
+type DiffValidateFunc func(
+	*terraform.InstanceInfo, *Resource, *terraform.ResourceConfig, interface{}) error
+
 // InternalValidate should be called to validate the structure
 // of the provider.
 //
@@ -104,6 +143,15 @@ func (p *Provider) SetMeta(v interface{}) {
 	p.meta = v
 }
 
+// readMeta returns the meta value for read-only operations.
+func (p *Provider) readMeta() interface{} {
+	if p.ReadMetaFunc == nil {
+		return p.meta
+	}
+
+	return p.ReadMetaFunc(p.meta)
+}
+
 // Input implementation of terraform.ResourceProvider interface.
 func (p *Provider) Input(
 	input terraform.UIInput,
@@ -142,6 +190,11 @@ func (p *Provider) Configure(c *terraform.ResourceConfig) error {
 		return nil
 	}
 
+	if mockMode() {
+		log.Printf("[INFO] %s is set, not configuring the provider", MockEnvVar)
+		return nil
+	}
+
 	sm := schemaMap(p.Schema)
 
 	// Get a ResourceData for this configuration. To do this, we actually
@@ -156,6 +209,8 @@ func (p *Provider) Configure(c *terraform.ResourceConfig) error {
 		return err
 	}
 
+	// Configuring may call APIs, e.g. to check credentials.
+	defer metrics.Flush()
 	meta, err := p.ConfigureFunc(data)
 	if err != nil {
 		return err
@@ -175,6 +230,12 @@ func (p *Provider) Apply(
 		return nil, fmt.Errorf("unknown resource type: %s", info.Type)
 	}
 
+	if mockMode() {
+		return nil, fmt.Errorf(
+			"%s: can't apply while %s is set", info.Id, MockEnvVar)
+	}
+
+	defer metrics.Flush()
 	return r.Apply(s, d, p.meta)
 }
 
@@ -188,7 +249,13 @@ func (p *Provider) Diff(
 		return nil, fmt.Errorf("unknown resource type: %s", info.Type)
 	}
 
-	return r.Diff(s, c)
+	if p.DiffValidateFunc != nil && !mockMode() {
+		if err := p.DiffValidateFunc(info, r, c, p.meta); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.diff(s, c, p.meta)
 }
 
 // Refresh implementation of terraform.ResourceProvider interface.
@@ -200,7 +267,12 @@ func (p *Provider) Refresh(
 		return nil, fmt.Errorf("unknown resource type: %s", info.Type)
 	}
 
-	return r.Refresh(s, p.meta)
+	if mockMode() {
+		return s, nil
+	}
+
+	defer metrics.Flush()
+	return r.Refresh(s, p.readMeta())
 }
 
 // Resources implementation of terraform.ResourceProvider interface.
@@ -244,6 +316,12 @@ func (p *Provider) ImportState(
 		return nil, fmt.Errorf("resource %s doesn't support import", info.Type)
 	}
 
+	if mockMode() {
+		return nil, fmt.Errorf("can't import while %s is set", MockEnvVar)
+	}
+
+	defer metrics.Flush()
+
 	// Create the data
 	data := r.Data(nil)
 	data.SetId(id)
@@ -253,7 +331,7 @@ func (p *Provider) ImportState(
 	results := []*ResourceData{data}
 	if r.Importer.State != nil {
 		var err error
-		results, err = r.Importer.State(data, p.meta)
+		results, err = r.Importer.State(data, p.readMeta())
 		if err != nil {
 			return nil, err
 		}
@@ -314,7 +392,12 @@ func (p *Provider) ReadDataApply(
 		return nil, fmt.Errorf("unknown data source: %s", info.Type)
 	}
 
-	return r.ReadDataApply(d, p.meta)
+	if mockMode() {
+		return r.mockDataApply(info, d)
+	}
+
+	defer metrics.Flush()
+	return r.ReadDataApply(d, p.readMeta())
 }
 
 // DataSources implementation of terraform.ResourceProvider interface.
@@ -334,3 +417,7 @@ func (p *Provider) DataSources() []terraform.DataSource {
 
 	return result
 }
+
+func mockMode() bool {
+	return os.Getenv(MockEnvVar) != ""
+}
@@ -316,6 +316,156 @@ func TestProviderImportState_setsType(t *testing.T) {
 	}
 }
 
+func TestProviderDiff_planCheck(t *testing.T) {
+	var configured []bool
+	p := &Provider{
+		ResourcesMap: map[string]*Resource{
+			"foo": &Resource{
+				Schema: map[string]*Schema{
+					"bar": &Schema{
+						Type:     TypeString,
+						Optional: true,
+						Computed: true,
+					},
+				},
+				PlanCheck: func(d *ResourceData, meta interface{}) ([]string, error) {
+					if meta != 42 {
+						t.Fatalf("bad meta: %#v", meta)
+					}
+					configured = append(configured, d.IsConfigured("bar"))
+					return nil, nil
+				},
+			},
+		},
+	}
+	p.SetMeta(42)
+
+	info := &terraform.InstanceInfo{Id: "foo.baz", Type: "foo"}
+	state := &terraform.InstanceState{
+		ID:         "baz",
+		Attributes: map[string]string{"bar": "value"},
+	}
+
+	for _, c := range []map[string]interface{}{
+		map[string]interface{}{"bar": "value"},
+		map[string]interface{}{},
+	} {
+		raw, err := config.NewRawConfig(c)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if _, err := p.Diff(info, state, terraform.NewResourceConfig(raw)); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	expected := []bool{true, false}
+	if !reflect.DeepEqual(configured, expected) {
+		t.Fatalf("bad: %#v", configured)
+	}
+}
+
+func TestProviderDiff_diffValidateFunc(t *testing.T) {
+	var called bool
+	p := &Provider{
+		ResourcesMap: map[string]*Resource{
+			"foo": &Resource{
+				Schema: map[string]*Schema{
+					"bar": &Schema{
+						Type:     TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+		DiffValidateFunc: func(info *terraform.InstanceInfo, r *Resource, c *terraform.ResourceConfig, meta interface{}) error {
+			called = true
+			if meta != 42 {
+				t.Fatalf("bad meta: %#v", meta)
+			}
+			if v, ok := c.Get("bar"); ok && v == "invalid" {
+				return fmt.Errorf("%s: bar is invalid", info.Id)
+			}
+			return nil
+		},
+	}
+	p.SetMeta(42)
+
+	info := &terraform.InstanceInfo{Id: "foo.baz", Type: "foo"}
+
+	raw, err := config.NewRawConfig(map[string]interface{}{"bar": "valid"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := p.Diff(info, nil, terraform.NewResourceConfig(raw)); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !called {
+		t.Fatal("DiffValidateFunc should be called")
+	}
+
+	raw, err = config.NewRawConfig(map[string]interface{}{"bar": "invalid"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := p.Diff(info, nil, terraform.NewResourceConfig(raw)); err == nil {
+		t.Fatal("should error")
+	}
+}
+
+func TestProviderRefresh_readMetaFunc(t *testing.T) {
+	var readMeta, applyMeta interface{}
+	p := &Provider{
+		ResourcesMap: map[string]*Resource{
+			"foo": &Resource{
+				Schema: map[string]*Schema{
+					"bar": &Schema{
+						Type:     TypeString,
+						Optional: true,
+					},
+				},
+				Create: func(d *ResourceData, meta interface{}) error {
+					applyMeta = meta
+					d.SetId("baz")
+					return nil
+				},
+				Read: func(d *ResourceData, meta interface{}) error {
+					readMeta = meta
+					return nil
+				},
+				Delete: func(d *ResourceData, meta interface{}) error {
+					return nil
+				},
+			},
+		},
+		ReadMetaFunc: func(meta interface{}) interface{} {
+			return meta.(int) + 1
+		},
+	}
+	p.SetMeta(42)
+
+	info := &terraform.InstanceInfo{Type: "foo"}
+	state := &terraform.InstanceState{ID: "baz"}
+	if _, err := p.Refresh(info, state); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if readMeta != 43 {
+		t.Fatalf("bad refresh meta: %#v", readMeta)
+	}
+
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"bar": &terraform.ResourceAttrDiff{New: "qux"},
+		},
+	}
+	if _, err := p.Apply(info, nil, diff); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if applyMeta != 42 {
+		t.Fatalf("bad apply meta: %#v", applyMeta)
+	}
+}
+
 func TestProviderMeta(t *testing.T) {
 	p := new(Provider)
 	if v := p.Meta(); v != nil {
@@ -83,6 +83,13 @@ type Resource struct {
 	Delete DeleteFunc
 	Exists ExistsFunc
 
+	// PlanCheck, if set, is called with the planned values of the
+	// resource after its diff is computed. Unlike validation, it can
+	// compare the planned values with the current state and use the
+	// configured provider. The warnings it returns are shown with the plan
+	// but never fail it, while an error fails the plan.
+	PlanCheck PlanCheckFunc
+
 	// Importer is the ResourceImporter implementation for this resource.
 	// If this is nil, then this resource does not support importing. If
 	// this is non-nil, then it supports importing and ResourceImporter
@@ -111,6 +118,11 @@ type DeleteFunc func(*ResourceData, interface{}) error
 // See Resource documentation.
 type ExistsFunc func(*ResourceData, interface{}) (bool, error)
 
+// PlanCheckFunc is the function used to check the planned values of a
+// resource. The meta value is the one returned by ConfigureFunc, or nil
+// if the resource is diffed outside of a configured Provider.
+type PlanCheckFunc func(*ResourceData, interface{}) ([]string, error)
+
 // See Resource documentation.
 type StateMigrateFunc func(
 	int, *terraform.InstanceState, interface{}) (*terraform.InstanceState, error)
@@ -176,7 +188,38 @@ func (r *Resource) Apply(
 func (r *Resource) Diff(
 	s *terraform.InstanceState,
 	c *terraform.ResourceConfig) (*terraform.InstanceDiff, error) {
-	return schemaMap(r.Schema).Diff(s, c)
+	return r.diff(s, c, nil)
+}
+
+func (r *Resource) diff(
+	s *terraform.InstanceState,
+	c *terraform.ResourceConfig,
+	meta interface{}) (*terraform.InstanceDiff, error) {
+	instanceDiff, err := schemaMap(r.Schema).Diff(s, c)
+	if err != nil || r.PlanCheck == nil {
+		return instanceDiff, err
+	}
+
+	data, err := schemaMap(r.Schema).Data(s, instanceDiff)
+	if err != nil {
+		return nil, err
+	}
+	data.config = c
+
+	warns, err := r.PlanCheck(data, meta)
+	if err != nil {
+		return nil, err
+	}
+	if len(warns) > 0 {
+		// The warnings are returned even if nothing changes, so they're
+		// shown on every plan until they're addressed.
+		if instanceDiff == nil {
+			instanceDiff = new(terraform.InstanceDiff)
+		}
+		instanceDiff.Warnings = warns
+	}
+
+	return instanceDiff, nil
 }
 
 // Validate validates the resource configuration against the schema.
@@ -102,6 +102,18 @@ func (d *ResourceData) GetOk(key string) (interface{}, bool) {
 	return r.Value, exists
 }
 
+// IsConfigured returns whether the given key is set in the configuration
+// of the resource, as opposed to only being known from the state. It's
+// only meaningful while the resource is diffed, such as in a PlanCheckFunc,
+// and is always false otherwise.
+func (d *ResourceData) IsConfigured(key string) bool {
+	if d.config == nil {
+		return false
+	}
+
+	return d.getRaw(key, getSourceConfig|getSourceExact).Exists
+}
+
 func (d *ResourceData) getRaw(key string, level getSource) getResult {
 	var parts []string
 	if key != "" {
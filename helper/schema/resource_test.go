@@ -6,9 +6,87 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func TestResourceDiff_planCheck(t *testing.T) {
+	r := &Resource{
+		Schema: map[string]*Schema{
+			"foo": &Schema{
+				Type:     TypeString,
+				Optional: true,
+			},
+		},
+		PlanCheck: func(d *ResourceData, meta interface{}) ([]string, error) {
+			if d.Get("foo").(string) == "bad" {
+				return nil, fmt.Errorf("foo can't be bad")
+			}
+			if o, n := d.GetChange("foo"); o.(string) != "" && n.(string) == "" {
+				return []string{"removing foo is deprecated"}, nil
+			}
+			if d.Get("foo").(string) == "old" {
+				return []string{"foo = old is deprecated"}, nil
+			}
+			return nil, nil
+		},
+	}
+
+	state := &terraform.InstanceState{
+		ID:         "bar",
+		Attributes: map[string]string{"foo": "baz"},
+	}
+
+	cases := []struct {
+		State    *terraform.InstanceState
+		Config   map[string]interface{}
+		Empty    bool
+		Warnings []string
+		Err      bool
+	}{
+		{nil, map[string]interface{}{"foo": "baz"}, false, nil, false},
+		{state, map[string]interface{}{}, false, []string{"removing foo is deprecated"}, false},
+		{nil, map[string]interface{}{"foo": "old"}, false, []string{"foo = old is deprecated"}, false},
+		{nil, map[string]interface{}{"foo": "bad"}, false, nil, true},
+		{
+			&terraform.InstanceState{
+				ID:         "bar",
+				Attributes: map[string]string{"foo": "old"},
+			},
+			map[string]interface{}{"foo": "old"},
+			true,
+			[]string{"foo = old is deprecated"},
+			false,
+		},
+	}
+
+	for i, tc := range cases {
+		c, err := config.NewRawConfig(tc.Config)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		diff, err := r.Diff(tc.State, terraform.NewResourceConfig(c))
+		if err != nil != tc.Err {
+			t.Fatalf("%d: bad err: %s", i, err)
+		}
+		if err != nil {
+			continue
+		}
+		if diff.Empty() != tc.Empty {
+			t.Fatalf("%d: expected empty %t, got %#v", i, tc.Empty, diff)
+		}
+
+		var warns []string
+		if diff != nil {
+			warns = diff.Warnings
+		}
+		if !reflect.DeepEqual(warns, tc.Warnings) {
+			t.Fatalf("%d: bad warnings: %#v", i, warns)
+		}
+	}
+}
+
 func TestResourceApply_create(t *testing.T) {
 	r := &Resource{
 		SchemaVersion: 2,
@@ -10,7 +10,10 @@ import (
 	"sync"
 
 	"github.com/hashicorp/go-plugin"
+	"github.com/hashicorp/terraform/builtin/varsources/kms"
+	"github.com/hashicorp/terraform/builtin/varsources/vault"
 	"github.com/hashicorp/terraform/helper/logging"
+	"github.com/hashicorp/terraform/terraform"
 	"github.com/mattn/go-colorable"
 	"github.com/mitchellh/cli"
 	"github.com/mitchellh/panicwrap"
@@ -146,6 +149,11 @@ func wrappedMain() int {
 	// Initialize the TFConfig settings for the commands...
 	ContextOpts.Providers = config.ProviderFactories()
 	ContextOpts.Provisioners = config.ProvisionerFactories()
+	ContextOpts.VariableSources = map[string]terraform.VariableSource{
+		"kms":   kms.VariableSource(),
+		"vault": vault.VariableSource(),
+	}
+	Notifier.Configs = config.NotificationConfigs()
 
 	exitCode, err := cli.Run()
 	if err != nil {
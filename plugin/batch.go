@@ -0,0 +1,97 @@
+package plugin
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// batchWindow is how long a call waits for other calls to join its
+	// batch before the batch is sent.
+	batchWindow = 2 * time.Millisecond
+
+	// batchMaxSize is the maximum number of calls sent in one batch.
+	batchMaxSize = 64
+)
+
+// rpcBatcher coalesces concurrent calls of one RPC method into batches, so
+// that a configuration with thousands of resources doesn't pay a process
+// round-trip for each of them. Calls made within Window of each other are
+// sent together by Flush, and each call returns once its batch has been
+// answered.
+type rpcBatcher struct {
+	Window  time.Duration
+	MaxSize int
+
+	// Flush sends a batch of calls. It must set the result of every call
+	// and call done on it.
+	Flush func([]*batchCall)
+
+	lock    sync.Mutex
+	pending []*batchCall
+	timer   *time.Timer
+}
+
+// batchCall is a single call waiting in a batch.
+type batchCall struct {
+	Args   interface{}
+	Result interface{}
+	Err    error
+
+	doneCh chan struct{}
+}
+
+func (c *batchCall) done() {
+	close(c.doneCh)
+}
+
+// Call adds a call with the given arguments to the next batch and waits
+// for its result.
+func (b *rpcBatcher) Call(args interface{}) (interface{}, error) {
+	c := &batchCall{Args: args, doneCh: make(chan struct{})}
+
+	b.lock.Lock()
+	b.pending = append(b.pending, c)
+	if len(b.pending) >= b.MaxSize {
+		calls := b.take()
+		b.lock.Unlock()
+		b.Flush(calls)
+	} else {
+		if b.timer == nil {
+			b.timer = time.AfterFunc(b.Window, b.flushPending)
+		}
+		b.lock.Unlock()
+	}
+
+	<-c.doneCh
+	return c.Result, c.Err
+}
+
+func (b *rpcBatcher) flushPending() {
+	b.lock.Lock()
+	calls := b.take()
+	b.lock.Unlock()
+
+	if len(calls) > 0 {
+		b.Flush(calls)
+	}
+}
+
+// take removes the pending calls. The lock must be held.
+func (b *rpcBatcher) take() []*batchCall {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	calls := b.pending
+	b.pending = nil
+	return calls
+}
+
+// isMissingMethod reports whether err is the error net/rpc returns when the
+// server doesn't have the called method, such as a plugin built before
+// batch calls existed.
+func isMissingMethod(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "can't find method")
+}
@@ -0,0 +1,54 @@
+package plugin
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRPCBatcher(t *testing.T) {
+	var lock sync.Mutex
+	var sizes []int
+	b := &rpcBatcher{
+		Window:  50 * time.Millisecond,
+		MaxSize: 4,
+		Flush: func(calls []*batchCall) {
+			lock.Lock()
+			sizes = append(sizes, len(calls))
+			lock.Unlock()
+
+			for _, c := range calls {
+				c.Result = c.Args.(int) * 2
+				c.done()
+			}
+		},
+	}
+
+	results := make([]interface{}, 6)
+	var wg sync.WaitGroup
+	for i := 0; i < len(results); i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = b.Call(i)
+		}(i)
+	}
+	wg.Wait()
+
+	for i, r := range results {
+		if r != i*2 {
+			t.Fatalf("%d: bad: %#v", i, r)
+		}
+	}
+
+	total := 0
+	for _, n := range sizes {
+		if n > b.MaxSize {
+			t.Fatalf("batch too large: %v", sizes)
+		}
+		total += n
+	}
+	if total != len(results) || len(sizes) >= len(results) {
+		t.Fatalf("calls should be batched: %v", sizes)
+	}
+}
@@ -0,0 +1,135 @@
+// Package discovery locates plugin binaries on disk and chooses between the
+// versions of each plugin that are available.
+package discovery
+
+import (
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"strings"
+)
+
+// FindPlugins looks in the given directories for files whose filenames
+// suggest that they are versioned plugins of the given kind (e.g. "provider")
+// and returns a PluginMetaSet representing the discovered potential-plugins.
+//
+// Currently this supports only the naming scheme
+// terraform-<KIND>-<NAME>_v<VERSION>, with an optional ".exe" suffix on
+// Windows. Unversioned plugin binaries are left to the legacy discovery
+// done at startup.
+//
+// This is a convenience wrapper around FindPluginPaths and ResolvePluginPaths.
+func FindPlugins(kind string, dirs []string) PluginMetaSet {
+	return ResolvePluginPaths(FindPluginPaths(kind, dirs))
+}
+
+// FindPluginPaths looks in the given directories for files whose filenames
+// suggest that they are versioned plugins of the given kind (e.g. "provider").
+//
+// The return value is a list of absolute paths that appear to refer to
+// plugins in the given directories, in the same order as the directories
+// themselves. Directories that do not exist are silently skipped.
+func FindPluginPaths(kind string, dirs []string) []string {
+	prefix := "terraform-" + kind + "-"
+
+	var ret []string
+	for _, dir := range dirs {
+		items, err := ioutil.ReadDir(dir)
+		if err != nil {
+			// Ignore missing dirs, non-dirs, etc
+			continue
+		}
+
+		log.Printf("[DEBUG] checking for %s plugins in %q", kind, dir)
+
+		for _, item := range items {
+			fullName := item.Name()
+
+			if !strings.HasPrefix(fullName, prefix) {
+				continue
+			}
+
+			// We only care about files; directories and other oddities
+			// can't be plugins.
+			if !item.Mode().IsRegular() {
+				continue
+			}
+
+			// Only versioned plugins are considered here.
+			if !strings.Contains(fullName, "_v") {
+				continue
+			}
+
+			absPath, err := filepath.Abs(filepath.Join(dir, fullName))
+			if err != nil {
+				log.Printf("[ERROR] plugin filepath error: %s", err)
+				continue
+			}
+
+			// Shared plugin directories may hold plugins cross-compiled
+			// for other platforms, which would fail the handshake.
+			if p := ExecutablePlatform(absPath); !CurrentPlatform.Runs(p) {
+				log.Printf("[WARN] ignoring %s %q built for %s", kind, fullName, p)
+				continue
+			}
+
+			log.Printf("[DEBUG] found %s %q", kind, fullName)
+			ret = append(ret, filepath.Clean(absPath))
+		}
+	}
+
+	return ret
+}
+
+// ResolvePluginPaths takes a list of paths to plugin executables (as returned
+// by e.g. FindPluginPaths) and produces a PluginMetaSet describing the
+// referenced plugins.
+//
+// If the same combination of plugin name and version appears multiple times,
+// the earlier reference will be preferred. Several different versions of
+// the same plugin name may be returned, in which case the methods of
+// PluginMetaSet can be used to filter down.
+func ResolvePluginPaths(paths []string) PluginMetaSet {
+	s := make(PluginMetaSet)
+
+	type nameVersion struct {
+		Name    string
+		Version string
+	}
+	found := make(map[nameVersion]struct{})
+
+	for _, path := range paths {
+		baseName := strings.ToLower(filepath.Base(path))
+
+		// Trim the "terraform-<KIND>-" prefix, where KIND is one word.
+		parts := strings.SplitN(baseName, "-", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		baseName = strings.TrimSuffix(parts[2], ".exe")
+
+		idx := strings.LastIndex(baseName, "_v")
+		if idx < 1 {
+			continue
+		}
+		name := baseName[:idx]
+		version := baseName[idx+2:]
+
+		if _, ok := found[nameVersion{name, version}]; ok {
+			// Skip duplicate versions of the same plugin
+			// (We do this during this step because after this we will be
+			// dealing with sets and thus lose our ordering with which to
+			// decide preference.)
+			continue
+		}
+
+		s.Add(PluginMeta{
+			Name:    name,
+			Version: VersionStr(version),
+			Path:    path,
+		})
+		found[nameVersion{name, version}] = struct{}{}
+	}
+
+	return s
+}
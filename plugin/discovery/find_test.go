@@ -0,0 +1,78 @@
+package discovery
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestFindPluginPaths(t *testing.T) {
+	got := FindPluginPaths(
+		"provider",
+		[]string{
+			"test-fixtures/plugin-cache",
+			"test-fixtures/legacy-style-plugins",
+			"test-fixtures/non-existent",
+		},
+	)
+
+	want := []string{
+		filepath.Join(fixtureDir(t), "plugin-cache", "terraform-provider-bar_v1.0.0"),
+		filepath.Join(fixtureDir(t), "plugin-cache", "terraform-provider-bar_vnotaversion"),
+		filepath.Join(fixtureDir(t), "plugin-cache", "terraform-provider-foo_v0.0.1"),
+		filepath.Join(fixtureDir(t), "plugin-cache", "terraform-provider-foo_v1.0.0"),
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("bad\ngot:  %#v\nwant: %#v", got, want)
+	}
+}
+
+func TestResolvePluginPaths(t *testing.T) {
+	got := ResolvePluginPaths([]string{
+		"/example/mockos_mockarch/terraform-provider-foo_v0.0.1",
+		"/example/mockos_mockarch/terraform-provider-foo_v0.0.2",
+		"/example2/mockos_mockarch/terraform-provider-foo_v0.0.1",
+		"/example/mockos_mockarch/terraform-provider-bar_v0.0.1.exe",
+		"/example/mockos_mockarch/terraform-provider-baz-v0.0.1",
+		"/example/mockos_mockarch/terraform-provider-boo",
+	})
+
+	want := []PluginMeta{
+		{
+			Name:    "foo",
+			Version: "0.0.1",
+			Path:    "/example/mockos_mockarch/terraform-provider-foo_v0.0.1",
+		},
+		{
+			Name:    "foo",
+			Version: "0.0.2",
+			Path:    "/example/mockos_mockarch/terraform-provider-foo_v0.0.2",
+		},
+		{
+			Name:    "bar",
+			Version: "0.0.1",
+			Path:    "/example/mockos_mockarch/terraform-provider-bar_v0.0.1.exe",
+		},
+	}
+
+	if got.Count() != len(want) {
+		t.Fatalf("wrong number of results %d; want %d\n%#v", got.Count(), len(want), got)
+	}
+
+	for _, wantMeta := range want {
+		if !got.Has(wantMeta) {
+			t.Errorf("missing %#v", wantMeta)
+		}
+	}
+}
+
+func fixtureDir(t *testing.T) string {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	return filepath.Join(wd, "test-fixtures")
+}
@@ -0,0 +1,188 @@
+package discovery
+
+import (
+	"testing"
+)
+
+func TestPluginMetaSetValidateVersions(t *testing.T) {
+	metas := []PluginMeta{
+		{
+			Name:    "foo",
+			Version: "1.0.0",
+			Path:    "test-foo",
+		},
+		{
+			Name:    "bar",
+			Version: "0.0.1",
+			Path:    "test-bar",
+		},
+		{
+			Name:    "baz",
+			Version: "bananas",
+			Path:    "test-bar",
+		},
+	}
+	s := make(PluginMetaSet)
+
+	for _, p := range metas {
+		s.Add(p)
+	}
+
+	valid, invalid := s.ValidateVersions()
+	if count := valid.Count(); count != 2 {
+		t.Errorf("valid set has %d metas; want 2", count)
+	}
+	if count := invalid.Count(); count != 1 {
+		t.Errorf("valid set has %d metas; want 1", count)
+	}
+
+	if !valid.Has(metas[0]) {
+		t.Errorf("'foo' not in valid set")
+	}
+	if !valid.Has(metas[1]) {
+		t.Errorf("'bar' not in valid set")
+	}
+	if !invalid.Has(metas[2]) {
+		t.Errorf("'baz' not in invalid set")
+	}
+
+	if invalid.Has(metas[0]) {
+		t.Errorf("'foo' in invalid set")
+	}
+	if invalid.Has(metas[1]) {
+		t.Errorf("'bar' in invalid set")
+	}
+	if valid.Has(metas[2]) {
+		t.Errorf("'baz' in valid set")
+	}
+}
+
+func TestPluginMetaSetWithName(t *testing.T) {
+	s := make(PluginMetaSet)
+	s.Add(PluginMeta{Name: "foo", Version: "1.0.0", Path: "foo-1"})
+	s.Add(PluginMeta{Name: "foo", Version: "2.0.0", Path: "foo-2"})
+	s.Add(PluginMeta{Name: "bar", Version: "1.0.0", Path: "bar-1"})
+
+	if count := s.WithName("foo").Count(); count != 2 {
+		t.Errorf("got %d metas named foo; want 2", count)
+	}
+	if count := s.WithName("baz").Count(); count != 0 {
+		t.Errorf("got %d metas named baz; want 0", count)
+	}
+}
+
+func TestPluginMetaSetNewest(t *testing.T) {
+	tests := []struct {
+		versions []string
+		want     string
+	}{
+		{
+			[]string{
+				"0.0.1",
+			},
+			"0.0.1",
+		},
+		{
+			[]string{
+				"0.0.1",
+				"0.0.2",
+			},
+			"0.0.2",
+		},
+		{
+			[]string{
+				"1.0.0",
+				"1.0.0-beta1",
+			},
+			"1.0.0",
+		},
+		{
+			[]string{
+				"0.10.0",
+				"0.9.0",
+				"0.2.0",
+			},
+			"0.10.0",
+		},
+	}
+
+	for _, test := range tests {
+		s := make(PluginMetaSet)
+		for _, version := range test.versions {
+			s.Add(PluginMeta{
+				Name:    "foo",
+				Version: VersionStr(version),
+				Path:    "foo-V" + version,
+			})
+		}
+
+		newest := s.Newest()
+		if newest.Version != VersionStr(test.want) {
+			t.Errorf("%v: got %s; want %s", test.versions, newest.Version, test.want)
+		}
+	}
+}
+
+func TestPluginMetaSetConstrainVersions(t *testing.T) {
+	metas := []PluginMeta{
+		{
+			Name:    "foo",
+			Version: "1.0.0",
+			Path:    "test-foo",
+		},
+		{
+			Name:    "foo",
+			Version: "2.0.0",
+			Path:    "test-foo",
+		},
+		{
+			Name:    "foo",
+			Version: "3.0.0",
+			Path:    "test-foo",
+		},
+		{
+			Name:    "bar",
+			Version: "0.0.5",
+			Path:    "test-bar",
+		},
+	}
+	s := make(PluginMetaSet)
+
+	for _, p := range metas {
+		s.Add(p)
+	}
+
+	fooConstraints, err := ParseConstraints(">=2.0.0")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	byName := s.ConstrainVersions(PluginRequirements{
+		"foo": fooConstraints,
+		"bar": AllVersions,
+		"baz": AllVersions,
+	})
+	if got, want := len(byName), 3; got != want {
+		t.Errorf("%d keys in map; want %d", got, want)
+	}
+
+	if got, want := byName["foo"].Count(), 2; got != want {
+		t.Errorf("%d metas for 'foo'; want %d", got, want)
+	}
+	if got, want := byName["bar"].Count(), 1; got != want {
+		t.Errorf("%d metas for 'bar'; want %d", got, want)
+	}
+	if got, want := byName["baz"].Count(), 0; got != want {
+		t.Errorf("%d metas for 'baz'; want %d", got, want)
+	}
+
+	if !byName["foo"].Has(metas[1]) {
+		t.Errorf("%#v missing from 'foo' set", metas[1])
+	}
+	if !byName["foo"].Has(metas[2]) {
+		t.Errorf("%#v missing from 'foo' set", metas[2])
+	}
+	if !byName["bar"].Has(metas[3]) {
+		t.Errorf("%#v missing from 'bar' set", metas[3])
+	}
+}
@@ -0,0 +1,115 @@
+package discovery
+
+import (
+	"debug/elf"
+	"debug/macho"
+	"debug/pe"
+	"runtime"
+)
+
+// Platform is the operating system and architecture an executable was built
+// for, using the GOOS and GOARCH names. Either may be empty if it can't be
+// told from the executable.
+type Platform struct {
+	OS   string
+	Arch string
+}
+
+// CurrentPlatform is the platform Terraform is running on.
+var CurrentPlatform = Platform{
+	OS:   runtime.GOOS,
+	Arch: runtime.GOARCH,
+}
+
+func (p Platform) String() string {
+	goos, goarch := p.OS, p.Arch
+	if goos == "" {
+		goos = "unknown"
+	}
+	if goarch == "" {
+		goarch = "unknown"
+	}
+	return goos + "_" + goarch
+}
+
+// compatibleArchs lists, for each architecture, the other architectures
+// whose binaries it usually runs natively.
+var compatibleArchs = map[string][]string{
+	"amd64": {"386"},
+	"arm64": {"arm"},
+}
+
+// Runs reports whether an executable built for other can run on p. Unknown
+// operating systems and architectures are assumed to be compatible, so
+// that plugins are only rejected when they are known not to work.
+func (p Platform) Runs(other Platform) bool {
+	if p.OS != "" && other.OS != "" && p.OS != other.OS {
+		return false
+	}
+	if p.Arch == "" || other.Arch == "" || p.Arch == other.Arch {
+		return true
+	}
+	for _, arch := range compatibleArchs[p.Arch] {
+		if arch == other.Arch {
+			return true
+		}
+	}
+	return false
+}
+
+// ExecutablePlatform reads the header of the executable at path to find
+// the platform it was built for. Files that aren't ELF, Mach-O or PE
+// executables, such as scripts, yield an empty Platform.
+func ExecutablePlatform(path string) Platform {
+	if f, err := elf.Open(path); err == nil {
+		defer f.Close()
+		return elfPlatform(f)
+	}
+	if f, err := macho.Open(path); err == nil {
+		defer f.Close()
+		return Platform{OS: "darwin", Arch: machoArchs[f.Cpu]}
+	}
+	if f, err := pe.Open(path); err == nil {
+		defer f.Close()
+		return Platform{OS: "windows", Arch: peArchs[f.Machine]}
+	}
+	return Platform{}
+}
+
+// elfPlatform returns the platform of an ELF executable. Only FreeBSD
+// binaries are reliably marked with their OS; the others are assumed to
+// be for one of the remaining ELF platforms, which can't be told apart.
+func elfPlatform(f *elf.File) Platform {
+	p := Platform{Arch: elfArchs[f.Machine]}
+	switch f.OSABI {
+	case elf.ELFOSABI_FREEBSD:
+		p.OS = "freebsd"
+	case elf.ELFOSABI_LINUX:
+		p.OS = "linux"
+	default:
+		if CurrentPlatform.OS == "darwin" || CurrentPlatform.OS == "windows" {
+			// Whichever it is, it's not this one.
+			p.OS = "linux"
+		}
+	}
+	return p
+}
+
+var elfArchs = map[elf.Machine]string{
+	elf.EM_386:     "386",
+	elf.EM_X86_64:  "amd64",
+	elf.EM_ARM:     "arm",
+	elf.EM_AARCH64: "arm64",
+}
+
+var machoArchs = map[macho.Cpu]string{
+	macho.Cpu386:   "386",
+	macho.CpuAmd64: "amd64",
+	macho.CpuArm:   "arm",
+}
+
+var peArchs = map[uint16]string{
+	0x14c:  "386",   // IMAGE_FILE_MACHINE_I386
+	0x8664: "amd64", // IMAGE_FILE_MACHINE_AMD64
+	0x1c4:  "arm",   // IMAGE_FILE_MACHINE_ARMNT
+}
@@ -0,0 +1,49 @@
+package discovery
+
+import (
+	"os"
+	"testing"
+)
+
+func TestPlatformRuns(t *testing.T) {
+	cases := []struct {
+		Host     Platform
+		Exe      Platform
+		Expected bool
+	}{
+		{Platform{"linux", "amd64"}, Platform{"linux", "amd64"}, true},
+		{Platform{"linux", "amd64"}, Platform{"linux", "386"}, true},
+		{Platform{"linux", "386"}, Platform{"linux", "amd64"}, false},
+		{Platform{"linux", "arm64"}, Platform{"linux", "arm"}, true},
+		{Platform{"linux", "arm"}, Platform{"linux", "arm64"}, false},
+		{Platform{"linux", "arm"}, Platform{"linux", "amd64"}, false},
+		{Platform{"freebsd", "arm"}, Platform{"freebsd", "arm"}, true},
+		{Platform{"freebsd", "amd64"}, Platform{"linux", "amd64"}, false},
+		{Platform{"freebsd", "amd64"}, Platform{"", "amd64"}, true},
+		{Platform{"windows", "amd64"}, Platform{"linux", "amd64"}, false},
+		{Platform{"linux", "arm"}, Platform{}, true},
+	}
+
+	for _, tc := range cases {
+		if actual := tc.Host.Runs(tc.Exe); actual != tc.Expected {
+			t.Errorf("%s running %s: expected %t, got %t", tc.Host, tc.Exe, tc.Expected, actual)
+		}
+	}
+}
+
+func TestExecutablePlatform(t *testing.T) {
+	// The test binary itself was built for the current platform.
+	self := ExecutablePlatform(os.Args[0])
+	if !CurrentPlatform.Runs(self) {
+		t.Fatalf("test binary detected as %s, running on %s", self, CurrentPlatform)
+	}
+	if self.Arch != CurrentPlatform.Arch {
+		t.Fatalf("expected arch %s, got %s", CurrentPlatform.Arch, self.Arch)
+	}
+
+	// Anything else, such as a script, has an unknown platform.
+	script := ExecutablePlatform("test-fixtures/legacy-style-plugins/terraform-provider-legacy")
+	if script != (Platform{}) {
+		t.Fatalf("expected unknown platform for a script, got %s", script)
+	}
+}
@@ -0,0 +1,96 @@
+package discovery
+
+import (
+	"fmt"
+
+	version "github.com/hashicorp/go-version"
+)
+
+// A VersionStr is a string containing a possibly-invalid representation
+// of a semver version number. Call Parse on it to obtain a real Version
+// object, or discover that it is invalid.
+type VersionStr string
+
+// Parse transforms a VersionStr into a Version if it is
+// syntactically valid. If it isn't then an error is returned instead.
+func (s VersionStr) Parse() (Version, error) {
+	raw, err := version.NewVersion(string(s))
+	if err != nil {
+		return Version{}, err
+	}
+	return Version{raw}, nil
+}
+
+// MustParse transforms a VersionStr into a Version if it is
+// syntactically valid. If it isn't then it panics.
+func (s VersionStr) MustParse() Version {
+	ret, err := s.Parse()
+	if err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// Version represents a version number that has been parsed from
+// a semver string and known to be valid.
+type Version struct {
+	// We wrap this here just because it avoids a proliferation of
+	// direct go-version imports all over the place, and keeps the
+	// version-processing details within this package.
+	raw *version.Version
+}
+
+func (v Version) String() string {
+	return v.raw.String()
+}
+
+// NewerThan returns true if the receiver is a later version than other.
+func (v Version) NewerThan(other Version) bool {
+	return v.raw.GreaterThan(other.raw)
+}
+
+// Constraints represents a set of versions which any given Version is either
+// a member of or not.
+type Constraints struct {
+	raw version.Constraints
+}
+
+// AllVersions is a Constraints containing all versions.
+var AllVersions = Constraints{}
+
+// ParseConstraints parses a string such as ">= 1.0.0, < 2.0.0" into a
+// Constraints, returning an error if the string is not valid.
+func ParseConstraints(s string) (Constraints, error) {
+	raw, err := version.NewConstraint(s)
+	if err != nil {
+		return Constraints{}, fmt.Errorf("invalid version constraint %q: %s", s, err)
+	}
+	return Constraints{raw}, nil
+}
+
+// Allows returns true if the given version permitted by the receiving
+// constraints set.
+func (s Constraints) Allows(v Version) bool {
+	return s.raw.Check(v.raw)
+}
+
+// Append combines the receiving set with the given other set to produce a
+// set that is the intersection of both sets, which is to say that the
+// resulting constraints only allow versions that both sets allow.
+func (s Constraints) Append(other Constraints) Constraints {
+	raw := make(version.Constraints, 0, len(s.raw)+len(other.raw))
+	raw = append(raw, s.raw...)
+	raw = append(raw, other.raw...)
+	return Constraints{raw}
+}
+
+// Unconstrained returns true if the receiver allows every version.
+func (s Constraints) Unconstrained() bool {
+	return len(s.raw) == 0
+}
+
+// String returns a string representation of the set members as a set
+// of range constraints.
+func (s Constraints) String() string {
+	return s.raw.String()
+}
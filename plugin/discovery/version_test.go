@@ -0,0 +1,60 @@
+package discovery
+
+import (
+	"testing"
+)
+
+func TestConstraintsAllows(t *testing.T) {
+	tests := []struct {
+		constraints string
+		version     string
+		want        bool
+	}{
+		{">= 1.0.0", "1.0.0", true},
+		{">= 1.0.0", "0.9.0", false},
+		{"~> 0.1.0", "0.1.9", true},
+		{"~> 0.1.0", "0.2.0", false},
+		{">= 1.0.0, < 2.0.0", "1.5.0", true},
+		{">= 1.0.0, < 2.0.0", "2.0.0", false},
+	}
+
+	for _, test := range tests {
+		c, err := ParseConstraints(test.constraints)
+		if err != nil {
+			t.Fatalf("%q: %s", test.constraints, err)
+		}
+
+		got := c.Allows(VersionStr(test.version).MustParse())
+		if got != test.want {
+			t.Errorf("%q allows %q: got %t; want %t", test.constraints, test.version, got, test.want)
+		}
+	}
+}
+
+func TestConstraintsAppend(t *testing.T) {
+	a, err := ParseConstraints(">= 1.0.0")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	b, err := ParseConstraints("< 2.0.0")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	c := a.Append(b)
+	if !c.Allows(VersionStr("1.2.0").MustParse()) {
+		t.Fatalf("%s should allow 1.2.0", c)
+	}
+	if c.Allows(VersionStr("2.1.0").MustParse()) {
+		t.Fatalf("%s should not allow 2.1.0", c)
+	}
+	if !AllVersions.Allows(VersionStr("2.1.0").MustParse()) {
+		t.Fatalf("AllVersions should allow 2.1.0")
+	}
+}
+
+func TestParseConstraints_invalid(t *testing.T) {
+	if _, err := ParseConstraints("bananas"); err == nil {
+		t.Fatal("should error")
+	}
+}
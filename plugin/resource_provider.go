@@ -1,7 +1,9 @@
 package plugin
 
 import (
+	"fmt"
 	"net/rpc"
+	"sync"
 
 	"github.com/hashicorp/go-plugin"
 	"github.com/hashicorp/terraform/terraform"
@@ -23,9 +25,20 @@ func (p *ResourceProviderPlugin) Client(
 
 // ResourceProvider is an implementation of terraform.ResourceProvider
 // that communicates over RPC.
+//
+// Concurrent Diff and Refresh calls are sent to the plugin in batches, see
+// rpcBatcher.
 type ResourceProvider struct {
 	Broker *plugin.MuxBroker
 	Client *rpc.Client
+
+	batchOnce      sync.Once
+	diffBatcher    *rpcBatcher
+	refreshBatcher *rpcBatcher
+
+	// noBatch is set once the plugin turns out not to support batch calls.
+	noBatchLock sync.Mutex
+	noBatch     bool
 }
 
 func (p *ResourceProvider) Input(
@@ -139,16 +152,18 @@ func (p *ResourceProvider) Diff(
 	info *terraform.InstanceInfo,
 	s *terraform.InstanceState,
 	c *terraform.ResourceConfig) (*terraform.InstanceDiff, error) {
-	var resp ResourceProviderDiffResponse
 	args := &ResourceProviderDiffArgs{
 		Info:   info,
 		State:  s,
 		Config: c,
 	}
-	err := p.Client.Call("Plugin.Diff", args, &resp)
+	p.batchOnce.Do(p.initBatchers)
+	raw, err := p.diffBatcher.Call(args)
 	if err != nil {
 		return nil, err
 	}
+
+	resp := raw.(*ResourceProviderDiffResponse)
 	if resp.Error != nil {
 		err = resp.Error
 	}
@@ -183,16 +198,17 @@ func (p *ResourceProvider) ValidateDataSource(
 func (p *ResourceProvider) Refresh(
 	info *terraform.InstanceInfo,
 	s *terraform.InstanceState) (*terraform.InstanceState, error) {
-	var resp ResourceProviderRefreshResponse
 	args := &ResourceProviderRefreshArgs{
 		Info:  info,
 		State: s,
 	}
-
-	err := p.Client.Call("Plugin.Refresh", args, &resp)
+	p.batchOnce.Do(p.initBatchers)
+	raw, err := p.refreshBatcher.Call(args)
 	if err != nil {
 		return nil, err
 	}
+
+	resp := raw.(*ResourceProviderRefreshResponse)
 	if resp.Error != nil {
 		err = resp.Error
 	}
@@ -284,6 +300,124 @@ func (p *ResourceProvider) DataSources() []terraform.DataSource {
 	return result
 }
 
+func (p *ResourceProvider) initBatchers() {
+	p.diffBatcher = &rpcBatcher{
+		Window:  batchWindow,
+		MaxSize: batchMaxSize,
+		Flush:   p.flushDiff,
+	}
+	p.refreshBatcher = &rpcBatcher{
+		Window:  batchWindow,
+		MaxSize: batchMaxSize,
+		Flush:   p.flushRefresh,
+	}
+}
+
+func (p *ResourceProvider) batching() bool {
+	p.noBatchLock.Lock()
+	defer p.noBatchLock.Unlock()
+	return !p.noBatch
+}
+
+// batchUnsupported checks whether err means the plugin doesn't support
+// batch calls, and if so stops sending them.
+func (p *ResourceProvider) batchUnsupported(err error) bool {
+	if !isMissingMethod(err) {
+		return false
+	}
+
+	p.noBatchLock.Lock()
+	defer p.noBatchLock.Unlock()
+	p.noBatch = true
+	return true
+}
+
+func (p *ResourceProvider) flushDiff(calls []*batchCall) {
+	if len(calls) > 1 && p.batching() {
+		args := &ResourceProviderDiffBatchArgs{
+			Items: make([]*ResourceProviderDiffArgs, len(calls)),
+		}
+		for i, c := range calls {
+			args.Items[i] = c.Args.(*ResourceProviderDiffArgs)
+		}
+
+		var resp ResourceProviderDiffBatchResponse
+		err := p.Client.Call("Plugin.DiffBatch", args, &resp)
+		if err == nil && len(resp.Items) != len(calls) {
+			err = fmt.Errorf(
+				"plugin returned %d diffs for %d resources",
+				len(resp.Items), len(calls))
+		}
+		if !p.batchUnsupported(err) {
+			for i, c := range calls {
+				if err != nil {
+					c.Err = err
+				} else {
+					c.Result = resp.Items[i]
+				}
+				c.done()
+			}
+			return
+		}
+	}
+
+	var wg sync.WaitGroup
+	for _, c := range calls {
+		wg.Add(1)
+		go func(c *batchCall) {
+			defer wg.Done()
+			var resp ResourceProviderDiffResponse
+			c.Err = p.Client.Call("Plugin.Diff", c.Args, &resp)
+			c.Result = &resp
+			c.done()
+		}(c)
+	}
+	wg.Wait()
+}
+
+func (p *ResourceProvider) flushRefresh(calls []*batchCall) {
+	if len(calls) > 1 && p.batching() {
+		args := &ResourceProviderRefreshBatchArgs{
+			Items: make([]*ResourceProviderRefreshArgs, len(calls)),
+		}
+		for i, c := range calls {
+			args.Items[i] = c.Args.(*ResourceProviderRefreshArgs)
+		}
+
+		var resp ResourceProviderRefreshBatchResponse
+		err := p.Client.Call("Plugin.RefreshBatch", args, &resp)
+		if err == nil && len(resp.Items) != len(calls) {
+			err = fmt.Errorf(
+				"plugin returned %d states for %d resources",
+				len(resp.Items), len(calls))
+		}
+		if !p.batchUnsupported(err) {
+			for i, c := range calls {
+				if err != nil {
+					c.Err = err
+				} else {
+					c.Result = resp.Items[i]
+				}
+				c.done()
+			}
+			return
+		}
+	}
+
+	var wg sync.WaitGroup
+	for _, c := range calls {
+		wg.Add(1)
+		go func(c *batchCall) {
+			defer wg.Done()
+			var resp ResourceProviderRefreshResponse
+			c.Err = p.Client.Call("Plugin.Refresh", c.Args, &resp)
+			c.Result = &resp
+			c.done()
+		}(c)
+	}
+	wg.Wait()
+}
+
 func (p *ResourceProvider) Close() error {
 	return p.Client.Close()
 }
@@ -331,6 +465,14 @@ type ResourceProviderDiffResponse struct {
 	Error *plugin.BasicError
 }
 
+type ResourceProviderDiffBatchArgs struct {
+	Items []*ResourceProviderDiffArgs
+}
+
+type ResourceProviderDiffBatchResponse struct {
+	Items []*ResourceProviderDiffResponse
+}
+
 type ResourceProviderRefreshArgs struct {
 	Info  *terraform.InstanceInfo
 	State *terraform.InstanceState
@@ -341,6 +483,14 @@ type ResourceProviderRefreshResponse struct {
 	Error *plugin.BasicError
 }
 
+type ResourceProviderRefreshBatchArgs struct {
+	Items []*ResourceProviderRefreshArgs
+}
+
+type ResourceProviderRefreshBatchResponse struct {
+	Items []*ResourceProviderRefreshResponse
+}
+
 type ResourceProviderImportStateArgs struct {
 	Info *terraform.InstanceInfo
 	Id   string
@@ -487,6 +637,46 @@ func (s *ResourceProviderServer) Refresh(
 	return nil
 }
 
+// DiffBatch diffs a batch of resources concurrently.
+func (s *ResourceProviderServer) DiffBatch(
+	args *ResourceProviderDiffBatchArgs,
+	result *ResourceProviderDiffBatchResponse) error {
+	items := make([]*ResourceProviderDiffResponse, len(args.Items))
+	var wg sync.WaitGroup
+	for i, item := range args.Items {
+		wg.Add(1)
+		go func(i int, item *ResourceProviderDiffArgs) {
+			defer wg.Done()
+			items[i] = new(ResourceProviderDiffResponse)
+			s.Diff(item, items[i])
+		}(i, item)
+	}
+	wg.Wait()
+
+	*result = ResourceProviderDiffBatchResponse{Items: items}
+	return nil
+}
+
+// RefreshBatch refreshes a batch of resources concurrently.
+func (s *ResourceProviderServer) RefreshBatch(
+	args *ResourceProviderRefreshBatchArgs,
+	result *ResourceProviderRefreshBatchResponse) error {
+	items := make([]*ResourceProviderRefreshResponse, len(args.Items))
+	var wg sync.WaitGroup
+	for i, item := range args.Items {
+		wg.Add(1)
+		go func(i int, item *ResourceProviderRefreshArgs) {
+			defer wg.Done()
+			items[i] = new(ResourceProviderRefreshResponse)
+			s.Refresh(item, items[i])
+		}(i, item)
+	}
+	wg.Wait()
+
+	*result = ResourceProviderRefreshBatchResponse{Items: items}
+	return nil
+}
+
 func (s *ResourceProviderServer) ImportState(
 	args *ResourceProviderImportStateArgs,
 	result *ResourceProviderImportStateResponse) error {
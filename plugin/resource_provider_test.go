@@ -2,7 +2,9 @@ package plugin
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
+	"sync"
 	"testing"
 
 	"github.com/hashicorp/go-plugin"
@@ -317,6 +319,116 @@ func TestResourceProvider_refresh(t *testing.T) {
 	}
 }
 
+func TestResourceProvider_refreshConcurrent(t *testing.T) {
+	p := new(terraform.MockResourceProvider)
+	p.RefreshFn = func(
+		info *terraform.InstanceInfo,
+		s *terraform.InstanceState) (*terraform.InstanceState, error) {
+		if info.Id == "aws_instance.err" {
+			return nil, errors.New("boom")
+		}
+		return &terraform.InstanceState{ID: info.Id}, nil
+	}
+
+	// Create a mock provider
+	client, _ := plugin.TestPluginRPCConn(t, pluginMap(&ServeOpts{
+		ProviderFunc: testProviderFixed(p),
+	}))
+	defer client.Close()
+
+	// Request the provider
+	raw, err := client.Dispense(ProviderPluginName)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	provider := raw.(terraform.ResourceProvider)
+
+	// Refresh more resources than fit in a single batch
+	n := batchMaxSize + 10
+	errs := make([]error, n)
+	states := make([]*terraform.InstanceState, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			info := &terraform.InstanceInfo{Id: fmt.Sprintf("aws_instance.foo%d", i)}
+			if i == 3 {
+				info.Id = "aws_instance.err"
+			}
+			states[i], errs[i] = provider.Refresh(info, &terraform.InstanceState{})
+		}(i)
+	}
+	wg.Wait()
+
+	for i := 0; i < n; i++ {
+		if i == 3 {
+			if errs[i] == nil || errs[i].Error() != "boom" {
+				t.Fatalf("%d: bad: %#v", i, errs[i])
+			}
+			continue
+		}
+		if errs[i] != nil {
+			t.Fatalf("%d: err: %s", i, errs[i])
+		}
+		if expected := fmt.Sprintf("aws_instance.foo%d", i); states[i].ID != expected {
+			t.Fatalf("%d: bad: %#v", i, states[i])
+		}
+	}
+}
+
+func TestResourceProvider_diffConcurrent(t *testing.T) {
+	p := new(terraform.MockResourceProvider)
+	p.DiffFn = func(
+		info *terraform.InstanceInfo,
+		s *terraform.InstanceState,
+		c *terraform.ResourceConfig) (*terraform.InstanceDiff, error) {
+		return &terraform.InstanceDiff{
+			Attributes: map[string]*terraform.ResourceAttrDiff{
+				"id": &terraform.ResourceAttrDiff{New: info.Id},
+			},
+		}, nil
+	}
+
+	// Create a mock provider
+	client, _ := plugin.TestPluginRPCConn(t, pluginMap(&ServeOpts{
+		ProviderFunc: testProviderFixed(p),
+	}))
+	defer client.Close()
+
+	// Request the provider
+	raw, err := client.Dispense(ProviderPluginName)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	provider := raw.(terraform.ResourceProvider)
+
+	n := 20
+	errs := make([]error, n)
+	diffs := make([]*terraform.InstanceDiff, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			info := &terraform.InstanceInfo{Id: fmt.Sprintf("aws_instance.foo%d", i)}
+			diffs[i], errs[i] = provider.Diff(
+				info, &terraform.InstanceState{}, &terraform.ResourceConfig{})
+		}(i)
+	}
+	wg.Wait()
+
+	for i := 0; i < n; i++ {
+		if errs[i] != nil {
+			t.Fatalf("%d: err: %s", i, errs[i])
+		}
+		expected := fmt.Sprintf("aws_instance.foo%d", i)
+		if actual := diffs[i].Attributes["id"].New; actual != expected {
+			t.Fatalf("%d: bad: %s", i, actual)
+		}
+	}
+}
+
 func TestResourceProvider_importState(t *testing.T) {
 	p := new(terraform.MockResourceProvider)
 
@@ -0,0 +1,53 @@
+package repl
+
+import (
+	"testing"
+)
+
+func TestFormatResult(t *testing.T) {
+	cases := []struct {
+		Input  interface{}
+		Output string
+	}{
+		{
+			"foo",
+			"foo",
+		},
+		{
+			[]interface{}{},
+			"[]",
+		},
+		{
+			[]interface{}{"a", "b"},
+			"[\n  \"a\",\n  \"b\"\n]",
+		},
+		{
+			map[string]interface{}{},
+			"{}",
+		},
+		{
+			map[string]interface{}{"b": "2", "a": "1"},
+			"{\n  \"a\" = \"1\"\n  \"b\" = \"2\"\n}",
+		},
+		{
+			map[string]interface{}{
+				"a": []interface{}{"x", "y"},
+			},
+			"{\n  \"a\" = [\n    \"x\",\n    \"y\"\n  ]\n}",
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := FormatResult(tc.Input)
+		if err != nil {
+			t.Fatalf("%#v: err: %s", tc.Input, err)
+		}
+		if actual != tc.Output {
+			t.Fatalf("%#v: expected:\n\n%s\n\ngot:\n\n%s", tc.Input, tc.Output, actual)
+		}
+	}
+
+	if _, err := FormatResult(42); err == nil {
+		t.Fatalf("expected error for unsupported type")
+	}
+}
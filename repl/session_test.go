@@ -0,0 +1,250 @@
+package repl
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/config/module"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestSession_basicState(t *testing.T) {
+	state := &terraform.State{
+		Modules: []*terraform.ModuleState{
+			&terraform.ModuleState{
+				Path: []string{"root"},
+				Resources: map[string]*terraform.ResourceState{
+					"test_instance.foo": &terraform.ResourceState{
+						Type: "test_instance",
+						Primary: &terraform.InstanceState{
+							ID: "bar",
+							Attributes: map[string]string{
+								"id": "bar",
+							},
+						},
+					},
+				},
+			},
+
+			&terraform.ModuleState{
+				Path: []string{"root", "module"},
+				Outputs: map[string]*terraform.OutputState{
+					"foo": &terraform.OutputState{
+						Type:  "string",
+						Value: "bar",
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("basic", func(t *testing.T) {
+		testSession(t, testSessionTest{
+			Module: "config-basic",
+			State:  state,
+			Inputs: []testSessionInput{
+				{
+					Input:  "test_instance.foo.id",
+					Output: "bar",
+				},
+			},
+		})
+	})
+
+	t.Run("missing attribute", func(t *testing.T) {
+		testSession(t, testSessionTest{
+			Module: "config-basic",
+			State:  state,
+			Inputs: []testSessionInput{
+				{
+					Input:         "test_instance.foo.nope",
+					Error:         true,
+					ErrorContains: "does not have attribute",
+				},
+			},
+		})
+	})
+
+	t.Run("missing resource", func(t *testing.T) {
+		testSession(t, testSessionTest{
+			State: state,
+			Inputs: []testSessionInput{
+				{
+					Input:         "test_instance.bar.id",
+					Error:         true,
+					ErrorContains: "'test_instance.bar' not found",
+				},
+			},
+		})
+	})
+
+	t.Run("missing module", func(t *testing.T) {
+		testSession(t, testSessionTest{
+			State: state,
+			Inputs: []testSessionInput{
+				{
+					Input:  "module.child.foo",
+					Output: "<computed>",
+				},
+			},
+		})
+	})
+
+	t.Run("module output", func(t *testing.T) {
+		testSession(t, testSessionTest{
+			State: state,
+			Inputs: []testSessionInput{
+				{
+					Input:  "module.module.foo",
+					Output: "bar",
+				},
+			},
+		})
+	})
+}
+
+func TestSession_variables(t *testing.T) {
+	testSession(t, testSessionTest{
+		Module: "config-variables",
+		Variables: map[string]interface{}{
+			"foo": "bar",
+			"bar": "baz",
+		},
+		Inputs: []testSessionInput{
+			{
+				Input:  "var.foo",
+				Output: "bar",
+			},
+			{
+				Input:  "var.bar",
+				Output: "baz",
+			},
+			{
+				Input:  "var.list",
+				Output: "[\n  \"a\",\n  \"b\"\n]",
+			},
+			{
+				Input:  "var.map",
+				Output: "{\n  \"key\" = \"value\"\n}",
+			},
+		},
+	})
+}
+
+func TestSession_functions(t *testing.T) {
+	testSession(t, testSessionTest{
+		Inputs: []testSessionInput{
+			{
+				Input:  "cidrsubnet(\"10.0.0.0/16\", 8, 3)",
+				Output: "10.0.3.0/24",
+			},
+			{
+				Input:  "upper(\"foo\")",
+				Output: "FOO",
+			},
+			{
+				Input:  "1 + 5",
+				Output: "6",
+			},
+			{
+				Input:         "nope(\"foo\")",
+				Error:         true,
+				ErrorContains: "unknown function",
+			},
+		},
+	})
+}
+
+func TestSession_commands(t *testing.T) {
+	testSession(t, testSessionTest{
+		Inputs: []testSessionInput{
+			{
+				Input:  "",
+				Output: "",
+			},
+			{
+				Input:          "help",
+				OutputContains: "allows you to experiment",
+			},
+			{
+				Input:   "exit",
+				Error:   true,
+				ExitErr: true,
+			},
+		},
+	})
+}
+
+func testSession(t *testing.T, test testSessionTest) {
+	// Build the TF context
+	mod := module.NewEmptyTree()
+	if test.Module != "" {
+		var err error
+		mod, err = module.NewTreeModule("", "test-fixtures/"+test.Module)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if err := mod.Load(nil, module.GetModeNone); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	ctx, err := terraform.NewContext(&terraform.ContextOpts{
+		Module:    mod,
+		State:     test.State,
+		Variables: test.Variables,
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Build the session
+	s := &Session{
+		Interpolater: ctx.Interpolater(),
+	}
+
+	// Test the inputs. These are a sequence of operations against a
+	// single session, so they run in order rather than as subtests.
+	for _, input := range test.Inputs {
+		result, err := s.Handle(input.Input)
+		if (err != nil) != input.Error {
+			t.Fatalf("%q: err: %s", input.Input, err)
+		}
+		if err != nil {
+			if input.ExitErr && err != ErrSessionExit {
+				t.Fatalf("%q: expected session exit, got: %s", input.Input, err)
+			}
+			if input.ErrorContains != "" && !strings.Contains(err.Error(), input.ErrorContains) {
+				t.Fatalf("%q: err: %s\n\nexpected to contain: %q", input.Input, err, input.ErrorContains)
+			}
+			continue
+		}
+
+		if input.OutputContains != "" {
+			if !strings.Contains(result, input.OutputContains) {
+				t.Fatalf("%q: output: %q\n\nexpected to contain: %q", input.Input, result, input.OutputContains)
+			}
+			continue
+		}
+
+		if result != input.Output {
+			t.Fatalf("%q: expected:\n\n%s\n\ngot:\n\n%s", input.Input, input.Output, result)
+		}
+	}
+}
+
+type testSessionTest struct {
+	Module    string                 // Fixture module name (optional)
+	State     *terraform.State       // State to use
+	Variables map[string]interface{} // Variables to set
+	Inputs    []testSessionInput     // Inputs to evaluate, in order
+}
+
+type testSessionInput struct {
+	Input          string
+	Output         string
+	OutputContains string
+	Error          bool // Error is true if error is expected
+	ErrorContains  string
+	ExitErr        bool // ExitErr is true if ErrSessionExit is expected
+}
@@ -0,0 +1,12 @@
+variable "foo" {}
+variable "bar" {}
+
+variable "list" {
+  default = ["a", "b"]
+}
+
+variable "map" {
+  default = {
+    key = "value"
+  }
+}
@@ -131,6 +131,30 @@ func (s *CacheState) PersistState() error {
 	return s.Durable.PersistState()
 }
 
+// Lock locks the durable storage, if it supports locking.
+//
+// Locker impl.
+func (s *CacheState) Lock(info *LockInfo) (string, error) {
+	durable, ok := s.Durable.(Locker)
+	if !ok {
+		return "", nil
+	}
+
+	return durable.Lock(info)
+}
+
+// Unlock unlocks the durable storage, if it supports locking.
+//
+// Locker impl.
+func (s *CacheState) Unlock(id string) error {
+	durable, ok := s.Durable.(Locker)
+	if !ok {
+		return nil
+	}
+
+	return durable.Unlock(id)
+}
+
 // CacheStateCache is the meta-interface that must be implemented for
 // the cache for the CacheState.
 type CacheStateCache interface {
@@ -0,0 +1,128 @@
+package state
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/user"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Locker is implemented by states that can be locked for the duration of
+// an operation, preventing concurrent operations from writing the state.
+//
+// Lock returns the ID of the acquired lock, which must be passed to Unlock
+// to release it. If the state is already locked, Lock returns a *LockError
+// describing the existing lock.
+type Locker interface {
+	Lock(info *LockInfo) (string, error)
+	Unlock(id string) error
+}
+
+// LockInfo stores metadata about a lock. It is persisted alongside the lock
+// so that a failed lock attempt can report who holds the lock and why.
+type LockInfo struct {
+	// Unique ID for the lock. NewLockInfo provides a random ID, but this
+	// may be overridden by the lock implementation.
+	ID string
+
+	// Terraform operation that acquired the lock, e.g. "apply".
+	Operation string
+
+	// Extra information to store with the lock, provided by the caller.
+	Info string
+
+	// user@hostname of whoever acquired the lock.
+	Who string
+
+	// Terraform version used to acquire the lock.
+	Version string
+
+	// Time that the lock was taken.
+	Created time.Time
+
+	// Path to the state being locked, set by the lock implementation.
+	Path string
+}
+
+// NewLockInfo creates a LockInfo populated with a new random ID and
+// details about the current process.
+func NewLockInfo() *LockInfo {
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		panic(err)
+	}
+
+	info := &LockInfo{
+		ID:      id,
+		Who:     lockWho(),
+		Version: terraform.VersionString(),
+		Created: time.Now().UTC(),
+	}
+	return info
+}
+
+// Marshal returns a JSON encoding of the LockInfo.
+func (l *LockInfo) Marshal() []byte {
+	js, err := json.Marshal(l)
+	if err != nil {
+		panic(err)
+	}
+	return js
+}
+
+// String returns a human readable summary of the lock.
+func (l *LockInfo) String() string {
+	tmpl := template.Must(template.New("LockInfo").Parse(lockInfoTemplate))
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, l); err != nil {
+		panic(err)
+	}
+	return out.String()
+}
+
+// LockError is returned by Lock or Unlock when the lock is held by
+// someone else, or could not be released.
+type LockError struct {
+	Info *LockInfo
+	Err  error
+}
+
+func (e *LockError) Error() string {
+	var out []string
+	if e.Err != nil {
+		out = append(out, e.Err.Error())
+	}
+
+	if e.Info != nil {
+		out = append(out, e.Info.String())
+	}
+	return strings.Join(out, "\n")
+}
+
+func lockWho() string {
+	host, _ := os.Hostname()
+
+	name := "unknown"
+	if u, err := user.Current(); err == nil {
+		name = u.Username
+	}
+
+	return fmt.Sprintf("%s@%s", name, host)
+}
+
+const lockInfoTemplate = `Lock Info:
+  ID:        {{.ID}}
+  Path:      {{.Path}}
+  Operation: {{.Operation}}
+  Who:       {{.Who}}
+  Version:   {{.Version}}
+  Created:   {{.Created}}
+  Info:      {{.Info}}
+`
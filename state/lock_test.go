@@ -0,0 +1,59 @@
+package state
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestLockInfo_marshal(t *testing.T) {
+	info := NewLockInfo()
+	info.Operation = "apply"
+
+	var actual LockInfo
+	if err := json.Unmarshal(info.Marshal(), &actual); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if actual.ID != info.ID || actual.Operation != "apply" {
+		t.Fatalf("bad: %#v", actual)
+	}
+	if !actual.Created.Equal(info.Created) {
+		t.Fatalf("bad created time: %s", actual.Created)
+	}
+}
+
+func TestLockError(t *testing.T) {
+	info := NewLockInfo()
+	err := &LockError{
+		Info: info,
+		Err:  errors.New("state locked"),
+	}
+
+	msg := err.Error()
+	if !strings.HasPrefix(msg, "state locked\n") {
+		t.Fatalf("bad: %s", msg)
+	}
+	if !strings.Contains(msg, info.ID) {
+		t.Fatalf("lock ID missing from error: %s", msg)
+	}
+}
+
+func TestCacheState_lockUnsupported(t *testing.T) {
+	s := &CacheState{
+		Cache:   &InmemState{},
+		Durable: &InmemState{},
+	}
+
+	id, err := s.Lock(NewLockInfo())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if id != "" {
+		t.Fatalf("bad lock id: %q", id)
+	}
+	if err := s.Unlock(id); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
@@ -2,12 +2,17 @@ package remote
 
 import (
 	"crypto/md5"
+	"errors"
+
+	"github.com/hashicorp/terraform/state"
 )
 
 // InmemClient is a Client implementation that stores data in memory.
 type InmemClient struct {
 	Data []byte
 	MD5  []byte
+
+	LockInfo *state.LockInfo
 }
 
 func (c *InmemClient) Get() (*Payload, error) {
@@ -30,3 +35,31 @@ func (c *InmemClient) Delete() error {
 	c.MD5 = nil
 	return nil
 }
+
+func (c *InmemClient) Lock(info *state.LockInfo) (string, error) {
+	if c.LockInfo != nil {
+		return "", &state.LockError{
+			Err:  errors.New("state locked"),
+			Info: c.LockInfo,
+		}
+	}
+
+	c.LockInfo = info
+	return info.ID, nil
+}
+
+func (c *InmemClient) Unlock(id string) error {
+	if c.LockInfo == nil {
+		return &state.LockError{Err: errors.New("state not locked")}
+	}
+
+	if c.LockInfo.ID != id {
+		return &state.LockError{
+			Err:  errors.New("lock id does not match existing lock"),
+			Info: c.LockInfo,
+		}
+	}
+
+	c.LockInfo = nil
+	return nil
+}
@@ -0,0 +1,81 @@
+package remote
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// EnvKeyPrefix is the prefix used for the keys of states belonging to a
+// non-default environment in remote storage that is organized by key.
+const EnvKeyPrefix = "env:"
+
+// envConfigFunc rewrites a remote client configuration in place so that it
+// points to the state of the named environment.
+type envConfigFunc func(conf map[string]string, env string) error
+
+// envConfigFuncs is the list of remote clients that support environments,
+// along with the function that derives the configuration for an environment.
+var envConfigFuncs = map[string]envConfigFunc{
+	"azure":  envConfigKey("key", envPrefixKey),
+	"consul": envConfigKey("path", envSuffixKey),
+	"etcd":   envConfigKey("path", envSuffixKey),
+	"gcs":    envConfigKey("path", envPrefixKey),
+	"local":  envConfigKey("path", envFilePath),
+	"s3":     envConfigKey("key", envPrefixKey),
+}
+
+// EnvConfig returns the configuration of a remote client of type t that
+// stores the state for the named environment, based on the configuration
+// conf for the default environment. The given configuration is not modified.
+//
+// An error is returned if the client type doesn't support environments.
+func EnvConfig(t string, conf map[string]string, env string) (map[string]string, error) {
+	f, ok := envConfigFuncs[t]
+	if !ok {
+		return nil, fmt.Errorf(
+			"remote state type %q does not support environments", t)
+	}
+
+	result := make(map[string]string, len(conf))
+	for k, v := range conf {
+		result[k] = v
+	}
+
+	if err := f(result, env); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// envConfigKey returns an envConfigFunc that rewrites the single
+// configuration key that identifies the state in the remote storage.
+func envConfigKey(key string, f func(string, string) string) envConfigFunc {
+	return func(conf map[string]string, env string) error {
+		v, ok := conf[key]
+		if !ok || v == "" {
+			return fmt.Errorf("missing '%s' configuration", key)
+		}
+
+		conf[key] = f(v, env)
+		return nil
+	}
+}
+
+// envPrefixKey stores the state in a separate "env:/NAME/" prefix, so
+// environments never collide with other states under the same key.
+func envPrefixKey(key, env string) string {
+	return fmt.Sprintf("%s/%s/%s", EnvKeyPrefix, env, key)
+}
+
+// envSuffixKey appends the environment to the key. This is used for
+// key/value stores where a key can't be both a value and a prefix.
+func envSuffixKey(key, env string) string {
+	return fmt.Sprintf("%s-%s%s", key, EnvKeyPrefix, env)
+}
+
+// envFilePath stores the state next to the default state file, in a
+// directory per environment.
+func envFilePath(path, env string) string {
+	return filepath.Join(path+".d", env, filepath.Base(path))
+}
@@ -0,0 +1,72 @@
+package remote
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestEnvConfig(t *testing.T) {
+	cases := []struct {
+		Type   string
+		Config map[string]string
+		Result map[string]string
+		Err    bool
+	}{
+		{
+			"s3",
+			map[string]string{"bucket": "foo", "key": "bar/terraform.tfstate"},
+			map[string]string{"bucket": "foo", "key": "env:/dev/bar/terraform.tfstate"},
+			false,
+		},
+		{
+			"consul",
+			map[string]string{"path": "foo/bar"},
+			map[string]string{"path": "foo/bar-env:dev"},
+			false,
+		},
+		{
+			"local",
+			map[string]string{"path": filepath.Join("foo", "terraform.tfstate")},
+			map[string]string{"path": filepath.Join("foo", "terraform.tfstate.d", "dev", "terraform.tfstate")},
+			false,
+		},
+		{
+			"s3",
+			map[string]string{"bucket": "foo"},
+			nil,
+			true,
+		},
+		{
+			"atlas",
+			map[string]string{"name": "foo/bar"},
+			nil,
+			true,
+		},
+	}
+
+	for i, tc := range cases {
+		actual, err := EnvConfig(tc.Type, tc.Config, "dev")
+		if err != nil != tc.Err {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+		if err != nil {
+			continue
+		}
+
+		if !reflect.DeepEqual(actual, tc.Result) {
+			t.Fatalf("%d: bad: %#v", i, actual)
+		}
+	}
+}
+
+func TestEnvConfig_noModify(t *testing.T) {
+	conf := map[string]string{"path": "foo/bar"}
+	if _, err := EnvConfig("consul", conf, "dev"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if conf["path"] != "foo/bar" {
+		t.Fatalf("config was modified: %#v", conf)
+	}
+}
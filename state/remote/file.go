@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 )
 
 func fileFactory(conf map[string]string) (Client, error) {
@@ -49,6 +50,10 @@ func (c *FileClient) Get() (*Payload, error) {
 }
 
 func (c *FileClient) Put(data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(c.Path), 0755); err != nil {
+		return err
+	}
+
 	f, err := os.Create(c.Path)
 	if err != nil {
 		return err
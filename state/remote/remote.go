@@ -2,6 +2,8 @@ package remote
 
 import (
 	"fmt"
+
+	"github.com/hashicorp/terraform/state"
 )
 
 // Client is the interface that must be implemented for a remote state
@@ -13,6 +15,13 @@ type Client interface {
 	Delete() error
 }
 
+// ClientLocker is an optional interface that allows a remote state
+// backend to lock the state while an operation is in progress.
+type ClientLocker interface {
+	Client
+	state.Locker
+}
+
 // Payload is the return value from the remote state storage.
 type Payload struct {
 	MD5  []byte
@@ -44,6 +44,102 @@ func testClient(t *testing.T, c Client) {
 	}
 }
 
+// testClientLocks is a generic function to test the locking of any client.
+// The two clients must be configured to use the same state.
+func testClientLocks(t *testing.T, c1, c2 Client) {
+	lockerA, ok := c1.(ClientLocker)
+	if !ok {
+		t.Fatal("client A not a ClientLocker")
+	}
+
+	lockerB, ok := c2.(ClientLocker)
+	if !ok {
+		t.Fatal("client B not a ClientLocker")
+	}
+
+	infoA := state.NewLockInfo()
+	infoA.Operation = "test"
+	infoA.Who = "clientA"
+
+	infoB := state.NewLockInfo()
+	infoB.Operation = "test"
+	infoB.Who = "clientB"
+
+	lockIDA, err := lockerA.Lock(infoA)
+	if err != nil {
+		t.Fatal("unable to get initial lock:", err)
+	}
+
+	_, err = lockerB.Lock(infoB)
+	if err == nil {
+		lockerA.Unlock(lockIDA)
+		t.Fatal("client B obtained lock while held by client A")
+	}
+	if _, ok := err.(*state.LockError); !ok {
+		t.Errorf("expected a LockError, but was %T: %s", err, err)
+	}
+
+	if err := lockerB.Unlock(infoB.ID); err == nil {
+		t.Fatal("client B released a lock it does not hold")
+	}
+
+	if err := lockerA.Unlock(lockIDA); err != nil {
+		t.Fatal("error unlocking client A", err)
+	}
+
+	lockIDB, err := lockerB.Lock(infoB)
+	if err != nil {
+		t.Fatal("unable to obtain lock from client B")
+	}
+
+	if lockIDB == lockIDA {
+		t.Fatalf("duplicate lock IDs: %q", lockIDB)
+	}
+
+	if err = lockerB.Unlock(lockIDB); err != nil {
+		t.Fatal("error unlocking client B:", err)
+	}
+}
+
+func TestInmemClient_locks(t *testing.T) {
+	c := &InmemClient{}
+	testClientLocks(t, c, c)
+}
+
+func TestRemoteState_locks(t *testing.T) {
+	s := &State{Client: &InmemClient{}}
+
+	info := state.NewLockInfo()
+	id, err := s.Lock(info)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if id != info.ID {
+		t.Fatalf("bad lock id: %q", id)
+	}
+
+	if _, err := s.Lock(state.NewLockInfo()); err == nil {
+		t.Fatal("expected error locking a locked state")
+	}
+
+	if err := s.Unlock(id); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Clients that don't support locking are a no-op
+	s = &State{Client: nilClient{}}
+	id, err = s.Lock(info)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if id != "" {
+		t.Fatalf("bad lock id: %q", id)
+	}
+	if err := s.Unlock(id); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
 func TestRemoteClient_noPayload(t *testing.T) {
 	s := &State{
 		Client: nilClient{},
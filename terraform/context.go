@@ -6,6 +6,8 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/hcl"
@@ -35,6 +37,8 @@ const (
 // ContextOpts are the user-configurable options to create a context with
 // NewContext.
 type ContextOpts struct {
+	Meta               *ContextMeta
+	Deadline           time.Duration
 	Destroy            bool
 	Diff               *Diff
 	Hooks              []Hook
@@ -44,18 +48,34 @@ type ContextOpts struct {
 	StateFutureAllowed bool
 	Providers          map[string]ResourceProviderFactory
 	Provisioners       map[string]ResourceProvisionerFactory
+	RefreshData        bool
 	Targets            []string
 	Variables          map[string]interface{}
 
+	// VariableSources are the sources variables can be read from, by
+	// the type of source. See VariableSource.
+	VariableSources map[string]VariableSource
+
 	UIInput UIInput
 }
 
+// ContextMeta is metadata about the running context. This is information
+// that this package or structure cannot determine on its own but exposes
+// into Terraform in various ways. This must be provided by the Context
+// initializer.
+type ContextMeta struct {
+	Env string // Env is the state environment
+}
+
 // Context represents all the context that Terraform needs in order to
 // perform operations on infrastructure. This structure is built using
 // NewContext. See the documentation for that.
 //
 // Extra functions on Context can be found in context_*.go files.
 type Context struct {
+	meta         *ContextMeta
+	deadline     time.Duration
+	deadlineAt   time.Time
 	destroy      bool
 	diff         *Diff
 	diffLock     sync.RWMutex
@@ -63,6 +83,7 @@ type Context struct {
 	module       *module.Tree
 	providers    map[string]ResourceProviderFactory
 	provisioners map[string]ResourceProvisionerFactory
+	refreshData  bool
 	sh           *stopHook
 	state        *State
 	stateLock    sync.RWMutex
@@ -70,6 +91,10 @@ type Context struct {
 	uiInput      UIInput
 	variables    map[string]interface{}
 
+	// secretVariables are the names of the variables that were read from
+	// a VariableSource, whose values aren't saved in plans.
+	secretVariables map[string]struct{}
+
 	l                   sync.Mutex // Lock acquired during any task
 	parallelSem         Semaphore
 	providerInputConfig map[string]map[string]interface{}
@@ -125,7 +150,10 @@ func NewContext(opts *ContextOpts) (*Context, error) {
 	//    2 - Take values specified in -var flags, overriding values
 	//        set by environment variables if necessary. This includes
 	//        values taken from -var-file in addition.
+	//    3 - Read the variables that still don't have a value from
+	//        their source, if they have one.
 	variables := make(map[string]interface{})
+	var secretVariables map[string]struct{}
 
 	if opts.Module != nil {
 		var err error
@@ -133,20 +161,31 @@ func NewContext(opts *ContextOpts) (*Context, error) {
 		if err != nil {
 			return nil, err
 		}
+
+		secretVariables, err = readVariableSources(
+			opts.Module, variables, opts.VariableSources)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return &Context{
+		meta:         opts.Meta,
+		deadline:     opts.Deadline,
 		destroy:      opts.Destroy,
 		diff:         opts.Diff,
 		hooks:        hooks,
 		module:       opts.Module,
 		providers:    opts.Providers,
 		provisioners: opts.Provisioners,
+		refreshData:  opts.RefreshData,
 		state:        state,
 		targets:      opts.Targets,
 		uiInput:      opts.UIInput,
 		variables:    variables,
 
+		secretVariables: secretVariables,
+
 		parallelSem:         NewSemaphore(par),
 		providerInputConfig: make(map[string]map[string]interface{}),
 		sh:                  sh,
@@ -184,6 +223,8 @@ func (c *Context) graphBuilder(g *ContextGraphOpts) GraphBuilder {
 		Provisioners: provisioners,
 		State:        c.state,
 		Targets:      c.targets,
+		Variables:    c.variables,
+		Meta:         c.meta,
 		Destroy:      c.destroy,
 		Validate:     g.Validate,
 		Verbose:      g.Verbose,
@@ -248,15 +289,18 @@ func (c *Context) Input(mode InputMode) error {
 				continue
 			}
 
-			// Ask the user for a value for this variable
+			// Ask the user for a value for this variable. Values that
+			// don't parse as the type of the variable are asked for again.
 			var value string
+			var decoded interface{}
+			var parseErr error
 			retry := 0
 			for {
 				var err error
 				value, err = c.uiInput.Input(&InputOpts{
 					Id:          fmt.Sprintf("var.%s", n),
 					Query:       fmt.Sprintf("var.%s", n),
-					Description: v.Description,
+					Description: inputVariableDescription(v, parseErr),
 				})
 				if err != nil {
 					return fmt.Errorf(
@@ -270,20 +314,25 @@ func (c *Context) Input(mode InputMode) error {
 						return fmt.Errorf("missing required value for %q", n)
 					}
 					retry++
+					parseErr = nil
 					continue
 				}
 
-				break
-			}
+				// no value provided, so don't set the variable at all
+				if value == "" {
+					break
+				}
 
-			// no value provided, so don't set the variable at all
-			if value == "" {
-				continue
-			}
+				decoded, parseErr = parseVariableAsHCL(n, value, valueType)
+				if parseErr != nil {
+					if retry > 2 {
+						return parseErr
+					}
+					retry++
+					continue
+				}
 
-			decoded, err := parseVariableAsHCL(n, value, valueType)
-			if err != nil {
-				return err
+				break
 			}
 
 			if decoded != nil {
@@ -326,6 +375,21 @@ func (c *Context) Apply() (*State, error) {
 		return nil, err
 	}
 
+	// If we have a deadline, stop the walk once it passes. Operations
+	// already in flight are cut off by EvalApply, and no new operations
+	// are started since the stop hook halts them.
+	var deadlineExceeded uint32
+	if c.deadline > 0 {
+		c.deadlineAt = time.Now().Add(c.deadline)
+		defer func() { c.deadlineAt = time.Time{} }()
+		timer := time.AfterFunc(c.deadline, func() {
+			log.Printf("[WARN] terraform: apply deadline of %s exceeded, stopping", c.deadline)
+			atomic.StoreUint32(&deadlineExceeded, 1)
+			c.sh.Stop()
+		})
+		defer timer.Stop()
+	}
+
 	// Do the walk
 	var walker *ContextGraphWalker
 	if c.destroy {
@@ -338,6 +402,18 @@ func (c *Context) Apply() (*State, error) {
 		err = multierror.Append(err, walker.ValidationErrors...)
 	}
 
+	// Operations that were cut off by the deadline can end the walk just
+	// before the timer fires, so check the time as well.
+	exceeded := atomic.LoadUint32(&deadlineExceeded) == 1
+	if c.deadline > 0 && !time.Now().Before(c.deadlineAt) {
+		exceeded = true
+	}
+	if exceeded {
+		err = multierror.Append(err, fmt.Errorf(
+			"Deadline of %s exceeded. Resources that were not yet applied "+
+				"have been skipped. Run apply again to continue.", c.deadline))
+	}
+
 	// Clean out any unused things
 	c.state.prune()
 
@@ -355,9 +431,18 @@ func (c *Context) Plan() (*Plan, error) {
 	v := c.acquireRun()
 	defer c.releaseRun(v)
 
+	// Secrets aren't saved in the plan, they're read from their source
+	// again when it's applied.
+	vars := make(map[string]interface{}, len(c.variables))
+	for k, v := range c.variables {
+		if _, ok := c.secretVariables[k]; !ok {
+			vars[k] = v
+		}
+	}
+
 	p := &Plan{
 		Module:  c.module,
-		Vars:    c.variables,
+		Vars:    vars,
 		State:   c.state,
 		Targets: c.targets,
 	}
@@ -512,6 +597,22 @@ func (c *Context) Validate() ([]string, []error) {
 	return walker.ValidationWarnings, rerrs.Errors
 }
 
+// Interpolater returns an Interpolater built on a copy of the state
+// that can be used to test interpolation values.
+func (c *Context) Interpolater() *Interpolater {
+	var varLock sync.Mutex
+	var stateLock sync.RWMutex
+	return &Interpolater{
+		Operation:          walkApply,
+		Meta:               c.meta,
+		Module:             c.module,
+		State:              c.state.DeepCopy(),
+		StateLock:          &stateLock,
+		VariableValues:     c.variables,
+		VariableValuesLock: &varLock,
+	}
+}
+
 // Module returns the module tree associated with this context.
 func (c *Context) Module() *module.Tree {
 	return c.module
@@ -524,6 +625,12 @@ func (c *Context) Variables() map[string]interface{} {
 	return c.variables
 }
 
+// SecretVariables returns the names of the variables whose values were read
+// from a VariableSource. Their values must not be stored anywhere.
+func (c *Context) SecretVariables() map[string]struct{} {
+	return c.secretVariables
+}
+
 // SetVariable sets a variable after a context has already been built.
 func (c *Context) SetVariable(k string, v interface{}) {
 	c.variables[k] = v
@@ -563,6 +670,29 @@ func (c *Context) walk(
 	return walker, graph.Walk(walker)
 }
 
+// inputVariableDescription returns the description shown when asking for the
+// value of v: its declared description, how to enter lists and maps, and
+// why the previous value, if any, was rejected.
+func inputVariableDescription(v *config.Variable, previous error) string {
+	var parts []string
+	if v.Description != "" {
+		parts = append(parts, v.Description)
+	}
+
+	switch v.Type() {
+	case config.VariableTypeList:
+		parts = append(parts, `Enter a list in HCL or JSON, such as ["a", "b"].`)
+	case config.VariableTypeMap:
+		parts = append(parts, `Enter a map in HCL or JSON, such as {a = "b"} or {"a": "b"}.`)
+	}
+
+	if previous != nil {
+		parts = append(parts, fmt.Sprintf("Invalid value: %s", previous))
+	}
+
+	return strings.Join(parts, "\n\n")
+}
+
 // parseVariableAsHCL parses the value of a single variable as would have been specified
 // on the command line via -var or in an environment variable named TF_VAR_x, where x is
 // the name of the variable. In order to get around the restriction of HCL requiring a
@@ -590,7 +720,13 @@ func parseVariableAsHCL(name string, input string, targetType config.VariableTyp
 	var decoded map[string]interface{}
 	err := hcl.Decode(&decoded, inputWithSentinal)
 	if err != nil {
-		return nil, fmt.Errorf("Cannot parse value for variable %s (%q) as valid HCL: %s", name, input, err)
+		// JSON objects aren't valid HCL values, but are valid as the value
+		// of a key in a JSON document.
+		decoded = nil
+		jsonInput := fmt.Sprintf(`{%q: %s}`, sentinelValue, input)
+		if jsonErr := hcl.Decode(&decoded, jsonInput); jsonErr != nil {
+			return nil, fmt.Errorf("Cannot parse value for variable %s (%q) as valid HCL: %s", name, input, err)
+		}
 	}
 
 	if len(decoded) != 1 {
@@ -604,11 +740,17 @@ func parseVariableAsHCL(name string, input string, targetType config.VariableTyp
 
 	switch targetType {
 	case config.VariableTypeList:
+		if _, ok := parsedValue.([]interface{}); !ok {
+			return nil, fmt.Errorf("Cannot parse value for variable %s (%q): expected a list, such as [\"a\", \"b\"].", name, input)
+		}
 		return parsedValue, nil
 	case config.VariableTypeMap:
-		if list, ok := parsedValue.([]map[string]interface{}); ok {
+		if list, ok := parsedValue.([]map[string]interface{}); ok && len(list) == 1 {
 			return list[0], nil
 		}
+		if _, ok := parsedValue.([]map[string]interface{}); !ok {
+			return nil, fmt.Errorf("Cannot parse value for variable %s (%q): expected a map, such as {a = \"b\"}.", name, input)
+		}
 
 		return nil, fmt.Errorf("Cannot parse value for variable %s (%q) as valid HCL. One value must be specified.", name, input)
 	default:
@@ -54,6 +54,8 @@ func (c *Context) Import(opts *ImportOpts) (*State, error) {
 		ImportTargets: opts.Targets,
 		Module:        opts.Module,
 		Providers:     providers,
+		Variables:     c.variables,
+		Meta:          c.meta,
 	}
 
 	// Build the graph!
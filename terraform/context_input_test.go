@@ -618,6 +618,86 @@ func TestContext2Input_interpolateVar(t *testing.T) {
 	}
 }
 
+func TestContext2Input_hclRetry(t *testing.T) {
+	m := testModule(t, "input-hcl")
+	p := testProvider("hcl")
+	p.ApplyFn = testApplyFn
+	p.DiffFn = testDiffFn
+
+	// The first answers don't parse as the type of the variable, and are
+	// asked for again with the error.
+	answers := map[string][]string{
+		"var.listed": []string{`{x = "y"}`, `["a", "b"]`},
+		"var.mapped": []string{`["x"]`, `{"x": "y", "w": "z"}`},
+	}
+	var descriptions []string
+	input := &MockUIInput{
+		InputFn: func(opts *InputOpts) (string, error) {
+			descriptions = append(descriptions, opts.Description)
+			answer := answers[opts.Id][0]
+			answers[opts.Id] = answers[opts.Id][1:]
+			return answer, nil
+		},
+	}
+
+	ctx := testContext2(t, &ContextOpts{
+		Module: m,
+		Providers: map[string]ResourceProviderFactory{
+			"hcl": testProviderFuncFixed(p),
+		},
+		Variables: map[string]interface{}{},
+		UIInput:   input,
+	})
+
+	if err := ctx.Input(InputModeVar | InputModeVarUnset); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if len(descriptions) != 4 {
+		t.Fatalf("expected 4 prompts, got: %#v", descriptions)
+	}
+	if !strings.Contains(descriptions[0], "Enter a list") {
+		t.Fatalf("bad: %s", descriptions[0])
+	}
+	if !strings.Contains(descriptions[1], "expected a list") {
+		t.Fatalf("bad: %s", descriptions[1])
+	}
+	if !strings.Contains(descriptions[3], "expected a map") {
+		t.Fatalf("bad: %s", descriptions[3])
+	}
+
+	if _, err := ctx.Plan(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	state, err := ctx.Apply()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	actualStr := strings.TrimSpace(state.String())
+	expectedStr := strings.TrimSpace(testTerraformInputHCL)
+	if actualStr != expectedStr {
+		t.Logf("expected: \n%s", expectedStr)
+		t.Fatalf("bad: \n%s", actualStr)
+	}
+}
+
+func TestContext2Input_hclInvalid(t *testing.T) {
+	m := testModule(t, "input-hcl")
+	input := &MockUIInput{InputReturnString: `"not a list"`}
+	ctx := testContext2(t, &ContextOpts{
+		Module:    m,
+		Variables: map[string]interface{}{},
+		UIInput:   input,
+	})
+
+	err := ctx.Input(InputModeVar | InputModeVarUnset)
+	if err == nil || !strings.Contains(err.Error(), "expected a list") {
+		t.Fatalf("expected error, got: %v", err)
+	}
+}
+
 func TestContext2Input_hcl(t *testing.T) {
 	input := new(MockUIInput)
 	m := testModule(t, "input-hcl")
@@ -257,6 +257,150 @@ func TestContext2Plan_moduleInput(t *testing.T) {
 	}
 }
 
+func TestContext2Plan_varSource(t *testing.T) {
+	m := testModule(t, "plan-var-source")
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	secret := &MockVariableSource{ReadVariableReturn: "hunter2"}
+	list := &MockVariableSource{ReadVariableReturn: `["a", "b"]`}
+	opts := &ContextOpts{
+		Module: m,
+		Providers: map[string]ResourceProviderFactory{
+			"aws": testProviderFuncFixed(p),
+		},
+		VariableSources: map[string]VariableSource{
+			"secret": secret,
+			"list":   list,
+		},
+	}
+	ctx := testContext2(t, opts)
+
+	if !secret.ReadVariableCalled {
+		t.Fatal("should read the secret")
+	}
+	if secret.ReadVariableLocation != "db#password" {
+		t.Fatalf("bad: %s", secret.ReadVariableLocation)
+	}
+	if secret.ReadVariableDir != m.Config().Dir {
+		t.Fatalf("bad: %s", secret.ReadVariableDir)
+	}
+
+	plan, err := ctx.Plan()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	attrs := plan.Diff.RootModule().Resources["aws_instance.foo"].Attributes
+	if v := attrs["foo"].New; v != "hunter2" {
+		t.Fatalf("bad: %s", v)
+	}
+	if v := attrs["num"].New; v != "2" {
+		t.Fatalf("bad: %s", v)
+	}
+
+	// The secrets must not be saved in the plan
+	if len(plan.Vars) != 0 {
+		t.Fatalf("bad: %#v", plan.Vars)
+	}
+
+	// but are read again when it's applied
+	secret.ReadVariableCalled = false
+	ctx, err = plan.Context(opts)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !secret.ReadVariableCalled {
+		t.Fatal("should read the secret again")
+	}
+	if v := ctx.Variables()["password"]; v != "hunter2" {
+		t.Fatalf("bad: %#v", v)
+	}
+}
+
+func TestContext2Plan_varSourceOverride(t *testing.T) {
+	m := testModule(t, "plan-var-source")
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	secret := &MockVariableSource{ReadVariableReturn: "hunter2"}
+	ctx := testContext2(t, &ContextOpts{
+		Module: m,
+		Providers: map[string]ResourceProviderFactory{
+			"aws": testProviderFuncFixed(p),
+		},
+		Variables: map[string]interface{}{
+			"password": "override",
+			"zones":    []interface{}{"a"},
+		},
+		VariableSources: map[string]VariableSource{
+			"secret": secret,
+		},
+	})
+
+	if secret.ReadVariableCalled {
+		t.Fatal("should not read the secret")
+	}
+
+	plan, err := ctx.Plan()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Values that weren't read from a source are saved as usual
+	if v := plan.Vars["password"]; v != "override" {
+		t.Fatalf("bad: %#v", plan.Vars)
+	}
+}
+
+func TestContext2Plan_varSourceErrors(t *testing.T) {
+	m := testModule(t, "plan-var-source")
+	p := testProvider("aws")
+
+	cases := map[string]struct {
+		Sources map[string]VariableSource
+		Err     string
+	}{
+		"unknown type": {
+			map[string]VariableSource{
+				"list": &MockVariableSource{ReadVariableReturn: `["a"]`},
+			},
+			`unknown source type "secret"`,
+		},
+		"read error": {
+			map[string]VariableSource{
+				"secret": &MockVariableSource{ReadVariableReturnError: fmt.Errorf("permission denied")},
+				"list":   &MockVariableSource{ReadVariableReturn: `["a"]`},
+			},
+			"permission denied",
+		},
+		"wrong type": {
+			map[string]VariableSource{
+				"secret": &MockVariableSource{ReadVariableReturn: "hunter2"},
+				"list":   &MockVariableSource{ReadVariableReturn: "hunter2"},
+			},
+			"is not a valid list",
+		},
+	}
+
+	for name, tc := range cases {
+		_, err := NewContext(&ContextOpts{
+			Module: m,
+			Providers: map[string]ResourceProviderFactory{
+				"aws": testProviderFuncFixed(p),
+			},
+			VariableSources: tc.Sources,
+		})
+		if err == nil {
+			t.Fatalf("%s: should error", name)
+		}
+		if !strings.Contains(err.Error(), tc.Err) {
+			t.Fatalf("%s: bad: %s", name, err)
+		}
+		if strings.Contains(err.Error(), "hunter2") {
+			t.Fatalf("%s: error must not contain the secret: %s", name, err)
+		}
+	}
+}
+
 func TestContext2Plan_moduleInputComputed(t *testing.T) {
 	m := testModule(t, "plan-module-input-computed")
 	p := testProvider("aws")
@@ -368,6 +512,62 @@ func TestContext2Plan_moduleOrphans(t *testing.T) {
 	}
 }
 
+func TestContext2Plan_moduleCountOrphans(t *testing.T) {
+	m := testModule(t, "plan-module-count")
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	s := &State{
+		Modules: []*ModuleState{
+			&ModuleState{
+				Path: []string{"root", "child[0]"},
+				Resources: map[string]*ResourceState{
+					"aws_instance.foo": &ResourceState{
+						Type: "aws_instance",
+						Primary: &InstanceState{
+							ID: "foo0",
+							Attributes: map[string]string{
+								"num": "0",
+							},
+						},
+					},
+				},
+			},
+			&ModuleState{
+				Path: []string{"root", "child[2]"},
+				Resources: map[string]*ResourceState{
+					"aws_instance.foo": &ResourceState{
+						Type: "aws_instance",
+						Primary: &InstanceState{
+							ID: "foo2",
+							Attributes: map[string]string{
+								"num": "2",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	ctx := testContext2(t, &ContextOpts{
+		Module: m,
+		Providers: map[string]ResourceProviderFactory{
+			"aws": testProviderFuncFixed(p),
+		},
+		State: s,
+	})
+
+	plan, err := ctx.Plan()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	actual := strings.TrimSpace(plan.String())
+	expected := strings.TrimSpace(testTerraformPlanModuleCountOrphansStr)
+	if actual != expected {
+		t.Fatalf("bad:\n%s", actual)
+	}
+}
+
 // https://github.com/hashicorp/terraform/issues/3114
 func TestContext2Plan_moduleOrphansWithProvisioner(t *testing.T) {
 	m := testModule(t, "plan-modules-remove-provisioners")
@@ -911,6 +1111,33 @@ func TestContext2Plan_computedDataResource(t *testing.T) {
 	}
 }
 
+func TestContext2Plan_dataResourceDependsOn(t *testing.T) {
+	m := testModule(t, "refresh-data-resource-depends-on")
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	ctx := testContext2(t, &ContextOpts{
+		Module: m,
+		Providers: map[string]ResourceProviderFactory{
+			"aws": testProviderFuncFixed(p),
+		},
+	})
+
+	if _, err := ctx.Refresh(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	plan, err := ctx.Plan()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// The data source is read during apply, once aws_instance.foo exists.
+	moduleDiff := plan.Diff.RootModule()
+	if _, ok := moduleDiff.Resources["data.aws_vpc.bar"]; !ok {
+		t.Fatalf("missing diff for data.aws_vpc.bar")
+	}
+}
+
 func TestContext2Plan_computedDataCountResource(t *testing.T) {
 	m := testModule(t, "plan-computed-data-count")
 	p := testProvider("aws")
@@ -1161,6 +1388,41 @@ func TestContext2Plan_countComputed(t *testing.T) {
 	if err == nil {
 		t.Fatal("should error")
 	}
+
+	expected := "aws_instance.bar: value of count can't be computed because " +
+		"aws_instance.foo.foo isn't known until apply"
+	if !strings.Contains(err.Error(), expected) {
+		t.Fatalf("expected %q in: %s", expected, err)
+	}
+}
+
+func TestContext2Plan_countComputedLength(t *testing.T) {
+	m := testModule(t, "plan-count-computed-length")
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	ctx := testContext2(t, &ContextOpts{
+		Module: m,
+		Providers: map[string]ResourceProviderFactory{
+			"aws": testProviderFuncFixed(p),
+		},
+	})
+
+	plan, err := ctx.Plan()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	for _, k := range []string{"aws_instance.bar.0", "aws_instance.bar.2", "aws_instance.baz.5"} {
+		if _, ok := plan.Diff.RootModule().Resources[k]; !ok {
+			t.Fatalf("missing %s in plan:\n\n%s", k, plan)
+		}
+	}
+	if _, ok := plan.Diff.RootModule().Resources["aws_instance.bar.3"]; ok {
+		t.Fatalf("unexpected aws_instance.bar.3 in plan:\n\n%s", plan)
+	}
+	if _, ok := plan.Diff.RootModule().Resources["aws_instance.baz.6"]; ok {
+		t.Fatalf("unexpected aws_instance.baz.6 in plan:\n\n%s", plan)
+	}
 }
 
 func TestContext2Plan_countIndex(t *testing.T) {
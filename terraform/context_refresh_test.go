@@ -570,6 +570,32 @@ func TestContext2Refresh_state(t *testing.T) {
 	}
 }
 
+func TestContext2Refresh_dataDependsOn(t *testing.T) {
+	p := testProvider("aws")
+	m := testModule(t, "refresh-data-resource-depends-on")
+	ctx := testContext2(t, &ContextOpts{
+		Module: m,
+		Providers: map[string]ResourceProviderFactory{
+			"aws": testProviderFuncFixed(p),
+		},
+	})
+
+	s, err := ctx.Refresh()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Data sources that explicitly depend on something are only read
+	// during apply.
+	if p.ReadDataApplyCalled {
+		t.Fatal("ReadDataApply should not have been called")
+	}
+
+	if _, ok := s.RootModule().Resources["data.aws_vpc.bar"]; ok {
+		t.Fatalf("bad: %#v", s.RootModule().Resources)
+	}
+}
+
 func TestContext2Refresh_dataState(t *testing.T) {
 	p := testProvider("null")
 	m := testModule(t, "refresh-data-resource-basic")
@@ -640,6 +666,77 @@ func TestContext2Refresh_dataState(t *testing.T) {
 	}
 }
 
+func TestContext2Refresh_dataCached(t *testing.T) {
+	p := testProvider("null")
+	p.ReadDataDiffFn = nil
+	p.ReadDataDiffReturn = &InstanceDiff{
+		Attributes: map[string]*ResourceAttrDiff{
+			"inputs.%": {
+				Old:  "",
+				New:  "1",
+				Type: DiffAttrInput,
+			},
+		},
+	}
+	p.ReadDataApplyFn = nil
+	p.ReadDataApplyReturn = &InstanceState{
+		ID: "-",
+	}
+
+	refresh := func(fixture string, state *State, refreshData bool) *State {
+		p.ReadDataApplyCalled = false
+		ctx := testContext2(t, &ContextOpts{
+			Module: testModule(t, fixture),
+			Providers: map[string]ResourceProviderFactory{
+				"null": testProviderFuncFixed(p),
+			},
+			State:       state,
+			RefreshData: refreshData,
+		})
+		s, err := ctx.Refresh()
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		return s
+	}
+
+	s := refresh("refresh-data-resource-basic", nil, false)
+	if !p.ReadDataApplyCalled {
+		t.Fatal("ReadDataApply should have been called")
+	}
+	rs := s.RootModule().Resources["data.null_data_source.testing"]
+	if rs == nil || rs.Primary.Meta[dataInputHashMetaKey] == "" {
+		t.Fatalf("input hash should be recorded: %#v", rs)
+	}
+
+	// Nothing changed
+	s = refresh("refresh-data-resource-basic", s, false)
+	if p.ReadDataApplyCalled {
+		t.Fatal("ReadDataApply should not have been called")
+	}
+	if rs := s.RootModule().Resources["data.null_data_source.testing"]; rs == nil || rs.Primary.ID != "-" {
+		t.Fatalf("previous result should be kept: %#v", rs)
+	}
+
+	// Reading data sources again was asked for
+	refresh("refresh-data-resource-basic", s, true)
+	if !p.ReadDataApplyCalled {
+		t.Fatal("ReadDataApply should have been called with RefreshData")
+	}
+
+	// The data source always refreshes
+	refresh("refresh-data-resource-always", s, false)
+	if !p.ReadDataApplyCalled {
+		t.Fatal("ReadDataApply should have been called with always_refresh")
+	}
+
+	// The config changed
+	refresh("refresh-data-resource-changed", s, false)
+	if !p.ReadDataApplyCalled {
+		t.Fatal("ReadDataApply should have been called after a config change")
+	}
+}
+
 func TestContext2Refresh_tainted(t *testing.T) {
 	p := testProvider("aws")
 	m := testModule(t, "refresh-basic")
@@ -285,6 +285,11 @@ type InstanceDiff struct {
 	Attributes     map[string]*ResourceAttrDiff
 	Destroy        bool
 	DestroyTainted bool
+
+	// Warnings are messages from the provider about this change, such as
+	// the use of deprecated arguments. They're shown with the plan but
+	// never fail it, and don't make the diff non-empty.
+	Warnings []string
 }
 
 // ResourceAttrDiff is the diff of a single attribute of a resource.
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/config"
@@ -19,6 +20,11 @@ type EvalApply struct {
 	Output    **InstanceState
 	CreateNew *bool
 	Error     *error
+
+	// Timeout, if non-zero, is the maximum time to wait for the provider
+	// to apply the diff. The deadline of the EvalContext, if any, further
+	// limits this.
+	Timeout time.Duration
 }
 
 // TODO: test
@@ -64,7 +70,7 @@ func (n *EvalApply) Eval(ctx EvalContext) (interface{}, error) {
 
 	// With the completed diff, apply!
 	log.Printf("[DEBUG] apply: %s: executing Apply", n.Info.Id)
-	state, err := provider.Apply(n.Info, state, diff)
+	state, err := n.apply(ctx, provider, state, diff)
 	if state == nil {
 		state = new(InstanceState)
 	}
@@ -104,6 +110,54 @@ func (n *EvalApply) Eval(ctx EvalContext) (interface{}, error) {
 	return nil, nil
 }
 
+// apply calls Apply on the provider, giving up once the timeout or the
+// deadline of the context passes. When it gives up the provider call is
+// abandoned rather than cancelled, so the prior state is returned along
+// with an error: the real resource may or may not have been changed.
+func (n *EvalApply) apply(
+	ctx EvalContext,
+	provider ResourceProvider,
+	state *InstanceState,
+	diff *InstanceDiff) (*InstanceState, error) {
+	timeout := n.Timeout
+	if deadline := ctx.Deadline(); !deadline.IsZero() {
+		remaining := deadline.Sub(time.Now())
+		if remaining <= 0 {
+			return state, fmt.Errorf("deadline exceeded before the operation started")
+		}
+		if timeout == 0 || remaining < timeout {
+			timeout = remaining
+		}
+	}
+
+	if timeout == 0 {
+		return provider.Apply(n.Info, state, diff)
+	}
+
+	type applyResult struct {
+		State *InstanceState
+		Err   error
+	}
+
+	prior := state.DeepCopy()
+	resultCh := make(chan applyResult, 1)
+	go func() {
+		s, err := provider.Apply(n.Info, state, diff)
+		resultCh <- applyResult{State: s, Err: err}
+	}()
+
+	select {
+	case r := <-resultCh:
+		return r.State, r.Err
+	case <-time.After(timeout):
+		log.Printf("[WARN] apply: %s: gave up after %s", n.Info.Id, timeout)
+		return prior, fmt.Errorf(
+			"timeout after %s waiting for the operation to complete. The "+
+				"operation may still be in progress; check the real resource "+
+				"and import it if it was created", timeout)
+	}
+}
+
 // EvalApplyPost is an EvalNode implementation that does the post-Apply work
 type EvalApplyPost struct {
 	Info  *InstanceInfo
@@ -2,6 +2,7 @@ package terraform
 
 import (
 	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform/config"
 )
@@ -77,4 +78,12 @@ type EvalContext interface {
 	// State returns the global state as well as the lock that should
 	// be used to modify that state.
 	State() (*State, *sync.RWMutex)
+
+	// Deadline returns the time by which the current operation must
+	// complete. The zero time means there is no deadline.
+	Deadline() time.Time
+
+	// RefreshData returns true if data sources must be read again during
+	// refresh even if their inputs didn't change since they were last read.
+	RefreshData() bool
 }
@@ -5,6 +5,7 @@ import (
 	"log"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform/config"
 )
@@ -40,6 +41,8 @@ type BuiltinEvalContext struct {
 	DiffLock            *sync.RWMutex
 	StateValue          *State
 	StateLock           *sync.RWMutex
+	DeadlineValue       time.Time
+	RefreshDataValue    bool
 
 	once sync.Once
 }
@@ -340,6 +343,14 @@ func (ctx *BuiltinEvalContext) State() (*State, *sync.RWMutex) {
 	return ctx.StateValue, ctx.StateLock
 }
 
+func (ctx *BuiltinEvalContext) Deadline() time.Time {
+	return ctx.DeadlineValue
+}
+
+func (ctx *BuiltinEvalContext) RefreshData() bool {
+	return ctx.RefreshDataValue
+}
+
 func (ctx *BuiltinEvalContext) init() {
 	// We nil-check the things below because they're meant to be configured,
 	// and we just default them to non-nil.
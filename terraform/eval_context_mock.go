@@ -2,6 +2,7 @@ package terraform
 
 import (
 	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform/config"
 )
@@ -83,6 +84,12 @@ type MockEvalContext struct {
 	StateCalled bool
 	StateState  *State
 	StateLock   *sync.RWMutex
+
+	DeadlineCalled bool
+	DeadlineTime   time.Time
+
+	RefreshDataCalled bool
+	RefreshDataValue  bool
 }
 
 func (c *MockEvalContext) Hook(fn func(Hook) (HookAction, error)) error {
@@ -198,3 +205,13 @@ func (c *MockEvalContext) State() (*State, *sync.RWMutex) {
 	c.StateCalled = true
 	return c.StateState, c.StateLock
 }
+
+func (c *MockEvalContext) Deadline() time.Time {
+	c.DeadlineCalled = true
+	return c.DeadlineTime
+}
+
+func (c *MockEvalContext) RefreshData() bool {
+	c.RefreshDataCalled = true
+	return c.RefreshDataValue
+}
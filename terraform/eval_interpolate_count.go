@@ -0,0 +1,150 @@
+package terraform
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/config"
+)
+
+// EvalInterpolateCount is an EvalNode that interpolates the count of a
+// resource. Counts can depend on other resources, so they may not be known
+// until those resources are created.
+//
+// When planning or applying, an unknown count is an error that names the
+// values that aren't known yet. When FromState is set, which is used for
+// walks that only need to visit the instances that already exist such as
+// refresh and destroy, a count that is unknown or can't be interpolated
+// because the resources it depends on aren't in the state yet is replaced
+// with the number of instances of the resource in the state instead.
+type EvalInterpolateCount struct {
+	Resource  *config.Resource
+	FromState bool
+}
+
+func (n *EvalInterpolateCount) Eval(ctx EvalContext) (interface{}, error) {
+	rc := n.Resource.RawCount
+	if _, err := ctx.Interpolate(rc, nil); err != nil {
+		if !n.FromState {
+			return nil, err
+		}
+
+		log.Printf(
+			"[DEBUG] %s: can't interpolate count, using the state: %s",
+			n.Resource.Id(), err)
+		return nil, n.setStateCount(ctx)
+	}
+
+	if !valueComputed(rc) {
+		return nil, nil
+	}
+
+	if n.FromState {
+		return nil, n.setStateCount(ctx)
+	}
+
+	unknown, err := n.unknownVariables(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(unknown) == 0 {
+		return nil, fmt.Errorf(
+			"%s: value of count can't be computed because it depends on "+
+				"values that aren't known until apply", n.Resource.Id())
+	}
+
+	return nil, fmt.Errorf(
+		"%s: value of count can't be computed because %s isn't known until "+
+			"apply. The count can only depend on values that are known when "+
+			"planning, such as variables and the number of instances of other "+
+			"resources, for example \"${length(aws_instance.foo.*.id)}\". "+
+			"To use other values, apply the resources they come from first "+
+			"with -target.",
+		n.Resource.Id(), strings.Join(unknown, ", "))
+}
+
+// setStateCount sets the count to the number of instances of the resource
+// in the state.
+func (n *EvalInterpolateCount) setStateCount(ctx EvalContext) error {
+	count, err := n.stateCount(ctx)
+	if err != nil {
+		return err
+	}
+
+	rc := n.Resource.RawCount
+	rc.Config()[rc.Key] = strconv.FormatInt(int64(count), 10)
+	return nil
+}
+
+// unknownVariables returns the variables referenced by the count whose
+// values aren't known, sorted.
+func (n *EvalInterpolateCount) unknownVariables(ctx EvalContext) ([]string, error) {
+	var result []string
+	for k := range n.Resource.RawCount.Variables {
+		rc, err := config.NewRawConfig(map[string]interface{}{
+			"value": fmt.Sprintf("${%s}", k),
+		})
+		if err != nil {
+			return nil, err
+		}
+		rc.Key = "value"
+
+		if _, err := ctx.Interpolate(rc, nil); err != nil {
+			return nil, err
+		}
+		if valueComputed(rc) {
+			result = append(result, k)
+		}
+	}
+
+	sort.Strings(result)
+	return result, nil
+}
+
+// stateCount returns the number of instances of the resource in the state.
+func (n *EvalInterpolateCount) stateCount(ctx EvalContext) (int, error) {
+	state, lock := ctx.State()
+	lock.RLock()
+	defer lock.RUnlock()
+
+	mod := state.ModuleByPath(ctx.Path())
+	if mod == nil {
+		return 0, nil
+	}
+
+	count := 0
+	for k := range mod.Resources {
+		key, err := ParseResourceStateKey(k)
+		if err != nil {
+			return 0, err
+		}
+		if key.Mode != n.Resource.Mode || key.Type != n.Resource.Type || key.Name != n.Resource.Name {
+			continue
+		}
+
+		index := key.Index
+		if index < 0 {
+			index = 0
+		}
+		if index+1 > count {
+			count = index + 1
+		}
+	}
+
+	return count, nil
+}
+
+// countComputed returns true if the value of the interpolated single-value
+// RawConfig rc isn't known.
+func valueComputed(rc *config.RawConfig) bool {
+	for _, k := range rc.UnknownKeys() {
+		if k == rc.Key {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,119 @@
+package terraform
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/hil/ast"
+	"github.com/hashicorp/terraform/config"
+)
+
+func TestEvalInterpolateCount_fromState(t *testing.T) {
+	r := testEvalInterpolateCountResource(t)
+
+	ctx := new(MockEvalContext)
+	ctx.PathPath = rootModulePath
+	ctx.StateLock = new(sync.RWMutex)
+	ctx.StateState = &State{
+		Modules: []*ModuleState{
+			&ModuleState{
+				Path: rootModulePath,
+				Resources: map[string]*ResourceState{
+					"aws_instance.foo.0": &ResourceState{
+						Type:    "aws_instance",
+						Primary: &InstanceState{ID: "foo0"},
+					},
+					"aws_instance.foo.2": &ResourceState{
+						Type:    "aws_instance",
+						Primary: &InstanceState{ID: "foo2"},
+					},
+					"aws_instance.foobar.5": &ResourceState{
+						Type:    "aws_instance",
+						Primary: &InstanceState{ID: "foobar5"},
+					},
+				},
+			},
+		},
+	}
+
+	n := &EvalInterpolateCount{Resource: r, FromState: true}
+	if _, err := n.Eval(ctx); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	count, err := r.Count()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if count != 3 {
+		t.Fatalf("expected count 3, got %d", count)
+	}
+}
+
+func TestEvalInterpolateCount_known(t *testing.T) {
+	raw, err := config.NewRawConfig(map[string]interface{}{"count": "2"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	raw.Key = "count"
+	if err := raw.Interpolate(nil); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	ctx := new(MockEvalContext)
+	n := &EvalInterpolateCount{
+		Resource: &config.Resource{
+			Mode:     config.ManagedResourceMode,
+			Type:     "aws_instance",
+			Name:     "foo",
+			RawCount: raw,
+		},
+	}
+	if _, err := n.Eval(ctx); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !ctx.InterpolateCalled {
+		t.Fatal("should interpolate the count")
+	}
+}
+
+func TestEvalInterpolateCount_computed(t *testing.T) {
+	ctx := new(MockEvalContext)
+	n := &EvalInterpolateCount{Resource: testEvalInterpolateCountResource(t)}
+
+	_, err := n.Eval(ctx)
+	if err == nil {
+		t.Fatal("should error")
+	}
+	if !strings.Contains(err.Error(), "value of count can't be computed") {
+		t.Fatalf("bad: %s", err)
+	}
+}
+
+func testEvalInterpolateCountResource(t *testing.T) *config.Resource {
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"count": "${var.foo}",
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	raw.Key = "count"
+
+	err = raw.Interpolate(map[string]ast.Variable{
+		"var.foo": ast.Variable{
+			Type:  ast.TypeString,
+			Value: config.UnknownVariableValue,
+		},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	return &config.Resource{
+		Mode:     config.ManagedResourceMode,
+		Type:     "aws_instance",
+		Name:     "foo",
+		RawCount: raw,
+	}
+}
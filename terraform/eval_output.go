@@ -37,8 +37,10 @@ func (n *EvalDeleteOutput) Eval(ctx EvalContext) (interface{}, error) {
 
 // EvalWriteOutput is an EvalNode implementation that writes the output
 // for the given name to the current state.
+// If Type is set, the value must be of that type.
 type EvalWriteOutput struct {
 	Name      string
+	Type      string
 	Sensitive bool
 	Value     *config.RawConfig
 }
@@ -79,21 +81,22 @@ func (n *EvalWriteOutput) Eval(ctx EvalContext) (interface{}, error) {
 		}
 	}
 
+	var output *OutputState
 	switch valueTyped := valueRaw.(type) {
 	case string:
-		mod.Outputs[n.Name] = &OutputState{
+		output = &OutputState{
 			Type:      "string",
 			Sensitive: n.Sensitive,
 			Value:     valueTyped,
 		}
 	case []interface{}:
-		mod.Outputs[n.Name] = &OutputState{
+		output = &OutputState{
 			Type:      "list",
 			Sensitive: n.Sensitive,
 			Value:     valueTyped,
 		}
 	case map[string]interface{}:
-		mod.Outputs[n.Name] = &OutputState{
+		output = &OutputState{
 			Type:      "map",
 			Sensitive: n.Sensitive,
 			Value:     valueTyped,
@@ -102,7 +105,7 @@ func (n *EvalWriteOutput) Eval(ctx EvalContext) (interface{}, error) {
 		// an HCL map is multi-valued, so if this was read out of a config the
 		// map may still be in a slice.
 		if len(valueTyped) == 1 {
-			mod.Outputs[n.Name] = &OutputState{
+			output = &OutputState{
 				Type:      "map",
 				Sensitive: n.Sensitive,
 				Value:     valueTyped[0],
@@ -115,5 +118,14 @@ func (n *EvalWriteOutput) Eval(ctx EvalContext) (interface{}, error) {
 		return nil, fmt.Errorf("output %s is not a valid type (%T)\n", n.Name, valueTyped)
 	}
 
+	// Unknown values are checked once they're known
+	if n.Type != "" && valueRaw != config.UnknownVariableValue && output.Type != n.Type {
+		return nil, fmt.Errorf(
+			"output %s is declared as type %s, but its value is of type %s",
+			n.Name, n.Type, output.Type)
+	}
+
+	mod.Outputs[n.Name] = output
+
 	return nil, nil
 }
@@ -3,6 +3,8 @@ package terraform
 import (
 	"sync"
 	"testing"
+
+	"github.com/hashicorp/terraform/config"
 )
 
 func TestEvalWriteMapOutput(t *testing.T) {
@@ -54,3 +56,41 @@ func TestEvalWriteMapOutput(t *testing.T) {
 		})
 	}
 }
+
+func TestEvalWriteOutput_type(t *testing.T) {
+	cases := []struct {
+		name  string
+		typ   string
+		value interface{}
+		err   bool
+	}{
+		{"list", "list", []interface{}{"a"}, false},
+		{"map", "map", []map[string]interface{}{{"a": "b"}}, false},
+		{"undeclared", "", "a", false},
+		{"mismatch", "string", []interface{}{"a"}, true},
+		{"unknown", "list", config.UnknownVariableValue, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := new(MockEvalContext)
+			ctx.StateState = NewState()
+			ctx.StateLock = new(sync.RWMutex)
+			ctx.PathPath = rootModulePath
+			ctx.InterpolateConfigResult = &ResourceConfig{
+				Config: map[string]interface{}{"value": tc.value},
+			}
+
+			evalNode := &EvalWriteOutput{Name: tc.name, Type: tc.typ}
+			_, err := evalNode.Eval(ctx)
+			if (err != nil) != tc.err {
+				t.Fatalf("expected error: %t, got: %v", tc.err, err)
+			}
+
+			_, written := ctx.StateState.RootModule().Outputs[tc.name]
+			if written == tc.err {
+				t.Fatalf("expected output to be written: %t", !tc.err)
+			}
+		})
+	}
+}
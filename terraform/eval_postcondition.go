@@ -0,0 +1,170 @@
+package terraform
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/config"
+)
+
+// postconditionInterval is the default time to wait between checks of a
+// postcondition that has a timeout.
+const postconditionInterval = 10 * time.Second
+
+// EvalCheckPostconditions is an EvalNode implementation that verifies the
+// postconditions of a resource or output once it has been applied.
+type EvalCheckPostconditions struct {
+	Name           string
+	Postconditions []*config.Postcondition
+
+	// The fields below are only set for resources. Resource is the
+	// interpolation scope for self references, and State is the state
+	// of the applied resource. If the resource has no state, for example
+	// because it failed to create, the postconditions are not checked.
+	Resource *Resource
+	State    **InstanceState
+	Error    *error
+
+	// Refresh is evaluated before checking a postcondition again while
+	// waiting for it to hold. It should refresh the resource and write
+	// its state so that the next check sees the new values.
+	Refresh EvalNode
+
+	// Interval is the time to wait between checks. It defaults to
+	// postconditionInterval.
+	Interval time.Duration
+}
+
+func (n *EvalCheckPostconditions) Eval(ctx EvalContext) (interface{}, error) {
+	if len(n.Postconditions) == 0 {
+		return nil, nil
+	}
+
+	// If the apply already failed or there is no resource left, there is
+	// nothing to check.
+	if n.Error != nil && *n.Error != nil {
+		return nil, nil
+	}
+	if n.State != nil && (*n.State == nil || (*n.State).ID == "") {
+		return nil, nil
+	}
+
+	var errs error
+	for i, p := range n.Postconditions {
+		if err := n.check(ctx, i, p); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	if errs != nil && n.Error != nil {
+		*n.Error = multierror.Append(*n.Error, errs)
+		return nil, nil
+	}
+
+	return nil, errs
+}
+
+// check verifies a single postcondition, checking it again until its
+// timeout passes if it has one.
+func (n *EvalCheckPostconditions) check(
+	ctx EvalContext, idx int, p *config.Postcondition) error {
+	var timeout time.Duration
+	if p.Timeout != "" {
+		var err error
+		timeout, err = time.ParseDuration(p.Timeout)
+		if err != nil {
+			return fmt.Errorf("%s: postcondition #%d: %s", n.Name, idx+1, err)
+		}
+	}
+
+	interval := n.Interval
+	if interval == 0 {
+		interval = postconditionInterval
+	}
+
+	deadline := time.Now().Add(timeout)
+	if d := ctx.Deadline(); !d.IsZero() && d.Before(deadline) {
+		deadline = d
+	}
+
+	for {
+		ok, msg, err := n.evaluate(ctx, idx, p)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+
+		if timeout == 0 || n.Refresh == nil || time.Now().Add(interval).After(deadline) {
+			return fmt.Errorf("%s: postcondition failed: %s", n.Name, msg)
+		}
+
+		log.Printf(
+			"[DEBUG] %s: postcondition #%d doesn't hold yet, checking again in %s",
+			n.Name, idx+1, interval)
+		time.Sleep(interval)
+
+		if _, err := n.Refresh.Eval(ctx); err != nil {
+			return err
+		}
+		if n.State != nil && (*n.State == nil || (*n.State).ID == "") {
+			return fmt.Errorf(
+				"%s: resource disappeared while waiting for postcondition #%d",
+				n.Name, idx+1)
+		}
+	}
+}
+
+// evaluate interpolates the postcondition and returns whether it holds,
+// along with the message to report if it doesn't.
+func (n *EvalCheckPostconditions) evaluate(
+	ctx EvalContext, idx int, p *config.Postcondition) (bool, string, error) {
+	cfg, err := ctx.Interpolate(p.RawConfig.Copy(), n.Resource)
+	if err != nil {
+		return false, "", fmt.Errorf(
+			"%s: postcondition #%d: %s", n.Name, idx+1, err)
+	}
+
+	msg := fmt.Sprintf("postcondition #%d is not true", idx+1)
+	if v, ok := cfg.Get("error_message"); ok && !cfg.IsComputed("error_message") {
+		if s, ok := v.(string); ok && s != "" {
+			msg = s
+		}
+	}
+
+	// A condition that still isn't known can't be checked. This only
+	// happens when it depends on values that are unknown even after apply.
+	if cfg.IsComputed("condition") {
+		log.Printf(
+			"[WARN] %s: postcondition #%d has an unknown value, skipping",
+			n.Name, idx+1)
+		return true, "", nil
+	}
+
+	raw, _ := cfg.Get("condition")
+	s, ok := raw.(string)
+	if !ok {
+		return false, "", fmt.Errorf(
+			"%s: postcondition #%d: condition must be a single value, got %T",
+			n.Name, idx+1, raw)
+	}
+
+	// Numbers hold when they are greater than zero, which allows
+	// conditions such as "${length(self.instances)}".
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return i > 0, msg, nil
+	}
+
+	result, err := strconv.ParseBool(s)
+	if err != nil {
+		return false, "", fmt.Errorf(
+			"%s: postcondition #%d: condition must be true, false or a number, got %q",
+			n.Name, idx+1, s)
+	}
+
+	return result, msg, nil
+}
@@ -0,0 +1,211 @@
+package terraform
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/config"
+)
+
+func TestEvalCheckPostconditions_impl(t *testing.T) {
+	var _ EvalNode = new(EvalCheckPostconditions)
+}
+
+func TestEvalCheckPostconditions(t *testing.T) {
+	cases := map[string]struct {
+		Config *ResourceConfig
+		Err    string
+	}{
+		"true": {
+			&ResourceConfig{
+				Config: map[string]interface{}{"condition": "true"},
+			},
+			"",
+		},
+		"false with message": {
+			&ResourceConfig{
+				Config: map[string]interface{}{
+					"condition":     "false",
+					"error_message": "not serving",
+				},
+			},
+			"output.foo: postcondition failed: not serving",
+		},
+		"false": {
+			&ResourceConfig{
+				Config: map[string]interface{}{"condition": "false"},
+			},
+			"postcondition #1 is not true",
+		},
+		"positive number": {
+			&ResourceConfig{
+				Config: map[string]interface{}{"condition": "2"},
+			},
+			"",
+		},
+		"zero": {
+			&ResourceConfig{
+				Config: map[string]interface{}{"condition": "0"},
+			},
+			"postcondition #1 is not true",
+		},
+		"negative number": {
+			&ResourceConfig{
+				Config: map[string]interface{}{"condition": "-1"},
+			},
+			"postcondition #1 is not true",
+		},
+		"not a boolean": {
+			&ResourceConfig{
+				Config: map[string]interface{}{"condition": "maybe"},
+			},
+			"condition must be true, false or a number",
+		},
+		"computed": {
+			&ResourceConfig{
+				Raw:    map[string]interface{}{"condition": "${var.foo}"},
+				Config: map[string]interface{}{},
+			},
+			"",
+		},
+	}
+
+	for tn, tc := range cases {
+		ctx := &MockEvalContext{InterpolateConfigResult: tc.Config}
+		n := &EvalCheckPostconditions{
+			Name: "output.foo",
+			Postconditions: []*config.Postcondition{
+				testPostcondition(t, ""),
+			},
+		}
+
+		_, err := n.Eval(ctx)
+		if (err != nil) != (tc.Err != "") {
+			t.Fatalf("%s: unexpected err: %v", tn, err)
+		}
+		if err != nil && !strings.Contains(err.Error(), tc.Err) {
+			t.Fatalf("%s: expected %q in: %s", tn, tc.Err, err)
+		}
+	}
+}
+
+func TestEvalCheckPostconditions_applyFailed(t *testing.T) {
+	ctx := &MockEvalContext{}
+	applyErr := fmt.Errorf("apply failed")
+	state := &InstanceState{ID: "foo"}
+	n := &EvalCheckPostconditions{
+		Name: "aws_instance.foo",
+		Postconditions: []*config.Postcondition{
+			testPostcondition(t, ""),
+		},
+		State: &state,
+		Error: &applyErr,
+	}
+
+	if _, err := n.Eval(ctx); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if ctx.InterpolateCalled {
+		t.Fatal("should not check postconditions of a failed resource")
+	}
+	if applyErr.Error() != "apply failed" {
+		t.Fatalf("bad: %s", applyErr)
+	}
+}
+
+func TestEvalCheckPostconditions_timeout(t *testing.T) {
+	ctx := &MockEvalContext{
+		InterpolateConfigResult: &ResourceConfig{
+			Config: map[string]interface{}{"condition": "false"},
+		},
+	}
+
+	var err error
+	refreshes := 0
+	state := &InstanceState{ID: "foo"}
+	n := &EvalCheckPostconditions{
+		Name: "aws_instance.foo",
+		Postconditions: []*config.Postcondition{
+			testPostcondition(t, "1m"),
+		},
+		State:    &state,
+		Error:    &err,
+		Interval: time.Millisecond,
+		Refresh: &testEvalFunc{func(EvalContext) (interface{}, error) {
+			refreshes++
+			if refreshes == 3 {
+				ctx.InterpolateConfigResult = &ResourceConfig{
+					Config: map[string]interface{}{"condition": "true"},
+				}
+			}
+			return nil, nil
+		}},
+	}
+
+	if _, evalErr := n.Eval(ctx); evalErr != nil {
+		t.Fatalf("err: %s", evalErr)
+	}
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if refreshes != 3 {
+		t.Fatalf("expected 3 refreshes, got %d", refreshes)
+	}
+}
+
+func TestEvalCheckPostconditions_timeoutExceeded(t *testing.T) {
+	ctx := &MockEvalContext{
+		InterpolateConfigResult: &ResourceConfig{
+			Config: map[string]interface{}{
+				"condition":     "false",
+				"error_message": "no healthy instances",
+			},
+		},
+	}
+
+	var err error
+	state := &InstanceState{ID: "foo"}
+	n := &EvalCheckPostconditions{
+		Name: "aws_elb.web",
+		Postconditions: []*config.Postcondition{
+			testPostcondition(t, "20ms"),
+		},
+		State:    &state,
+		Error:    &err,
+		Interval: 5 * time.Millisecond,
+		Refresh: &testEvalFunc{func(EvalContext) (interface{}, error) {
+			return nil, nil
+		}},
+	}
+
+	if _, evalErr := n.Eval(ctx); evalErr != nil {
+		t.Fatalf("err: %s", evalErr)
+	}
+	if err == nil || !strings.Contains(err.Error(), "aws_elb.web: postcondition failed: no healthy instances") {
+		t.Fatalf("bad: %v", err)
+	}
+}
+
+func testPostcondition(t *testing.T, timeout string) *config.Postcondition {
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"condition": "${var.ready}",
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	return &config.Postcondition{
+		RawConfig: raw,
+		Timeout:   timeout,
+	}
+}
+
+type testEvalFunc struct {
+	F func(EvalContext) (interface{}, error)
+}
+
+func (n *testEvalFunc) Eval(ctx EvalContext) (interface{}, error) {
+	return n.F(ctx)
+}
@@ -2,8 +2,72 @@ package terraform
 
 import (
 	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/mitchellh/hashstructure"
 )
 
+// dataInputHashMetaKey is the key of the instance state meta that records
+// the hash of the inputs a data source was read with.
+const dataInputHashMetaKey = "data_input_hash"
+
+// EvalDataInputHash is an EvalNode implementation that hashes the inputs of
+// a data source: its own configuration and the configuration of its
+// provider. A data source whose inputs didn't change since it was last read
+// doesn't need to be read again.
+type EvalDataInputHash struct {
+	Provider string
+	Config   **ResourceConfig
+	Output   *string
+}
+
+func (n *EvalDataInputHash) Eval(ctx EvalContext) (interface{}, error) {
+	inputs := make(map[string]interface{})
+	if config := *n.Config; config != nil {
+		inputs["config"] = config.Raw
+	}
+	if config := ctx.ParentProviderConfig(n.Provider); config != nil {
+		inputs["provider"] = config.Raw
+	}
+
+	hash, err := hashstructure.Hash(inputs, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	*n.Output = strconv.FormatUint(hash, 16)
+	return nil, nil
+}
+
+// EvalReadDataCached is an EvalNode implementation that reuses the state
+// of a data source from the previous run, if its inputs didn't change since
+// it was read and the user didn't ask for data sources to be read again.
+// Output is set to the previous state if it can be reused, or nil.
+type EvalReadDataCached struct {
+	Info          *InstanceInfo
+	Prior         **InstanceState
+	InputHash     *string
+	AlwaysRefresh bool
+	Output        **InstanceState
+}
+
+func (n *EvalReadDataCached) Eval(ctx EvalContext) (interface{}, error) {
+	*n.Output = nil
+
+	prior := *n.Prior
+	if prior == nil || n.AlwaysRefresh || ctx.RefreshData() {
+		return nil, nil
+	}
+	if hash := prior.Meta[dataInputHashMetaKey]; hash == "" || hash != *n.InputHash {
+		return nil, nil
+	}
+
+	log.Printf("[DEBUG] %s: inputs unchanged, reusing the previous result", n.Info.Id)
+	*n.Output = prior
+	return nil, nil
+}
+
 // EvalReadDataDiff is an EvalNode implementation that executes a data
 // resource's ReadDataDiff method to discover what attributes it exports.
 type EvalReadDataDiff struct {
@@ -90,6 +154,10 @@ type EvalReadDataApply struct {
 	Output   **InstanceState
 	Diff     **InstanceDiff
 	Info     *InstanceInfo
+
+	// InputHash, if set, is recorded in the state so that the data
+	// source isn't read again until its inputs change.
+	InputHash *string
 }
 
 func (n *EvalReadDataApply) Eval(ctx EvalContext) (interface{}, error) {
@@ -124,6 +192,11 @@ func (n *EvalReadDataApply) Eval(ctx EvalContext) (interface{}, error) {
 		return nil, fmt.Errorf("%s: %s", n.Info.Id, err)
 	}
 
+	if state != nil && n.InputHash != nil && *n.InputHash != "" {
+		state.init()
+		state.Meta[dataInputHashMetaKey] = *n.InputHash
+	}
+
 	err = ctx.Hook(func(h Hook) (HookAction, error) {
 		return h.PostRefresh(n.Info, state)
 	})
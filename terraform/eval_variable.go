@@ -30,10 +30,7 @@ type EvalTypeCheckVariable struct {
 }
 
 func (n *EvalTypeCheckVariable) Eval(ctx EvalContext) (interface{}, error) {
-	currentTree := n.ModuleTree
-	for _, pathComponent := range n.ModulePath[1:] {
-		currentTree = currentTree.Children()[pathComponent]
-	}
+	currentTree := n.ModuleTree.Child(n.ModulePath[1:])
 	targetConfig := currentTree.Config()
 
 	prototypes := make(map[string]config.VariableType)
@@ -213,10 +210,7 @@ type EvalCoerceMapVariable struct {
 // Eval implements the EvalNode interface. See EvalCoerceMapVariable for
 // details.
 func (n *EvalCoerceMapVariable) Eval(ctx EvalContext) (interface{}, error) {
-	currentTree := n.ModuleTree
-	for _, pathComponent := range n.ModulePath[1:] {
-		currentTree = currentTree.Children()[pathComponent]
-	}
+	currentTree := n.ModuleTree.Child(n.ModulePath[1:])
 	targetConfig := currentTree.Config()
 
 	prototypes := make(map[string]config.VariableType)
@@ -130,6 +130,14 @@ func (g *Graph) ConnectTo(v dag.Vertex, targets []string) []string {
 	for _, t := range targets {
 		if dest := g.dependableMap[t]; dest != nil {
 			g.Connect(dag.BasicEdge(v, dest))
+		} else if dests := g.dependableSplat(t); len(dests) > 0 {
+			for _, dest := range dests {
+				g.Connect(dag.BasicEdge(v, dest))
+			}
+		} else if dests := g.dependableModule(t); len(dests) > 0 {
+			for _, dest := range dests {
+				g.Connect(dag.BasicEdge(v, dest))
+			}
 		} else {
 			missing = append(missing, t)
 		}
@@ -138,6 +146,54 @@ func (g *Graph) ConnectTo(v dag.Vertex, targets []string) []string {
 	return missing
 }
 
+// dependableSplat returns the vertices for a dependable name referencing
+// all instances of a counted module, such as "module.foo[*].output.bar".
+// The instances are numbered sequentially, so this looks them up until
+// the first index that isn't found.
+func (g *Graph) dependableSplat(n string) []dag.Vertex {
+	idx := strings.Index(n, "[*]")
+	if idx == -1 {
+		return nil
+	}
+
+	var result []dag.Vertex
+	for i := 0; ; i++ {
+		name := fmt.Sprintf("%s[%d]%s", n[:idx], i, n[idx+3:])
+		dest := g.dependableMap[name]
+		if dest == nil {
+			return result
+		}
+
+		result = append(result, dest)
+	}
+}
+
+// dependableModule returns the vertices for a dependable name referencing
+// a whole module, such as "module.foo", that isn't a vertex of its own
+// anymore. This is the case once the module is flattened, or when it has
+// count set, and the name then refers to every vertex within the module.
+func (g *Graph) dependableModule(n string) []dag.Vertex {
+	if !strings.HasPrefix(n, "module.") || strings.Count(n, ".") != 1 {
+		return nil
+	}
+
+	seen := make(map[dag.Vertex]struct{})
+	var result []dag.Vertex
+	for name, v := range g.dependableMap {
+		if !strings.HasPrefix(name, n+".") && !strings.HasPrefix(name, n+"[") {
+			continue
+		}
+		if _, ok := seen[v]; ok {
+			continue
+		}
+
+		seen[v] = struct{}{}
+		result = append(result, v)
+	}
+
+	return result
+}
+
 // Dependable finds the vertices in the graph that have the given dependable
 // names and returns them.
 func (g *Graph) Dependable(n string) dag.Vertex {
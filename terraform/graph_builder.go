@@ -73,6 +73,11 @@ type BuiltinGraphBuilder struct {
 	// Targets is the user-specified list of resources to target.
 	Targets []string
 
+	// Variables and Meta are used to compute the count of counted
+	// modules, which are expanded while the graph is built.
+	Variables map[string]interface{}
+	Meta      *ContextMeta
+
 	// Destroy is set to true when we're in a `terraform destroy` or a
 	// `terraform plan -destroy`
 	Destroy bool
@@ -103,10 +108,16 @@ func (b *BuiltinGraphBuilder) Build(path []string) (*Graph, error) {
 func (b *BuiltinGraphBuilder) Steps(path []string) []GraphTransformer {
 	steps := []GraphTransformer{
 		// Create all our resources from the configuration and state
-		&ConfigTransformer{Module: b.Root},
+		&ConfigTransformer{
+			Module:    b.Root,
+			Variables: b.Variables,
+			Meta:      b.Meta,
+		},
 		&OrphanTransformer{
-			State:  b.State,
-			Module: b.Root,
+			State:     b.State,
+			Module:    b.Root,
+			Variables: b.Variables,
+			Meta:      b.Meta,
 		},
 
 		// Output-related transformations
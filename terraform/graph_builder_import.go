@@ -16,6 +16,11 @@ type ImportGraphBuilder struct {
 
 	// Providers is the list of providers supported.
 	Providers []string
+
+	// Variables and Meta are used to compute the count of counted
+	// modules, see ConfigTransformer.
+	Variables map[string]interface{}
+	Meta      *ContextMeta
 }
 
 // Build builds the graph according to the steps returned by Steps.
@@ -38,7 +43,11 @@ func (b *ImportGraphBuilder) Steps() []GraphTransformer {
 
 	steps := []GraphTransformer{
 		// Create all our resources from the configuration and state
-		&ConfigTransformer{Module: mod},
+		&ConfigTransformer{
+			Module:    mod,
+			Variables: b.Variables,
+			Meta:      b.Meta,
+		},
 
 		// Add the import steps
 		&ImportStateTransformer{Targets: b.ImportTargets},
@@ -204,6 +204,25 @@ func TestBuiltinGraphBuilder_multiLevelModule(t *testing.T) {
 	}
 }
 
+func TestBuiltinGraphBuilder_moduleDependsOn(t *testing.T) {
+	b := &BuiltinGraphBuilder{
+		Root:      testModule(t, "graph-builder-module-depends-on"),
+		Providers: []string{"aws"},
+		Validate:  true,
+	}
+
+	g, err := b.Build(RootModulePath)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	actual := strings.TrimSpace(g.String())
+	expected := strings.TrimSpace(testBuiltinGraphBuilderModuleDependsOnStr)
+	if actual != expected {
+		t.Fatalf("bad: %s", actual)
+	}
+}
+
 func TestBuiltinGraphBuilder_orphanDeps(t *testing.T) {
 	state := &State{
 		Modules: []*ModuleState{
@@ -324,6 +343,35 @@ root
   module.foo.var.foo
 `
 
+const testBuiltinGraphBuilderModuleDependsOnStr = `
+aws_instance.db
+  provider.aws
+aws_instance.web
+  module.child.aws_instance.foo
+  module.child.provider.aws
+  provider.aws
+module.child.aws_instance.foo
+  aws_instance.db
+  module.child.provider.aws
+module.child.plan-destroy
+  aws_instance.db
+module.child.provider.aws
+  aws_instance.db
+  provider.aws
+provider.aws
+provider.aws (close)
+  aws_instance.db
+  aws_instance.web
+  provider.aws
+provider.module.child.aws (close)
+  module.child.aws_instance.foo
+  module.child.provider.aws
+root
+  module.child.plan-destroy
+  provider.aws (close)
+  provider.module.child.aws (close)
+`
+
 const testBuiltinGraphBuilderOrphanDepsStr = `
 aws_instance.bar (orphan)
   provider.aws
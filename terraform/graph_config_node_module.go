@@ -11,6 +11,8 @@ import (
 )
 
 // GraphNodeConfigModule represents a module within the configuration graph.
+// Counted modules are represented by a node for each instance, in which
+// case the last element of Path is the instance name, such as "foo[1]".
 type GraphNodeConfigModule struct {
 	Path   []string
 	Module *config.Module
@@ -35,7 +37,8 @@ func (n *GraphNodeConfigModule) DependableName() []string {
 
 func (n *GraphNodeConfigModule) DependentOn() []string {
 	vars := n.Module.RawConfig.Variables
-	result := make([]string, 0, len(vars))
+	result := make([]string, len(n.Module.DependsOn), len(vars)+len(n.Module.DependsOn))
+	copy(result, n.Module.DependsOn)
 	for _, v := range vars {
 		if vn := varNameForVar(v); vn != "" {
 			result = append(result, vn)
@@ -46,7 +49,14 @@ func (n *GraphNodeConfigModule) DependentOn() []string {
 }
 
 func (n *GraphNodeConfigModule) Name() string {
-	return fmt.Sprintf("module.%s", n.Module.Name)
+	return fmt.Sprintf("module.%s", n.Path[len(n.Path)-1])
+}
+
+// index returns the index of the module instance, or -1 if the module
+// doesn't have count set.
+func (n *GraphNodeConfigModule) index() int {
+	_, index := config.ParseModuleInstanceName(n.Path[len(n.Path)-1])
+	return index
 }
 
 // GraphNodeExpandable
@@ -138,12 +148,20 @@ func (n *graphNodeModuleExpanded) DotNode(name string, opts *GraphDotOpts) *dot.
 
 // GraphNodeEvalable impl.
 func (n *graphNodeModuleExpanded) EvalTree() EvalNode {
+	// Instances of counted modules can use count.index within the
+	// module block, which is interpolated in the scope of the instance.
+	var resource *Resource
+	if index := n.Original.index(); index != -1 {
+		resource = &Resource{CountIndex: index}
+	}
+
 	var resourceConfig *ResourceConfig
 	return &EvalSequence{
 		Nodes: []EvalNode{
 			&EvalInterpolate{
-				Config: n.Original.Module.RawConfig,
-				Output: &resourceConfig,
+				Config:   n.Original.Module.RawConfig,
+				Resource: resource,
+				Output:   &resourceConfig,
 			},
 
 			&EvalVariableBlock{
@@ -188,6 +206,11 @@ func (n *graphNodeModuleExpanded) FlattenGraph() *Graph {
 	return graph
 }
 
+// GraphNodeFlatDependent impl.
+func (n *graphNodeModuleExpanded) FlatDependentOn() []string {
+	return n.Original.Module.DependsOn
+}
+
 // GraphNodeSubgraph impl.
 func (n *graphNodeModuleExpanded) Subgraph() *Graph {
 	return n.Graph
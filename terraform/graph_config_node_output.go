@@ -37,6 +37,13 @@ func (n *GraphNodeConfigOutput) DependentOn() []string {
 			result = append(result, vn)
 		}
 	}
+	for _, p := range n.Output.Postconditions {
+		for _, v := range p.RawConfig.Variables {
+			if vn := varNameForVar(v); vn != "" {
+				result = append(result, vn)
+			}
+		}
+	}
 
 	return result
 }
@@ -50,9 +57,17 @@ func (n *GraphNodeConfigOutput) EvalTree() EvalNode {
 			Nodes: []EvalNode{
 				&EvalWriteOutput{
 					Name:      n.Output.Name,
+					Type:      n.Output.DeclaredType,
 					Sensitive: n.Output.Sensitive,
 					Value:     n.Output.RawConfig,
 				},
+				&EvalOpFilter{
+					Ops: []walkOperation{walkApply},
+					Node: &EvalCheckPostconditions{
+						Name:           fmt.Sprintf("output.%s", n.Output.Name),
+						Postconditions: n.Output.Postconditions,
+					},
+				},
 			},
 		},
 	}
@@ -96,6 +96,13 @@ func (n *GraphNodeConfigResource) DependentOn() []string {
 			}
 		}
 	}
+	for _, p := range n.Resource.Postconditions {
+		for _, v := range p.RawConfig.Variables {
+			if vn := varNameForVar(v); vn != "" && vn != n.Resource.Id() {
+				result = append(result, vn)
+			}
+		}
+	}
 
 	return result
 }
@@ -117,6 +124,11 @@ func (n *GraphNodeConfigResource) VarWalk(fn func(config.InterpolatedVariable))
 			fn(v)
 		}
 	}
+	for _, p := range n.Resource.Postconditions {
+		for _, v := range p.RawConfig.Variables {
+			fn(v)
+		}
+	}
 }
 
 func (n *GraphNodeConfigResource) Name() string {
@@ -211,12 +223,38 @@ func (n *GraphNodeConfigResource) SetTargets(targets []ResourceAddress) {
 
 // GraphNodeEvalable impl.
 func (n *GraphNodeConfigResource) EvalTree() EvalNode {
+	// The count may depend on resources that haven't been planned yet
+	// when destroying, since destroy nodes are visited first. Only the
+	// instances that already exist matter then.
+	checkOps := []walkOperation{walkPlan, walkApply}
+	stateOps := []walkOperation{
+		walkInput, walkRefresh, walkPlanDestroy, walkDestroy, walkImport}
+	if n.Destroy {
+		stateOps = append(stateOps, checkOps...)
+		checkOps = nil
+	}
+
 	return &EvalSequence{
 		Nodes: []EvalNode{
-			&EvalInterpolate{Config: n.Resource.RawCount},
 			&EvalOpFilter{
-				Ops:  []walkOperation{walkValidate},
-				Node: &EvalValidateCount{Resource: n.Resource},
+				Ops: []walkOperation{walkValidate},
+				Node: &EvalSequence{
+					Nodes: []EvalNode{
+						&EvalInterpolate{Config: n.Resource.RawCount},
+						&EvalValidateCount{Resource: n.Resource},
+					},
+				},
+			},
+			&EvalOpFilter{
+				Ops:  checkOps,
+				Node: &EvalInterpolateCount{Resource: n.Resource},
+			},
+			&EvalOpFilter{
+				Ops: stateOps,
+				Node: &EvalInterpolateCount{
+					Resource:  n.Resource,
+					FromState: true,
+				},
 			},
 			&EvalCountFixZeroOneBoundary{Resource: n.Resource},
 		},
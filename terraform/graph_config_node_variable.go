@@ -171,6 +171,16 @@ func (n *GraphNodeConfigVariable) EvalTree() EvalNode {
 		return &EvalNoop{}
 	}
 
+	// Values set on instances of counted modules can use count.index,
+	// which is interpolated in the scope of the instance.
+	var resource *Resource
+	if len(n.ModulePath) > 1 {
+		_, index := config.ParseModuleInstanceName(n.ModulePath[len(n.ModulePath)-1])
+		if index != -1 {
+			resource = &Resource{CountIndex: index}
+		}
+	}
+
 	// Otherwise, interpolate the value of this variable and set it
 	// within the variables mapping.
 	var config *ResourceConfig
@@ -178,8 +188,9 @@ func (n *GraphNodeConfigVariable) EvalTree() EvalNode {
 	return &EvalSequence{
 		Nodes: []EvalNode{
 			&EvalInterpolate{
-				Config: n.Value,
-				Output: &config,
+				Config:   n.Value,
+				Resource: resource,
+				Output:   &config,
 			},
 
 			&EvalVariableBlock{
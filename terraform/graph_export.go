@@ -0,0 +1,511 @@
+package terraform
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/dag"
+	"github.com/hashicorp/terraform/dot"
+)
+
+// The formats a graph can be exported in.
+const (
+	GraphFormatDot     = "dot"
+	GraphFormatJSON    = "json"
+	GraphFormatMermaid = "mermaid"
+)
+
+// GraphExport returns a visual representation of the given Terraform graph
+// in the given format, which is one of the GraphFormat constants.
+func GraphExport(g *Graph, format string, opts *GraphDotOpts) (string, error) {
+	switch format {
+	case GraphFormatDot:
+		return GraphDot(g, opts)
+	case GraphFormatJSON:
+		return GraphJSON(g, opts)
+	case GraphFormatMermaid:
+		return GraphMermaid(g, opts)
+	default:
+		return "", fmt.Errorf(
+			"unknown graph format %q, must be %q, %q or %q",
+			format, GraphFormatDot, GraphFormatJSON, GraphFormatMermaid)
+	}
+}
+
+// graphExport is the part of a Terraform graph that is drawn: the drawable
+// nodes of each module subgraph and the edges between them. All the output
+// formats are written from it, so they always draw the same graph.
+type graphExport struct {
+	Modules []*graphExportModule
+}
+
+type graphExportModule struct {
+	Name  string
+	Depth int
+	Nodes []*graphExportNode
+	Edges []*graphExportEdge
+}
+
+type graphExportNode struct {
+	// Node is the node as returned by GraphNodeDotter. Its name is unique
+	// across the whole graph.
+	Node *dot.Node
+
+	// Address is the name of the vertex, such as "aws_instance.web" or
+	// "module.child.aws_instance.web" once modules are flattened.
+	Address string
+
+	// Module is the module the node belongs to, such as "root" or
+	// "module.child", and Path is the module path of flattened nodes.
+	Module string
+	Path   []string
+}
+
+type graphExportEdge struct {
+	From  string
+	To    string
+	Attrs map[string]string
+	Cycle bool
+}
+
+// graphExportBuild builds the graphExport for the given graph, applying the
+// module depth and reachability filters in opts.
+func graphExportBuild(g *Graph, opts *GraphDotOpts) (*graphExport, error) {
+	var ge graphExport
+	if err := graphExportSubgraph(&ge, "root", g, opts, 0); err != nil {
+		return nil, err
+	}
+
+	if opts.MaxDepth >= 0 {
+		ge.collapse(opts.MaxDepth)
+	}
+	if opts.From != "" {
+		if err := ge.reachable(opts.From, opts.Dependents); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ge, nil
+}
+
+func graphExportSubgraph(
+	ge *graphExport, modName string, g *Graph, opts *GraphDotOpts, modDepth int) error {
+	// Respect user-specified module depth
+	if opts.MaxDepth >= 0 && modDepth > opts.MaxDepth {
+		return nil
+	}
+
+	m := &graphExportModule{Name: modName, Depth: modDepth}
+	ge.Modules = append(ge.Modules, m)
+
+	origins, err := graphDotFindOrigins(g)
+	if err != nil {
+		return err
+	}
+
+	drawableVertices := make(map[dag.Vertex]struct{})
+	toDraw := make([]dag.Vertex, 0, len(g.Vertices()))
+	subgraphVertices := make(map[dag.Vertex]*Graph)
+
+	walk := func(v dag.Vertex, depth int) error {
+		// We only care about nodes that yield non-empty Dot strings.
+		if dn, ok := v.(GraphNodeDotter); !ok {
+			return nil
+		} else if dn.DotNode("fake", opts) == nil {
+			return nil
+		}
+
+		drawableVertices[v] = struct{}{}
+		toDraw = append(toDraw, v)
+
+		if sn, ok := v.(GraphNodeSubgraph); ok {
+			subgraphVertices[v] = sn.Subgraph()
+		}
+		return nil
+	}
+
+	if err := g.ReverseDepthFirstWalk(origins, walk); err != nil {
+		return err
+	}
+
+	for _, v := range toDraw {
+		dn := v.(GraphNodeDotter)
+		nodeName := graphDotNodeName(modName, v)
+
+		n := &graphExportNode{
+			Node:    dn.DotNode(nodeName, opts),
+			Address: dag.VertexName(v),
+			Module:  modName,
+		}
+		if sp, ok := v.(GraphNodeSubPath); ok {
+			n.Path = sp.Path()
+			n.Module = stageModuleName(n.Path[1:])
+		}
+		m.Nodes = append(m.Nodes, n)
+
+		// Add all the edges from this vertex to other nodes
+		targets := dag.AsVertexList(g.DownEdges(v))
+		for _, t := range targets {
+			target := t.(dag.Vertex)
+			// Only want edges where both sides are drawable.
+			if _, ok := drawableVertices[target]; !ok {
+				continue
+			}
+
+			m.Edges = append(m.Edges, &graphExportEdge{
+				From:  nodeName,
+				To:    graphDotNodeName(modName, target),
+				Attrs: map[string]string{},
+			})
+		}
+	}
+
+	// Recurse into any subgraphs
+	for _, v := range toDraw {
+		subgraph, ok := subgraphVertices[v]
+		if !ok {
+			continue
+		}
+
+		err := graphExportSubgraph(ge, dag.VertexName(v), subgraph, opts, modDepth+1)
+		if err != nil {
+			return err
+		}
+	}
+
+	if opts.DrawCycles {
+		colors := []string{"red", "green", "blue"}
+		for ci, cycle := range g.Cycles() {
+			for i, c := range cycle {
+				// Catch the last wrapping edge of the cycle
+				if i+1 >= len(cycle) {
+					i = -1
+				}
+
+				m.Edges = append(m.Edges, &graphExportEdge{
+					From: graphDotNodeName(modName, c),
+					To:   graphDotNodeName(modName, cycle[i+1]),
+					Attrs: map[string]string{
+						"color":    colors[ci%len(colors)],
+						"penwidth": "2.0",
+					},
+					Cycle: true,
+				})
+			}
+		}
+	}
+
+	return nil
+}
+
+// collapse replaces the flattened nodes of modules deeper than maxDepth
+// with a single node for their module at maxDepth+1, so that a flattened
+// graph respects the module depth just like a graph with subgraphs.
+func (ge *graphExport) collapse(maxDepth int) {
+	for _, m := range ge.Modules {
+		renamed := make(map[string]string)
+		added := make(map[string]bool)
+		nodes := make([]*graphExportNode, 0, len(m.Nodes))
+		for _, n := range m.Nodes {
+			if len(n.Path)-1 <= maxDepth {
+				nodes = append(nodes, n)
+				continue
+			}
+
+			addr := stageModuleName(n.Path[1 : maxDepth+2])
+			name := graphDotNodeName(m.Name, addr)
+			renamed[n.Node.Name] = name
+			if added[name] {
+				continue
+			}
+			added[name] = true
+
+			nodes = append(nodes, &graphExportNode{
+				Node: dot.NewNode(name, map[string]string{
+					"label": addr,
+					"shape": "component",
+				}),
+				Address: addr,
+				Module:  stageModuleName(n.Path[1 : maxDepth+1]),
+				Path:    n.Path[:maxDepth+1],
+			})
+		}
+		if len(renamed) == 0 {
+			continue
+		}
+
+		seen := make(map[string]bool)
+		edges := make([]*graphExportEdge, 0, len(m.Edges))
+		for _, e := range m.Edges {
+			if to, ok := renamed[e.From]; ok {
+				e.From = to
+			}
+			if to, ok := renamed[e.To]; ok {
+				e.To = to
+			}
+
+			key := fmt.Sprintf("%s\x00%s\x00%t", e.From, e.To, e.Cycle)
+			if e.From == e.To || seen[key] {
+				continue
+			}
+			seen[key] = true
+			edges = append(edges, e)
+		}
+
+		m.Nodes = nodes
+		m.Edges = edges
+	}
+}
+
+// reachable removes the nodes that can't be reached from the node named
+// from by following edges, or by following them backwards if dependents
+// is true. A module name such as "module.child" starts from every node in
+// that module.
+func (ge *graphExport) reachable(from string, dependents bool) error {
+	var start []string
+	next := make(map[string][]string)
+	for _, m := range ge.Modules {
+		for _, n := range m.Nodes {
+			if n.Address == from || n.Node.Name == from ||
+				(strings.HasPrefix(from, "module.") && n.Module == from) ||
+				strings.HasPrefix(n.Module, from+".") {
+				start = append(start, n.Node.Name)
+			}
+		}
+		for _, e := range m.Edges {
+			if e.Cycle {
+				continue
+			}
+			if dependents {
+				next[e.To] = append(next[e.To], e.From)
+			} else {
+				next[e.From] = append(next[e.From], e.To)
+			}
+		}
+	}
+	if len(start) == 0 {
+		return fmt.Errorf("%q is not in the graph", from)
+	}
+
+	keep := make(map[string]bool)
+	for len(start) > 0 {
+		name := start[len(start)-1]
+		start = start[:len(start)-1]
+		if keep[name] {
+			continue
+		}
+
+		keep[name] = true
+		start = append(start, next[name]...)
+	}
+
+	for _, m := range ge.Modules {
+		nodes := make([]*graphExportNode, 0, len(m.Nodes))
+		for _, n := range m.Nodes {
+			if keep[n.Node.Name] {
+				nodes = append(nodes, n)
+			}
+		}
+
+		edges := make([]*graphExportEdge, 0, len(m.Edges))
+		for _, e := range m.Edges {
+			if keep[e.From] && keep[e.To] {
+				edges = append(edges, e)
+			}
+		}
+
+		m.Nodes = nodes
+		m.Edges = edges
+	}
+
+	return nil
+}
+
+// nodes returns all the nodes of the graph sorted by name.
+func (ge *graphExport) nodes() []*graphExportNode {
+	var result []*graphExportNode
+	for _, m := range ge.Modules {
+		result = append(result, m.Nodes...)
+	}
+
+	sort.Sort(graphExportNodeSort(result))
+	return result
+}
+
+// edges returns the edges between the nodes of the graph sorted by their
+// ends. An edge that is both a normal edge and part of a cycle is returned
+// once, as part of the cycle.
+func (ge *graphExport) edges() []*graphExportEdge {
+	nodes := make(map[string]bool)
+	for _, n := range ge.nodes() {
+		nodes[n.Node.Name] = true
+	}
+
+	byKey := make(map[string]*graphExportEdge)
+	var keys []string
+	for _, m := range ge.Modules {
+		for _, e := range m.Edges {
+			if !nodes[e.From] || !nodes[e.To] {
+				continue
+			}
+
+			key := e.From + "\x00" + e.To
+			if existing, ok := byKey[key]; ok {
+				if e.Cycle {
+					existing.Cycle = true
+				}
+				continue
+			}
+			byKey[key] = &graphExportEdge{From: e.From, To: e.To, Cycle: e.Cycle}
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+	result := make([]*graphExportEdge, len(keys))
+	for i, k := range keys {
+		result[i] = byKey[k]
+	}
+	return result
+}
+
+func (n *graphExportNode) label() string {
+	if l, ok := n.Node.Attrs["label"]; ok {
+		return l
+	}
+
+	return n.Address
+}
+
+type graphExportNodeSort []*graphExportNode
+
+func (s graphExportNodeSort) Len() int           { return len(s) }
+func (s graphExportNodeSort) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s graphExportNodeSort) Less(i, j int) bool { return s[i].Node.Name < s[j].Node.Name }
+
+// graphJSON is the document written by GraphJSON.
+type graphJSON struct {
+	Nodes []graphJSONNode `json:"nodes"`
+	Edges []graphJSONEdge `json:"edges"`
+}
+
+type graphJSONNode struct {
+	ID      string `json:"id"`
+	Address string `json:"address"`
+	Label   string `json:"label"`
+	Module  string `json:"module"`
+}
+
+type graphJSONEdge struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Cycle bool   `json:"cycle,omitempty"`
+}
+
+// GraphJSON returns the given Terraform graph as a JSON document with a
+// list of nodes and a list of edges. An edge from one node to another
+// means that the first node depends on the second.
+func GraphJSON(g *Graph, opts *GraphDotOpts) (string, error) {
+	ge, err := graphExportBuild(g, opts)
+	if err != nil {
+		return "", err
+	}
+
+	doc := graphJSON{
+		Nodes: []graphJSONNode{},
+		Edges: []graphJSONEdge{},
+	}
+	for _, n := range ge.nodes() {
+		doc.Nodes = append(doc.Nodes, graphJSONNode{
+			ID:      n.Node.Name,
+			Address: n.Address,
+			Label:   n.label(),
+			Module:  n.Module,
+		})
+	}
+	for _, e := range ge.edges() {
+		doc.Edges = append(doc.Edges, graphJSONEdge{
+			From:  e.From,
+			To:    e.To,
+			Cycle: e.Cycle,
+		})
+	}
+
+	out, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(out) + "\n", nil
+}
+
+// GraphMermaid returns the given Terraform graph as a Mermaid flowchart.
+// The nodes of each module other than the root are grouped in a subgraph,
+// and edges that are part of a cycle are drawn thick.
+func GraphMermaid(g *Graph, opts *GraphDotOpts) (string, error) {
+	ge, err := graphExportBuild(g, opts)
+	if err != nil {
+		return "", err
+	}
+
+	nodes := ge.nodes()
+	ids := make(map[string]string, len(nodes))
+	var modules []string
+	byModule := make(map[string][]*graphExportNode)
+	for i, n := range nodes {
+		ids[n.Node.Name] = fmt.Sprintf("n%d", i)
+		if _, ok := byModule[n.Module]; !ok {
+			modules = append(modules, n.Module)
+		}
+		byModule[n.Module] = append(byModule[n.Module], n)
+	}
+	sort.Strings(modules)
+
+	var buf bytes.Buffer
+	buf.WriteString("graph TD\n")
+	for i, mod := range modules {
+		indent := "\t"
+		if mod != "root" {
+			buf.WriteString(fmt.Sprintf("\tsubgraph m%d [%s]\n", i, graphMermaidQuote(mod)))
+			indent = "\t\t"
+		}
+		for _, n := range byModule[mod] {
+			buf.WriteString(indent + ids[n.Node.Name] + graphMermaidShape(n) + "\n")
+		}
+		if mod != "root" {
+			buf.WriteString("\tend\n")
+		}
+	}
+	for _, e := range ge.edges() {
+		arrow := "-->"
+		if e.Cycle {
+			arrow = "==>"
+		}
+		buf.WriteString(fmt.Sprintf("\t%s %s %s\n", ids[e.From], arrow, ids[e.To]))
+	}
+
+	return buf.String(), nil
+}
+
+// graphMermaidShape returns the Mermaid shape for a node, matching the
+// shape of the node in the dot output as closely as Mermaid allows.
+func graphMermaidShape(n *graphExportNode) string {
+	label := graphMermaidQuote(n.label())
+	switch n.Node.Attrs["shape"] {
+	case "box":
+		return "[" + label + "]"
+	case "diamond":
+		return "{" + label + "}"
+	case "component":
+		return "[[" + label + "]]"
+	default:
+		return "(" + label + ")"
+	}
+}
+
+func graphMermaidQuote(s string) string {
+	return `"` + strings.Replace(s, `"`, "#quot;", -1) + `"`
+}
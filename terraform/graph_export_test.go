@@ -0,0 +1,247 @@
+package terraform
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/dot"
+)
+
+func TestGraphExport(t *testing.T) {
+	cases := map[string]struct {
+		Format string
+		Opts   GraphDotOpts
+		Expect string
+		Error  string
+	}{
+		"json": {
+			Format: GraphFormatJSON,
+			Opts:   GraphDotOpts{MaxDepth: -1},
+			Expect: `
+{
+  "nodes": [
+    {
+      "id": "[root] aws_instance.db",
+      "address": "aws_instance.db",
+      "label": "aws_instance.db",
+      "module": "root"
+    },
+    {
+      "id": "[root] aws_instance.web",
+      "address": "aws_instance.web",
+      "label": "aws_instance.web",
+      "module": "root"
+    },
+    {
+      "id": "[root] module.child.aws_instance.app",
+      "address": "module.child.aws_instance.app",
+      "label": "aws_instance.app",
+      "module": "module.child"
+    },
+    {
+      "id": "[root] module.child.module.grandchild.aws_instance.leaf",
+      "address": "module.child.module.grandchild.aws_instance.leaf",
+      "label": "aws_instance.leaf",
+      "module": "module.child.module.grandchild"
+    },
+    {
+      "id": "[root] root",
+      "address": "root",
+      "label": "root",
+      "module": "root"
+    }
+  ],
+  "edges": [
+    {
+      "from": "[root] aws_instance.db",
+      "to": "[root] root"
+    },
+    {
+      "from": "[root] aws_instance.web",
+      "to": "[root] aws_instance.db"
+    },
+    {
+      "from": "[root] module.child.aws_instance.app",
+      "to": "[root] aws_instance.db"
+    },
+    {
+      "from": "[root] module.child.module.grandchild.aws_instance.leaf",
+      "to": "[root] module.child.aws_instance.app"
+    }
+  ]
+}
+`,
+		},
+		"mermaid": {
+			Format: GraphFormatMermaid,
+			Opts:   GraphDotOpts{MaxDepth: -1},
+			Expect: `
+graph TD
+	subgraph m0 ["module.child"]
+		n2["aws_instance.app"]
+	end
+	subgraph m1 ["module.child.module.grandchild"]
+		n3["aws_instance.leaf"]
+	end
+	n0["aws_instance.db"]
+	n1["aws_instance.web"]
+	n4("root")
+	n0 --> n4
+	n1 --> n0
+	n2 --> n0
+	n3 --> n2
+`,
+		},
+		"module depth": {
+			Format: GraphFormatMermaid,
+			Opts:   GraphDotOpts{MaxDepth: 0},
+			Expect: `
+graph TD
+	n0["aws_instance.db"]
+	n1["aws_instance.web"]
+	n2[["module.child"]]
+	n3("root")
+	n0 --> n3
+	n1 --> n0
+	n2 --> n0
+`,
+		},
+		"from": {
+			Format: GraphFormatDot,
+			Opts: GraphDotOpts{
+				MaxDepth: -1,
+				From:     "module.child",
+			},
+			Expect: `
+digraph {
+	compound = "true"
+	newrank = "true"
+	subgraph "root" {
+		"[root] aws_instance.db" [label = "aws_instance.db", shape = "box"]
+		"[root] module.child.aws_instance.app" [label = "aws_instance.app", shape = "box"]
+		"[root] module.child.module.grandchild.aws_instance.leaf" [label = "aws_instance.leaf", shape = "box"]
+		"[root] root"
+		"[root] aws_instance.db" -> "[root] root"
+		"[root] module.child.aws_instance.app" -> "[root] aws_instance.db"
+		"[root] module.child.module.grandchild.aws_instance.leaf" -> "[root] module.child.aws_instance.app"
+	}
+}
+`,
+		},
+		"from dependents": {
+			Format: GraphFormatMermaid,
+			Opts: GraphDotOpts{
+				MaxDepth:   -1,
+				From:       "aws_instance.db",
+				Dependents: true,
+			},
+			Expect: `
+graph TD
+	subgraph m0 ["module.child"]
+		n2["aws_instance.app"]
+	end
+	subgraph m1 ["module.child.module.grandchild"]
+		n3["aws_instance.leaf"]
+	end
+	n0["aws_instance.db"]
+	n1["aws_instance.web"]
+	n1 --> n0
+	n2 --> n0
+	n3 --> n2
+`,
+		},
+		"from missing": {
+			Format: GraphFormatJSON,
+			Opts: GraphDotOpts{
+				MaxDepth: -1,
+				From:     "aws_instance.nope",
+			},
+			Error: `"aws_instance.nope" is not in the graph`,
+		},
+		"bad format": {
+			Format: "svg",
+			Error:  `unknown graph format "svg"`,
+		},
+	}
+
+	for tn, tc := range cases {
+		actual, err := GraphExport(testGraphExportGraph(), tc.Format, &tc.Opts)
+		if err == nil && tc.Error != "" {
+			t.Fatalf("%s: expected err: %s, got none", tn, tc.Error)
+		}
+		if err != nil && tc.Error == "" {
+			t.Fatalf("%s: unexpected err: %s", tn, err)
+		}
+		if err != nil {
+			if !strings.Contains(err.Error(), tc.Error) {
+				t.Fatalf("%s: expected err: %s\nto contain: %s", tn, err, tc.Error)
+			}
+			continue
+		}
+
+		expected := strings.TrimSpace(tc.Expect) + "\n"
+		if actual != expected {
+			t.Fatalf("%s:\n\nexpected:\n%s\n\ngot:\n%s", tn, expected, actual)
+		}
+	}
+}
+
+// testGraphExportGraph returns a flattened graph like the ones built for
+// configurations with nested modules.
+func testGraphExportGraph() *Graph {
+	var g Graph
+	g.Add(&testDrawableOrigin{"root"})
+	g.Add(&testDrawableFlat{
+		VertexName:      "aws_instance.db",
+		PathValue:       []string{"root"},
+		DependentOnMock: []string{"root"},
+	})
+	g.Add(&testDrawableFlat{
+		VertexName:      "aws_instance.web",
+		PathValue:       []string{"root"},
+		DependentOnMock: []string{"aws_instance.db"},
+	})
+	g.Add(&testDrawableFlat{
+		VertexName:      "aws_instance.app",
+		PathValue:       []string{"root", "child"},
+		DependentOnMock: []string{"aws_instance.db"},
+	})
+	g.Add(&testDrawableFlat{
+		VertexName:      "aws_instance.leaf",
+		PathValue:       []string{"root", "child", "grandchild"},
+		DependentOnMock: []string{"module.child.aws_instance.app"},
+	})
+
+	g.ConnectDependents()
+	return &g
+}
+
+// testDrawableFlat is a drawable node from a flattened module, which is
+// named with its module path like the flattened resource nodes.
+type testDrawableFlat struct {
+	VertexName      string
+	PathValue       []string
+	DependentOnMock []string
+}
+
+func (node *testDrawableFlat) Name() string {
+	if prefix := modulePrefixStr(node.PathValue); prefix != "" {
+		return prefix + "." + node.VertexName
+	}
+	return node.VertexName
+}
+func (node *testDrawableFlat) Path() []string {
+	return node.PathValue
+}
+func (node *testDrawableFlat) DotNode(n string, opts *GraphDotOpts) *dot.Node {
+	return dot.NewNode(n, map[string]string{
+		"label": node.VertexName,
+		"shape": "box",
+	})
+}
+func (node *testDrawableFlat) DependableName() []string {
+	return []string{node.Name()}
+}
+func (node *testDrawableFlat) DependentOn() []string {
+	return node.DependentOnMock
+}
@@ -80,8 +80,11 @@ func (w *ContextGraphWalker) EnterPath(path []string) EvalContext {
 		DiffLock:            &w.Context.diffLock,
 		StateValue:          w.Context.state,
 		StateLock:           &w.Context.stateLock,
+		DeadlineValue:       w.Context.deadlineAt,
+		RefreshDataValue:    w.Context.refreshData,
 		Interpolater: &Interpolater{
 			Operation:          w.Operation,
+			Meta:               w.Context.meta,
 			Module:             w.Context.module,
 			State:              w.Context.state,
 			StateLock:          &w.Context.stateLock,
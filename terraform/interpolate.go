@@ -6,6 +6,7 @@ import (
 	"os"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -26,6 +27,7 @@ const (
 // for interpolations such as `aws_instance.foo.bar`.
 type Interpolater struct {
 	Operation          walkOperation
+	Meta               *ContextMeta
 	Module             *module.Tree
 	State              *State
 	StateLock          *sync.RWMutex
@@ -84,6 +86,8 @@ func (i *Interpolater) Values(
 			err = i.valueSelfVar(scope, n, v, result)
 		case *config.SimpleVariable:
 			err = i.valueSimpleVar(scope, n, v, result)
+		case *config.TerraformVariable:
+			err = i.valueTerraformVar(scope, n, v, result)
 		case *config.UserVariable:
 			err = i.valueUserVar(scope, n, v, result)
 		default:
@@ -131,10 +135,19 @@ func (i *Interpolater) valueModuleVar(
 	v *config.ModuleVariable,
 	result map[string]ast.Variable) error {
 
+	// Splats of counted modules collect the output of every instance
+	if v.Multi && v.Index == -1 {
+		return i.valueModuleSplatVar(scope, n, v, result)
+	}
+
 	// Build the path to the child module we want
+	name := v.Name
+	if v.Multi {
+		name = config.ModuleInstanceName(v.Name, v.Index)
+	}
 	path := make([]string, len(scope.Path), len(scope.Path)+1)
 	copy(path, scope.Path)
-	path = append(path, v.Name)
+	path = append(path, name)
 
 	// Grab the lock so that if other interpolations are running or
 	// state is being modified, we'll be safe.
@@ -168,6 +181,91 @@ func (i *Interpolater) valueModuleVar(
 	return nil
 }
 
+func (i *Interpolater) valueModuleSplatVar(
+	scope *InterpolationScope,
+	n string,
+	v *config.ModuleVariable,
+	result map[string]ast.Variable) error {
+	count, err := i.moduleCount(scope, v.Name)
+	if err != nil {
+		return err
+	}
+	if count < 0 {
+		result[n] = unknownVariable()
+		return nil
+	}
+
+	i.StateLock.RLock()
+	defer i.StateLock.RUnlock()
+
+	// Collect the output of every instance. If any of them isn't known
+	// yet, then the whole list isn't either.
+	values := make([]interface{}, 0, count)
+	for idx := 0; idx < count; idx++ {
+		path := make([]string, len(scope.Path), len(scope.Path)+1)
+		copy(path, scope.Path)
+		path = append(path, config.ModuleInstanceName(v.Name, idx))
+
+		mod := i.State.ModuleByPath(path)
+		if mod == nil {
+			result[n] = unknownVariable()
+			return nil
+		}
+
+		outputState, ok := mod.Outputs[v.Field]
+		if !ok || outputState.Value == config.UnknownVariableValue {
+			result[n] = unknownVariable()
+			return nil
+		}
+
+		values = append(values, outputState.Value)
+	}
+
+	variable, err := hil.InterfaceToVariable(values)
+	if err != nil {
+		return err
+	}
+	result[n] = variable
+
+	return nil
+}
+
+// moduleCount returns the count of the module block with the given name
+// within the module of the scope, or -1 if it isn't known yet.
+func (i *Interpolater) moduleCount(scope *InterpolationScope, name string) (int, error) {
+	var mc *config.Module
+	if t := i.Module.Child(scope.Path[1:]); t != nil {
+		mc = moduleConfig(t.Config(), name)
+	}
+	if mc == nil {
+		return 0, fmt.Errorf("module.%s: module not found", name)
+	}
+	if !mc.Counted() {
+		return 0, fmt.Errorf("module.%s: module doesn't have count set", name)
+	}
+
+	rc := mc.RawCount.Copy()
+	vs, err := i.Values(scope, rc.Variables)
+	if err != nil {
+		return 0, err
+	}
+	if err := rc.Interpolate(vs); err != nil {
+		return 0, err
+	}
+
+	raw := rc.Value()
+	if raw == config.UnknownVariableValue {
+		return -1, nil
+	}
+
+	count, err := strconv.ParseInt(raw.(string), 0, 0)
+	if err != nil {
+		return 0, fmt.Errorf("module.%s: count must be an integer: %s", name, err)
+	}
+
+	return int(count), nil
+}
+
 func (i *Interpolater) valuePathVar(
 	scope *InterpolationScope,
 	n string,
@@ -292,6 +390,25 @@ func (i *Interpolater) valueSimpleVar(
 	return nil
 }
 
+func (i *Interpolater) valueTerraformVar(
+	scope *InterpolationScope,
+	n string,
+	v *config.TerraformVariable,
+	result map[string]ast.Variable) error {
+	if v.Field != "env" {
+		return fmt.Errorf(
+			"%s: only supported key for 'terraform.X' interpolations is 'env'", n)
+	}
+
+	if i.Meta == nil {
+		return fmt.Errorf(
+			"%s: internal error: nil Meta. Please report a bug.", n)
+	}
+
+	result[n] = ast.Variable{Type: ast.TypeString, Value: i.Meta.Env}
+	return nil
+}
+
 func (i *Interpolater) valueUserVar(
 	scope *InterpolationScope,
 	n string,
@@ -528,11 +645,11 @@ func (i *Interpolater) computeResourceMultiVariable(
 			continue
 		}
 
+		// Unknown attributes are kept as unknown elements rather than
+		// making the whole list unknown, so that the length of the list
+		// is still known. RawConfig treats the result as unknown for any
+		// use other than the length function.
 		if singleAttr, ok := r.Primary.Attributes[v.Field]; ok {
-			if singleAttr == config.UnknownVariableValue {
-				return &unknownVariable, nil
-			}
-
 			values = append(values, singleAttr)
 			continue
 		}
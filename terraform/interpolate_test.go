@@ -109,6 +109,36 @@ func TestInterpolater_pathCwd(t *testing.T) {
 	})
 }
 
+func TestInterpolater_terraformEnv(t *testing.T) {
+	i := &Interpolater{
+		Meta: &ContextMeta{Env: "foo"},
+	}
+
+	scope := &InterpolationScope{}
+
+	testInterpolate(t, i, scope, "terraform.env", ast.Variable{
+		Value: "foo",
+		Type:  ast.TypeString,
+	})
+}
+
+func TestInterpolater_terraformEnvNoMeta(t *testing.T) {
+	i := &Interpolater{}
+	scope := &InterpolationScope{}
+
+	v, err := config.NewInterpolatedVariable("terraform.env")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	_, err = i.Values(scope, map[string]config.InterpolatedVariable{
+		"foo": v,
+	})
+	if err == nil {
+		t.Fatal("should error")
+	}
+}
+
 func TestInterpolater_pathModule(t *testing.T) {
 	mod := testModule(t, "interpolate-path-module")
 	i := &Interpolater{
@@ -352,9 +382,15 @@ func TestInterpolater_resourceVariableMulti(t *testing.T) {
 		Path: rootModulePath,
 	}
 
+	// Unknown attributes are unknown elements, so the length is known
 	testInterpolate(t, i, scope, "aws_instance.web.*.foo", ast.Variable{
-		Value: config.UnknownVariableValue,
-		Type:  ast.TypeString,
+		Type: ast.TypeList,
+		Value: []ast.Variable{
+			ast.Variable{
+				Value: config.UnknownVariableValue,
+				Type:  ast.TypeString,
+			},
+		},
 	})
 }
 
@@ -0,0 +1,286 @@
+package terraform
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/dag"
+)
+
+// The ways a plan can be split into stages with Context.Stages.
+const (
+	// StageByDepth puts resources with the same dependency depth in the
+	// same stage: the first stage holds the changed resources that depend
+	// on no other changed resource, the second those that depend only on
+	// the first, and so on.
+	StageByDepth = "depth"
+
+	// StageByModule puts the changed resources of each module in their own
+	// stage, ordered so that a module's stage comes after the stages of the
+	// modules it depends on.
+	StageByModule = "module"
+)
+
+// PlanStage is one part of a plan that is applied on its own with
+// Context.ApplyStage. Applying every stage in order applies the whole plan.
+type PlanStage struct {
+	// Name describes the stage, such as "depth 1" or "module.network".
+	Name string
+
+	// Resources are the addresses of the resources changed by this stage,
+	// sorted.
+	Resources []string
+
+	// Diff is the part of the plan's diff for Resources.
+	Diff *Diff
+}
+
+// Stages splits the diff of this context into stages using the given mode,
+// which is StageByDepth or StageByModule. Resources that depend on each
+// other in both directions, such as when a resource and the resource that
+// depends on it are both replaced, are always kept in the same stage.
+func (c *Context) Stages(by string) ([]*PlanStage, error) {
+	var keyFn func(*ResourceAddress) string
+	switch by {
+	case StageByDepth:
+		keyFn = func(addr *ResourceAddress) string { return addr.String() }
+	case StageByModule:
+		keyFn = func(addr *ResourceAddress) string { return stageModuleName(addr.Path) }
+	default:
+		return nil, fmt.Errorf(
+			"unknown stage mode %q, must be %q or %q", by, StageByDepth, StageByModule)
+	}
+
+	c.diffLock.RLock()
+	diff := c.diff
+	c.diffLock.RUnlock()
+	if diff == nil || diff.Empty() {
+		return nil, nil
+	}
+
+	// Find the resources that are changed by the diff, keyed by their
+	// address without an index so they can be matched to the graph.
+	changed := make(map[string]*ResourceAddress)
+	for _, md := range diff.Modules {
+		for k, id := range md.Resources {
+			if id.Empty() {
+				continue
+			}
+
+			addr, err := stageResourceAddress(md.Path, k)
+			if err != nil {
+				return nil, err
+			}
+			changed[addr.String()] = addr
+		}
+	}
+
+	// The dependencies come from the graph Apply will walk, which also
+	// includes the ordering of any destroys.
+	graph, err := c.Graph(&ContextGraphOpts{Validate: true})
+	if err != nil {
+		return nil, err
+	}
+
+	var keys dag.Graph
+	for _, addr := range changed {
+		keys.Add(keyFn(addr))
+	}
+	for _, v := range graph.Vertices() {
+		an, ok := v.(GraphNodeAddressable)
+		if !ok {
+			continue
+		}
+		addr := an.ResourceAddress()
+		if _, ok := changed[addr.String()]; !ok {
+			continue
+		}
+
+		deps, err := graph.Ancestors(v)
+		if err != nil {
+			return nil, err
+		}
+		for _, dep := range deps.List() {
+			dn, ok := dep.(GraphNodeAddressable)
+			if !ok {
+				continue
+			}
+			depAddr := dn.ResourceAddress()
+			if _, ok := changed[depAddr.String()]; !ok {
+				continue
+			}
+
+			if from, to := keyFn(addr), keyFn(depAddr); from != to {
+				keys.Connect(dag.BasicEdge(from, to))
+			}
+		}
+	}
+
+	// Merge the keys that depend on each other and find the depth of each
+	// group, which is one more than the deepest group it depends on.
+	groups := dag.StronglyConnected(&keys)
+	groupOf := make(map[dag.Vertex]int)
+	for i, group := range groups {
+		for _, v := range group {
+			groupOf[v] = i
+		}
+	}
+	depths := make(map[int]int)
+	var depth func(int) int
+	depth = func(i int) int {
+		if d, ok := depths[i]; ok {
+			return d
+		}
+		d := 0
+		for _, v := range groups[i] {
+			for _, dep := range keys.DownEdges(v).List() {
+				if j := groupOf[dep]; j != i {
+					if dd := depth(j) + 1; dd > d {
+						d = dd
+					}
+				}
+			}
+		}
+		depths[i] = d
+		return d
+	}
+
+	// Build the stages from the groups.
+	stageKeys := make(map[string]string)
+	stageDepths := make(map[string]int)
+	for i, group := range groups {
+		var name string
+		if by == StageByDepth {
+			name = fmt.Sprintf("depth %d", depth(i))
+		} else {
+			names := make([]string, len(group))
+			for j, v := range group {
+				names[j] = v.(string)
+			}
+			sort.Strings(names)
+			name = strings.Join(names, ", ")
+		}
+
+		for _, v := range group {
+			stageKeys[v.(string)] = name
+		}
+		stageDepths[name] = depth(i)
+	}
+
+	stagesByName := make(map[string]*PlanStage)
+	for _, md := range diff.Modules {
+		for k, id := range md.Resources {
+			if id.Empty() {
+				continue
+			}
+
+			addr, err := stageResourceAddress(md.Path, k)
+			if err != nil {
+				return nil, err
+			}
+			name := stageKeys[keyFn(addr)]
+			stage, ok := stagesByName[name]
+			if !ok {
+				stage = &PlanStage{Name: name, Diff: new(Diff)}
+				stage.Diff.init()
+				stagesByName[name] = stage
+			}
+
+			smd := stage.Diff.ModuleByPath(md.Path)
+			if smd == nil {
+				smd = stage.Diff.AddModule(md.Path)
+				smd.Destroy = md.Destroy
+			}
+			smd.Resources[k] = id
+
+			stage.Resources = append(stage.Resources, addr.String())
+		}
+	}
+
+	stages := make([]*PlanStage, 0, len(stagesByName))
+	for _, stage := range stagesByName {
+		stage.Resources = stageUniqueSorted(stage.Resources)
+		stages = append(stages, stage)
+	}
+	sort.Sort(planStageSort{stages, stageDepths})
+
+	return stages, nil
+}
+
+// ApplyStage applies only the changes in the given stage. The resulting
+// state becomes the state of this context, so the next stage can be
+// applied on top of it.
+func (c *Context) ApplyStage(stage *PlanStage) (*State, error) {
+	c.diffLock.Lock()
+	diff := c.diff
+	c.diff = stage.Diff
+	c.diffLock.Unlock()
+
+	defer func() {
+		c.diffLock.Lock()
+		c.diff = diff
+		c.diffLock.Unlock()
+	}()
+
+	return c.Apply()
+}
+
+// stageResourceAddress returns the address of the resource with the given
+// key in the diff of the module at path, without its index.
+func stageResourceAddress(path []string, k string) (*ResourceAddress, error) {
+	rsk, err := ParseResourceStateKey(k)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResourceAddress{
+		Path:         path[1:],
+		Index:        -1,
+		InstanceType: TypePrimary,
+		Name:         rsk.Name,
+		Type:         rsk.Type,
+		Mode:         rsk.Mode,
+	}, nil
+}
+
+// stageModuleName returns the name of the module with the given path
+// relative to the root, such as "module.network".
+func stageModuleName(path []string) string {
+	if len(path) == 0 {
+		return "root"
+	}
+
+	parts := make([]string, 0, len(path)*2)
+	for _, p := range path {
+		parts = append(parts, "module", p)
+	}
+	return strings.Join(parts, ".")
+}
+
+func stageUniqueSorted(s []string) []string {
+	sort.Strings(s)
+	result := s[:0]
+	for i, v := range s {
+		if i == 0 || v != s[i-1] {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// planStageSort sorts stages by depth and then by name.
+type planStageSort struct {
+	Stages []*PlanStage
+	Depths map[string]int
+}
+
+func (s planStageSort) Len() int      { return len(s.Stages) }
+func (s planStageSort) Swap(i, j int) { s.Stages[i], s.Stages[j] = s.Stages[j], s.Stages[i] }
+func (s planStageSort) Less(i, j int) bool {
+	a, b := s.Stages[i], s.Stages[j]
+	if s.Depths[a.Name] != s.Depths[b.Name] {
+		return s.Depths[a.Name] < s.Depths[b.Name]
+	}
+	return a.Name < b.Name
+}
@@ -0,0 +1,201 @@
+package terraform
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestContextStages_depth(t *testing.T) {
+	ctx := testContextStages(t)
+
+	stages, err := ctx.Stages(StageByDepth)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []struct {
+		Name      string
+		Resources []string
+	}{
+		{"depth 0", []string{"aws_instance.lb", "aws_instance.vpc"}},
+		{"depth 1", []string{"aws_instance.db"}},
+		{"depth 2", []string{"aws_instance.web", "module.child.aws_instance.app"}},
+	}
+	if len(stages) != len(expected) {
+		t.Fatalf("expected %d stages, got %d: %#v", len(expected), len(stages), stages)
+	}
+	for i, e := range expected {
+		if stages[i].Name != e.Name || !reflect.DeepEqual(stages[i].Resources, e.Resources) {
+			t.Fatalf("stage %d: expected %s %v, got %s %v",
+				i, e.Name, e.Resources, stages[i].Name, stages[i].Resources)
+		}
+	}
+}
+
+func TestContextStages_module(t *testing.T) {
+	ctx := testContextStages(t)
+
+	stages, err := ctx.Stages(StageByModule)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if len(stages) != 2 {
+		t.Fatalf("expected 2 stages, got %d: %#v", len(stages), stages)
+	}
+	if stages[0].Name != "root" || len(stages[0].Resources) != 4 {
+		t.Fatalf("bad: %s %v", stages[0].Name, stages[0].Resources)
+	}
+	if stages[1].Name != "module.child" ||
+		!reflect.DeepEqual(stages[1].Resources, []string{"module.child.aws_instance.app"}) {
+		t.Fatalf("bad: %s %v", stages[1].Name, stages[1].Resources)
+	}
+}
+
+func TestContextStages_badMode(t *testing.T) {
+	ctx := testContextStages(t)
+
+	if _, err := ctx.Stages("layer"); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestContextApplyStage(t *testing.T) {
+	ctx := testContextStages(t)
+
+	stages, err := ctx.Stages(StageByDepth)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	state, err := ctx.ApplyStage(stages[0])
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	checkStateString(t, state, `
+aws_instance.lb:
+  ID = foo
+  num = 2
+  type = aws_instance
+aws_instance.vpc:
+  ID = foo
+  num = 1
+  type = aws_instance
+	`)
+
+	for _, stage := range stages[1:] {
+		state, err = ctx.ApplyStage(stage)
+		if err != nil {
+			t.Fatalf("%s: %s", stage.Name, err)
+		}
+	}
+	checkStateString(t, state, `
+aws_instance.db:
+  ID = foo
+  foo = foo
+  type = aws_instance
+
+  Dependencies:
+    aws_instance.vpc
+aws_instance.lb:
+  ID = foo
+  num = 2
+  type = aws_instance
+aws_instance.vpc:
+  ID = foo
+  num = 1
+  type = aws_instance
+aws_instance.web:
+  ID = foo
+  foo = foo
+  type = aws_instance
+
+  Dependencies:
+    aws_instance.db
+
+module.child:
+  aws_instance.app:
+    ID = foo
+    foo = foo
+    type = aws_instance
+	`)
+}
+
+func TestContextStages_replace(t *testing.T) {
+	m := testModule(t, "apply-stages-replace")
+	p := testProvider("aws")
+	p.ApplyFn = testApplyFn
+	p.DiffFn = testDiffFn
+	state := &State{
+		Modules: []*ModuleState{
+			&ModuleState{
+				Path: rootModulePath,
+				Resources: map[string]*ResourceState{
+					"aws_instance.a": &ResourceState{
+						Type: "aws_instance",
+						Primary: &InstanceState{
+							ID:         "a",
+							Attributes: map[string]string{"require_new": "no"},
+						},
+					},
+					"aws_instance.b": &ResourceState{
+						Type:         "aws_instance",
+						Dependencies: []string{"aws_instance.a"},
+						Primary: &InstanceState{
+							ID: "b",
+							Attributes: map[string]string{
+								"require_new": "no",
+								"foo":         "a",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	ctx := testContext2(t, &ContextOpts{
+		Module: m,
+		Providers: map[string]ResourceProviderFactory{
+			"aws": testProviderFuncFixed(p),
+		},
+		State: state,
+	})
+
+	if _, err := ctx.Plan(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	stages, err := ctx.Stages(StageByDepth)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Replacing both destroys b before a but creates a before b, so
+	// they can't be split.
+	if len(stages) != 1 {
+		t.Fatalf("expected 1 stage, got %d: %#v", len(stages), stages)
+	}
+	expected := []string{"aws_instance.a", "aws_instance.b", "aws_instance.c"}
+	if !reflect.DeepEqual(stages[0].Resources, expected) {
+		t.Fatalf("bad: %v", stages[0].Resources)
+	}
+}
+
+func testContextStages(t *testing.T) *Context {
+	m := testModule(t, "apply-stages")
+	p := testProvider("aws")
+	p.ApplyFn = testApplyFn
+	p.DiffFn = testDiffFn
+	ctx := testContext2(t, &ContextOpts{
+		Module: m,
+		Providers: map[string]ResourceProviderFactory{
+			"aws": testProviderFuncFixed(p),
+		},
+	})
+
+	if _, err := ctx.Plan(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	return ctx
+}
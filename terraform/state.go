@@ -178,20 +178,37 @@ func (s *State) ModuleOrphans(path []string, c *config.Config) [][]string {
 	s.Lock()
 	defer s.Unlock()
 
-	return s.moduleOrphans(path, c)
+	var names []string
+	if c != nil {
+		names = make([]string, len(c.Modules))
+		for i, m := range c.Modules {
+			names[i] = m.Name
+		}
+	}
+
+	return s.moduleOrphans(path, names)
 
 }
 
-func (s *State) moduleOrphans(path []string, c *config.Config) [][]string {
+// ModuleInstanceOrphans is the same as ModuleOrphans, but takes the names
+// of the child modules in the configuration rather than the configuration
+// itself. The names of instances of counted modules depend on their count,
+// so this is used to find orphaned instances.
+func (s *State) ModuleInstanceOrphans(path []string, names []string) [][]string {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.moduleOrphans(path, names)
+}
+
+func (s *State) moduleOrphans(path []string, names []string) [][]string {
 	// direct keeps track of what direct children we have both in our config
 	// and in our state. childrenKeys keeps track of what isn't an orphan.
 	direct := make(map[string]struct{})
 	childrenKeys := make(map[string]struct{})
-	if c != nil {
-		for _, m := range c.Modules {
-			childrenKeys[m.Name] = struct{}{}
-			direct[m.Name] = struct{}{}
-		}
+	for _, n := range names {
+		childrenKeys[n] = struct{}{}
+		direct[n] = struct{}{}
 	}
 
 	// Go over the direct children and find any that aren't in our keys.
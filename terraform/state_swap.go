@@ -0,0 +1,92 @@
+package terraform
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// SwapModules swaps the state of the two modules at the given addresses,
+// such as "module.blue" and "module.green", along with the state of all
+// their child modules. Resources keep their IDs and attributes, they're only
+// bound to the other module.
+//
+// This is used for blue/green deployments: two modules with the same
+// configuration but different inputs can trade places without destroying
+// and recreating their resources.
+//
+// Both modules must exist in the state, and neither may be a child of the
+// other. The state is left untouched if an error is returned.
+func (s *State) SwapModules(aRaw, bRaw string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	a, err := parseSwapModuleAddress(aRaw)
+	if err != nil {
+		return err
+	}
+	b, err := parseSwapModuleAddress(bRaw)
+	if err != nil {
+		return err
+	}
+
+	if reflect.DeepEqual(a, b) {
+		return fmt.Errorf("can't swap %s with itself", aRaw)
+	}
+	if hasPathPrefix(a, b) || hasPathPrefix(b, a) {
+		return fmt.Errorf(
+			"can't swap %s with %s: one module contains the other", aRaw, bRaw)
+	}
+	if s.moduleByPath(a) == nil {
+		return fmt.Errorf("module not found in the state: %s", aRaw)
+	}
+	if s.moduleByPath(b) == nil {
+		return fmt.Errorf("module not found in the state: %s", bRaw)
+	}
+
+	for _, mod := range s.Modules {
+		switch {
+		case hasPathPrefix(mod.Path, a):
+			mod.Path = replacePathPrefix(mod.Path, a, b)
+		case hasPathPrefix(mod.Path, b):
+			mod.Path = replacePathPrefix(mod.Path, b, a)
+		}
+	}
+
+	s.sort()
+	return nil
+}
+
+// parseSwapModuleAddress parses the address of a module and returns the
+// path of its module state.
+func parseSwapModuleAddress(raw string) ([]string, error) {
+	addr, err := ParseResourceAddress(raw)
+	if err != nil {
+		return nil, err
+	}
+	if detectAddrAddLoc(addr) != stateAddModule || len(addr.Path) == 0 {
+		return nil, fmt.Errorf("%s is not the address of a module", raw)
+	}
+
+	return append([]string{"root"}, addr.Path...), nil
+}
+
+// hasPathPrefix returns true if path is prefix or one of its children.
+func hasPathPrefix(path, prefix []string) bool {
+	if len(path) < len(prefix) {
+		return false
+	}
+	for i, p := range prefix {
+		if path[i] != p {
+			return false
+		}
+	}
+
+	return true
+}
+
+// replacePathPrefix returns path with the prefix old replaced by new.
+func replacePathPrefix(path, old, new []string) []string {
+	result := make([]string, 0, len(new)+len(path)-len(old))
+	result = append(result, new...)
+	return append(result, path[len(old):]...)
+}
@@ -0,0 +1,162 @@
+package terraform
+
+import (
+	"testing"
+)
+
+func TestStateSwapModules(t *testing.T) {
+	cases := map[string]struct {
+		Err      bool
+		A, B     string
+		One, Two *State
+	}{
+		"siblings": {
+			false,
+			"module.blue",
+			"module.green",
+			&State{
+				Modules: []*ModuleState{
+					testStateSwapModule([]string{"root", "blue"}, "blue"),
+					testStateSwapModule([]string{"root", "green"}, "green"),
+				},
+			},
+			&State{
+				Modules: []*ModuleState{
+					testStateSwapModule([]string{"root", "blue"}, "green"),
+					testStateSwapModule([]string{"root", "green"}, "blue"),
+				},
+			},
+		},
+
+		"children": {
+			false,
+			"module.blue",
+			"module.green",
+			&State{
+				Modules: []*ModuleState{
+					testStateSwapModule([]string{"root", "blue"}, "blue"),
+					testStateSwapModule([]string{"root", "blue", "db"}, "blue-db"),
+					testStateSwapModule([]string{"root", "green"}, "green"),
+					testStateSwapModule([]string{"root", "other"}, "other"),
+				},
+			},
+			&State{
+				Modules: []*ModuleState{
+					testStateSwapModule([]string{"root", "blue"}, "green"),
+					testStateSwapModule([]string{"root", "green"}, "blue"),
+					testStateSwapModule([]string{"root", "green", "db"}, "blue-db"),
+					testStateSwapModule([]string{"root", "other"}, "other"),
+				},
+			},
+		},
+
+		"nested": {
+			false,
+			"module.app.module.blue",
+			"module.green",
+			&State{
+				Modules: []*ModuleState{
+					testStateSwapModule([]string{"root", "app"}, "app"),
+					testStateSwapModule([]string{"root", "app", "blue"}, "blue"),
+					testStateSwapModule([]string{"root", "green"}, "green"),
+				},
+			},
+			&State{
+				Modules: []*ModuleState{
+					testStateSwapModule([]string{"root", "app"}, "app"),
+					testStateSwapModule([]string{"root", "app", "blue"}, "green"),
+					testStateSwapModule([]string{"root", "green"}, "blue"),
+				},
+			},
+		},
+
+		"missing module": {
+			true,
+			"module.blue",
+			"module.green",
+			&State{
+				Modules: []*ModuleState{
+					testStateSwapModule([]string{"root", "blue"}, "blue"),
+				},
+			},
+			nil,
+		},
+
+		"same module": {
+			true,
+			"module.blue",
+			"module.blue",
+			&State{
+				Modules: []*ModuleState{
+					testStateSwapModule([]string{"root", "blue"}, "blue"),
+				},
+			},
+			nil,
+		},
+
+		"child module": {
+			true,
+			"module.blue",
+			"module.blue.module.db",
+			&State{
+				Modules: []*ModuleState{
+					testStateSwapModule([]string{"root", "blue"}, "blue"),
+					testStateSwapModule([]string{"root", "blue", "db"}, "blue-db"),
+				},
+			},
+			nil,
+		},
+
+		"resource address": {
+			true,
+			"module.blue",
+			"aws_instance.green",
+			&State{
+				Modules: []*ModuleState{
+					testStateSwapModule([]string{"root", "blue"}, "blue"),
+				},
+			},
+			nil,
+		},
+	}
+
+	for k, tc := range cases {
+		tc.One.init()
+		if tc.Two != nil {
+			tc.Two.init()
+		}
+
+		before := tc.One.DeepCopy()
+		err := tc.One.SwapModules(tc.A, tc.B)
+		if (err != nil) != tc.Err {
+			t.Fatalf("bad: %s\n\n%s", k, err)
+		}
+		if tc.Err {
+			// The state must be untouched on errors
+			if !tc.One.Equal(before) {
+				t.Fatalf("Bad: %s: state modified\n\n%s", k, tc.One.String())
+			}
+			continue
+		}
+
+		if !tc.One.Equal(tc.Two) {
+			t.Fatalf("Bad: %s\n\n%s\n\n%s", k, tc.One.String(), tc.Two.String())
+		}
+	}
+}
+
+// testStateSwapModule returns a module state at path with a single
+// resource whose ID is id.
+func testStateSwapModule(path []string, id string) *ModuleState {
+	return &ModuleState{
+		Path: path,
+		Resources: map[string]*ResourceState{
+			"test_instance.foo": &ResourceState{
+				Type: "test_instance",
+				Primary: &InstanceState{
+					ID: id,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,141 @@
+package terraform
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// TargetSelector selects resources from the state by address pattern and
+// attribute values. It is the parsed form of a -target-selector flag such
+// as:
+//
+//     aws_instance.*[tags.Team="payments"]
+//
+// The pattern is matched against resource addresses without their index,
+// using the same syntax as path.Match. Every attribute condition must match
+// the flattened attributes of the primary instance for it to be selected.
+type TargetSelector struct {
+	Pattern    string
+	Attributes map[string]string
+}
+
+var targetSelectorAttrRegexp = regexp.MustCompile(
+	`^\[\s*([^=\[\]\s]+)\s*=\s*("(?:[^"\\]|\\.)*"|[^\]]*?)\s*\]`)
+
+// ParseTargetSelector parses a target selector string.
+func ParseTargetSelector(s string) (*TargetSelector, error) {
+	pattern := s
+	rest := ""
+	if idx := strings.Index(s, "["); idx >= 0 {
+		pattern = s[:idx]
+		rest = s[idx:]
+	}
+
+	pattern = strings.TrimSpace(pattern)
+	if pattern == "" {
+		return nil, fmt.Errorf("target selector %q: missing address pattern", s)
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, fmt.Errorf("target selector %q: invalid address pattern: %s", s, err)
+	}
+
+	selector := &TargetSelector{
+		Pattern:    pattern,
+		Attributes: make(map[string]string),
+	}
+
+	for rest != "" {
+		match := targetSelectorAttrRegexp.FindStringSubmatch(rest)
+		if match == nil {
+			return nil, fmt.Errorf(
+				"target selector %q: invalid attribute condition %q, expected [key=\"value\"]", s, rest)
+		}
+
+		value := match[2]
+		if strings.HasPrefix(value, `"`) {
+			var err error
+			value, err = strconv.Unquote(value)
+			if err != nil {
+				return nil, fmt.Errorf("target selector %q: %s", s, err)
+			}
+		}
+
+		selector.Attributes[match[1]] = value
+		rest = strings.TrimSpace(rest[len(match[0]):])
+	}
+
+	return selector, nil
+}
+
+// Select returns the addresses of all resource instances in the state that
+// match the selector, sorted. The addresses can be used as targets.
+func (s *TargetSelector) Select(state *State) ([]string, error) {
+	if state == nil {
+		return nil, nil
+	}
+
+	var result []string
+	for _, m := range state.Modules {
+		for k, r := range m.Resources {
+			if r.Primary == nil {
+				continue
+			}
+
+			key, err := ParseResourceStateKey(k)
+			if err != nil {
+				return nil, err
+			}
+
+			addr := &ResourceAddress{
+				Path:  m.Path[1:],
+				Index: -1,
+				Name:  key.Name,
+				Type:  key.Type,
+				Mode:  key.Mode,
+			}
+
+			matched, err := path.Match(s.Pattern, addr.String())
+			if err != nil {
+				return nil, err
+			}
+			if !matched || !s.matchAttributes(r.Primary.Attributes) {
+				continue
+			}
+
+			addr.Index = key.Index
+			result = append(result, addr.String())
+		}
+	}
+
+	sort.Strings(result)
+	return result, nil
+}
+
+func (s *TargetSelector) matchAttributes(attrs map[string]string) bool {
+	for k, v := range s.Attributes {
+		if actual, ok := attrs[k]; !ok || actual != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *TargetSelector) String() string {
+	keys := make([]string, 0, len(s.Attributes))
+	for k := range s.Attributes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	result := s.Pattern
+	for _, k := range keys {
+		result += fmt.Sprintf("[%s=%q]", k, s.Attributes[k])
+	}
+
+	return result
+}
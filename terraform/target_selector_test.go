@@ -0,0 +1,185 @@
+package terraform
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseTargetSelector(t *testing.T) {
+	cases := map[string]struct {
+		Input    string
+		Expected *TargetSelector
+		Err      bool
+	}{
+		"pattern only": {
+			"aws_instance.*",
+			&TargetSelector{
+				Pattern:    "aws_instance.*",
+				Attributes: map[string]string{},
+			},
+			false,
+		},
+		"quoted attribute": {
+			`aws_instance.*[tags.Team="payments"]`,
+			&TargetSelector{
+				Pattern:    "aws_instance.*",
+				Attributes: map[string]string{"tags.Team": "payments"},
+			},
+			false,
+		},
+		"unquoted attribute": {
+			`module.*.aws_instance.web[tags.Env=prod]`,
+			&TargetSelector{
+				Pattern:    "module.*.aws_instance.web",
+				Attributes: map[string]string{"tags.Env": "prod"},
+			},
+			false,
+		},
+		"multiple attributes": {
+			`aws_instance.*[tags.Team="payments"] [instance_type = "t2.micro"]`,
+			&TargetSelector{
+				Pattern: "aws_instance.*",
+				Attributes: map[string]string{
+					"tags.Team":     "payments",
+					"instance_type": "t2.micro",
+				},
+			},
+			false,
+		},
+		"quoted value with brackets": {
+			`aws_instance.*[tags.Name="web [blue]"]`,
+			&TargetSelector{
+				Pattern:    "aws_instance.*",
+				Attributes: map[string]string{"tags.Name": "web [blue]"},
+			},
+			false,
+		},
+		"missing pattern": {
+			`[tags.Team="payments"]`,
+			nil,
+			true,
+		},
+		"missing value": {
+			`aws_instance.*[tags.Team]`,
+			nil,
+			true,
+		},
+		"index": {
+			`aws_instance.web[0]`,
+			nil,
+			true,
+		},
+	}
+
+	for tn, tc := range cases {
+		actual, err := ParseTargetSelector(tc.Input)
+		if (err != nil) != tc.Err {
+			t.Fatalf("%s: unexpected err: %#v", tn, err)
+		}
+		if err != nil {
+			continue
+		}
+
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%s:\nexpected: %#v\n  actual: %#v", tn, tc.Expected, actual)
+		}
+	}
+}
+
+func TestTargetSelectorSelect(t *testing.T) {
+	state := &State{
+		Modules: []*ModuleState{
+			&ModuleState{
+				Path: rootModulePath,
+				Resources: map[string]*ResourceState{
+					"aws_instance.web.0": &ResourceState{
+						Type: "aws_instance",
+						Primary: &InstanceState{
+							ID: "i-abc123",
+							Attributes: map[string]string{
+								"tags.Team": "payments",
+							},
+						},
+					},
+					"aws_instance.web.1": &ResourceState{
+						Type: "aws_instance",
+						Primary: &InstanceState{
+							ID: "i-abc456",
+							Attributes: map[string]string{
+								"tags.Team": "search",
+							},
+						},
+					},
+					"aws_instance.db": &ResourceState{
+						Type: "aws_instance",
+						Primary: &InstanceState{
+							ID: "i-def789",
+							Attributes: map[string]string{
+								"tags.Team": "payments",
+							},
+						},
+					},
+					"aws_s3_bucket.logs": &ResourceState{
+						Type: "aws_s3_bucket",
+						Primary: &InstanceState{
+							ID: "logs",
+							Attributes: map[string]string{
+								"tags.Team": "payments",
+							},
+						},
+					},
+				},
+			},
+			&ModuleState{
+				Path: []string{"root", "child"},
+				Resources: map[string]*ResourceState{
+					"aws_instance.app": &ResourceState{
+						Type: "aws_instance",
+						Primary: &InstanceState{
+							ID: "i-ghi012",
+							Attributes: map[string]string{
+								"tags.Team": "payments",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	cases := map[string][]string{
+		`aws_instance.*[tags.Team="payments"]`: []string{
+			"aws_instance.db",
+			"aws_instance.web[0]",
+		},
+		`*[tags.Team="payments"]`: []string{
+			"aws_instance.db",
+			"aws_instance.web[0]",
+			"aws_s3_bucket.logs",
+			"module.child.aws_instance.app",
+		},
+		`module.child.*`: []string{
+			"module.child.aws_instance.app",
+		},
+		`aws_instance.web[tags.Team="search"]`: []string{
+			"aws_instance.web[1]",
+		},
+		`aws_instance.*[tags.Team="billing"]`: nil,
+	}
+
+	for input, expected := range cases {
+		selector, err := ParseTargetSelector(input)
+		if err != nil {
+			t.Fatalf("%s: err: %s", input, err)
+		}
+
+		actual, err := selector.Select(state)
+		if err != nil {
+			t.Fatalf("%s: err: %s", input, err)
+		}
+
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("%s:\nexpected: %#v\n  actual: %#v", input, expected, actual)
+		}
+	}
+}
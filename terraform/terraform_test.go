@@ -424,6 +424,55 @@ module.child:
     type = aws_instance
 `
 
+const testTerraformApplyModuleCountStr = `
+aws_instance.foo:
+  ID = foo
+  bar = b
+  foo = a,b
+  type = aws_instance
+
+  Dependencies:
+    module.child[*]
+    module.child[1]
+
+Outputs:
+
+azs = [a b]
+
+module.child[0]:
+  aws_instance.bar:
+    ID = foo
+    az = a
+    type = aws_instance
+
+  Outputs:
+
+  az = a
+module.child[1]:
+  aws_instance.bar:
+    ID = foo
+    az = b
+    type = aws_instance
+
+  Outputs:
+
+  az = b
+`
+
+const testTerraformApplyModuleCountZeroStr = `
+aws_instance.foo:
+  ID = foo
+  foo = 
+  type = aws_instance
+
+  Dependencies:
+    module.child[*]
+
+Outputs:
+
+ids = []
+`
+
 const testTerraformApplyModuleBoolStr = `
 aws_instance.bar:
   ID = foo
@@ -1237,6 +1286,29 @@ STATE:
 <no state>
 `
 
+const testTerraformPlanModuleCountOrphansStr = `
+DIFF:
+
+module.child[0]:
+module.child[1]:
+  CREATE: aws_instance.foo
+    num:  "" => "1"
+    type: "" => "aws_instance"
+module.child[2]:
+  DESTROY: aws_instance.foo
+
+STATE:
+
+module.child[0]:
+  aws_instance.foo:
+    ID = foo0
+    num = 0
+module.child[2]:
+  aws_instance.foo:
+    ID = foo2
+    num = 2
+`
+
 const testTerraformPlanModuleOrphansStr = `
 DIFF:
 
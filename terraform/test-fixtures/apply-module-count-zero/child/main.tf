@@ -0,0 +1,5 @@
+resource "aws_instance" "bar" {}
+
+output "id" {
+    value = "${aws_instance.bar.id}"
+}
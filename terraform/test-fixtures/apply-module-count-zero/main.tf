@@ -0,0 +1,12 @@
+module "child" {
+    source = "./child"
+    count = 0
+}
+
+resource "aws_instance" "foo" {
+    foo = "${join(",", module.child.*.id)}"
+}
+
+output "ids" {
+    value = ["${module.child.*.id}"]
+}
@@ -0,0 +1,9 @@
+variable "az" {}
+
+resource "aws_instance" "bar" {
+    az = "${var.az}"
+}
+
+output "az" {
+    value = "${aws_instance.bar.az}"
+}
@@ -0,0 +1,18 @@
+variable "azs" {
+    default = ["a", "b"]
+}
+
+module "child" {
+    source = "./child"
+    count = "${length(var.azs)}"
+    az = "${element(var.azs, count.index)}"
+}
+
+resource "aws_instance" "foo" {
+    foo = "${join(",", module.child.*.az)}"
+    bar = "${module.child.1.az}"
+}
+
+output "azs" {
+    value = ["${module.child.*.az}"]
+}
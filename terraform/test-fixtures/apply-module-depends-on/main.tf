@@ -0,0 +1,10 @@
+resource "aws_instance" "a" {}
+
+module "child" {
+  source     = "./child"
+  depends_on = ["aws_instance.a"]
+}
+
+resource "aws_instance" "c" {
+  depends_on = ["module.child"]
+}
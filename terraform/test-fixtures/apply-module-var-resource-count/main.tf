@@ -2,5 +2,5 @@ variable "count" {}
 
 module "child" {
     source = "./child"
-    count = "${var.count}"
+    num = "${var.count}"
 }
@@ -0,0 +1,12 @@
+resource "aws_instance" "a" {
+    require_new = "yes"
+}
+
+resource "aws_instance" "b" {
+    require_new = "yes"
+    foo = "${aws_instance.a.id}"
+}
+
+resource "aws_instance" "c" {
+    num = "1"
+}
@@ -0,0 +1,5 @@
+variable "db_id" {}
+
+resource "aws_instance" "app" {
+    foo = "${var.db_id}"
+}
@@ -0,0 +1,20 @@
+resource "aws_instance" "vpc" {
+    num = "1"
+}
+
+resource "aws_instance" "lb" {
+    num = "2"
+}
+
+resource "aws_instance" "db" {
+    foo = "${aws_instance.vpc.id}"
+}
+
+resource "aws_instance" "web" {
+    foo = "${aws_instance.db.id}"
+}
+
+module "child" {
+    source = "./child"
+    db_id = "${aws_instance.db.id}"
+}
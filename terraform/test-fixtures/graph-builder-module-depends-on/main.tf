@@ -0,0 +1,10 @@
+resource "aws_instance" "db" {}
+
+module "child" {
+    source = "./child"
+    depends_on = ["aws_instance.db"]
+}
+
+resource "aws_instance" "web" {
+    depends_on = ["module.child"]
+}
@@ -0,0 +1,13 @@
+resource "aws_instance" "foo" {
+    count = 3
+    compute = "foo"
+}
+
+resource "aws_instance" "bar" {
+    count = "${length(aws_instance.foo.*.foo)}"
+    foo = "${aws_instance.foo.*.foo[count.index]}"
+}
+
+resource "aws_instance" "baz" {
+    count = "${length(aws_instance.foo.*.foo) * 2}"
+}
@@ -0,0 +1,5 @@
+variable "index" {}
+
+resource "aws_instance" "foo" {
+    num = "${var.index}"
+}
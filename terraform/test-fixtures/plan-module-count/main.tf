@@ -0,0 +1,9 @@
+variable "num" {
+    default = 2
+}
+
+module "child" {
+    source = "./child"
+    count = "${var.num}"
+    index = "${count.index}"
+}
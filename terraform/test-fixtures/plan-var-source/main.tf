@@ -0,0 +1,13 @@
+variable "password" {
+    source = "secret://db#password"
+}
+
+variable "zones" {
+    type   = "list"
+    source = "list://zones"
+}
+
+resource "aws_instance" "foo" {
+    foo = "${var.password}"
+    num = "${length(var.zones)}"
+}
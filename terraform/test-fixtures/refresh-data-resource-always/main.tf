@@ -0,0 +1,9 @@
+data "null_data_source" "testing" {
+  inputs = {
+    test = "yes"
+  }
+
+  lifecycle {
+    always_refresh = true
+  }
+}
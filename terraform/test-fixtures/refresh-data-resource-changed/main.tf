@@ -0,0 +1,5 @@
+data "null_data_source" "testing" {
+  inputs = {
+    test = "no"
+  }
+}
@@ -0,0 +1,6 @@
+resource "aws_instance" "foo" {}
+
+data "aws_vpc" "bar" {
+  foo        = "bar"
+  depends_on = ["aws_instance.foo"]
+}
@@ -3,8 +3,12 @@ package terraform
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/hil"
+	"github.com/hashicorp/hil/ast"
 	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/config/module"
 )
@@ -15,6 +19,12 @@ import (
 // Graph.
 type ConfigTransformer struct {
 	Module *module.Tree
+
+	// Variables and Meta are used to compute the count of counted modules.
+	// Modules are expanded while the graph is built, so their count can
+	// only depend on variables.
+	Variables map[string]interface{}
+	Meta      *ContextMeta
 }
 
 func (t *ConfigTransformer) Transform(g *Graph) error {
@@ -26,8 +36,17 @@ func (t *ConfigTransformer) Transform(g *Graph) error {
 		return errors.New("module must be loaded")
 	}
 
-	// Get the module we care about
-	module := t.Module.Child(g.Path[1:])
+	// Get the module we care about. Instances of counted modules that
+	// are no longer within the count don't have any configuration.
+	instances := &moduleInstances{
+		Root:      t.Module,
+		Variables: t.Variables,
+		Meta:      t.Meta,
+	}
+	module, err := instances.Tree(g.Path)
+	if err != nil {
+		return err
+	}
 	if module == nil {
 		return nil
 	}
@@ -65,18 +84,30 @@ func (t *ConfigTransformer) Transform(g *Graph) error {
 		})
 	}
 
-	// Write all the modules out
+	// Write all the modules out. Counted modules get a node for each
+	// instance.
 	children := module.Children()
+	counted := make(map[string]struct{})
 	for _, m := range config.Modules {
-		path := make([]string, len(g.Path), len(g.Path)+1)
-		copy(path, g.Path)
-		path = append(path, m.Name)
-
-		nodes = append(nodes, &GraphNodeConfigModule{
-			Path:   path,
-			Module: m,
-			Tree:   children[m.Name],
-		})
+		names, err := instances.Names(g.Path, m)
+		if err != nil {
+			return err
+		}
+		if m.Counted() {
+			counted[fmt.Sprintf("module.%s", m.Name)] = struct{}{}
+		}
+
+		for _, name := range names {
+			path := make([]string, len(g.Path), len(g.Path)+1)
+			copy(path, g.Path)
+			path = append(path, name)
+
+			nodes = append(nodes, &GraphNodeConfigModule{
+				Path:   path,
+				Module: m,
+				Tree:   children[m.Name],
+			})
+		}
 	}
 
 	// Write all the outputs out
@@ -84,9 +115,6 @@ func (t *ConfigTransformer) Transform(g *Graph) error {
 		nodes = append(nodes, &GraphNodeConfigOutput{Output: o})
 	}
 
-	// Err is where the final error value will go if there is one
-	var err error
-
 	// Build the graph vertices
 	for _, n := range nodes {
 		g.Add(n)
@@ -97,6 +125,16 @@ func (t *ConfigTransformer) Transform(g *Graph) error {
 	for _, n := range nodes {
 		if missing := g.ConnectDependent(n); len(missing) > 0 {
 			for _, m := range missing {
+				// Splats of modules with a count of zero and the
+				// modules themselves don't have anything to depend on.
+				name := m
+				if idx := strings.Index(m, "[*]"); idx != -1 {
+					name = m[:idx]
+				}
+				if _, ok := counted[name]; ok {
+					continue
+				}
+
 				err = multierror.Append(err, fmt.Errorf(
 					"%s: missing dependency: %s", n.Name(), m))
 			}
@@ -112,6 +150,17 @@ func (t *ConfigTransformer) Transform(g *Graph) error {
 func varNameForVar(raw config.InterpolatedVariable) string {
 	switch v := raw.(type) {
 	case *config.ModuleVariable:
+		if v.Multi {
+			// Splats depend on all the instances of the module, see
+			// Graph.ConnectTo.
+			name := fmt.Sprintf("%s[*]", v.Name)
+			if v.Index != -1 {
+				name = config.ModuleInstanceName(v.Name, v.Index)
+			}
+
+			return fmt.Sprintf("module.%s.output.%s", name, v.Field)
+		}
+
 		return fmt.Sprintf("module.%s.output.%s", v.Name, v.Field)
 	case *config.ResourceVariable:
 		return v.ResourceId()
@@ -121,3 +170,211 @@ func varNameForVar(raw config.InterpolatedVariable) string {
 		return ""
 	}
 }
+
+// moduleInstances resolves the instances of counted modules while the
+// graph is built. Module counts can only reference variables, so they are
+// computed from the root variables by interpolating the module blocks
+// along the path.
+type moduleInstances struct {
+	Root      *module.Tree
+	Variables map[string]interface{}
+	Meta      *ContextMeta
+}
+
+// Tree returns the module tree for the given graph path, or nil if the
+// path doesn't refer to a module in the configuration. Unlike
+// module.Tree.Child, this returns nil for instances of counted modules
+// that are beyond the current count.
+func (m *moduleInstances) Tree(path []string) (*module.Tree, error) {
+	tree := m.Root
+	for i := 1; i < len(path); i++ {
+		name, index := config.ParseModuleInstanceName(path[i])
+		mc := moduleConfig(tree.Config(), name)
+		child := tree.Children()[name]
+		if mc == nil || child == nil {
+			return nil, nil
+		}
+
+		if !mc.Counted() {
+			if index != -1 {
+				return nil, nil
+			}
+		} else {
+			if index == -1 {
+				return nil, nil
+			}
+
+			count, err := m.Count(path[:i], mc)
+			if err != nil {
+				return nil, err
+			}
+			if index >= count {
+				return nil, nil
+			}
+		}
+
+		tree = child
+	}
+
+	return tree, nil
+}
+
+// Names returns the names of the instances of the module block mc within
+// the module at path. Modules without count have a single instance that
+// is named after the module.
+func (m *moduleInstances) Names(path []string, mc *config.Module) ([]string, error) {
+	if !mc.Counted() {
+		return []string{mc.Name}, nil
+	}
+
+	count, err := m.Count(path, mc)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, count)
+	for i := range result {
+		result[i] = config.ModuleInstanceName(mc.Name, i)
+	}
+
+	return result, nil
+}
+
+// Count returns the count of the module block mc within the module at path.
+func (m *moduleInstances) Count(path []string, mc *config.Module) (int, error) {
+	if !mc.Counted() {
+		return 1, nil
+	}
+
+	vs, err := m.variables(path)
+	if err != nil {
+		return 0, err
+	}
+
+	rc := mc.RawCount.Copy()
+	for k := range rc.Variables {
+		if _, ok := vs[k]; !ok {
+			return 0, fmt.Errorf(
+				"module.%s: count can't be computed because %s isn't known "+
+					"until apply. Module counts can only depend on variables "+
+					"with static values.", mc.Name, k)
+		}
+	}
+	if err := rc.Interpolate(vs); err != nil {
+		return 0, fmt.Errorf("module.%s: error interpolating count: %s", mc.Name, err)
+	}
+
+	v, err := strconv.ParseInt(rc.Value().(string), 0, 0)
+	if err != nil {
+		return 0, fmt.Errorf("module.%s: count must be an integer: %s", mc.Name, err)
+	}
+	if v < 0 {
+		return 0, fmt.Errorf("module.%s: count must be positive", mc.Name)
+	}
+
+	return int(v), nil
+}
+
+// variables returns the values of the variables of the module at path
+// that are known while the graph is built, keyed the same way as the
+// variables of an interpolation.
+func (m *moduleInstances) variables(path []string) (map[string]ast.Variable, error) {
+	result := make(map[string]ast.Variable)
+	if m.Meta != nil {
+		result["terraform.env"] = ast.Variable{
+			Type:  ast.TypeString,
+			Value: m.Meta.Env,
+		}
+	}
+
+	tree := m.Root.Child(path[1:])
+	if tree == nil {
+		return result, nil
+	}
+
+	for _, v := range tree.Config().Variables {
+		if v.Default == nil {
+			continue
+		}
+
+		variable, err := hil.InterfaceToVariable(v.Default)
+		if err != nil {
+			return nil, fmt.Errorf("invalid default value for %s: %s", v.Name, err)
+		}
+		result["var."+v.Name] = variable
+	}
+
+	// The root variables are given, the variables of other modules are
+	// set by interpolating their module block within the parent module.
+	// Values that depend on anything other than variables aren't known
+	// yet, which leaves the variable unset.
+	var values map[string]interface{}
+	if len(path) <= 1 {
+		values = m.Variables
+	} else {
+		parent, err := m.variables(path[:len(path)-1])
+		if err != nil {
+			return nil, err
+		}
+
+		name, index := config.ParseModuleInstanceName(path[len(path)-1])
+		if index != -1 {
+			parent["count.index"] = ast.Variable{
+				Type:  ast.TypeInt,
+				Value: index,
+			}
+		}
+
+		mc := moduleConfig(m.Root.Child(path[1:len(path)-1]).Config(), name)
+		if mc == nil {
+			return result, nil
+		}
+
+		values = make(map[string]interface{})
+		for k, raw := range mc.RawConfig.Raw {
+			rc, err := config.NewRawConfig(map[string]interface{}{k: raw})
+			if err != nil {
+				return nil, err
+			}
+
+			known := true
+			for v := range rc.Variables {
+				if _, ok := parent[v]; !ok {
+					known = false
+					break
+				}
+			}
+			if !known {
+				continue
+			}
+
+			if err := rc.Interpolate(parent); err != nil {
+				return nil, err
+			}
+			if v := rc.Config()[k]; v != config.UnknownVariableValue {
+				values[k] = v
+			}
+		}
+	}
+
+	for k, v := range values {
+		variable, err := hil.InterfaceToVariable(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %s: %s", k, err)
+		}
+		result["var."+k] = variable
+	}
+
+	return result, nil
+}
+
+// moduleConfig returns the module block with the given name in c.
+func moduleConfig(c *config.Config, name string) *config.Module {
+	for _, m := range c.Modules {
+		if m.Name == name {
+			return m
+		}
+	}
+
+	return nil
+}
@@ -22,12 +22,28 @@ type GraphNodeFlattenable interface {
 	Flatten(path []string) (dag.Vertex, error)
 }
 
+// GraphNodeFlatDependent can be implemented by nodes with subgraphs to
+// declare dependencies that every node of the subgraph has once it is
+// flattened. The names are resolved in the graph being flattened into.
+type GraphNodeFlatDependent interface {
+	FlatDependentOn() []string
+}
+
 // FlattenTransformer is a transformer that goes through the graph, finds
 // subgraphs that can be flattened, and flattens them into this graph,
 // removing the prior subgraph node.
 type FlattenTransformer struct{}
 
 func (t *FlattenTransformer) Transform(g *Graph) error {
+	// Dependencies of whole subgraphs are connected once everything is
+	// flattened, since they may reference other subgraphs that haven't
+	// been flattened yet.
+	type flatDependency struct {
+		Vertices  []dag.Vertex
+		DependsOn []string
+	}
+	var flatDeps []flatDependency
+
 	for _, v := range g.Vertices() {
 		fn, ok := v.(GraphNodeFlatGraph)
 		if !ok {
@@ -101,6 +117,21 @@ func (t *FlattenTransformer) Transform(g *Graph) error {
 		for _, v := range dependents {
 			g.ConnectDependent(v)
 		}
+
+		if dv, ok := v.(GraphNodeFlatDependent); ok {
+			if deps := dv.FlatDependentOn(); len(deps) > 0 {
+				flatDeps = append(flatDeps, flatDependency{
+					Vertices:  subgraph.Vertices(),
+					DependsOn: deps,
+				})
+			}
+		}
+	}
+
+	for _, d := range flatDeps {
+		for _, v := range d.Vertices {
+			g.ConnectTo(v, d.DependsOn)
+		}
 	}
 
 	return nil
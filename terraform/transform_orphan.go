@@ -27,6 +27,11 @@ type OrphanTransformer struct {
 
 	// View, if non-nil will set a view on the module state.
 	View string
+
+	// Variables and Meta are used to compute the count of counted modules,
+	// see ConfigTransformer.
+	Variables map[string]interface{}
+	Meta      *ContextMeta
 }
 
 func (t *OrphanTransformer) Transform(g *Graph) error {
@@ -46,9 +51,27 @@ func (t *OrphanTransformer) Transform(g *Graph) error {
 	}
 
 	var config *config.Config
+	var moduleNames []string
 	if t.Module != nil {
-		if module := t.Module.Child(g.Path[1:]); module != nil {
+		instances := &moduleInstances{
+			Root:      t.Module,
+			Variables: t.Variables,
+			Meta:      t.Meta,
+		}
+		module, err := instances.Tree(g.Path)
+		if err != nil {
+			return err
+		}
+
+		if module != nil {
 			config = module.Config()
+			for _, m := range config.Modules {
+				names, err := instances.Names(g.Path, m)
+				if err != nil {
+					return err
+				}
+				moduleNames = append(moduleNames, names...)
+			}
 		}
 	}
 
@@ -88,7 +111,7 @@ func (t *OrphanTransformer) Transform(g *Graph) error {
 
 	// Go over each module orphan and add it to the graph. We store the
 	// vertexes and states outside so that we can connect dependencies later.
-	moduleOrphans := t.State.ModuleOrphans(g.Path, config)
+	moduleOrphans := t.State.ModuleInstanceOrphans(g.Path, moduleNames)
 	moduleVertexes := make([]dag.Vertex, len(moduleOrphans))
 	for i, path := range moduleOrphans {
 		var deps []string
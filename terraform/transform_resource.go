@@ -2,7 +2,10 @@ package terraform
 
 import (
 	"fmt"
+	"log"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/dag"
@@ -191,6 +194,9 @@ func (n *graphNodeExpandedResource) StateDependencies() []string {
 		deps = append(deps, d)
 	}
 
+	// Variables are stored in a map, so sort to keep the state stable.
+	sort.Strings(deps)
+
 	return deps
 }
 
@@ -508,6 +514,7 @@ func (n *graphNodeExpandedResource) managedResourceEvalNodes(resource *Resource,
 					Output:    &state,
 					Error:     &err,
 					CreateNew: &createNew,
+					Timeout:   n.timeout(),
 				},
 				&EvalWriteState{
 					Name:         n.stateId(),
@@ -541,6 +548,33 @@ func (n *graphNodeExpandedResource) managedResourceEvalNodes(resource *Resource,
 					},
 				},
 
+				// Verify the postconditions now that the state is written,
+				// so that self references see the applied values.
+				&EvalCheckPostconditions{
+					Name:           n.stateId(),
+					Postconditions: n.Resource.Postconditions,
+					Resource:       resource,
+					State:          &state,
+					Error:          &err,
+					Refresh: &EvalSequence{
+						Nodes: []EvalNode{
+							&EvalRefresh{
+								Info:     info,
+								Provider: &provider,
+								State:    &state,
+								Output:   &state,
+							},
+							&EvalWriteState{
+								Name:         n.stateId(),
+								ResourceType: n.Resource.Type,
+								Provider:     n.Resource.Provider,
+								Dependencies: n.StateDependencies(),
+								State:        &state,
+							},
+						},
+					},
+				},
+
 				// We clear the diff out here so that future nodes
 				// don't see a diff that is already complete. There
 				// is no longer a diff!
@@ -568,6 +602,8 @@ func (n *graphNodeExpandedResource) dataResourceEvalNodes(resource *Resource, in
 	var config *ResourceConfig
 	var diff *InstanceDiff
 	var state *InstanceState
+	var prior *InstanceState
+	var inputHash string
 
 	nodes := make([]EvalNode, 0, 5)
 
@@ -577,6 +613,13 @@ func (n *graphNodeExpandedResource) dataResourceEvalNodes(resource *Resource, in
 		Node: &EvalSequence{
 			Nodes: []EvalNode{
 
+				// Keep the previous result, which is reused below if
+				// the inputs of the data source didn't change.
+				&EvalReadState{
+					Name:   n.stateId(),
+					Output: &prior,
+				},
+
 				// Always destroy the existing state first, since we must
 				// make sure that values from a previous read will not
 				// get interpolated if we end up needing to defer our
@@ -596,8 +639,10 @@ func (n *graphNodeExpandedResource) dataResourceEvalNodes(resource *Resource, in
 				},
 
 				// The rest of this pass can proceed only if there are no
-				// computed values in our config.
-				// (If there are, we'll deal with this during the plan and
+				// computed values in our config, and if the data source
+				// doesn't explicitly depend on anything, since that means
+				// it should only be read once its dependencies are applied.
+				// (If so, we'll deal with this during the plan and
 				// apply phases.)
 				&EvalIf{
 					If: func(ctx EvalContext) (bool, error) {
@@ -605,6 +650,51 @@ func (n *graphNodeExpandedResource) dataResourceEvalNodes(resource *Resource, in
 							return true, EvalEarlyExitError{}
 						}
 
+						if len(n.Resource.DependsOn) > 0 {
+							return true, EvalEarlyExitError{}
+						}
+
+						return true, nil
+					},
+					Then: EvalNoop{},
+				},
+
+				&EvalDataInputHash{
+					Provider: n.ProvidedBy()[0],
+					Config:   &config,
+					Output:   &inputHash,
+				},
+
+				// Reuse the previous result if nothing it depends on
+				// changed, unless the user asked for data sources to be
+				// read again.
+				&EvalReadDataCached{
+					Info:          info,
+					Prior:         &prior,
+					InputHash:     &inputHash,
+					AlwaysRefresh: n.Resource.Lifecycle.AlwaysRefresh,
+					Output:        &state,
+				},
+
+				&EvalIf{
+					If: func(ctx EvalContext) (bool, error) {
+						return state != nil, nil
+					},
+					Then: &EvalWriteState{
+						Name:         n.stateId(),
+						ResourceType: n.Resource.Type,
+						Provider:     n.Resource.Provider,
+						Dependencies: n.StateDependencies(),
+						State:        &state,
+					},
+				},
+
+				&EvalIf{
+					If: func(ctx EvalContext) (bool, error) {
+						if state != nil {
+							return true, EvalEarlyExitError{}
+						}
+
 						return true, nil
 					},
 					Then: EvalNoop{},
@@ -630,10 +720,11 @@ func (n *graphNodeExpandedResource) dataResourceEvalNodes(resource *Resource, in
 				},
 
 				&EvalReadDataApply{
-					Info:     info,
-					Diff:     &diff,
-					Provider: &provider,
-					Output:   &state,
+					Info:      info,
+					Diff:      &diff,
+					Provider:  &provider,
+					Output:    &state,
+					InputHash: &inputHash,
 				},
 
 				&EvalWriteState{
@@ -786,6 +877,12 @@ func (n *graphNodeExpandedResource) dataResourceEvalNodes(resource *Resource, in
 					Output: &provider,
 				},
 
+				&EvalDataInputHash{
+					Provider: n.ProvidedBy()[0],
+					Config:   &config,
+					Output:   &inputHash,
+				},
+
 				// Make a new diff with our newly-interpolated config.
 				&EvalReadDataDiff{
 					Info:     info,
@@ -796,10 +893,11 @@ func (n *graphNodeExpandedResource) dataResourceEvalNodes(resource *Resource, in
 				},
 
 				&EvalReadDataApply{
-					Info:     info,
-					Diff:     &diff,
-					Provider: &provider,
-					Output:   &state,
+					Info:      info,
+					Diff:      &diff,
+					Provider:  &provider,
+					Output:    &state,
+					InputHash: &inputHash,
 				},
 
 				&EvalWriteState{
@@ -839,6 +937,23 @@ func (n *graphNodeExpandedResource) stateId() string {
 	return fmt.Sprintf("%s.%d", n.Resource.Id(), n.Index)
 }
 
+// timeout is the lifecycle timeout of the resource, or zero if none.
+func (n *graphNodeExpandedResource) timeout() time.Duration {
+	if n.Resource.Lifecycle.Timeout == "" {
+		return 0
+	}
+
+	// The timeout is verified during config validation, so an error
+	// here can only come from skipping validation.
+	d, err := time.ParseDuration(n.Resource.Lifecycle.Timeout)
+	if err != nil {
+		log.Printf("[WARN] %s: ignoring invalid timeout: %s", n.stateId(), err)
+		return 0
+	}
+
+	return d
+}
+
 // GraphNodeStateRepresentative impl.
 func (n *graphNodeExpandedResource) StateId() []string {
 	return []string{n.stateId()}
@@ -933,6 +1048,7 @@ func (n *graphNodeExpandedResourceDestroy) EvalTree() EvalNode {
 						Provider: &provider,
 						Output:   &state,
 						Error:    &err,
+						Timeout:  n.timeout(),
 					},
 				},
 				&EvalWriteState{
@@ -0,0 +1,75 @@
+package terraform
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/config/module"
+)
+
+// VariableSource is an external store, such as Vault, that the values of
+// root module variables can be read from. A variable picks a source by its
+// type in the configuration: the source "vault://secret/db" reads the
+// location "secret/db" from the VariableSource registered as "vault" in
+// ContextOpts.VariableSources.
+//
+// Values read from a source are secrets. They're never saved in a plan,
+// and are read again when the plan is applied.
+type VariableSource interface {
+	// ReadVariable returns the value at the given location. Relative
+	// paths in the location are relative to dir, the directory of the
+	// root module.
+	ReadVariable(location string, dir string) (string, error)
+}
+
+// readVariableSources reads the variables of the root module that have a
+// source and weren't given a value otherwise, such as with -var, into
+// variables. It returns the names of the variables it read.
+func readVariableSources(
+	m *module.Tree,
+	variables map[string]interface{},
+	sources map[string]VariableSource) (map[string]struct{}, error) {
+	result := make(map[string]struct{})
+	for _, v := range m.Config().Variables {
+		if v.Source == "" {
+			continue
+		}
+		if _, ok := variables[v.Name]; ok {
+			continue
+		}
+
+		typ, location, err := v.SourceLocation()
+		if err != nil {
+			return nil, fmt.Errorf("variable %s: %s", v.Name, err)
+		}
+		source, ok := sources[typ]
+		if !ok {
+			return nil, fmt.Errorf(
+				"variable %s: unknown source type %q", v.Name, typ)
+		}
+
+		log.Printf("[DEBUG] Reading variable %s from %s", v.Name, v.Source)
+		raw, err := source.ReadVariable(location, m.Config().Dir)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"variable %s: error reading %s: %s", v.Name, v.Source, err)
+		}
+
+		// The value is a secret, so errors must not include it.
+		var value interface{} = raw
+		if v.Type() != config.VariableTypeString {
+			value, err = parseVariableAsHCL(v.Name, raw, v.Type())
+			if err != nil {
+				return nil, fmt.Errorf(
+					"variable %s: the value of %s is not a valid %s",
+					v.Name, v.Source, v.Type().Printable())
+			}
+		}
+
+		variables[v.Name] = value
+		result[v.Name] = struct{}{}
+	}
+
+	return result, nil
+}
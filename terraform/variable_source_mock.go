@@ -0,0 +1,25 @@
+package terraform
+
+import "sync"
+
+// MockVariableSource implements VariableSource but mocks out all the calls
+// for testing purposes.
+type MockVariableSource struct {
+	sync.Mutex
+
+	ReadVariableCalled      bool
+	ReadVariableLocation    string
+	ReadVariableDir         string
+	ReadVariableReturn      string
+	ReadVariableReturnError error
+}
+
+func (s *MockVariableSource) ReadVariable(location string, dir string) (string, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.ReadVariableCalled = true
+	s.ReadVariableLocation = location
+	s.ReadVariableDir = dir
+	return s.ReadVariableReturn, s.ReadVariableReturnError
+}